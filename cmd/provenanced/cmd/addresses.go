@@ -1,11 +1,21 @@
 package cmd
 
 import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	"io"
+	"os"
 	"regexp"
 	"strings"
 
+	tmcli "github.com/tendermint/tendermint/libs/cli"
+
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/google/uuid"
 	"github.com/spf13/cobra"
@@ -36,72 +46,321 @@ func AddMetaAddressCmd() *cobra.Command {
 	return queryCmd
 }
 
+const (
+	flagFile   = "file"
+	flagExists = "exists"
+)
+
+// metadataAddressInfo is the JSON output of the metaaddress commands.
+type metadataAddressInfo struct {
+	Input          string `json:"input,omitempty"`
+	Type           string `json:"type"`
+	Bech32         string `json:"bech32"`
+	Hex            string `json:"hex"`
+	Base64         string `json:"base64"`
+	PrimaryUUID    string `json:"primary_uuid,omitempty"`
+	SecondaryUUID  string `json:"secondary_uuid,omitempty"`
+	ParentAddress  string `json:"parent_address,omitempty"`
+	NameHashHex    string `json:"name_hash_hex,omitempty"`
+	NameHashBase64 string `json:"name_hash_base64,omitempty"`
+	ExcessHex      string `json:"excess_hex,omitempty"`
+	Exists         *bool  `json:"exists,omitempty"`
+}
+
+// newMetadataAddressInfo creates the JSON output for a metadata address.
+func newMetadataAddressInfo(input string, addr types.MetadataAddress, exists *bool) metadataAddressInfo {
+	details := addr.GetDetails()
+	rv := metadataAddressInfo{
+		Input:          input,
+		Type:           details.Prefix,
+		Bech32:         addr.String(),
+		Hex:            hex.EncodeToString(addr),
+		Base64:         base64.StdEncoding.EncodeToString(addr),
+		PrimaryUUID:    details.PrimaryUUID,
+		SecondaryUUID:  details.SecondaryUUID,
+		NameHashHex:    details.NameHashHex,
+		NameHashBase64: details.NameHashBase64,
+		ExcessHex:      details.ExcessHex,
+		Exists:         exists,
+	}
+	if !details.ParentAddress.Empty() {
+		rv.ParentAddress = details.ParentAddress.String()
+	}
+	return rv
+}
+
+// parseMetadataAddress parses a metadata address provided as bech32, hex (with or without a 0x prefix) or base64.
+func parseMetadataAddress(input string) (types.MetadataAddress, error) {
+	input = strings.TrimSpace(input)
+	addr, bech32Err := types.MetadataAddressFromBech32(input)
+	if bech32Err == nil {
+		return addr, nil
+	}
+	if bz, err := hex.DecodeString(strings.TrimPrefix(strings.TrimPrefix(input, "0x"), "0X")); err == nil {
+		if _, err = types.VerifyMetadataAddressFormat(bz); err == nil {
+			return bz, nil
+		}
+	}
+	if bz, err := base64.StdEncoding.DecodeString(input); err == nil {
+		if _, err = types.VerifyMetadataAddressFormat(bz); err == nil {
+			return bz, nil
+		}
+	}
+	return nil, bech32Err
+}
+
+// readInputLines reads the non-empty lines of the --file flag's file (or stdin if it's "-").
+// Lines starting with # are ignored. The line numbers of the returned lines are also returned.
+func readInputLines(cmd *cobra.Command) ([]string, []int, error) {
+	file, err := cmd.Flags().GetString(flagFile)
+	if err != nil || len(file) == 0 {
+		return nil, nil, err
+	}
+	var in io.Reader
+	if file == "-" {
+		in = cmd.InOrStdin()
+	} else {
+		f, err := os.Open(file)
+		if err != nil {
+			return nil, nil, err
+		}
+		defer f.Close()
+		in = f
+	}
+	var lines []string
+	var lineNums []int
+	scanner := bufio.NewScanner(in)
+	for i := 1; scanner.Scan(); i++ {
+		line := strings.TrimSpace(scanner.Text())
+		if len(line) == 0 || strings.HasPrefix(line, "#") {
+			continue
+		}
+		lines = append(lines, line)
+		lineNums = append(lineNums, i)
+	}
+	if err = scanner.Err(); err != nil {
+		return nil, nil, fmt.Errorf("could not read %s: %w", file, err)
+	}
+	return lines, lineNums, nil
+}
+
+// metadataAddressExists queries the chain to find out if the entry with the given metadata address exists.
+func metadataAddressExists(ctx context.Context, queryClient types.QueryClient, addr types.MetadataAddress) (bool, error) {
+	id := addr.String()
+	switch {
+	case addr.IsScopeAddress():
+		res, err := queryClient.Scope(ctx, &types.ScopeRequest{ScopeId: id})
+		if err != nil {
+			return false, err
+		}
+		return res.Scope != nil && res.Scope.Scope != nil, nil
+	case addr.IsSessionAddress():
+		res, err := queryClient.Sessions(ctx, &types.SessionsRequest{SessionId: id})
+		if err != nil {
+			return false, err
+		}
+		return len(res.Sessions) == 1 && res.Sessions[0].Session != nil, nil
+	case addr.IsRecordAddress():
+		res, err := queryClient.Records(ctx, &types.RecordsRequest{RecordAddr: id})
+		if err != nil {
+			return false, err
+		}
+		return len(res.Records) == 1 && res.Records[0].Record != nil, nil
+	case addr.IsScopeSpecificationAddress():
+		res, err := queryClient.ScopeSpecification(ctx, &types.ScopeSpecificationRequest{SpecificationId: id})
+		if err != nil {
+			return false, err
+		}
+		return res.ScopeSpecification != nil && res.ScopeSpecification.Specification != nil, nil
+	case addr.IsContractSpecificationAddress():
+		res, err := queryClient.ContractSpecification(ctx, &types.ContractSpecificationRequest{SpecificationId: id})
+		if err != nil {
+			return false, err
+		}
+		return res.ContractSpecification != nil && res.ContractSpecification.Specification != nil, nil
+	case addr.IsRecordSpecificationAddress():
+		res, err := queryClient.RecordSpecification(ctx, &types.RecordSpecificationRequest{SpecificationId: id})
+		if err != nil {
+			return false, err
+		}
+		return res.RecordSpecification != nil && res.RecordSpecification.Specification != nil, nil
+	}
+	return false, fmt.Errorf("cannot look up metadata address %s with unknown type", addr)
+}
+
+// existsChecker returns a function that checks if a metadata address exists on chain if the --exists flag was given.
+// If it wasn't, the returned function always returns nil.
+func existsChecker(cmd *cobra.Command) (func(addr types.MetadataAddress) (*bool, error), error) {
+	check, err := cmd.Flags().GetBool(flagExists)
+	if err != nil {
+		return nil, err
+	}
+	if !check {
+		return func(types.MetadataAddress) (*bool, error) { return nil, nil }, nil
+	}
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return nil, err
+	}
+	queryClient := types.NewQueryClient(clientCtx)
+	return func(addr types.MetadataAddress) (*bool, error) {
+		exists, err := metadataAddressExists(cmd.Context(), queryClient, addr)
+		if err != nil {
+			return nil, fmt.Errorf("could not look up %s: %w", addr, err)
+		}
+		return &exists, nil
+	}, nil
+}
+
+// isJSONOutput returns true if the --output flag is json.
+func isJSONOutput(cmd *cobra.Command) (bool, error) {
+	output, err := cmd.Flags().GetString(tmcli.OutputFlag)
+	if err != nil {
+		return false, err
+	}
+	return output == "json", nil
+}
+
+// addMetaAddressFlags adds the flags shared by the metaaddress encode and decode commands.
+func addMetaAddressFlags(cmd *cobra.Command, fileUsage string) {
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().String(flagFile, "", fileUsage)
+	cmd.Flags().Bool(flagExists, false, "Query the chain to check whether each entry exists")
+}
+
 // AddMetaAddressDecoder returns metadata address parser cobra Command.
 func AddMetaAddressDecoder() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "decode [address]",
+		Use:     "decode [address ...]",
 		Aliases: []string{"d"},
 		Short:   "Decode MetadataAddress and display associate IDs and types",
-		Example: fmt.Sprintf("%[1]s decode scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel", cmdStart),
-		Args:    cobra.ExactArgs(1),
+		Long: fmt.Sprintf(`Decode MetadataAddress and display associate IDs and types.
+
+Each address can be provided as bech32, hex or base64.
+Addresses can be provided as arguments, or with --%[2]s, one per line.
+Use --%[2]s - to read them from stdin. Empty lines and lines starting with # are ignored.
+
+The output contains the address in all formats, its type, and its UUIDs, parent address and name hash.
+Use --output json to get one JSON object per address.
+Use --%[3]s to also query the chain to find out if each entry exists.`, cmdStart, flagFile, flagExists),
+		Example: fmt.Sprintf(`%[1]s decode scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel
+%[1]s decode 0091978ba25f35459a86a7feca1b0512e0
+%[1]s decode AJGXi6JfNUWahqf+yhsFEuA=
+%[1]s decode --file ids.txt --output json
+cat ids.txt | %[1]s decode --file - --%[2]s --node tcp://localhost:26657`, cmdStart, flagExists),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			addr, parseErr := types.MetadataAddressFromBech32(args[0])
-			if parseErr != nil {
-				return parseErr
+			inputs, lineNums, err := readInputLines(cmd)
+			if err != nil {
+				return err
+			}
+			if len(args) == 0 && len(inputs) == 0 {
+				return fmt.Errorf("at least one address is required as an argument or with --%s", flagFile)
+			}
+			asJSON, err := isJSONOutput(cmd)
+			if err != nil {
+				return err
+			}
+			exists, err := existsChecker(cmd)
+			if err != nil {
+				return err
 			}
-			addrDetails := addr.GetDetails()
-			var toOut string
-			switch {
-			case addr.IsScopeAddress():
-				toOut = fmt.Sprintf(`Type: Scope
+
+			all := make([]string, 0, len(args)+len(inputs))
+			all = append(all, args...)
+			all = append(all, inputs...)
+			for i, input := range all {
+				addr, err := parseMetadataAddress(input)
+				if err == nil && !addr.Empty() {
+					var found *bool
+					if found, err = exists(addr); err == nil {
+						err = printMetadataAddress(cmd, input, addr, found, asJSON, i > 0)
+					}
+				}
+				if err != nil {
+					if i >= len(args) {
+						return fmt.Errorf("line %d: %w", lineNums[i-len(args)], err)
+					}
+					return err
+				}
+			}
+			return nil
+		},
+	}
+	addMetaAddressFlags(cmd, "A file with addresses to decode, one per line (- for stdin)")
+	return cmd
+}
+
+// printMetadataAddress outputs the details of a decoded metadata address.
+func printMetadataAddress(cmd *cobra.Command, input string, addr types.MetadataAddress, exists *bool, asJSON bool, addSeparator bool) error {
+	if asJSON {
+		bz, err := json.Marshal(newMetadataAddressInfo(input, addr, exists))
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(cmd.OutOrStdout(), "%s\n", bz)
+		return err
+	}
+
+	addrDetails := addr.GetDetails()
+	var toOut string
+	if addSeparator {
+		toOut = "\n"
+	}
+	switch {
+	case addr.IsScopeAddress():
+		toOut += fmt.Sprintf(`Type: Scope
 Scope UUID: %s
 `, addrDetails.PrimaryUUID)
-			case addr.IsSessionAddress():
-				toOut = fmt.Sprintf(`Type: Session
+	case addr.IsSessionAddress():
+		toOut += fmt.Sprintf(`Type: Session
 Scope Id: %s
 Scope UUID: %s
 Session UUID: %s
 `, addrDetails.ParentAddress, addrDetails.PrimaryUUID, addrDetails.SecondaryUUID)
-			case addr.IsRecordAddress():
-				toOut = fmt.Sprintf(`Type: Record
+	case addr.IsRecordAddress():
+		toOut += fmt.Sprintf(`Type: Record
 Scope Id: %s
 Scope UUID: %s
 Name Hash (hex): %s
 `, addrDetails.ParentAddress, addrDetails.PrimaryUUID, addrDetails.NameHashHex)
-			case addr.IsScopeSpecificationAddress():
-				toOut = fmt.Sprintf(`Type: Scope Specification
+	case addr.IsScopeSpecificationAddress():
+		toOut += fmt.Sprintf(`Type: Scope Specification
 Scope Specification UUID: %s
 `, addrDetails.PrimaryUUID)
-			case addr.IsContractSpecificationAddress():
-				toOut = fmt.Sprintf(`Type: Contract Specification
+	case addr.IsContractSpecificationAddress():
+		toOut += fmt.Sprintf(`Type: Contract Specification
 Contract Specification UUID: %s
 `, addrDetails.PrimaryUUID)
-			case addr.IsRecordSpecificationAddress():
-				toOut = fmt.Sprintf(`Type: Record Specification
+	case addr.IsRecordSpecificationAddress():
+		toOut += fmt.Sprintf(`Type: Record Specification
 Contract Specification Id: %s
 Contract Specification UUID: %s
 Name Hash (hex): %s
 `, addrDetails.ParentAddress, addrDetails.PrimaryUUID, addrDetails.NameHashHex)
-			default:
-				toOut = fmt.Sprintf(`Type: UNKNOWN
+	default:
+		toOut += fmt.Sprintf(`Type: UNKNOWN
 prefix: %s
 primary UUID: %s
 secondary UUID: %s
 Name Hash (hex): %s
 Excess (hex): %s
 `, addrDetails.Prefix, addrDetails.PrimaryUUID, addrDetails.SecondaryUUID, addrDetails.NameHashHex, addrDetails.ExcessHex)
-			}
-			_, cmdErr := fmt.Fprint(cmd.OutOrStdout(), toOut)
-			return cmdErr
-		},
 	}
-	return cmd
+	toOut += fmt.Sprintf(`Bech32: %s
+Hex: %s
+Base64: %s
+`, addr, hex.EncodeToString(addr), base64.StdEncoding.EncodeToString(addr))
+	if exists != nil {
+		toOut += fmt.Sprintf("Exists: %t\n", *exists)
+	}
+	_, err := fmt.Fprint(cmd.OutOrStdout(), toOut)
+	return err
 }
 
 // AddMetaAddressEncoder returns metadata address encoder cobra Command.
 func AddMetaAddressEncoder() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "encode [type] [uuid] [uuid|name]",
+		Use:   "encode [type] [uuid|parent-id] [uuid|name]",
 		Short: "Encodes metadata uuids to bech32 address for specific type",
 		Long: fmt.Sprintf(`Encodes metadata uuids to bech32 address for specific type.
 
@@ -114,70 +373,187 @@ The third [uuid|name] argument is either required or forbidden based on the type
 
 These types forbid a third argument: scope scope-specification contract-specification
 These types require a third argument: session record record-specification
-This type requires the third argument to be a UUID: session`, cmdStart),
+This type requires the third argument to be a UUID: session
+
+For session and record addresses, the first uuid can instead be the id of the scope (or of another
+session or record in that scope) as bech32, hex or base64.
+For record-specification addresses, it can instead be the id of the contract specification.
+
+Multiple addresses can be encoded using --%[2]s with one "type uuid [uuid|name]" entry per line.
+Use --%[2]s - to read them from stdin. Empty lines and lines starting with # are ignored.
+
+Use --output json to get one JSON object per address with the address in all formats.
+Use --%[3]s to also query the chain to find out if each entry exists.`, cmdStart, flagFile, flagExists),
 		Example: fmt.Sprintf(`%[1]s encode scope 91978ba2-5f35-459a-86a7-feca1b0512e0
 %[1]s encode session 91978ba2-5f35-459a-86a7-feca1b0512e0 5803f8bc-6067-4eb5-951f-2121671c2ec0
 %[1]s encode record 91978ba2-5f35-459a-86a7-feca1b0512e0 recordname
+%[1]s encode record scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel recordname
 %[1]s encode scope-specification dc83ea70-eacd-40fe-9adf-1cf6148bf8a2
 %[1]s encode contract-specification def6bc0a-c9dd-4874-948f-5206e6060a84
-%[1]s encode record-specification def6bc0a-c9dd-4874-948f-5206e6060a84 recordname`, cmdStart),
-		Args: cobra.RangeArgs(2, 3),
+%[1]s encode record-specification def6bc0a-c9dd-4874-948f-5206e6060a84 recordname
+%[1]s encode --file to-encode.txt --output json`, cmdStart),
+		Args: func(cmd *cobra.Command, args []string) error {
+			if file, _ := cmd.Flags().GetString(flagFile); len(file) > 0 {
+				if len(args) > 0 {
+					return fmt.Errorf("arguments cannot be provided with --%s", flagFile)
+				}
+				return nil
+			}
+			return cobra.RangeArgs(2, 3)(cmd, args)
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			addrType := strings.ToLower(regexp.MustCompile("[^[:alpha:]]+").ReplaceAllString(args[0], ""))
-			primaryUUID, err := uuid.Parse(args[1])
+			asJSON, err := isJSONOutput(cmd)
 			if err != nil {
 				return err
 			}
-			var uuidOrNameArg string
-			argsLen := len(args)
-			if argsLen == 3 {
-				uuidOrNameArg = strings.TrimSpace(args[2])
-				if len(uuidOrNameArg) == 0 {
-					argsLen--
-				}
+			exists, err := existsChecker(cmd)
+			if err != nil {
+				return err
 			}
-			var addr types.MetadataAddress
-			switch addrType {
-			case "scope":
-				if argsLen != 2 {
-					return fmt.Errorf("too many arguments for %s address encoder", addrType)
+			output := func(addr types.MetadataAddress) error {
+				found, err := exists(addr)
+				if err != nil {
+					return err
 				}
-				addr = types.ScopeMetadataAddress(primaryUUID)
-			case "session":
-				if argsLen != 3 {
-					return fmt.Errorf("not enough arguments for %s address encoder", addrType)
+				if asJSON {
+					bz, err := json.Marshal(newMetadataAddressInfo("", addr, found))
+					if err != nil {
+						return err
+					}
+					_, err = fmt.Fprintf(cmd.OutOrStdout(), "%s\n", bz)
+					return err
 				}
-				secondaryUUID, err := uuid.Parse(uuidOrNameArg)
-				if err != nil {
+				if found != nil {
+					_, err = fmt.Fprintf(cmd.OutOrStdout(), "%s exists: %t\n", addr, *found)
 					return err
 				}
-				addr = types.SessionMetadataAddress(primaryUUID, secondaryUUID)
-			case "record":
-				if argsLen != 3 {
-					return fmt.Errorf("not enough arguments for %s address encoder", addrType)
+				_, err = fmt.Fprintf(cmd.OutOrStdout(), "%s\n", addr)
+				return err
+			}
+
+			if len(args) > 0 {
+				addr, err := encodeMetadataAddress(args)
+				if err != nil {
+					return err
 				}
-				addr = types.RecordMetadataAddress(primaryUUID, uuidOrNameArg)
-			case "scopespecification", "scopespec":
-				if argsLen != 2 {
-					return fmt.Errorf("too many arguments for %s address encoder", "scope-specification")
+				return output(addr)
+			}
+
+			lines, lineNums, err := readInputLines(cmd)
+			if err != nil {
+				return err
+			}
+			for i, line := range lines {
+				lineArgs := regexp.MustCompile(`\s+`).Split(line, 3)
+				if len(lineArgs) < 2 {
+					return fmt.Errorf("line %d: expected \"type uuid [uuid|name]\", got %q", lineNums[i], line)
 				}
-				addr = types.ScopeSpecMetadataAddress(primaryUUID)
-			case "contractspecification", "contractspec", "cspec":
-				if argsLen != 2 {
-					return fmt.Errorf("too many arguments for %s address encoder", "contract-specification")
+				addr, err := encodeMetadataAddress(lineArgs)
+				if err == nil {
+					err = output(addr)
 				}
-				addr = types.ContractSpecMetadataAddress(primaryUUID)
-			case "recordspecification", "recordspec", "recspec":
-				if argsLen != 3 {
-					return fmt.Errorf("not enough arguments for %s address encoder", "record-specification")
+				if err != nil {
+					return fmt.Errorf("line %d: %w", lineNums[i], err)
 				}
-				addr = types.RecordSpecMetadataAddress(primaryUUID, uuidOrNameArg)
-			default:
-				return fmt.Errorf("unknown type: %s, Supported types: scope session record scope-specification contract-specification record-specification", args[0])
 			}
-			_, cmdErr := fmt.Fprintf(cmd.OutOrStdout(), "%s\n", addr)
-			return cmdErr
+			return nil
 		},
 	}
+	addMetaAddressFlags(cmd, `A file with entries to encode, one "type uuid [uuid|name]" per line (- for stdin)`)
 	return cmd
 }
+
+// encodeMetadataAddress creates a metadata address from the encode command's [type] [uuid|parent-id] [uuid|name] args.
+func encodeMetadataAddress(args []string) (types.MetadataAddress, error) {
+	addrType := strings.ToLower(regexp.MustCompile("[^[:alpha:]]+").ReplaceAllString(args[0], ""))
+	var uuidOrNameArg string
+	argsLen := len(args)
+	if argsLen == 3 {
+		uuidOrNameArg = strings.TrimSpace(args[2])
+		if len(uuidOrNameArg) == 0 {
+			argsLen--
+		}
+	}
+	var addr types.MetadataAddress
+	switch addrType {
+	case "scope":
+		primaryUUID, err := uuid.Parse(args[1])
+		if err != nil {
+			return nil, err
+		}
+		if argsLen != 2 {
+			return nil, fmt.Errorf("too many arguments for %s address encoder", addrType)
+		}
+		addr = types.ScopeMetadataAddress(primaryUUID)
+	case "session":
+		primaryUUID, err := parseUUIDOrParentID(args[1], types.MetadataAddress.AsScopeAddress)
+		if err != nil {
+			return nil, err
+		}
+		if argsLen != 3 {
+			return nil, fmt.Errorf("not enough arguments for %s address encoder", addrType)
+		}
+		secondaryUUID, err := uuid.Parse(uuidOrNameArg)
+		if err != nil {
+			return nil, err
+		}
+		addr = types.SessionMetadataAddress(primaryUUID, secondaryUUID)
+	case "record":
+		primaryUUID, err := parseUUIDOrParentID(args[1], types.MetadataAddress.AsScopeAddress)
+		if err != nil {
+			return nil, err
+		}
+		if argsLen != 3 {
+			return nil, fmt.Errorf("not enough arguments for %s address encoder", addrType)
+		}
+		addr = types.RecordMetadataAddress(primaryUUID, uuidOrNameArg)
+	case "scopespecification", "scopespec":
+		primaryUUID, err := uuid.Parse(args[1])
+		if err != nil {
+			return nil, err
+		}
+		if argsLen != 2 {
+			return nil, fmt.Errorf("too many arguments for %s address encoder", "scope-specification")
+		}
+		addr = types.ScopeSpecMetadataAddress(primaryUUID)
+	case "contractspecification", "contractspec", "cspec":
+		primaryUUID, err := uuid.Parse(args[1])
+		if err != nil {
+			return nil, err
+		}
+		if argsLen != 2 {
+			return nil, fmt.Errorf("too many arguments for %s address encoder", "contract-specification")
+		}
+		addr = types.ContractSpecMetadataAddress(primaryUUID)
+	case "recordspecification", "recordspec", "recspec":
+		primaryUUID, err := parseUUIDOrParentID(args[1], types.MetadataAddress.AsContractSpecAddress)
+		if err != nil {
+			return nil, err
+		}
+		if argsLen != 3 {
+			return nil, fmt.Errorf("not enough arguments for %s address encoder", "record-specification")
+		}
+		addr = types.RecordSpecMetadataAddress(primaryUUID, uuidOrNameArg)
+	default:
+		return nil, fmt.Errorf("unknown type: %s, Supported types: scope session record scope-specification contract-specification record-specification", args[0])
+	}
+	return addr, nil
+}
+
+// parseUUIDOrParentID parses the arg as a UUID or as a metadata address that can be converted
+// to the needed parent address using asParent. The parent's primary UUID is returned.
+func parseUUIDOrParentID(arg string, asParent func(types.MetadataAddress) (types.MetadataAddress, error)) (uuid.UUID, error) {
+	primaryUUID, uuidErr := uuid.Parse(arg)
+	if uuidErr == nil {
+		return primaryUUID, nil
+	}
+	addr, err := parseMetadataAddress(arg)
+	if err != nil || addr.Empty() {
+		return uuid.UUID{}, uuidErr
+	}
+	parent, err := asParent(addr)
+	if err != nil {
+		return uuid.UUID{}, err
+	}
+	return parent.PrimaryUUID()
+}
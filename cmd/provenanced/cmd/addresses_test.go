@@ -2,9 +2,14 @@ package cmd_test
 
 import (
 	"bytes"
+	"encoding/base64"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/google/uuid"
@@ -12,7 +17,19 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	testnet "github.com/cosmos/cosmos-sdk/testutil/network"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	tmcli "github.com/tendermint/tendermint/libs/cli"
+
+	"github.com/provenance-io/provenance/app"
 	"github.com/provenance-io/provenance/cmd/provenanced/cmd"
+	"github.com/provenance-io/provenance/internal/antewrapper"
+	"github.com/provenance-io/provenance/internal/pioconfig"
+	"github.com/provenance-io/provenance/testutil"
+	"github.com/provenance-io/provenance/x/metadata/types"
 )
 
 type MetaaddressTestSuite struct {
@@ -133,15 +150,45 @@ func (s MetaaddressTestSuite) TestAddMetaAddressDecoder() {
 				fmt.Sprintf("Name Hash (hex): %s", s.recordNameHashedHex),
 			},
 		},
+		{
+			name: "valid scope as hex",
+			args: []string{hex.EncodeToString(types.ScopeMetadataAddress(s.scopeUUID))},
+			inResult: []string{
+				"Type: Scope",
+				fmt.Sprintf("Scope UUID: %s", s.scopeUUIDStr),
+				fmt.Sprintf("Bech32: %s", s.scopeIDStr),
+			},
+		},
+		{
+			name: "valid scope as hex with 0x",
+			args: []string{"0x" + hex.EncodeToString(types.ScopeMetadataAddress(s.scopeUUID))},
+			inResult: []string{
+				"Type: Scope",
+				fmt.Sprintf("Bech32: %s", s.scopeIDStr),
+			},
+		},
+		{
+			name: "valid record as base64",
+			args: []string{base64.StdEncoding.EncodeToString(types.RecordMetadataAddress(s.scopeUUID, s.recordName))},
+			inResult: []string{
+				"Type: Record",
+				fmt.Sprintf("Scope Id: %s", s.scopeIDStr),
+				fmt.Sprintf("Bech32: %s", s.recordIDStr),
+				fmt.Sprintf("Hex: %s", hex.EncodeToString(types.RecordMetadataAddress(s.scopeUUID, s.recordName))),
+			},
+		},
 		{
 			name: "no args",
 			args: []string{},
-			err:  "accepts 1 arg(s), received 0",
+			err:  "at least one address is required as an argument or with --file",
 		},
 		{
 			name: "two args",
 			args: []string{s.scopeIDStr, s.sessionIDStr},
-			err:  "accepts 1 arg(s), received 2",
+			inResult: []string{
+				fmt.Sprintf("Bech32: %s", s.scopeIDStr),
+				fmt.Sprintf("Bech32: %s", s.sessionIDStr),
+			},
 		},
 		{
 			name: "invalid address",
@@ -249,6 +296,16 @@ func (s MetaaddressTestSuite) TestAddMetaAddressEncoder() {
 			args:     []string{"record", s.scopeUUIDStr, s.recordName},
 			inResult: []string{s.recordIDStr},
 		},
+		{
+			name:     "record from scope id valid",
+			args:     []string{"record", s.scopeIDStr, s.recordName},
+			inResult: []string{s.recordIDStr},
+		},
+		{
+			name:     "record from session id valid",
+			args:     []string{"record", s.sessionIDStr, s.recordName},
+			inResult: []string{s.recordIDStr},
+		},
 		{
 			name:     "Record valid",
 			args:     []string{"Record", s.scopeUUIDStr, s.recordName},
@@ -352,6 +409,16 @@ func (s MetaaddressTestSuite) TestAddMetaAddressEncoder() {
 			args:     []string{"RecSpec", s.contractSpecUUIDStr, s.recordName},
 			inResult: []string{s.recordSpecIDStr},
 		},
+		{
+			name:     "record-specification from contract spec id valid",
+			args:     []string{"record-specification", s.contractSpecIDStr, s.recordName},
+			inResult: []string{s.recordSpecIDStr},
+		},
+		{
+			name: "record-specification from scope id invalid",
+			args: []string{"record-specification", s.scopeIDStr, s.recordName},
+			err:  fmt.Sprintf("this metadata address (%s) does not contain a contract specification uuid", s.scopeIDStr),
+		},
 		{
 			name: "record-specification invalid missing param",
 			args: []string{"record-specification", s.contractSpecUUIDStr},
@@ -384,3 +451,280 @@ func (s MetaaddressTestSuite) TestAddMetaAddressEncoder() {
 		})
 	}
 }
+
+func (s MetaaddressTestSuite) TestAddMetaAddressDecoderBatch() {
+	recordHex := hex.EncodeToString(types.RecordMetadataAddress(s.scopeUUID, s.recordName))
+	idFile := filepath.Join(s.T().TempDir(), "ids.txt")
+	s.Require().NoError(os.WriteFile(idFile, []byte(fmt.Sprintf("# ids\n%s\n\n%s\n", s.scopeIDStr, recordHex)), 0o600), "writing id file")
+	badFile := filepath.Join(s.T().TempDir(), "bad.txt")
+	missingFile := filepath.Join(s.T().TempDir(), "missing.txt")
+	s.Require().NoError(os.WriteFile(badFile, []byte(fmt.Sprintf("%s\n\nnotanid\n", s.scopeIDStr)), 0o600), "writing bad file")
+
+	tests := []struct {
+		name   string
+		args   []string
+		stdin  string
+		expOut []string
+		err    string
+	}{
+		{
+			name:   "file as json",
+			args:   []string{"--file", idFile, "--output", "json"},
+			expOut: []string{s.scopeIDStr, s.recordIDStr},
+		},
+		{
+			name:   "args and stdin as json",
+			args:   []string{s.sessionIDStr, "--file", "-", "--output", "json"},
+			stdin:  s.contractSpecIDStr + "\n" + s.recordSpecIDStr + "\n",
+			expOut: []string{s.sessionIDStr, s.contractSpecIDStr, s.recordSpecIDStr},
+		},
+		{
+			name: "bad line",
+			args: []string{"--file", badFile},
+			err:  "line 3: decoding bech32 failed: invalid bech32 string length 7",
+		},
+		{
+			name: "missing file",
+			args: []string{"--file", missingFile},
+			err:  fmt.Sprintf("open %s: no such file or directory", missingFile),
+		},
+	}
+
+	for _, tc := range tests {
+		s.T().Run(tc.name, func(t *testing.T) {
+			command := cmd.AddMetaAddressDecoder()
+			command.SetArgs(tc.args)
+			command.SetIn(strings.NewReader(tc.stdin))
+			b := bytes.NewBufferString("")
+			command.SetOut(b)
+			err := command.Execute()
+			if len(tc.err) > 0 {
+				require.EqualErrorf(t, err, tc.err, "%s - expected error", command.Name())
+				return
+			}
+			require.NoErrorf(t, err, "%s - unexpected error", command.Name())
+			lines := strings.Split(strings.TrimSpace(b.String()), "\n")
+			require.Len(t, lines, len(tc.expOut), "output lines")
+			for i, line := range lines {
+				var info map[string]interface{}
+				require.NoError(t, json.Unmarshal([]byte(line), &info), "unmarshalling line %d", i)
+				addr, err := types.MetadataAddressFromBech32(tc.expOut[i])
+				require.NoError(t, err, "MetadataAddressFromBech32(%q)", tc.expOut[i])
+				assert.Equal(t, tc.expOut[i], info["bech32"], "line %d bech32", i)
+				assert.Equal(t, hex.EncodeToString(addr), info["hex"], "line %d hex", i)
+				assert.Equal(t, base64.StdEncoding.EncodeToString(addr), info["base64"], "line %d base64", i)
+				prefix, _ := addr.Prefix()
+				assert.Equal(t, prefix, info["type"], "line %d type", i)
+				assert.NotContains(t, info, "exists", "line %d exists", i)
+			}
+		})
+	}
+}
+
+func (s MetaaddressTestSuite) TestAddMetaAddressEncoderBatch() {
+	stdin := fmt.Sprintf(`scope %[1]s
+# comment
+session %[2]s %[3]s
+record %[2]s %[4]s
+contract-spec %[5]s
+`, s.scopeUUIDStr, s.scopeIDStr, s.sessionUUIDStr, s.recordName, s.contractSpecUUIDStr)
+
+	tests := []struct {
+		name   string
+		args   []string
+		stdin  string
+		expOut string
+		err    string
+	}{
+		{
+			name:   "stdin as text",
+			args:   []string{"--file", "-"},
+			stdin:  stdin,
+			expOut: strings.Join([]string{s.scopeIDStr, s.sessionIDStr, s.recordIDStr, s.contractSpecIDStr}, "\n") + "\n",
+		},
+		{
+			name:  "stdin as json",
+			args:  []string{"--file", "-", "--output", "json"},
+			stdin: "record " + s.scopeUUIDStr + " " + s.recordName + "\n",
+			expOut: fmt.Sprintf(`{"type":"record","bech32":"%s","hex":"%s","base64":"%s","primary_uuid":"%s","parent_address":"%s","name_hash_hex":"%s","name_hash_base64":"%s"}`+"\n",
+				s.recordIDStr, hex.EncodeToString(types.RecordMetadataAddress(s.scopeUUID, s.recordName)),
+				base64.StdEncoding.EncodeToString(types.RecordMetadataAddress(s.scopeUUID, s.recordName)),
+				s.scopeUUIDStr, s.scopeIDStr, s.recordNameHashedHex, base64.StdEncoding.EncodeToString(s.recordNameHashedBytes)),
+		},
+		{
+			name:  "bad line",
+			args:  []string{"--file", "-"},
+			stdin: "scope " + s.scopeUUIDStr + "\nsession " + s.scopeUUIDStr + "\n",
+			err:   "line 2: not enough arguments for session address encoder",
+		},
+		{
+			name:  "line with only a type",
+			args:  []string{"--file", "-"},
+			stdin: "scope\n",
+			err:   `line 1: expected "type uuid [uuid|name]", got "scope"`,
+		},
+		{
+			name: "file and args",
+			args: []string{"scope", s.scopeUUIDStr, "--file", "-"},
+			err:  "arguments cannot be provided with --file",
+		},
+	}
+
+	for _, tc := range tests {
+		s.T().Run(tc.name, func(t *testing.T) {
+			command := cmd.AddMetaAddressEncoder()
+			command.SetArgs(tc.args)
+			command.SetIn(strings.NewReader(tc.stdin))
+			b := bytes.NewBufferString("")
+			command.SetOut(b)
+			err := command.Execute()
+			if len(tc.err) > 0 {
+				require.EqualErrorf(t, err, tc.err, "%s - expected error", command.Name())
+				return
+			}
+			require.NoErrorf(t, err, "%s - unexpected error", command.Name())
+			assert.Equal(t, tc.expOut, b.String(), "output")
+		})
+	}
+}
+
+type MetaaddressExistsTestSuite struct {
+	suite.Suite
+
+	cfg     testnet.Config
+	testnet *testnet.Network
+
+	owner string
+
+	scopeID        types.MetadataAddress
+	sessionID      types.MetadataAddress
+	recordID       types.MetadataAddress
+	scopeSpecID    types.MetadataAddress
+	contractSpecID types.MetadataAddress
+	recordSpecID   types.MetadataAddress
+	recordName     string
+}
+
+func (s *MetaaddressExistsTestSuite) SetupSuite() {
+	s.T().Log("setting up metaaddress exists test suite")
+	// Use the same address prefixes as the root command so the addresses cached by this network stay valid for the
+	// other tests in this package.
+	app.SetConfig(false, false)
+	pioconfig.SetProvenanceConfig("atom", 0)
+	cfg := testutil.DefaultTestNetworkConfig()
+	cfg.NumValidators = 1
+	cfg.ChainID = antewrapper.SimAppChainID
+
+	s.owner = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	scopeUUID := uuid.New()
+	contractSpecUUID := uuid.New()
+	s.recordName = "recordname"
+	s.scopeID = types.ScopeMetadataAddress(scopeUUID)
+	s.sessionID = types.SessionMetadataAddress(scopeUUID, uuid.New())
+	s.recordID = types.RecordMetadataAddress(scopeUUID, s.recordName)
+	s.scopeSpecID = types.ScopeSpecMetadataAddress(uuid.New())
+	s.contractSpecID = types.ContractSpecMetadataAddress(contractSpecUUID)
+	s.recordSpecID = types.RecordSpecMetadataAddress(contractSpecUUID, s.recordName)
+
+	owners := []types.Party{{Address: s.owner, Role: types.PartyType_PARTY_TYPE_OWNER}}
+	ownerRoles := []types.PartyType{types.PartyType_PARTY_TYPE_OWNER}
+
+	var metadataData types.GenesisState
+	s.Require().NoError(cfg.Codec.UnmarshalJSON(cfg.GenesisState[types.ModuleName], &metadataData), "unmarshal metadata genesis")
+	metadataData.Scopes = append(metadataData.Scopes,
+		*types.NewScope(s.scopeID, s.scopeSpecID, owners, nil, s.owner))
+	metadataData.Sessions = append(metadataData.Sessions,
+		*types.NewSession("exists session", s.sessionID, s.contractSpecID, owners, nil))
+	metadataData.Records = append(metadataData.Records,
+		*types.NewRecord(s.recordName, s.sessionID, *types.NewProcess("process", &types.Process_Hash{Hash: "processhash"}, "method"),
+			nil, []types.RecordOutput{*types.NewRecordOutput("outputhash", types.ResultStatus_RESULT_STATUS_PASS)}, s.recordSpecID))
+	metadataData.ScopeSpecifications = append(metadataData.ScopeSpecifications,
+		*types.NewScopeSpecification(s.scopeSpecID, nil, []string{s.owner}, ownerRoles, []types.MetadataAddress{s.contractSpecID}))
+	metadataData.ContractSpecifications = append(metadataData.ContractSpecifications,
+		*types.NewContractSpecification(s.contractSpecID, nil, []string{s.owner}, ownerRoles,
+			types.NewContractSpecificationSourceHash("sourcehash"), "classname"))
+	metadataData.RecordSpecifications = append(metadataData.RecordSpecifications,
+		*types.NewRecordSpecification(s.recordSpecID, s.recordName, nil, "typename", types.DefinitionType_DEFINITION_TYPE_RECORD, ownerRoles))
+	metadataDataBz, err := cfg.Codec.MarshalJSON(&metadataData)
+	s.Require().NoError(err, "marshal metadata genesis")
+	cfg.GenesisState[types.ModuleName] = metadataDataBz
+
+	s.cfg = cfg
+	s.testnet, err = testnet.New(s.T(), s.T().TempDir(), cfg)
+	s.Require().NoError(err, "creating testnet")
+
+	_, err = s.testnet.WaitForHeight(1)
+	s.Require().NoError(err, "waiting for height 1")
+}
+
+func (s *MetaaddressExistsTestSuite) TearDownSuite() {
+	testutil.CleanUp(s.testnet, s.T())
+}
+
+func TestMetaaddressExistsTestSuite(t *testing.T) {
+	suite.Run(t, new(MetaaddressExistsTestSuite))
+}
+
+func (s *MetaaddressExistsTestSuite) TestMetaAddressExistsCmd() {
+	missingScopeID := types.ScopeMetadataAddress(uuid.New())
+	asJSON := fmt.Sprintf("--%s=json", tmcli.OutputFlag)
+
+	tests := []struct {
+		name      string
+		encode    bool
+		args      []string
+		expInOuts []string
+	}{
+		{
+			name: "existing entries as text",
+			args: []string{s.scopeID.String(), s.sessionID.String(), s.recordID.String(), "--exists"},
+			expInOuts: []string{
+				fmt.Sprintf("Bech32: %s\nHex: %x\nBase64: ", s.scopeID, []byte(s.scopeID)),
+				fmt.Sprintf("Bech32: %s", s.sessionID),
+				fmt.Sprintf("Bech32: %s", s.recordID),
+				"Exists: true",
+			},
+		},
+		{
+			name: "specifications as json",
+			args: []string{s.scopeSpecID.String(), s.contractSpecID.String(), s.recordSpecID.String(), "--exists", asJSON},
+			expInOuts: []string{
+				fmt.Sprintf(`"bech32":"%s"`, s.scopeSpecID),
+				fmt.Sprintf(`"bech32":"%s"`, s.contractSpecID),
+				fmt.Sprintf(`"bech32":"%s"`, s.recordSpecID),
+				`"exists":true`,
+			},
+		},
+		{
+			name:      "missing scope",
+			args:      []string{missingScopeID.String(), "--exists", asJSON},
+			expInOuts: []string{fmt.Sprintf(`"bech32":"%s"`, missingScopeID), `"exists":false`},
+		},
+		{
+			name:      "record from scope id",
+			encode:    true,
+			args:      []string{"record", s.scopeID.String(), s.recordName, "--exists"},
+			expInOuts: []string{fmt.Sprintf("%s exists: true", s.recordID)},
+		},
+		{
+			name:      "missing record",
+			encode:    true,
+			args:      []string{"record", s.scopeID.String(), "notarecord", "--exists"},
+			expInOuts: []string{fmt.Sprintf("%s exists: false", s.scopeID.MustGetAsRecordAddress("notarecord"))},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			command := cmd.AddMetaAddressDecoder()
+			if tc.encode {
+				command = cmd.AddMetaAddressEncoder()
+			}
+			out, err := clitestutil.ExecTestCLICmd(s.testnet.Validators[0].ClientCtx, command, tc.args)
+			s.Require().NoError(err, "%s error", command.Name())
+			for _, exp := range tc.expInOuts {
+				s.Assert().Contains(out.String(), exp, "%s output", command.Name())
+			}
+		})
+	}
+}
@@ -0,0 +1,103 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+
+	metadatatypes "github.com/provenance-io/provenance/x/metadata/types"
+)
+
+const flagDisableP8eMessages = "disable-p8e-messages"
+
+// MigrateP8eDataCmd returns the migrate-p8e-data cobra command.
+func MigrateP8eDataCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate-p8e-data [genesis-file]",
+		Short: "Audit and normalize the metadata created through the p8e messages in a genesis file",
+		Long: `Audit and normalize the metadata created through the p8e messages in a genesis file.
+
+This works offline against a genesis file, e.g. one created with the export command.
+Records in sessions that use a contract specification created with MsgWriteP8eContractSpecRequest are
+converted to the format used by the native metadata messages. The same conversion is done by the
+metadata module store migration.
+
+A report is output with the number of p8e scopes, records and contract specifications found,
+the number of records normalized, and the gaps that need manual attention.
+
+The normalized genesis is only written if --output-document is provided.
+Use --disable-p8e-messages to also set the metadata DisableP8eMessages param in that genesis.
+`,
+		Example: fmt.Sprintf(`$ %[1]s migrate-p8e-data exported-genesis.json
+$ %[1]s migrate-p8e-data exported-genesis.json --output-document migrated-genesis.json --disable-p8e-messages`, version.AppName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			cdc := clientCtx.Codec
+
+			outFile, err := cmd.Flags().GetString(flags.FlagOutputDocument)
+			if err != nil {
+				return err
+			}
+			disable, err := cmd.Flags().GetBool(flagDisableP8eMessages)
+			if err != nil {
+				return err
+			}
+			if disable && len(outFile) == 0 {
+				return fmt.Errorf("--%s requires --%s", flagDisableP8eMessages, flags.FlagOutputDocument)
+			}
+
+			appState, genDoc, err := genutiltypes.GenesisStateFromGenFile(args[0])
+			if err != nil {
+				return fmt.Errorf("failed to unmarshal genesis state: %w", err)
+			}
+
+			var mdGenState metadatatypes.GenesisState
+			if appState[metadatatypes.ModuleName] != nil {
+				if err = cdc.UnmarshalJSON(appState[metadatatypes.ModuleName], &mdGenState); err != nil {
+					return fmt.Errorf("failed to unmarshal metadata genesis state: %w", err)
+				}
+			}
+
+			report, err := metadatatypes.NormalizeP8eData(metadatatypes.NewGenesisP8eDataStore(&mdGenState))
+			if err != nil {
+				return err
+			}
+
+			if len(outFile) > 0 {
+				if disable {
+					mdGenState.Params.DisableP8EMessages = true
+				}
+				appState[metadatatypes.ModuleName], err = cdc.MarshalJSON(&mdGenState)
+				if err != nil {
+					return fmt.Errorf("failed to marshal metadata genesis state: %w", err)
+				}
+				genDoc.AppState, err = json.Marshal(appState)
+				if err != nil {
+					return fmt.Errorf("failed to marshal application genesis state: %w", err)
+				}
+				if err = genutil.ExportGenesisFile(genDoc, outFile); err != nil {
+					return err
+				}
+			}
+
+			reportBz, err := json.MarshalIndent(report, "", "  ")
+			if err != nil {
+				return err
+			}
+			fmt.Fprintln(cmd.OutOrStdout(), string(reportBz))
+			return nil
+		},
+	}
+	cmd.Flags().String(flags.FlagOutputDocument, "", "The file to write the normalized genesis to")
+	cmd.Flags().Bool(flagDisableP8eMessages, false, "Also disable the p8e messages in the normalized genesis")
+
+	return cmd
+}
@@ -0,0 +1,105 @@
+package cmd_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+
+	"github.com/provenance-io/provenance/app"
+	provenancecmd "github.com/provenance-io/provenance/cmd/provenanced/cmd"
+	metadatatypes "github.com/provenance-io/provenance/x/metadata/types"
+)
+
+func TestMigrateP8eDataCmd(t *testing.T) {
+	cdc := app.MakeEncodingConfig().Marshaler
+
+	hash := sha512.Sum512([]byte("a p8e contract spec"))
+	cSpecUUID, err := uuid.FromBytes(hash[:16])
+	require.NoError(t, err, "uuid.FromBytes")
+	scopeUUID := uuid.New()
+	session := metadatatypes.Session{
+		SessionId:       metadatatypes.SessionMetadataAddress(scopeUUID, uuid.New()),
+		SpecificationId: metadatatypes.ContractSpecMetadataAddress(cSpecUUID),
+	}
+	mdGenState := metadatatypes.DefaultGenesisState()
+	mdGenState.ContractSpecifications = []metadatatypes.ContractSpecification{{
+		SpecificationId: session.SpecificationId,
+		Source:          metadatatypes.NewContractSpecificationSourceHash(base64.StdEncoding.EncodeToString(hash[:])),
+	}}
+	mdGenState.Sessions = []metadatatypes.Session{session}
+	mdGenState.Records = []metadatatypes.Record{{
+		Name:      "record",
+		SessionId: session.SessionId,
+		Process:   metadatatypes.Process{ProcessId: &metadatatypes.Process_Hash{Hash: "processhash"}, Name: "record", Method: "record"},
+		Inputs: []metadatatypes.RecordInput{
+			{Name: "input", TypeName: "Input", Source: &metadatatypes.RecordInput_Hash{Hash: "inputhash"}, Status: metadatatypes.RecordInputStatus_Record},
+		},
+		Outputs: []metadatatypes.RecordOutput{{Hash: "outputhash", Status: metadatatypes.ResultStatus_RESULT_STATUS_PASS}},
+	}}
+	mdGenStateBz, err := cdc.MarshalJSON(mdGenState)
+	require.NoError(t, err, "marshalling metadata genesis state")
+	appStateBz, err := json.Marshal(map[string]json.RawMessage{metadatatypes.ModuleName: mdGenStateBz})
+	require.NoError(t, err, "marshalling app state")
+
+	home := t.TempDir()
+	genFile := filepath.Join(home, "genesis.json")
+	outFile := filepath.Join(home, "migrated.json")
+	genDoc := &tmtypes.GenesisDoc{ChainID: "p8e-test", AppState: appStateBz}
+	require.NoError(t, genDoc.SaveAs(genFile), "saving genesis file")
+
+	runCmd := func(args ...string) (string, error) {
+		clientCtx := client.Context{}.WithCodec(cdc)
+		ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+		cmd := provenancecmd.MigrateP8eDataCmd()
+		cmd.SetArgs(args)
+		var out bytes.Buffer
+		cmd.SetOut(&out)
+		err := cmd.ExecuteContext(ctx)
+		return out.String(), err
+	}
+
+	t.Run("disable without output document", func(t *testing.T) {
+		_, err := runCmd(genFile, "--disable-p8e-messages")
+		assert.EqualError(t, err, "--disable-p8e-messages requires --output-document")
+	})
+
+	t.Run("report only", func(t *testing.T) {
+		out, err := runCmd(genFile)
+		require.NoError(t, err, "migrate-p8e-data")
+		var report metadatatypes.P8eMigrationReport
+		require.NoError(t, json.Unmarshal([]byte(out), &report), "unmarshalling report")
+		assert.Equal(t, 1, report.ContractSpecifications, "contract specifications")
+		assert.Equal(t, 1, report.Scopes, "scopes")
+		assert.Equal(t, 1, report.Records, "records")
+		assert.Equal(t, 1, report.NormalizedRecords, "normalized records")
+		assert.NoFileExists(t, outFile, "output document")
+	})
+
+	t.Run("normalize and disable", func(t *testing.T) {
+		_, err := runCmd(genFile, fmt.Sprintf("--%s=%s", flags.FlagOutputDocument, outFile), "--disable-p8e-messages")
+		require.NoError(t, err, "migrate-p8e-data")
+
+		appState, _, err := genutiltypes.GenesisStateFromGenFile(outFile)
+		require.NoError(t, err, "reading output document")
+		var migrated metadatatypes.GenesisState
+		require.NoError(t, cdc.UnmarshalJSON(appState[metadatatypes.ModuleName], &migrated), "unmarshalling metadata genesis state")
+		assert.True(t, migrated.Params.DisableP8EMessages, "DisableP8eMessages param")
+		require.Len(t, migrated.Records, 1, "records")
+		assert.Equal(t, metadatatypes.RecordInputStatus_Proposed, migrated.Records[0].Inputs[0].Status, "record input status")
+	})
+}
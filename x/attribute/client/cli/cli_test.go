@@ -357,6 +357,50 @@ pagination:
 	}
 }
 
+func (s *IntegrationTestSuite) TestCheckAccountAttributesCmd() {
+	testCases := []struct {
+		name           string
+		args           []string
+		expectedOutput string
+	}{
+		{
+			"should pass with attribute present",
+			[]string{s.account1Addr.String(), "--all-of", "example.attribute", fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+			fmt.Sprintf(`{"account":"%s","pass":true,"missing":[],"forbidden":[]}`, s.account1Addr.String()),
+		},
+		{
+			"should pass with attribute value matching",
+			[]string{s.account1Addr.String(), "--any-of", "example.none,example.attribute=example attribute value string", fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+			fmt.Sprintf(`{"account":"%s","pass":true,"missing":[],"forbidden":[]}`, s.account1Addr.String()),
+		},
+		{
+			"should fail with missing and forbidden attributes",
+			[]string{s.account1Addr.String(), "--all-of", "example.none", "--none-of", "example.attribute", fmt.Sprintf("--%s=text", tmcli.OutputFlag)},
+			fmt.Sprintf(`account: %s
+forbidden:
+- name: example.attribute
+  value: null
+missing:
+- name: example.none
+  value: null
+pass: false`, s.account1Addr.String()),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.CheckAccountAttributesCmd()
+			clientCtx := s.testnet.Validators[0].ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expectedOutput, strings.TrimSpace(out.String()))
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestScanAccountAttributesCmd() {
 	testCases := []struct {
 		name           string
@@ -31,6 +31,7 @@ func GetQueryCmd() *cobra.Command {
 		GetAccountAttributeCmd(),
 		ListAccountAttributesCmd(),
 		ScanAccountAttributesCmd(),
+		CheckAccountAttributesCmd(),
 	)
 
 	return queryCmd
@@ -199,6 +200,85 @@ func ScanAccountAttributesCmd() *cobra.Command {
 	return cmd
 }
 
+const (
+	FlagAllOf  = "all-of"
+	FlagAnyOf  = "any-of"
+	FlagNoneOf = "none-of"
+)
+
+// CheckAccountAttributesCmd checks account attributes against an all-of/any-of/none-of predicate.
+func CheckAccountAttributesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "check [address] {--all-of <name>[=<value>]} {--any-of <name>[=<value>]} {--none-of <name>[=<value>]}",
+		Short: "Check whether an account has a set of attributes",
+		Long: `Check whether an account has a set of attributes.
+The check passes if the account has every --all-of attribute, at least one --any-of attribute (if any are provided),
+and none of the --none-of attributes. Each flag can be provided multiple times.
+If a value is provided with a name, the attribute value must also match.`,
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`
+				$ %[1]s query attribute check pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk --all-of kyc.pb --any-of region.pb=us --any-of region.pb=ca
+				$ %[1]s query attribute check pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk --all-of kyc.pb,accredited.pb --none-of sanctioned.pb
+				`,
+				version.AppName,
+			)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := types.QueryAttributeCheckRequest{Account: strings.ToLower(strings.TrimSpace(args[0]))}
+			if req.AllOf, err = readAttributeMatchesFlag(cmd.Flags(), FlagAllOf); err != nil {
+				return err
+			}
+			if req.AnyOf, err = readAttributeMatchesFlag(cmd.Flags(), FlagAnyOf); err != nil {
+				return err
+			}
+			if req.NoneOf, err = readAttributeMatchesFlag(cmd.Flags(), FlagNoneOf); err != nil {
+				return err
+			}
+
+			var response *types.QueryAttributeCheckResponse
+			if response, err = queryClient.AttributeCheck(context.Background(), &req); err != nil {
+				fmt.Printf("failed to check account \"%s\" attributes: %v\n", req.Account, err)
+				return nil
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	cmd.Flags().StringSlice(FlagAllOf, nil, "attributes the account must have, as <name> or <name>=<value>")
+	cmd.Flags().StringSlice(FlagAnyOf, nil, "attributes the account must have at least one of, as <name> or <name>=<value>")
+	cmd.Flags().StringSlice(FlagNoneOf, nil, "attributes the account must not have, as <name> or <name>=<value>")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// readAttributeMatchesFlag reads a flag containing entries of the form <name> or <name>=<value>.
+func readAttributeMatchesFlag(flagSet *flag.FlagSet, flagName string) ([]types.AttributeMatch, error) {
+	entries, err := flagSet.GetStringSlice(flagName)
+	if err != nil {
+		return nil, err
+	}
+	var matches []types.AttributeMatch
+	for _, entry := range entries {
+		name, value, hasValue := strings.Cut(entry, "=")
+		if len(strings.TrimSpace(name)) == 0 {
+			return nil, fmt.Errorf("invalid --%s entry %q: empty name", flagName, entry)
+		}
+		var valueBz []byte
+		if hasValue {
+			valueBz = []byte(strings.TrimSpace(value))
+		}
+		matches = append(matches, types.NewAttributeMatch(name, valueBz))
+	}
+	return matches, nil
+}
+
 // sdk ReadPageRequest expects binary but we encoded to base64 in our marshaller
 func withPageKeyDecoded(flagSet *flag.FlagSet) *flag.FlagSet {
 	encoded, err := flagSet.GetString(flags.FlagPageKey)
@@ -31,6 +31,7 @@ func NewTxCmd() *cobra.Command {
 		NewUpdateAccountAttributeCmd(),
 		NewDeleteDistinctAccountAttributeCmd(),
 		NewDeleteAccountAttributeCmd(),
+		NewAddAccountAttributesBatchCmd(),
 	)
 	return txCmd
 }
@@ -142,6 +143,53 @@ func NewUpdateAccountAttributeCmd() *cobra.Command {
 	return cmd
 }
 
+// NewAddAccountAttributesBatchCmd creates a command for adding an attribute to many accounts at once.
+func NewAddAccountAttributesBatchCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "add-batch [name] [type] [value] [address] [address2 ...]",
+		Aliases: []string{"ab"},
+		Short:   "Add an attribute to many accounts on the provenance blockchain",
+		Long: fmt.Sprintf(`Adds the same attribute name, type and value to each of the provided addresses.
+If any of the attributes cannot be added, none of them are added.
+Note: the attribute name must have already been created through the name module.
+Refer to %s tx name bind --help for more information on how to do this.`, version.AppName),
+		Args:    cobra.MinimumNArgs(4),
+		Example: fmt.Sprintf(`$ %s tx attribute add-batch "attr1.pb" "string" "test value" tp1jypkeck8vywptdltjnwspwzulkqu7jv6ey90dx tp1y0txdp3sqmxjvfdaa8hfvwcljl8ugcfv26uync`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			name := args[0]
+			attributeType, err := types.AttributeTypeFromString(strings.TrimSpace(args[1]))
+			if err != nil {
+				return fmt.Errorf("account attribute type is invalid: %w", err)
+			}
+			valueString := strings.TrimSpace(args[2])
+			value, err := encodeAttributeValue(valueString, attributeType)
+			if err != nil {
+				return fmt.Errorf("error encoding value %s to type %s : %w", valueString, attributeType.String(), err)
+			}
+
+			attrs := make([]types.Attribute, 0, len(args)-3)
+			for _, account := range args[3:] {
+				if err = types.ValidateAttributeAddress(account); err != nil {
+					return fmt.Errorf("invalid address %q: %w", account, err)
+				}
+				attrs = append(attrs, types.NewAttribute(name, account, attributeType, value))
+			}
+
+			msg := types.NewMsgAddAttributesBatchRequest(clientCtx.GetFromAddress(), attrs)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func encodeAttributeValue(value string, attrType types.AttributeType) ([]byte, error) {
 	var encodedValue []byte
 	if attrType == types.AttributeType_Bytes || attrType == types.AttributeType_Proto {
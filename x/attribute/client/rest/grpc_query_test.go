@@ -1,7 +1,10 @@
 package rest_test
 
 import (
+	"bytes"
 	"fmt"
+	"io"
+	"net/http"
 	"testing"
 
 	"github.com/gogo/protobuf/proto"
@@ -215,6 +218,52 @@ func (s *IntegrationTestSuite) TestGRPCQueries() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestAttributeCheckQuery() {
+	val := s.testnet.Validators[0]
+	url := fmt.Sprintf("%s/provenance/attribute/v1/attributes/%s/check", val.APIAddress, s.accountAddr)
+	exampleMatch := attributetypes.AttributeMatch{Name: "example.attribute", Value: []byte("example attribute value string")}
+	missingMatch := attributetypes.AttributeMatch{Name: "missing.attribute"}
+
+	testCases := []struct {
+		name     string
+		req      *attributetypes.QueryAttributeCheckRequest
+		expected *attributetypes.QueryAttributeCheckResponse
+	}{
+		{
+			"all of present attribute",
+			&attributetypes.QueryAttributeCheckRequest{AllOf: []attributetypes.AttributeMatch{exampleMatch}},
+			&attributetypes.QueryAttributeCheckResponse{Account: s.accountAddr.String(), Pass: true},
+		},
+		{
+			"all of missing attribute",
+			&attributetypes.QueryAttributeCheckRequest{AllOf: []attributetypes.AttributeMatch{exampleMatch, missingMatch}},
+			&attributetypes.QueryAttributeCheckResponse{Account: s.accountAddr.String(), Missing: []attributetypes.AttributeMatch{missingMatch}},
+		},
+		{
+			"none of present attribute",
+			&attributetypes.QueryAttributeCheckRequest{NoneOf: []attributetypes.AttributeMatch{exampleMatch}},
+			&attributetypes.QueryAttributeCheckResponse{Account: s.accountAddr.String(), Forbidden: []attributetypes.AttributeMatch{exampleMatch}},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			body, err := val.ClientCtx.Codec.MarshalJSON(tc.req)
+			s.Require().NoError(err, "MarshalJSON request")
+			httpResp, err := http.Post(url, "application/json", bytes.NewReader(body))
+			s.Require().NoError(err, "POST %s", url)
+			defer httpResp.Body.Close()
+			resp, err := io.ReadAll(httpResp.Body)
+			s.Require().NoError(err, "reading response body")
+			var actual attributetypes.QueryAttributeCheckResponse
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(resp, &actual), "UnmarshalJSON response: %s", resp)
+			s.Require().Equal(tc.expected.String(), actual.String())
+		})
+	}
+}
+
 func TestIntegrationTestSuite(t *testing.T) {
 	suite.Run(t, new(IntegrationTestSuite))
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/provenance-io/provenance/x/attribute/types"
+	nametypes "github.com/provenance-io/provenance/x/name/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
@@ -85,6 +86,47 @@ func (k Keeper) GetAttributes(ctx sdk.Context, addr string, name string) ([]type
 	return k.prefixScan(ctx, types.AddrStrAttributesNameKeyPrefix(addr, name), pred)
 }
 
+// CheckAttributes evaluates an all-of/any-of/none-of predicate against all attributes of an account.
+// The match names are normalized the same way attribute names are when they are stored.
+// See types.CheckAttributes for details on the results.
+func (k Keeper) CheckAttributes(
+	ctx sdk.Context, addr string, allOf, anyOf, noneOf []types.AttributeMatch,
+) (pass bool, missing, forbidden []types.AttributeMatch, err error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "keeper_method", "check")
+
+	if allOf, err = k.normalizeAttributeMatches(ctx, allOf); err != nil {
+		return false, nil, nil, err
+	}
+	if anyOf, err = k.normalizeAttributeMatches(ctx, anyOf); err != nil {
+		return false, nil, nil, err
+	}
+	if noneOf, err = k.normalizeAttributeMatches(ctx, noneOf); err != nil {
+		return false, nil, nil, err
+	}
+	attrs, err := k.GetAllAttributes(ctx, addr)
+	if err != nil {
+		return false, nil, nil, err
+	}
+	pass, missing, forbidden = types.CheckAttributes(attrs, allOf, anyOf, noneOf)
+	return pass, missing, forbidden, nil
+}
+
+// normalizeAttributeMatches returns a copy of the provided matches with their names normalized by the name module.
+func (k Keeper) normalizeAttributeMatches(ctx sdk.Context, matches []types.AttributeMatch) ([]types.AttributeMatch, error) {
+	if len(matches) == 0 {
+		return matches, nil
+	}
+	rv := make([]types.AttributeMatch, len(matches))
+	for i, m := range matches {
+		name, err := k.nameKeeper.Normalize(ctx, m.Name)
+		if err != nil {
+			return nil, fmt.Errorf("unable to normalize attribute name \"%s\": %w", m.Name, err)
+		}
+		rv[i] = types.AttributeMatch{Name: name, Value: m.Value}
+	}
+	return rv, nil
+}
+
 // IterateRecords iterates over all the stored attribute records and passes them to a callback function.
 func (k Keeper) IterateRecords(ctx sdk.Context, prefix []byte, handle Handler) error {
 	// Init a attribute record iterator
@@ -160,6 +202,65 @@ func (k Keeper) SetAttribute(
 	return nil
 }
 
+// SetAttributesBatch stores many attributes. Every attribute name must resolve to the given owner address.
+// All attributes are validated before any are stored, so either all of them are stored or none are.
+// Name normalization and ownership are only checked once for each distinct name.
+func (k Keeper) SetAttributesBatch(ctx sdk.Context, attrs []types.Attribute, owner sdk.AccAddress) error {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "keeper_method", "set_batch")
+
+	if len(attrs) == 0 {
+		return fmt.Errorf("no attributes provided")
+	}
+
+	// Verify an account exists for the given owner address
+	if ownerAcc := k.authKeeper.GetAccount(ctx, owner); ownerAcc == nil {
+		return fmt.Errorf("no account found for owner address \"%s\"", owner.String())
+	}
+
+	maxLength := k.GetMaxValueLength(ctx)
+	// A map of provided attribute name to normalized name for names that have already been checked.
+	checkedNames := make(map[string]string)
+	toStore := make([]types.Attribute, len(attrs))
+	for i, attr := range attrs {
+		if err := attr.ValidateBasic(); err != nil {
+			return fmt.Errorf("attribute [%d]: %w", i, err)
+		}
+		if int(maxLength) < len(attr.Value) {
+			return fmt.Errorf("attribute [%d]: attribute value length of %v exceeds max length %v", i, len(attr.Value), maxLength)
+		}
+		normalizedName, checked := checkedNames[attr.Name]
+		if !checked {
+			var err error
+			normalizedName, err = k.nameKeeper.Normalize(ctx, attr.Name)
+			if err != nil {
+				return fmt.Errorf("attribute [%d]: unable to normalize attribute name \"%s\": %w", i, attr.Name, err)
+			}
+			if !k.nameKeeper.ResolvesTo(ctx, normalizedName, owner) {
+				return fmt.Errorf("attribute [%d]: \"%s\" does not resolve to address \"%s\"", i, normalizedName, owner.String())
+			}
+			checkedNames[attr.Name] = normalizedName
+		}
+		attr.Name = normalizedName
+		toStore[i] = attr
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	for i := range toStore {
+		bz, err := k.cdc.Marshal(&toStore[i])
+		if err != nil {
+			return fmt.Errorf("attribute [%d]: %w", i, err)
+		}
+		store.Set(types.AddrAttributeKey(toStore[i].GetAddressBytes(), toStore[i]), bz)
+
+		attributeAddEvent := types.NewEventAttributeAdd(toStore[i], owner.String())
+		if err := ctx.EventManager().EmitTypedEvent(attributeAddEvent); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // Updates an attribute under the given account. The attribute name must resolve to the given owner address and value must resolve to an existing attribute.
 func (k Keeper) UpdateAttribute(ctx sdk.Context, originalAttribute types.Attribute, updateAttribute types.Attribute, owner sdk.AccAddress,
 ) error {
@@ -297,6 +398,53 @@ func (k Keeper) DeleteAttribute(ctx sdk.Context, addr string, name string, value
 	return nil
 }
 
+// ConvertUnicodeAttributeNames re-keys attributes stored under names with raw unicode segments to the punycode
+// form of the name so that they can still be updated and deleted once the name module converts its records.
+// This must run before the name module migration: an attribute is only converted when its punycode name is not
+// yet bound, which is the same condition the name module uses to convert the name record itself.
+func (k Keeper) ConvertUnicodeAttributeNames(ctx sdk.Context) error {
+	type storedAttr struct {
+		key  []byte
+		attr types.Attribute
+	}
+	var toConvert []storedAttr
+	store := ctx.KVStore(k.storeKey)
+	it := sdk.KVStorePrefixIterator(store, types.AttributeKeyPrefix)
+	for ; it.Valid(); it.Next() {
+		attr := types.Attribute{}
+		if err := k.cdc.Unmarshal(it.Value(), &attr); err != nil {
+			it.Close()
+			return err
+		}
+		if !nametypes.IsASCII(attr.Name) {
+			toConvert = append(toConvert, storedAttr{key: it.Key(), attr: attr})
+		}
+	}
+	if err := it.Close(); err != nil {
+		return err
+	}
+
+	for _, entry := range toConvert {
+		name, err := k.nameKeeper.Normalize(ctx, entry.attr.Name)
+		if err == nil && k.nameKeeper.NameExists(ctx, name) {
+			err = nametypes.ErrNameAlreadyBound.Wrap(name)
+		}
+		if err != nil {
+			k.Logger(ctx).Error("unable to convert unicode attribute name", "name", entry.attr.Name, "address", entry.attr.Address, "error", err)
+			continue
+		}
+		attr := entry.attr
+		attr.Name = name
+		bz, err := k.cdc.Marshal(&attr)
+		if err != nil {
+			return err
+		}
+		store.Delete(entry.key)
+		store.Set(types.AddrAttributeKey(attr.GetAddressBytes(), attr), bz)
+	}
+	return nil
+}
+
 // A predicate function for matching names
 type namePred = func(string) bool
 
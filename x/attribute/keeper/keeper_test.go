@@ -160,6 +160,104 @@ func (s *KeeperTestSuite) TestSetAttribute() {
 
 }
 
+func (s *KeeperTestSuite) TestSetAttributesBatch() {
+	attr := func(name, addr, value string) types.Attribute {
+		return types.Attribute{
+			Name:          name,
+			Value:         []byte(value),
+			Address:       addr,
+			AttributeType: types.AttributeType_String,
+		}
+	}
+
+	cases := []struct {
+		name      string
+		attrs     []types.Attribute
+		ownerAddr sdk.AccAddress
+		errorMsg  string
+	}{
+		{
+			name:      "should fail with no attributes",
+			attrs:     []types.Attribute{},
+			ownerAddr: s.user1Addr,
+			errorMsg:  "no attributes provided",
+		},
+		{
+			name:      "should fail unable to find owner",
+			attrs:     []types.Attribute{attr("example.attribute", s.user1, "one")},
+			ownerAddr: s.user2Addr,
+			errorMsg:  fmt.Sprintf("no account found for owner address \"%s\"", s.user2),
+		},
+		{
+			name: "should fail with index of attribute with too long value",
+			attrs: []types.Attribute{
+				attr("example.attribute", s.user1, "one"),
+				attr("example.attribute", s.user2, "01234567891"),
+			},
+			ownerAddr: s.user1Addr,
+			errorMsg:  "attribute [1]: attribute value length of 11 exceeds max length 10",
+		},
+		{
+			name: "should fail with index of attribute with invalid name",
+			attrs: []types.Attribute{
+				attr("example.attribute", s.user1, "one"),
+				attr("attribute", s.user1, "two"),
+				attr("example.cant.normalize.me", s.user2, "three"),
+			},
+			ownerAddr: s.user1Addr,
+			errorMsg:  "attribute [2]: unable to normalize attribute name \"example.cant.normalize.me\": segment of name is too short",
+		},
+		{
+			name: "should fail with index of attribute name not resolving to owner",
+			attrs: []types.Attribute{
+				attr("example.attribute", s.user1, "one"),
+				attr("example.not.found", s.user2, "two"),
+			},
+			ownerAddr: s.user1Addr,
+			errorMsg:  fmt.Sprintf("attribute [1]: \"example.not.found\" does not resolve to address \"%s\"", s.user1),
+		},
+		{
+			name: "should successfully add one attribute to many accounts",
+			attrs: []types.Attribute{
+				attr("example.attribute", s.user1, "one"),
+				attr("Example.Attribute", s.user2, "one"),
+			},
+			ownerAddr: s.user1Addr,
+		},
+		{
+			name: "should successfully add many attributes to one account",
+			attrs: []types.Attribute{
+				attr("example.attribute", s.user2, "two"),
+				attr("attribute", s.user2, "three"),
+			},
+			ownerAddr: s.user1Addr,
+		},
+	}
+	for _, tc := range cases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			ctx, _ := s.ctx.CacheContext()
+			err := s.app.AttributeKeeper.SetAttributesBatch(ctx, tc.attrs, tc.ownerAddr)
+			if len(tc.errorMsg) > 0 {
+				s.Require().EqualError(err, tc.errorMsg)
+				for _, attr := range tc.attrs {
+					attrs, getErr := s.app.AttributeKeeper.GetAllAttributes(ctx, attr.Address)
+					s.Require().NoError(getErr)
+					s.Assert().Empty(attrs, "attributes for %s after failed batch", attr.Address)
+				}
+			} else {
+				s.Require().NoError(err)
+				for _, attr := range tc.attrs {
+					attrs, getErr := s.app.AttributeKeeper.GetAttributes(ctx, attr.Address, attr.Name)
+					s.Require().NoError(getErr)
+					s.Assert().Len(attrs, 1, "attributes named %s for %s", attr.Name, attr.Address)
+				}
+			}
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestUpdateAttribute() {
 
 	attr := types.Attribute{
@@ -589,3 +687,130 @@ func (s *KeeperTestSuite) TestIterateRecord() {
 	})
 
 }
+
+func (s *KeeperTestSuite) TestConvertUnicodeAttributeNames() {
+	nameStore := s.ctx.KVStore(s.app.GetKey(nametypes.ModuleName))
+	attrStore := s.ctx.KVStore(s.app.GetKey(types.StoreKey))
+	// Names and attributes created before unicode segments were encoded were stored as they were provided.
+	for _, name := range []string{"пример.attribute", "имя.attribute"} {
+		key, err := nametypes.GetNameKeyPrefix(name)
+		s.Require().NoError(err, "GetNameKeyPrefix(%q)", name)
+		record := nametypes.NewNameRecord(name, s.user1Addr, false)
+		nameStore.Set(key, s.app.AppCodec().MustMarshal(&record))
+
+		attr := types.NewAttribute(name, s.user1, types.AttributeType_String, []byte("test"))
+		attrStore.Set(types.AddrAttributeKey(s.user1Addr, attr), s.app.AppCodec().MustMarshal(&attr))
+	}
+	converted, err := s.app.NameKeeper.Normalize(s.ctx, "пример.attribute")
+	s.Require().NoError(err, "Normalize")
+	// The punycode form of the second name is already bound by someone else.
+	collision, err := s.app.NameKeeper.Normalize(s.ctx, "имя.attribute")
+	s.Require().NoError(err, "Normalize")
+	s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, collision, s.user2Addr, false), "SetNameRecord(%q)", collision)
+
+	// The attribute module is migrated before the name module.
+	s.Require().NoError(s.app.AttributeKeeper.ConvertUnicodeAttributeNames(s.ctx), "ConvertUnicodeAttributeNames")
+	s.Require().NoError(s.app.NameKeeper.ConvertUnicodeNames(s.ctx), "ConvertUnicodeNames")
+
+	attrs, err := s.app.AttributeKeeper.GetAttributes(s.ctx, s.user1, converted)
+	s.Require().NoError(err, "GetAttributes(%q)", converted)
+	s.Require().Len(attrs, 1, "attributes under the punycode name")
+	s.Assert().Equal(converted, attrs[0].Name, "attribute name")
+	s.Assert().False(attrStore.Has(types.AddrAttributeKey(s.user1Addr, types.NewAttribute("пример.attribute", s.user1, types.AttributeType_String, []byte("test")))), "unicode attribute key exists")
+
+	attrs, err = s.app.AttributeKeeper.GetAttributes(s.ctx, s.user1, collision)
+	s.Require().NoError(err, "GetAttributes(%q)", collision)
+	s.Assert().Empty(attrs, "attributes under the colliding punycode name")
+
+	s.Assert().NoError(s.app.AttributeKeeper.DeleteAttribute(s.ctx, s.user1, converted, nil, s.user1Addr), "DeleteAttribute(%q)", converted)
+}
+
+func (s *KeeperTestSuite) TestAttributeCheck() {
+	unicodeName, err := s.app.NameKeeper.Normalize(s.ctx, "пример.attribute")
+	s.Require().NoError(err, "Normalize")
+	s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, unicodeName, s.user1Addr, false), "SetNameRecord(%q)", unicodeName)
+	for _, attr := range []types.Attribute{
+		types.NewAttribute("example.attribute", s.user1, types.AttributeType_String, []byte("yes")),
+		types.NewAttribute("пример.attribute", s.user1, types.AttributeType_String, []byte("value")),
+	} {
+		s.Require().NoError(s.app.AttributeKeeper.SetAttribute(s.ctx, attr, s.user1Addr), "SetAttribute(%q)", attr.Name)
+	}
+
+	s.Run("keeper normalizes match names", func() {
+		pass, missing, forbidden, err := s.app.AttributeKeeper.CheckAttributes(s.ctx, s.user1,
+			[]types.AttributeMatch{{Name: " Example.Attribute ", Value: []byte("yes")}, {Name: "ПРИМЕР.attribute"}},
+			nil,
+			[]types.AttributeMatch{{Name: unicodeName, Value: []byte("other")}},
+		)
+		s.Require().NoError(err, "CheckAttributes")
+		s.Assert().True(pass, "pass")
+		s.Assert().Empty(missing, "missing")
+		s.Assert().Empty(forbidden, "forbidden")
+	})
+
+	s.Run("keeper reports normalized names", func() {
+		pass, missing, forbidden, err := s.app.AttributeKeeper.CheckAttributes(s.ctx, s.user1,
+			[]types.AttributeMatch{{Name: "Example.Attribute", Value: []byte("no")}},
+			nil,
+			[]types.AttributeMatch{{Name: "пример.attribute"}},
+		)
+		s.Require().NoError(err, "CheckAttributes")
+		s.Assert().False(pass, "pass")
+		s.Assert().Equal([]types.AttributeMatch{{Name: "example.attribute", Value: []byte("no")}}, missing, "missing")
+		s.Assert().Equal([]types.AttributeMatch{{Name: unicodeName}}, forbidden, "forbidden")
+	})
+
+	s.Run("keeper rejects names that cannot be normalized", func() {
+		_, _, _, err := s.app.AttributeKeeper.CheckAttributes(s.ctx, s.user1, []types.AttributeMatch{{Name: "a.attribute"}}, nil, nil)
+		s.Assert().EqualError(err, `unable to normalize attribute name "a.attribute": segment of name is too short`)
+	})
+
+	cases := []struct {
+		name    string
+		req     *types.QueryAttributeCheckRequest
+		expPass bool
+		expErr  string
+	}{
+		{
+			name:   "nil request",
+			expErr: "rpc error: code = InvalidArgument desc = invalid request",
+		},
+		{
+			name:   "invalid account",
+			req:    &types.QueryAttributeCheckRequest{Account: "invalid", AllOf: []types.AttributeMatch{{Name: "example.attribute"}}},
+			expErr: "rpc error: code = InvalidArgument desc = invalid account address: must be either an account address or scope metadata address: \"invalid\"",
+		},
+		{
+			name:   "empty predicate",
+			req:    &types.QueryAttributeCheckRequest{Account: s.user1},
+			expErr: "rpc error: code = InvalidArgument desc = at least one of all_of, any_of, or none_of must be provided",
+		},
+		{
+			name:   "empty match name",
+			req:    &types.QueryAttributeCheckRequest{Account: s.user1, AnyOf: []types.AttributeMatch{{Name: "example.attribute"}, {Name: " "}}},
+			expErr: "rpc error: code = InvalidArgument desc = invalid any_of[1]: invalid name: empty",
+		},
+		{
+			name:    "passes",
+			req:     &types.QueryAttributeCheckRequest{Account: s.user1, AllOf: []types.AttributeMatch{{Name: "EXAMPLE.attribute"}}},
+			expPass: true,
+		},
+		{
+			name:    "fails",
+			req:     &types.QueryAttributeCheckRequest{Account: s.user2, AllOf: []types.AttributeMatch{{Name: "example.attribute"}}},
+			expPass: false,
+		},
+	}
+	for _, tc := range cases {
+		s.Run("query "+tc.name, func() {
+			resp, err := s.app.AttributeKeeper.AttributeCheck(sdk.WrapSDKContext(s.ctx), tc.req)
+			if len(tc.expErr) > 0 {
+				s.Assert().EqualError(err, tc.expErr, "AttributeCheck")
+				return
+			}
+			s.Require().NoError(err, "AttributeCheck")
+			s.Assert().Equal(tc.req.Account, resp.Account, "account")
+			s.Assert().Equal(tc.expPass, resp.Pass, "pass")
+		})
+	}
+}
@@ -23,3 +23,12 @@ func (m *Migrator) Migrate1to2(ctx sdk.Context) error {
 	ctx.Logger().Info("Finished Migrating Attribute Module from Version 1 to 2")
 	return err
 }
+
+// Migrate2to3 migrates from version 2 to 3 to re-key attributes with unicode names under their punycode form.
+// It has to run before the name module is migrated from version 4 to 5.
+func (m *Migrator) Migrate2to3(ctx sdk.Context) error {
+	ctx.Logger().Info("Migrating Attribute Module from Version 2 to 3 (1/1)")
+	err := m.keeper.ConvertUnicodeAttributeNames(ctx)
+	ctx.Logger().Info("Finished Migrating Attribute Module from Version 2 to 3")
+	return err
+}
@@ -203,3 +203,40 @@ func (k msgServer) DeleteDistinctAttribute(goCtx context.Context, msg *types.Msg
 
 	return &types.MsgDeleteDistinctAttributeResponse{}, nil
 }
+
+func (k msgServer) AddAttributesBatch(goCtx context.Context, msg *types.MsgAddAttributesBatchRequest) (*types.MsgAddAttributesBatchResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	ownerAddr, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.Keeper.SetAttributesBatch(ctx, msg.Attributes, ownerAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		telemetry.IncrCounterWithLabels(
+			[]string{types.ModuleName, types.EventTelemetryKeyAddBatch},
+			1,
+			[]metrics.Label{
+				telemetry.NewLabel(types.EventTelemetryLabelOwner, msg.Owner),
+				telemetry.NewLabel(types.EventTelemetryLabelSize, fmt.Sprintf("%d", len(msg.Attributes))),
+			},
+		)
+	}()
+
+	for _, attr := range msg.Attributes {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeAttributeAdded,
+				sdk.NewAttribute(types.AttributeKeyNameAttribute, attr.Name),
+				sdk.NewAttribute(types.AttributeKeyAccountAddress, attr.Address),
+			),
+		)
+	}
+
+	return &types.MsgAddAttributesBatchResponse{}, nil
+}
@@ -123,3 +123,22 @@ func (k Keeper) Scan(c context.Context, req *types.QueryScanRequest) (*types.Que
 
 	return &types.QueryScanResponse{Account: req.Account, Attributes: attributes, Pagination: pageRes}, nil
 }
+
+// AttributeCheck evaluates an all-of/any-of/none-of predicate against the attributes of a specified account
+func (k Keeper) AttributeCheck(c context.Context, req *types.QueryAttributeCheckRequest) (*types.QueryAttributeCheckResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if err := types.ValidateAttributeAddress(req.Account); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("invalid account address: %v", err))
+	}
+	if err := types.ValidateAttributeCheck(req.AllOf, req.AnyOf, req.NoneOf); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	pass, missing, forbidden, err := k.CheckAttributes(ctx, req.Account, req.AllOf, req.AnyOf, req.NoneOf)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &types.QueryAttributeCheckResponse{Account: req.Account, Pass: pass, Missing: missing, Forbidden: forbidden}, nil
+}
@@ -0,0 +1,99 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// NewAttributeMatch creates a new AttributeMatch. A nil or empty value matches any attribute value.
+func NewAttributeMatch(name string, value []byte) AttributeMatch {
+	return AttributeMatch{
+		Name:  strings.ToLower(strings.TrimSpace(name)),
+		Value: value,
+	}
+}
+
+// ValidateBasic ensures an attribute match is valid.
+func (m AttributeMatch) ValidateBasic() error {
+	if strings.TrimSpace(m.Name) == "" {
+		return fmt.Errorf("invalid name: empty")
+	}
+	return nil
+}
+
+// Matches returns true if the provided attribute has this match's name and,
+// if this match has a value, that value too.
+// The match name must already be normalized the same way stored attribute names are.
+func (m AttributeMatch) Matches(attr Attribute) bool {
+	if m.Name != attr.Name {
+		return false
+	}
+	return len(m.Value) == 0 || bytes.Equal(m.Value, attr.Value)
+}
+
+// matchesAny returns true if any of the provided attributes satisfy this match.
+func (m AttributeMatch) matchesAny(attrs []Attribute) bool {
+	for _, attr := range attrs {
+		if m.Matches(attr) {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateAttributeCheck ensures an all-of/any-of/none-of predicate has at least one entry and that every entry is valid.
+func ValidateAttributeCheck(allOf, anyOf, noneOf []AttributeMatch) error {
+	if len(allOf) == 0 && len(anyOf) == 0 && len(noneOf) == 0 {
+		return fmt.Errorf("at least one of all_of, any_of, or none_of must be provided")
+	}
+	if err := validateAttributeMatches("all_of", allOf); err != nil {
+		return err
+	}
+	if err := validateAttributeMatches("any_of", anyOf); err != nil {
+		return err
+	}
+	return validateAttributeMatches("none_of", noneOf)
+}
+
+// validateAttributeMatches returns an error if any of the provided matches are invalid.
+func validateAttributeMatches(field string, matches []AttributeMatch) error {
+	for i, m := range matches {
+		if err := m.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid %s[%d]: %w", field, i, err)
+		}
+	}
+	return nil
+}
+
+// CheckAttributes evaluates an all-of/any-of/none-of predicate against a set of attributes.
+// The check passes when every allOf entry is satisfied, at least one anyOf entry is satisfied (if any are provided),
+// and no noneOf entry is satisfied.
+// The missing result contains the unsatisfied allOf entries, followed by all the anyOf entries if none were satisfied.
+// The forbidden result contains the noneOf entries that were satisfied.
+func CheckAttributes(attrs []Attribute, allOf, anyOf, noneOf []AttributeMatch) (pass bool, missing, forbidden []AttributeMatch) {
+	for _, m := range allOf {
+		if !m.matchesAny(attrs) {
+			missing = append(missing, m)
+		}
+	}
+
+	anyOfPassed := len(anyOf) == 0
+	for _, m := range anyOf {
+		if m.matchesAny(attrs) {
+			anyOfPassed = true
+			break
+		}
+	}
+	if !anyOfPassed {
+		missing = append(missing, anyOf...)
+	}
+
+	for _, m := range noneOf {
+		if m.matchesAny(attrs) {
+			forbidden = append(forbidden, m)
+		}
+	}
+
+	return len(missing) == 0 && len(forbidden) == 0, missing, forbidden
+}
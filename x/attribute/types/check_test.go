@@ -0,0 +1,127 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckAttributes(t *testing.T) {
+	account := addrs[0].String()
+	attrs := []Attribute{
+		NewAttribute("kyc.pb", account, AttributeType_String, []byte("passed")),
+		NewAttribute("accredited.pb", account, AttributeType_String, []byte("yes")),
+		NewAttribute("region.pb", account, AttributeType_String, []byte("us")),
+	}
+	kyc := NewAttributeMatch("kyc.pb", nil)
+	kycFailed := NewAttributeMatch("kyc.pb", []byte("failed"))
+	accredited := NewAttributeMatch("Accredited.pb", []byte("yes"))
+	regionUS := NewAttributeMatch("region.pb", []byte("us"))
+	regionEU := NewAttributeMatch("region.pb", []byte("eu"))
+	sanctioned := NewAttributeMatch("sanctioned.pb", nil)
+
+	tests := []struct {
+		name         string
+		allOf        []AttributeMatch
+		anyOf        []AttributeMatch
+		noneOf       []AttributeMatch
+		expPass      bool
+		expMissing   []AttributeMatch
+		expForbidden []AttributeMatch
+	}{
+		{
+			name:    "empty predicate passes",
+			expPass: true,
+		},
+		{
+			name:    "all of present with and without value",
+			allOf:   []AttributeMatch{kyc, accredited},
+			expPass: true,
+		},
+		{
+			name:       "all of with value mismatch",
+			allOf:      []AttributeMatch{kyc, kycFailed, accredited},
+			expPass:    false,
+			expMissing: []AttributeMatch{kycFailed},
+		},
+		{
+			name:    "any of with one present",
+			anyOf:   []AttributeMatch{regionEU, regionUS},
+			expPass: true,
+		},
+		{
+			name:       "any of with none present",
+			allOf:      []AttributeMatch{kyc, sanctioned},
+			anyOf:      []AttributeMatch{regionEU, kycFailed},
+			expPass:    false,
+			expMissing: []AttributeMatch{sanctioned, regionEU, kycFailed},
+		},
+		{
+			name:    "none of absent",
+			noneOf:  []AttributeMatch{sanctioned, regionEU},
+			expPass: true,
+		},
+		{
+			name:         "none of present",
+			allOf:        []AttributeMatch{kyc},
+			noneOf:       []AttributeMatch{sanctioned, regionUS},
+			expPass:      false,
+			expForbidden: []AttributeMatch{regionUS},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			pass, missing, forbidden := CheckAttributes(attrs, tc.allOf, tc.anyOf, tc.noneOf)
+			assert.Equal(t, tc.expPass, pass, "pass")
+			assert.Equal(t, tc.expMissing, missing, "missing")
+			assert.Equal(t, tc.expForbidden, forbidden, "forbidden")
+		})
+	}
+}
+
+func TestValidateAttributeCheck(t *testing.T) {
+	match := NewAttributeMatch("kyc.pb", nil)
+	tests := []struct {
+		name   string
+		allOf  []AttributeMatch
+		anyOf  []AttributeMatch
+		noneOf []AttributeMatch
+		expErr string
+	}{
+		{
+			name:   "empty predicate",
+			expErr: "at least one of all_of, any_of, or none_of must be provided",
+		},
+		{
+			name:  "all of only",
+			allOf: []AttributeMatch{match},
+		},
+		{
+			name:   "none of only",
+			noneOf: []AttributeMatch{match},
+		},
+		{
+			name:   "empty name in any of",
+			anyOf:  []AttributeMatch{match, {Name: " "}},
+			expErr: "invalid any_of[1]: invalid name: empty",
+		},
+		{
+			name:   "empty name in none of",
+			allOf:  []AttributeMatch{match},
+			noneOf: []AttributeMatch{{}},
+			expErr: "invalid none_of[0]: invalid name: empty",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateAttributeCheck(tc.allOf, tc.anyOf, tc.noneOf)
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "ValidateAttributeCheck")
+			} else {
+				assert.NoError(t, err, "ValidateAttributeCheck")
+			}
+		})
+	}
+}
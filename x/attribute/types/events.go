@@ -24,6 +24,8 @@ const (
 	EventTelemetryKeyDelete string = "delete"
 	// EventTelemetryKeyDistinctDelete delete telemetry metrics key
 	EventTelemetryKeyDistinctDelete string = "distinct_delete"
+	// EventTelemetryKeyAddBatch add batch telemetry metrics key
+	EventTelemetryKeyAddBatch string = "add_batch"
 	// EventTelemetryLabelName name telemetry metrics label
 	EventTelemetryLabelName string = "name"
 	// EventTelemetryLabelName name telemetry metrics label
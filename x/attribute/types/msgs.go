@@ -13,6 +13,7 @@ const (
 	TypeMsgUpdateAttribute         = "update_attribute"
 	TypeMsgDeleteAttribute         = "delete_attribute"
 	TypeMsgDeleteDistinctAttribute = "delete_distinct_attribute"
+	TypeMsgAddAttributesBatch      = "add_attributes_batch"
 )
 
 // Compile time interface checks.
@@ -21,6 +22,7 @@ var (
 	_ sdk.Msg = &MsgUpdateAttributeRequest{}
 	_ sdk.Msg = &MsgDeleteAttributeRequest{}
 	_ sdk.Msg = &MsgDeleteDistinctAttributeRequest{}
+	_ sdk.Msg = &MsgAddAttributesBatchRequest{}
 )
 
 // NewMsgAddAttributeRequest creates a new add attribute message
@@ -242,3 +244,63 @@ func (msg MsgDeleteDistinctAttributeRequest) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{addr}
 }
+
+// NewMsgAddAttributesBatchRequest creates a new add attributes batch message
+func NewMsgAddAttributesBatchRequest(owner sdk.AccAddress, attributes []Attribute) *MsgAddAttributesBatchRequest { //nolint:interfacer
+	attrs := make([]Attribute, len(attributes))
+	for i, attr := range attributes {
+		attrs[i] = NewAttribute(strings.ToLower(strings.TrimSpace(attr.Name)), attr.Address, attr.AttributeType, attr.Value)
+	}
+	return &MsgAddAttributesBatchRequest{
+		Attributes: attrs,
+		Owner:      owner.String(),
+	}
+}
+
+// Route returns the name of the module.
+func (msg MsgAddAttributesBatchRequest) Route() string {
+	return ModuleName
+}
+
+// Type returns the message action.
+func (msg MsgAddAttributesBatchRequest) Type() string { return TypeMsgAddAttributesBatch }
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgAddAttributesBatchRequest) ValidateBasic() error {
+	if len(msg.Owner) == 0 {
+		return fmt.Errorf("empty owner address")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return err
+	}
+	if len(msg.Attributes) == 0 {
+		return fmt.Errorf("empty attributes list")
+	}
+	for i, attr := range msg.Attributes {
+		if err := attr.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid attribute at index %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgAddAttributesBatchRequest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners indicates that the message must have been signed by the name owner.
+func (msg MsgAddAttributesBatchRequest) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(fmt.Errorf("invalid owner value on message: %w", err))
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// String implements stringer interface
+func (msg MsgAddAttributesBatchRequest) String() string {
+	out, _ := yaml.Marshal(msg)
+	return string(out)
+}
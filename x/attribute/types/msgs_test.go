@@ -106,3 +106,36 @@ func TestMsgDeleteDistinctAttribute(t *testing.T) {
 		}
 	}
 }
+
+// test ValidateBasic for TestMsgAddAttributesBatch
+func TestMsgAddAttributesBatch(t *testing.T) {
+	validAttr := NewAttribute("test", addrs[0].String(), AttributeType_String, []byte("value"))
+	tests := []struct {
+		name   string
+		owner  sdk.AccAddress
+		attrs  []Attribute
+		expErr string
+	}{
+		{"nil owner", nil, []Attribute{validAttr}, "empty owner address"},
+		{"no attributes", addrs[1], nil, "empty attributes list"},
+		{
+			"invalid second attribute",
+			addrs[1],
+			[]Attribute{validAttr, NewAttribute("", addrs[0].String(), AttributeType_String, []byte("value"))},
+			"invalid attribute at index 1: invalid name: empty",
+		},
+		{"valid attributes", addrs[1], []Attribute{validAttr, NewAttribute("test2", addrs[1].String(), AttributeType_Int, []byte("10"))}, ""},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := NewMsgAddAttributesBatchRequest(tc.owner, tc.attrs)
+			err := msg.ValidateBasic()
+			if len(tc.expErr) > 0 {
+				require.EqualError(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
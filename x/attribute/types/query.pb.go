@@ -432,6 +432,184 @@ func (m *QueryScanResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// AttributeMatch identifies an attribute by name and, optionally, value.
+type AttributeMatch struct {
+	// name is the attribute name to match.
+	// It is normalized the same way names are in the name module (e.g. unicode segments are matched in punycode form).
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// value is an optional attribute value that must also match exactly.
+	// If empty, an attribute with any value will match.
+	Value []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *AttributeMatch) Reset()         { *m = AttributeMatch{} }
+func (m *AttributeMatch) String() string { return proto.CompactTextString(m) }
+func (*AttributeMatch) ProtoMessage()    {}
+func (*AttributeMatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79f9aff39a1796c1, []int{8}
+}
+func (m *AttributeMatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttributeMatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttributeMatch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AttributeMatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttributeMatch.Merge(m, src)
+}
+func (m *AttributeMatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttributeMatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttributeMatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttributeMatch proto.InternalMessageInfo
+
+func (m *AttributeMatch) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *AttributeMatch) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+// QueryAttributeCheckRequest is the request type for the Query/AttributeCheck method.
+// The check passes if the account has every all_of entry, at least one any_of entry (when any are provided),
+// and none of the none_of entries.
+type QueryAttributeCheckRequest struct {
+	// account defines the address to check.
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// all_of are the attributes that the account must have.
+	AllOf []AttributeMatch `protobuf:"bytes,2,rep,name=all_of,json=allOf,proto3" json:"all_of"`
+	// any_of are attributes that the account must have at least one of. Ignored if empty.
+	AnyOf []AttributeMatch `protobuf:"bytes,3,rep,name=any_of,json=anyOf,proto3" json:"any_of"`
+	// none_of are attributes that the account must not have.
+	NoneOf []AttributeMatch `protobuf:"bytes,4,rep,name=none_of,json=noneOf,proto3" json:"none_of"`
+}
+
+func (m *QueryAttributeCheckRequest) Reset()         { *m = QueryAttributeCheckRequest{} }
+func (m *QueryAttributeCheckRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAttributeCheckRequest) ProtoMessage()    {}
+func (*QueryAttributeCheckRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79f9aff39a1796c1, []int{9}
+}
+func (m *QueryAttributeCheckRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAttributeCheckRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAttributeCheckRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAttributeCheckRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAttributeCheckRequest.Merge(m, src)
+}
+func (m *QueryAttributeCheckRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAttributeCheckRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAttributeCheckRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAttributeCheckRequest proto.InternalMessageInfo
+
+// QueryAttributeCheckResponse is the response type for the Query/AttributeCheck method.
+type QueryAttributeCheckResponse struct {
+	// a string containing the address of the account that was checked.
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// pass is true if the account satisfies the requested predicate.
+	Pass bool `protobuf:"varint,2,opt,name=pass,proto3" json:"pass,omitempty"`
+	// missing are the all_of entries that the account does not have.
+	// If none of the any_of entries are satisfied, they are all included here too.
+	Missing []AttributeMatch `protobuf:"bytes,3,rep,name=missing,proto3" json:"missing"`
+	// forbidden are the none_of entries that the account has.
+	Forbidden []AttributeMatch `protobuf:"bytes,4,rep,name=forbidden,proto3" json:"forbidden"`
+}
+
+func (m *QueryAttributeCheckResponse) Reset()         { *m = QueryAttributeCheckResponse{} }
+func (m *QueryAttributeCheckResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAttributeCheckResponse) ProtoMessage()    {}
+func (*QueryAttributeCheckResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79f9aff39a1796c1, []int{10}
+}
+func (m *QueryAttributeCheckResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAttributeCheckResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAttributeCheckResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAttributeCheckResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAttributeCheckResponse.Merge(m, src)
+}
+func (m *QueryAttributeCheckResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAttributeCheckResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAttributeCheckResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAttributeCheckResponse proto.InternalMessageInfo
+
+func (m *QueryAttributeCheckResponse) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *QueryAttributeCheckResponse) GetPass() bool {
+	if m != nil {
+		return m.Pass
+	}
+	return false
+}
+
+func (m *QueryAttributeCheckResponse) GetMissing() []AttributeMatch {
+	if m != nil {
+		return m.Missing
+	}
+	return nil
+}
+
+func (m *QueryAttributeCheckResponse) GetForbidden() []AttributeMatch {
+	if m != nil {
+		return m.Forbidden
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "provenance.attribute.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "provenance.attribute.v1.QueryParamsResponse")
@@ -441,6 +619,9 @@ func init() {
 	proto.RegisterType((*QueryAttributesResponse)(nil), "provenance.attribute.v1.QueryAttributesResponse")
 	proto.RegisterType((*QueryScanRequest)(nil), "provenance.attribute.v1.QueryScanRequest")
 	proto.RegisterType((*QueryScanResponse)(nil), "provenance.attribute.v1.QueryScanResponse")
+	proto.RegisterType((*AttributeMatch)(nil), "provenance.attribute.v1.AttributeMatch")
+	proto.RegisterType((*QueryAttributeCheckRequest)(nil), "provenance.attribute.v1.QueryAttributeCheckRequest")
+	proto.RegisterType((*QueryAttributeCheckResponse)(nil), "provenance.attribute.v1.QueryAttributeCheckResponse")
 }
 
 func init() {
@@ -448,46 +629,58 @@ func init() {
 }
 
 var fileDescriptor_79f9aff39a1796c1 = []byte{
-	// 620 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x95, 0x3f, 0x6f, 0xd3, 0x4e,
-	0x18, 0xc7, 0x7d, 0x69, 0x9a, 0xdf, 0xaf, 0x4f, 0x17, 0x38, 0x4a, 0x1b, 0x59, 0xc8, 0x29, 0x46,
-	0x22, 0xa5, 0x50, 0x1f, 0x49, 0x95, 0xa5, 0xc0, 0x40, 0x06, 0x60, 0x0c, 0x81, 0x89, 0xed, 0x62,
-	0x5d, 0x8d, 0x25, 0xe2, 0x73, 0x73, 0xe7, 0xa8, 0x55, 0x94, 0x05, 0x31, 0x30, 0x20, 0x81, 0x84,
-	0x04, 0x6b, 0x59, 0x90, 0x78, 0x17, 0x2c, 0xa0, 0x8e, 0x95, 0x58, 0x98, 0x10, 0x4a, 0x18, 0x78,
-	0x19, 0x28, 0xe7, 0x4b, 0xe2, 0x36, 0xb8, 0x09, 0x12, 0x0c, 0xdd, 0xce, 0x97, 0xe7, 0xcf, 0xe7,
-	0xfb, 0xbd, 0xbb, 0x27, 0x70, 0x29, 0x6c, 0xf1, 0x36, 0x0b, 0x68, 0xe0, 0x32, 0x42, 0xa5, 0x6c,
-	0xf9, 0x8d, 0x48, 0x32, 0xd2, 0x2e, 0x91, 0x9d, 0x88, 0xb5, 0xf6, 0x9c, 0xb0, 0xc5, 0x25, 0xc7,
-	0x2b, 0xe3, 0x20, 0x67, 0x14, 0xe4, 0xb4, 0x4b, 0xe6, 0xba, 0xcb, 0x45, 0x93, 0x0b, 0xd2, 0xa0,
-	0x82, 0xc5, 0x19, 0xa4, 0x5d, 0x6a, 0x30, 0x49, 0x4b, 0x24, 0xa4, 0x9e, 0x1f, 0x50, 0xe9, 0xf3,
-	0x20, 0x2e, 0x62, 0x2e, 0x79, 0xdc, 0xe3, 0x6a, 0x49, 0x06, 0x2b, 0xbd, 0x7b, 0xc1, 0xe3, 0xdc,
-	0x7b, 0xc2, 0x08, 0x0d, 0x7d, 0x42, 0x83, 0x80, 0x4b, 0x95, 0x22, 0xf4, 0xaf, 0xc5, 0x34, 0xba,
-	0x31, 0x85, 0x0a, 0xb4, 0x97, 0x00, 0xdf, 0x1f, 0xb4, 0xaf, 0xd1, 0x16, 0x6d, 0x8a, 0x3a, 0xdb,
-	0x89, 0x98, 0x90, 0xf6, 0x43, 0x38, 0x77, 0x64, 0x57, 0x84, 0x3c, 0x10, 0x0c, 0xdf, 0x82, 0x5c,
-	0xa8, 0x76, 0xf2, 0x68, 0x15, 0xad, 0x2d, 0x96, 0x0b, 0x4e, 0x8a, 0x3e, 0x27, 0x4e, 0xac, 0x66,
-	0x0f, 0xbe, 0x15, 0x8c, 0xba, 0x4e, 0xb2, 0xdf, 0x22, 0x38, 0xaf, 0xca, 0xde, 0x1e, 0x86, 0xea,
-	0x7e, 0x38, 0x0f, 0xff, 0x51, 0xd7, 0xe5, 0x51, 0x20, 0x55, 0xe5, 0x85, 0xfa, 0xf0, 0x13, 0x63,
-	0xc8, 0x06, 0xb4, 0xc9, 0xf2, 0x19, 0xb5, 0xad, 0xd6, 0xf8, 0x0e, 0xc0, 0xd8, 0xa4, 0xfc, 0x9c,
-	0x42, 0xb9, 0xec, 0xc4, 0x8e, 0x3a, 0x03, 0x47, 0x9d, 0xf8, 0x0c, 0xb4, 0xa3, 0x4e, 0x8d, 0x7a,
-	0xc3, 0x4e, 0xf5, 0x44, 0xe6, 0xd6, 0xff, 0xcf, 0xf7, 0x0b, 0xc6, 0xcf, 0xfd, 0x82, 0x61, 0x7f,
-	0x42, 0xb0, 0x7c, 0x9c, 0x4c, 0x6b, 0x4e, 0x47, 0xbb, 0x07, 0x30, 0xd2, 0x2c, 0xf2, 0x99, 0xd5,
-	0xb9, 0xb5, 0xc5, 0xb2, 0x9d, 0xea, 0xc8, 0xa8, 0xb2, 0x36, 0x25, 0x91, 0x8b, 0xef, 0xfe, 0x46,
-	0x50, 0x71, 0xaa, 0xa0, 0x18, 0x30, 0xa9, 0xc8, 0x7e, 0x36, 0xa1, 0x43, 0x4c, 0xb7, 0xf8, 0xa8,
-	0x9d, 0x99, 0xbf, 0x60, 0xe7, 0x67, 0x04, 0x2b, 0x13, 0x18, 0xa7, 0xd1, 0xcf, 0x37, 0x08, 0xce,
-	0x28, 0x21, 0x0f, 0x5c, 0x1a, 0x4c, 0x77, 0x72, 0x19, 0x72, 0x22, 0xda, 0xde, 0xf6, 0x77, 0xf5,
-	0x75, 0xd5, 0x5f, 0xff, 0xe0, 0xc2, 0x7e, 0x44, 0x70, 0x36, 0x01, 0x76, 0x0a, 0xbd, 0x2d, 0xbf,
-	0x9c, 0x87, 0x79, 0x25, 0x01, 0xbf, 0x40, 0x90, 0x8b, 0x07, 0x06, 0xbe, 0x9a, 0xca, 0x34, 0x39,
-	0xa5, 0xcc, 0x6b, 0xb3, 0x05, 0xc7, 0xbd, 0xed, 0xe2, 0xd3, 0x2f, 0x3f, 0x5e, 0x67, 0x2e, 0xe2,
-	0x02, 0x49, 0x9b, 0x8d, 0xf1, 0x98, 0xc2, 0x1f, 0x10, 0x2c, 0x8c, 0x1c, 0xc0, 0xce, 0xc9, 0x4d,
-	0x8e, 0x8f, 0x32, 0x93, 0xcc, 0x1c, 0xaf, 0xb9, 0x6e, 0x28, 0xae, 0x0a, 0xde, 0x24, 0x53, 0x67,
-	0x36, 0xe9, 0xe8, 0xf3, 0xec, 0x92, 0xce, 0x60, 0x12, 0x76, 0xf1, 0x7b, 0x04, 0x30, 0x7e, 0x64,
-	0x78, 0xd6, 0xe6, 0x23, 0x0b, 0xaf, 0xcf, 0x9e, 0xa0, 0x71, 0x2b, 0x0a, 0x97, 0xe0, 0x8d, 0xe9,
-	0xb8, 0x62, 0xcc, 0x8b, 0xdf, 0x21, 0xc8, 0x0e, 0xee, 0x2a, 0xbe, 0x72, 0x72, 0xc7, 0xc4, 0x43,
-	0x33, 0xd7, 0x67, 0x09, 0xd5, 0x58, 0x55, 0x85, 0x75, 0x13, 0x6f, 0xfd, 0x91, 0x8b, 0xc2, 0xa5,
-	0x01, 0xe9, 0xc4, 0xaf, 0xb4, 0x5b, 0x6d, 0x1e, 0xf4, 0x2c, 0x74, 0xd8, 0xb3, 0xd0, 0xf7, 0x9e,
-	0x85, 0x5e, 0xf5, 0x2d, 0xe3, 0xb0, 0x6f, 0x19, 0x5f, 0xfb, 0x96, 0x01, 0xa6, 0xcf, 0xd3, 0x58,
-	0x6a, 0xe8, 0x51, 0xc5, 0xf3, 0xe5, 0xe3, 0xa8, 0xe1, 0xb8, 0xbc, 0x99, 0xe8, 0xbe, 0xe1, 0xf3,
-	0x24, 0xcb, 0x6e, 0x02, 0x40, 0xee, 0x85, 0x4c, 0x34, 0x72, 0xea, 0x1f, 0x78, 0xf3, 0x57, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x6f, 0x59, 0xc9, 0xa6, 0x4a, 0x08, 0x00, 0x00,
+	// 805 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0xcf, 0x4f, 0x1b, 0x47,
+	0x14, 0xf6, 0x18, 0x63, 0xe0, 0x51, 0xa1, 0x76, 0x4a, 0xc1, 0xda, 0x56, 0x36, 0xdd, 0x4a, 0x35,
+	0xa5, 0x65, 0xa7, 0xc6, 0x45, 0xaa, 0xdc, 0x1f, 0x52, 0x69, 0x04, 0x91, 0xa2, 0x08, 0xe2, 0xe4,
+	0x94, 0x4b, 0x34, 0x5e, 0xd6, 0xcb, 0x2a, 0xf6, 0xcc, 0xe2, 0x59, 0x5b, 0x58, 0x88, 0x4b, 0x94,
+	0x43, 0x0e, 0x39, 0x44, 0x4a, 0x94, 0x5c, 0xc9, 0x25, 0x52, 0xfe, 0x86, 0x5c, 0x72, 0x49, 0xc4,
+	0x25, 0x12, 0x52, 0x2e, 0x39, 0x45, 0x09, 0xe4, 0x90, 0x3f, 0x23, 0xda, 0xd9, 0xb1, 0xbd, 0x06,
+	0xcc, 0x1a, 0x2b, 0x39, 0x70, 0x9b, 0x1d, 0xbf, 0xf7, 0xbd, 0xef, 0xfb, 0x66, 0xe6, 0x3d, 0xc3,
+	0x4f, 0x6e, 0x8d, 0x37, 0x2c, 0x46, 0x99, 0x69, 0x11, 0xea, 0x79, 0x35, 0xa7, 0x54, 0xf7, 0x2c,
+	0xd2, 0xc8, 0x91, 0xcd, 0xba, 0x55, 0x6b, 0x1a, 0x6e, 0x8d, 0x7b, 0x1c, 0x4f, 0x77, 0x82, 0x8c,
+	0x76, 0x90, 0xd1, 0xc8, 0x69, 0x73, 0x26, 0x17, 0x55, 0x2e, 0x48, 0x89, 0x0a, 0x2b, 0xc8, 0x20,
+	0x8d, 0x5c, 0xc9, 0xf2, 0x68, 0x8e, 0xb8, 0xd4, 0x76, 0x18, 0xf5, 0x1c, 0xce, 0x02, 0x10, 0x6d,
+	0xd2, 0xe6, 0x36, 0x97, 0x4b, 0xe2, 0xaf, 0xd4, 0xee, 0x0f, 0x36, 0xe7, 0x76, 0xc5, 0x22, 0xd4,
+	0x75, 0x08, 0x65, 0x8c, 0x7b, 0x32, 0x45, 0xa8, 0x5f, 0xb3, 0xbd, 0xd8, 0x75, 0x58, 0xc8, 0x40,
+	0x7d, 0x12, 0xf0, 0x15, 0xbf, 0xfc, 0x1a, 0xad, 0xd1, 0xaa, 0x28, 0x5a, 0x9b, 0x75, 0x4b, 0x78,
+	0xfa, 0x35, 0xf8, 0xb6, 0x6b, 0x57, 0xb8, 0x9c, 0x09, 0x0b, 0xff, 0x03, 0x49, 0x57, 0xee, 0xa4,
+	0xd0, 0x0c, 0x9a, 0x1d, 0x5f, 0xc8, 0x18, 0x3d, 0xf4, 0x19, 0x41, 0xe2, 0x52, 0x62, 0xef, 0x6d,
+	0x26, 0x56, 0x54, 0x49, 0xfa, 0x23, 0x04, 0xdf, 0x49, 0xd8, 0xff, 0x5a, 0xa1, 0xaa, 0x1e, 0x4e,
+	0xc1, 0x08, 0x35, 0x4d, 0x5e, 0x67, 0x9e, 0x44, 0x1e, 0x2b, 0xb6, 0x3e, 0x31, 0x86, 0x04, 0xa3,
+	0x55, 0x2b, 0x15, 0x97, 0xdb, 0x72, 0x8d, 0x97, 0x01, 0x3a, 0x26, 0xa5, 0x86, 0x24, 0x95, 0x9f,
+	0x8d, 0xc0, 0x51, 0xc3, 0x77, 0xd4, 0x08, 0xce, 0x40, 0x39, 0x6a, 0xac, 0x51, 0xbb, 0x55, 0xa9,
+	0x18, 0xca, 0x2c, 0x8c, 0xde, 0xd9, 0xcd, 0xc4, 0x3e, 0xee, 0x66, 0x62, 0xfa, 0x0b, 0x04, 0x53,
+	0x47, 0x99, 0x29, 0xcd, 0xbd, 0xa9, 0x5d, 0x04, 0x68, 0x6b, 0x16, 0xa9, 0xf8, 0xcc, 0xd0, 0xec,
+	0xf8, 0x82, 0xde, 0xd3, 0x91, 0x36, 0xb2, 0x32, 0x25, 0x94, 0x8b, 0x57, 0x4e, 0x10, 0x94, 0x8d,
+	0x14, 0x14, 0x10, 0x0c, 0x2b, 0xd2, 0x6f, 0x1f, 0xd3, 0x21, 0xa2, 0x2d, 0xee, 0xb6, 0x33, 0xfe,
+	0x19, 0xec, 0x7c, 0x89, 0x60, 0xfa, 0x18, 0x8d, 0xf3, 0xe8, 0xe7, 0x43, 0x04, 0x5f, 0x4b, 0x21,
+	0x57, 0x4d, 0xca, 0xa2, 0x9d, 0x9c, 0x82, 0xa4, 0xa8, 0x97, 0xcb, 0xce, 0x96, 0xba, 0xae, 0xea,
+	0xeb, 0x0b, 0x5c, 0xd8, 0xe7, 0x08, 0xbe, 0x09, 0x11, 0x3b, 0x8f, 0xde, 0x16, 0x60, 0xa2, 0x5d,
+	0xe7, 0x32, 0xf5, 0xcc, 0x8d, 0xf6, 0x5b, 0x47, 0xa1, 0xb7, 0x3e, 0x09, 0xc3, 0x0d, 0x5a, 0xa9,
+	0x07, 0x0d, 0xe0, 0xab, 0x62, 0xf0, 0xa1, 0x3f, 0x88, 0x83, 0xd6, 0x7d, 0xc1, 0xfe, 0xdf, 0xb0,
+	0xcc, 0x9b, 0xd1, 0x27, 0x74, 0x01, 0x92, 0xb4, 0x52, 0xb9, 0xc1, 0xcb, 0xca, 0x83, 0x6c, 0xb4,
+	0x07, 0x92, 0x9b, 0x32, 0x62, 0x98, 0x56, 0x2a, 0xab, 0x65, 0x89, 0xc2, 0x9a, 0x3e, 0xca, 0xd0,
+	0x60, 0x28, 0xac, 0xb9, 0x5a, 0xc6, 0xcb, 0x30, 0xc2, 0x38, 0xb3, 0x7c, 0x98, 0xc4, 0x20, 0x30,
+	0x49, 0x3f, 0x7b, 0xb5, 0x1c, 0xba, 0x15, 0xef, 0x11, 0x7c, 0x7f, 0xa2, 0x2d, 0x91, 0xf7, 0x03,
+	0x43, 0xc2, 0xa5, 0x42, 0x48, 0x97, 0x47, 0x8b, 0x72, 0x8d, 0x57, 0x60, 0xa4, 0xea, 0x08, 0xe1,
+	0x30, 0x7b, 0x30, 0x99, 0xad, 0x6c, 0x7c, 0x09, 0xc6, 0xca, 0xbc, 0x56, 0x72, 0xd6, 0xd7, 0x2d,
+	0x36, 0x98, 0xd4, 0x4e, 0xfe, 0xc2, 0xab, 0x24, 0x0c, 0x4b, 0x8d, 0xf8, 0x2e, 0x82, 0x64, 0x30,
+	0x67, 0xf0, 0xaf, 0x3d, 0xe1, 0x8e, 0x0f, 0x37, 0xed, 0xb7, 0xfe, 0x82, 0x03, 0xcf, 0xf4, 0xec,
+	0xad, 0xd7, 0x1f, 0xee, 0xc7, 0x7f, 0xc4, 0x19, 0xd2, 0x6b, 0xa4, 0x06, 0xd3, 0x0d, 0x3f, 0x45,
+	0x30, 0xd6, 0x26, 0x8f, 0x8d, 0xd3, 0x8b, 0x1c, 0x9d, 0x80, 0x1a, 0xe9, 0x3b, 0x5e, 0xf1, 0xfa,
+	0x4b, 0xf2, 0x5a, 0xc4, 0x79, 0x12, 0x39, 0xea, 0xc9, 0xb6, 0x3a, 0xe6, 0x1d, 0xb2, 0xed, 0x3f,
+	0xaa, 0x1d, 0xfc, 0x04, 0x01, 0x74, 0x7a, 0x33, 0xee, 0xb7, 0x78, 0xdb, 0xc2, 0xdf, 0xfb, 0x4f,
+	0x50, 0x74, 0x17, 0x25, 0x5d, 0x82, 0xe7, 0xa3, 0xe9, 0x8a, 0x0e, 0x5f, 0xfc, 0x18, 0x41, 0xc2,
+	0x6f, 0x71, 0xf8, 0x97, 0xd3, 0x2b, 0x86, 0xfa, 0xb3, 0x36, 0xd7, 0x4f, 0xa8, 0xa2, 0xb5, 0x24,
+	0x69, 0xfd, 0x8d, 0x0b, 0x67, 0x72, 0x51, 0x98, 0x94, 0x91, 0xed, 0xa0, 0xb9, 0xef, 0xe0, 0x67,
+	0x08, 0x26, 0xba, 0x1f, 0x1c, 0xce, 0xf7, 0xe9, 0x4f, 0xb8, 0x6b, 0x69, 0x7f, 0x9c, 0x2d, 0x49,
+	0x29, 0xf8, 0x57, 0x2a, 0xf8, 0xb3, 0x80, 0xe6, 0xf4, 0xfc, 0x99, 0xbc, 0x25, 0xa6, 0x8f, 0xb3,
+	0x54, 0xdd, 0x3b, 0x48, 0xa3, 0xfd, 0x83, 0x34, 0x7a, 0x77, 0x90, 0x46, 0xf7, 0x0e, 0xd3, 0xb1,
+	0xfd, 0xc3, 0x74, 0xec, 0xcd, 0x61, 0x3a, 0x06, 0x9a, 0xc3, 0x7b, 0x31, 0x5a, 0x43, 0xd7, 0x17,
+	0x6d, 0xc7, 0xdb, 0xa8, 0x97, 0x0c, 0x93, 0x57, 0x43, 0x65, 0xe7, 0x1d, 0x1e, 0x26, 0xb1, 0x15,
+	0xa2, 0xe1, 0x35, 0x5d, 0x4b, 0x94, 0x92, 0xf2, 0x6f, 0x67, 0xfe, 0xd3, 0x00, 0xa6, 0xb6, 0x77,
+	0xcd, 0x3f, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -510,6 +703,8 @@ type QueryClient interface {
 	Attributes(ctx context.Context, in *QueryAttributesRequest, opts ...grpc.CallOption) (*QueryAttributesResponse, error)
 	// Scan queries attributes on a given account (address) for any that match the provided suffix
 	Scan(ctx context.Context, in *QueryScanRequest, opts ...grpc.CallOption) (*QueryScanResponse, error)
+	// AttributeCheck evaluates a simple boolean predicate against the attributes of a given account (address).
+	AttributeCheck(ctx context.Context, in *QueryAttributeCheckRequest, opts ...grpc.CallOption) (*QueryAttributeCheckResponse, error)
 }
 
 type queryClient struct {
@@ -556,6 +751,15 @@ func (c *queryClient) Scan(ctx context.Context, in *QueryScanRequest, opts ...gr
 	return out, nil
 }
 
+func (c *queryClient) AttributeCheck(ctx context.Context, in *QueryAttributeCheckRequest, opts ...grpc.CallOption) (*QueryAttributeCheckResponse, error) {
+	out := new(QueryAttributeCheckResponse)
+	err := c.cc.Invoke(ctx, "/provenance.attribute.v1.Query/AttributeCheck", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries params of the attribute module.
@@ -566,6 +770,8 @@ type QueryServer interface {
 	Attributes(context.Context, *QueryAttributesRequest) (*QueryAttributesResponse, error)
 	// Scan queries attributes on a given account (address) for any that match the provided suffix
 	Scan(context.Context, *QueryScanRequest) (*QueryScanResponse, error)
+	// AttributeCheck evaluates a simple boolean predicate against the attributes of a given account (address).
+	AttributeCheck(context.Context, *QueryAttributeCheckRequest) (*QueryAttributeCheckResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -584,6 +790,9 @@ func (*UnimplementedQueryServer) Attributes(ctx context.Context, req *QueryAttri
 func (*UnimplementedQueryServer) Scan(ctx context.Context, req *QueryScanRequest) (*QueryScanResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Scan not implemented")
 }
+func (*UnimplementedQueryServer) AttributeCheck(ctx context.Context, req *QueryAttributeCheckRequest) (*QueryAttributeCheckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttributeCheck not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -661,6 +870,24 @@ func _Query_Scan_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AttributeCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAttributeCheckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AttributeCheck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.attribute.v1.Query/AttributeCheck",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AttributeCheck(ctx, req.(*QueryAttributeCheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "provenance.attribute.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -681,6 +908,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Scan",
 			Handler:    _Query_Scan_Handler,
 		},
+		{
+			MethodName: "AttributeCheck",
+			Handler:    _Query_AttributeCheck_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "provenance/attribute/v1/query.proto",
@@ -1050,6 +1281,183 @@ func (m *QueryScanResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AttributeMatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttributeMatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttributeMatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAttributeCheckRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAttributeCheckRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAttributeCheckRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NoneOf) > 0 {
+		for iNdEx := len(m.NoneOf) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NoneOf[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.AnyOf) > 0 {
+		for iNdEx := len(m.AnyOf) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AnyOf[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.AllOf) > 0 {
+		for iNdEx := len(m.AllOf) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AllOf[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAttributeCheckResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAttributeCheckResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAttributeCheckResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Forbidden) > 0 {
+		for iNdEx := len(m.Forbidden) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Forbidden[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Missing) > 0 {
+		for iNdEx := len(m.Missing) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Missing[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Pass {
+		i--
+		if m.Pass {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1209,12 +1617,88 @@ func (m *QueryScanResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
+func (m *AttributeMatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAttributeCheckRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.AllOf) > 0 {
+		for _, e := range m.AllOf {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.AnyOf) > 0 {
+		for _, e := range m.AnyOf {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.NoneOf) > 0 {
+		for _, e := range m.NoneOf {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAttributeCheckResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pass {
+		n += 2
+	}
+	if len(m.Missing) > 0 {
+		for _, e := range m.Missing {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Forbidden) > 0 {
+		for _, e := range m.Forbidden {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
 func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1275,28 +1759,445 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowQuery
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAttributeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAttributeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAttributeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAttributeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAttributeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAttributeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attributes = append(m.Attributes, Attribute{})
+			if err := m.Attributes[len(m.Attributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAttributesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAttributesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAttributesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1323,7 +2224,10 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1348,7 +2252,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAttributeRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAttributesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1371,10 +2275,10 @@ func (m *QueryAttributeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAttributeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAttributesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAttributeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAttributesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1411,9 +2315,9 @@ func (m *QueryAttributeRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1423,23 +2327,25 @@ func (m *QueryAttributeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Attributes = append(m.Attributes, Attribute{})
+			if err := m.Attributes[len(m.Attributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
@@ -1471,7 +2377,7 @@ func (m *QueryAttributeRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+				m.Pagination = &query.PageResponse{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -1498,7 +2404,7 @@ func (m *QueryAttributeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAttributeResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryScanRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1521,10 +2427,10 @@ func (m *QueryAttributeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAttributeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryScanRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAttributeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryScanRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1561,9 +2467,9 @@ func (m *QueryAttributeResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Suffix", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1573,25 +2479,23 @@ func (m *QueryAttributeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Attributes = append(m.Attributes, Attribute{})
-			if err := m.Attributes[len(m.Attributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Suffix = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
@@ -1623,7 +2527,7 @@ func (m *QueryAttributeResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+				m.Pagination = &query.PageRequest{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -1650,7 +2554,7 @@ func (m *QueryAttributeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAttributesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryScanResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1673,10 +2577,10 @@ func (m *QueryAttributesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAttributesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryScanResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAttributesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryScanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1712,6 +2616,40 @@ func (m *QueryAttributesRequest) Unmarshal(dAtA []byte) error {
 			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attributes = append(m.Attributes, Attribute{})
+			if err := m.Attributes[len(m.Attributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -1741,7 +2679,7 @@ func (m *QueryAttributesRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+				m.Pagination = &query.PageResponse{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -1768,7 +2706,7 @@ func (m *QueryAttributesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAttributesResponse) Unmarshal(dAtA []byte) error {
+func (m *AttributeMatch) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1791,15 +2729,15 @@ func (m *QueryAttributesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAttributesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AttributeMatch: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAttributesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AttributeMatch: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1827,47 +2765,13 @@ func (m *QueryAttributesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Attributes = append(m.Attributes, Attribute{})
-			if err := m.Attributes[len(m.Attributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1877,26 +2781,24 @@ func (m *QueryAttributesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -1920,7 +2822,7 @@ func (m *QueryAttributesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryScanRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAttributeCheckRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1943,10 +2845,10 @@ func (m *QueryScanRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryScanRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAttributeCheckRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryScanRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAttributeCheckRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1983,9 +2885,9 @@ func (m *QueryScanRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Suffix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllOf", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1995,27 +2897,29 @@ func (m *QueryScanRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Suffix = string(dAtA[iNdEx:postIndex])
+			m.AllOf = append(m.AllOf, AttributeMatch{})
+			if err := m.AllOf[len(m.AllOf)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AnyOf", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2042,10 +2946,42 @@ func (m *QueryScanRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			m.AnyOf = append(m.AnyOf, AttributeMatch{})
+			if err := m.AnyOf[len(m.AnyOf)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoneOf", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NoneOf = append(m.NoneOf, AttributeMatch{})
+			if err := m.NoneOf[len(m.NoneOf)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2070,7 +3006,7 @@ func (m *QueryScanRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryScanResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAttributeCheckResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2093,10 +3029,10 @@ func (m *QueryScanResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryScanResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAttributeCheckResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryScanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAttributeCheckResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2132,8 +3068,28 @@ func (m *QueryScanResponse) Unmarshal(dAtA []byte) error {
 			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pass", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Pass = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Missing", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2160,14 +3116,14 @@ func (m *QueryScanResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Attributes = append(m.Attributes, Attribute{})
-			if err := m.Attributes[len(m.Attributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Missing = append(m.Missing, AttributeMatch{})
+			if err := m.Missing[len(m.Missing)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Forbidden", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2194,10 +3150,8 @@ func (m *QueryScanResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Forbidden = append(m.Forbidden, AttributeMatch{})
+			if err := m.Forbidden[len(m.Forbidden)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -362,6 +362,85 @@ func (m *MsgDeleteDistinctAttributeResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDeleteDistinctAttributeResponse proto.InternalMessageInfo
 
+// MsgAddAttributesBatchRequest defines an sdk.Msg type that is used to add many attributes at once.
+// It can be used to set one attribute name on many accounts, or many attributes on one account.
+// Every attribute name must resolve to the owner. If any attribute fails, none are added.
+type MsgAddAttributesBatchRequest struct {
+	// The attributes to add. Each attribute contains the account it is added to.
+	Attributes []Attribute `protobuf:"bytes,1,rep,name=attributes,proto3" json:"attributes"`
+	// The address that the attribute names must resolve to.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (m *MsgAddAttributesBatchRequest) Reset()      { *m = MsgAddAttributesBatchRequest{} }
+func (*MsgAddAttributesBatchRequest) ProtoMessage() {}
+func (*MsgAddAttributesBatchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5de344c1a12714be, []int{8}
+}
+func (m *MsgAddAttributesBatchRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddAttributesBatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddAttributesBatchRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddAttributesBatchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddAttributesBatchRequest.Merge(m, src)
+}
+func (m *MsgAddAttributesBatchRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddAttributesBatchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddAttributesBatchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddAttributesBatchRequest proto.InternalMessageInfo
+
+// MsgAddAttributesBatchResponse defines the Msg/AddAttributesBatch response type.
+type MsgAddAttributesBatchResponse struct {
+}
+
+func (m *MsgAddAttributesBatchResponse) Reset()         { *m = MsgAddAttributesBatchResponse{} }
+func (m *MsgAddAttributesBatchResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddAttributesBatchResponse) ProtoMessage()    {}
+func (*MsgAddAttributesBatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5de344c1a12714be, []int{9}
+}
+func (m *MsgAddAttributesBatchResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddAttributesBatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddAttributesBatchResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddAttributesBatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddAttributesBatchResponse.Merge(m, src)
+}
+func (m *MsgAddAttributesBatchResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddAttributesBatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddAttributesBatchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddAttributesBatchResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgAddAttributeRequest)(nil), "provenance.attribute.v1.MsgAddAttributeRequest")
 	proto.RegisterType((*MsgAddAttributeResponse)(nil), "provenance.attribute.v1.MsgAddAttributeResponse")
@@ -371,47 +450,53 @@ func init() {
 	proto.RegisterType((*MsgDeleteAttributeResponse)(nil), "provenance.attribute.v1.MsgDeleteAttributeResponse")
 	proto.RegisterType((*MsgDeleteDistinctAttributeRequest)(nil), "provenance.attribute.v1.MsgDeleteDistinctAttributeRequest")
 	proto.RegisterType((*MsgDeleteDistinctAttributeResponse)(nil), "provenance.attribute.v1.MsgDeleteDistinctAttributeResponse")
+	proto.RegisterType((*MsgAddAttributesBatchRequest)(nil), "provenance.attribute.v1.MsgAddAttributesBatchRequest")
+	proto.RegisterType((*MsgAddAttributesBatchResponse)(nil), "provenance.attribute.v1.MsgAddAttributesBatchResponse")
 }
 
 func init() { proto.RegisterFile("provenance/attribute/v1/tx.proto", fileDescriptor_5de344c1a12714be) }
 
 var fileDescriptor_5de344c1a12714be = []byte{
-	// 549 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xbf, 0x6f, 0xd3, 0x40,
-	0x18, 0xf5, 0x25, 0x4e, 0x2b, 0x3e, 0xd2, 0x80, 0x8e, 0x96, 0xb8, 0x16, 0x72, 0xdc, 0x88, 0x1f,
-	0x59, 0xb0, 0x69, 0x2a, 0x96, 0x32, 0x15, 0x75, 0x8d, 0x84, 0x22, 0x60, 0xe8, 0x40, 0xe5, 0x38,
-	0x27, 0x63, 0x29, 0xf1, 0x39, 0xf6, 0x39, 0xb4, 0x4c, 0x2c, 0x48, 0x6c, 0x20, 0x26, 0xc6, 0xfc,
-	0x39, 0x8c, 0x95, 0x58, 0x18, 0x18, 0x50, 0xb2, 0xf0, 0x1f, 0xb0, 0xa2, 0x9c, 0x13, 0xc7, 0x75,
-	0x73, 0x26, 0x81, 0xcd, 0xdf, 0xf9, 0xdd, 0x7b, 0xef, 0xbe, 0x77, 0x9f, 0x0d, 0xba, 0x1f, 0xd0,
-	0x21, 0xf1, 0x2c, 0xcf, 0x26, 0xa6, 0xc5, 0x58, 0xe0, 0x76, 0x22, 0x46, 0xcc, 0xe1, 0xbe, 0xc9,
-	0xce, 0x0c, 0x3f, 0xa0, 0x8c, 0xe2, 0xea, 0x02, 0x61, 0x24, 0x08, 0x63, 0xb8, 0xaf, 0x6e, 0x3b,
-	0xd4, 0xa1, 0x1c, 0x63, 0x4e, 0x9f, 0x62, 0xb8, 0xfa, 0x40, 0x44, 0xb8, 0xd8, 0xcb, 0x81, 0xf5,
-	0x6f, 0x08, 0x6e, 0xb7, 0x42, 0xe7, 0xa8, 0xdb, 0x3d, 0x9a, 0xbf, 0x69, 0x93, 0x41, 0x44, 0x42,
-	0x86, 0x31, 0xc8, 0x9e, 0xd5, 0x27, 0x0a, 0xd2, 0x51, 0xe3, 0x5a, 0x9b, 0x3f, 0xe3, 0x6d, 0x28,
-	0x0d, 0xad, 0x5e, 0x44, 0x94, 0x82, 0x8e, 0x1a, 0xe5, 0x76, 0x5c, 0xe0, 0x16, 0x54, 0x12, 0xde,
-	0x53, 0x76, 0xee, 0x13, 0xa5, 0xa8, 0xa3, 0x46, 0xa5, 0x79, 0xdf, 0x10, 0xb8, 0x36, 0x12, 0xb1,
-	0xe7, 0xe7, 0x3e, 0x69, 0x6f, 0x59, 0xe9, 0x12, 0x2b, 0xb0, 0x69, 0xd9, 0x36, 0x8d, 0x3c, 0xa6,
-	0xc8, 0x5c, 0x7b, 0x5e, 0x4e, 0xe5, 0xe9, 0x1b, 0x8f, 0x04, 0x4a, 0x89, 0xaf, 0xc7, 0xc5, 0xe1,
-	0xcd, 0x0f, 0xa3, 0x9a, 0xf4, 0x65, 0x54, 0x93, 0x7e, 0x8d, 0x6a, 0xd2, 0xbb, 0x1f, 0xba, 0x54,
-	0xdf, 0x85, 0xea, 0x95, 0x43, 0x85, 0x3e, 0xf5, 0x42, 0x52, 0xff, 0x5d, 0x80, 0xdd, 0x56, 0xe8,
-	0xbc, 0xf0, 0xbb, 0x16, 0x23, 0x2b, 0x9d, 0xf9, 0x1e, 0x54, 0x68, 0xe0, 0x3a, 0xae, 0x67, 0xf5,
-	0x4e, 0xd3, 0x87, 0xdf, 0x9a, 0xaf, 0xbe, 0xe4, 0x4d, 0xd8, 0x83, 0x72, 0xc4, 0x49, 0x67, 0xa0,
-	0x22, 0x07, 0x5d, 0x8f, 0xd7, 0x62, 0xc8, 0x2b, 0xa8, 0x26, 0x4c, 0x99, 0x86, 0xc9, 0x6b, 0x35,
-	0x6c, 0x67, 0x4e, 0x73, 0x69, 0x19, 0x9f, 0xc0, 0xce, 0xcc, 0x42, 0x86, 0xbd, 0xb4, 0x16, 0xfb,
-	0xad, 0xe8, 0x72, 0x73, 0xb2, 0xa1, 0x6c, 0x08, 0x42, 0xd9, 0xcc, 0x0f, 0xe5, 0x0e, 0xa8, 0xcb,
-	0x1a, 0x3f, 0xcb, 0x65, 0xc0, 0x63, 0x39, 0x26, 0x3d, 0xb2, 0x62, 0x2c, 0x29, 0x43, 0x05, 0x81,
-	0xa1, 0xe2, 0x2a, 0x86, 0xae, 0x48, 0xce, 0x0c, 0x7d, 0x44, 0xb0, 0x97, 0xbc, 0x3e, 0x76, 0x43,
-	0xe6, 0x7a, 0x36, 0xfb, 0x8f, 0x21, 0x49, 0xf9, 0x2d, 0x0a, 0xfc, 0xca, 0xf9, 0x7e, 0xef, 0x42,
-	0x3d, 0xcf, 0x50, 0xec, 0xbb, 0xf9, 0x5e, 0x86, 0x62, 0x2b, 0x74, 0xf0, 0x00, 0xca, 0xe9, 0x01,
-	0xc0, 0xa6, 0x30, 0xfd, 0xe5, 0xf3, 0xaf, 0x3e, 0x5a, 0x7d, 0x43, 0x2c, 0x8d, 0xdf, 0xc2, 0x8d,
-	0x4c, 0xbc, 0xb8, 0x99, 0x47, 0xb2, 0x7c, 0x08, 0xd5, 0x83, 0xb5, 0xf6, 0x2c, 0xb4, 0x33, 0x49,
-	0xe6, 0x6b, 0x2f, 0xbf, 0x69, 0xf9, 0xda, 0x82, 0xab, 0x82, 0x3f, 0x23, 0xa8, 0x0a, 0x62, 0xc1,
-	0x87, 0x7f, 0x27, 0x14, 0x5d, 0x2e, 0xf5, 0xc9, 0x3f, 0xed, 0x8d, 0x4d, 0x3d, 0xed, 0x7f, 0x1d,
-	0x6b, 0xe8, 0x62, 0xac, 0xa1, 0x9f, 0x63, 0x0d, 0x7d, 0x9a, 0x68, 0xd2, 0xc5, 0x44, 0x93, 0xbe,
-	0x4f, 0x34, 0x09, 0x54, 0x97, 0x8a, 0x88, 0x9f, 0xa1, 0x93, 0xc7, 0x8e, 0xcb, 0x5e, 0x47, 0x1d,
-	0xc3, 0xa6, 0x7d, 0x73, 0x81, 0x7a, 0xe8, 0xd2, 0x54, 0x65, 0x9e, 0xa5, 0xfe, 0x2a, 0xd3, 0x2f,
-	0x4c, 0xd8, 0xd9, 0xe0, 0xff, 0x93, 0x83, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x84, 0xf7, 0xbe,
-	0xa7, 0xcb, 0x06, 0x00, 0x00,
+	// 618 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x95, 0x3d, 0x6f, 0xd3, 0x5c,
+	0x14, 0xc7, 0x7d, 0xe3, 0xa4, 0xd5, 0x73, 0xfa, 0xf2, 0xa0, 0x4b, 0x4b, 0x5c, 0xab, 0x38, 0x6e,
+	0xc4, 0x4b, 0x16, 0x6c, 0x9a, 0xa8, 0x0c, 0x65, 0x6a, 0xd4, 0x81, 0x25, 0x12, 0x8a, 0x80, 0xa1,
+	0x03, 0x95, 0xe3, 0x5c, 0xb9, 0x96, 0x12, 0x5f, 0x27, 0xbe, 0x0e, 0x2d, 0x13, 0x12, 0x0b, 0x12,
+	0x03, 0x15, 0x13, 0x63, 0x3e, 0x4e, 0xc7, 0x4a, 0x2c, 0x0c, 0x08, 0xa1, 0x64, 0xe1, 0x1b, 0xb0,
+	0xa2, 0xd8, 0x8e, 0xe3, 0x38, 0xb1, 0x9b, 0xc0, 0xe6, 0x7b, 0xfd, 0x3f, 0xff, 0xf3, 0xf3, 0x39,
+	0xe7, 0x5e, 0x83, 0x6c, 0x77, 0x69, 0x8f, 0x58, 0x9a, 0xa5, 0x13, 0x55, 0x63, 0xac, 0x6b, 0x36,
+	0x5c, 0x46, 0xd4, 0xde, 0xbe, 0xca, 0xce, 0x15, 0xbb, 0x4b, 0x19, 0xc5, 0xf9, 0x89, 0x42, 0x09,
+	0x15, 0x4a, 0x6f, 0x5f, 0xdc, 0x32, 0xa8, 0x41, 0x3d, 0x8d, 0x3a, 0x7a, 0xf2, 0xe5, 0xe2, 0xc3,
+	0x24, 0xc3, 0x49, 0xac, 0x27, 0x2c, 0x7e, 0x45, 0x70, 0xa7, 0xe6, 0x18, 0x47, 0xcd, 0xe6, 0xd1,
+	0xf8, 0x4d, 0x9d, 0x74, 0x5c, 0xe2, 0x30, 0x8c, 0x21, 0x6b, 0x69, 0x6d, 0x22, 0x20, 0x19, 0x95,
+	0xfe, 0xab, 0x7b, 0xcf, 0x78, 0x0b, 0x72, 0x3d, 0xad, 0xe5, 0x12, 0x21, 0x23, 0xa3, 0xd2, 0x7a,
+	0xdd, 0x5f, 0xe0, 0x1a, 0x6c, 0x86, 0xbe, 0xa7, 0xec, 0xc2, 0x26, 0x02, 0x2f, 0xa3, 0xd2, 0x66,
+	0xf9, 0x81, 0x92, 0x40, 0xad, 0x84, 0xc9, 0x5e, 0x5c, 0xd8, 0xa4, 0xbe, 0xa1, 0x45, 0x97, 0x58,
+	0x80, 0x55, 0x4d, 0xd7, 0xa9, 0x6b, 0x31, 0x21, 0xeb, 0xe5, 0x1e, 0x2f, 0x47, 0xe9, 0xe9, 0x1b,
+	0x8b, 0x74, 0x85, 0x9c, 0xb7, 0xef, 0x2f, 0x0e, 0x6f, 0x7d, 0xe8, 0x17, 0xb8, 0x2f, 0xfd, 0x02,
+	0xf7, 0xab, 0x5f, 0xe0, 0xde, 0x7d, 0x97, 0xb9, 0xe2, 0x0e, 0xe4, 0x67, 0x3e, 0xca, 0xb1, 0xa9,
+	0xe5, 0x90, 0xe2, 0xef, 0x0c, 0xec, 0xd4, 0x1c, 0xe3, 0xa5, 0xdd, 0xd4, 0x18, 0x59, 0xe8, 0x9b,
+	0xef, 0xc3, 0x26, 0xed, 0x9a, 0x86, 0x69, 0x69, 0xad, 0xd3, 0xe8, 0xc7, 0x6f, 0x8c, 0x77, 0x5f,
+	0x79, 0x45, 0xd8, 0x83, 0x75, 0xd7, 0x33, 0x0d, 0x44, 0xbc, 0x27, 0x5a, 0xf3, 0xf7, 0x7c, 0xc9,
+	0x6b, 0xc8, 0x87, 0x4e, 0xb1, 0x82, 0x65, 0x97, 0x2a, 0xd8, 0xf6, 0xd8, 0x66, 0x6a, 0x1b, 0x9f,
+	0xc0, 0x76, 0x80, 0x10, 0x73, 0xcf, 0x2d, 0xe5, 0x7e, 0xdb, 0x9d, 0x2e, 0x4e, 0xbc, 0x29, 0x2b,
+	0x09, 0x4d, 0x59, 0x4d, 0x6f, 0xca, 0x2e, 0x88, 0xf3, 0x0a, 0x1f, 0xf4, 0xa5, 0xe3, 0xb5, 0xe5,
+	0x98, 0xb4, 0xc8, 0x82, 0x6d, 0x89, 0x00, 0x65, 0x12, 0x80, 0xf8, 0x45, 0x80, 0x66, 0x52, 0x06,
+	0x40, 0x9f, 0x10, 0xec, 0x85, 0xaf, 0x8f, 0x4d, 0x87, 0x99, 0x96, 0xce, 0xfe, 0xe1, 0x90, 0x44,
+	0x78, 0xf9, 0x04, 0xde, 0x6c, 0x3a, 0xef, 0x3d, 0x28, 0xa6, 0x01, 0x05, 0xdc, 0x97, 0x08, 0x76,
+	0x63, 0xc3, 0xef, 0x54, 0x35, 0xa6, 0x9f, 0x8d, 0x91, 0x9f, 0x01, 0x84, 0xcd, 0x77, 0x04, 0x24,
+	0xf3, 0xa5, 0xb5, 0x72, 0xf1, 0xe6, 0xd1, 0xa8, 0x66, 0xaf, 0x7e, 0x14, 0xb8, 0x7a, 0x24, 0x76,
+	0x02, 0x9e, 0x49, 0x07, 0x2f, 0xc0, 0xdd, 0x04, 0x22, 0x9f, 0xb9, 0xfc, 0x31, 0x07, 0x7c, 0xcd,
+	0x31, 0x70, 0x07, 0xd6, 0xa3, 0x2a, 0xac, 0x26, 0x62, 0xcd, 0xbf, 0xb3, 0xc4, 0xc7, 0x8b, 0x07,
+	0xf8, 0xa9, 0xf1, 0x5b, 0xf8, 0x3f, 0x36, 0x92, 0xb8, 0x9c, 0x66, 0x32, 0xff, 0xe2, 0x10, 0x2b,
+	0x4b, 0xc5, 0x4c, 0x72, 0xc7, 0xa6, 0x2f, 0x3d, 0xf7, 0xfc, 0xd3, 0x21, 0x56, 0x96, 0x8a, 0x09,
+	0x72, 0x7f, 0x46, 0x90, 0x4f, 0x18, 0x25, 0x7c, 0x78, 0xb3, 0x61, 0xd2, 0x81, 0x10, 0x9f, 0xfe,
+	0x55, 0x6c, 0x00, 0xf5, 0x1e, 0x01, 0x9e, 0x1d, 0x13, 0x7c, 0xb0, 0x68, 0x57, 0xa7, 0x06, 0x5d,
+	0x7c, 0xb2, 0x6c, 0x98, 0x4f, 0x51, 0x6d, 0x5f, 0x0d, 0x24, 0x74, 0x3d, 0x90, 0xd0, 0xcf, 0x81,
+	0x84, 0x2e, 0x87, 0x12, 0x77, 0x3d, 0x94, 0xb8, 0x6f, 0x43, 0x89, 0x03, 0xd1, 0xa4, 0x49, 0x9e,
+	0xcf, 0xd1, 0xc9, 0x81, 0x61, 0xb2, 0x33, 0xb7, 0xa1, 0xe8, 0xb4, 0xad, 0x4e, 0x54, 0x8f, 0x4c,
+	0x1a, 0x59, 0xa9, 0xe7, 0x91, 0xff, 0xf1, 0xe8, 0x6e, 0x76, 0x1a, 0x2b, 0xde, 0x9f, 0xb8, 0xf2,
+	0x67, 0x00, 0x51, 0xa9, 0x0a, 0xd9, 0x05, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -434,6 +519,8 @@ type MsgClient interface {
 	DeleteAttribute(ctx context.Context, in *MsgDeleteAttributeRequest, opts ...grpc.CallOption) (*MsgDeleteAttributeResponse, error)
 	// DeleteDistinctAttribute defines a method to verify a particular invariance.
 	DeleteDistinctAttribute(ctx context.Context, in *MsgDeleteDistinctAttributeRequest, opts ...grpc.CallOption) (*MsgDeleteDistinctAttributeResponse, error)
+	// AddAttributesBatch defines a method to add many attributes in a single all-or-nothing operation.
+	AddAttributesBatch(ctx context.Context, in *MsgAddAttributesBatchRequest, opts ...grpc.CallOption) (*MsgAddAttributesBatchResponse, error)
 }
 
 type msgClient struct {
@@ -480,6 +567,15 @@ func (c *msgClient) DeleteDistinctAttribute(ctx context.Context, in *MsgDeleteDi
 	return out, nil
 }
 
+func (c *msgClient) AddAttributesBatch(ctx context.Context, in *MsgAddAttributesBatchRequest, opts ...grpc.CallOption) (*MsgAddAttributesBatchResponse, error) {
+	out := new(MsgAddAttributesBatchResponse)
+	err := c.cc.Invoke(ctx, "/provenance.attribute.v1.Msg/AddAttributesBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// AddAttribute defines a method to verify a particular invariance.
@@ -490,6 +586,8 @@ type MsgServer interface {
 	DeleteAttribute(context.Context, *MsgDeleteAttributeRequest) (*MsgDeleteAttributeResponse, error)
 	// DeleteDistinctAttribute defines a method to verify a particular invariance.
 	DeleteDistinctAttribute(context.Context, *MsgDeleteDistinctAttributeRequest) (*MsgDeleteDistinctAttributeResponse, error)
+	// AddAttributesBatch defines a method to add many attributes in a single all-or-nothing operation.
+	AddAttributesBatch(context.Context, *MsgAddAttributesBatchRequest) (*MsgAddAttributesBatchResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -508,6 +606,9 @@ func (*UnimplementedMsgServer) DeleteAttribute(ctx context.Context, req *MsgDele
 func (*UnimplementedMsgServer) DeleteDistinctAttribute(ctx context.Context, req *MsgDeleteDistinctAttributeRequest) (*MsgDeleteDistinctAttributeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteDistinctAttribute not implemented")
 }
+func (*UnimplementedMsgServer) AddAttributesBatch(ctx context.Context, req *MsgAddAttributesBatchRequest) (*MsgAddAttributesBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddAttributesBatch not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -585,6 +686,24 @@ func _Msg_DeleteDistinctAttribute_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_AddAttributesBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddAttributesBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddAttributesBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.attribute.v1.Msg/AddAttributesBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddAttributesBatch(ctx, req.(*MsgAddAttributesBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "provenance.attribute.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -605,6 +724,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteDistinctAttribute",
 			Handler:    _Msg_DeleteDistinctAttribute_Handler,
 		},
+		{
+			MethodName: "AddAttributesBatch",
+			Handler:    _Msg_AddAttributesBatch_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "provenance/attribute/v1/tx.proto",
@@ -921,6 +1044,73 @@ func (m *MsgDeleteDistinctAttributeResponse) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgAddAttributesBatchRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddAttributesBatchRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddAttributesBatchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Attributes) > 0 {
+		for iNdEx := len(m.Attributes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attributes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddAttributesBatchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddAttributesBatchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddAttributesBatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1077,6 +1267,34 @@ func (m *MsgDeleteDistinctAttributeResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgAddAttributesBatchRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Attributes) > 0 {
+		for _, e := range m.Attributes {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddAttributesBatchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2060,6 +2278,172 @@ func (m *MsgDeleteDistinctAttributeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgAddAttributesBatchRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddAttributesBatchRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddAttributesBatchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attributes = append(m.Attributes, Attribute{})
+			if err := m.Attributes[len(m.Attributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddAttributesBatchResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddAttributesBatchResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddAttributesBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -19,6 +19,8 @@ type AttributeQueryParams struct {
 	Get *GetAttributesParams `json:"get_attributes,omitempty"`
 	// Get all account attributes.
 	GetAll *GetAllAttributesParams `json:"get_all_attributes,omitempty"`
+	// Check account attributes against a predicate.
+	Check *CheckAttributesParams `json:"check_attributes,omitempty"`
 }
 
 // GetAttributesParams are params for querying an account attributes by address and name.
@@ -35,6 +37,18 @@ type GetAllAttributesParams struct {
 	Address string `json:"address"`
 }
 
+// CheckAttributesParams are params for checking whether an account's attributes satisfy a predicate.
+type CheckAttributesParams struct {
+	// The account to check
+	Address string `json:"address"`
+	// Attributes the account must have.
+	AllOf []AttributeMatch `json:"all_of,omitempty"`
+	// Attributes the account must have at least one of.
+	AnyOf []AttributeMatch `json:"any_of,omitempty"`
+	// Attributes the account must not have.
+	NoneOf []AttributeMatch `json:"none_of,omitempty"`
+}
+
 // Querier returns a smart contract querier for the attribute module.
 func Querier(keeper keeper.Keeper) provwasm.Querier {
 	return func(ctx sdk.Context, query json.RawMessage, version string) ([]byte, error) {
@@ -53,6 +67,8 @@ func Querier(keeper keeper.Keeper) provwasm.Querier {
 			return params.Get.Run(ctx, keeper)
 		case params.GetAll != nil:
 			return params.GetAll.Run(ctx, keeper)
+		case params.Check != nil:
+			return params.Check.Run(ctx, keeper)
 		default:
 			return nil, fmt.Errorf("wasm: invalid account attribute query: %s", string(query))
 		}
@@ -85,6 +101,51 @@ func (params *GetAllAttributesParams) Run(ctx sdk.Context, keeper keeper.Keeper)
 	return createResponse(params.Address, attrs)
 }
 
+// Run checks account attributes against a predicate.
+func (params *CheckAttributesParams) Run(ctx sdk.Context, keeper keeper.Keeper) ([]byte, error) {
+	err := types.ValidateAttributeAddress(params.Address)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid address: %w", err)
+	}
+	allOf, anyOf, noneOf := toAttributeMatches(params.AllOf), toAttributeMatches(params.AnyOf), toAttributeMatches(params.NoneOf)
+	if err = types.ValidateAttributeCheck(allOf, anyOf, noneOf); err != nil {
+		return nil, fmt.Errorf("wasm: invalid attribute check: %w", err)
+	}
+	pass, missing, forbidden, err := keeper.CheckAttributes(ctx, params.Address, allOf, anyOf, noneOf)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: attribute check failed: %w", err)
+	}
+	res := CheckAttributesResponse{
+		Address:   params.Address,
+		Pass:      pass,
+		Missing:   fromAttributeMatches(missing),
+		Forbidden: fromAttributeMatches(forbidden),
+	}
+	bz, err := json.Marshal(res)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: marshal response failed: %w", err)
+	}
+	return bz, nil
+}
+
+// Convert the contract representation of attribute matches to the module representation.
+func toAttributeMatches(matches []AttributeMatch) []types.AttributeMatch {
+	rv := make([]types.AttributeMatch, len(matches))
+	for i, m := range matches {
+		rv[i] = types.NewAttributeMatch(m.Name, m.Value)
+	}
+	return rv
+}
+
+// Convert the module representation of attribute matches to the contract representation.
+func fromAttributeMatches(matches []types.AttributeMatch) []AttributeMatch {
+	var rv []AttributeMatch
+	for _, m := range matches {
+		rv = append(rv, AttributeMatch{Name: m.Name, Value: append([]byte{}, m.Value...)})
+	}
+	return rv
+}
+
 // Create a JSON response from the results of a account attribute query.
 func createResponse(address string, attrs []types.Attribute) ([]byte, error) {
 	res := AttributeResponse{Address: address}
@@ -18,3 +18,23 @@ type AttributeResponse struct {
 	// The attributes queried for the account.
 	Attributes []Attribute `json:"attributes,omitempty"`
 }
+
+// AttributeMatch identifies an attribute by name and, optionally, value.
+type AttributeMatch struct {
+	// The attribute name.
+	Name string `json:"name"`
+	// The attribute value. If empty, an attribute with any value matches.
+	Value []byte `json:"value,omitempty"`
+}
+
+// CheckAttributesResponse returns the result of checking a cosmos account's attributes against a predicate.
+type CheckAttributesResponse struct {
+	// The account address in Bech32 format
+	Address string `json:"address"`
+	// Whether the account satisfies the predicate.
+	Pass bool `json:"pass"`
+	// The required attributes that the account does not have.
+	Missing []AttributeMatch `json:"missing,omitempty"`
+	// The forbidden attributes that the account has.
+	Forbidden []AttributeMatch `json:"forbidden,omitempty"`
+}
@@ -0,0 +1,18 @@
+package metadata
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/metadata/keeper"
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// EndBlocker removes scope data access and scope sale offers that have expired.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+	k.PruneExpiredDataAccess(ctx)
+	k.PruneExpiredScopeSaleOffers(ctx)
+}
@@ -2,6 +2,8 @@ package cli_test
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
@@ -12,6 +14,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	"sigs.k8s.io/yaml"
 
 	tmcli "github.com/tendermint/tendermint/libs/cli"
 
@@ -293,7 +296,7 @@ func (s *IntegrationCLITestSuite) SetupSuite() {
 		[]metadatatypes.PartyType{metadatatypes.PartyType_PARTY_TYPE_OWNER},
 	)
 
-	s.scopeAsJson = fmt.Sprintf("{\"scope_id\":\"%s\",\"specification_id\":\"%s\",\"owners\":[{\"address\":\"%s\",\"role\":\"PARTY_TYPE_OWNER\"}],\"data_access\":[\"%s\"],\"value_owner_address\":\"%s\"}",
+	s.scopeAsJson = fmt.Sprintf("{\"scope_id\":\"%s\",\"specification_id\":\"%s\",\"owners\":[{\"address\":\"%s\",\"role\":\"PARTY_TYPE_OWNER\"}],\"data_access\":[\"%s\"],\"value_owner_address\":\"%s\",\"data_access_grants\":[]}",
 		s.scopeID,
 		s.scopeSpecID,
 		s.user1AddrStr,
@@ -302,6 +305,7 @@ func (s *IntegrationCLITestSuite) SetupSuite() {
 	)
 	s.scopeAsText = fmt.Sprintf(`data_access:
 - %s
+data_access_grants: []
 owners:
 - address: %s
   role: PARTY_TYPE_OWNER
@@ -421,18 +425,29 @@ type_name: recordtypename`,
 			eKey = "\"\""
 		}
 		return fmt.Sprintf(`encryption_key: %s
+key_valid_from: null
+key_valid_until: null
 locator_uri: %s
-owner: %s`,
+name: %s
+owner: %s
+priority: %d
+state: %s`,
 			eKey,
 			loc.LocatorUri,
+			loc.Name,
 			loc.Owner,
+			loc.Priority,
+			loc.State,
 		)
 	}
 	locAsJson := func(loc metadatatypes.ObjectStoreLocator) string {
-		return fmt.Sprintf("{\"owner\":\"%s\",\"locator_uri\":\"%s\",\"encryption_key\":\"%s\"}",
+		return fmt.Sprintf("{\"owner\":\"%s\",\"locator_uri\":\"%s\",\"encryption_key\":\"%s\",\"name\":\"%s\",\"priority\":%d,\"state\":\"%s\",\"key_valid_from\":null,\"key_valid_until\":null}",
 			loc.Owner,
 			loc.LocatorUri,
 			loc.EncryptionKey,
+			loc.Name,
+			loc.Priority,
+			loc.State,
 		)
 	}
 	s.ownerAddr1 = s.user1Addr
@@ -610,13 +625,13 @@ func (s *IntegrationCLITestSuite) TestGetMetadataParamsCmd() {
 			"get params as json output",
 			[]string{s.asJson},
 			"",
-			[]string{"\"params\":{}"},
+			[]string{"\"params\":{\"max_record_versions\":0,\"disable_p8e_messages\":false,\"emit_detailed_events\":false}"},
 		},
 		{
 			"get params as text output",
 			[]string{s.asText},
 			"",
-			[]string{"params: {}"},
+			[]string{"params:", "max_record_versions: 0", "disable_p8e_messages: false", "emit_detailed_events: false"},
 		},
 		{
 			"get params - invalid args",
@@ -628,7 +643,7 @@ func (s *IntegrationCLITestSuite) TestGetMetadataParamsCmd() {
 			"get params as json output including request",
 			[]string{s.asJson, s.includeRequest},
 			"",
-			[]string{"\"params\":{}", "\"request\":{}"},
+			[]string{"\"params\":{\"max_record_versions\":0,\"disable_p8e_messages\":false,\"emit_detailed_events\":false}", "\"request\":{}"},
 		},
 		{
 			"get locator params as json",
@@ -1023,6 +1038,39 @@ func (s *IntegrationCLITestSuite) TestGetMetadataScopeCmd() {
 			"accepts 1 arg(s), received 0",
 			[]string{},
 		},
+		{
+			"get scopes by filters",
+			[]string{
+				"--specification", s.scopeSpecID.String(), "--owner", s.user1AddrStr, "--role", "owner",
+				"--value-owner", s.user2AddrStr, "--data-access", s.user1AddrStr, s.asText,
+			},
+			"",
+			[]string{s.scopeID.String()},
+		},
+		{
+			"get scopes by filters - no matches",
+			[]string{"--owner", s.user1AddrStr, "--role", "servicer", "--value-owner", s.user1AddrStr, s.asText},
+			"",
+			[]string{"scopes: []"},
+		},
+		{
+			"get scopes by filters - unknown role",
+			[]string{"--owner", s.user1AddrStr, "--role", "notarole"},
+			"unknown party type: notarole",
+			[]string{},
+		},
+		{
+			"get scopes by filters - role without owner",
+			[]string{"--role", "owner"},
+			"rpc error: code = InvalidArgument desc = owner role cannot be provided without an owner: invalid request",
+			[]string{},
+		},
+		{
+			"get scopes by filters - with arg",
+			[]string{s.scopeID.String(), "--owner", s.user1AddrStr},
+			"no arguments are allowed with the filter flags, received 1",
+			[]string{},
+		},
 	}
 
 	runQueryCmdTestCases(s, cmd, testCases)
@@ -1251,6 +1299,42 @@ func (s *IntegrationCLITestSuite) TestGetMetadataSessionCmd() {
 			"accepts between 1 and 2 arg(s), received 0",
 			[]string{},
 		},
+		{
+			"sessions by party as json",
+			[]string{"--party", s.user1AddrStr, s.asJson},
+			"",
+			[]string{s.sessionAsJson},
+		},
+		{
+			"sessions by party and role as text",
+			[]string{"--party", s.user1AddrStr, "--role", "owner", s.asText},
+			"",
+			[]string{indentedSessionText},
+		},
+		{
+			"sessions by party without sessions",
+			[]string{"--party", sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(), s.asJson},
+			"",
+			[]string{`"sessions":[]`},
+		},
+		{
+			"sessions by party with unknown role",
+			[]string{"--party", s.user1AddrStr, "--role", "boss"},
+			"unknown party type: boss",
+			[]string{},
+		},
+		{
+			"sessions by party with args",
+			[]string{s.scopeID.String(), "--party", s.user1AddrStr},
+			"no arguments are allowed with the --party flag, received 1",
+			[]string{},
+		},
+		{
+			"sessions by role without party",
+			[]string{"--role", "owner"},
+			"the --role flag can only be used with the --party flag",
+			[]string{},
+		},
 	}
 
 	runQueryCmdTestCases(s, cmd, testCases)
@@ -1756,6 +1840,36 @@ func (s *IntegrationCLITestSuite) TestGetValueOwnershipCmd() {
 	runQueryCmdTestCases(s, cmd, testCases)
 }
 
+func (s *IntegrationCLITestSuite) TestGetVerifyCmd() {
+	cmd := func() *cobra.Command { return cli.GetVerifyCmd() }
+	objDir := s.T().TempDir()
+
+	testCases := []queryCmdTestCase{
+		{
+			name:          "scope with unverifiable hashes",
+			args:          []string{s.scopeID.String(), "--" + cli.FlagObjectStoreDir, objDir},
+			expectedError: "2 of 2 objects failed verification",
+		},
+		{
+			name:          "record with unverifiable hashes",
+			args:          []string{s.recordID.String(), "--" + cli.FlagObjectStoreDir, objDir},
+			expectedError: "2 of 2 objects failed verification",
+		},
+		{
+			name:          "scope specification id",
+			args:          []string{s.scopeSpecID.String()},
+			expectedError: fmt.Sprintf("id %q is not a scope or record id", s.scopeSpecID.String()),
+		},
+		{
+			name:          "bad id",
+			args:          []string{"notanid"},
+			expectedError: `invalid id "notanid"`,
+		},
+	}
+
+	runQueryCmdTestCases(s, cmd, testCases)
+}
+
 func (s *IntegrationCLITestSuite) TestGetOSLocatorCmd() {
 	cmd := func() *cobra.Command { return cli.GetOSLocatorCmd() }
 
@@ -1773,6 +1887,7 @@ func (s *IntegrationCLITestSuite) TestGetOSLocatorCmd() {
 			[]string{
 				"params:",
 				fmt.Sprintf("max_uri_length: %d", metadatatypes.DefaultMaxURILength),
+				fmt.Sprintf("max_locators_per_owner: %d", metadatatypes.DefaultMaxLocatorsPerOwner),
 			},
 		},
 		{
@@ -1782,6 +1897,7 @@ func (s *IntegrationCLITestSuite) TestGetOSLocatorCmd() {
 			[]string{
 				"\"params\":{",
 				fmt.Sprintf("\"max_uri_length\":%d", metadatatypes.DefaultMaxURILength),
+				fmt.Sprintf("\"max_locators_per_owner\":%d", metadatatypes.DefaultMaxLocatorsPerOwner),
 			},
 		},
 		{
@@ -2139,6 +2255,51 @@ func (s *IntegrationCLITestSuite) TestScopeTxCommands() {
 			},
 			false, "", &sdk.TxResponse{}, 0,
 		},
+		{
+			"should fail to add metadata scope data access, invalid expiration",
+			cli.AddRemoveScopeDataAccessCmd(),
+			[]string{
+				"add",
+				scopeID,
+				s.user1AddrStr,
+				fmt.Sprintf("--%s=%s", cli.FlagExpiration, "tomorrow"),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.accountAddrStr),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			true, `invalid expiration "tomorrow": must be an RFC3339 timestamp or a duration`, &sdk.TxResponse{}, 0,
+		},
+		{
+			"should successfully add metadata scope data access with a role and expiration",
+			cli.AddRemoveScopeDataAccessCmd(),
+			[]string{
+				"add",
+				scopeID,
+				s.user1AddrStr,
+				fmt.Sprintf("--%s=%s", cli.FlagRole, "auditor"),
+				fmt.Sprintf("--%s=%s", cli.FlagExpiration, "720h"),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.accountAddrStr),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, "", &sdk.TxResponse{}, 0,
+		},
+		{
+			"should successfully remove metadata scope data access with a role and expiration",
+			cli.AddRemoveScopeDataAccessCmd(),
+			[]string{
+				"remove",
+				scopeID,
+				s.user1AddrStr,
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.accountAddrStr),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, "", &sdk.TxResponse{}, 0,
+		},
 
 		{
 			"should fail to add/remove metadata scope owners, invalid scopeid",
@@ -2225,6 +2386,185 @@ func (s *IntegrationCLITestSuite) TestScopeTxCommands() {
 	runTxCmdTestCases(s, testCases)
 }
 
+func (s *IntegrationCLITestSuite) TestWriteScopeBundleTxCommand() {
+	scopeUUID := uuid.New()
+	bundle := metadatatypes.ScopeBundle{
+		Scope: *metadatatypes.NewScope(metadatatypes.ScopeMetadataAddress(scopeUUID), s.scopeSpecID,
+			ownerPartyList(s.accountAddrStr), nil, ""),
+		Sessions: []metadatatypes.Session{
+			*metadatatypes.NewSession("bundle session", metadatatypes.SessionMetadataAddress(scopeUUID, uuid.New()),
+				s.contractSpecID, ownerPartyList(s.accountAddrStr), nil),
+		},
+	}
+	bundleJSON, err := s.getClientCtx().Codec.MarshalJSON(metadatatypes.NewMsgWriteScopeBundleRequest([]metadatatypes.ScopeBundle{bundle}, nil))
+	s.Require().NoError(err, "MarshalJSON")
+	dir := s.T().TempDir()
+	bundleFile := filepath.Join(dir, "bundle.json")
+	s.Require().NoError(os.WriteFile(bundleFile, bundleJSON, 0o600), "WriteFile bundle")
+	dupFile := filepath.Join(dir, "dup.json")
+	dupJSON, err := s.getClientCtx().Codec.MarshalJSON(metadatatypes.NewMsgWriteScopeBundleRequest([]metadatatypes.ScopeBundle{bundle, bundle}, nil))
+	s.Require().NoError(err, "MarshalJSON dup")
+	s.Require().NoError(os.WriteFile(dupFile, dupJSON, 0o600), "WriteFile dup")
+
+	testCases := []txCmdTestCase{
+		{
+			"should successfully write scope bundle",
+			cli.WriteScopeBundleCmd(),
+			[]string{
+				bundleFile,
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.accountAddrStr),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, "", &sdk.TxResponse{}, 0,
+		},
+		{
+			"should fail to write scope bundle, duplicate scope",
+			cli.WriteScopeBundleCmd(),
+			[]string{
+				dupFile,
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.accountAddrStr),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			true, fmt.Sprintf("duplicate scope %s in bundles", bundle.Scope.ScopeId), &sdk.TxResponse{}, 0,
+		},
+		{
+			"should fail to write scope bundle, file does not exist",
+			cli.WriteScopeBundleCmd(),
+			[]string{
+				filepath.Join(dir, "missing.json"),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.accountAddrStr),
+			},
+			true, "", &sdk.TxResponse{}, 0,
+		},
+	}
+
+	runTxCmdTestCases(s, testCases)
+}
+
+func (s *IntegrationCLITestSuite) TestApplyAndExportSpecsCommands() {
+	contractSpecUUID := uuid.New()
+	contractSpec := *metadatatypes.NewContractSpecification(
+		metadatatypes.ContractSpecMetadataAddress(contractSpecUUID),
+		nil,
+		[]string{s.accountAddrStr},
+		[]metadatatypes.PartyType{metadatatypes.PartyType_PARTY_TYPE_OWNER},
+		metadatatypes.NewContractSpecificationSourceHash("applyspecshash"),
+		"applyspecsclass",
+	)
+	newRecordSpec := func(name string) metadatatypes.RecordSpecification {
+		return *metadatatypes.NewRecordSpecification(
+			metadatatypes.RecordSpecMetadataAddress(contractSpecUUID, name),
+			name,
+			[]*metadatatypes.InputSpecification{
+				metadatatypes.NewInputSpecification("input", "inputtype", metadatatypes.NewInputSpecificationSourceHash("inputhash")),
+			},
+			"recordtype",
+			metadatatypes.DefinitionType_DEFINITION_TYPE_RECORD,
+			[]metadatatypes.PartyType{metadatatypes.PartyType_PARTY_TYPE_OWNER},
+		)
+	}
+	recordSpec1 := newRecordSpec("applyspecs1")
+	recordSpec2 := newRecordSpec("applyspecs2")
+	scopeSpec := *metadatatypes.NewScopeSpecification(
+		metadatatypes.ScopeSpecMetadataAddress(uuid.New()),
+		nil,
+		[]string{s.accountAddrStr},
+		[]metadatatypes.PartyType{metadatatypes.PartyType_PARTY_TYPE_OWNER},
+		[]metadatatypes.MetadataAddress{contractSpec.SpecificationId},
+	)
+
+	dir := s.T().TempDir()
+	writeSpecsFile := func(name string, file *cli.SpecificationsFile) string {
+		bz, err := cli.MarshalSpecificationsFile(s.getClientCtx().Codec, file)
+		s.Require().NoError(err, "MarshalSpecificationsFile %s", name)
+		bz, err = yaml.JSONToYAML(bz)
+		s.Require().NoError(err, "JSONToYAML %s", name)
+		path := filepath.Join(dir, name)
+		s.Require().NoError(os.WriteFile(path, bz, 0o600), "WriteFile %s", name)
+		return path
+	}
+	fullFile := &cli.SpecificationsFile{
+		ScopeSpecifications:    []metadatatypes.ScopeSpecification{scopeSpec},
+		ContractSpecifications: []metadatatypes.ContractSpecification{contractSpec},
+		RecordSpecifications:   []metadatatypes.RecordSpecification{recordSpec1, recordSpec2},
+	}
+	fullPath := writeSpecsFile("full.yaml", fullFile)
+	updatedContractSpec := contractSpec
+	updatedContractSpec.ClassName = "applyspecsclass2"
+	updatedPath := writeSpecsFile("updated.yaml", &cli.SpecificationsFile{
+		ContractSpecifications: []metadatatypes.ContractSpecification{updatedContractSpec},
+		RecordSpecifications:   []metadatatypes.RecordSpecification{recordSpec1},
+	})
+	txFlags := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, s.accountAddrStr),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	}
+
+	runTxCmdTestCases(s, []txCmdTestCase{
+		{
+			"should successfully apply new specifications",
+			cli.ApplySpecificationsCmd(),
+			append([]string{fullPath}, txFlags...),
+			false, "", &sdk.TxResponse{}, 0,
+		},
+		{
+			"should fail to apply specifications, file does not exist",
+			cli.ApplySpecificationsCmd(),
+			append([]string{filepath.Join(dir, "missing.yaml")}, txFlags...),
+			true, "", &sdk.TxResponse{}, 0,
+		},
+	})
+
+	s.T().Run("export matches applied file", func(t *testing.T) {
+		out, err := clitestutil.ExecTestCLICmd(s.getClientCtx(), cli.GetExportSpecsCmd(), []string{scopeSpec.SpecificationId.String(), s.asJson})
+		require.NoError(t, err, "export-specs")
+		exported, err := cli.ParseSpecificationsFile(s.getClientCtx().Codec, out.Bytes())
+		require.NoError(t, err, "ParseSpecificationsFile")
+		assert.Equal(t, fullFile.ScopeSpecifications, exported.ScopeSpecifications, "exported scope specifications")
+		assert.Equal(t, fullFile.ContractSpecifications, exported.ContractSpecifications, "exported contract specifications")
+		assert.ElementsMatch(t, fullFile.RecordSpecifications, exported.RecordSpecifications, "exported record specifications")
+	})
+
+	s.T().Run("applying unchanged specifications does nothing", func(t *testing.T) {
+		out, err := clitestutil.ExecTestCLICmd(s.getClientCtx(), cli.ApplySpecificationsCmd(), append([]string{fullPath}, txFlags...))
+		require.NoError(t, err, "apply-specs")
+		assert.Equal(t, "All specifications are already up to date.\n", out.String(), "apply-specs output")
+	})
+
+	runTxCmdTestCases(s, []txCmdTestCase{
+		{
+			"should successfully apply updated contract specification and removed record specification",
+			cli.ApplySpecificationsCmd(),
+			append([]string{updatedPath}, txFlags...),
+			false, "", &sdk.TxResponse{}, 0,
+		},
+	})
+
+	s.T().Run("export after update", func(t *testing.T) {
+		out, err := clitestutil.ExecTestCLICmd(s.getClientCtx(), cli.GetExportSpecsCmd(), []string{contractSpec.SpecificationId.String()})
+		require.NoError(t, err, "export-specs")
+		exported, err := cli.ParseSpecificationsFile(s.getClientCtx().Codec, out.Bytes())
+		require.NoError(t, err, "ParseSpecificationsFile")
+		expected := &cli.SpecificationsFile{
+			ScopeSpecifications:    []metadatatypes.ScopeSpecification{},
+			ContractSpecifications: []metadatatypes.ContractSpecification{updatedContractSpec},
+			RecordSpecifications:   []metadatatypes.RecordSpecification{recordSpec1},
+		}
+		assert.Equal(t, expected, exported, "exported specifications")
+	})
+
+	s.T().Run("export unknown specification", func(t *testing.T) {
+		_, err := clitestutil.ExecTestCLICmd(s.getClientCtx(), cli.GetExportSpecsCmd(), []string{s.scopeID.String()})
+		assert.EqualError(t, err, fmt.Sprintf("id %q is not a scope or contract specification id", s.scopeID.String()), "export-specs")
+	})
+}
+
 func (s *IntegrationCLITestSuite) TestScopeSpecificationTxCommands() {
 	addCommand := cli.WriteScopeSpecificationCmd()
 	removeCommand := cli.RemoveScopeSpecificationCmd()
@@ -2346,6 +2686,7 @@ func (s *IntegrationCLITestSuite) TestAddObjectLocatorCmd() {
 			[]string{
 				s.accountAddrStr,
 				userURI,
+				fmt.Sprintf("--%s=%d", cli.FlagPriority, 2),
 				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.accountAddrStr),
 				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
 				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
@@ -2366,6 +2707,18 @@ func (s *IntegrationCLITestSuite) TestAddObjectLocatorCmd() {
 			},
 			false, "", &sdk.TxResponse{}, 0,
 		},
+	}
+
+	runTxCmdTestCases(s, testCases)
+
+	s.Run("modify keeps the fields that were not provided", func() {
+		out, err := clitestutil.ExecTestCLICmd(s.getClientCtx(), cli.GetOSLocatorCmd(), []string{s.accountAddrStr, s.asText})
+		s.Require().NoError(err, "GetOSLocatorCmd")
+		s.Assert().Contains(out.String(), "priority: 2", "locator priority")
+		s.Assert().Contains(out.String(), "www.google.com", "locator uri")
+	})
+
+	testCases = []txCmdTestCase{
 		{
 			"Should successfully delete os locator",
 			cli.RemoveOsLocatorCmd(),
@@ -3527,3 +3880,41 @@ func (s *IntegrationCLITestSuite) TestCountAuthorizationIntactTxCommands() {
 
 	runTxCmdTestCases(s, testCases)
 }
+
+func (s *IntegrationCLITestSuite) TestGrantScopeAuthorizationCmd() {
+	scopeID := metadatatypes.ScopeMetadataAddress(uuid.New()).String()
+	txFlags := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, s.accountAddrStr),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	}
+	testCases := []txCmdTestCase{
+		{
+			"should successfully grant a scope limited authorization",
+			cli.GrantScopeAuthorizationCmd(),
+			append([]string{
+				s.user3AddrStr,
+				metadatatypes.TypeURLMsgWriteScopeRequest,
+				fmt.Sprintf("--%s=%s", cli.FlagAllowedMsgs, metadatatypes.TypeURLMsgAddScopeDataAccessRequest),
+				fmt.Sprintf("--%s=%s", cli.FlagScopes, scopeID),
+				fmt.Sprintf("--%s=%d", cli.FlagMaxUses, 2),
+			}, txFlags...),
+			false, "", &sdk.TxResponse{}, 0,
+		},
+		{
+			"should fail to grant an authorization for a non-metadata message",
+			cli.GrantScopeAuthorizationCmd(),
+			append([]string{s.user3AddrStr, "/cosmos.bank.v1beta1.MsgSend"}, txFlags...),
+			true, `"/cosmos.bank.v1beta1.MsgSend" is not a metadata message type: invalid type`, &sdk.TxResponse{}, 0,
+		},
+		{
+			"should fail to grant with an invalid scope id",
+			cli.GrantScopeAuthorizationCmd(),
+			append([]string{s.user3AddrStr, metadatatypes.TypeURLMsgWriteScopeRequest, fmt.Sprintf("--%s=%s", cli.FlagScopes, "notascope")}, txFlags...),
+			true, "", &sdk.TxResponse{}, 0,
+		},
+	}
+
+	runTxCmdTestCases(s, testCases)
+}
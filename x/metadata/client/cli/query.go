@@ -3,6 +3,7 @@ package cli
 import (
 	"context"
 	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"regexp"
@@ -18,6 +19,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/bech32"
 	"github.com/cosmos/cosmos-sdk/version"
 
+	"github.com/provenance-io/provenance/x/metadata/client/verify"
 	"github.com/provenance-io/provenance/x/metadata/types"
 )
 
@@ -33,6 +35,17 @@ var (
 
 const all = "all"
 
+const (
+	FlagSpecification  = "specification"
+	FlagOwner          = "owner"
+	FlagRole           = "role"
+	FlagValueOwner     = "value-owner"
+	FlagDataAccess     = "data-access"
+	FlagObjectStoreDir = "object-store-dir"
+	FlagTimeout        = "timeout"
+	FlagParty          = "party"
+)
+
 // GetQueryCmd returns the top-level command for marker CLI queries.
 func GetQueryCmd() *cobra.Command {
 	queryCmd := &cobra.Command{
@@ -53,9 +66,15 @@ func GetQueryCmd() *cobra.Command {
 		GetMetadataScopeSpecCmd(),
 		GetMetadataContractSpecCmd(),
 		GetMetadataRecordSpecCmd(),
+		GetExportSpecsCmd(),
 		GetOwnershipCmd(),
 		GetValueOwnershipCmd(),
+		GetValueOwnerTokenCmd(),
+		GetScopeSaleOfferCmd(),
+		GetRecordHistoryCmd(),
+		GetScopeSpecMigrationCmd(),
 		GetOSLocatorCmd(),
+		GetVerifyCmd(),
 	)
 	return queryCmd
 }
@@ -208,21 +227,39 @@ func GetMetadataGetAllCmd() *cobra.Command {
 // GetMetadataScopeCmd returns the command handler for metadata scope querying.
 func GetMetadataScopeCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "scope {scope_id|scope_uuid|session_id|record_id|\"all\"}",
+		Use:     "scope {scope_id|scope_uuid|session_id|record_id|\"all\"|filter flags}",
 		Aliases: []string{"sc", "scopes"},
 		Short:   "Query the current metadata for a scope",
 		Long: fmt.Sprintf(`%[1]s scope {scope_id} - gets the scope with the given id.
 %[1]s scope {scope_uuid} - gets the scope with the given uuid.
 %[1]s scope {session_id} - gets the scope containing the given session.
 %[1]s scope {record_id} - gets the scope containing the given record.
-%[1]s scope all - gets all scopes.`, cmdStart),
-		Args: cobra.ExactArgs(1),
+%[1]s scope all - gets all scopes.
+%[1]s scopes {filter flags} - gets all scopes matching all of the provided filters.
+
+The filter flags are --%[2]s, --%[3]s, --%[4]s, --%[5]s, and --%[6]s.
+The --%[4]s flag can only be used with the --%[3]s flag.`,
+			cmdStart, FlagSpecification, FlagOwner, FlagRole, FlagValueOwner, FlagDataAccess),
+		Args: func(cmd *cobra.Command, args []string) error {
+			if hasScopeFilterFlags(cmd) {
+				if len(args) > 0 {
+					return fmt.Errorf("no arguments are allowed with the filter flags, received %d", len(args))
+				}
+				return nil
+			}
+			return cobra.ExactArgs(1)(cmd, args)
+		},
 		Example: fmt.Sprintf(`%[1]s scope scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel
 %[1]s scope 91978ba2-5f35-459a-86a7-feca1b0512e0
 %[1]s scope session1qxge0zaztu65tx5x5llv5xc9zts9sqlch3sxwn44j50jzgt8rshvqyfrjcr
 %[1]s scope record1q2ge0zaztu65tx5x5llv5xc9ztsw42dq2jdvmdazuwzcaddhh8gmu3mcze3
-%[1]s scope all`, cmdStart),
+%[1]s scope all
+%[1]s scopes --%[2]s scopespec1qnwg86nsatx5pl56muw0v9ytlz3qu3jx6m --%[3]s pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42 --%[4]s owner`,
+			cmdStart, FlagSpecification, FlagOwner, FlagRole),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				return outputScopes(cmd)
+			}
 			arg0 := strings.TrimSpace(args[0])
 			if arg0 == all {
 				return outputScopesAll(cmd)
@@ -245,16 +282,31 @@ func GetMetadataScopeCmd() *cobra.Command {
 	addIncludeSessionsFlag(cmd)
 	addIncludeRecordsFlag(cmd)
 	addIncludeRequestFlag(cmd)
+	cmd.Flags().String(FlagSpecification, "", "only include scopes with this scope specification id or uuid")
+	cmd.Flags().String(FlagOwner, "", "only include scopes with this owner address")
+	cmd.Flags().String(FlagRole, "", "only include scopes where the --owner has this party type, e.g. owner, servicer")
+	cmd.Flags().String(FlagValueOwner, "", "only include scopes with this value owner address")
+	cmd.Flags().String(FlagDataAccess, "", "only include scopes with this address in their data access list")
 	flags.AddQueryFlagsToCmd(cmd)
-	flags.AddPaginationFlagsToCmd(cmd, "scopes (all)")
+	flags.AddPaginationFlagsToCmd(cmd, "scopes (all or filtered)")
 
 	return cmd
 }
 
+// hasScopeFilterFlags returns true if any of the scope filter flags were provided to the command.
+func hasScopeFilterFlags(cmd *cobra.Command) bool {
+	for _, name := range []string{FlagSpecification, FlagOwner, FlagRole, FlagValueOwner, FlagDataAccess} {
+		if cmd.Flags().Changed(name) {
+			return true
+		}
+	}
+	return false
+}
+
 // GetMetadataSessionCmd returns the command handler for metadata session querying.
 func GetMetadataSessionCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "session {session_id|{scope_id|scope_uuid} [session_uuid|record_name]|record_id|\"all\"}",
+		Use:     "session {session_id|{scope_id|scope_uuid} [session_uuid|record_name]|record_id|\"all\"|--party address}",
 		Aliases: []string{"se", "sessions"},
 		Short:   "Query the current metadata for sessions",
 		Long: fmt.Sprintf(`%[1]s session {session_id} - gets the session with the given id.
@@ -265,8 +317,21 @@ func GetMetadataSessionCmd() *cobra.Command {
 %[1]s session {scope_uuid} {session_uuid} - gets a session with the given scope uuid and session uuid.
 %[1]s session {scope_uuid} {record_name} - gets the session in the given scope containing the given record.
 %[1]s session {record_id} - gets the session containing the given record.
-%[1]s session all - gets all sessions.`, cmdStart),
-		Args: cobra.RangeArgs(1, 2),
+%[1]s session all - gets all sessions.
+%[1]s sessions --%[2]s {address} [--%[3]s {party type}] - gets the sessions that the address is a party to,
+    optionally only where it has the given party type.`, cmdStart, FlagParty, FlagRole),
+		Args: func(cmd *cobra.Command, args []string) error {
+			if cmd.Flags().Changed(FlagRole) && !cmd.Flags().Changed(FlagParty) {
+				return fmt.Errorf("the --%s flag can only be used with the --%s flag", FlagRole, FlagParty)
+			}
+			if cmd.Flags().Changed(FlagParty) {
+				if len(args) > 0 {
+					return fmt.Errorf("no arguments are allowed with the --%s flag, received %d", FlagParty, len(args))
+				}
+				return nil
+			}
+			return cobra.RangeArgs(1, 2)(cmd, args)
+		},
 		Example: fmt.Sprintf(`%[1]s session session1qxge0zaztu65tx5x5llv5xc9zts9sqlch3sxwn44j50jzgt8rshvqyfrjcr
 %[1]s session scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel
 %[1]s session scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel 5803f8bc-6067-4eb5-951f-2121671c2ec0
@@ -275,8 +340,12 @@ func GetMetadataSessionCmd() *cobra.Command {
 %[1]s session 91978ba2-5f35-459a-86a7-feca1b0512e0 5803f8bc-6067-4eb5-951f-2121671c2ec0
 %[1]s session 91978ba2-5f35-459a-86a7-feca1b0512e0 recordname
 %[1]s session record1q2ge0zaztu65tx5x5llv5xc9ztsw42dq2jdvmdazuwzcaddhh8gmu3mcze3
-%[1]s session all`, cmdStart),
+%[1]s session all
+%[1]s sessions --%[2]s pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42 --%[3]s servicer`, cmdStart, FlagParty, FlagRole),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				return outputSessionsByParty(cmd)
+			}
 			arg0 := strings.TrimSpace(args[0])
 			if arg0 == all {
 				return outputSessionsAll(cmd)
@@ -306,8 +375,10 @@ func GetMetadataSessionCmd() *cobra.Command {
 	addIncludeScopeFlag(cmd)
 	addIncludeRecordsFlag(cmd)
 	addIncludeRequestFlag(cmd)
+	cmd.Flags().String(FlagParty, "", "get the sessions that this address is a party to")
+	cmd.Flags().String(FlagRole, "", "only include sessions where the --party has this party type, e.g. owner, servicer")
 	flags.AddQueryFlagsToCmd(cmd)
-	flags.AddPaginationFlagsToCmd(cmd, "sessions (all)")
+	flags.AddPaginationFlagsToCmd(cmd, "sessions (all or by party)")
 
 	return cmd
 }
@@ -524,20 +595,202 @@ func GetValueOwnershipCmd() *cobra.Command {
 	return cmd
 }
 
+// GetValueOwnerTokenCmd returns the command handler for querying the value owner token of a scope.
+func GetValueOwnerTokenCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "valuetoken {scope_id|denom}",
+		Aliases: []string{"vt", "valueownertoken"},
+		Short:   "Query the value owner token of a scope",
+		Long: fmt.Sprintf(`%[1]s valuetoken {scope_id} - gets the value owner token of the scope with the given scope id.
+%[1]s valuetoken {denom} - gets the scope and value owner token for the given value owner token denom.`, cmdStart),
+		Args: cobra.ExactArgs(1),
+		Example: fmt.Sprintf(`%[1]s valuetoken scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel
+%[1]s valuetoken %[2]sscope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel`, cmdStart, types.ValueOwnerTokenDenomPrefix),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			arg := strings.TrimSpace(args[0])
+			if len(arg) == 0 {
+				return fmt.Errorf("empty scope id or denom")
+			}
+			if strings.HasPrefix(arg, types.ValueOwnerTokenDenomPrefix) {
+				return outputValueOwnerToken(cmd, "", arg)
+			}
+			return outputValueOwnerToken(cmd, arg, "")
+		},
+	}
+
+	addIncludeRequestFlag(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetScopeSaleOfferCmd returns the command handler for querying the sale offer of a scope.
+func GetScopeSaleOfferCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "saleoffer {scope_id|scope_uuid}",
+		Aliases: []string{"so", "scopesaleoffer"},
+		Short:   "Query the open offer to sell the value ownership of a scope",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf(`%[1]s saleoffer scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel
+%[1]s saleoffer 91978ba2-5f35-459a-86a7-feca1b0512e0`, cmdStart),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			arg := strings.TrimSpace(args[0])
+			if len(arg) == 0 {
+				return fmt.Errorf("empty scope id")
+			}
+			return outputScopeSaleOffer(cmd, arg)
+		},
+	}
+
+	addIncludeRequestFlag(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetRecordHistoryCmd returns the command handler for querying the retained versions of a record.
+func GetRecordHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "recordhistory {record_id|{scope_id|scope_uuid} record_name}",
+		Aliases: []string{"rh", "record-history"},
+		Short:   "Query the retained versions of a record",
+		Long: fmt.Sprintf(`%[1]s recordhistory {record_id} - gets the retained versions of the record with the given id.
+%[1]s recordhistory {scope_id} {record_name} - gets the retained versions of the record with the given name in the given scope.
+%[1]s recordhistory {scope_uuid} {record_name} - gets the retained versions of the record with the given name in the given scope.
+
+Versions are listed oldest first and are only retained while the max record versions param is greater than zero.`, cmdStart),
+		Args: cobra.RangeArgs(1, 2),
+		Example: fmt.Sprintf(`%[1]s recordhistory record1q2ge0zaztu65tx5x5llv5xc9ztsw42dq2jdvmdazuwzcaddhh8gmu3mcze3
+%[1]s recordhistory scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel recordname
+%[1]s recordhistory 91978ba2-5f35-459a-86a7-feca1b0512e0 recordname`, cmdStart),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			req := types.RecordHistoryRequest{}
+			if len(args) == 1 {
+				req.RecordAddr = strings.TrimSpace(args[0])
+			} else {
+				req.ScopeId = strings.TrimSpace(args[0])
+				req.Name = strings.TrimSpace(args[1])
+			}
+			return outputRecordHistory(cmd, &req)
+		},
+	}
+
+	addIncludeRequestFlag(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "record versions")
+
+	return cmd
+}
+
+// GetScopeSpecMigrationCmd returns the command handler for checking whether a scope can be moved to a scope specification.
+func GetScopeSpecMigrationCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "specmigration {scope_id|scope_uuid} {scope_spec_id|scope_spec_uuid}",
+		Aliases: []string{"sm", "spec-migration"},
+		Short:   "Check whether a scope can be migrated to a scope specification",
+		Long: `Check whether a scope can be migrated to a scope specification.
+The scope's owners, sessions, and records are checked against the scope specification and any incompatibilities are listed.
+Signatures are not checked.`,
+		Args: cobra.ExactArgs(2),
+		Example: fmt.Sprintf(`%[1]s specmigration scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel scopespec1qnwg86nsatx5pl56muw0v9ytlz3qu3jx6m
+%[1]s specmigration 91978ba2-5f35-459a-86a7-feca1b0512e0 dc83ea70-eacd-40fe-9adf-1cf6148bf8a2`, cmdStart),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			scopeID := strings.TrimSpace(args[0])
+			if len(scopeID) == 0 {
+				return fmt.Errorf("empty scope id")
+			}
+			specID := strings.TrimSpace(args[1])
+			if len(specID) == 0 {
+				return fmt.Errorf("empty specification id")
+			}
+			return outputScopeSpecMigration(cmd, scopeID, specID)
+		},
+	}
+
+	addIncludeRequestFlag(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetExportSpecsCmd returns the command handler for exporting specifications in the format used by apply-specs.
+func GetExportSpecsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "export-specs {scope_spec_id|contract_spec_id} [{scope_spec_id|contract_spec_id} ...]",
+		Aliases: []string{"export-specifications"},
+		Short:   "Export specifications in the format used by apply-specs",
+		Long: fmt.Sprintf(`Export specifications in the format used by %[1]s tx metadata apply-specs.
+A scope specification is exported with all of its contract specifications,
+and a contract specification is exported with all of its record specifications.
+The output is yaml by default; use --output json for json.`, version.AppName),
+		Args: cobra.MinimumNArgs(1),
+		Example: fmt.Sprintf(`%[1]s export-specs scopespec1qnwg86nsatx5pl56muw0v9ytlz3qu3jx6m > specs.yaml
+%[1]s export-specs contractspec1q000d0q2e8w5say53afqdesxp2zqzkr4fn --output json`, cmdStart),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ids := make([]string, len(args))
+			for i, arg := range args {
+				ids[i] = strings.TrimSpace(arg)
+			}
+			return outputExportSpecs(cmd, ids)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetVerifyCmd returns the command handler for verifying off-chain objects against the hashes in records.
+func GetVerifyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "verify {scope_id|scope_uuid|record_id}",
+		Aliases: []string{"v", "verify-hashes"},
+		Short:   "Verify that off-chain objects match the hashes recorded on chain",
+		Long: fmt.Sprintf(`%[1]s verify {scope_id} - verifies the hashed inputs and outputs of all records in that scope.
+%[1]s verify {scope_uuid} - verifies the hashed inputs and outputs of all records in that scope.
+%[1]s verify {record_id} - verifies the hashed inputs and outputs of that record.
+
+Objects are fetched from the object store locators of the scope's owners, using http(s) and file locator uris.
+Objects are requested by their hash, base64 encoded with the url-safe alphabet.
+Use --%[2]s to fetch all objects from a local directory instead.
+Each http(s) request fails if it takes longer than --%[3]s.
+A report of every checked object is output, and an error is returned if any objects are missing or do not match.`,
+			cmdStart, FlagObjectStoreDir, FlagTimeout),
+		Args: cobra.ExactArgs(1),
+		Example: fmt.Sprintf(`%[1]s verify scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel
+%[1]s verify record1q2ge0zaztu65tx5x5llv5xc9ztsw42dq2jdvmdazuwzcaddhh8gmu3mcze3 --%[2]s ./objects`,
+			cmdStart, FlagObjectStoreDir),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id := strings.TrimSpace(args[0])
+			if len(id) == 0 {
+				return fmt.Errorf("empty id")
+			}
+			return outputVerify(cmd, id)
+		},
+	}
+
+	cmd.Flags().String(FlagObjectStoreDir, "", "a local directory to fetch all objects from")
+	cmd.Flags().Duration(FlagTimeout, verify.DefaultHTTPTimeout, "the maximum time to wait for each object store request")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetOSLocatorCmd returns the command handler for metadata object store locator querying.
 func GetOSLocatorCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "locator {owner|scope_id|scope_uuid|uri|\"params\"|\"all\"}",
 		Aliases: []string{"l", "locators"},
 		Short:   "Query the current metadata for object store locators",
-		Long: fmt.Sprintf(`%[1]s locator {owner} - gets the object store locator for that owner.
-%[1]s locator {scope_id} - gets object store locators for all the owners of that scope.
-%[1]s locator {scope_uuid} - gets object store locators for all the owners of that scope.
+		Long: fmt.Sprintf(`%[1]s locator {owner} - gets the object store locators for that owner, or just the one named with --name.
+%[1]s locator {scope_id} - gets the active object store locators for all the owners of that scope.
+%[1]s locator {scope_uuid} - gets the active object store locators for all the owners of that scope.
 %[1]s locator {uri} - gets object store locators with that uri.
 %[1]s locator params - gets the object store locator params.
 %[1]s locator all - gets all object store locators.`, cmdStart),
 		Args: cobra.ExactArgs(1),
 		Example: fmt.Sprintf(`%[1]s locator pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42
+%[1]s locator pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42 --name backup
 %[1]s locator scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel
 %[1]s locator 91978ba2-5f35-459a-86a7-feca1b0512e0
 %[1]s locator https://provenance.io/
@@ -561,7 +814,11 @@ func GetOSLocatorCmd() *cobra.Command {
 			// Okay... maybe check for a generic bech32.
 			_, _, bech32Err := bech32.DecodeAndConvert(arg0)
 			if bech32Err == nil {
-				return outputOSLocator(cmd, arg0)
+				name, err := cmd.Flags().GetString(FlagLocatorName)
+				if err != nil {
+					return err
+				}
+				return outputOSLocator(cmd, arg0, name)
 			}
 			// Well maybe a UUID?
 			_, uuidErr := uuid.Parse(arg0)
@@ -574,6 +831,7 @@ func GetOSLocatorCmd() *cobra.Command {
 	}
 
 	addIncludeRequestFlag(cmd)
+	cmd.Flags().String(FlagLocatorName, "", "the name of a single locator to get for an owner")
 	flags.AddQueryFlagsToCmd(cmd)
 	flags.AddPaginationFlagsToCmd(cmd, "locators (all)")
 
@@ -656,6 +914,93 @@ func outputScopesAll(cmd *cobra.Command) error {
 	return clientCtx.PrintProto(res)
 }
 
+// outputScopes calls the Scopes query using the filter flags and outputs the response.
+func outputScopes(cmd *cobra.Command) error {
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return err
+	}
+	pageReq, e := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+	if e != nil {
+		return e
+	}
+	req := types.ScopesRequest{Pagination: pageReq}
+	if req.SpecificationId, err = cmd.Flags().GetString(FlagSpecification); err != nil {
+		return err
+	}
+	if req.Owner, err = cmd.Flags().GetString(FlagOwner); err != nil {
+		return err
+	}
+	if req.ValueOwner, err = cmd.Flags().GetString(FlagValueOwner); err != nil {
+		return err
+	}
+	if req.DataAccess, err = cmd.Flags().GetString(FlagDataAccess); err != nil {
+		return err
+	}
+	role, err := cmd.Flags().GetString(FlagRole)
+	if err != nil {
+		return err
+	}
+	if len(role) > 0 {
+		roleVal, ok := types.PartyType_value[fmt.Sprintf("PARTY_TYPE_%s", strings.ToUpper(strings.TrimSpace(role)))]
+		if !ok {
+			return fmt.Errorf("unknown party type: %s", role)
+		}
+		req.OwnerRole = types.PartyType(roleVal)
+	}
+
+	queryClient := types.NewQueryClient(clientCtx)
+	res, err := queryClient.Scopes(context.Background(), &req)
+	if err != nil {
+		return err
+	}
+
+	if !includeRequest {
+		res.Request = nil
+	}
+
+	return clientCtx.PrintProto(res)
+}
+
+// outputSessionsByParty calls the SessionsByParty query and outputs the response.
+func outputSessionsByParty(cmd *cobra.Command) error {
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return err
+	}
+	pageReq, e := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+	if e != nil {
+		return e
+	}
+	req := types.SessionsByPartyRequest{Pagination: pageReq}
+	if req.Party, err = cmd.Flags().GetString(FlagParty); err != nil {
+		return err
+	}
+	role, err := cmd.Flags().GetString(FlagRole)
+	if err != nil {
+		return err
+	}
+	if len(role) > 0 {
+		roleVal, ok := types.PartyType_value[fmt.Sprintf("PARTY_TYPE_%s", strings.ToUpper(strings.TrimSpace(role)))]
+		if !ok {
+			return fmt.Errorf("unknown party type: %s", role)
+		}
+		req.Role = types.PartyType(roleVal)
+	}
+
+	queryClient := types.NewQueryClient(clientCtx)
+	res, err := queryClient.SessionsByParty(context.Background(), &req)
+	if err != nil {
+		return err
+	}
+
+	if !includeRequest {
+		res.Request = nil
+	}
+
+	return clientCtx.PrintProto(res)
+}
+
 // outputSessions calls the Sessions query and outputs the response.
 func outputSessions(cmd *cobra.Command, scopeID, sessionID, recordID, recordName string) error {
 	clientCtx, err := client.GetClientQueryContext(cmd)
@@ -821,6 +1166,146 @@ func outputValueOwnership(cmd *cobra.Command, address string) error {
 	return clientCtx.PrintProto(res)
 }
 
+// outputValueOwnerToken calls the ScopeValueOwnerToken query and outputs the response.
+func outputValueOwnerToken(cmd *cobra.Command, scopeID string, denom string) error {
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return err
+	}
+	queryClient := types.NewQueryClient(clientCtx)
+	res, err := queryClient.ScopeValueOwnerToken(
+		context.Background(),
+		&types.ScopeValueOwnerTokenRequest{ScopeId: scopeID, Denom: denom},
+	)
+	if err != nil {
+		return err
+	}
+
+	if !includeRequest {
+		res.Request = nil
+	}
+
+	return clientCtx.PrintProto(res)
+}
+
+// outputScopeSaleOffer calls the ScopeSaleOffer query and outputs the response.
+func outputScopeSaleOffer(cmd *cobra.Command, scopeID string) error {
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return err
+	}
+	queryClient := types.NewQueryClient(clientCtx)
+	res, err := queryClient.ScopeSaleOffer(context.Background(), &types.ScopeSaleOfferRequest{ScopeId: scopeID})
+	if err != nil {
+		return err
+	}
+
+	if !includeRequest {
+		res.Request = nil
+	}
+
+	return clientCtx.PrintProto(res)
+}
+
+// outputScopeSpecMigration calls the CheckScopeSpecificationMigration query and outputs the response.
+func outputScopeSpecMigration(cmd *cobra.Command, scopeID, specID string) error {
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return err
+	}
+	queryClient := types.NewQueryClient(clientCtx)
+	req := types.CheckScopeSpecificationMigrationRequest{ScopeId: scopeID, SpecificationId: specID}
+	res, err := queryClient.CheckScopeSpecificationMigration(context.Background(), &req)
+	if err != nil {
+		return err
+	}
+
+	if !includeRequest {
+		res.Request = nil
+	}
+
+	return clientCtx.PrintProto(res)
+}
+
+// outputExportSpecs gets the requested specifications and outputs them in the format used by apply-specs.
+func outputExportSpecs(cmd *cobra.Command, ids []string) error {
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return err
+	}
+	file, err := exportSpecifications(context.Background(), types.NewQueryClient(clientCtx), ids)
+	if err != nil {
+		return err
+	}
+	out, err := MarshalSpecificationsFile(clientCtx.Codec, file)
+	if err != nil {
+		return err
+	}
+	return clientCtx.PrintRaw(out)
+}
+
+// outputVerify verifies the off-chain objects of a scope or record and outputs the report.
+func outputVerify(cmd *cobra.Command, id string) error {
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return err
+	}
+	dir, err := cmd.Flags().GetString(FlagObjectStoreDir)
+	if err != nil {
+		return err
+	}
+	timeout, err := cmd.Flags().GetDuration(FlagTimeout)
+	if err != nil {
+		return err
+	}
+	if timeout <= 0 {
+		return fmt.Errorf("the --%s must be greater than zero", FlagTimeout)
+	}
+	verifier := verify.NewVerifier(types.NewQueryClient(clientCtx))
+	verifier.Backends = verify.BackendsWithHTTPTimeout(timeout)
+	if len(dir) > 0 {
+		verifier.Override = verify.NewFileSystemBackend(dir)
+	}
+	report, err := verifier.Verify(context.Background(), id)
+	if err != nil {
+		return err
+	}
+	out, err := json.Marshal(report)
+	if err != nil {
+		return err
+	}
+	if err = clientCtx.PrintRaw(out); err != nil {
+		return err
+	}
+	if !report.OK() {
+		return fmt.Errorf("%d of %d objects failed verification", report.Failed, len(report.Results))
+	}
+	return nil
+}
+
+// outputRecordHistory calls the RecordHistory query and outputs the response.
+func outputRecordHistory(cmd *cobra.Command, req *types.RecordHistoryRequest) error {
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return err
+	}
+	req.Pagination, err = client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+	if err != nil {
+		return err
+	}
+	queryClient := types.NewQueryClient(clientCtx)
+	res, err := queryClient.RecordHistory(context.Background(), req)
+	if err != nil {
+		return err
+	}
+
+	if !includeRequest {
+		res.Request = nil
+	}
+
+	return clientCtx.PrintProto(res)
+}
+
 // outputScopeSpec calls the ScopeSpecification query and outputs the response.
 func outputScopeSpec(cmd *cobra.Command, specificationID string) error {
 	clientCtx, err := client.GetClientQueryContext(cmd)
@@ -1020,7 +1505,7 @@ func outputOSLocatorParams(cmd *cobra.Command) error {
 }
 
 // outputOSLocator calls the OSLocator query and outputs the response.
-func outputOSLocator(cmd *cobra.Command, owner string) error {
+func outputOSLocator(cmd *cobra.Command, owner, name string) error {
 	clientCtx, err := client.GetClientQueryContext(cmd)
 	if err != nil {
 		return err
@@ -1028,7 +1513,7 @@ func outputOSLocator(cmd *cobra.Command, owner string) error {
 	queryClient := types.NewQueryClient(clientCtx)
 	res, err := queryClient.OSLocator(
 		context.Background(),
-		&types.OSLocatorRequest{Owner: owner},
+		&types.OSLocatorRequest{Owner: owner, Name: name},
 	)
 	if err != nil {
 		return err
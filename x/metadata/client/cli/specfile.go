@@ -0,0 +1,226 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// SpecificationsFile is the content of a file used by the apply-specs and export-specs commands.
+type SpecificationsFile struct {
+	ScopeSpecifications    []types.ScopeSpecification
+	ContractSpecifications []types.ContractSpecification
+	RecordSpecifications   []types.RecordSpecification
+}
+
+// specificationsFileJSON is the json layout of a SpecificationsFile.
+// Each entry is the proto json of the specification.
+type specificationsFileJSON struct {
+	ScopeSpecifications    []json.RawMessage `json:"scope_specifications,omitempty"`
+	ContractSpecifications []json.RawMessage `json:"contract_specifications,omitempty"`
+	RecordSpecifications   []json.RawMessage `json:"record_specifications,omitempty"`
+}
+
+// ParseSpecificationsFile parses the yaml or json contents of a specifications file.
+func ParseSpecificationsFile(cdc codec.JSONCodec, contents []byte) (*SpecificationsFile, error) {
+	jsonContents, err := yaml.YAMLToJSON(contents)
+	if err != nil {
+		return nil, err
+	}
+	var raw specificationsFileJSON
+	if err = json.Unmarshal(jsonContents, &raw); err != nil {
+		return nil, err
+	}
+
+	rv := &SpecificationsFile{
+		ScopeSpecifications:    make([]types.ScopeSpecification, len(raw.ScopeSpecifications)),
+		ContractSpecifications: make([]types.ContractSpecification, len(raw.ContractSpecifications)),
+		RecordSpecifications:   make([]types.RecordSpecification, len(raw.RecordSpecifications)),
+	}
+	for i, bz := range raw.ScopeSpecifications {
+		if err = cdc.UnmarshalJSON(bz, &rv.ScopeSpecifications[i]); err != nil {
+			return nil, fmt.Errorf("invalid scope specification [%d]: %w", i, err)
+		}
+	}
+	for i, bz := range raw.ContractSpecifications {
+		if err = cdc.UnmarshalJSON(bz, &rv.ContractSpecifications[i]); err != nil {
+			return nil, fmt.Errorf("invalid contract specification [%d]: %w", i, err)
+		}
+	}
+	for i, bz := range raw.RecordSpecifications {
+		if err = cdc.UnmarshalJSON(bz, &rv.RecordSpecifications[i]); err != nil {
+			return nil, fmt.Errorf("invalid record specification [%d]: %w", i, err)
+		}
+	}
+	return rv, nil
+}
+
+// MarshalSpecificationsFile gets the json of a specifications file.
+func MarshalSpecificationsFile(cdc codec.JSONCodec, file *SpecificationsFile) ([]byte, error) {
+	var raw specificationsFileJSON
+	for i := range file.ScopeSpecifications {
+		bz, err := cdc.MarshalJSON(&file.ScopeSpecifications[i])
+		if err != nil {
+			return nil, err
+		}
+		raw.ScopeSpecifications = append(raw.ScopeSpecifications, bz)
+	}
+	for i := range file.ContractSpecifications {
+		bz, err := cdc.MarshalJSON(&file.ContractSpecifications[i])
+		if err != nil {
+			return nil, err
+		}
+		raw.ContractSpecifications = append(raw.ContractSpecifications, bz)
+	}
+	for i := range file.RecordSpecifications {
+		bz, err := cdc.MarshalJSON(&file.RecordSpecifications[i])
+		if err != nil {
+			return nil, err
+		}
+		raw.RecordSpecifications = append(raw.RecordSpecifications, bz)
+	}
+	return json.Marshal(raw)
+}
+
+// exportSpecifications gets a specifications file with the requested specifications.
+// A scope specification is exported with all of its contract specifications,
+// and a contract specification is exported with all of its record specifications.
+func exportSpecifications(ctx context.Context, queryClient types.QueryClient, ids []string) (*SpecificationsFile, error) {
+	rv := &SpecificationsFile{}
+	contractSpecsSeen := map[string]bool{}
+	addContractSpec := func(id string) error {
+		res, err := queryClient.ContractSpecification(ctx, &types.ContractSpecificationRequest{SpecificationId: id, IncludeRecordSpecs: true})
+		if err != nil {
+			return err
+		}
+		if res.ContractSpecification == nil || res.ContractSpecification.Specification == nil {
+			return fmt.Errorf("contract specification %s not found", id)
+		}
+		spec := res.ContractSpecification.Specification
+		if contractSpecsSeen[spec.SpecificationId.String()] {
+			return nil
+		}
+		contractSpecsSeen[spec.SpecificationId.String()] = true
+		rv.ContractSpecifications = append(rv.ContractSpecifications, *spec)
+		for _, rSpec := range res.RecordSpecifications {
+			if rSpec != nil && rSpec.Specification != nil {
+				rv.RecordSpecifications = append(rv.RecordSpecifications, *rSpec.Specification)
+			}
+		}
+		return nil
+	}
+
+	for _, id := range ids {
+		addr, err := types.MetadataAddressFromBech32(id)
+		if err != nil {
+			return nil, fmt.Errorf("invalid specification id %q: %w", id, err)
+		}
+		switch {
+		case addr.IsScopeSpecificationAddress():
+			res, err := queryClient.ScopeSpecification(ctx, &types.ScopeSpecificationRequest{SpecificationId: id})
+			if err != nil {
+				return nil, err
+			}
+			if res.ScopeSpecification == nil || res.ScopeSpecification.Specification == nil {
+				return nil, fmt.Errorf("scope specification %s not found", id)
+			}
+			spec := res.ScopeSpecification.Specification
+			rv.ScopeSpecifications = append(rv.ScopeSpecifications, *spec)
+			for _, cSpecID := range spec.ContractSpecIds {
+				if err = addContractSpec(cSpecID.String()); err != nil {
+					return nil, err
+				}
+			}
+		case addr.IsContractSpecificationAddress():
+			if err = addContractSpec(id); err != nil {
+				return nil, err
+			}
+		default:
+			return nil, fmt.Errorf("id %q is not a scope or contract specification id", id)
+		}
+	}
+	return rv, nil
+}
+
+// specificationChanges compares the specifications in a file with those on chain and gets the messages needed
+// to make the chain match the file. Record specifications of a contract specification in the file are
+// deleted if they are not also in the file. Nothing else is deleted.
+// Contract specifications are written first, then record specifications, then scope specifications.
+func specificationChanges(
+	ctx context.Context,
+	cdc codec.Codec,
+	queryClient types.QueryClient,
+	file *SpecificationsFile,
+	signers []string,
+) ([]sdk.Msg, error) {
+	var msgs []sdk.Msg
+
+	inFile := map[string]bool{}
+	for _, rSpec := range file.RecordSpecifications {
+		inFile[rSpec.SpecificationId.String()] = true
+	}
+	var deletes []sdk.Msg
+	for i := range file.ContractSpecifications {
+		spec := file.ContractSpecifications[i]
+		res, err := queryClient.ContractSpecification(ctx, &types.ContractSpecificationRequest{
+			SpecificationId:    spec.SpecificationId.String(),
+			IncludeRecordSpecs: true,
+		})
+		if err != nil {
+			return nil, err
+		}
+		var existing *types.ContractSpecification
+		if res.ContractSpecification != nil {
+			existing = res.ContractSpecification.Specification
+		}
+		if existing == nil || !sameSpec(cdc, existing, &spec) {
+			msgs = append(msgs, types.NewMsgWriteContractSpecificationRequest(spec, signers))
+		}
+		for _, rSpec := range res.RecordSpecifications {
+			if rSpec != nil && rSpec.Specification != nil && !inFile[rSpec.Specification.SpecificationId.String()] {
+				deletes = append(deletes, types.NewMsgDeleteRecordSpecificationRequest(rSpec.Specification.SpecificationId, signers))
+			}
+		}
+	}
+
+	for i := range file.RecordSpecifications {
+		spec := file.RecordSpecifications[i]
+		res, err := queryClient.RecordSpecification(ctx, &types.RecordSpecificationRequest{SpecificationId: spec.SpecificationId.String()})
+		if err != nil {
+			return nil, err
+		}
+		if res.RecordSpecification == nil || res.RecordSpecification.Specification == nil ||
+			!sameSpec(cdc, res.RecordSpecification.Specification, &spec) {
+			msgs = append(msgs, types.NewMsgWriteRecordSpecificationRequest(spec, signers))
+		}
+	}
+	msgs = append(msgs, deletes...)
+
+	for i := range file.ScopeSpecifications {
+		spec := file.ScopeSpecifications[i]
+		res, err := queryClient.ScopeSpecification(ctx, &types.ScopeSpecificationRequest{SpecificationId: spec.SpecificationId.String()})
+		if err != nil {
+			return nil, err
+		}
+		if res.ScopeSpecification == nil || res.ScopeSpecification.Specification == nil ||
+			!sameSpec(cdc, res.ScopeSpecification.Specification, &spec) {
+			msgs = append(msgs, types.NewMsgWriteScopeSpecificationRequest(spec, signers))
+		}
+	}
+
+	return msgs, nil
+}
+
+// sameSpec returns true if the two specifications have the same encoding.
+func sameSpec(cdc codec.Codec, a, b codec.ProtoMarshaler) bool {
+	aBz, aErr := cdc.Marshal(a)
+	bBz, bErr := cdc.Marshal(b)
+	return aErr == nil && bErr == nil && string(aBz) == string(bBz)
+}
@@ -1,9 +1,13 @@
 package cli
 
 import (
+	"context"
 	"encoding/base64"
 	"fmt"
+	"os"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/spf13/cobra"
@@ -12,6 +16,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 
 	"github.com/provenance-io/provenance/x/metadata/types"
 
@@ -19,9 +24,20 @@ import (
 )
 
 const (
-	FlagSigners  = "signers"
-	AddSwitch    = "add"
-	RemoveSwitch = "remove"
+	FlagSigners       = "signers"
+	FlagBuyer         = "buyer"
+	FlagLocatorName   = "name"
+	FlagEncryptionKey = "encryption-key"
+	FlagPriority      = "priority"
+	FlagRetired       = "retired"
+	FlagKeyValidFrom  = "key-valid-from"
+	FlagKeyValidUntil = "key-valid-until"
+	FlagAllowedMsgs   = "allowed-msgs"
+	FlagScopes        = "scopes"
+	FlagMaxUses       = "max-uses"
+	FlagExpiration    = "expiration"
+	AddSwitch         = "add"
+	RemoveSwitch      = "remove"
 )
 
 // NewTxCmd is the top-level command for Metadata CLI transactions.
@@ -37,9 +53,15 @@ func NewTxCmd() *cobra.Command {
 
 	txCmd.AddCommand(
 		WriteScopeCmd(),
+		WriteScopeBundleCmd(),
 		RemoveScopeCmd(),
 		AddRemoveScopeDataAccessCmd(),
 		AddRemoveScopeOwnersCmd(),
+		TokenizeScopeValueOwnerCmd(),
+		OfferScopeSaleCmd(),
+		CancelScopeSaleCmd(),
+		AcceptScopeSaleCmd(),
+		MigrateScopeSpecificationCmd(),
 
 		BindOsLocatorCmd(),
 		RemoveOsLocatorCmd(),
@@ -57,10 +79,14 @@ func NewTxCmd() *cobra.Command {
 		WriteRecordSpecificationCmd(),
 		RemoveRecordSpecificationCmd(),
 
+		ApplySpecificationsCmd(),
+
 		WriteSessionCmd(),
 
 		WriteRecordCmd(),
 		RemoveRecordCmd(),
+
+		GrantScopeAuthorizationCmd(),
 	)
 
 	return txCmd
@@ -127,6 +153,53 @@ func WriteScopeCmd() *cobra.Command {
 	return cmd
 }
 
+// WriteScopeBundleCmd creates a command for adding or updating several scopes, with their sessions and records, at once.
+func WriteScopeBundleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "write-scope-bundle [bundle-file] [flags]",
+		Short: "Add/Update several scopes, with their sessions and records, in a single message",
+		Long: `Add/Update several scopes, with their sessions and records, in a single message.
+The bundle file is a JSON MsgWriteScopeBundleRequest, e.g. {"bundles":[{"scope":{...},"sessions":[...],"records":[...]}]}.
+Any signers in the file are replaced by the --signers flag (or the --from address).
+Either every scope, session, and record is written, or none of them are.`,
+		Example: fmt.Sprintf(`$ %[1]s tx metadata write-scope-bundle loans.json`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contents, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var msg types.MsgWriteScopeBundleRequest
+			if err = clientCtx.Codec.UnmarshalJSON(contents, &msg); err != nil {
+				return fmt.Errorf("invalid bundle file %s: %w", args[0], err)
+			}
+
+			msg.Signers, err = parseSigners(cmd, &clientCtx)
+			if err != nil {
+				return err
+			}
+
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	addSignerFlagCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // RemoveScopeCmd creates a command for removing a scope.
 func RemoveScopeCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -171,7 +244,11 @@ func AddRemoveScopeDataAccessCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "scope-data-access {add|remove} [scope-id] [data-access]",
 		Short: "Add or remove a metadata scope data access on to the provenance blockchain",
+		Long: `Add or remove a metadata scope data access on to the provenance blockchain.
+When adding, the --role and --expiration flags can be used to label the access and have it lapse.
+The expiration can be an RFC3339 timestamp or a duration from now, e.g. 720h.`,
 		Example: fmt.Sprintf(`$ %[1]s tx metadata scope-data-access add scope1qzhpuff00wpy2yuf7xr0rp8aucqstsk0cn pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42
+									 $ %[1]s tx metadata scope-data-access add scope1qzhpuff00wpy2yuf7xr0rp8aucqstsk0cn pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42 --role auditor --expiration 2030-01-01T00:00:00Z
 									 $ %[1]s tx metadata scope-data-access remove scope1qzhpuff00wpy2yuf7xr0rp8aucqstsk0cn pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42`, version.AppName),
 		Args: cobra.ExactArgs(3),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -203,7 +280,22 @@ func AddRemoveScopeDataAccessCmd() *cobra.Command {
 			dataAccess := strings.Split(args[2], ",")
 			var msg sdk.Msg
 			if removeOrAdd == AddSwitch {
-				msg = types.NewMsgAddScopeDataAccessRequest(scopeID, dataAccess, signers)
+				addMsg := types.NewMsgAddScopeDataAccessRequest(scopeID, dataAccess, signers)
+				if addMsg.Role, err = cmd.Flags().GetString(FlagRole); err != nil {
+					return err
+				}
+				expStr, err := cmd.Flags().GetString(FlagExpiration)
+				if err != nil {
+					return err
+				}
+				if len(expStr) > 0 {
+					expiration, err := parseExpiration(expStr)
+					if err != nil {
+						return err
+					}
+					addMsg.Expiration = &expiration
+				}
+				msg = addMsg
 			} else {
 				msg = types.NewMsgDeleteScopeDataAccessRequest(scopeID, dataAccess, signers)
 			}
@@ -216,6 +308,8 @@ func AddRemoveScopeDataAccessCmd() *cobra.Command {
 	}
 
 	addSignerFlagCmd(cmd)
+	cmd.Flags().String(FlagRole, "", "a label for the purpose of the data access being added, e.g. auditor")
+	cmd.Flags().String(FlagExpiration, "", "when the data access being added lapses, as an RFC3339 timestamp or a duration from now")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -280,13 +374,285 @@ func AddRemoveScopeOwnersCmd() *cobra.Command {
 	return cmd
 }
 
+// TokenizeScopeValueOwnerCmd creates a command for replacing the value owner of a scope with a value owner token.
+func TokenizeScopeValueOwnerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tokenize-value-owner [scope-id] [supply]",
+		Short: "Replace the value owner of a scope with a marker-backed value owner token",
+		Long: `Replace the value owner of a scope with a marker-backed value owner token.
+The full supply of the token is given to the existing value owner. Use a supply of 1 for whole ownership,
+or a larger supply to allow fractional ownership. Value owner actions on the scope then require signatures
+from accounts that together hold the full supply of the token.`,
+		Example: fmt.Sprintf(`$ %[1]s tx metadata tokenize-value-owner scope1qzhpuff00wpy2yuf7xr0rp8aucqstsk0cn 1`, version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var scopeID types.MetadataAddress
+			scopeID, err = types.MetadataAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			supply, ok := sdk.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("invalid supply: %s", args[1])
+			}
+
+			signers, err := parseSigners(cmd, &clientCtx)
+			if err != nil {
+				return err
+			}
+
+			msg := *types.NewMsgTokenizeScopeValueOwnerRequest(scopeID, supply, signers)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	addSignerFlagCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// OfferScopeSaleCmd creates a command for offering to sell the value ownership of a scope.
+func OfferScopeSaleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "offer-scope-sale [scope-id] [price] [expiration]",
+		Short: "Offer to sell the value ownership of a scope",
+		Long: `Offer to sell the value ownership of a scope.
+The offer can be accepted by anyone, or only by the --buyer if provided, until it expires.
+The expiration is either an RFC3339 timestamp or a duration from now (e.g. 72h).
+When accepted, the price is paid to the current value owner and the buyer becomes the value owner.`,
+		Example: fmt.Sprintf(`$ %[1]s tx metadata offer-scope-sale scope1qzhpuff00wpy2yuf7xr0rp8aucqstsk0cn 1000nhash 72h
+$ %[1]s tx metadata offer-scope-sale scope1qzhpuff00wpy2yuf7xr0rp8aucqstsk0cn 1000nhash 2023-01-01T00:00:00Z --buyer pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42`, version.AppName),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var scopeID types.MetadataAddress
+			scopeID, err = types.MetadataAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			price, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid price: %w", err)
+			}
+
+			expiration, err := parseExpiration(args[2])
+			if err != nil {
+				return err
+			}
+
+			buyer, err := cmd.Flags().GetString(FlagBuyer)
+			if err != nil {
+				return err
+			}
+
+			signers, err := parseSigners(cmd, &clientCtx)
+			if err != nil {
+				return err
+			}
+
+			msg := *types.NewMsgOfferScopeSaleRequest(scopeID, buyer, price, expiration, signers)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().String(FlagBuyer, "", "the only address allowed to accept the offer")
+	addSignerFlagCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CancelScopeSaleCmd creates a command for cancelling an offer to sell the value ownership of a scope.
+func CancelScopeSaleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "cancel-scope-sale [scope-id]",
+		Short:   "Cancel an offer to sell the value ownership of a scope",
+		Example: fmt.Sprintf(`$ %[1]s tx metadata cancel-scope-sale scope1qzhpuff00wpy2yuf7xr0rp8aucqstsk0cn`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var scopeID types.MetadataAddress
+			scopeID, err = types.MetadataAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			signers, err := parseSigners(cmd, &clientCtx)
+			if err != nil {
+				return err
+			}
+
+			msg := *types.NewMsgCancelScopeSaleRequest(scopeID, signers)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	addSignerFlagCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// AcceptScopeSaleCmd creates a command for accepting an offer to sell the value ownership of a scope.
+func AcceptScopeSaleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accept-scope-sale [scope-id] [price]",
+		Short: "Accept an offer to sell the value ownership of a scope",
+		Long: `Accept an offer to sell the value ownership of a scope.
+The price must equal the offer price. It is paid by the --buyer, which defaults to the --from address,
+and the buyer becomes the value owner of the scope.`,
+		Example: fmt.Sprintf(`$ %[1]s tx metadata accept-scope-sale scope1qzhpuff00wpy2yuf7xr0rp8aucqstsk0cn 1000nhash`, version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var scopeID types.MetadataAddress
+			scopeID, err = types.MetadataAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			price, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid price: %w", err)
+			}
+
+			buyer, err := cmd.Flags().GetString(FlagBuyer)
+			if err != nil {
+				return err
+			}
+			if len(buyer) == 0 {
+				buyer = clientCtx.GetFromAddress().String()
+			}
+
+			signers, err := parseSigners(cmd, &clientCtx)
+			if err != nil {
+				return err
+			}
+
+			msg := *types.NewMsgAcceptScopeSaleRequest(scopeID, buyer, price, signers)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().String(FlagBuyer, "", "the address paying for the scope (defaults to the --from address)")
+	addSignerFlagCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// MigrateScopeSpecificationCmd creates a command for moving a scope to a different scope specification.
+func MigrateScopeSpecificationCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "migrate-scope-specification [scope-id] [scope-spec-id]",
+		Aliases: []string{"migrate-scope-spec"},
+		Short:   "Move a scope to a different scope specification",
+		Long: `Move a scope to a different scope specification.
+The scope's owners, sessions, and records must all be allowed by the new scope specification, and all owners must sign.
+Use the specmigration query to check a scope against a scope specification first.`,
+		Example: fmt.Sprintf(`$ %[1]s tx metadata migrate-scope-specification scope1qzhpuff00wpy2yuf7xr0rp8aucqstsk0cn scopespec1qnwg86nsatx5pl56muw0v9ytlz3qu3jx6m`, version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var scopeID types.MetadataAddress
+			scopeID, err = types.MetadataAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			var specID types.MetadataAddress
+			specID, err = types.MetadataAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			signers, err := parseSigners(cmd, &clientCtx)
+			if err != nil {
+				return err
+			}
+
+			msg := *types.NewMsgMigrateScopeSpecificationRequest(scopeID, specID, signers)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	addSignerFlagCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// parseExpiration parses either an RFC3339 timestamp or a duration from now.
+func parseExpiration(arg string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, arg); err == nil {
+		return t, nil
+	}
+	d, err := time.ParseDuration(arg)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid expiration %q: must be an RFC3339 timestamp or a duration", arg)
+	}
+	return time.Now().Add(d).UTC(), nil
+}
+
 // BindOsLocatorCmd creates a command for binding an owner to uri in the object store.
 func BindOsLocatorCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "bind-locator [owner] [uri]",
-		Short:   "Bind a uri to an owner address on the provenance blockchain",
-		Example: fmt.Sprintf(`$ %[1]s tx metadata bind-locator pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42 "http://foo.com"`, version.AppName),
-		Args:    cobra.ExactArgs(2),
+		Use:   "bind-locator [owner] [uri]",
+		Short: "Bind a uri to an owner address on the provenance blockchain",
+		Long: `Bind a uri to an owner address on the provenance blockchain.
+An owner can have several locators, each with a different --name. If no name is provided, "default" is used.`,
+		Example: fmt.Sprintf(`$ %[1]s tx metadata bind-locator pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42 "http://foo.com"
+$ %[1]s tx metadata bind-locator pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42 "http://backup.foo.com" --name backup --priority 2`, version.AppName),
+		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -301,12 +667,16 @@ func BindOsLocatorCmd() *cobra.Command {
 			objectStoreLocator := types.ObjectStoreLocator{
 				LocatorUri: args[1], Owner: args[0],
 			}
+			if err = parseOSLocatorFlags(cmd, &objectStoreLocator); err != nil {
+				return err
+			}
 
 			addOSLocator := *types.NewMsgBindOSLocatorRequest(objectStoreLocator)
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &addOSLocator)
 		},
 	}
 
+	addOSLocatorFlagsCmd(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -315,10 +685,11 @@ func BindOsLocatorCmd() *cobra.Command {
 // RemoveOsLocatorCmd creates a command for removing an object store locator entry.
 func RemoveOsLocatorCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "remove-locator [owner] [uri]",
-		Short:   "Remove an os locator already associated owner address on the provenance blockchain",
-		Example: fmt.Sprintf(`$ %[1]s tx metadata remove-locator pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42 "http://foo.com"`, version.AppName),
-		Args:    cobra.ExactArgs(2),
+		Use:   "remove-locator [owner] [uri]",
+		Short: "Remove an os locator already associated owner address on the provenance blockchain",
+		Example: fmt.Sprintf(`$ %[1]s tx metadata remove-locator pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42 "http://foo.com"
+$ %[1]s tx metadata remove-locator pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42 "http://backup.foo.com" --name backup`, version.AppName),
+		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -333,12 +704,17 @@ func RemoveOsLocatorCmd() *cobra.Command {
 			objectStoreLocator := types.ObjectStoreLocator{
 				LocatorUri: args[1], Owner: args[0],
 			}
+			objectStoreLocator.Name, err = cmd.Flags().GetString(FlagLocatorName)
+			if err != nil {
+				return err
+			}
 
 			deleteOSLocator := *types.NewMsgDeleteOSLocatorRequest(objectStoreLocator)
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &deleteOSLocator)
 		},
 	}
 
+	cmd.Flags().String(FlagLocatorName, "", "the name of the locator to remove (default \"default\")")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -347,10 +723,14 @@ func RemoveOsLocatorCmd() *cobra.Command {
 // ModifyOsLocatorCmd creates a command to modify the object store locator uri for an owner.
 func ModifyOsLocatorCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "modify-locator [owner] [uri]",
-		Short:   "Modify a uri already associated owner address on the provenance blockchain",
-		Example: fmt.Sprintf(`$ %[1]s tx metadata modify-locator pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42 "http://foo2.com"`, version.AppName),
-		Args:    cobra.ExactArgs(2),
+		Use:   "modify-locator [owner] [uri]",
+		Short: "Modify a uri already associated owner address on the provenance blockchain",
+		Long: `Modify a uri already associated owner address on the provenance blockchain.
+The uri of the locator with the provided --name (or "default") is replaced, along with any other locator flags provided.
+Fields without a flag keep their current values. Use --retired to stop using a locator without removing it.`,
+		Example: fmt.Sprintf(`$ %[1]s tx metadata modify-locator pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42 "http://foo2.com"
+$ %[1]s tx metadata modify-locator pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42 "http://foo.com" --retired --key-valid-until 2023-06-01T00:00:00Z`, version.AppName),
+		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -369,12 +749,38 @@ func ModifyOsLocatorCmd() *cobra.Command {
 			objectStoreLocator := types.ObjectStoreLocator{
 				LocatorUri: args[1], Owner: args[0],
 			}
+			if err = parseOSLocatorFlags(cmd, &objectStoreLocator); err != nil {
+				return err
+			}
+			retired, err := cmd.Flags().GetBool(FlagRetired)
+			if err != nil {
+				return err
+			}
+			if retired {
+				objectStoreLocator.State = types.ObjectStoreLocatorState_OBJECT_STORE_LOCATOR_STATE_RETIRED
+			}
 
-			modifyOSLocator := *types.NewMsgModifyOSLocatorRequest(objectStoreLocator)
+			updateFields := []string{types.OSLocatorFieldLocatorURI}
+			for flag, field := range map[string]string{
+				FlagEncryptionKey: types.OSLocatorFieldEncryptionKey,
+				FlagPriority:      types.OSLocatorFieldPriority,
+				FlagRetired:       types.OSLocatorFieldState,
+				FlagKeyValidFrom:  types.OSLocatorFieldKeyValidFrom,
+				FlagKeyValidUntil: types.OSLocatorFieldKeyValidUntil,
+			} {
+				if cmd.Flags().Changed(flag) {
+					updateFields = append(updateFields, field)
+				}
+			}
+			sort.Strings(updateFields)
+
+			modifyOSLocator := *types.NewMsgModifyOSLocatorRequest(objectStoreLocator, updateFields...)
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &modifyOSLocator)
 		},
 	}
 
+	addOSLocatorFlagsCmd(cmd)
+	cmd.Flags().Bool(FlagRetired, false, "mark the locator as retired")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -982,6 +1388,124 @@ func addSignerFlagCmd(cmd *cobra.Command) {
 	cmd.Flags().String(FlagSigners, "", "comma delimited list of bech32 addresses")
 }
 
+// GrantScopeAuthorizationCmd creates a command for granting a MetadataScopeAuthorization.
+func GrantScopeAuthorizationCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "grant-authz [grantee] [msg-type-url]",
+		Aliases: []string{"ga"},
+		Short:   "Grant an address permission to sign metadata messages on your behalf",
+		Long: fmt.Sprintf(`Grant an address permission to sign metadata messages on your behalf.
+The grant applies to the provided message type and the messages below it in the metadata message hierarchy.
+It can be limited to some of those message types with --%[1]s, to some scopes with --%[2]s or to the scopes of
+a scope specification with --%[3]s, and to a number of uses with --%[4]s.`,
+			FlagAllowedMsgs, FlagScopes, FlagSpecification, FlagMaxUses),
+		Example: fmt.Sprintf(`$ %[1]s tx metadata grant-authz pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42 %[2]s --%[3]s scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel --%[4]s 1
+$ %[1]s tx metadata grant-authz pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42 %[2]s --%[5]s %[6]s --%[7]s scopespec1qnwg86nsatx5pl56muw0v9ytlz3qu3jx6m`,
+			version.AppName, types.TypeURLMsgWriteScopeRequest, FlagScopes, FlagMaxUses,
+			FlagAllowedMsgs, types.TypeURLMsgAddScopeDataAccessRequest, FlagSpecification),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			authorization := types.NewMetadataScopeAuthorization(strings.TrimSpace(args[1]))
+			if authorization.AllowedMsgTypeUrls, err = cmd.Flags().GetStringSlice(FlagAllowedMsgs); err != nil {
+				return err
+			}
+			scopes, err := cmd.Flags().GetStringSlice(FlagScopes)
+			if err != nil {
+				return err
+			}
+			for _, scope := range scopes {
+				scopeID, serr := types.MetadataAddressFromBech32(scope)
+				if serr != nil {
+					return serr
+				}
+				authorization.ScopeIds = append(authorization.ScopeIds, scopeID)
+			}
+			spec, err := cmd.Flags().GetString(FlagSpecification)
+			if err != nil {
+				return err
+			}
+			if len(spec) > 0 {
+				if authorization.SpecificationId, err = types.MetadataAddressFromBech32(spec); err != nil {
+					return err
+				}
+			}
+			if authorization.MaxUses, err = cmd.Flags().GetUint64(FlagMaxUses); err != nil {
+				return err
+			}
+			expSec, err := cmd.Flags().GetInt64(FlagExpiration)
+			if err != nil {
+				return err
+			}
+
+			exp := time.Unix(expSec, 0)
+			msg, err := authz.NewMsgGrant(clientCtx.GetFromAddress(), grantee, authorization, &exp)
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().StringSlice(FlagAllowedMsgs, nil, "the message type urls that the grant is limited to")
+	cmd.Flags().StringSlice(FlagScopes, nil, "the scope ids that the grant is limited to")
+	cmd.Flags().String(FlagSpecification, "", "the scope specification id whose scopes the grant is limited to")
+	cmd.Flags().Uint64(FlagMaxUses, 0, "the number of times the grant can be used (default unlimited)")
+	cmd.Flags().Int64(FlagExpiration, time.Now().AddDate(1, 0, 0).Unix(), "The Unix timestamp. Default is one year.")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// addOSLocatorFlagsCmd adds the flags for the optional object store locator fields.
+func addOSLocatorFlagsCmd(cmd *cobra.Command) {
+	cmd.Flags().String(FlagLocatorName, "", "the name of the locator (default \"default\")")
+	cmd.Flags().String(FlagEncryptionKey, "", "the bech32 address of the owner's encryption key")
+	cmd.Flags().Uint32(FlagPriority, 0, "the priority of the locator, lower values are preferred")
+	cmd.Flags().String(FlagKeyValidFrom, "", "the RFC3339 time from which the encryption key is valid")
+	cmd.Flags().String(FlagKeyValidUntil, "", "the RFC3339 time after which the encryption key is no longer valid")
+}
+
+// parseOSLocatorFlags sets the optional object store locator fields from the flags added by addOSLocatorFlagsCmd.
+func parseOSLocatorFlags(cmd *cobra.Command, locator *types.ObjectStoreLocator) error {
+	var err error
+	if locator.Name, err = cmd.Flags().GetString(FlagLocatorName); err != nil {
+		return err
+	}
+	if locator.EncryptionKey, err = cmd.Flags().GetString(FlagEncryptionKey); err != nil {
+		return err
+	}
+	if locator.Priority, err = cmd.Flags().GetUint32(FlagPriority); err != nil {
+		return err
+	}
+	parseTime := func(flag string) (*time.Time, error) {
+		value, ferr := cmd.Flags().GetString(flag)
+		if ferr != nil || len(value) == 0 {
+			return nil, ferr
+		}
+		t, ferr := time.Parse(time.RFC3339, value)
+		if ferr != nil {
+			return nil, fmt.Errorf("invalid %s %q: %w", flag, value, ferr)
+		}
+		return &t, nil
+	}
+	if locator.KeyValidFrom, err = parseTime(FlagKeyValidFrom); err != nil {
+		return err
+	}
+	if locator.KeyValidUntil, err = parseTime(FlagKeyValidUntil); err != nil {
+		return err
+	}
+	return nil
+}
+
 // parseSigners checks signers flag for signers, else uses the from address
 func parseSigners(cmd *cobra.Command, client *client.Context) ([]string, error) {
 	flagSet := cmd.Flags()
@@ -1032,6 +1556,64 @@ func parseDescription(cliArgs []string) *types.Description {
 	return &description
 }
 
+// ApplySpecificationsCmd creates a command for making the specifications on chain match those in a file.
+func ApplySpecificationsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "apply-specs [file] [flags]",
+		Aliases: []string{"apply-specifications"},
+		Short:   "Add/Update the scope, contract and record specifications defined in a file",
+		Long: fmt.Sprintf(`Add/Update the scope, contract and record specifications defined in a yaml or json file.
+The file has scope_specifications, contract_specifications and record_specifications lists, the same as the output of %[1]s query metadata export-specs.
+Each specification in the file is compared with the one on chain, and only the ones that are new or different are written.
+Record specifications of a contract specification in the file are deleted if they are not also in the file.
+All of the messages are submitted in a single transaction.`, version.AppName),
+		Example: fmt.Sprintf(`$ %[1]s tx metadata apply-specs specs.yaml`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contents, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			file, err := ParseSpecificationsFile(clientCtx.Codec, contents)
+			if err != nil {
+				return fmt.Errorf("invalid specifications file %s: %w", args[0], err)
+			}
+
+			signers, err := parseSigners(cmd, &clientCtx)
+			if err != nil {
+				return err
+			}
+
+			msgs, err := specificationChanges(context.Background(), clientCtx.Codec, types.NewQueryClient(clientCtx), file, signers)
+			if err != nil {
+				return err
+			}
+			if len(msgs) == 0 {
+				return clientCtx.PrintString("All specifications are already up to date.\n")
+			}
+
+			for _, msg := range msgs {
+				if err = msg.ValidateBasic(); err != nil {
+					return err
+				}
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msgs...)
+		},
+	}
+
+	addSignerFlagCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // RemoveScopeSpecificationCmd creates a command to remove scope specification
 func RemoveScopeSpecificationCmd() *cobra.Command {
 	cmd := &cobra.Command{
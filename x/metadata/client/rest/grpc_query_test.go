@@ -223,7 +223,8 @@ func (suite *IntegrationGRPCTestSuite) TestGRPCQueries() {
 			false,
 			&types.OSLocatorResponse{},
 			&types.OSLocatorResponse{
-				Locator: &suite.objectLocator,
+				Locator:  &suite.objectLocator,
+				Locators: []types.ObjectStoreLocator{suite.objectLocator},
 				Request: &types.OSLocatorRequest{
 					Owner: suite.ownerAddr.String(),
 				},
@@ -245,6 +246,7 @@ func (suite *IntegrationGRPCTestSuite) TestGRPCQueries() {
 					Owner:         suite.ownerAddr.String(),
 					LocatorUri:    suite.uri,
 					EncryptionKey: suite.encryptionKey.String(),
+					Name:          types.DefaultOSLocatorName,
 				}},
 				Request: &types.OSLocatorsByURIRequest{
 					Uri:        b64.StdEncoding.EncodeToString([]byte(suite.uri)),
@@ -272,6 +274,7 @@ func (suite *IntegrationGRPCTestSuite) TestGRPCQueries() {
 					Owner:         suite.ownerAddr1.String(),
 					LocatorUri:    suite.uri1,
 					EncryptionKey: suite.encryptionKey1.String(),
+					Name:          types.DefaultOSLocatorName,
 				}},
 				Request: &types.OSLocatorsByScopeRequest{
 					ScopeId: suite.scopeUUID.String(),
@@ -326,6 +329,7 @@ func (suite *IntegrationGRPCTestSuite) TestAllOSLocator() {
 					Owner:         suite.ownerAddr1.String(),
 					EncryptionKey: suite.encryptionKey1.String(),
 					LocatorUri:    suite.uri1,
+					Name:          types.DefaultOSLocatorName,
 				}},
 			},
 		},
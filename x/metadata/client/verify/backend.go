@@ -0,0 +1,124 @@
+package verify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// DefaultHTTPTimeout is the time limit for each http object store request when no other client is provided.
+const DefaultHTTPTimeout = 30 * time.Second
+
+// ErrObjectNotFound is returned by a Backend when it does not have the requested object.
+var ErrObjectNotFound = errors.New("object not found")
+
+// Backend fetches off-chain objects from an object store.
+type Backend interface {
+	// Fetch gets the object with the given hash from the object store at the given locator uri.
+	// ErrObjectNotFound is returned if the object store does not have the object.
+	Fetch(ctx context.Context, locatorURI string, hash string) ([]byte, error)
+}
+
+// ObjectName gets the name that an object with the given hash is stored under.
+// Hashes are base64 encoded, so the url-safe alphabet is used to make the name usable as a file name or url path segment.
+func ObjectName(hash string) string {
+	return strings.NewReplacer("+", "-", "/", "_").Replace(strings.TrimSpace(hash))
+}
+
+// FileSystemBackend is a Backend that reads objects from files in a directory.
+// Each object is stored in a file named with the ObjectName of its hash.
+type FileSystemBackend struct {
+	// Dir is the directory containing the objects.
+	// If empty, the path of the locator uri is used, which allows file:// locators.
+	Dir string
+}
+
+var _ Backend = FileSystemBackend{}
+
+// NewFileSystemBackend creates a FileSystemBackend that reads all objects from the given directory.
+func NewFileSystemBackend(dir string) FileSystemBackend {
+	return FileSystemBackend{Dir: dir}
+}
+
+// Fetch reads the object with the given hash from the backend's directory.
+func (b FileSystemBackend) Fetch(_ context.Context, locatorURI string, hash string) ([]byte, error) {
+	dir := b.Dir
+	if len(dir) == 0 {
+		u, err := url.Parse(locatorURI)
+		if err != nil {
+			return nil, fmt.Errorf("invalid locator uri %q: %w", locatorURI, err)
+		}
+		dir = u.Path
+	}
+	bz, err := os.ReadFile(filepath.Join(dir, ObjectName(hash)))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrObjectNotFound
+	}
+	return bz, err
+}
+
+// HTTPBackend is a Backend that gets objects from an http object store.
+// Each object is requested from <locator uri>/<object name>.
+type HTTPBackend struct {
+	// Client is the http client to use. If nil, a client with the DefaultHTTPTimeout is used.
+	Client *http.Client
+}
+
+var _ Backend = HTTPBackend{}
+
+// NewHTTPBackend creates an HTTPBackend that uses the given client.
+func NewHTTPBackend(client *http.Client) HTTPBackend {
+	return HTTPBackend{Client: client}
+}
+
+// NewHTTPBackendWithTimeout creates an HTTPBackend whose requests fail if they take longer than the timeout.
+func NewHTTPBackendWithTimeout(timeout time.Duration) HTTPBackend {
+	return NewHTTPBackend(&http.Client{Timeout: timeout})
+}
+
+// Fetch gets the object with the given hash from the object store at the locator uri.
+func (b HTTPBackend) Fetch(ctx context.Context, locatorURI string, hash string) ([]byte, error) {
+	client := b.Client
+	if client == nil {
+		client = &http.Client{Timeout: DefaultHTTPTimeout}
+	}
+	objURL := strings.TrimSuffix(locatorURI, "/") + "/" + url.PathEscape(ObjectName(hash))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, objURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return nil, ErrObjectNotFound
+	case resp.StatusCode != http.StatusOK:
+		return nil, fmt.Errorf("unexpected response from %s: %s", objURL, resp.Status)
+	}
+	return io.ReadAll(resp.Body)
+}
+
+// DefaultBackends gets the backends to use for each locator uri scheme when nothing else is configured.
+func DefaultBackends() map[string]Backend {
+	return BackendsWithHTTPTimeout(DefaultHTTPTimeout)
+}
+
+// BackendsWithHTTPTimeout gets the default backends for each locator uri scheme, with http(s) requests limited to the timeout.
+func BackendsWithHTTPTimeout(timeout time.Duration) map[string]Backend {
+	httpBackend := NewHTTPBackendWithTimeout(timeout)
+	return map[string]Backend{
+		"file":  FileSystemBackend{},
+		"http":  httpBackend,
+		"https": httpBackend,
+	}
+}
@@ -0,0 +1,293 @@
+// Package verify checks that the off-chain objects referenced by metadata records match the hashes recorded on chain.
+package verify
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+const (
+	// StatusOK indicates that the object was found and matches its recorded hash.
+	StatusOK = "ok"
+	// StatusMismatch indicates that the object was found but does not match its recorded hash.
+	StatusMismatch = "mismatch"
+	// StatusMissing indicates that the object could not be found in any of the object stores.
+	StatusMissing = "missing"
+	// StatusInvalidHash indicates that the recorded hash could not be decoded.
+	StatusInvalidHash = "invalid_hash"
+
+	// KindInput indicates a record input.
+	KindInput = "input"
+	// KindOutput indicates a record output.
+	KindOutput = "output"
+)
+
+// QueryClient is the part of the metadata query client needed for verification.
+type QueryClient interface {
+	Records(ctx context.Context, in *types.RecordsRequest, opts ...grpc.CallOption) (*types.RecordsResponse, error)
+	OSLocatorsByScope(ctx context.Context, in *types.OSLocatorsByScopeRequest, opts ...grpc.CallOption) (*types.OSLocatorsByScopeResponse, error)
+}
+
+// Result is the outcome of checking a single record input or output.
+type Result struct {
+	// RecordID is the bech32 address of the record.
+	RecordID string `json:"record_id"`
+	// RecordName is the name of the record.
+	RecordName string `json:"record_name"`
+	// Kind is either KindInput or KindOutput.
+	Kind string `json:"kind"`
+	// Name is the name of the input. It is empty for outputs.
+	Name string `json:"name,omitempty"`
+	// Index is the position of the input or output in the record.
+	Index int `json:"index"`
+	// ExpectedHash is the hash recorded on chain.
+	ExpectedHash string `json:"expected_hash"`
+	// ActualHash is the hash of the fetched object, encoded the same way as the expected hash.
+	ActualHash string `json:"actual_hash,omitempty"`
+	// LocatorURI is the uri of the object store that the object was fetched from.
+	LocatorURI string `json:"locator_uri,omitempty"`
+	// Status is one of the Status* constants.
+	Status string `json:"status"`
+	// Error describes why the object could not be checked.
+	Error string `json:"error,omitempty"`
+}
+
+// Report is the outcome of checking all of the hashed inputs and outputs of some records.
+type Report struct {
+	// ScopeID is the bech32 address of the scope containing the records.
+	ScopeID string `json:"scope_id"`
+	// Results has an entry for each hashed input and output.
+	Results []Result `json:"results"`
+	// Verified is the number of results that have StatusOK.
+	Verified int `json:"verified"`
+	// Failed is the number of results that do not have StatusOK.
+	Failed int `json:"failed"`
+}
+
+// OK returns true if every checked object matched its recorded hash.
+func (r Report) OK() bool {
+	return r.Failed == 0
+}
+
+// Verifier fetches off-chain objects and compares them to the hashes recorded on chain.
+type Verifier struct {
+	// QueryClient is used to look up records and object store locators.
+	QueryClient QueryClient
+	// Backends are the backends to use for each locator uri scheme.
+	Backends map[string]Backend
+	// Override, if not nil, is used to fetch every object instead of the backends of the owners' locators.
+	Override Backend
+}
+
+// NewVerifier creates a Verifier that uses the DefaultBackends.
+func NewVerifier(queryClient QueryClient) *Verifier {
+	return &Verifier{
+		QueryClient: queryClient,
+		Backends:    DefaultBackends(),
+	}
+}
+
+// Verify checks the hashed inputs and outputs of the records in a scope, or of a single record.
+// The id can be a bech32 scope or record address, or a scope uuid.
+// An error is only returned if the records or locators cannot be looked up. Objects that fail verification are
+// identified in the report.
+func (v *Verifier) Verify(ctx context.Context, id string) (*Report, error) {
+	id = strings.TrimSpace(id)
+	req := &types.RecordsRequest{}
+	var scopeAddr types.MetadataAddress
+	if scopeUUID, err := uuid.Parse(id); err == nil {
+		scopeAddr = types.ScopeMetadataAddress(scopeUUID)
+		req.ScopeId = scopeAddr.String()
+	} else {
+		addr, err := types.MetadataAddressFromBech32(id)
+		if err != nil {
+			return nil, fmt.Errorf("invalid id %q: %w", id, err)
+		}
+		switch {
+		case addr.IsScopeAddress():
+			scopeAddr = addr
+			req.ScopeId = addr.String()
+		case addr.IsRecordAddress():
+			scopeAddr = addr.MustGetAsScopeAddress()
+			req.RecordAddr = addr.String()
+		default:
+			return nil, fmt.Errorf("id %q is not a scope or record id", id)
+		}
+	}
+
+	recRes, err := v.QueryClient.Records(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var locators []types.ObjectStoreLocator
+	if v.Override == nil {
+		locRes, err := v.QueryClient.OSLocatorsByScope(ctx, &types.OSLocatorsByScopeRequest{ScopeId: scopeAddr.String()})
+		if err != nil {
+			return nil, err
+		}
+		locators = locRes.Locators
+	}
+
+	report := &Report{ScopeID: scopeAddr.String(), Results: []Result{}}
+	for _, wrapper := range recRes.Records {
+		if wrapper == nil || wrapper.Record == nil {
+			continue
+		}
+		record := wrapper.Record
+		recordID := scopeAddr.MustGetAsRecordAddress(record.Name).String()
+		for i, input := range record.Inputs {
+			hash, ok := input.Source.(*types.RecordInput_Hash)
+			if !ok || len(hash.Hash) == 0 {
+				// Inputs that are other records are already on chain.
+				continue
+			}
+			result := v.check(ctx, locators, hash.Hash)
+			result.RecordID, result.RecordName, result.Kind, result.Name, result.Index = recordID, record.Name, KindInput, input.Name, i
+			report.add(result)
+		}
+		for i, output := range record.Outputs {
+			if len(output.Hash) == 0 {
+				continue
+			}
+			result := v.check(ctx, locators, output.Hash)
+			result.RecordID, result.RecordName, result.Kind, result.Index = recordID, record.Name, KindOutput, i
+			report.add(result)
+		}
+	}
+	return report, nil
+}
+
+// add appends a result to this report and updates its counts.
+func (r *Report) add(result Result) {
+	r.Results = append(r.Results, result)
+	if result.Status == StatusOK {
+		r.Verified++
+	} else {
+		r.Failed++
+	}
+}
+
+// check fetches the object with the given hash and compares it to the hash.
+// The locators are tried in order until one has a matching object.
+func (v *Verifier) check(ctx context.Context, locators []types.ObjectStoreLocator, expected string) Result {
+	rv := Result{ExpectedHash: expected}
+	if _, _, err := decodeHash(expected); err != nil {
+		rv.Status = StatusInvalidHash
+		rv.Error = err.Error()
+		return rv
+	}
+
+	uris := make([]string, 0, len(locators))
+	for _, loc := range locators {
+		uris = append(uris, loc.LocatorUri)
+	}
+	if v.Override != nil {
+		uris = []string{""}
+	}
+
+	rv.Status = StatusMissing
+	var errs []string
+	for _, uri := range uris {
+		backend, err := v.getBackend(uri)
+		if err != nil {
+			errs = append(errs, err.Error())
+			continue
+		}
+		bz, err := backend.Fetch(ctx, uri, expected)
+		if err != nil {
+			if !errors.Is(err, ErrObjectNotFound) {
+				errs = append(errs, err.Error())
+			}
+			continue
+		}
+		actual, err := ComputeHash(expected, bz)
+		if err != nil {
+			errs = append(errs, err.Error())
+			continue
+		}
+		rv.ActualHash, rv.LocatorURI = actual, uri
+		if actual == expected {
+			rv.Status, rv.Error = StatusOK, ""
+			return rv
+		}
+		rv.Status = StatusMismatch
+	}
+	if rv.Status == StatusMissing && len(uris) == 0 {
+		errs = append(errs, "no object store locators found")
+	}
+	rv.Error = strings.Join(errs, "; ")
+	return rv
+}
+
+// getBackend gets the backend to use for the given locator uri.
+func (v *Verifier) getBackend(uri string) (Backend, error) {
+	if v.Override != nil {
+		return v.Override, nil
+	}
+	u, err := url.Parse(uri)
+	if err != nil {
+		return nil, fmt.Errorf("invalid locator uri %q: %w", uri, err)
+	}
+	backend, ok := v.Backends[strings.ToLower(u.Scheme)]
+	if !ok || backend == nil {
+		return nil, fmt.Errorf("no backend for locator uri %q", uri)
+	}
+	return backend, nil
+}
+
+// ComputeHash computes the hash of the data using the algorithm and encoding of the expected hash.
+// The expected hash can be base64 (standard or url-safe) or hex encoded, and must be a sha256 or sha512 hash.
+func ComputeHash(expected string, data []byte) (string, error) {
+	expBz, encode, err := decodeHash(expected)
+	if err != nil {
+		return "", err
+	}
+	var actual []byte
+	switch len(expBz) {
+	case sha256.Size:
+		sum := sha256.Sum256(data)
+		actual = sum[:]
+	case sha512.Size:
+		sum := sha512.Sum512(data)
+		actual = sum[:]
+	}
+	if bytes.Equal(actual, expBz) {
+		return expected, nil
+	}
+	return encode(actual), nil
+}
+
+// decodeHash decodes a hash string, returning its bytes and a function that encodes bytes the same way.
+func decodeHash(hash string) ([]byte, func([]byte) string, error) {
+	encodings := []struct {
+		decode func(string) ([]byte, error)
+		encode func([]byte) string
+	}{
+		{hex.DecodeString, hex.EncodeToString},
+		{base64.StdEncoding.DecodeString, base64.StdEncoding.EncodeToString},
+		{base64.URLEncoding.DecodeString, base64.URLEncoding.EncodeToString},
+		{base64.RawStdEncoding.DecodeString, base64.RawStdEncoding.EncodeToString},
+		{base64.RawURLEncoding.DecodeString, base64.RawURLEncoding.EncodeToString},
+	}
+	for _, enc := range encodings {
+		bz, err := enc.decode(hash)
+		if err == nil && (len(bz) == sha256.Size || len(bz) == sha512.Size) {
+			return bz, enc.encode, nil
+		}
+	}
+	return nil, nil, fmt.Errorf("hash %q is not a base64 or hex encoded sha256 or sha512 hash", hash)
+}
@@ -0,0 +1,199 @@
+package verify_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/provenance-io/provenance/x/metadata/client/verify"
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// fakeQueryClient is a verify.QueryClient that returns canned responses.
+type fakeQueryClient struct {
+	records  []*types.RecordWrapper
+	locators []types.ObjectStoreLocator
+
+	lastRecordsReq *types.RecordsRequest
+}
+
+var _ verify.QueryClient = (*fakeQueryClient)(nil)
+
+func (c *fakeQueryClient) Records(_ context.Context, in *types.RecordsRequest, _ ...grpc.CallOption) (*types.RecordsResponse, error) {
+	c.lastRecordsReq = in
+	return &types.RecordsResponse{Records: c.records}, nil
+}
+
+func (c *fakeQueryClient) OSLocatorsByScope(_ context.Context, _ *types.OSLocatorsByScopeRequest, _ ...grpc.CallOption) (*types.OSLocatorsByScopeResponse, error) {
+	return &types.OSLocatorsByScopeResponse{Locators: c.locators}, nil
+}
+
+func sha512Base64(data string) string {
+	sum := sha512.Sum512([]byte(data))
+	return base64.StdEncoding.EncodeToString(sum[:])
+}
+
+func writeObject(t *testing.T, dir, data string) string {
+	hash := sha512Base64(data)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, verify.ObjectName(hash)), []byte(data), 0o600), "WriteFile")
+	return hash
+}
+
+func TestComputeHash(t *testing.T) {
+	data := []byte("some data")
+	sum256 := sha256.Sum256(data)
+	sum512 := sha512.Sum512(data)
+	other := sha256.Sum256([]byte("other data"))
+
+	tests := []struct {
+		name     string
+		expected string
+		exp      string
+		expErr   string
+	}{
+		{"sha512 base64", base64.StdEncoding.EncodeToString(sum512[:]), base64.StdEncoding.EncodeToString(sum512[:]), ""},
+		{"sha256 base64", base64.StdEncoding.EncodeToString(sum256[:]), base64.StdEncoding.EncodeToString(sum256[:]), ""},
+		{"sha256 url base64", base64.URLEncoding.EncodeToString(sum256[:]), base64.URLEncoding.EncodeToString(sum256[:]), ""},
+		{"sha256 hex", hex.EncodeToString(sum256[:]), hex.EncodeToString(sum256[:]), ""},
+		{"different sha256 hex", hex.EncodeToString(other[:]), hex.EncodeToString(sum256[:]), ""},
+		{"not a hash", "notahash", "", `hash "notahash" is not a base64 or hex encoded sha256 or sha512 hash`},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := verify.ComputeHash(tc.expected, data)
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "ComputeHash error")
+				return
+			}
+			require.NoError(t, err, "ComputeHash error")
+			assert.Equal(t, tc.exp, actual, "ComputeHash result")
+		})
+	}
+}
+
+func TestVerify(t *testing.T) {
+	dir := t.TempDir()
+	scopeUUID := uuid.MustParse("91978ba2-5f35-459a-86a7-feca1b0512e0")
+	scopeAddr := types.ScopeMetadataAddress(scopeUUID)
+	recordAddr := types.RecordMetadataAddress(scopeUUID, "rec")
+
+	inputHash := writeObject(t, dir, "input")
+	outputHash := writeObject(t, dir, "output")
+	badHash := sha512Base64("expected")
+	require.NoError(t, os.WriteFile(filepath.Join(dir, verify.ObjectName(badHash)), []byte("tampered"), 0o600), "WriteFile")
+	missingHash := sha512Base64("missing")
+
+	record := &types.Record{
+		Name: "rec",
+		Inputs: []types.RecordInput{
+			{Name: "on-chain", Source: &types.RecordInput_RecordId{RecordId: recordAddr}},
+			{Name: "in", Source: &types.RecordInput_Hash{Hash: inputHash}},
+			{Name: "bad", Source: &types.RecordInput_Hash{Hash: "notahash"}},
+		},
+		Outputs: []types.RecordOutput{
+			{Hash: outputHash},
+			{Hash: badHash},
+			{Hash: missingHash},
+		},
+	}
+	qc := &fakeQueryClient{
+		records:  []*types.RecordWrapper{{Record: record}},
+		locators: []types.ObjectStoreLocator{{Owner: "owner", LocatorUri: "file://" + dir}},
+	}
+
+	report, err := verify.NewVerifier(qc).Verify(context.Background(), recordAddr.String())
+	require.NoError(t, err, "Verify")
+	assert.Equal(t, recordAddr.String(), qc.lastRecordsReq.RecordAddr, "records request record addr")
+	assert.Equal(t, scopeAddr.String(), report.ScopeID, "report scope id")
+	assert.False(t, report.OK(), "report OK")
+	assert.Equal(t, 2, report.Verified, "report verified")
+	assert.Equal(t, 3, report.Failed, "report failed")
+
+	expStatuses := []struct {
+		kind   string
+		index  int
+		status string
+	}{
+		{verify.KindInput, 1, verify.StatusOK},
+		{verify.KindInput, 2, verify.StatusInvalidHash},
+		{verify.KindOutput, 0, verify.StatusOK},
+		{verify.KindOutput, 1, verify.StatusMismatch},
+		{verify.KindOutput, 2, verify.StatusMissing},
+	}
+	require.Len(t, report.Results, len(expStatuses), "report results")
+	for i, exp := range expStatuses {
+		result := report.Results[i]
+		assert.Equal(t, exp.kind, result.Kind, "result[%d] kind", i)
+		assert.Equal(t, exp.index, result.Index, "result[%d] index", i)
+		assert.Equal(t, exp.status, result.Status, "result[%d] status", i)
+		assert.Equal(t, recordAddr.String(), result.RecordID, "result[%d] record id", i)
+	}
+	assert.Equal(t, sha512Base64("tampered"), report.Results[3].ActualHash, "mismatched actual hash")
+
+	t.Run("http backend", func(t *testing.T) {
+		server := httptest.NewServer(http.FileServer(http.Dir(dir)))
+		defer server.Close()
+		qc.locators = []types.ObjectStoreLocator{{Owner: "owner", LocatorUri: server.URL}}
+		report, err := verify.NewVerifier(qc).Verify(context.Background(), scopeAddr.String())
+		require.NoError(t, err, "Verify")
+		assert.Equal(t, scopeAddr.String(), qc.lastRecordsReq.ScopeId, "records request scope id")
+		assert.Equal(t, 2, report.Verified, "report verified")
+		assert.Equal(t, 3, report.Failed, "report failed")
+	})
+
+	t.Run("http backend timeout", func(t *testing.T) {
+		done := make(chan struct{})
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			select {
+			case <-done:
+			case <-r.Context().Done():
+			}
+		}))
+		defer server.Close()
+		defer close(done)
+		qc.locators = []types.ObjectStoreLocator{{Owner: "owner", LocatorUri: server.URL}}
+		verifier := verify.NewVerifier(qc)
+		verifier.Backends = verify.BackendsWithHTTPTimeout(50 * time.Millisecond)
+		report, err := verifier.Verify(context.Background(), scopeAddr.String())
+		require.NoError(t, err, "Verify")
+		assert.Equal(t, 0, report.Verified, "report verified")
+		assert.Contains(t, report.Results[0].Error, "Client.Timeout exceeded", "first result error")
+	})
+
+	t.Run("directory override", func(t *testing.T) {
+		qc.locators = nil
+		verifier := verify.NewVerifier(qc)
+		verifier.Override = verify.NewFileSystemBackend(dir)
+		report, err := verifier.Verify(context.Background(), scopeUUID.String())
+		require.NoError(t, err, "Verify")
+		assert.Equal(t, scopeAddr.String(), qc.lastRecordsReq.ScopeId, "records request scope id")
+		assert.Equal(t, 2, report.Verified, "report verified")
+	})
+
+	t.Run("no locators", func(t *testing.T) {
+		qc.locators = nil
+		report, err := verify.NewVerifier(qc).Verify(context.Background(), scopeAddr.String())
+		require.NoError(t, err, "Verify")
+		assert.Equal(t, 0, report.Verified, "report verified")
+		assert.Equal(t, "no object store locators found", report.Results[0].Error, "first result error")
+	})
+
+	t.Run("invalid id", func(t *testing.T) {
+		_, err := verify.NewVerifier(qc).Verify(context.Background(), types.ScopeSpecMetadataAddress(scopeUUID).String())
+		assert.ErrorContains(t, err, "is not a scope or record id", "Verify")
+	})
+}
@@ -386,6 +386,132 @@ func (s MetadataHandlerTestSuite) TestWriteDeleteRecord() {
 // TODO: WriteContractSpecification tests
 // TODO: DeleteContractSpecification tests
 
+func (s MetadataHandlerTestSuite) TestWriteScopeBundle() {
+	cSpecUUID := uuid.New()
+	cSpec := types.ContractSpecification{
+		SpecificationId: types.ContractSpecMetadataAddress(cSpecUUID),
+		OwnerAddresses:  []string{s.user1},
+		PartiesInvolved: []types.PartyType{types.PartyType_PARTY_TYPE_OWNER},
+		Source:          types.NewContractSpecificationSourceHash("bundlesource"),
+		ClassName:       "bundleclass",
+	}
+	s.app.MetadataKeeper.SetContractSpecification(s.ctx, cSpec)
+	sSpec := types.ScopeSpecification{
+		SpecificationId: types.ScopeSpecMetadataAddress(uuid.New()),
+		OwnerAddresses:  []string{s.user1},
+		PartiesInvolved: []types.PartyType{types.PartyType_PARTY_TYPE_OWNER},
+		ContractSpecIds: []types.MetadataAddress{cSpec.SpecificationId},
+	}
+	s.app.MetadataKeeper.SetScopeSpecification(s.ctx, sSpec)
+	rSpec := types.RecordSpecification{
+		SpecificationId:    types.RecordSpecMetadataAddress(cSpecUUID, "bundlerecord"),
+		Name:               "bundlerecord",
+		TypeName:           "string",
+		ResultType:         types.DefinitionType_DEFINITION_TYPE_RECORD,
+		ResponsibleParties: []types.PartyType{types.PartyType_PARTY_TYPE_OWNER},
+	}
+	s.app.MetadataKeeper.SetRecordSpecification(s.ctx, rSpec)
+
+	newBundle := func(recordSpecID types.MetadataAddress) types.ScopeBundle {
+		scopeUUID := uuid.New()
+		sessionID := types.SessionMetadataAddress(scopeUUID, uuid.New())
+		return types.ScopeBundle{
+			Scope: *types.NewScope(types.ScopeMetadataAddress(scopeUUID), sSpec.SpecificationId,
+				ownerPartyList(s.user1), nil, ""),
+			Sessions: []types.Session{{
+				SessionId:       sessionID,
+				SpecificationId: cSpec.SpecificationId,
+				Parties:         ownerPartyList(s.user1),
+				Name:            "bundleclass",
+			}},
+			Records: []types.Record{{
+				Name:      rSpec.Name,
+				SessionId: sessionID,
+				Process: types.Process{
+					ProcessId: &types.Process_Hash{Hash: "bundleprochash"},
+					Name:      "bundleproc",
+					Method:    "bundlemethod",
+				},
+				Outputs:         []types.RecordOutput{{Hash: "bundleout", Status: types.ResultStatus_RESULT_STATUS_PASS}},
+				SpecificationId: recordSpecID,
+			}},
+		}
+	}
+
+	s.T().Run("invalid record rolls back every bundle", func(t *testing.T) {
+		good := newBundle(rSpec.SpecificationId)
+		bad := newBundle(types.RecordSpecMetadataAddress(cSpecUUID, "unknown"))
+		bad.Records[0].Name = "unknown"
+		msg := types.NewMsgWriteScopeBundleRequest([]types.ScopeBundle{good, bad}, []string{s.user1})
+		_, err := s.handler(s.ctx, msg)
+		require.Error(t, err, "handler")
+		assert.Contains(t, err.Error(), "bundle [1]: record \"unknown\"", "handler error")
+		_, found := s.app.MetadataKeeper.GetScope(s.ctx, good.Scope.ScopeId)
+		assert.False(t, found, "first bundle scope found")
+		_, found = s.app.MetadataKeeper.GetSession(s.ctx, good.Sessions[0].SessionId)
+		assert.False(t, found, "first bundle session found")
+	})
+
+	s.T().Run("everything is written", func(t *testing.T) {
+		bundles := []types.ScopeBundle{newBundle(rSpec.SpecificationId), newBundle(rSpec.SpecificationId)}
+		msg := types.NewMsgWriteScopeBundleRequest(bundles, []string{s.user1})
+		em := sdk.NewEventManager()
+		ctx := s.ctx.WithEventManager(em)
+		res, err := s.handler(ctx, msg)
+		require.NoError(t, err, "handler")
+		require.NotNil(t, res, "handler result")
+
+		written := 0
+		for _, event := range res.GetEvents() {
+			switch event.Type {
+			case "provenance.metadata.v1.EventScopeBundleWritten":
+				written++
+			case "provenance.metadata.v1.EventScopeCreated", "provenance.metadata.v1.EventSessionCreated",
+				"provenance.metadata.v1.EventRecordCreated":
+				t.Errorf("unexpected event %s", event.Type)
+			}
+		}
+		assert.Equal(t, len(bundles), written, "number of EventScopeBundleWritten events")
+
+		for i, bundle := range bundles {
+			_, found := s.app.MetadataKeeper.GetScope(s.ctx, bundle.Scope.ScopeId)
+			assert.True(t, found, "bundle [%d] scope found", i)
+			session, found := s.app.MetadataKeeper.GetSession(s.ctx, bundle.Sessions[0].SessionId)
+			if assert.True(t, found, "bundle [%d] session found", i) {
+				assert.NotNil(t, session.Audit, "bundle [%d] session audit", i)
+			}
+			_, found = s.app.MetadataKeeper.GetRecord(s.ctx, bundle.Records[0].SessionId.MustGetAsRecordAddress(rSpec.Name))
+			assert.True(t, found, "bundle [%d] record found", i)
+		}
+	})
+
+	s.T().Run("detailed events are kept", func(t *testing.T) {
+		s.app.MetadataKeeper.SetParams(s.ctx, types.NewParams(0, false, true))
+		defer s.app.MetadataKeeper.SetParams(s.ctx, types.DefaultParams())
+
+		msg := types.NewMsgWriteScopeBundleRequest([]types.ScopeBundle{newBundle(rSpec.SpecificationId)}, []string{s.user1})
+		res, err := s.handler(s.ctx, msg)
+		require.NoError(t, err, "handler")
+		require.NotNil(t, res, "handler result")
+
+		counts := map[string]int{}
+		for _, event := range res.GetEvents() {
+			counts[event.Type]++
+		}
+		for _, eventType := range types.DetailedEventTypes() {
+			assert.Equal(t, 1, counts[eventType], "number of %s events", eventType)
+		}
+		assert.Equal(t, 0, counts["provenance.metadata.v1.EventScopeCreated"], "number of EventScopeCreated events")
+	})
+
+	s.T().Run("missing owner signature", func(t *testing.T) {
+		msg := types.NewMsgWriteScopeBundleRequest([]types.ScopeBundle{newBundle(rSpec.SpecificationId)}, []string{s.user2})
+		_, err := s.handler(s.ctx, msg)
+		require.Error(t, err, "handler")
+		assert.Contains(t, err.Error(), "missing signature", "handler error")
+	})
+}
+
 func (s MetadataHandlerTestSuite) TestAddContractSpecToScopeSpec() {
 	cSpec := types.ContractSpecification{
 		SpecificationId: types.ContractSpecMetadataAddress(uuid.New()),
@@ -608,6 +734,23 @@ func (s MetadataHandlerTestSuite) TestAddP8EContractSpec() {
 	}
 }
 
+func (s MetadataHandlerTestSuite) TestP8eMessagesDisabled() {
+	validDefSpec := createDefinitionSpec("perform_input_checks", "io.provenance.loan.LoanProtos$PartiesList", p8e.ProvenanceReference{Hash: "Adv+huolGTKofYCR0dw5GHm/R7sUWOwF32XR8r8r9kDy4il5U/LApxOWYHb05jhK4+eY4YzRMRiWcxU3Lx0+Mw=="}, 1)
+	cSpec := createContractSpec([]*p8e.DefinitionSpec{&validDefSpec}, p8e.OutputSpec{Spec: &validDefSpec}, validDefSpec)
+
+	s.app.MetadataKeeper.SetParams(s.ctx, types.NewParams(0, true, false))
+	defer s.app.MetadataKeeper.SetParams(s.ctx, types.DefaultParams())
+
+	_, err := s.handler(s.ctx, &types.MsgWriteP8EContractSpecRequest{Contractspec: cSpec, Signers: []string{s.user1}})
+	s.Assert().ErrorIs(err, types.ErrP8eMessagesDisabled, "WriteP8EContractSpec")
+	_, err = s.handler(s.ctx, &types.MsgP8EMemorializeContractRequest{Invoker: s.user1})
+	s.Assert().ErrorIs(err, types.ErrP8eMessagesDisabled, "P8EMemorializeContract")
+
+	s.app.MetadataKeeper.SetParams(s.ctx, types.DefaultParams())
+	_, err = s.handler(s.ctx, &types.MsgWriteP8EContractSpecRequest{Contractspec: cSpec, Signers: []string{s.user1}})
+	s.Assert().NoError(err, "WriteP8EContractSpec after re-enabling")
+}
+
 // TODO: P8EMemorializeContract tests
 // TODO: BindOSLocator tests
 // TODO: DeleteOSLocator tests
@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// MaxScopesPrunedPerBlock is the maximum number of scopes that have expired data access removed in one block.
+// Any others are handled in the following blocks.
+const MaxScopesPrunedPerBlock = 100
+
+// GetScopesWithExpiredDataAccess returns the ids of scopes with data access that expired at or before the provided time.
+// At most limit scope ids are returned, the ones with the earliest expirations first. A limit of zero returns them all.
+func (k Keeper) GetScopesWithExpiredDataAccess(ctx sdk.Context, before time.Time, limit int) []types.MetadataAddress {
+	store := ctx.KVStore(k.storeKey)
+	// The index is only precise to the second, so include the entire second and filter on the scopes.
+	iterator := store.Iterator(types.DataAccessExpirationKeyPrefix, types.GetDataAccessExpirationKeyPrefix(before.Add(time.Second)))
+	defer iterator.Close()
+	var scopeIDs []types.MetadataAddress
+	for ; iterator.Valid(); iterator.Next() {
+		scopeID, err := types.ParseDataAccessExpirationKeyScopeID(iterator.Key())
+		if err != nil {
+			k.Logger(ctx).Error("invalid data access expiration index entry", "err", err)
+			continue
+		}
+		found := false
+		for _, id := range scopeIDs {
+			if id.Equals(scopeID) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			if limit > 0 && len(scopeIDs) >= limit {
+				break
+			}
+			scopeIDs = append(scopeIDs, scopeID)
+		}
+	}
+	return scopeIDs
+}
+
+// PruneExpiredDataAccess removes scope data access that has expired as of the block time.
+// At most MaxScopesPrunedPerBlock scopes are updated; the rest are left for the next block.
+func (k Keeper) PruneExpiredDataAccess(ctx sdk.Context) {
+	blockTime := ctx.BlockTime()
+	for _, scopeID := range k.GetScopesWithExpiredDataAccess(ctx, blockTime, MaxScopesPrunedPerBlock) {
+		scope, found := k.GetScope(ctx, scopeID)
+		if !found {
+			k.Logger(ctx).Error("scope with expired data access not found", "scopeId", scopeID.String())
+			continue
+		}
+		expired := scope.RemoveExpiredDataAccess(blockTime)
+		if len(expired) == 0 {
+			continue
+		}
+		k.SetScope(ctx, scope)
+		k.EmitEvent(ctx, types.NewEventScopeDataAccessExpired(scopeID, expired))
+	}
+}
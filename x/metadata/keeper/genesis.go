@@ -1,8 +1,6 @@
 package keeper
 
 import (
-	"strings"
-
 	"github.com/provenance-io/provenance/x/metadata/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -47,20 +45,17 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
 	}
 	if data.ObjectStoreLocators != nil {
 		for _, s := range data.ObjectStoreLocators {
-			addr, err := sdk.AccAddressFromBech32(s.Owner)
-			if err != nil {
-				panic(err)
-			}
-			encryptionKey := sdk.AccAddress{}
-			if strings.TrimSpace(s.EncryptionKey) != "" {
-				encryptionKey, _ = sdk.AccAddressFromBech32(s.EncryptionKey)
-			}
-			err = k.ImportOSLocatorRecord(ctx, addr, encryptionKey, s.LocatorUri)
-			if err != nil {
+			if err := k.ImportOSLocatorRecord(ctx, s); err != nil {
 				panic(err)
 			}
 		}
 	}
+	for _, offer := range data.ScopeSaleOffers {
+		k.SetScopeSaleOffer(ctx, offer)
+	}
+	for _, recordVersion := range data.RecordVersions {
+		k.SetRecordVersion(ctx, recordVersion)
+	}
 }
 
 // ExportGenesis exports the current keeper state of the metadata module.ExportGenesis
@@ -74,6 +69,8 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) (data *types.GenesisState) {
 	contractSpecs := make([]types.ContractSpecification, 0)
 	recordSpecs := make([]types.RecordSpecification, 0)
 	objectStoreLocators := make([]types.ObjectStoreLocator, 0)
+	scopeSaleOffers := make([]types.ScopeSaleOffer, 0)
+	recordVersions := make([]types.RecordVersion, 0)
 
 	appendToScopes := func(scope types.Scope) bool {
 		scopes = append(scopes, scope)
@@ -134,5 +131,24 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) (data *types.GenesisState) {
 		panic(err)
 	}
 
-	return types.NewGenesisState(params, oslocatorparams, scopes, sessions, records, scopeSpecs, contractSpecs, recordSpecs, objectStoreLocators)
+	appendToScopeSaleOffers := func(offer types.ScopeSaleOffer) bool {
+		scopeSaleOffers = append(scopeSaleOffers, offer)
+		return false
+	}
+	if err := k.IterateScopeSaleOffers(ctx, appendToScopeSaleOffers); err != nil {
+		panic(err)
+	}
+
+	appendToRecordVersions := func(recordVersion types.RecordVersion) bool {
+		recordVersions = append(recordVersions, recordVersion)
+		return false
+	}
+	if err := k.IterateRecordVersions(ctx, types.MetadataAddress{}, appendToRecordVersions); err != nil {
+		panic(err)
+	}
+
+	genState := types.NewGenesisState(params, oslocatorparams, scopes, sessions, records, scopeSpecs, contractSpecs, recordSpecs, objectStoreLocators)
+	genState.ScopeSaleOffers = scopeSaleOffers
+	genState.RecordVersions = recordVersions
+	return genState
 }
@@ -10,6 +10,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	authzKeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/gogo/protobuf/proto"
@@ -98,12 +99,14 @@ type MetadataKeeperI interface {
 	// GetRecordSpecificationsForContractSpecificationID returns all the record specifications associated with given contractSpecID
 	GetRecordSpecificationsForContractSpecificationID(ctx sdk.Context, contractSpecID types.MetadataAddress) ([]*types.RecordSpecification, error)
 
-	// GetOsLocatorRecord returns the OS locator records for a given name record.
-	GetOsLocatorRecord(ctx sdk.Context, ownerAddr sdk.AccAddress) (types.ObjectStoreLocator, bool)
-	// return if OSLocator exists for a given owner addr
-	OSLocatorExists(ctx sdk.Context, ownerAddr sdk.AccAddress) bool
+	// GetOsLocatorRecord returns the named OS locator record for a given owner.
+	GetOsLocatorRecord(ctx sdk.Context, ownerAddr sdk.AccAddress, name string) (types.ObjectStoreLocator, bool)
+	// GetOSLocatorsForOwner returns all OS locator records for a given owner.
+	GetOSLocatorsForOwner(ctx sdk.Context, ownerAddr sdk.AccAddress) ([]types.ObjectStoreLocator, error)
+	// return if a named OSLocator exists for a given owner addr
+	OSLocatorExists(ctx sdk.Context, ownerAddr sdk.AccAddress, name string) bool
 	// add OSLocator instance
-	SetOSLocator(ctx sdk.Context, ownerAddr, encryptionKey sdk.AccAddress, uri string) error
+	SetOSLocator(ctx sdk.Context, locator types.ObjectStoreLocator) error
 	// get OS locator by scope UUID.
 	GetOSLocatorByScope(ctx sdk.Context, scopeID string) ([]types.ObjectStoreLocator, error)
 }
@@ -120,6 +123,15 @@ type Keeper struct {
 
 	// To check granter grantee authorization of messages.
 	authzKeeper authzKeeper.Keeper
+
+	// To create the markers backing value owner tokens.
+	markerKeeper types.MarkerKeeper
+
+	// To find the holders of value owner tokens.
+	bankKeeper types.BankKeeper
+
+	// specs, when not nil, caches the specifications read while processing a single message.
+	specs *specCache
 }
 
 // NewKeeper creates new instances of the metadata Keeper.
@@ -127,17 +139,21 @@ func NewKeeper(
 	cdc codec.BinaryCodec, key storetypes.StoreKey, paramSpace paramtypes.Subspace,
 	authKeeper authkeeper.AccountKeeper,
 	authzKeeper authzKeeper.Keeper,
+	markerKeeper types.MarkerKeeper,
+	bankKeeper types.BankKeeper,
 ) Keeper {
 	// set KeyTable if it has not already been set
 	if !paramSpace.HasKeyTable() {
-		paramSpace = paramSpace.WithKeyTable(types.OSParamKeyTable())
+		paramSpace = paramSpace.WithKeyTable(types.OSParamKeyTable().RegisterParamSet(&types.Params{}))
 	}
 	return Keeper{
-		storeKey:    key,
-		cdc:         cdc,
-		paramSpace:  paramSpace,
-		authKeeper:  authKeeper,
-		authzKeeper: authzKeeper,
+		storeKey:     key,
+		cdc:          cdc,
+		paramSpace:   paramSpace,
+		authKeeper:   authKeeper,
+		authzKeeper:  authzKeeper,
+		markerKeeper: markerKeeper,
+		bankKeeper:   bankKeeper,
 	}
 }
 
@@ -169,37 +185,38 @@ func (k Keeper) CreateAccountForKey(ctx sdk.Context, addr sdk.AccAddress, pubKey
 // For example passing in `/provenance.metadata.v1.MsgAddScopeDataAccessRequest` would return a list containing
 // ("/provenance.metadata.v1.MsgAddScopeDataAccessRequest", "/provenance.metadata.v1.MsgWriteScopeRequest")
 func (k Keeper) GetMessageTypeURLs(msgTypeURL string) []string {
-	urls := []string{}
-	if len(msgTypeURL) > 0 {
-		urls = append(urls, msgTypeURL)
-	}
-	switch msgTypeURL {
-	case types.TypeURLMsgAddScopeDataAccessRequest, types.TypeURLMsgDeleteScopeDataAccessRequest,
-		types.TypeURLMsgAddScopeOwnerRequest, types.TypeURLMsgDeleteScopeOwnerRequest:
-		urls = append(urls, types.TypeURLMsgWriteScopeRequest)
-	case types.TypeURLMsgWriteRecordRequest:
-		urls = append(urls, types.TypeURLMsgWriteSessionRequest)
-	case types.TypeURLMsgAddContractSpecToScopeSpecRequest, types.TypeURLMsgDeleteContractSpecFromScopeSpecRequest:
-		urls = append(urls, types.TypeURLMsgWriteScopeSpecificationRequest)
-	case types.TypeURLMsgWriteRecordSpecificationRequest:
-		urls = append(urls, types.TypeURLMsgWriteContractSpecificationRequest)
-	case types.TypeURLMsgDeleteRecordSpecificationRequest:
-		urls = append(urls, types.TypeURLMsgDeleteContractSpecificationRequest)
-	}
-	return urls
+	return types.GetMessageTypeURLs(msgTypeURL)
 }
 
 // checkAuthZForMissing checks to see if the missing types.Party have an assigned grantee that can sing on their behalf
+// The scopeID is the scope being acted on, and is empty if the message does not act on a scope.
 func (k Keeper) checkAuthzForMissing(
 	ctx sdk.Context,
 	addrs []string,
 	signers []string,
 	msgTypeURL string,
+	scopeID types.MetadataAddress,
 ) ([]string, error) {
 	stillMissing := []string{}
 	// return as a list this message type and its parent
 	// type if it is a message belonging to a hierarchy
 	msgTypeURLs := k.GetMessageTypeURLs(msgTypeURL)
+	// The scope specification is only looked up if needed by a MetadataScopeAuthorization.
+	var specID types.MetadataAddress
+	specLoaded := false
+	accept := func(authorization authz.Authorization) (authz.AcceptResponse, error) {
+		scopeAuth, ok := authorization.(*types.MetadataScopeAuthorization)
+		if !ok {
+			return authorization.Accept(ctx, nil)
+		}
+		if !specLoaded && !scopeID.Empty() {
+			if scope, found := k.GetScope(ctx, scopeID); found {
+				specID = scope.SpecificationId
+			}
+			specLoaded = true
+		}
+		return scopeAuth.AcceptScope(msgTypeURL, scopeID, specID)
+	}
 
 	for _, addr := range addrs {
 		found := false
@@ -212,7 +229,7 @@ func (k Keeper) checkAuthzForMissing(
 			for _, msgType := range msgTypeURLs {
 				authorization, exp := k.authzKeeper.GetAuthorization(ctx, grantee, granter, msgType)
 				if authorization != nil {
-					resp, err := authorization.Accept(ctx, nil)
+					resp, err := accept(authorization)
 					if err == nil && resp.Accept {
 						switch {
 						case resp.Delete:
@@ -258,7 +275,7 @@ func (k Keeper) ValidateAllOwnersAreSignersWithAuthz(
 	// Authz grants rights to address on specific message types.
 	// If no message type URL is provided, skip the Authz check.
 	if len(msgTypeURL) > 0 {
-		stillMissing, err = k.checkAuthzForMissing(ctx, missing, signers, msgTypeURL)
+		stillMissing, err = k.checkAuthzForMissing(ctx, missing, signers, msgTypeURL, nil)
 		if err != nil {
 			return fmt.Errorf("error validating signers: %w", err)
 		}
@@ -275,7 +292,14 @@ func (k Keeper) ValidateAllOwnersAreSignersWithAuthz(
 }
 
 // ValidateAllPartiesAreSignersWithAuthz validate all parties are signers with authz module
-func (k Keeper) ValidateAllPartiesAreSignersWithAuthz(ctx sdk.Context, parties []types.Party, signers []string, msgTypeURL string) error {
+// The scopeID is the scope being acted on, and is used to check grants that are limited to specific scopes.
+func (k Keeper) ValidateAllPartiesAreSignersWithAuthz(
+	ctx sdk.Context,
+	scopeID types.MetadataAddress,
+	parties []types.Party,
+	signers []string,
+	msgTypeURL string,
+) error {
 	addresses := make([]string, len(parties))
 	for i, party := range parties {
 		addresses[i] = party.Address
@@ -287,7 +311,7 @@ func (k Keeper) ValidateAllPartiesAreSignersWithAuthz(ctx sdk.Context, parties [
 	// Authz grants rights to address on specific message types.
 	// If no message type URL is provided, skip the Authz check.
 	if len(msgTypeURL) > 0 {
-		stillMissing, err = k.checkAuthzForMissing(ctx, missing, signers, msgTypeURL)
+		stillMissing, err = k.checkAuthzForMissing(ctx, missing, signers, msgTypeURL, scopeID)
 		if err != nil {
 			return fmt.Errorf("error validating signers: %w", err)
 		}
@@ -364,9 +388,9 @@ func FindMissingMdAddr(required, entries []types.MetadataAddress) []types.Metada
 	return retval
 }
 
-// VerifyCorrectOwner to determines whether the signer resolves to the owner of the OSLocator record.
-func (k Keeper) VerifyCorrectOwner(ctx sdk.Context, ownerAddr sdk.AccAddress) bool { //nolint:interfacer
-	stored, found := k.GetOsLocatorRecord(ctx, ownerAddr)
+// VerifyCorrectOwner to determines whether the signer resolves to the owner of the named OSLocator record.
+func (k Keeper) VerifyCorrectOwner(ctx sdk.Context, ownerAddr sdk.AccAddress, name string) bool { //nolint:interfacer
+	stored, found := k.GetOsLocatorRecord(ctx, ownerAddr, name)
 	if !found {
 		return false
 	}
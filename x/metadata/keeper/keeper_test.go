@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/google/uuid"
 
 	simapp "github.com/provenance-io/provenance/app"
 	"github.com/provenance-io/provenance/internal/pioconfig"
@@ -311,7 +312,7 @@ func (s *KeeperTestSuite) TestValidateAllOwnerPartiesAreSigners() {
 	// Test cases
 	for n, tc := range cases {
 		s.T().Run(n, func(t *testing.T) {
-			err := s.app.MetadataKeeper.ValidateAllPartiesAreSignersWithAuthz(s.ctx, tc.owners, tc.signers, tc.msgTypeURL)
+			err := s.app.MetadataKeeper.ValidateAllPartiesAreSignersWithAuthz(s.ctx, nil, tc.owners, tc.signers, tc.msgTypeURL)
 			if len(tc.errorMsg) == 0 {
 				assert.NoError(t, err, "%s unexpected error", n)
 			} else {
@@ -404,7 +405,7 @@ func (s *KeeperTestSuite) TestValidateAllOwnerPartiesAreSignersWithCountAuthoriz
 				s.Require().NoError(err)
 			}
 
-			err := s.app.MetadataKeeper.ValidateAllPartiesAreSignersWithAuthz(s.ctx, tc.owners, tc.signers, tc.msgTypeURL)
+			err := s.app.MetadataKeeper.ValidateAllPartiesAreSignersWithAuthz(s.ctx, nil, tc.owners, tc.signers, tc.msgTypeURL)
 			if len(tc.errorMsg) == 0 {
 				assert.NoError(t, err, "%s unexpected error", tc.name)
 			} else {
@@ -454,7 +455,7 @@ func (s *KeeperTestSuite) TestValidateAllOwnerPartiesAreSignersWithCountAuthoriz
 		signers := []string{s.user3}
 
 		// validate signatures
-		err = s.app.MetadataKeeper.ValidateAllPartiesAreSignersWithAuthz(s.ctx, parties, signers, specialCaseMsgTypeUrl)
+		err = s.app.MetadataKeeper.ValidateAllPartiesAreSignersWithAuthz(s.ctx, nil, parties, signers, specialCaseMsgTypeUrl)
 		assert.NoError(t, err, "special case", "ValidateAllPartiesAreSigners")
 
 		// validate first grant is deleted after one use
@@ -469,6 +470,121 @@ func (s *KeeperTestSuite) TestValidateAllOwnerPartiesAreSignersWithCountAuthoriz
 	})
 }
 
+func (s *KeeperTestSuite) TestValidateSignersWithMetadataScopeAuthorization() {
+	specID := types.ScopeSpecMetadataAddress(uuid.New())
+	otherSpecID := types.ScopeSpecMetadataAddress(uuid.New())
+	scopeID := types.ScopeMetadataAddress(uuid.New())
+	otherScopeID := types.ScopeMetadataAddress(uuid.New())
+	s.app.MetadataKeeper.SetScope(s.ctx, *types.NewScope(scopeID, specID, ownerPartyList(s.user1), nil, ""))
+	s.app.MetadataKeeper.SetScope(s.ctx, *types.NewScope(otherScopeID, otherSpecID, ownerPartyList(s.user1), nil, ""))
+
+	exp1Hour := s.ctx.BlockTime().Add(time.Hour)
+	parties := ownerPartyList(s.user1)
+	signers := []string{s.user2}
+
+	tests := []struct {
+		name       string
+		auth       *types.MetadataScopeAuthorization
+		scopeID    types.MetadataAddress
+		msgTypeURL string
+		errorMsg   string
+	}{
+		{
+			name:       "unrestricted",
+			auth:       types.NewMetadataScopeAuthorization(types.TypeURLMsgWriteScopeRequest),
+			scopeID:    scopeID,
+			msgTypeURL: types.TypeURLMsgWriteScopeRequest,
+		},
+		{
+			name:       "allowed scope",
+			auth:       &types.MetadataScopeAuthorization{MsgTypeUrl: types.TypeURLMsgWriteScopeRequest, ScopeIds: []types.MetadataAddress{scopeID}},
+			scopeID:    scopeID,
+			msgTypeURL: types.TypeURLMsgWriteScopeRequest,
+		},
+		{
+			name:       "other scope",
+			auth:       &types.MetadataScopeAuthorization{MsgTypeUrl: types.TypeURLMsgWriteScopeRequest, ScopeIds: []types.MetadataAddress{scopeID}},
+			scopeID:    otherScopeID,
+			msgTypeURL: types.TypeURLMsgWriteScopeRequest,
+			errorMsg:   fmt.Sprintf("missing signature from [%s (PARTY_TYPE_OWNER)]", s.user1),
+		},
+		{
+			name:       "allowed scope specification",
+			auth:       &types.MetadataScopeAuthorization{MsgTypeUrl: types.TypeURLMsgWriteScopeRequest, SpecificationId: specID},
+			scopeID:    scopeID,
+			msgTypeURL: types.TypeURLMsgWriteScopeRequest,
+		},
+		{
+			name:       "other scope specification",
+			auth:       &types.MetadataScopeAuthorization{MsgTypeUrl: types.TypeURLMsgWriteScopeRequest, SpecificationId: specID},
+			scopeID:    otherScopeID,
+			msgTypeURL: types.TypeURLMsgWriteScopeRequest,
+			errorMsg:   fmt.Sprintf("missing signature from [%s (PARTY_TYPE_OWNER)]", s.user1),
+		},
+		{
+			name: "allowed child message type",
+			auth: &types.MetadataScopeAuthorization{
+				MsgTypeUrl:         types.TypeURLMsgWriteScopeRequest,
+				AllowedMsgTypeUrls: []string{types.TypeURLMsgAddScopeDataAccessRequest},
+			},
+			scopeID:    scopeID,
+			msgTypeURL: types.TypeURLMsgAddScopeDataAccessRequest,
+		},
+		{
+			name: "not allowed child message type",
+			auth: &types.MetadataScopeAuthorization{
+				MsgTypeUrl:         types.TypeURLMsgWriteScopeRequest,
+				AllowedMsgTypeUrls: []string{types.TypeURLMsgAddScopeDataAccessRequest},
+			},
+			scopeID:    scopeID,
+			msgTypeURL: types.TypeURLMsgAddScopeOwnerRequest,
+			errorMsg:   fmt.Sprintf("missing signature from [%s (PARTY_TYPE_OWNER)]", s.user1),
+		},
+	}
+
+	for _, tc := range tests {
+		s.T().Run(tc.name, func(t *testing.T) {
+			require.NoError(t, tc.auth.ValidateBasic(), "ValidateBasic")
+			require.NoError(t, s.app.AuthzKeeper.SaveGrant(s.ctx, s.user2Addr, s.user1Addr, tc.auth, &exp1Hour), "SaveGrant")
+			defer s.app.AuthzKeeper.DeleteGrant(s.ctx, s.user2Addr, s.user1Addr, tc.auth.MsgTypeURL())
+
+			err := s.app.MetadataKeeper.ValidateAllPartiesAreSignersWithAuthz(s.ctx, tc.scopeID, parties, signers, tc.msgTypeURL)
+			if len(tc.errorMsg) == 0 {
+				assert.NoError(t, err, "ValidateAllPartiesAreSignersWithAuthz")
+			} else {
+				assert.EqualError(t, err, tc.errorMsg, "ValidateAllPartiesAreSignersWithAuthz")
+			}
+		})
+	}
+
+	s.T().Run("max uses", func(t *testing.T) {
+		auth := &types.MetadataScopeAuthorization{MsgTypeUrl: types.TypeURLMsgWriteScopeSpecificationRequest, MaxUses: 2}
+		require.NoError(t, s.app.AuthzKeeper.SaveGrant(s.ctx, s.user2Addr, s.user1Addr, auth, &exp1Hour), "SaveGrant")
+
+		owners := []string{s.user1}
+		err := s.app.MetadataKeeper.ValidateAllOwnersAreSignersWithAuthz(s.ctx, owners, signers, types.TypeURLMsgWriteScopeSpecificationRequest)
+		require.NoError(t, err, "first use")
+		stored, _ := s.app.AuthzKeeper.GetAuthorization(s.ctx, s.user2Addr, s.user1Addr, types.TypeURLMsgWriteScopeSpecificationRequest)
+		require.NotNil(t, stored, "authorization after first use")
+		assert.Equal(t, uint64(1), stored.(*types.MetadataScopeAuthorization).MaxUses, "max uses after first use")
+
+		err = s.app.MetadataKeeper.ValidateAllOwnersAreSignersWithAuthz(s.ctx, owners, signers, types.TypeURLMsgAddContractSpecToScopeSpecRequest)
+		require.NoError(t, err, "second use")
+		stored, _ = s.app.AuthzKeeper.GetAuthorization(s.ctx, s.user2Addr, s.user1Addr, types.TypeURLMsgWriteScopeSpecificationRequest)
+		assert.Nil(t, stored, "authorization after last use")
+
+		err = s.app.MetadataKeeper.ValidateAllOwnersAreSignersWithAuthz(s.ctx, owners, signers, types.TypeURLMsgWriteScopeSpecificationRequest)
+		assert.EqualError(t, err, fmt.Sprintf("missing signature from existing owner %s; required for update", s.user1), "third use")
+	})
+
+	s.T().Run("scope restricted grant without a scope", func(t *testing.T) {
+		auth := &types.MetadataScopeAuthorization{MsgTypeUrl: types.TypeURLMsgWriteScopeSpecificationRequest, ScopeIds: []types.MetadataAddress{scopeID}}
+		require.NoError(t, s.app.AuthzKeeper.SaveGrant(s.ctx, s.user2Addr, s.user1Addr, auth, &exp1Hour), "SaveGrant")
+		err := s.app.MetadataKeeper.ValidateAllOwnersAreSignersWithAuthz(s.ctx, []string{s.user1}, signers, types.TypeURLMsgWriteScopeSpecificationRequest)
+		assert.EqualError(t, err, fmt.Sprintf("missing signature from existing owner %s; required for update", s.user1), "ValidateAllOwnersAreSignersWithAuthz")
+	})
+}
+
 func (s *KeeperTestSuite) TestValidateAllOwnersAreSigners() {
 
 	tests := map[string]struct {
@@ -1053,12 +1169,12 @@ func (s *KeeperTestSuite) TestParams() {
 
 func (s *KeeperTestSuite) TestGetOSLocator() {
 	s.Run("get os locator by owner address", func() {
-		r, found := s.app.MetadataKeeper.GetOsLocatorRecord(s.ctx, s.user1Addr)
+		r, found := s.app.MetadataKeeper.GetOsLocatorRecord(s.ctx, s.user1Addr, "")
 		s.Require().NotEmpty(r)
 		s.Require().True(found)
 	})
 	s.Run("not found by owner address", func() {
-		r, found := s.app.MetadataKeeper.GetOsLocatorRecord(s.ctx, sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()))
+		r, found := s.app.MetadataKeeper.GetOsLocatorRecord(s.ctx, sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()), "")
 		s.Require().Empty(r)
 		s.Require().False(found)
 	})
@@ -1077,16 +1193,16 @@ func (s *KeeperTestSuite) TestAddOSLocator() {
 		acc1 := s.app.AccountKeeper.GetAccount(s.ctx, s.user3Addr)
 		s.Require().NotNil(acc1)
 		// create os locator with ^^ account
-		err := s.app.MetadataKeeper.SetOSLocator(s.ctx, s.user3Addr, sdk.AccAddress{}, "https://bob.com/alice")
+		err := s.app.MetadataKeeper.SetOSLocator(s.ctx, types.NewOSLocatorRecord(s.user3Addr, sdk.AccAddress{}, "https://bob.com/alice"))
 		s.Require().Empty(err)
-		r, found := s.app.MetadataKeeper.GetOsLocatorRecord(s.ctx, s.user1Addr)
+		r, found := s.app.MetadataKeeper.GetOsLocatorRecord(s.ctx, s.user1Addr, "")
 		s.Require().NotEmpty(r)
 		s.Require().True(found)
 	})
 
 	s.Run("add os locator account does not exist.", func() {
 		// create account and check default values
-		err := s.app.MetadataKeeper.SetOSLocator(s.ctx, sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()), sdk.AccAddress{}, "https://bob.com/alice")
+		err := s.app.MetadataKeeper.SetOSLocator(s.ctx, types.NewOSLocatorRecord(sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()), sdk.AccAddress{}, "https://bob.com/alice"))
 		s.Require().NotEmpty(err)
 	})
 
@@ -1104,9 +1220,9 @@ func (s *KeeperTestSuite) TestAddOSLocator() {
 		acc1 := s.app.AccountKeeper.GetAccount(s.ctx, user4Addr)
 		s.Require().NotNil(acc1)
 		// create os locator with ^^ account
-		err := s.app.MetadataKeeper.SetOSLocator(s.ctx, user4Addr, s.encryptionKey, "foo.com")
+		err := s.app.MetadataKeeper.SetOSLocator(s.ctx, types.NewOSLocatorRecord(user4Addr, s.encryptionKey, "foo.com"))
 		s.Require().NotEmpty(err)
-		r, found := s.app.MetadataKeeper.GetOsLocatorRecord(s.ctx, user4Addr)
+		r, found := s.app.MetadataKeeper.GetOsLocatorRecord(s.ctx, user4Addr, "")
 		s.Require().Empty(r)
 		s.Require().False(found)
 	})
@@ -1115,9 +1231,9 @@ func (s *KeeperTestSuite) TestAddOSLocator() {
 func (s *KeeperTestSuite) TestModifyOSLocator() {
 	s.Run("modify os locator", func() {
 		// modify os locator
-		err := s.app.MetadataKeeper.ModifyOSLocator(s.ctx, s.user1Addr, s.encryptionKey, "https://bob.com/alice")
+		_, err := s.app.MetadataKeeper.ModifyOSLocator(s.ctx, types.NewOSLocatorRecord(s.user1Addr, s.encryptionKey, "https://bob.com/alice"))
 		s.Require().Empty(err)
-		r, found := s.app.MetadataKeeper.GetOsLocatorRecord(s.ctx, s.user1Addr)
+		r, found := s.app.MetadataKeeper.GetOsLocatorRecord(s.ctx, s.user1Addr, "")
 		s.Require().NotEmpty(r)
 		s.Require().True(found)
 		s.Require().Equal(s.encryptionKey.String(), r.EncryptionKey)
@@ -1125,24 +1241,56 @@ func (s *KeeperTestSuite) TestModifyOSLocator() {
 	})
 	s.Run("modify os locator invalid uri", func() {
 		// modify os locator
-		err := s.app.MetadataKeeper.ModifyOSLocator(s.ctx, s.user1Addr, s.encryptionKey, "://bob.com/alice")
+		_, err := s.app.MetadataKeeper.ModifyOSLocator(s.ctx, types.NewOSLocatorRecord(s.user1Addr, s.encryptionKey, "://bob.com/alice"))
 		s.Require().NotEmpty(err)
 	})
 
 	s.Run("modify os locator invalid uri length", func() {
 		// modify os locator
-		err := s.app.MetadataKeeper.ModifyOSLocator(s.ctx, s.user1Addr, s.encryptionKey1, "https://www.google.com/search?q=long+url+example&oq=long+uril+&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8")
+		_, err := s.app.MetadataKeeper.ModifyOSLocator(s.ctx, types.NewOSLocatorRecord(s.user1Addr, s.encryptionKey1, "https://www.google.com/search?q=long+url+example&oq=long+uril+&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8&aqs=chrome.1.69i57j0i13l9.4447j0j15&sourceid=chrome&ie=UTF-8"))
 		s.Require().NotEmpty(err)
 		s.Require().Equal("uri length greater than allowed", err.Error())
 	})
+
+	s.Run("modify os locator only changes the named fields", func() {
+		validFrom := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+		validUntil := time.Date(2032, 1, 1, 0, 0, 0, 0, time.UTC)
+		locator := types.NewOSLocatorRecord(s.user1Addr, s.encryptionKey, "https://bob.com/alice")
+		locator.Priority = 3
+		locator.KeyValidFrom = &validFrom
+		locator.KeyValidUntil = &validUntil
+		_, err := s.app.MetadataKeeper.ModifyOSLocator(s.ctx, locator, types.OSLocatorFieldPriority,
+			types.OSLocatorFieldKeyValidFrom, types.OSLocatorFieldKeyValidUntil)
+		s.Require().NoError(err, "ModifyOSLocator set priority and validity window")
+
+		retire := types.ObjectStoreLocator{Owner: s.user1, LocatorUri: "https://bob.com/retired",
+			State: types.ObjectStoreLocatorState_OBJECT_STORE_LOCATOR_STATE_RETIRED}
+		updated, err := s.app.MetadataKeeper.ModifyOSLocator(s.ctx, retire, types.OSLocatorFieldLocatorURI, types.OSLocatorFieldState)
+		s.Require().NoError(err, "ModifyOSLocator retire")
+		s.Assert().Equal("https://bob.com/retired", updated.LocatorUri, "updated uri")
+		s.Assert().Equal(types.ObjectStoreLocatorState_OBJECT_STORE_LOCATOR_STATE_RETIRED, updated.State, "updated state")
+		s.Assert().Equal(s.encryptionKey.String(), updated.EncryptionKey, "updated encryption key")
+		s.Assert().Equal(uint32(3), updated.Priority, "updated priority")
+		s.Assert().Equal(&validFrom, updated.KeyValidFrom, "updated key valid from")
+		s.Assert().Equal(&validUntil, updated.KeyValidUntil, "updated key valid until")
+
+		stored, found := s.app.MetadataKeeper.GetOsLocatorRecord(s.ctx, s.user1Addr, "")
+		s.Require().True(found, "GetOsLocatorRecord found")
+		s.Assert().Equal(updated, stored, "stored locator")
+	})
+
+	s.Run("modify os locator unknown field", func() {
+		_, err := s.app.MetadataKeeper.ModifyOSLocator(s.ctx, types.NewOSLocatorRecord(s.user1Addr, s.encryptionKey, "https://bob.com/alice"), "name")
+		s.Require().EqualError(err, `unknown locator update field "name"`)
+	})
 }
 
 func (s *KeeperTestSuite) TestDeleteOSLocator() {
 	s.Run("delete os locator", func() {
 		// modify os locator
-		err := s.app.MetadataKeeper.RemoveOSLocator(s.ctx, s.user1Addr)
+		err := s.app.MetadataKeeper.RemoveOSLocator(s.ctx, s.user1Addr, "")
 		s.Require().Empty(err)
-		r, found := s.app.MetadataKeeper.GetOsLocatorRecord(s.ctx, s.user1Addr)
+		r, found := s.app.MetadataKeeper.GetOsLocatorRecord(s.ctx, s.user1Addr, "")
 		s.Require().Empty(r)
 		s.Require().False(found)
 
@@ -1255,3 +1403,95 @@ func (s *KeeperTestSuite) TestGetMsgTypeURLs() {
 	urls = s.app.MetadataKeeper.GetMessageTypeURLs(types.TypeURLMsgDeleteRecordSpecificationRequest)
 	assert.Equal(s.T(), expected, urls)
 }
+
+func (s *KeeperTestSuite) TestMultipleOSLocators() {
+	now := s.ctx.BlockTime()
+	past, future := now.Add(-time.Hour), now.Add(time.Hour)
+	newLocator := func(name string, priority uint32, uri string) types.ObjectStoreLocator {
+		locator := types.NewOSLocatorRecord(s.user1Addr, sdk.AccAddress{}, uri)
+		locator.Name = name
+		locator.Priority = priority
+		return locator
+	}
+	backup := newLocator("backup", 2, "https://backup.provenance.io")
+	primary := newLocator("primary", 1, "https://primary.provenance.io")
+	primary.KeyValidFrom = &past
+	next := newLocator("next", 3, "https://next.provenance.io")
+	next.KeyValidFrom = &future
+
+	s.Run("bind named locators", func() {
+		s.Require().NoError(s.app.MetadataKeeper.SetOSLocator(s.ctx, backup), "SetOSLocator backup")
+		s.Require().NoError(s.app.MetadataKeeper.SetOSLocator(s.ctx, primary), "SetOSLocator primary")
+		s.Require().NoError(s.app.MetadataKeeper.SetOSLocator(s.ctx, next), "SetOSLocator next")
+		s.Assert().ErrorIs(s.app.MetadataKeeper.SetOSLocator(s.ctx, backup), types.ErrOSLocatorAlreadyBound, "SetOSLocator backup again")
+
+		locators, err := s.app.MetadataKeeper.GetOSLocatorsForOwner(s.ctx, s.user1Addr)
+		s.Require().NoError(err, "GetOSLocatorsForOwner")
+		names := make([]string, len(locators))
+		for i, locator := range locators {
+			names[i] = locator.Name
+		}
+		s.Assert().Equal([]string{types.DefaultOSLocatorName, "primary", "backup", "next"}, names, "locator names in priority order")
+	})
+
+	scopeID := types.ScopeMetadataAddress(uuid.New())
+	s.app.MetadataKeeper.SetScope(s.ctx, *types.NewScope(scopeID, nil, ownerPartyList(s.user1, s.user2), nil, ""))
+	byScope := func() []string {
+		locators, err := s.app.MetadataKeeper.GetOSLocatorByScope(s.ctx, scopeID.String())
+		s.Require().NoError(err, "GetOSLocatorByScope")
+		uris := make([]string, len(locators))
+		for i, locator := range locators {
+			uris[i] = locator.LocatorUri
+		}
+		return uris
+	}
+
+	s.Run("active locators by scope", func() {
+		s.Assert().Equal([]string{s.uri, primary.LocatorUri, backup.LocatorUri, s.uri1}, byScope(), "locator uris by scope")
+	})
+
+	s.Run("retired and expired locators are not active", func() {
+		retired := s.objectLocator
+		retired.State = types.ObjectStoreLocatorState_OBJECT_STORE_LOCATOR_STATE_RETIRED
+		_, err := s.app.MetadataKeeper.ModifyOSLocator(s.ctx, retired, types.OSLocatorFieldState)
+		s.Require().NoError(err, "ModifyOSLocator retire default")
+		rotated := backup
+		rotated.KeyValidUntil = &now
+		_, err = s.app.MetadataKeeper.ModifyOSLocator(s.ctx, rotated, types.OSLocatorFieldKeyValidUntil)
+		s.Require().NoError(err, "ModifyOSLocator expire backup key")
+		s.Assert().Equal([]string{primary.LocatorUri, s.uri1}, byScope(), "locator uris by scope")
+
+		preferred, found := s.app.MetadataKeeper.GetPreferredOSLocator(s.ctx, s.user1Addr)
+		s.Require().True(found, "GetPreferredOSLocator found")
+		s.Assert().Equal("primary", preferred.Name, "preferred locator name")
+	})
+
+	s.Run("query by owner and name", func() {
+		res, err := s.queryClient.OSLocator(s.ctx.Context(), &types.OSLocatorRequest{Owner: s.user1})
+		s.Require().NoError(err, "OSLocator")
+		s.Assert().Len(res.Locators, 4, "owner locators")
+		res, err = s.queryClient.OSLocator(s.ctx.Context(), &types.OSLocatorRequest{Owner: s.user1, Name: "backup"})
+		s.Require().NoError(err, "OSLocator by name")
+		s.Require().NotNil(res.Locator, "OSLocator by name locator")
+		s.Assert().Equal(backup.LocatorUri, res.Locator.LocatorUri, "OSLocator by name uri")
+	})
+
+	s.Run("locator limit per owner", func() {
+		params := s.app.MetadataKeeper.GetOSLocatorParams(s.ctx)
+		defer s.app.MetadataKeeper.SetOSLocatorParams(s.ctx, params)
+		s.app.MetadataKeeper.SetOSLocatorParams(s.ctx, types.NewOSLocatorParams(params.MaxUriLength, 4))
+		extra := newLocator("extra", 5, "https://extra.provenance.io")
+		s.Assert().ErrorIs(s.app.MetadataKeeper.SetOSLocator(s.ctx, extra), types.ErrOSLocatorLimitReached, "SetOSLocator over limit")
+		s.Assert().False(s.app.MetadataKeeper.OSLocatorExists(s.ctx, s.user1Addr, "extra"), "extra exists")
+
+		s.app.MetadataKeeper.SetOSLocatorParams(s.ctx, types.NewOSLocatorParams(params.MaxUriLength, 5))
+		s.Require().NoError(s.app.MetadataKeeper.SetOSLocator(s.ctx, extra), "SetOSLocator under limit")
+		s.Require().NoError(s.app.MetadataKeeper.RemoveOSLocator(s.ctx, s.user1Addr, "extra"), "RemoveOSLocator extra")
+	})
+
+	s.Run("delete named locator", func() {
+		s.Require().NoError(s.app.MetadataKeeper.RemoveOSLocator(s.ctx, s.user1Addr, "primary"), "RemoveOSLocator primary")
+		s.Assert().False(s.app.MetadataKeeper.OSLocatorExists(s.ctx, s.user1Addr, "primary"), "primary exists after removal")
+		s.Assert().True(s.app.MetadataKeeper.OSLocatorExists(s.ctx, s.user1Addr, "backup"), "backup exists after removal of primary")
+	})
+}
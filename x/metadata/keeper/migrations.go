@@ -93,6 +93,38 @@ func (m *Migrator) Migrate2to3(ctx sdk.Context) error {
 	return nil
 }
 
+// Migrate3to4 migrates from version 3 to 4 to add the scope owner role and data access indexes.
+func (m *Migrator) Migrate3to4(ctx sdk.Context) error {
+	ctx.Logger().Info("Migrating Metadata Module from Version 3 to 4")
+	err := indexScopeOwnerRolesAndDataAccess(ctx, m.keeper)
+	ctx.Logger().Info("Finished Migrating Metadata Module from Version 3 to 4")
+	return err
+}
+
+// Migrate4to5 migrates from version 4 to 5 to move each owner's object store locator under the default locator name.
+func (m *Migrator) Migrate4to5(ctx sdk.Context) error {
+	ctx.Logger().Info("Migrating Metadata Module from Version 4 to 5")
+	err := nameOSLocators(ctx, m.keeper)
+	ctx.Logger().Info("Finished Migrating Metadata Module from Version 4 to 5")
+	return err
+}
+
+// Migrate5to6 migrates from version 5 to 6 to normalize the data created through the p8e messages.
+func (m *Migrator) Migrate5to6(ctx sdk.Context) error {
+	ctx.Logger().Info("Migrating Metadata Module from Version 5 to 6")
+	err := normalizeP8eData(ctx, m.keeper)
+	ctx.Logger().Info("Finished Migrating Metadata Module from Version 5 to 6")
+	return err
+}
+
+// Migrate6to7 migrates from version 6 to 7 to add the session party index.
+func (m *Migrator) Migrate6to7(ctx sdk.Context) error {
+	ctx.Logger().Info("Migrating Metadata Module from Version 6 to 7")
+	err := indexSessionParties(ctx, m.keeper)
+	ctx.Logger().Info("Finished Migrating Metadata Module from Version 6 to 7")
+	return err
+}
+
 // keyLookup is a map used to identify known keys.
 type keyLookup map[string]struct{}
 
@@ -203,6 +235,80 @@ func reindexScopes(ctx sdk.Context, mdKeeper Keeper, lookup keyLookup) error {
 	return rv
 }
 
+// indexScopeOwnerRolesAndDataAccess creates the owner role and data access indexes for all scopes.
+// This is a function for a migration, not intended for outside use.
+func indexScopeOwnerRolesAndDataAccess(ctx sdk.Context, mdKeeper Keeper) error {
+	store := ctx.KVStore(mdKeeper.storeKey)
+	i := 0
+	rv := mdKeeper.IterateScopes(ctx, func(scope types.Scope) (stop bool) {
+		i++
+		for _, key := range getScopeIndexValues(&scope).IndexKeys() {
+			if key[0] == types.OwnerRoleScopeCacheKeyPrefix[0] || key[0] == types.DataAccessScopeCacheKeyPrefix[0] {
+				store.Set(key, []byte{0x01})
+			}
+		}
+		if i%10000 == 0 {
+			ctx.Logger().Info(fmt.Sprintf("Indexed %d scopes.", i))
+		}
+		return false
+	})
+	ctx.Logger().Info(fmt.Sprintf("Done indexing owner roles and data access of %d scopes.", i))
+	return rv
+}
+
+// indexSessionParties creates the party index entries for all sessions.
+// This is a function for a migration, not intended for outside use.
+func indexSessionParties(ctx sdk.Context, mdKeeper Keeper) error {
+	store := ctx.KVStore(mdKeeper.storeKey)
+	i := 0
+	rv := mdKeeper.IterateSessions(ctx, types.MetadataAddress{}, func(session types.Session) (stop bool) {
+		i++
+		for _, key := range getSessionIndexKeys(&session) {
+			store.Set(key, []byte{0x01})
+		}
+		if i%10000 == 0 {
+			ctx.Logger().Info(fmt.Sprintf("Indexed %d sessions.", i))
+		}
+		return false
+	})
+	ctx.Logger().Info(fmt.Sprintf("Done indexing parties of %d sessions.", i))
+	return rv
+}
+
+// nameOSLocators moves each object store locator stored under just its owner's address
+// to a key with the default locator name, setting that name on the locator.
+// This is a function for a migration, not intended for outside use.
+func nameOSLocators(ctx sdk.Context, mdKeeper Keeper) error {
+	store := prefix.NewStore(ctx.KVStore(mdKeeper.storeKey), types.OSLocatorAddressKeyPrefix)
+	var oldKeys [][]byte
+	it := store.Iterator(nil, nil)
+	for ; it.Valid(); it.Next() {
+		// Unnamed keys are just the length-prefixed owner address.
+		key := it.Key()
+		if len(key) > 0 && len(key) == 1+int(key[0]) {
+			oldKeys = append(oldKeys, key)
+		}
+	}
+	it.Close()
+
+	for _, key := range oldKeys {
+		var locator types.ObjectStoreLocator
+		if err := mdKeeper.cdc.Unmarshal(store.Get(key), &locator); err != nil {
+			return err
+		}
+		locator.Name = types.DefaultOSLocatorName
+		bz, err := mdKeeper.cdc.Marshal(&locator)
+		if err != nil {
+			return err
+		}
+		store.Delete(key)
+		ownerAddr := sdk.AccAddress(key[1:])
+		ctx.KVStore(mdKeeper.storeKey).Set(types.GetOSLocatorKey(ownerAddr, locator.Name), bz)
+	}
+	ctx.Logger().Info(fmt.Sprintf("Done naming %d object store locators.", len(oldKeys)))
+	return nil
+}
+
 // reindexScopeSpecs creates all missing scope specification indexes.
 // This is a function for a migration, not intended for outside use.
 func reindexScopeSpecs(ctx sdk.Context, mdKeeper Keeper, lookup keyLookup) error {
@@ -319,3 +425,58 @@ func deleteSessions(ctx sdk.Context, mdKeeper Keeper, sessionsToDelete []types.M
 	ctx.Logger().Info(fmt.Sprintf("Done deleting %d empty sessions.", len(sessionsToDelete)))
 	return nil
 }
+
+// normalizeP8eData normalizes all p8e-origin records and logs the data that still needs attention.
+// This is a function for a migration, not intended for outside use.
+func normalizeP8eData(ctx sdk.Context, mdKeeper Keeper) error {
+	report, err := types.NormalizeP8eData(&p8eDataStore{ctx: ctx, keeper: mdKeeper})
+	if err != nil {
+		return err
+	}
+	for _, gap := range report.Gaps {
+		ctx.Logger().Info("p8e data gap", "id", gap.ID, "issue", gap.Issue)
+	}
+	ctx.Logger().Info(fmt.Sprintf("Done normalizing p8e data. Found %d contract specs, %d scopes and %d records. Normalized %d records. Found %d gaps.",
+		report.ContractSpecifications, report.Scopes, report.Records, report.NormalizedRecords, len(report.Gaps)))
+	return nil
+}
+
+// p8eDataStore is a types.P8eDataStore backed by the metadata keeper.
+type p8eDataStore struct {
+	ctx    sdk.Context
+	keeper Keeper
+}
+
+var _ types.P8eDataStore = &p8eDataStore{}
+
+func (s *p8eDataStore) IterateContractSpecs(handler func(types.ContractSpecification) (stop bool)) error {
+	return s.keeper.IterateContractSpecs(s.ctx, handler)
+}
+
+func (s *p8eDataStore) IterateRecords(handler func(types.Record) (stop bool)) error {
+	return s.keeper.IterateRecords(s.ctx, types.MetadataAddress{}, handler)
+}
+
+func (s *p8eDataStore) GetScope(id types.MetadataAddress) (types.Scope, bool) {
+	return s.keeper.GetScope(s.ctx, id)
+}
+
+func (s *p8eDataStore) GetSession(id types.MetadataAddress) (types.Session, bool) {
+	return s.keeper.GetSession(s.ctx, id)
+}
+
+func (s *p8eDataStore) GetRecord(id types.MetadataAddress) (types.Record, bool) {
+	return s.keeper.GetRecord(s.ctx, id)
+}
+
+func (s *p8eDataStore) GetScopeSpecification(id types.MetadataAddress) (types.ScopeSpecification, bool) {
+	return s.keeper.GetScopeSpecification(s.ctx, id)
+}
+
+func (s *p8eDataStore) GetRecordSpecification(id types.MetadataAddress) (types.RecordSpecification, bool) {
+	return s.keeper.GetRecordSpecification(s.ctx, id)
+}
+
+func (s *p8eDataStore) SetRecord(record types.Record) {
+	s.keeper.SetRecord(s.ctx, record)
+}
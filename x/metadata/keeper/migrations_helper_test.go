@@ -85,3 +85,8 @@ func IndexScopeBad(store *sdk.KVStore, scope *types.Scope) {
 		}
 	}
 }
+
+// WithSpecCache exposes withSpecCache for unit tests.
+func (k Keeper) WithSpecCache() Keeper {
+	return k.withSpecCache()
+}
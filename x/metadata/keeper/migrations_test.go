@@ -2,6 +2,8 @@ package keeper_test
 
 import (
 	"bytes"
+	"crypto/sha512"
+	"encoding/base64"
 	"fmt"
 	"math/rand"
 	"sort"
@@ -1091,3 +1093,145 @@ func (s *MigrationsTestSuite) Test2To3() {
 		// but it would deadlock with scopeCount := ScopeSpecCount * 2 * 8.
 	})
 }
+
+func (s *MigrationsTestSuite) Test3To4() {
+	owner := sdk.AccAddress("owner_______________")
+	servicer := sdk.AccAddress("servicer____________")
+	reader := sdk.AccAddress("reader______________")
+	scope := types.Scope{
+		ScopeId: types.ScopeMetadataAddress(uuid.New()),
+		Owners: []types.Party{
+			{Address: owner.String(), Role: types.PartyType_PARTY_TYPE_OWNER},
+			{Address: servicer.String(), Role: types.PartyType_PARTY_TYPE_SERVICER},
+		},
+		DataAccess:        []string{reader.String()},
+		ValueOwnerAddress: owner.String(),
+	}
+	// Write the scope directly so that none of the new indexes exist yet.
+	bz, err := s.app.AppCodec().Marshal(&scope)
+	s.Require().NoError(err, "marshalling scope")
+	s.store.Set(scope.ScopeId, bz)
+
+	expKeys := [][]byte{
+		types.GetOwnerRoleScopeCacheKey(owner, types.PartyType_PARTY_TYPE_OWNER, scope.ScopeId),
+		types.GetOwnerRoleScopeCacheKey(servicer, types.PartyType_PARTY_TYPE_SERVICER, scope.ScopeId),
+		types.GetDataAccessScopeCacheKey(reader, scope.ScopeId),
+	}
+	for i, key := range expKeys {
+		s.Assert().False(s.store.Has(key), "key %d exists before migration", i)
+	}
+
+	migrator := keeper.NewMigrator(s.app.MetadataKeeper)
+	s.Require().NoError(migrator.Migrate3to4(s.ctx), "running migration v3 to v4")
+
+	for i, key := range expKeys {
+		s.Assert().True(s.store.Has(key), "key %d exists after migration", i)
+	}
+	s.Assert().False(s.store.Has(types.GetAddressScopeCacheKey(owner, scope.ScopeId)), "migration should only add the new indexes")
+}
+
+func (s *MigrationsTestSuite) Test4To5() {
+	owner := sdk.AccAddress("owner_______________")
+	locator := types.ObjectStoreLocator{Owner: owner.String(), LocatorUri: "https://provenance.io"}
+	// Write the locator under its unnamed key the way it was stored before names.
+	bz, err := s.app.AppCodec().Marshal(&locator)
+	s.Require().NoError(err, "marshalling locator")
+	oldKey := types.GetOSLocatorOwnerIteratorPrefix(owner)
+	s.store.Set(oldKey, bz)
+
+	migrator := keeper.NewMigrator(s.app.MetadataKeeper)
+	s.Require().NoError(migrator.Migrate4to5(s.ctx), "running migration v4 to v5")
+
+	s.Assert().False(s.store.Has(oldKey), "unnamed key exists after migration")
+	migrated, found := s.app.MetadataKeeper.GetOsLocatorRecord(s.ctx, owner, types.DefaultOSLocatorName)
+	s.Require().True(found, "default locator found after migration")
+	locator.Name = types.DefaultOSLocatorName
+	s.Assert().Equal(locator, migrated, "migrated locator")
+	s.Assert().True(migrated.IsActive(s.ctx.BlockTime()), "migrated locator is active")
+
+	s.Require().NoError(migrator.Migrate4to5(s.ctx), "running migration v4 to v5 again")
+	locators, err := s.app.MetadataKeeper.GetOSLocatorsForOwner(s.ctx, owner)
+	s.Require().NoError(err, "GetOSLocatorsForOwner")
+	s.Assert().Equal([]types.ObjectStoreLocator{locator}, locators, "locators after second migration")
+}
+
+func (s *MigrationsTestSuite) Test5To6() {
+	hash := sha512.Sum512([]byte("a p8e contract spec"))
+	cSpecUUID, err := uuid.FromBytes(hash[:16])
+	s.Require().NoError(err, "uuid.FromBytes")
+	cSpec := types.ContractSpecification{
+		SpecificationId: types.ContractSpecMetadataAddress(cSpecUUID),
+		OwnerAddresses:  []string{sdk.AccAddress("owner_______________").String()},
+		PartiesInvolved: []types.PartyType{types.PartyType_PARTY_TYPE_OWNER},
+		Source:          types.NewContractSpecificationSourceHash(base64.StdEncoding.EncodeToString(hash[:])),
+		ClassName:       "io.provenance.Contract",
+	}
+	s.app.MetadataKeeper.SetContractSpecification(s.ctx, cSpec)
+	rSpec := types.RecordSpecification{
+		SpecificationId:    types.RecordSpecMetadataAddress(cSpecUUID, "record"),
+		Name:               "record",
+		TypeName:           "io.provenance.Record",
+		ResultType:         types.DefinitionType_DEFINITION_TYPE_RECORD,
+		ResponsibleParties: []types.PartyType{types.PartyType_PARTY_TYPE_OWNER},
+	}
+	s.app.MetadataKeeper.SetRecordSpecification(s.ctx, rSpec)
+
+	scopeUUID := uuid.New()
+	session := types.Session{
+		SessionId:       types.SessionMetadataAddress(scopeUUID, uuid.New()),
+		SpecificationId: cSpec.SpecificationId,
+		Name:            "io.provenance.Contract",
+	}
+	s.app.MetadataKeeper.SetSession(s.ctx, session)
+	record := types.Record{
+		Name:      "record",
+		SessionId: session.SessionId,
+		Process:   types.Process{ProcessId: &types.Process_Hash{Hash: "processhash"}, Name: "io.provenance.Record", Method: "record"},
+		Inputs: []types.RecordInput{
+			{Name: "input", TypeName: "io.provenance.Input", Source: &types.RecordInput_Hash{Hash: "inputhash"}, Status: types.RecordInputStatus_Record},
+		},
+		Outputs: []types.RecordOutput{{Hash: "outputhash", Status: types.ResultStatus_RESULT_STATUS_PASS}},
+	}
+	s.app.MetadataKeeper.SetRecord(s.ctx, record)
+
+	migrator := keeper.NewMigrator(s.app.MetadataKeeper)
+	s.Require().NoError(migrator.Migrate5to6(s.ctx), "running migration v5 to v6")
+
+	migrated, found := s.app.MetadataKeeper.GetRecord(s.ctx, types.RecordMetadataAddress(scopeUUID, "record"))
+	s.Require().True(found, "record found after migration")
+	record.SpecificationId = rSpec.SpecificationId
+	record.Inputs[0].Status = types.RecordInputStatus_Proposed
+	s.Assert().Equal(record, migrated, "migrated record")
+	s.Assert().NoError(migrated.ValidateBasic(), "migrated record ValidateBasic")
+}
+
+func (s *MigrationsTestSuite) Test6To7() {
+	owner := sdk.AccAddress("owner_______________")
+	affiliate := sdk.AccAddress("affiliate___________")
+	session := types.Session{
+		SessionId: types.SessionMetadataAddress(uuid.New(), uuid.New()),
+		Parties: []types.Party{
+			{Address: owner.String(), Role: types.PartyType_PARTY_TYPE_OWNER},
+			{Address: affiliate.String(), Role: types.PartyType_PARTY_TYPE_AFFILIATE},
+		},
+	}
+	// Write the session directly so that the party index doesn't exist yet.
+	bz, err := s.app.AppCodec().Marshal(&session)
+	s.Require().NoError(err, "marshalling session")
+	s.store.Set(session.SessionId, bz)
+
+	expKeys := [][]byte{
+		types.GetPartyRoleSessionCacheKey(owner, types.PartyType_PARTY_TYPE_OWNER, session.SessionId),
+		types.GetPartyRoleSessionCacheKey(affiliate, types.PartyType_PARTY_TYPE_AFFILIATE, session.SessionId),
+	}
+	for i, key := range expKeys {
+		s.Assert().False(s.store.Has(key), "key %d exists before migration", i)
+	}
+
+	migrator := keeper.NewMigrator(s.app.MetadataKeeper)
+	s.Require().NoError(migrator.Migrate6to7(s.ctx), "running migration v6 to v7")
+
+	for i, key := range expKeys {
+		s.Assert().True(s.store.Has(key), "key %d exists after migration", i)
+	}
+}
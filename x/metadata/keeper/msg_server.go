@@ -48,6 +48,49 @@ func (k msgServer) WriteScope(
 	return types.NewMsgWriteScopeResponse(msg.Scope.ScopeId), nil
 }
 
+func (k msgServer) WriteScopeBundle(
+	goCtx context.Context,
+	msg *types.MsgWriteScopeBundleRequest,
+) (*types.MsgWriteScopeBundleResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "tx", "WriteScopeBundle")
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Everything is written to a cache that's only committed once every bundle has been applied.
+	// The individual scope, session, and record events are dropped in favor of one event per bundle.
+	cms := ctx.MultiStore().CacheMultiStore()
+	writeCtx := ctx.WithMultiStore(cms).WithEventManager(sdk.NewEventManager())
+	// Specifications are usually shared by the items in a bundle, so they're only looked up once.
+	bk := k.Keeper.withSpecCache()
+
+	auditBy := strings.Join(msg.Signers, ", ")
+	resp := &types.MsgWriteScopeBundleResponse{}
+	for i, bundle := range msg.Bundles {
+		if err := bk.writeScopeBundle(writeCtx, bundle, msg.Signers, auditBy, msg.MsgTypeURL()); err != nil {
+			return nil, fmt.Errorf("bundle [%d]: %w", i, err)
+		}
+		resp.ScopeIdInfos = append(resp.ScopeIdInfos, types.GetScopeIDInfo(bundle.Scope.ScopeId))
+	}
+	cms.Write()
+
+	// The detailed events are kept though, since they're needed to follow the changes from events alone.
+	if k.GetEmitDetailedEvents(ctx) {
+		detailedTypes := types.DetailedEventTypes()
+		for _, event := range writeCtx.EventManager().Events() {
+			for _, t := range detailedTypes {
+				if event.Type == t {
+					ctx.EventManager().EmitEvent(event)
+					break
+				}
+			}
+		}
+	}
+	for _, bundle := range msg.Bundles {
+		k.EmitEvent(ctx, types.NewEventScopeBundleWritten(bundle.Scope.ScopeId, len(bundle.Sessions), len(bundle.Records)))
+	}
+	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_WriteScopeBundle, msg.GetSigners()))
+	return resp, nil
+}
+
 func (k msgServer) DeleteScope(
 	goCtx context.Context,
 	msg *types.MsgDeleteScopeRequest,
@@ -85,11 +128,14 @@ func (k msgServer) AddScopeDataAccess(
 		return nil, fmt.Errorf("scope not found with id %s", msg.ScopeId)
 	}
 
-	if err := k.ValidateScopeAddDataAccess(ctx, msg.DataAccess, existing, msg.Signers, msg.MsgTypeURL()); err != nil {
+	// Access that has expired, but not yet been pruned, can be added again.
+	existing.RemoveExpiredDataAccess(ctx.BlockTime())
+
+	if err := k.ValidateScopeAddDataAccess(ctx, msg.DataAccess, msg.Expiration, existing, msg.Signers, msg.MsgTypeURL()); err != nil {
 		return nil, err
 	}
 
-	existing.AddDataAccess(msg.DataAccess)
+	existing.AddDataAccessGrants(msg.DataAccess, msg.Role, msg.Expiration)
 
 	k.SetScope(ctx, existing)
 
@@ -185,6 +231,151 @@ func (k msgServer) DeleteScopeOwner(
 	return types.NewMsgDeleteScopeOwnerResponse(), nil
 }
 
+func (k msgServer) TokenizeScopeValueOwner(
+	goCtx context.Context,
+	msg *types.MsgTokenizeScopeValueOwnerRequest,
+) (*types.MsgTokenizeScopeValueOwnerResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "tx", "TokenizeScopeValueOwner")
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	existing, found := k.GetScope(ctx, msg.ScopeId)
+	if !found {
+		return nil, fmt.Errorf("scope not found with id %s", msg.ScopeId)
+	}
+
+	if err := k.ValidateScopeTokenizeValueOwner(ctx, existing, msg.Signers, msg.MsgTypeURL()); err != nil {
+		return nil, err
+	}
+
+	markerAddr, err := k.CreateValueOwnerToken(ctx, existing, msg.Supply)
+	if err != nil {
+		return nil, err
+	}
+
+	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_TokenizeScopeValueOwner, msg.GetSigners()))
+	return types.NewMsgTokenizeScopeValueOwnerResponse(types.ValueOwnerTokenDenom(msg.ScopeId), markerAddr), nil
+}
+
+func (k msgServer) OfferScopeSale(
+	goCtx context.Context,
+	msg *types.MsgOfferScopeSaleRequest,
+) (*types.MsgOfferScopeSaleResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "tx", "OfferScopeSale")
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	existing, found := k.GetScope(ctx, msg.ScopeId)
+	if !found {
+		return nil, fmt.Errorf("scope not found with id %s", msg.ScopeId)
+	}
+
+	if err := k.ValidateScopeSaleOffer(ctx, existing, msg.Expiration, msg.Signers, msg.MsgTypeURL()); err != nil {
+		return nil, err
+	}
+
+	offer := types.ScopeSaleOffer{
+		ScopeId:    msg.ScopeId,
+		Seller:     existing.ValueOwnerAddress,
+		Buyer:      msg.Buyer,
+		Price:      msg.Price,
+		Expiration: msg.Expiration,
+	}
+	k.SetScopeSaleOffer(ctx, offer)
+
+	k.EmitEvent(ctx, types.NewEventScopeSaleOffered(offer))
+	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_OfferScopeSale, msg.GetSigners()))
+	return types.NewMsgOfferScopeSaleResponse(), nil
+}
+
+func (k msgServer) CancelScopeSale(
+	goCtx context.Context,
+	msg *types.MsgCancelScopeSaleRequest,
+) (*types.MsgCancelScopeSaleResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "tx", "CancelScopeSale")
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	offer, found := k.GetScopeSaleOffer(ctx, msg.ScopeId)
+	if !found {
+		return nil, fmt.Errorf("no sale offer found for scope %s", msg.ScopeId)
+	}
+
+	if err := k.ValidateScopeSaleCancel(ctx, offer, msg.Signers, msg.MsgTypeURL()); err != nil {
+		return nil, err
+	}
+
+	k.RemoveScopeSaleOffer(ctx, msg.ScopeId)
+
+	k.EmitEvent(ctx, types.NewEventScopeSaleCancelled(msg.ScopeId))
+	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_CancelScopeSale, msg.GetSigners()))
+	return types.NewMsgCancelScopeSaleResponse(), nil
+}
+
+func (k msgServer) AcceptScopeSale(
+	goCtx context.Context,
+	msg *types.MsgAcceptScopeSaleRequest,
+) (*types.MsgAcceptScopeSaleResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "tx", "AcceptScopeSale")
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	existing, found := k.GetScope(ctx, msg.ScopeId)
+	if !found {
+		return nil, fmt.Errorf("scope not found with id %s", msg.ScopeId)
+	}
+	offer, found := k.GetScopeSaleOffer(ctx, msg.ScopeId)
+	if !found {
+		return nil, fmt.Errorf("no sale offer found for scope %s", msg.ScopeId)
+	}
+
+	if err := k.ValidateScopeSaleAccept(ctx, existing, offer, msg.Buyer, msg.Price, msg.Signers, msg.MsgTypeURL()); err != nil {
+		return nil, err
+	}
+
+	// The payment and the value owner change are part of the same msg, so either both happen or neither does.
+	buyerAddr, err := sdk.AccAddressFromBech32(msg.Buyer)
+	if err != nil {
+		return nil, err
+	}
+	sellerAddr, err := sdk.AccAddressFromBech32(offer.Seller)
+	if err != nil {
+		return nil, err
+	}
+	if err = k.PayScopeSale(ctx, buyerAddr, sellerAddr, offer.Price, msg.Signers); err != nil {
+		return nil, fmt.Errorf("could not pay for scope %s: %w", msg.ScopeId, err)
+	}
+
+	k.RemoveScopeSaleOffer(ctx, msg.ScopeId)
+	existing.ValueOwnerAddress = msg.Buyer
+	k.SetScope(ctx, existing)
+
+	k.EmitEvent(ctx, types.NewEventScopeSold(msg.ScopeId, offer.Seller, msg.Buyer, offer.Price))
+	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_AcceptScopeSale, msg.GetSigners()))
+	return types.NewMsgAcceptScopeSaleResponse(), nil
+}
+
+func (k msgServer) MigrateScopeSpecification(
+	goCtx context.Context,
+	msg *types.MsgMigrateScopeSpecificationRequest,
+) (*types.MsgMigrateScopeSpecificationResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "tx", "MigrateScopeSpecification")
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	existing, found := k.GetScope(ctx, msg.ScopeId)
+	if !found {
+		return nil, fmt.Errorf("scope not found with id %s", msg.ScopeId)
+	}
+
+	if err := k.ValidateScopeSpecificationMigration(ctx, existing, msg.SpecificationId, msg.Signers, msg.MsgTypeURL()); err != nil {
+		return nil, err
+	}
+
+	oldSpecID := existing.SpecificationId
+	existing.SpecificationId = msg.SpecificationId
+	k.SetScope(ctx, existing)
+
+	k.EmitEvent(ctx, types.NewEventScopeSpecificationMigrated(msg.ScopeId, oldSpecID, msg.SpecificationId))
+	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_MigrateScopeSpecification, msg.GetSigners()))
+	return types.NewMsgMigrateScopeSpecificationResponse(), nil
+}
+
 func (k msgServer) WriteSession(
 	goCtx context.Context,
 	msg *types.MsgWriteSessionRequest,
@@ -239,6 +430,7 @@ func (k msgServer) WriteRecord(
 	}
 
 	k.SetRecord(ctx, msg.Record)
+	k.addRecordVersion(ctx, existing, msg.Record)
 
 	// Remove the old session if it doesn't have any records in it anymore.
 	// Note that the RemoveSession does the record checking part.
@@ -542,6 +734,10 @@ func (k msgServer) WriteP8EContractSpec(
 	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "tx", "WriteP8EContractSpec")
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	if k.GetDisableP8eMessages(ctx) {
+		return nil, types.ErrP8eMessagesDisabled
+	}
+
 	proposed, newrecords, err := types.ConvertP8eContractSpec(&msg.Contractspec, msg.Signers)
 	if err != nil {
 		return nil, err
@@ -586,6 +782,10 @@ func (k msgServer) P8EMemorializeContract(
 	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "tx", "P8EMemorializeContract")
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	if k.GetDisableP8eMessages(ctx) {
+		return nil, types.ErrP8eMessagesDisabled
+	}
+
 	p8EData, err := types.ConvertP8eMemorializeContractRequest(msg)
 	if err != nil {
 		return nil, err
@@ -648,17 +848,14 @@ func (k msgServer) BindOSLocator(
 
 	// already valid address, checked in ValidateBasic
 	ownerAddress, _ := sdk.AccAddressFromBech32(msg.Locator.Owner)
-	encryptionKey := sdk.AccAddress{}
-	if strings.TrimSpace(msg.Locator.EncryptionKey) != "" {
-		encryptionKey, _ = sdk.AccAddressFromBech32(msg.Locator.EncryptionKey)
-	}
-	if k.Keeper.OSLocatorExists(ctx, ownerAddress) {
-		ctx.Logger().Error("Address already bound to an URI", "owner", msg.Locator.Owner)
+	msg.Locator.Name = types.NormalizeOSLocatorName(msg.Locator.Name)
+	if k.Keeper.OSLocatorExists(ctx, ownerAddress, msg.Locator.Name) {
+		ctx.Logger().Error("Address already bound to an URI", "owner", msg.Locator.Owner, "name", msg.Locator.Name)
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(types.ErrOSLocatorAlreadyBound.Error())
 	}
 
 	// Bind owner to URI
-	if err := k.Keeper.SetOSLocator(ctx, ownerAddress, encryptionKey, msg.Locator.LocatorUri); err != nil {
+	if err := k.Keeper.SetOSLocator(ctx, msg.Locator); err != nil {
 		ctx.Logger().Error("unable to bind name", "err", err)
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
@@ -681,19 +878,20 @@ func (k msgServer) DeleteOSLocator(
 
 	// already valid address, checked in ValidateBasic
 	ownerAddr, _ := sdk.AccAddressFromBech32(msg.Locator.Owner)
+	msg.Locator.Name = types.NormalizeOSLocatorName(msg.Locator.Name)
 
-	if !k.Keeper.OSLocatorExists(ctx, ownerAddr) {
-		ctx.Logger().Error("Address not already bound to an URI", "owner", msg.Locator.Owner)
+	if !k.Keeper.OSLocatorExists(ctx, ownerAddr, msg.Locator.Name) {
+		ctx.Logger().Error("Address not already bound to an URI", "owner", msg.Locator.Owner, "name", msg.Locator.Name)
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(types.ErrOSLocatorAlreadyBound.Error())
 	}
 
-	if !k.Keeper.VerifyCorrectOwner(ctx, ownerAddr) {
+	if !k.Keeper.VerifyCorrectOwner(ctx, ownerAddr, msg.Locator.Name) {
 		ctx.Logger().Error("msg sender cannot delete os locator", "owner", ownerAddr)
 		return nil, sdkerrors.ErrUnauthorized.Wrap("msg sender cannot delete os locator.")
 	}
 
 	// Delete
-	if err := k.Keeper.RemoveOSLocator(ctx, ownerAddr); err != nil {
+	if err := k.Keeper.RemoveOSLocator(ctx, ownerAddr, msg.Locator.Name); err != nil {
 		ctx.Logger().Error("error deleting name", "err", err)
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
@@ -716,26 +914,24 @@ func (k msgServer) ModifyOSLocator(
 
 	// already valid address(es), checked in ValidateBasic
 	ownerAddr, _ := sdk.AccAddressFromBech32(msg.Locator.Owner)
-	encryptionKey := sdk.AccAddress{}
-	if strings.TrimSpace(msg.Locator.EncryptionKey) != "" {
-		encryptionKey, _ = sdk.AccAddressFromBech32(msg.Locator.EncryptionKey)
-	}
+	msg.Locator.Name = types.NormalizeOSLocatorName(msg.Locator.Name)
 
-	if !k.Keeper.OSLocatorExists(ctx, ownerAddr) {
-		ctx.Logger().Error("Address not already bound to an URI", "owner", msg.Locator.Owner)
+	if !k.Keeper.OSLocatorExists(ctx, ownerAddr, msg.Locator.Name) {
+		ctx.Logger().Error("Address not already bound to an URI", "owner", msg.Locator.Owner, "name", msg.Locator.Name)
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(types.ErrOSLocatorAlreadyBound.Error())
 	}
 
-	if !k.Keeper.VerifyCorrectOwner(ctx, ownerAddr) {
+	if !k.Keeper.VerifyCorrectOwner(ctx, ownerAddr, msg.Locator.Name) {
 		ctx.Logger().Error("msg sender cannot modify os locator", "owner", ownerAddr)
 		return nil, sdkerrors.ErrUnauthorized.Wrap("msg sender cannot delete os locator.")
 	}
 	// Modify
-	if err := k.Keeper.ModifyOSLocator(ctx, ownerAddr, encryptionKey, msg.Locator.LocatorUri); err != nil {
+	locator, err := k.Keeper.ModifyOSLocator(ctx, msg.Locator, msg.UpdateFields...)
+	if err != nil {
 		ctx.Logger().Error("error deleting name", "err", err)
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
 
 	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_ModifyOSLocator, msg.GetSigners()))
-	return types.NewMsgModifyOSLocatorResponse(msg.Locator), nil
+	return types.NewMsgModifyOSLocatorResponse(locator), nil
 }
@@ -3,14 +3,16 @@ package keeper
 import (
 	"fmt"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/provenance-io/provenance/x/metadata/types"
 )
 
-// GetOsLocatorRecord Gets the object store locator entry from the kvstore for the given owner address.
-func (k Keeper) GetOsLocatorRecord(ctx sdk.Context, ownerAddr sdk.AccAddress) (osLocator types.ObjectStoreLocator, found bool) {
-	key := types.GetOSLocatorKey(ownerAddr)
+// GetOsLocatorRecord Gets the named object store locator entry from the kvstore for the given owner address.
+// An empty name gets the owner's default locator.
+func (k Keeper) GetOsLocatorRecord(ctx sdk.Context, ownerAddr sdk.AccAddress, name string) (osLocator types.ObjectStoreLocator, found bool) {
+	key := types.GetOSLocatorKey(ownerAddr, name)
 	store := ctx.KVStore(k.storeKey)
 	b := store.Get(key)
 	if b == nil {
@@ -24,38 +26,96 @@ func (k Keeper) GetOsLocatorRecord(ctx sdk.Context, ownerAddr sdk.AccAddress) (o
 	return osLocator, true
 }
 
-// OSLocatorExists checks if the provided bech32 owner address has a OSL entry in the kvstore.
-func (k Keeper) OSLocatorExists(ctx sdk.Context, ownerAddr sdk.AccAddress) bool {
-	key := types.GetOSLocatorKey(ownerAddr)
+// GetOSLocatorsForOwner gets all of the object store locators of an owner, ordered by priority.
+func (k Keeper) GetOSLocatorsForOwner(ctx sdk.Context, ownerAddr sdk.AccAddress) ([]types.ObjectStoreLocator, error) {
+	var locators []types.ObjectStoreLocator
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetOSLocatorOwnerIteratorPrefix(ownerAddr))
+	it := store.Iterator(nil, nil)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var locator types.ObjectStoreLocator
+		if err := k.cdc.Unmarshal(it.Value(), &locator); err != nil {
+			return nil, err
+		}
+		locators = append(locators, locator)
+	}
+	types.SortOSLocatorsByPriority(locators)
+	return locators, nil
+}
+
+// countOSLocatorsForOwner counts the object store locators of an owner.
+func (k Keeper) countOSLocatorsForOwner(ctx sdk.Context, ownerAddr sdk.AccAddress) uint32 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetOSLocatorOwnerIteratorPrefix(ownerAddr))
+	it := store.Iterator(nil, nil)
+	defer it.Close()
+	var count uint32
+	for ; it.Valid(); it.Next() {
+		count++
+	}
+	return count
+}
+
+// GetPreferredOSLocator gets the highest priority active object store locator of an owner.
+// If the owner has no active locators, their highest priority locator is returned.
+func (k Keeper) GetPreferredOSLocator(ctx sdk.Context, ownerAddr sdk.AccAddress) (osLocator types.ObjectStoreLocator, found bool) {
+	locators, err := k.GetOSLocatorsForOwner(ctx, ownerAddr)
+	if err != nil {
+		ctx.Logger().Error("failed to get locators", "err", err)
+		return types.ObjectStoreLocator{}, false
+	}
+	if len(locators) == 0 {
+		return types.ObjectStoreLocator{}, false
+	}
+	for _, locator := range locators {
+		if locator.IsActive(ctx.BlockTime()) {
+			return locator, true
+		}
+	}
+	return locators[0], true
+}
+
+// OSLocatorExists checks if the provided bech32 owner address has a OSL entry with the given name in the kvstore.
+func (k Keeper) OSLocatorExists(ctx sdk.Context, ownerAddr sdk.AccAddress, name string) bool {
+	key := types.GetOSLocatorKey(ownerAddr, name)
 	store := ctx.KVStore(k.storeKey)
 	return store.Has(key)
 }
 
 // SetOSLocator binds an OS Locator to an address in the kvstore.
+// An empty locator name is stored as the default name.
 // An error is returned if no account exists for the address.
-// An error is returned if an OS Locator already exists for the address.
-func (k Keeper) SetOSLocator(ctx sdk.Context, ownerAddr, encryptionKey sdk.AccAddress, uri string) error {
-	urlToPersist, err := k.checkValidURI(uri, ctx)
+// An error is returned if an OS Locator with the same name already exists for the address.
+// An error is returned if the address already has the maximum number of locators allowed by the params.
+func (k Keeper) SetOSLocator(ctx sdk.Context, locator types.ObjectStoreLocator) error {
+	urlToPersist, err := k.checkValidURI(locator.LocatorUri, ctx)
 	if err != nil {
 		return err
 	}
+	ownerAddr, err := sdk.AccAddressFromBech32(locator.Owner)
+	if err != nil {
+		return types.ErrInvalidAddress
+	}
 	if account := k.authKeeper.GetAccount(ctx, ownerAddr); account == nil {
 		return types.ErrInvalidAddress
 	}
-	key := types.GetOSLocatorKey(ownerAddr)
+	locator.Name = types.NormalizeOSLocatorName(locator.Name)
+	key := types.GetOSLocatorKey(ownerAddr, locator.Name)
 	store := ctx.KVStore(k.storeKey)
 	if store.Has(key) {
 		return types.ErrOSLocatorAlreadyBound
 	}
+	if max := k.GetMaxLocatorsPerOwner(ctx); max > 0 && k.countOSLocatorsForOwner(ctx, ownerAddr) >= max {
+		return types.ErrOSLocatorLimitReached.Wrapf("%s has %d locators", locator.Owner, max)
+	}
 
-	record := types.NewOSLocatorRecord(ownerAddr, encryptionKey, urlToPersist.String())
-	bz, err := k.cdc.Marshal(&record)
+	locator.LocatorUri = urlToPersist.String()
+	bz, err := k.cdc.Marshal(&locator)
 	if err != nil {
 		return err
 	}
 	store.Set(key, bz)
 
-	k.EmitEvent(ctx, types.NewEventOSLocatorCreated(record.Owner))
+	k.EmitEvent(ctx, types.NewEventOSLocatorCreated(locator.Owner, locator.Name))
 	defer types.GetIncObjFunc(types.TLType_OSLocator, types.TLAction_Created)
 	return nil
 }
@@ -80,7 +140,8 @@ func (k Keeper) IterateOSLocators(ctx sdk.Context, cb func(account types.ObjectS
 	return nil
 }
 
-// GetOSLocatorByScope gets all Object Store Locators associated with a scope.
+// GetOSLocatorByScope gets all active Object Store Locators associated with a scope.
+// Locators are grouped by owner, in scope owner order, and each owner's locators are ordered by priority.
 func (k Keeper) GetOSLocatorByScope(ctx sdk.Context, scopeID string) ([]types.ObjectStoreLocator, error) {
 	scopeAddr, err := ParseScopeID(scopeID)
 	if err != nil {
@@ -93,77 +154,109 @@ func (k Keeper) GetOSLocatorByScope(ctx sdk.Context, scopeID string) ([]types.Ob
 	}
 
 	// should always have valid owners, hence creating it with capacity
-	signers := make([]sdk.AccAddress, len(scope.Owners))
+	signers := make([]sdk.AccAddress, 0, len(scope.Owners))
 
-	for i, p := range scope.Owners {
+	for _, p := range scope.Owners {
 		addr, err := sdk.AccAddressFromBech32(p.Address)
 		if err != nil {
 			panic(err)
 		}
-		signers[i] = addr
+		// The same address can be an owner in more than one role.
+		duplicate := false
+		for _, signer := range signers {
+			if signer.Equals(addr) {
+				duplicate = true
+				break
+			}
+		}
+		if !duplicate {
+			signers = append(signers, addr)
+		}
 	}
 
 	// may not have object locators defined for all owners
 	locators := make([]types.ObjectStoreLocator, 0, len(signers))
 	for _, addr := range signers {
-		loc, found := k.GetOsLocatorRecord(ctx, addr)
-		if !found {
-			continue
+		ownerLocators, err := k.GetOSLocatorsForOwner(ctx, addr)
+		if err != nil {
+			return []types.ObjectStoreLocator{}, err
+		}
+		for _, loc := range ownerLocators {
+			if loc.IsActive(ctx.BlockTime()) {
+				locators = append(locators, loc)
+			}
 		}
-		locators = append(locators, loc)
 	}
 	return locators, nil
 }
 
-// RemoveOSLocator removes an os locator record from the kvstore.
-func (k Keeper) RemoveOSLocator(ctx sdk.Context, ownerAddr sdk.AccAddress) error {
-	key := types.GetOSLocatorKey(ownerAddr)
+// RemoveOSLocator removes a named os locator record from the kvstore.
+func (k Keeper) RemoveOSLocator(ctx sdk.Context, ownerAddr sdk.AccAddress, name string) error {
+	name = types.NormalizeOSLocatorName(name)
+	key := types.GetOSLocatorKey(ownerAddr, name)
 	store := ctx.KVStore(k.storeKey)
 	if !store.Has(key) {
 		return types.ErrAddressNotBound
 	}
 	store.Delete(key)
-	k.EmitEvent(ctx, types.NewEventOSLocatorDeleted(ownerAddr.String()))
+	k.EmitEvent(ctx, types.NewEventOSLocatorDeleted(ownerAddr.String(), name))
 	defer types.GetIncObjFunc(types.TLType_OSLocator, types.TLAction_Deleted)
 	return nil
 }
 
-// ModifyOSLocator updates an existing os locator entry in the kvstore, returns an error if it doesn't exist.
-func (k Keeper) ModifyOSLocator(ctx sdk.Context, ownerAddr, encryptionKey sdk.AccAddress, uri string) error {
-	urlToPersist, err := k.checkValidURI(uri, ctx)
+// ModifyOSLocator updates an existing named os locator entry in the kvstore, returns an error if it doesn't exist.
+// Each of the named update fields is taken from the provided locator and the rest of the existing entry is kept.
+// If no update fields are named, the locator uri and encryption key are taken from the provided locator.
+// The updated entry is returned.
+func (k Keeper) ModifyOSLocator(ctx sdk.Context, locator types.ObjectStoreLocator, updateFields ...string) (types.ObjectStoreLocator, error) {
+	ownerAddr, err := sdk.AccAddressFromBech32(locator.Owner)
 	if err != nil {
-		return err
+		return types.ObjectStoreLocator{}, types.ErrInvalidAddress
 	}
-	key := types.GetOSLocatorKey(ownerAddr)
-	store := ctx.KVStore(k.storeKey)
-	if !store.Has(key) {
-		return types.ErrAddressNotBound
+	if err = types.ValidateOSLocatorUpdateFields(updateFields); err != nil {
+		return types.ObjectStoreLocator{}, err
+	}
+	existing, found := k.GetOsLocatorRecord(ctx, ownerAddr, types.NormalizeOSLocatorName(locator.Name))
+	if !found {
+		return types.ObjectStoreLocator{}, types.ErrAddressNotBound
 	}
 
-	record := types.NewOSLocatorRecord(ownerAddr, encryptionKey, urlToPersist.String())
-	bz, err := k.cdc.Marshal(&record)
+	updated := existing.WithUpdates(locator, updateFields)
+	urlToPersist, err := k.checkValidURI(updated.LocatorUri, ctx)
 	if err != nil {
-		return err
+		return types.ObjectStoreLocator{}, err
 	}
-	store.Set(key, bz)
-	k.EmitEvent(ctx, types.NewEventOSLocatorUpdated(record.Owner))
+	updated.LocatorUri = urlToPersist.String()
+	if err = updated.ValidateBasic(); err != nil {
+		return types.ObjectStoreLocator{}, err
+	}
+	bz, err := k.cdc.Marshal(&updated)
+	if err != nil {
+		return types.ObjectStoreLocator{}, err
+	}
+	ctx.KVStore(k.storeKey).Set(types.GetOSLocatorKey(ownerAddr, updated.Name), bz)
+	k.EmitEvent(ctx, types.NewEventOSLocatorUpdated(updated.Owner, updated.Name))
 	defer types.GetIncObjFunc(types.TLType_OSLocator, types.TLAction_Updated)
-	return nil
+	return updated, nil
 }
 
 // ImportOSLocatorRecord binds a name to an address in the kvstore.
 // Different from SetOSLocator in that there is less validation here.
 // The uri format is not checked, and the owner address account is not looked up.
 // This also does not emit any events.
-func (k Keeper) ImportOSLocatorRecord(ctx sdk.Context, ownerAddr, encryptionKey sdk.AccAddress, uri string) error {
-	key := types.GetOSLocatorKey(ownerAddr)
+func (k Keeper) ImportOSLocatorRecord(ctx sdk.Context, locator types.ObjectStoreLocator) error {
+	ownerAddr, err := sdk.AccAddressFromBech32(locator.Owner)
+	if err != nil {
+		return err
+	}
+	locator.Name = types.NormalizeOSLocatorName(locator.Name)
+	key := types.GetOSLocatorKey(ownerAddr, locator.Name)
 	store := ctx.KVStore(k.storeKey)
 	if store.Has(key) {
 		return types.ErrOSLocatorAlreadyBound
 	}
 
-	record := types.NewOSLocatorRecord(ownerAddr, encryptionKey, uri)
-	bz, err := k.cdc.Marshal(&record)
+	bz, err := k.cdc.Marshal(&locator)
 	if err != nil {
 		return err
 	}
@@ -9,7 +9,8 @@ import (
 // GetParams returns the total set of metadata parameters.
 func (k Keeper) GetOSLocatorParams(ctx sdk.Context) (osLocatorParams types.OSLocatorParams) {
 	return types.OSLocatorParams{
-		MaxUriLength: k.GetMaxURILength(ctx),
+		MaxUriLength:        k.GetMaxURILength(ctx),
+		MaxLocatorsPerOwner: k.GetMaxLocatorsPerOwner(ctx),
 	}
 }
 
@@ -26,3 +27,12 @@ func (k Keeper) GetMaxURILength(ctx sdk.Context) (max uint32) {
 	}
 	return
 }
+
+// GetMaxLocatorsPerOwner gets the configured parameter for the maximum number of locators an owner can have (or the default if unset)
+func (k Keeper) GetMaxLocatorsPerOwner(ctx sdk.Context) (max uint32) {
+	max = types.DefaultMaxLocatorsPerOwner
+	if k.paramSpace.Has(ctx, types.ParamStoreKeyMaxLocatorsPerOwner) {
+		k.paramSpace.Get(ctx, types.ParamStoreKeyMaxLocatorsPerOwner, &max)
+	}
+	return
+}
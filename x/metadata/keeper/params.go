@@ -8,8 +8,41 @@ import (
 
 // GetParams returns the total set of metadata parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
-	return types.Params{} // there are currently no params so no further action required here.
+	return types.Params{
+		MaxRecordVersions:  k.GetMaxRecordVersions(ctx),
+		DisableP8EMessages: k.GetDisableP8eMessages(ctx),
+		EmitDetailedEvents: k.GetEmitDetailedEvents(ctx),
+	}
 }
 
 // SetParams sets the metadata parameters to the param space.
-func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {} // currently no params are supported
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// GetMaxRecordVersions gets the configured number of record versions to retain (or the default if unset).
+func (k Keeper) GetMaxRecordVersions(ctx sdk.Context) (max uint32) {
+	max = types.DefaultMaxRecordVersions
+	if k.paramSpace.Has(ctx, types.ParamStoreKeyMaxRecordVersions) {
+		k.paramSpace.Get(ctx, types.ParamStoreKeyMaxRecordVersions, &max)
+	}
+	return
+}
+
+// GetDisableP8eMessages gets whether the legacy p8e messages are disabled (or the default if unset).
+func (k Keeper) GetDisableP8eMessages(ctx sdk.Context) (disabled bool) {
+	disabled = types.DefaultDisableP8eMessages
+	if k.paramSpace.Has(ctx, types.ParamStoreKeyDisableP8eMessages) {
+		k.paramSpace.Get(ctx, types.ParamStoreKeyDisableP8eMessages, &disabled)
+	}
+	return
+}
+
+// GetEmitDetailedEvents gets whether events with the changed fields of scopes, sessions and records are emitted (or the default if unset).
+func (k Keeper) GetEmitDetailedEvents(ctx sdk.Context) (emit bool) {
+	emit = types.DefaultEmitDetailedEvents
+	if k.paramSpace.Has(ctx, types.ParamStoreKeyEmitDetailedEvents) {
+		k.paramSpace.Get(ctx, types.ParamStoreKeyEmitDetailedEvents, &emit)
+	}
+	return
+}
@@ -157,7 +157,7 @@ func queryOSGet(ctx sdk.Context, path []string, keeper Keeper, legacyQuerierCdc
 	if err != nil {
 		return nil, types.ErrInvalidAddress
 	}
-	msgs, _ := keeper.GetOsLocatorRecord(ctx, accAddr)
+	msgs, _ := keeper.GetPreferredOSLocator(ctx, accAddr)
 
 	bz, err := codec.MarshalJSONIndent(legacyQuerierCdc, msgs)
 	if err != nil {
@@ -17,6 +17,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/google/uuid"
 
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
 	"github.com/provenance-io/provenance/x/metadata/types"
 )
 
@@ -93,6 +94,7 @@ func (k Keeper) Scope(c context.Context, req *types.ScopeRequest) (*types.ScopeR
 	ctx := sdk.UnwrapSDKContext(c)
 	scope, found := k.GetScope(ctx, scopeAddr)
 	if found {
+		scope.RemoveExpiredDataAccess(ctx.BlockTime())
 		retval.Scope = types.WrapScope(&scope)
 	} else {
 		retval.Scope = types.WrapScopeNotFound(scopeAddr)
@@ -152,6 +154,7 @@ func (k Keeper) ScopesAll(c context.Context, req *types.ScopesAllRequest) (*type
 		var scope types.Scope
 		vErr := scope.Unmarshal(value)
 		if vErr == nil {
+			scope.RemoveExpiredDataAccess(ctx.BlockTime())
 			retval.Scopes = append(retval.Scopes, types.WrapScope(&scope))
 			return nil
 		}
@@ -176,6 +179,135 @@ func (k Keeper) ScopesAll(c context.Context, req *types.ScopesAllRequest) (*type
 	return &retval, nil
 }
 
+// Scopes returns the scopes matching all of the filters in the provided request.
+func (k Keeper) Scopes(c context.Context, req *types.ScopesRequest) (*types.ScopesResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "query", "Scopes")
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	retval := types.ScopesResponse{Request: req}
+
+	var specAddr types.MetadataAddress
+	var ownerAddr, valueOwnerAddr, dataAccessAddr sdk.AccAddress
+	var err error
+	if len(req.SpecificationId) > 0 {
+		specAddr, err = ParseScopeSpecID(req.SpecificationId)
+		if err != nil {
+			return &retval, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	if len(req.Owner) > 0 {
+		ownerAddr, err = sdk.AccAddressFromBech32(req.Owner)
+		if err != nil {
+			return &retval, status.Errorf(codes.InvalidArgument, "invalid owner: %s", err.Error())
+		}
+	}
+	if req.OwnerRole != types.PartyType_PARTY_TYPE_UNSPECIFIED {
+		if len(ownerAddr) == 0 {
+			return &retval, status.Error(codes.InvalidArgument, "owner role cannot be provided without an owner")
+		}
+		if _, ok := types.PartyType_name[int32(req.OwnerRole)]; !ok {
+			return &retval, status.Errorf(codes.InvalidArgument, "unknown owner role: %d", req.OwnerRole)
+		}
+	}
+	if len(req.ValueOwner) > 0 {
+		valueOwnerAddr, err = sdk.AccAddressFromBech32(req.ValueOwner)
+		if err != nil {
+			return &retval, status.Errorf(codes.InvalidArgument, "invalid value owner: %s", err.Error())
+		}
+	}
+	if len(req.DataAccess) > 0 {
+		dataAccessAddr, err = sdk.AccAddressFromBech32(req.DataAccess)
+		if err != nil {
+			return &retval, status.Errorf(codes.InvalidArgument, "invalid data access: %s", err.Error())
+		}
+	}
+
+	// Pick the most selective index available to drive the iteration.
+	// All other filters are checked against the scope itself.
+	var storePrefix []byte
+	keyIsScopeID := true
+	switch {
+	case len(ownerAddr) > 0 && req.OwnerRole != types.PartyType_PARTY_TYPE_UNSPECIFIED:
+		storePrefix = types.GetOwnerRoleScopeCacheIteratorPrefix(ownerAddr, req.OwnerRole)
+	case len(ownerAddr) > 0:
+		storePrefix = types.GetAddressScopeCacheIteratorPrefix(ownerAddr)
+	case len(dataAccessAddr) > 0:
+		storePrefix = types.GetDataAccessScopeCacheIteratorPrefix(dataAccessAddr)
+	case len(valueOwnerAddr) > 0:
+		storePrefix = types.GetValueOwnerScopeCacheIteratorPrefix(valueOwnerAddr)
+	case !specAddr.Empty():
+		storePrefix = types.GetScopeSpecScopeCacheIteratorPrefix(specAddr)
+	default:
+		storePrefix = types.ScopeKeyPrefix
+		keyIsScopeID = false
+	}
+
+	matches := func(scope *types.Scope) bool {
+		if !specAddr.Empty() && !specAddr.Equals(scope.SpecificationId) {
+			return false
+		}
+		if len(ownerAddr) > 0 {
+			found := false
+			for _, party := range scope.Owners {
+				if isAddress(party.Address, ownerAddr) && (req.OwnerRole == types.PartyType_PARTY_TYPE_UNSPECIFIED || party.Role == req.OwnerRole) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+		if len(valueOwnerAddr) > 0 && !isAddress(scope.ValueOwnerAddress, valueOwnerAddr) {
+			return false
+		}
+		if len(dataAccessAddr) > 0 {
+			found := false
+			for _, addr := range scope.DataAccess {
+				if isAddress(addr, dataAccessAddr) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+		return true
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), storePrefix)
+	pageRes, err := query.FilteredPaginate(prefixStore, getPageRequest(req), func(key, value []byte, accumulate bool) (bool, error) {
+		var scope types.Scope
+		if keyIsScopeID {
+			var found bool
+			scope, found = k.GetScope(ctx, key)
+			if !found {
+				return false, nil
+			}
+		} else if vErr := scope.Unmarshal(value); vErr != nil {
+			k.Logger(ctx).Error("failed to unmarshal scope", "key (base64)", b64.StdEncoding.EncodeToString(key), "error", vErr)
+			return false, nil
+		}
+		scope.RemoveExpiredDataAccess(ctx.BlockTime())
+		if !matches(&scope) {
+			return false, nil
+		}
+		if accumulate {
+			retval.Scopes = append(retval.Scopes, types.WrapScope(&scope))
+		}
+		return true, nil
+	})
+	if err != nil {
+		return &retval, status.Error(codes.Unavailable, err.Error())
+	}
+	retval.Pagination = pageRes
+	return &retval, nil
+}
+
 // Sessions returns sessions based on the provided request.
 func (k Keeper) Sessions(c context.Context, req *types.SessionsRequest) (*types.SessionsResponse, error) {
 	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "query", "Sessions")
@@ -289,6 +421,7 @@ func (k Keeper) Sessions(c context.Context, req *types.SessionsRequest) (*types.
 	if req.IncludeScope {
 		scope, found := k.GetScope(ctx, scopeAddr)
 		if found {
+			scope.RemoveExpiredDataAccess(ctx.BlockTime())
 			retval.Scope = types.WrapScope(&scope)
 		} else {
 			retval.Scope = types.WrapScopeNotFound(scopeAddr)
@@ -364,6 +497,89 @@ func (k Keeper) SessionsAll(c context.Context, req *types.SessionsAllRequest) (*
 	return &retval, nil
 }
 
+// SessionsByParty returns the sessions that an address is a party to (limited by pagination).
+func (k Keeper) SessionsByParty(c context.Context, req *types.SessionsByPartyRequest) (*types.SessionsByPartyResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "query", "SessionsByParty")
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	retval := types.SessionsByPartyResponse{Request: req}
+
+	if len(req.Party) == 0 {
+		return &retval, status.Error(codes.InvalidArgument, "party cannot be empty")
+	}
+	partyAddr, err := sdk.AccAddressFromBech32(req.Party)
+	if err != nil {
+		return &retval, status.Errorf(codes.InvalidArgument, "invalid party: %s", err.Error())
+	}
+	if _, ok := types.PartyType_name[int32(req.Role)]; !ok {
+		return &retval, status.Errorf(codes.InvalidArgument, "unknown role: %d", req.Role)
+	}
+
+	// Without a role, the keys in the prefix store start with the role byte.
+	withRole := req.Role != types.PartyType_PARTY_TYPE_UNSPECIFIED
+	storePrefix := types.GetPartySessionCacheIteratorPrefix(partyAddr)
+	if withRole {
+		storePrefix = types.GetPartyRoleSessionCacheIteratorPrefix(partyAddr, req.Role)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), storePrefix)
+	pageRes, err := query.FilteredPaginate(prefixStore, getPageRequest(req), func(key, _ []byte, accumulate bool) (bool, error) {
+		sessionID := types.MetadataAddress(key)
+		var role types.PartyType
+		if !withRole {
+			if len(key) < 2 {
+				return false, nil
+			}
+			role = types.PartyType(key[0])
+			sessionID = key[1:]
+		}
+		session, found := k.GetSession(ctx, sessionID)
+		if !found {
+			return false, nil
+		}
+		// A party can have several roles in a session. Without a role filter,
+		// only the entry for the first of them is used so each session is only returned once.
+		if !withRole && firstPartyRole(session.Parties, partyAddr) != role {
+			return false, nil
+		}
+		if accumulate {
+			retval.Sessions = append(retval.Sessions, types.WrapSession(&session))
+		}
+		return true, nil
+	})
+	if err != nil {
+		return &retval, status.Error(codes.Unavailable, err.Error())
+	}
+	retval.Pagination = pageRes
+	return &retval, nil
+}
+
+// firstPartyRole returns the lowest role that the address has in the parties.
+func firstPartyRole(parties []types.Party, addr sdk.AccAddress) types.PartyType {
+	var rv types.PartyType
+	found := false
+	for _, party := range parties {
+		partyAddr, err := sdk.AccAddressFromBech32(party.Address)
+		if err != nil || !addr.Equals(partyAddr) {
+			continue
+		}
+		if !found || party.Role < rv {
+			rv = party.Role
+			found = true
+		}
+	}
+	return rv
+}
+
+// isAddress returns true if the provided bech32 string is a valid address equal to addr.
+func isAddress(bech32 string, addr sdk.AccAddress) bool {
+	parsed, err := sdk.AccAddressFromBech32(bech32)
+	return err == nil && addr.Equals(parsed)
+}
+
 // Records returns records based on the provided request.
 func (k Keeper) Records(c context.Context, req *types.RecordsRequest) (*types.RecordsResponse, error) {
 	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "query", "Records")
@@ -464,6 +680,7 @@ func (k Keeper) Records(c context.Context, req *types.RecordsRequest) (*types.Re
 	if req.IncludeScope {
 		scope, found := k.GetScope(ctx, scopeAddr)
 		if found {
+			scope.RemoveExpiredDataAccess(ctx.BlockTime())
 			retval.Scope = types.WrapScope(&scope)
 		} else {
 			retval.Scope = types.WrapScopeNotFound(scopeAddr)
@@ -540,6 +757,53 @@ func (k Keeper) RecordsAll(c context.Context, req *types.RecordsAllRequest) (*ty
 	return &retval, nil
 }
 
+// RecordHistory returns the retained versions of a record (limited by pagination).
+func (k Keeper) RecordHistory(c context.Context, req *types.RecordHistoryRequest) (*types.RecordHistoryResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "query", "RecordHistory")
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	retval := types.RecordHistoryResponse{Request: req}
+
+	var recordAddr types.MetadataAddress
+	switch {
+	case len(req.RecordAddr) > 0:
+		var err error
+		recordAddr, err = ParseRecordAddr(req.RecordAddr)
+		if err != nil {
+			return &retval, status.Error(codes.InvalidArgument, err.Error())
+		}
+	case len(req.ScopeId) > 0 && len(req.Name) > 0:
+		scopeAddr, err := ParseScopeID(req.ScopeId)
+		if err != nil {
+			return &retval, status.Error(codes.InvalidArgument, err.Error())
+		}
+		recordAddr, err = scopeAddr.AsRecordAddress(req.Name)
+		if err != nil {
+			return &retval, status.Error(codes.InvalidArgument, err.Error())
+		}
+	default:
+		return &retval, status.Error(codes.InvalidArgument, "a record address or a scope id and record name must be provided")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetRecordVersionIteratorPrefix(recordAddr))
+	pageRes, err := query.Paginate(prefixStore, getPageRequest(req), func(_, value []byte) error {
+		var recordVersion types.RecordVersion
+		if vErr := recordVersion.Unmarshal(value); vErr != nil {
+			return vErr
+		}
+		retval.Versions = append(retval.Versions, recordVersion)
+		return nil
+	})
+	if err != nil {
+		return &retval, status.Error(codes.Unavailable, err.Error())
+	}
+	retval.Pagination = pageRes
+	return &retval, nil
+}
+
 // Ownership returns a list of scope identifiers that list the given address as a data or value owner.
 func (k Keeper) Ownership(c context.Context, req *types.OwnershipRequest) (*types.OwnershipResponse, error) {
 	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "query", "Ownership")
@@ -620,6 +884,134 @@ func (k Keeper) ValueOwnership(c context.Context, req *types.ValueOwnershipReque
 		return &retval, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
 	}
 	retval.Pagination = pageRes
+
+	for _, scopeID := range k.GetScopesWithValueOwnerTokenHeldBy(ctx, addr) {
+		scopeUUID, sErr := scopeID.ScopeUUID()
+		if sErr != nil {
+			return &retval, status.Error(codes.Internal, sErr.Error())
+		}
+		retval.TokenScopeUuids = append(retval.TokenScopeUuids, scopeUUID.String())
+	}
+	return &retval, nil
+}
+
+// ScopeValueOwnerToken returns the value owner token of a scope.
+func (k Keeper) ScopeValueOwnerToken(c context.Context, req *types.ScopeValueOwnerTokenRequest) (*types.ScopeValueOwnerTokenResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "query", "ScopeValueOwnerToken")
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	retval := types.ScopeValueOwnerTokenResponse{Request: req, Supply: sdk.ZeroInt()}
+
+	var scopeAddr types.MetadataAddress
+	if len(req.ScopeId) > 0 {
+		var err error
+		scopeAddr, err = ParseScopeID(req.ScopeId)
+		if err != nil {
+			return &retval, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	if len(req.Denom) > 0 {
+		scopeAddr2, err := types.ScopeIDFromValueOwnerTokenDenom(req.Denom)
+		if err != nil {
+			return &retval, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if scopeAddr.Empty() {
+			scopeAddr = scopeAddr2
+		} else if !scopeAddr.Equals(scopeAddr2) {
+			return &retval, status.Errorf(codes.InvalidArgument, "denom %s is not the value owner token of scope %s", req.Denom, scopeAddr)
+		}
+	}
+	if scopeAddr.Empty() {
+		return &retval, status.Error(codes.InvalidArgument, "empty request parameters")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	scope, found := k.GetScope(ctx, scopeAddr)
+	if !found {
+		return &retval, status.Errorf(codes.NotFound, "scope not found with id %s", scopeAddr)
+	}
+
+	retval.ScopeId = scopeAddr.String()
+	retval.Denom = types.ValueOwnerTokenDenom(scopeAddr)
+	markerAddr, err := markertypes.MarkerAddress(retval.Denom)
+	if err != nil {
+		return &retval, status.Error(codes.Internal, err.Error())
+	}
+	retval.MarkerAddress = markerAddr.String()
+	retval.Tokenized = scope.ValueOwnerAddress == retval.MarkerAddress
+	if retval.Tokenized {
+		retval.Supply = k.bankKeeper.GetSupply(ctx, retval.Denom).Amount
+		retval.ValueOwner, err = k.GetValueOwnerTokenHolder(ctx, retval.Denom)
+		if err != nil {
+			return &retval, status.Error(codes.Internal, err.Error())
+		}
+	}
+	return &retval, nil
+}
+
+// ScopeSaleOffer returns the open offer to sell the value ownership of a scope.
+func (k Keeper) ScopeSaleOffer(c context.Context, req *types.ScopeSaleOfferRequest) (*types.ScopeSaleOfferResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "query", "ScopeSaleOffer")
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	retval := types.ScopeSaleOfferResponse{Request: req}
+
+	if len(req.ScopeId) == 0 {
+		return &retval, status.Error(codes.InvalidArgument, "scope id cannot be empty")
+	}
+	scopeAddr, err := ParseScopeID(req.ScopeId)
+	if err != nil {
+		return &retval, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	offer, found := k.GetScopeSaleOffer(ctx, scopeAddr)
+	if !found {
+		return &retval, status.Errorf(codes.NotFound, "no sale offer found for scope %s", scopeAddr)
+	}
+	retval.Offer = &offer
+	retval.Expired = k.IsScopeSaleOfferExpired(ctx, offer)
+	return &retval, nil
+}
+
+// CheckScopeSpecificationMigration reports whether a scope can be migrated to a scope specification, and if not, why.
+func (k Keeper) CheckScopeSpecificationMigration(
+	c context.Context,
+	req *types.CheckScopeSpecificationMigrationRequest,
+) (*types.CheckScopeSpecificationMigrationResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "query", "CheckScopeSpecificationMigration")
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	retval := types.CheckScopeSpecificationMigrationResponse{Request: req}
+
+	if len(req.ScopeId) == 0 {
+		return &retval, status.Error(codes.InvalidArgument, "scope id cannot be empty")
+	}
+	scopeAddr, err := ParseScopeID(req.ScopeId)
+	if err != nil {
+		return &retval, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if len(req.SpecificationId) == 0 {
+		return &retval, status.Error(codes.InvalidArgument, "specification id cannot be empty")
+	}
+	specAddr, err := ParseScopeSpecID(req.SpecificationId)
+	if err != nil {
+		return &retval, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	scope, found := k.GetScope(ctx, scopeAddr)
+	if !found {
+		return &retval, status.Errorf(codes.NotFound, "scope not found with id %s", scopeAddr)
+	}
+	retval.Issues = k.GetScopeSpecificationMigrationIssues(ctx, scope, specAddr)
+	retval.Compatible = len(retval.Issues) == 0
 	return &retval, nil
 }
 
@@ -898,11 +1290,25 @@ func (k Keeper) OSLocator(c context.Context, request *types.OSLocatorRequest) (*
 		return &retval, types.ErrInvalidAddress
 	}
 
-	record, exists := k.GetOsLocatorRecord(ctx, accAddr)
+	if len(request.Name) > 0 {
+		record, exists := k.GetOsLocatorRecord(ctx, accAddr, request.Name)
+		if !exists {
+			return &retval, types.ErrAddressNotBound
+		}
+		retval.Locator = &record
+		retval.Locators = []types.ObjectStoreLocator{record}
+		return &retval, nil
+	}
+
+	record, exists := k.GetPreferredOSLocator(ctx, accAddr)
 	if !exists {
 		return &retval, types.ErrAddressNotBound
 	}
 	retval.Locator = &record
+	retval.Locators, err = k.GetOSLocatorsForOwner(ctx, accAddr)
+	if err != nil {
+		return &retval, err
+	}
 
 	return &retval, nil
 }
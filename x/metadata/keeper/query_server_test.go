@@ -3,6 +3,7 @@ package keeper_test
 import (
 	gocontext "context"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -12,6 +13,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	"github.com/stretchr/testify/assert"
@@ -170,6 +172,329 @@ func (s *QueryServerTestSuite) TestScopeQuery() {
 
 // TODO: ScopesAll tests
 
+func (s *QueryServerTestSuite) TestScopesQuery() {
+	app, ctx, user1, user2 := s.app, s.ctx, s.user1, s.user2
+	user3 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	otherSpecID := types.ScopeSpecMetadataAddress(uuid.New())
+
+	// scope 0: spec, owner user1, value owner user1.
+	// scope 1: spec, owner user1 + servicer user2, data access user3.
+	// scope 2: other spec, owner user2 + servicer user1, value owner user3, data access user3.
+	// scope 3: no spec, owner user3.
+	scopes := []types.Scope{
+		*types.NewScope(types.ScopeMetadataAddress(uuid.New()), s.scopeSpecID, ownerPartyList(user1), nil, user1),
+		*types.NewScope(types.ScopeMetadataAddress(uuid.New()), s.scopeSpecID,
+			[]types.Party{{Address: user1, Role: types.PartyType_PARTY_TYPE_OWNER}, {Address: user2, Role: types.PartyType_PARTY_TYPE_SERVICER}},
+			[]string{user3}, ""),
+		*types.NewScope(types.ScopeMetadataAddress(uuid.New()), otherSpecID,
+			[]types.Party{{Address: user2, Role: types.PartyType_PARTY_TYPE_OWNER}, {Address: user1, Role: types.PartyType_PARTY_TYPE_SERVICER}},
+			[]string{user3}, user3),
+		*types.NewScope(types.ScopeMetadataAddress(uuid.New()), nil, ownerPartyList(user3), nil, ""),
+	}
+	for _, scope := range scopes {
+		app.MetadataKeeper.SetScope(ctx, scope)
+	}
+
+	scopeIDs := func(res *types.ScopesResponse) []string {
+		var rv []string
+		for _, sw := range res.Scopes {
+			rv = append(rv, sw.ScopeIdInfo.ScopeAddr)
+		}
+		return rv
+	}
+	ids := func(indexes ...int) []string {
+		var rv []string
+		for _, i := range indexes {
+			rv = append(rv, scopes[i].ScopeId.String())
+		}
+		return rv
+	}
+
+	tests := []struct {
+		name   string
+		req    *types.ScopesRequest
+		expIDs []string
+		expErr string
+	}{
+		{
+			name:   "role without owner",
+			req:    &types.ScopesRequest{OwnerRole: types.PartyType_PARTY_TYPE_OWNER},
+			expErr: "rpc error: code = InvalidArgument desc = owner role cannot be provided without an owner",
+		},
+		{
+			name:   "invalid owner",
+			req:    &types.ScopesRequest{Owner: "bad"},
+			expErr: "rpc error: code = InvalidArgument desc = invalid owner: decoding bech32 failed: invalid bech32 string length 3",
+		},
+		{
+			name:   "no filters",
+			req:    &types.ScopesRequest{},
+			expIDs: ids(0, 1, 2, 3),
+		},
+		{
+			name:   "by spec",
+			req:    &types.ScopesRequest{SpecificationId: s.scopeSpecUUID.String()},
+			expIDs: ids(0, 1),
+		},
+		{
+			name:   "by owner in any role",
+			req:    &types.ScopesRequest{Owner: user1},
+			expIDs: ids(0, 1, 2),
+		},
+		{
+			name:   "by owner and role",
+			req:    &types.ScopesRequest{Owner: user1, OwnerRole: types.PartyType_PARTY_TYPE_SERVICER},
+			expIDs: ids(2),
+		},
+		{
+			name:   "by value owner",
+			req:    &types.ScopesRequest{ValueOwner: user3},
+			expIDs: ids(2),
+		},
+		{
+			name:   "by data access",
+			req:    &types.ScopesRequest{DataAccess: user3},
+			expIDs: ids(1, 2),
+		},
+		{
+			name:   "by spec and data access",
+			req:    &types.ScopesRequest{SpecificationId: s.scopeSpecID.String(), DataAccess: user3},
+			expIDs: ids(1),
+		},
+		{
+			name:   "by owner role and value owner",
+			req:    &types.ScopesRequest{Owner: user2, OwnerRole: types.PartyType_PARTY_TYPE_OWNER, ValueOwner: user3},
+			expIDs: ids(2),
+		},
+		{
+			name:   "by uppercase owner and role",
+			req:    &types.ScopesRequest{Owner: strings.ToUpper(user1), OwnerRole: types.PartyType_PARTY_TYPE_SERVICER},
+			expIDs: ids(2),
+		},
+		{
+			name:   "by uppercase value owner",
+			req:    &types.ScopesRequest{ValueOwner: strings.ToUpper(user3)},
+			expIDs: ids(2),
+		},
+		{
+			name:   "by uppercase data access",
+			req:    &types.ScopesRequest{DataAccess: strings.ToUpper(user3)},
+			expIDs: ids(1, 2),
+		},
+		{
+			name:   "owner with value only held by other",
+			req:    &types.ScopesRequest{Owner: user3},
+			expIDs: ids(3),
+		},
+		{
+			name:   "no matches",
+			req:    &types.ScopesRequest{Owner: user2, OwnerRole: types.PartyType_PARTY_TYPE_SERVICER, SpecificationId: otherSpecID.String()},
+			expIDs: nil,
+		},
+	}
+
+	s.Run("nil request", func() {
+		_, err := app.MetadataKeeper.Scopes(sdk.WrapSDKContext(ctx), nil)
+		s.Assert().EqualError(err, "rpc error: code = InvalidArgument desc = empty request", "Scopes error")
+	})
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			res, err := s.queryClient.Scopes(gocontext.Background(), tc.req)
+			if len(tc.expErr) > 0 {
+				s.Assert().EqualError(err, tc.expErr, "Scopes error")
+				return
+			}
+			s.Require().NoError(err, "Scopes error")
+			s.Assert().ElementsMatch(tc.expIDs, scopeIDs(res), "Scopes results")
+		})
+	}
+
+	s.Run("pagination", func() {
+		req := &types.ScopesRequest{Owner: user1, Pagination: &query.PageRequest{Limit: 2, CountTotal: true}}
+		res, err := s.queryClient.Scopes(gocontext.Background(), req)
+		s.Require().NoError(err, "Scopes page 1")
+		s.Require().Len(res.Scopes, 2, "Scopes page 1")
+		s.Assert().Equal(3, int(res.Pagination.Total), "Scopes page 1 total")
+		s.Require().NotEmpty(res.Pagination.NextKey, "Scopes page 1 next key")
+		found := scopeIDs(res)
+
+		req.Pagination = &query.PageRequest{Limit: 2, Key: res.Pagination.NextKey}
+		res, err = s.queryClient.Scopes(gocontext.Background(), req)
+		s.Require().NoError(err, "Scopes page 2")
+		s.Require().Len(res.Scopes, 1, "Scopes page 2")
+		found = append(found, scopeIDs(res)...)
+		s.Assert().ElementsMatch(ids(0, 1, 2), found, "Scopes all pages")
+	})
+
+	s.Run("index updates on change", func() {
+		updated := scopes[1]
+		updated.Owners = ownerPartyList(user1)
+		updated.DataAccess = []string{user2}
+		app.MetadataKeeper.SetScope(ctx, updated)
+
+		res, err := s.queryClient.Scopes(gocontext.Background(), &types.ScopesRequest{Owner: user2, OwnerRole: types.PartyType_PARTY_TYPE_SERVICER})
+		s.Require().NoError(err, "Scopes by removed role")
+		s.Assert().Empty(scopeIDs(res), "Scopes by removed role")
+
+		res, err = s.queryClient.Scopes(gocontext.Background(), &types.ScopesRequest{DataAccess: user3})
+		s.Require().NoError(err, "Scopes by removed data access")
+		s.Assert().ElementsMatch(ids(2), scopeIDs(res), "Scopes by removed data access")
+
+		res, err = s.queryClient.Scopes(gocontext.Background(), &types.ScopesRequest{DataAccess: user2})
+		s.Require().NoError(err, "Scopes by added data access")
+		s.Assert().ElementsMatch(ids(1), scopeIDs(res), "Scopes by added data access")
+
+		app.MetadataKeeper.RemoveScope(ctx, scopes[1].ScopeId)
+		res, err = s.queryClient.Scopes(gocontext.Background(), &types.ScopesRequest{Owner: user1, OwnerRole: types.PartyType_PARTY_TYPE_OWNER})
+		s.Require().NoError(err, "Scopes after removal")
+		s.Assert().ElementsMatch(ids(0), scopeIDs(res), "Scopes after removal")
+	})
+}
+
+func (s *QueryServerTestSuite) TestSessionsByPartyQuery() {
+	app, ctx, user1, user2 := s.app, s.ctx, s.user1, s.user2
+	user3 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	scopeUUID := uuid.New()
+
+	// session 0: owner user1.
+	// session 1: owner user1 + servicer user2.
+	// session 2: owner user2 + servicer user1 + affiliate user1.
+	sessions := []types.Session{
+		*types.NewSession("s0", types.SessionMetadataAddress(scopeUUID, uuid.New()), s.cSpecID, ownerPartyList(user1), nil),
+		*types.NewSession("s1", types.SessionMetadataAddress(scopeUUID, uuid.New()), s.cSpecID,
+			[]types.Party{{Address: user1, Role: types.PartyType_PARTY_TYPE_OWNER}, {Address: user2, Role: types.PartyType_PARTY_TYPE_SERVICER}}, nil),
+		*types.NewSession("s2", types.SessionMetadataAddress(scopeUUID, uuid.New()), s.cSpecID,
+			[]types.Party{
+				{Address: user2, Role: types.PartyType_PARTY_TYPE_OWNER},
+				{Address: user1, Role: types.PartyType_PARTY_TYPE_SERVICER},
+				{Address: user1, Role: types.PartyType_PARTY_TYPE_AFFILIATE},
+			}, nil),
+	}
+	for _, session := range sessions {
+		app.MetadataKeeper.SetSession(ctx, session)
+	}
+
+	sessionIDs := func(res *types.SessionsByPartyResponse) []string {
+		var rv []string
+		for _, sw := range res.Sessions {
+			rv = append(rv, sw.SessionIdInfo.SessionAddr)
+		}
+		return rv
+	}
+	ids := func(indexes ...int) []string {
+		var rv []string
+		for _, i := range indexes {
+			rv = append(rv, sessions[i].SessionId.String())
+		}
+		return rv
+	}
+
+	tests := []struct {
+		name   string
+		req    *types.SessionsByPartyRequest
+		expIDs []string
+		expErr string
+	}{
+		{
+			name:   "no party",
+			req:    &types.SessionsByPartyRequest{},
+			expErr: "rpc error: code = InvalidArgument desc = party cannot be empty",
+		},
+		{
+			name:   "invalid party",
+			req:    &types.SessionsByPartyRequest{Party: "bad"},
+			expErr: "rpc error: code = InvalidArgument desc = invalid party: decoding bech32 failed: invalid bech32 string length 3",
+		},
+		{
+			name:   "unknown role",
+			req:    &types.SessionsByPartyRequest{Party: user1, Role: 99},
+			expErr: "rpc error: code = InvalidArgument desc = unknown role: 99",
+		},
+		{
+			name:   "party in any role",
+			req:    &types.SessionsByPartyRequest{Party: user1},
+			expIDs: ids(0, 1, 2),
+		},
+		{
+			name:   "party in upper case",
+			req:    &types.SessionsByPartyRequest{Party: strings.ToUpper(user1)},
+			expIDs: ids(0, 1, 2),
+		},
+		{
+			name:   "party and role",
+			req:    &types.SessionsByPartyRequest{Party: user1, Role: types.PartyType_PARTY_TYPE_SERVICER},
+			expIDs: ids(2),
+		},
+		{
+			name:   "other party",
+			req:    &types.SessionsByPartyRequest{Party: user2},
+			expIDs: ids(1, 2),
+		},
+		{
+			name:   "party without sessions",
+			req:    &types.SessionsByPartyRequest{Party: user3},
+			expIDs: nil,
+		},
+	}
+
+	s.Run("nil request", func() {
+		_, err := app.MetadataKeeper.SessionsByParty(sdk.WrapSDKContext(ctx), nil)
+		s.Assert().EqualError(err, "rpc error: code = InvalidArgument desc = empty request", "SessionsByParty error")
+	})
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			res, err := s.queryClient.SessionsByParty(gocontext.Background(), tc.req)
+			if len(tc.expErr) > 0 {
+				s.Assert().EqualError(err, tc.expErr, "SessionsByParty error")
+				return
+			}
+			s.Require().NoError(err, "SessionsByParty error")
+			s.Assert().ElementsMatch(tc.expIDs, sessionIDs(res), "SessionsByParty results")
+		})
+	}
+
+	s.Run("pagination", func() {
+		req := &types.SessionsByPartyRequest{Party: user1, Pagination: &query.PageRequest{Limit: 2, CountTotal: true}}
+		res, err := s.queryClient.SessionsByParty(gocontext.Background(), req)
+		s.Require().NoError(err, "SessionsByParty page 1")
+		s.Require().Len(res.Sessions, 2, "SessionsByParty page 1")
+		s.Assert().Equal(3, int(res.Pagination.Total), "SessionsByParty page 1 total")
+		s.Require().NotEmpty(res.Pagination.NextKey, "SessionsByParty page 1 next key")
+		found := sessionIDs(res)
+
+		req.Pagination = &query.PageRequest{Limit: 2, Key: res.Pagination.NextKey}
+		res, err = s.queryClient.SessionsByParty(gocontext.Background(), req)
+		s.Require().NoError(err, "SessionsByParty page 2")
+		s.Require().Len(res.Sessions, 1, "SessionsByParty page 2")
+		found = append(found, sessionIDs(res)...)
+		s.Assert().ElementsMatch(ids(0, 1, 2), found, "SessionsByParty all pages")
+	})
+
+	s.Run("index updates on change and removal", func() {
+		updated := sessions[1]
+		updated.Parties = ownerPartyList(user2)
+		app.MetadataKeeper.SetSession(ctx, updated)
+
+		res, err := s.queryClient.SessionsByParty(gocontext.Background(), &types.SessionsByPartyRequest{Party: user1})
+		s.Require().NoError(err, "SessionsByParty by removed party")
+		s.Assert().ElementsMatch(ids(0, 2), sessionIDs(res), "SessionsByParty by removed party")
+
+		res, err = s.queryClient.SessionsByParty(gocontext.Background(), &types.SessionsByPartyRequest{Party: user2, Role: types.PartyType_PARTY_TYPE_OWNER})
+		s.Require().NoError(err, "SessionsByParty by added role")
+		s.Assert().ElementsMatch(ids(1, 2), sessionIDs(res), "SessionsByParty by added role")
+
+		app.MetadataKeeper.RemoveSession(ctx, sessions[0].SessionId)
+		res, err = s.queryClient.SessionsByParty(gocontext.Background(), &types.SessionsByPartyRequest{Party: user1})
+		s.Require().NoError(err, "SessionsByParty after removal")
+		s.Assert().ElementsMatch(ids(2), sessionIDs(res), "SessionsByParty after removal")
+		s.Assert().False(ctx.KVStore(app.GetKey(types.StoreKey)).Has(
+			types.GetPartyRoleSessionCacheKey(s.user1Addr, types.PartyType_PARTY_TYPE_OWNER, sessions[0].SessionId)),
+			"index entry of removed session")
+	})
+}
+
 func (s *QueryServerTestSuite) TestSessionsQuery() {
 	app, ctx, queryClient := s.app, s.ctx, s.queryClient
 
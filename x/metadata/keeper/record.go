@@ -61,15 +61,27 @@ func (k Keeper) SetRecord(ctx sdk.Context, record types.Record) {
 
 	recordID := record.SessionId.MustGetAsRecordAddress(record.Name)
 
+	emitDetails := k.GetEmitDetailedEvents(ctx)
+	var oldRecord *types.Record
 	var event proto.Message = types.NewEventRecordCreated(recordID, record.SessionId)
 	action := types.TLAction_Created
 	if store.Has(recordID) {
 		event = types.NewEventRecordUpdated(recordID, record.SessionId)
 		action = types.TLAction_Updated
+		if emitDetails {
+			oldRecord = &types.Record{}
+			if err := k.cdc.Unmarshal(store.Get(recordID), oldRecord); err != nil {
+				k.Logger(ctx).Error("could not unmarshal old record", "err", err, "recordId", recordID.String())
+				oldRecord = nil
+			}
+		}
 	}
 
 	store.Set(recordID, b)
 	k.EmitEvent(ctx, event)
+	if emitDetails {
+		k.EmitEvent(ctx, types.NewEventRecordChanged(oldRecord, record))
+	}
 	defer types.GetIncObjFunc(types.TLType_Record, action)
 }
 
@@ -84,6 +96,7 @@ func (k Keeper) RemoveRecord(ctx sdk.Context, id types.MetadataAddress) {
 	}
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(id)
+	k.addRecordDeletedVersion(ctx, record)
 	k.EmitEvent(ctx, types.NewEventRecordDeleted(id))
 	defer types.GetIncObjFunc(types.TLType_Record, types.TLAction_Deleted)
 
@@ -142,7 +155,7 @@ func (k Keeper) ValidateRecordUpdate(
 			if !found {
 				return fmt.Errorf("original session %s not found for existing record", existing.SessionId)
 			}
-			if err := k.ValidateAllPartiesAreSignersWithAuthz(ctx, session.Parties, signers, msgTypeURL); err != nil {
+			if err := k.ValidateAllPartiesAreSignersWithAuthz(ctx, existing.SessionId.MustGetAsScopeAddress(), session.Parties, signers, msgTypeURL); err != nil {
 				return fmt.Errorf("missing signer from original session %s: %w", session.SessionId, err)
 			}
 		}
@@ -171,7 +184,7 @@ func (k Keeper) ValidateRecordUpdate(
 	}
 
 	// Make sure all the session parties have signed.
-	if signErr := k.ValidateAllPartiesAreSignersWithAuthz(ctx, session.Parties, signers, msgTypeURL); signErr != nil {
+	if signErr := k.ValidateAllPartiesAreSignersWithAuthz(ctx, scopeID, session.Parties, signers, msgTypeURL); signErr != nil {
 		return signErr
 	}
 
@@ -221,6 +234,7 @@ func (k Keeper) ValidateRecordUpdate(
 	}
 
 	// Make sure all the inputs conform to their spec.
+	recordID := proposed.SessionId.MustGetAsRecordAddress(proposed.Name)
 	for _, name := range inputNames {
 		input := inputMap[name]
 		inputSpec := inputSpecMap[name]
@@ -271,17 +285,41 @@ func (k Keeper) ValidateRecordUpdate(
 			return fmt.Errorf("input %s has source value %s but spec calls for %s",
 				input.Name, inputSourceValue, inputSpecSourceValue)
 		}
+		if inputSourceType == sourceTypeRecord {
+			if err := k.validateRecordInputSource(ctx, scopeID, recordID, input); err != nil {
+				return err
+			}
+		}
 	}
 
-	// Validate the output count
-	switch recSpec.ResultType {
+	return validateRecordOutputs(proposed.Name, recSpec.ResultType, proposed.Outputs)
+}
+
+// validateRecordOutputs makes sure a record's outputs are the shape called for by its specification's result type.
+// A record has exactly one output. A record list has at least one output, and each is a distinct entry with its own hash.
+func validateRecordOutputs(name string, resultType types.DefinitionType, outputs []types.RecordOutput) error {
+	switch resultType {
 	case types.DefinitionType_DEFINITION_TYPE_RECORD:
-		if len(proposed.Outputs) != 1 {
-			return fmt.Errorf("invalid output count (expected: 1, got: %d)", len(proposed.Outputs))
+		if len(outputs) != 1 {
+			return fmt.Errorf("invalid output count for record %s with result type %s (expected: 1, got: %d)",
+				name, resultType, len(outputs))
 		}
 	case types.DefinitionType_DEFINITION_TYPE_RECORD_LIST:
-		if len(proposed.Outputs) == 0 {
-			return fmt.Errorf("invalid output count (expected > 0, got: 0)")
+		if len(outputs) == 0 {
+			return fmt.Errorf("invalid output count for record %s with result type %s (expected > 0, got: 0)",
+				name, resultType)
+		}
+		seen := make(map[string]int, len(outputs))
+		for i, output := range outputs {
+			if len(output.Hash) == 0 {
+				return fmt.Errorf("output [%d] of record %s with result type %s has no hash (status %s)",
+					i, name, resultType, output.Status)
+			}
+			if j, found := seen[output.Hash]; found {
+				return fmt.Errorf("output [%d] of record %s with result type %s has the same hash %s as output [%d]",
+					i, name, resultType, output.Hash, j)
+			}
+			seen[output.Hash] = i
 		}
 	}
 	// case types.DefinitionType_DEFINITION_TYPE_PROPOSED: ignored
@@ -290,6 +328,58 @@ func (k Keeper) ValidateRecordUpdate(
 	return nil
 }
 
+// validateRecordInputSource makes sure that the record an input is sourced from is another record in the same scope,
+// and that its record specification has the same type name as the input.
+func (k Keeper) validateRecordInputSource(ctx sdk.Context, scopeID, recordID types.MetadataAddress, input types.RecordInput) error {
+	sourceID := input.Source.(*types.RecordInput_RecordId).RecordId
+	if sourceID.Equals(recordID) {
+		return fmt.Errorf("input %s source record id %s is the record being written", input.Name, sourceID)
+	}
+	sourceScopeID, err := sourceID.AsScopeAddress()
+	if err != nil {
+		return fmt.Errorf("input %s source record id %s is invalid: %w", input.Name, sourceID, err)
+	}
+	if !sourceScopeID.Equals(scopeID) {
+		return fmt.Errorf("input %s source record id %s is in scope %s but must be in scope %s",
+			input.Name, sourceID, sourceScopeID, scopeID)
+	}
+	source, found := k.GetRecord(ctx, sourceID)
+	if !found {
+		return fmt.Errorf("input %s source record id %s not found", input.Name, sourceID)
+	}
+	sourceSpec, err := k.getRecordSpecificationOf(ctx, source)
+	if err != nil {
+		return fmt.Errorf("input %s source record id %s: %w", input.Name, sourceID, err)
+	}
+	if sourceSpec.TypeName != input.TypeName {
+		return fmt.Errorf("input %s has TypeName %s but source record %s has TypeName %s",
+			input.Name, input.TypeName, sourceID, sourceSpec.TypeName)
+	}
+	return nil
+}
+
+// getRecordSpecificationOf gets the record specification of a record.
+// Old records might not have a specification id, in which case it is found using the record's session.
+func (k Keeper) getRecordSpecificationOf(ctx sdk.Context, record types.Record) (*types.RecordSpecification, error) {
+	specID := record.SpecificationId
+	if specID.Empty() {
+		session, found := k.GetSession(ctx, record.SessionId)
+		if !found {
+			return nil, fmt.Errorf("session not found for session id %s", record.SessionId)
+		}
+		contractSpecUUID, err := session.SpecificationId.ContractSpecUUID()
+		if err != nil {
+			return nil, err
+		}
+		specID = types.RecordSpecMetadataAddress(contractSpecUUID, record.Name)
+	}
+	spec, found := k.GetRecordSpecification(ctx, specID)
+	if !found {
+		return nil, fmt.Errorf("record specification not found for record specification id %s", specID)
+	}
+	return &spec, nil
+}
+
 // ValidateRecordRemove checks the current record and the proposed removal scope to determine if the the proposed remove is valid
 // based on the existing state
 func (k Keeper) ValidateRecordRemove(
@@ -312,7 +402,7 @@ func (k Keeper) ValidateRecordRemove(
 	if !recordID.Equals(proposedID) {
 		return fmt.Errorf("cannot remove record. expected %s, got %s", recordID, proposedID)
 	}
-	if err := k.ValidateAllPartiesAreSignersWithAuthz(ctx, scope.Owners, signers, msgTypeURL); err != nil {
+	if err := k.ValidateAllPartiesAreSignersWithAuthz(ctx, scopeID, scope.Owners, signers, msgTypeURL); err != nil {
 		return err
 	}
 	return nil
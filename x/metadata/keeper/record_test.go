@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	gocontext "context"
 	"fmt"
 	"testing"
 	"time"
@@ -9,12 +10,14 @@ import (
 
 	"github.com/provenance-io/provenance/app"
 	simapp "github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/x/metadata/keeper"
 	"github.com/provenance-io/provenance/x/metadata/types"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	"github.com/stretchr/testify/assert"
@@ -487,7 +490,7 @@ func (s *RecordKeeperTestSuite) TestValidateRecordUpdate() {
 				s.recordName, sessionID, *process, []types.RecordInput{*goodInput}, []types.RecordOutput{}, s.recordSpecID),
 			signers:         []string{s.user1},
 			partiesInvolved: ownerPartyList(s.user1),
-			errorMsg:        "invalid output count (expected: 1, got: 0)",
+			errorMsg:        fmt.Sprintf("invalid output count for record %s with result type DEFINITION_TYPE_RECORD (expected: 1, got: 0)", s.recordName),
 		},
 		"output count wrong - record - two": {
 			existing: nil,
@@ -506,14 +509,41 @@ func (s *RecordKeeperTestSuite) TestValidateRecordUpdate() {
 				s.recordSpecID),
 			signers:         []string{s.user1},
 			partiesInvolved: ownerPartyList(s.user1),
-			errorMsg:        "invalid output count (expected: 1, got: 2)",
+			errorMsg:        fmt.Sprintf("invalid output count for record %s with result type DEFINITION_TYPE_RECORD (expected: 1, got: 2)", s.recordName),
 		},
 		"output count wrong - record list - zero": {
 			existing:        nil,
 			proposed:        types.NewRecord(recordName2, sessionID, *process, []types.RecordInput{*goodInput2}, []types.RecordOutput{}, recordSpec2ID),
 			signers:         []string{s.user1},
 			partiesInvolved: ownerPartyList(s.user1),
-			errorMsg:        "invalid output count (expected > 0, got: 0)",
+			errorMsg:        fmt.Sprintf("invalid output count for record %s with result type DEFINITION_TYPE_RECORD_LIST (expected > 0, got: 0)", recordName2),
+		},
+		"record list - entry without hash": {
+			existing: nil,
+			proposed: types.NewRecord(
+				recordName2, sessionID, *process, []types.RecordInput{*goodInput2},
+				[]types.RecordOutput{
+					{Hash: "justsomeoutput", Status: types.ResultStatus_RESULT_STATUS_PASS},
+					{Status: types.ResultStatus_RESULT_STATUS_SKIP},
+				},
+				recordSpec2ID),
+			signers:         []string{s.user1},
+			partiesInvolved: ownerPartyList(s.user1),
+			errorMsg:        fmt.Sprintf("output [1] of record %s with result type DEFINITION_TYPE_RECORD_LIST has no hash (status RESULT_STATUS_SKIP)", recordName2),
+		},
+		"record list - duplicate entry": {
+			existing: nil,
+			proposed: types.NewRecord(
+				recordName2, sessionID, *process, []types.RecordInput{*goodInput2},
+				[]types.RecordOutput{
+					{Hash: "justsomeoutput", Status: types.ResultStatus_RESULT_STATUS_PASS},
+					{Hash: "justsomeoutput2", Status: types.ResultStatus_RESULT_STATUS_PASS},
+					{Hash: "justsomeoutput", Status: types.ResultStatus_RESULT_STATUS_FAIL},
+				},
+				recordSpec2ID),
+			signers:         []string{s.user1},
+			partiesInvolved: ownerPartyList(s.user1),
+			errorMsg:        fmt.Sprintf("output [2] of record %s with result type DEFINITION_TYPE_RECORD_LIST has the same hash justsomeoutput as output [0]", recordName2),
 		},
 		"valid - empty specification id": {
 			existing: nil,
@@ -578,3 +608,203 @@ func (s *RecordKeeperTestSuite) TestValidateRecordUpdate() {
 		})
 	}
 }
+
+func (s *RecordKeeperTestSuite) TestValidateRecordUpdateRecordInputs() {
+	scopeUUID := uuid.New()
+	scopeID := types.ScopeMetadataAddress(scopeUUID)
+	s.app.MetadataKeeper.SetScope(s.ctx, *types.NewScope(scopeID, s.scopeSpecID, ownerPartyList(s.user1), nil, ""))
+	sessionID := types.SessionMetadataAddress(scopeUUID, uuid.New())
+	s.app.MetadataKeeper.SetSession(s.ctx, *types.NewSession(s.sessionName, sessionID, s.contractSpecID, ownerPartyList(s.user1), nil))
+
+	otherScopeUUID := uuid.New()
+	s.app.MetadataKeeper.SetScope(s.ctx, *types.NewScope(types.ScopeMetadataAddress(otherScopeUUID), s.scopeSpecID, ownerPartyList(s.user1), nil, ""))
+	otherSessionID := types.SessionMetadataAddress(otherScopeUUID, uuid.New())
+	s.app.MetadataKeeper.SetSession(s.ctx, *types.NewSession(s.sessionName, otherSessionID, s.contractSpecID, ownerPartyList(s.user1), nil))
+
+	s.app.MetadataKeeper.SetContractSpecification(s.ctx, types.ContractSpecification{
+		SpecificationId: s.contractSpecID,
+		OwnerAddresses:  []string{s.user1},
+		PartiesInvolved: []types.PartyType{types.PartyType_PARTY_TYPE_OWNER},
+		ClassName:       "classname",
+	})
+	newRecordSpec := func(name string, typeName string, inputs ...*types.InputSpecification) types.MetadataAddress {
+		specID := types.RecordSpecMetadataAddress(s.contractSpecUUID, name)
+		s.app.MetadataKeeper.SetRecordSpecification(s.ctx, *types.NewRecordSpecification(specID, name, inputs, typeName,
+			types.DefinitionType_DEFINITION_TYPE_RECORD_LIST, []types.PartyType{types.PartyType_PARTY_TYPE_OWNER}))
+		return specID
+	}
+
+	process := types.NewProcess("processname", &types.Process_Hash{Hash: "HASH"}, "process_method")
+	outputs := []types.RecordOutput{{Hash: "output", Status: types.ResultStatus_RESULT_STATUS_PASS}}
+
+	// The source records are a list of Loans and a Document with no specification id.
+	loansSpecID := newRecordSpec("loans", "Loan")
+	newRecordSpec("document", "Document")
+	loans := types.NewRecord("loans", sessionID, *process, nil, outputs, loansSpecID)
+	s.app.MetadataKeeper.SetRecord(s.ctx, *loans)
+	loansID := types.RecordMetadataAddress(scopeUUID, "loans")
+	document := types.NewRecord("document", sessionID, *process, nil, outputs, nil)
+	s.app.MetadataKeeper.SetRecord(s.ctx, *document)
+	documentID := types.RecordMetadataAddress(scopeUUID, "document")
+	otherLoans := types.NewRecord("loans", otherSessionID, *process, nil, outputs, loansSpecID)
+	s.app.MetadataKeeper.SetRecord(s.ctx, *otherLoans)
+	otherLoansID := types.RecordMetadataAddress(otherScopeUUID, "loans")
+
+	newRecord := func(name string, inputTypeName string, sourceID types.MetadataAddress) *types.Record {
+		newRecordSpec(name, "Summary", types.NewInputSpecification("source", inputTypeName, types.NewInputSpecificationSourceRecordID(sourceID)))
+		input := types.NewRecordInput("source", &types.RecordInput_RecordId{RecordId: sourceID}, inputTypeName, types.RecordInputStatus_Record)
+		return types.NewRecord(name, sessionID, *process, []types.RecordInput{*input}, outputs, nil)
+	}
+
+	// A record can't use an earlier version of itself as an input.
+	selfSourced := newRecord("summary5", "Summary", types.RecordMetadataAddress(scopeUUID, "summary5"))
+	s.app.MetadataKeeper.SetRecord(s.ctx, *types.NewRecord("summary5", sessionID, *process, nil, outputs, nil))
+
+	tests := []struct {
+		name     string
+		proposed *types.Record
+		errorMsg string
+	}{
+		{
+			name:     "source record with matching type",
+			proposed: newRecord("summary1", "Loan", loansID),
+		},
+		{
+			name:     "source record without specification id",
+			proposed: newRecord("summary2", "Document", documentID),
+		},
+		{
+			name:     "source record type does not match",
+			proposed: newRecord("summary3", "Document", loansID),
+			errorMsg: fmt.Sprintf("input source has TypeName Document but source record %s has TypeName Loan", loansID),
+		},
+		{
+			name:     "source record in another scope",
+			proposed: newRecord("summary4", "Loan", otherLoansID),
+			errorMsg: fmt.Sprintf("input source source record id %s is in scope %s but must be in scope %s",
+				otherLoansID, types.ScopeMetadataAddress(otherScopeUUID), scopeID),
+		},
+		{
+			name:     "source record is the record being written",
+			proposed: selfSourced,
+			errorMsg: fmt.Sprintf("input source source record id %s is the record being written", types.RecordMetadataAddress(scopeUUID, "summary5")),
+		},
+	}
+
+	for _, tc := range tests {
+		s.T().Run(tc.name, func(t *testing.T) {
+			err := s.app.MetadataKeeper.ValidateRecordUpdate(s.ctx, nil, tc.proposed, []string{s.user1}, nil, types.TypeURLMsgWriteRecordRequest)
+			if len(tc.errorMsg) != 0 {
+				assert.EqualError(t, err, tc.errorMsg, "ValidateRecordUpdate")
+			} else {
+				assert.NoError(t, err, "ValidateRecordUpdate")
+			}
+		})
+	}
+}
+
+func (s *RecordKeeperTestSuite) TestRecordVersions() {
+	msgServer := keeper.NewMsgServerImpl(s.app.MetadataKeeper)
+	scope := types.NewScope(s.scopeID, s.scopeSpecID, ownerPartyList(s.user1), []string{s.user1}, s.user1)
+	s.app.MetadataKeeper.SetScope(s.ctx, *scope)
+	session := types.NewSession(s.sessionName, s.sessionID, s.contractSpecID, ownerPartyList(s.user1), nil)
+	s.app.MetadataKeeper.SetSession(s.ctx, *session)
+	s.app.MetadataKeeper.SetContractSpecification(s.ctx, types.ContractSpecification{
+		SpecificationId: s.contractSpecID,
+		OwnerAddresses:  []string{s.user1},
+		PartiesInvolved: []types.PartyType{types.PartyType_PARTY_TYPE_OWNER},
+		ClassName:       "classname",
+	})
+	s.app.MetadataKeeper.SetRecordSpecification(s.ctx, *types.NewRecordSpecification(
+		s.recordSpecID, s.recordName, []*types.InputSpecification{}, "TestRecordTypeName",
+		types.DefinitionType_DEFINITION_TYPE_RECORD, []types.PartyType{types.PartyType_PARTY_TYPE_OWNER},
+	))
+
+	writeRecord := func(height int64, hash string) {
+		s.ctx = s.ctx.WithBlockHeight(height)
+		process := types.NewProcess("processname", &types.Process_Hash{Hash: "HASH"}, "process_method")
+		outputs := []types.RecordOutput{{Hash: hash, Status: types.ResultStatus_RESULT_STATUS_PASS}}
+		record := types.NewRecord(s.recordName, s.sessionID, *process, []types.RecordInput{}, outputs, s.recordSpecID)
+		_, err := msgServer.WriteRecord(sdk.WrapSDKContext(s.ctx),
+			types.NewMsgWriteRecordRequest(*record, nil, "", []string{s.user1}, nil))
+		s.Require().NoError(err, "WriteRecord %s", hash)
+	}
+	history := func() []types.RecordVersion {
+		res, err := s.app.MetadataKeeper.RecordHistory(sdk.WrapSDKContext(s.ctx), &types.RecordHistoryRequest{RecordAddr: s.recordID.String()})
+		s.Require().NoError(err, "RecordHistory")
+		return res.Versions
+	}
+	versionSummary := func(versions []types.RecordVersion) []string {
+		rv := make([]string, len(versions))
+		for i, v := range versions {
+			rv[i] = fmt.Sprintf("%d@%d:%s", v.Version, v.Height, v.Record.Outputs[0].Hash)
+			if v.Deleted {
+				rv[i] += " (deleted)"
+			}
+		}
+		return rv
+	}
+
+	s.Run("versioning disabled", func() {
+		writeRecord(1, "first")
+		s.Assert().Empty(history(), "history")
+	})
+
+	s.Run("existing record is retained when versioning is enabled", func() {
+		s.app.MetadataKeeper.SetParams(s.ctx, types.NewParams(3, false, false))
+		writeRecord(2, "second")
+		s.Assert().Equal([]string{"1@0:first", "2@2:second"}, versionSummary(history()), "history")
+	})
+
+	s.Run("oldest versions are removed", func() {
+		writeRecord(3, "third")
+		writeRecord(4, "fourth")
+		s.Assert().Equal([]string{"2@2:second", "3@3:third", "4@4:fourth"}, versionSummary(history()), "history")
+		s.Assert().Equal(s.sessionID, history()[0].Record.SessionId, "session id")
+	})
+
+	s.Run("query by scope and name with pagination", func() {
+		res, err := s.queryClient.RecordHistory(gocontext.Background(), &types.RecordHistoryRequest{
+			ScopeId: s.scopeUUID.String(), Name: s.recordName, Pagination: &query.PageRequest{Limit: 2},
+		})
+		s.Require().NoError(err, "RecordHistory")
+		s.Assert().Equal([]string{"2@2:second", "3@3:third"}, versionSummary(res.Versions), "history page 1")
+		s.Assert().NotEmpty(res.Pagination.NextKey, "next key")
+	})
+
+	s.Run("lowering the param trims on next write", func() {
+		s.app.MetadataKeeper.SetParams(s.ctx, types.NewParams(1, false, false))
+		writeRecord(5, "fifth")
+		s.Assert().Equal([]string{"5@5:fifth"}, versionSummary(history()), "history")
+	})
+
+	s.Run("disabling versioning keeps the history", func() {
+		s.app.MetadataKeeper.SetParams(s.ctx, types.NewParams(0, false, false))
+		writeRecord(6, "sixth")
+		s.Assert().Equal([]string{"5@5:fifth"}, versionSummary(history()), "history")
+	})
+
+	s.Run("bad requests", func() {
+		_, err := s.app.MetadataKeeper.RecordHistory(sdk.WrapSDKContext(s.ctx), &types.RecordHistoryRequest{ScopeId: s.scopeID.String()})
+		s.Assert().EqualError(err, "rpc error: code = InvalidArgument desc = a record address or a scope id and record name must be provided")
+		_, err = s.app.MetadataKeeper.RecordHistory(sdk.WrapSDKContext(s.ctx), &types.RecordHistoryRequest{RecordAddr: s.scopeID.String()})
+		s.Assert().ErrorContains(err, "is not a record address")
+	})
+
+	s.Run("removing the record keeps its history", func() {
+		s.app.MetadataKeeper.SetParams(s.ctx, types.NewParams(3, false, false))
+		s.ctx = s.ctx.WithBlockHeight(7)
+		s.app.MetadataKeeper.RemoveRecord(s.ctx, s.recordID)
+		_, found := s.app.MetadataKeeper.GetRecord(s.ctx, s.recordID)
+		s.Assert().False(found, "record found after removal")
+		s.Assert().Equal([]string{"5@5:fifth", "6@7:sixth (deleted)"}, versionSummary(history()), "history")
+	})
+
+	s.Run("disabling versioning keeps deleted versions", func() {
+		s.app.MetadataKeeper.SetParams(s.ctx, types.NewParams(0, false, false))
+		s.app.MetadataKeeper.SetSession(s.ctx, *session)
+		writeRecord(8, "eighth")
+		s.app.MetadataKeeper.RemoveRecord(s.ctx, s.recordID)
+		s.Assert().Equal([]string{"5@5:fifth", "6@7:sixth (deleted)"}, versionSummary(history()), "history")
+	})
+}
@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// SetRecordVersion stores a retained version of a record in the module kv store.
+func (k Keeper) SetRecordVersion(ctx sdk.Context, recordVersion types.RecordVersion) {
+	recordID := recordVersion.Record.SessionId.MustGetAsRecordAddress(recordVersion.Record.Name)
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetRecordVersionKey(recordID, recordVersion.Version), k.cdc.MustMarshal(&recordVersion))
+}
+
+// IterateRecordVersions processes the retained versions of a record, oldest first, with the given handler.
+// If the recordID is an empty MetadataAddress, the retained versions of all records are processed.
+func (k Keeper) IterateRecordVersions(ctx sdk.Context, recordID types.MetadataAddress, handler func(types.RecordVersion) (stop bool)) error {
+	store := ctx.KVStore(k.storeKey)
+	pre := types.RecordVersionKeyPrefix
+	if !recordID.Empty() {
+		pre = types.GetRecordVersionIteratorPrefix(recordID)
+	}
+	it := sdk.KVStorePrefixIterator(store, pre)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var recordVersion types.RecordVersion
+		if err := k.cdc.Unmarshal(it.Value(), &recordVersion); err != nil {
+			return err
+		}
+		if handler(recordVersion) {
+			break
+		}
+	}
+	return nil
+}
+
+// addRecordVersion retains the newly written record as the next version of that record,
+// then removes the oldest versions until no more than the MaxRecordVersions param are retained.
+// If the record existed before versioning was enabled, the existing record is retained first, with a height of zero.
+func (k Keeper) addRecordVersion(ctx sdk.Context, existing *types.Record, record types.Record) {
+	k.appendRecordVersion(ctx, existing, record, false)
+}
+
+// addRecordDeletedVersion retains the removal of a record as the next version of that record, so that its history
+// is kept after it is removed. The oldest versions are removed until no more than the MaxRecordVersions param are retained.
+func (k Keeper) addRecordDeletedVersion(ctx sdk.Context, record types.Record) {
+	k.appendRecordVersion(ctx, nil, record, true)
+}
+
+// appendRecordVersion stores the next version of a record, then removes the oldest versions of it
+// until no more than the MaxRecordVersions param are retained.
+// Nothing is stored or removed while versioning is disabled, so any history retained before then is kept.
+func (k Keeper) appendRecordVersion(ctx sdk.Context, existing *types.Record, record types.Record, deleted bool) {
+	maxVersions := int(k.GetMaxRecordVersions(ctx))
+	if maxVersions == 0 {
+		return
+	}
+	recordID := record.SessionId.MustGetAsRecordAddress(record.Name)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetRecordVersionIteratorPrefix(recordID))
+	keys := getAllKeys(store)
+
+	var lastVersion uint64
+	if len(keys) > 0 {
+		lastVersion = sdk.BigEndianToUint64(keys[len(keys)-1])
+	} else if existing != nil {
+		lastVersion = 1
+		k.SetRecordVersion(ctx, types.RecordVersion{Record: *existing, Version: lastVersion})
+		keys = append(keys, sdk.Uint64ToBigEndian(lastVersion))
+	}
+	version := lastVersion + 1
+	k.SetRecordVersion(ctx, types.RecordVersion{Record: record, Version: version, Height: ctx.BlockHeight(), Deleted: deleted})
+	keys = append(keys, sdk.Uint64ToBigEndian(version))
+
+	for len(keys) > maxVersions {
+		store.Delete(keys[0])
+		keys = keys[1:]
+	}
+}
+
+// getAllKeys gets all the keys in the provided store, in iteration order.
+func getAllKeys(store sdk.KVStore) [][]byte {
+	var keys [][]byte
+	it := store.Iterator(nil, nil)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, it.Key())
+	}
+	return keys
+}
@@ -0,0 +1,239 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// GetScopeSaleOffer returns the open offer to sell the value ownership of a scope.
+func (k Keeper) GetScopeSaleOffer(ctx sdk.Context, scopeID types.MetadataAddress) (offer types.ScopeSaleOffer, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.GetScopeSaleOfferKey(scopeID))
+	if b == nil {
+		return types.ScopeSaleOffer{}, false
+	}
+	k.cdc.MustUnmarshal(b, &offer)
+	return offer, true
+}
+
+// SetScopeSaleOffer stores an offer to sell the value ownership of a scope, replacing any existing offer for the scope.
+func (k Keeper) SetScopeSaleOffer(ctx sdk.Context, offer types.ScopeSaleOffer) {
+	k.RemoveScopeSaleOffer(ctx, offer.ScopeId)
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetScopeSaleOfferKey(offer.ScopeId), k.cdc.MustMarshal(&offer))
+	store.Set(types.GetScopeSaleOfferExpirationKey(offer.Expiration, offer.ScopeId), []byte{0x01})
+}
+
+// RemoveScopeSaleOffer deletes the offer to sell the value ownership of a scope.
+// Returns true if there was an offer to delete.
+func (k Keeper) RemoveScopeSaleOffer(ctx sdk.Context, scopeID types.MetadataAddress) bool {
+	offer, found := k.GetScopeSaleOffer(ctx, scopeID)
+	if !found {
+		return false
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetScopeSaleOfferKey(scopeID))
+	store.Delete(types.GetScopeSaleOfferExpirationKey(offer.Expiration, scopeID))
+	return true
+}
+
+// IterateScopeSaleOffers processes all offers to sell the value ownership of scopes using a given handler.
+func (k Keeper) IterateScopeSaleOffers(ctx sdk.Context, handler func(offer types.ScopeSaleOffer) (stop bool)) error {
+	store := ctx.KVStore(k.storeKey)
+	it := sdk.KVStorePrefixIterator(store, types.ScopeSaleOfferKeyPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var offer types.ScopeSaleOffer
+		if err := k.cdc.Unmarshal(it.Value(), &offer); err != nil {
+			return err
+		}
+		if handler(offer) {
+			break
+		}
+	}
+	return nil
+}
+
+// MaxScopeSaleOffersPrunedPerBlock is the maximum number of expired scope sale offers removed in one block.
+// Any others are handled in the following blocks.
+const MaxScopeSaleOffersPrunedPerBlock = 100
+
+// PruneExpiredScopeSaleOffers removes scope sale offers that have expired as of the block time.
+// At most MaxScopeSaleOffersPrunedPerBlock offers are removed; the rest are left for the next block.
+func (k Keeper) PruneExpiredScopeSaleOffers(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	// The index is only precise to the second, so include the entire second and check each offer.
+	iterator := store.Iterator(types.ScopeSaleOfferExpirationKeyPrefix,
+		types.GetScopeSaleOfferExpirationKeyPrefix(ctx.BlockTime().Add(time.Second)))
+	var keys [][]byte
+	for ; iterator.Valid() && len(keys) < MaxScopeSaleOffersPrunedPerBlock; iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		scopeID, err := types.ParseScopeSaleOfferExpirationKeyScopeID(key)
+		if err != nil {
+			k.Logger(ctx).Error("invalid scope sale offer expiration index entry", "err", err)
+			store.Delete(key)
+			continue
+		}
+		offer, found := k.GetScopeSaleOffer(ctx, scopeID)
+		if !found {
+			store.Delete(key)
+			continue
+		}
+		if !k.IsScopeSaleOfferExpired(ctx, offer) {
+			continue
+		}
+		k.RemoveScopeSaleOffer(ctx, scopeID)
+		k.EmitEvent(ctx, types.NewEventScopeSaleCancelled(scopeID))
+	}
+}
+
+// removeStaleScopeSaleOffer deletes the sale offer of a scope if it was made by someone other than the scope's
+// value owner. This keeps an offer from outliving a change of value owner, e.g. being accepted again after the
+// value ownership has gone from the seller to someone else and back.
+func (k Keeper) removeStaleScopeSaleOffer(ctx sdk.Context, scope types.Scope) {
+	offer, found := k.GetScopeSaleOffer(ctx, scope.ScopeId)
+	if !found || offer.Seller == scope.ValueOwnerAddress {
+		return
+	}
+	k.RemoveScopeSaleOffer(ctx, scope.ScopeId)
+	k.EmitEvent(ctx, types.NewEventScopeSaleCancelled(scope.ScopeId))
+}
+
+// IsScopeSaleOfferExpired returns true if the offer can no longer be accepted.
+func (k Keeper) IsScopeSaleOfferExpired(ctx sdk.Context, offer types.ScopeSaleOffer) bool {
+	return !ctx.BlockTime().Before(offer.Expiration)
+}
+
+// ValidateScopeSaleOffer checks that the value owner of a scope has authorized offering it for sale.
+func (k Keeper) ValidateScopeSaleOffer(
+	ctx sdk.Context,
+	existing types.Scope,
+	expiration time.Time,
+	signers []string,
+	msgTypeURL string,
+) error {
+	if len(existing.ValueOwnerAddress) == 0 {
+		return fmt.Errorf("scope %s does not have a value owner to sell", existing.ScopeId)
+	}
+	if _, isToken := k.getValueOwnerTokenDenom(ctx, existing.ValueOwnerAddress); isToken {
+		return fmt.Errorf("scope %s value owner is tokenized and is sold by sending the value owner token", existing.ScopeId)
+	}
+	if !ctx.BlockTime().Before(expiration) {
+		return fmt.Errorf("expiration %s must be after the current block time", expiration.UTC().Format(time.RFC3339))
+	}
+	return k.validateScopeUpdateValueOwner(ctx, existing.ScopeId, existing.ValueOwnerAddress, "", nil, signers, msgTypeURL)
+}
+
+// ValidateScopeSaleCancel checks that the seller of a scope sale offer has authorized cancelling it.
+func (k Keeper) ValidateScopeSaleCancel(ctx sdk.Context, offer types.ScopeSaleOffer, signers []string, msgTypeURL string) error {
+	return k.validateScopeUpdateValueOwner(ctx, offer.ScopeId, offer.Seller, "", nil, signers, msgTypeURL)
+}
+
+// ValidateScopeSaleAccept checks that a scope sale offer can be accepted by the buyer for the given price.
+func (k Keeper) ValidateScopeSaleAccept(
+	ctx sdk.Context,
+	existing types.Scope,
+	offer types.ScopeSaleOffer,
+	buyer string,
+	price sdk.Coins,
+	signers []string,
+	msgTypeURL string,
+) error {
+	if existing.ValueOwnerAddress != offer.Seller {
+		return fmt.Errorf("offer is no longer valid: scope %s value owner has changed", existing.ScopeId)
+	}
+	if k.IsScopeSaleOfferExpired(ctx, offer) {
+		return fmt.Errorf("offer expired at %s", offer.Expiration.UTC().Format(time.RFC3339))
+	}
+	if len(offer.Buyer) > 0 && offer.Buyer != buyer {
+		return fmt.Errorf("offer can only be accepted by %s", offer.Buyer)
+	}
+	if !price.IsAllGTE(offer.Price) || !offer.Price.IsAllGTE(price) {
+		return fmt.Errorf("price %s does not equal offer price %s", price, offer.Price)
+	}
+
+	isMarker, hasAuth := k.IsMarkerAndHasAuthority(ctx, buyer, signers, markertypes.Access_Withdraw)
+	if isMarker {
+		// If the buyer is a marker, make sure a signer has withdraw authority on it to make the payment.
+		if !hasAuth {
+			return fmt.Errorf("missing signature for %s with authority to withdraw the payment", buyer)
+		}
+	} else {
+		found := false
+		for _, signer := range signers {
+			if buyer == signer {
+				found = true
+				break
+			}
+		}
+		if !found {
+			stillMissing, err := k.checkAuthzForMissing(ctx, []string{buyer}, signers, msgTypeURL, existing.ScopeId)
+			if err != nil {
+				return fmt.Errorf("error validating signers: %w", err)
+			}
+			if len(stillMissing) > 0 {
+				return fmt.Errorf("missing signature from buyer %s", buyer)
+			}
+		}
+	}
+
+	// The buyer is becoming the value owner, so the same rules apply as for any other proposed value owner.
+	return k.validateScopeUpdateValueOwner(ctx, existing.ScopeId, "", buyer, nil, signers, msgTypeURL)
+}
+
+// PayScopeSale moves the price of a scope sale from the buyer to the seller using the marker rules that apply.
+// A marker buyer pays with a withdrawal made by a signer that has withdraw access on it.
+// Restricted marker coins are transferred by a signer that has transfer access on their marker.
+// Everything else is sent using the bank module, and must be send enabled.
+func (k Keeper) PayScopeSale(ctx sdk.Context, buyer, seller sdk.AccAddress, price sdk.Coins, signers []string) error {
+	if acc, isMarker := k.authKeeper.GetAccount(ctx, buyer).(*markertypes.MarkerAccount); isMarker {
+		caller := signerWithMarkerAccess(acc, signers, markertypes.Access_Withdraw)
+		if caller == nil {
+			return fmt.Errorf("missing signature for %s with authority to withdraw the payment", buyer)
+		}
+		return k.markerKeeper.WithdrawCoins(ctx, caller, seller, acc.GetDenom(), price)
+	}
+
+	var unrestricted sdk.Coins
+	for _, coin := range price {
+		marker, err := k.markerKeeper.GetMarkerByDenom(ctx, coin.Denom)
+		if err != nil || marker.GetMarkerType() != markertypes.MarkerType_RestrictedCoin {
+			unrestricted = unrestricted.Add(coin)
+			continue
+		}
+		admin := signerWithMarkerAccess(marker, signers, markertypes.Access_Transfer)
+		if admin == nil {
+			return fmt.Errorf("missing signature with authority to transfer restricted coin %s", coin.Denom)
+		}
+		if err = k.markerKeeper.TransferCoin(ctx, buyer, seller, admin, coin); err != nil {
+			return err
+		}
+	}
+	if unrestricted.IsZero() {
+		return nil
+	}
+	if err := k.bankKeeper.IsSendEnabledCoins(ctx, unrestricted...); err != nil {
+		return err
+	}
+	return k.bankKeeper.SendCoins(ctx, buyer, seller, unrestricted)
+}
+
+// signerWithMarkerAccess returns the first signer that has the given access on the marker, or nil if none do.
+func signerWithMarkerAccess(marker markertypes.MarkerAccountI, signers []string, access markertypes.Access) sdk.AccAddress {
+	for _, signer := range signers {
+		addr, err := sdk.AccAddressFromBech32(signer)
+		if err == nil && marker.AddressHasAccess(addr, access) {
+			return addr
+		}
+	}
+	return nil
+}
@@ -0,0 +1,195 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+	"github.com/provenance-io/provenance/x/metadata/keeper"
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+func (s *ScopeKeeperTestSuite) TestScopeSale() {
+	msgServer := keeper.NewMsgServerImpl(s.app.MetadataKeeper)
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.ctx = s.ctx.WithBlockTime(now)
+	scopeSpec := types.NewScopeSpecification(s.scopeSpecID, nil, []string{s.user1}, []types.PartyType{types.PartyType_PARTY_TYPE_OWNER}, []types.MetadataAddress{})
+	s.app.MetadataKeeper.SetScopeSpecification(s.ctx, *scopeSpec)
+	scope := *types.NewScope(s.scopeID, s.scopeSpecID, ownerPartyList(s.user1), []string{}, s.user1)
+	s.app.MetadataKeeper.SetScope(s.ctx, scope)
+	price := sdk.NewCoins(sdk.NewInt64Coin("salecoin", 100))
+	s.Require().NoError(testutil.FundAccount(s.app.BankKeeper, s.ctx, s.user2Addr, sdk.NewCoins(sdk.NewInt64Coin("salecoin", 150))), "funding user2")
+
+	offer := func(buyer string, expiration time.Time, signers ...string) error {
+		_, err := msgServer.OfferScopeSale(sdk.WrapSDKContext(s.ctx),
+			types.NewMsgOfferScopeSaleRequest(s.scopeID, buyer, price, expiration, signers))
+		return err
+	}
+	accept := func(buyer string, price sdk.Coins, signers ...string) error {
+		_, err := msgServer.AcceptScopeSale(sdk.WrapSDKContext(s.ctx),
+			types.NewMsgAcceptScopeSaleRequest(s.scopeID, buyer, price, signers))
+		return err
+	}
+	cancel := func(signers ...string) error {
+		_, err := msgServer.CancelScopeSale(sdk.WrapSDKContext(s.ctx),
+			types.NewMsgCancelScopeSaleRequest(s.scopeID, signers))
+		return err
+	}
+	valueOwner := func() string {
+		existing, found := s.app.MetadataKeeper.GetScope(s.ctx, s.scopeID)
+		s.Require().True(found, "GetScope found")
+		return existing.ValueOwnerAddress
+	}
+
+	s.Run("value owner must sign offer", func() {
+		err := offer("", now.Add(time.Hour), s.user2)
+		s.Assert().EqualError(err, "missing signature from existing value owner "+s.user1)
+	})
+
+	s.Run("expiration must be in the future", func() {
+		err := offer("", now, s.user1)
+		s.Assert().EqualError(err, "expiration 2023-01-01T00:00:00Z must be after the current block time")
+	})
+
+	s.Run("cancel without offer", func() {
+		s.Assert().EqualError(cancel(s.user1), "no sale offer found for scope "+s.scopeID.String())
+	})
+
+	s.Run("offer to specific buyer", func() {
+		s.Require().NoError(offer(s.user3, now.Add(time.Hour), s.user1), "OfferScopeSale")
+		res, err := s.queryClient.ScopeSaleOffer(s.ctx.Context(), &types.ScopeSaleOfferRequest{ScopeId: s.scopeUUID.String()})
+		s.Require().NoError(err, "ScopeSaleOffer query")
+		s.Require().NotNil(res.Offer, "ScopeSaleOffer query offer")
+		s.Assert().Equal(s.user1, res.Offer.Seller, "offer seller")
+		s.Assert().Equal(s.user3, res.Offer.Buyer, "offer buyer")
+		s.Assert().False(res.Expired, "offer expired")
+		s.Assert().EqualError(accept(s.user2, price, s.user2), "offer can only be accepted by "+s.user3)
+	})
+
+	s.Run("cancel", func() {
+		s.Assert().EqualError(cancel(s.user2), "missing signature from existing value owner "+s.user1)
+		s.Require().NoError(cancel(s.user1), "CancelScopeSale")
+		_, found := s.app.MetadataKeeper.GetScopeSaleOffer(s.ctx, s.scopeID)
+		s.Assert().False(found, "offer found after cancel")
+	})
+
+	s.Run("expired offer", func() {
+		s.Require().NoError(offer("", now.Add(time.Hour), s.user1), "OfferScopeSale")
+		s.ctx = s.ctx.WithBlockTime(now.Add(time.Hour))
+		defer func() { s.ctx = s.ctx.WithBlockTime(now) }()
+		res, err := s.app.MetadataKeeper.ScopeSaleOffer(sdk.WrapSDKContext(s.ctx), &types.ScopeSaleOfferRequest{ScopeId: s.scopeID.String()})
+		s.Require().NoError(err, "ScopeSaleOffer query")
+		s.Assert().True(res.Expired, "offer expired")
+		s.Assert().EqualError(accept(s.user2, price, s.user2), "offer expired at 2023-01-01T01:00:00Z")
+	})
+
+	s.Run("buyer must sign and pay the offer price", func() {
+		s.Assert().EqualError(accept(s.user2, price, s.user3), "missing signature from buyer "+s.user2)
+		lower := sdk.NewCoins(sdk.NewInt64Coin("salecoin", 99))
+		s.Assert().EqualError(accept(s.user2, lower, s.user2), "price 99salecoin does not equal offer price 100salecoin")
+		other := sdk.NewCoins(sdk.NewInt64Coin("othercoin", 100))
+		s.Assert().EqualError(accept(s.user2, other, s.user2), "price 100othercoin does not equal offer price 100salecoin")
+		s.Assert().Equal(s.user1, valueOwner(), "value owner after failed accepts")
+	})
+
+	s.Run("accept", func() {
+		s.Require().NoError(accept(s.user2, price, s.user2), "AcceptScopeSale")
+		s.Assert().Equal(s.user2, valueOwner(), "value owner after sale")
+		s.Assert().Equal(sdk.NewInt64Coin("salecoin", 100), s.app.BankKeeper.GetBalance(s.ctx, s.user1Addr, "salecoin"), "seller balance")
+		s.Assert().Equal(sdk.NewInt64Coin("salecoin", 50), s.app.BankKeeper.GetBalance(s.ctx, s.user2Addr, "salecoin"), "buyer balance")
+		_, found := s.app.MetadataKeeper.GetScopeSaleOffer(s.ctx, s.scopeID)
+		s.Assert().False(found, "offer found after sale")
+	})
+
+	s.Run("value owner change removes offer", func() {
+		s.Require().NoError(offer("", now.Add(time.Hour), s.user2), "OfferScopeSale")
+		updated, found := s.app.MetadataKeeper.GetScope(s.ctx, s.scopeID)
+		s.Require().True(found, "GetScope found")
+		updated.ValueOwnerAddress = s.user3
+		s.app.MetadataKeeper.SetScope(s.ctx, updated)
+		_, found = s.app.MetadataKeeper.GetScopeSaleOffer(s.ctx, s.scopeID)
+		s.Assert().False(found, "offer found after value owner change")
+
+		// Changing the value owner back to the seller must not revive the old offer.
+		updated.ValueOwnerAddress = s.user2
+		s.app.MetadataKeeper.SetScope(s.ctx, updated)
+		s.Assert().EqualError(accept(s.user1, price, s.user1), "no sale offer found for scope "+s.scopeID.String())
+		updated.ValueOwnerAddress = s.user3
+		s.app.MetadataKeeper.SetScope(s.ctx, updated)
+	})
+
+	s.Run("expired offers are pruned", func() {
+		s.Require().NoError(offer("", now.Add(time.Hour), s.user3), "OfferScopeSale")
+		s.app.MetadataKeeper.PruneExpiredScopeSaleOffers(s.ctx.WithBlockTime(now.Add(time.Hour - time.Nanosecond)))
+		_, found := s.app.MetadataKeeper.GetScopeSaleOffer(s.ctx, s.scopeID)
+		s.Assert().True(found, "offer found before expiration")
+
+		s.app.MetadataKeeper.PruneExpiredScopeSaleOffers(s.ctx.WithBlockTime(now.Add(time.Hour)))
+		_, found = s.app.MetadataKeeper.GetScopeSaleOffer(s.ctx, s.scopeID)
+		s.Assert().False(found, "offer found after expiration")
+	})
+
+	s.Run("insufficient funds", func() {
+		s.Require().NoError(offer("", now.Add(time.Hour), s.user3), "OfferScopeSale")
+		err := accept(s.user2, price, s.user2)
+		s.Require().Error(err, "AcceptScopeSale")
+		s.Assert().Contains(err.Error(), "could not pay for scope "+s.scopeID.String(), "AcceptScopeSale error")
+		s.Assert().Equal(s.user3, valueOwner(), "value owner after failed payment")
+	})
+
+	s.Run("remove scope removes offer", func() {
+		s.app.MetadataKeeper.RemoveScope(s.ctx, s.scopeID)
+		_, found := s.app.MetadataKeeper.GetScopeSaleOffer(s.ctx, s.scopeID)
+		s.Assert().False(found, "offer found after scope removal")
+	})
+}
+
+func (s *ScopeKeeperTestSuite) TestScopeSaleRestrictedCoinPayment() {
+	msgServer := keeper.NewMsgServerImpl(s.app.MetadataKeeper)
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.ctx = s.ctx.WithBlockTime(now)
+	scopeID := types.ScopeMetadataAddress(uuid.New())
+	s.app.MetadataKeeper.SetScope(s.ctx, *types.NewScope(scopeID, nil, ownerPartyList(s.user1), []string{}, s.user1))
+
+	denom := "restrictedsale"
+	marker := markertypes.NewEmptyMarkerAccount(denom, s.user3, []markertypes.AccessGrant{*markertypes.NewAccessGrant(s.user3Addr,
+		[]markertypes.Access{markertypes.Access_Mint, markertypes.Access_Withdraw, markertypes.Access_Transfer})})
+	marker.MarkerType = markertypes.MarkerType_RestrictedCoin
+	s.Require().NoError(marker.SetSupply(sdk.NewInt64Coin(denom, 1000)), "SetSupply")
+	s.Require().NoError(s.app.MarkerKeeper.AddMarkerAccount(s.ctx, marker), "AddMarkerAccount")
+	s.Require().NoError(s.app.MarkerKeeper.FinalizeMarker(s.ctx, s.user3Addr, denom), "FinalizeMarker")
+	s.Require().NoError(s.app.MarkerKeeper.ActivateMarker(s.ctx, s.user3Addr, denom), "ActivateMarker")
+	for _, addr := range []sdk.AccAddress{s.user2Addr, s.user3Addr} {
+		s.Require().NoError(s.app.MarkerKeeper.WithdrawCoins(s.ctx, s.user3Addr, addr, denom,
+			sdk.NewCoins(sdk.NewInt64Coin(denom, 100))), "WithdrawCoins to %s", addr)
+	}
+
+	price := sdk.NewCoins(sdk.NewInt64Coin(denom, 10))
+	_, err := msgServer.OfferScopeSale(sdk.WrapSDKContext(s.ctx),
+		types.NewMsgOfferScopeSaleRequest(scopeID, "", price, now.Add(time.Hour), []string{s.user1}))
+	s.Require().NoError(err, "OfferScopeSale")
+	accept := func(buyer string) error {
+		_, err := msgServer.AcceptScopeSale(sdk.WrapSDKContext(s.ctx),
+			types.NewMsgAcceptScopeSaleRequest(scopeID, buyer, price, []string{buyer}))
+		return err
+	}
+
+	s.Run("buyer without transfer authority", func() {
+		err := accept(s.user2)
+		s.Assert().EqualError(err, "could not pay for scope "+scopeID.String()+": missing signature with authority to transfer restricted coin "+denom)
+		s.Assert().Equal(sdk.NewInt64Coin(denom, 100), s.app.BankKeeper.GetBalance(s.ctx, s.user2Addr, denom), "buyer balance")
+	})
+
+	s.Run("buyer with transfer authority", func() {
+		s.Require().NoError(accept(s.user3), "AcceptScopeSale")
+		s.Assert().Equal(sdk.NewInt64Coin(denom, 10), s.app.BankKeeper.GetBalance(s.ctx, s.user1Addr, denom), "seller balance")
+		s.Assert().Equal(sdk.NewInt64Coin(denom, 90), s.app.BankKeeper.GetBalance(s.ctx, s.user3Addr, denom), "buyer balance")
+		existing, found := s.app.MetadataKeeper.GetScope(s.ctx, scopeID)
+		s.Require().True(found, "GetScope found")
+		s.Assert().Equal(s.user3, existing.ValueOwnerAddress, "value owner after sale")
+	})
+}
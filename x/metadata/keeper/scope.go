@@ -2,6 +2,8 @@ package keeper
 
 import (
 	"fmt"
+	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/gogo/protobuf/proto"
@@ -99,7 +101,11 @@ func (k Keeper) SetScope(ctx sdk.Context, scope types.Scope) {
 
 	store.Set(scope.ScopeId, b)
 	k.indexScope(ctx, &scope, oldScope)
+	k.removeStaleScopeSaleOffer(ctx, scope)
 	k.EmitEvent(ctx, event)
+	if k.GetEmitDetailedEvents(ctx) {
+		k.EmitEvent(ctx, types.NewEventScopeChanged(oldScope, scope))
+	}
 	defer types.GetIncObjFunc(types.TLType_Scope, action)
 }
 
@@ -130,6 +136,7 @@ func (k Keeper) RemoveScope(ctx sdk.Context, id types.MetadataAddress) {
 	// Sessions will be removed as the last record in each is deleted.
 
 	k.indexScope(ctx, nil, &scope)
+	k.RemoveScopeSaleOffer(ctx, id)
 	store.Delete(id)
 	k.EmitEvent(ctx, types.NewEventScopeDeleted(scope.ScopeId))
 	defer types.GetIncObjFunc(types.TLType_Scope, types.TLAction_Deleted)
@@ -141,6 +148,10 @@ type scopeIndexValues struct {
 	Addresses       []string
 	ValueOwner      string
 	SpecificationID types.MetadataAddress
+	Owners          []types.Party
+	DataAccess      []string
+	// ExpiringGrants are the data access grants that have an expiration.
+	ExpiringGrants []types.DataAccessGrant
 }
 
 // getScopeIndexValues extracts the values used to index a scope.
@@ -153,7 +164,14 @@ func getScopeIndexValues(scope *types.Scope) *scopeIndexValues {
 		ValueOwner:      scope.ValueOwnerAddress,
 		SpecificationID: scope.SpecificationId,
 	}
+	rv.Owners = append(rv.Owners, scope.Owners...)
+	rv.DataAccess = append(rv.DataAccess, scope.DataAccess...)
 	rv.Addresses = append(rv.Addresses, scope.DataAccess...)
+	for _, g := range scope.DataAccessGrants {
+		if g.Expiration != nil {
+			rv.ExpiringGrants = append(rv.ExpiringGrants, g)
+		}
+	}
 	for _, p := range scope.Owners {
 		rv.Addresses = appendIfNew(rv.Addresses, p.Address)
 	}
@@ -190,6 +208,41 @@ func getMissingScopeIndexValues(required, found *scopeIndexValues) scopeIndexVal
 	if !required.SpecificationID.Equals(found.SpecificationID) {
 		rv.SpecificationID = required.SpecificationID
 	}
+	rv.Owners = findMissingParties(required.Owners, found.Owners)
+	rv.DataAccess = FindMissing(required.DataAccess, found.DataAccess)
+	rv.ExpiringGrants = findMissingExpiringGrants(required.ExpiringGrants, found.ExpiringGrants)
+	return rv
+}
+
+// findMissingExpiringGrants returns all grants in the required list that do not have an entry
+// with the same address and expiration in the entries list.
+// Expirations are compared to the second since that is the precision of the index.
+func findMissingExpiringGrants(required, entries []types.DataAccessGrant) []types.DataAccessGrant {
+	rv := []types.DataAccessGrant{}
+reqLoop:
+	for _, req := range required {
+		for _, entry := range entries {
+			if req.Address == entry.Address && req.Expiration.Unix() == entry.Expiration.Unix() {
+				continue reqLoop
+			}
+		}
+		rv = append(rv, req)
+	}
+	return rv
+}
+
+// findMissingParties returns all parties in the required list that are not found in the entries list.
+func findMissingParties(required, entries []types.Party) []types.Party {
+	rv := []types.Party{}
+reqLoop:
+	for _, req := range required {
+		for _, entry := range entries {
+			if req.Equals(entry) {
+				continue reqLoop
+			}
+		}
+		rv = append(rv, req)
+	}
 	return rv
 }
 
@@ -212,6 +265,21 @@ func (v scopeIndexValues) IndexKeys() [][]byte {
 	if !v.SpecificationID.Empty() {
 		rv = append(rv, types.GetScopeSpecScopeCacheKey(v.SpecificationID, v.ScopeID))
 	}
+	for _, party := range v.Owners {
+		if addr, err := sdk.AccAddressFromBech32(party.Address); err == nil {
+			rv = append(rv, types.GetOwnerRoleScopeCacheKey(addr, party.Role, v.ScopeID))
+		}
+	}
+	for _, addrStr := range v.DataAccess {
+		if addr, err := sdk.AccAddressFromBech32(addrStr); err == nil {
+			rv = append(rv, types.GetDataAccessScopeCacheKey(addr, v.ScopeID))
+		}
+	}
+	for _, g := range v.ExpiringGrants {
+		if addr, err := sdk.AccAddressFromBech32(g.Address); err == nil {
+			rv = append(rv, types.GetDataAccessExpirationKey(*g.Expiration, v.ScopeID, addr))
+		}
+	}
 	return rv
 }
 
@@ -258,6 +326,12 @@ func (k Keeper) ValidateScopeUpdate(
 		return err
 	}
 
+	for _, g := range proposed.DataAccessGrants {
+		if g.IsExpired(ctx.BlockTime()) {
+			return fmt.Errorf("data access grant for %s has already expired", g.Address)
+		}
+	}
+
 	// IDs must match
 	if len(existing.ScopeId) > 0 {
 		if !proposed.ScopeId.Equals(existing.ScopeId) {
@@ -291,14 +365,14 @@ func (k Keeper) ValidateScopeUpdate(
 			proposedCopy.ValueOwnerAddress = existing.ValueOwnerAddress
 		}
 		if !existing.Equals(proposedCopy) {
-			if err := k.ValidateAllPartiesAreSignersWithAuthz(ctx, existing.Owners, signers, msgTypeURL); err != nil {
+			if err := k.ValidateAllPartiesAreSignersWithAuthz(ctx, existing.ScopeId, existing.Owners, signers, msgTypeURL); err != nil {
 				return err
 			}
 			validatedParties = existing.Owners
 		}
 	}
 
-	if err := k.validateScopeUpdateValueOwner(ctx, existing.ValueOwnerAddress, proposed.ValueOwnerAddress, validatedParties, signers, msgTypeURL); err != nil {
+	if err := k.validateScopeUpdateValueOwner(ctx, existing.ScopeId, existing.ValueOwnerAddress, proposed.ValueOwnerAddress, validatedParties, signers, msgTypeURL); err != nil {
 		return err
 	}
 
@@ -308,19 +382,29 @@ func (k Keeper) ValidateScopeUpdate(
 // ValidateScopeRemove checks the current scope and the proposed removal scope to determine if the proposed remove is valid
 // based on the existing state
 func (k Keeper) ValidateScopeRemove(ctx sdk.Context, scope types.Scope, signers []string, msgTypeURL string) error {
-	if err := k.ValidateAllPartiesAreSignersWithAuthz(ctx, scope.Owners, signers, msgTypeURL); err != nil {
+	if err := k.ValidateAllPartiesAreSignersWithAuthz(ctx, scope.ScopeId, scope.Owners, signers, msgTypeURL); err != nil {
 		return err
 	}
 
-	if err := k.validateScopeUpdateValueOwner(ctx, scope.ValueOwnerAddress, "", scope.Owners, signers, msgTypeURL); err != nil {
+	if err := k.validateScopeUpdateValueOwner(ctx, scope.ScopeId, scope.ValueOwnerAddress, "", scope.Owners, signers, msgTypeURL); err != nil {
 		return err
 	}
 
 	return nil
 }
 
+// ValidateScopeTokenizeValueOwner checks that the existing value owner of a scope has authorized replacing it with a
+// value owner token.
+func (k Keeper) ValidateScopeTokenizeValueOwner(ctx sdk.Context, existing types.Scope, signers []string, msgTypeURL string) error {
+	if len(existing.ValueOwnerAddress) == 0 {
+		return fmt.Errorf("scope %s does not have a value owner to tokenize", existing.ScopeId)
+	}
+	return k.validateScopeUpdateValueOwner(ctx, existing.ScopeId, existing.ValueOwnerAddress, "", nil, signers, msgTypeURL)
+}
+
 func (k Keeper) validateScopeUpdateValueOwner(
 	ctx sdk.Context,
+	scopeID types.MetadataAddress,
 	existing,
 	proposed string,
 	validatedParties []types.Party,
@@ -332,8 +416,14 @@ func (k Keeper) validateScopeUpdateValueOwner(
 		return nil
 	}
 	if len(existing) > 0 {
+		isToken, holdsAll := k.IsValueOwnerTokenAndHeldBy(ctx, existing, signers)
 		isMarker, hasAuth := k.IsMarkerAndHasAuthority(ctx, existing, signers, markertypes.Access_Withdraw)
-		if isMarker {
+		if isToken {
+			// If the existing is a value owner token marker, make sure the signers hold the full supply of the token.
+			if !holdsAll {
+				return fmt.Errorf("missing signatures from holders of the full supply of the value owner token held by %s", existing)
+			}
+		} else if isMarker {
 			// If the existing is a marker, make sure a signer has withdraw authority on it.
 			if !hasAuth {
 				return fmt.Errorf("missing signature for %s with authority to withdraw/remove existing value owner", existing)
@@ -358,7 +448,7 @@ func (k Keeper) validateScopeUpdateValueOwner(
 				}
 			}
 			if !found {
-				stillMissing, err := k.checkAuthzForMissing(ctx, []string{existing}, signers, msgTypeURL)
+				stillMissing, err := k.checkAuthzForMissing(ctx, []string{existing}, signers, msgTypeURL, scopeID)
 				if err != nil {
 					return fmt.Errorf("error validating signers: %w", err)
 				}
@@ -386,6 +476,7 @@ func (k Keeper) validateScopeUpdateValueOwner(
 func (k Keeper) ValidateScopeAddDataAccess(
 	ctx sdk.Context,
 	dataAccessAddrs []string,
+	expiration *time.Time,
 	existing types.Scope,
 	signers []string,
 	msgTypeURL string,
@@ -393,6 +484,10 @@ func (k Keeper) ValidateScopeAddDataAccess(
 	if len(dataAccessAddrs) < 1 {
 		return fmt.Errorf("data access list cannot be empty")
 	}
+	if expiration != nil && !expiration.After(ctx.BlockTime()) {
+		return fmt.Errorf("data access expiration %s must be after the block time %s",
+			expiration.UTC().Format(time.RFC3339), ctx.BlockTime().UTC().Format(time.RFC3339))
+	}
 
 	for _, da := range dataAccessAddrs {
 		_, err := sdk.AccAddressFromBech32(da)
@@ -406,7 +501,7 @@ func (k Keeper) ValidateScopeAddDataAccess(
 		}
 	}
 
-	if err := k.ValidateAllPartiesAreSignersWithAuthz(ctx, existing.Owners, signers, msgTypeURL); err != nil {
+	if err := k.ValidateAllPartiesAreSignersWithAuthz(ctx, existing.ScopeId, existing.Owners, signers, msgTypeURL); err != nil {
 		return err
 	}
 
@@ -441,7 +536,7 @@ func (k Keeper) ValidateScopeDeleteDataAccess(
 		}
 	}
 
-	if err := k.ValidateAllPartiesAreSignersWithAuthz(ctx, existing.Owners, signers, msgTypeURL); err != nil {
+	if err := k.ValidateAllPartiesAreSignersWithAuthz(ctx, existing.ScopeId, existing.Owners, signers, msgTypeURL); err != nil {
 		return err
 	}
 
@@ -467,12 +562,93 @@ func (k Keeper) ValidateScopeUpdateOwners(
 	if err := k.ValidateScopeOwners(proposed.Owners, scopeSpec); err != nil {
 		return err
 	}
-	if err := k.ValidateAllPartiesAreSignersWithAuthz(ctx, existing.Owners, signers, msgTypeURL); err != nil {
+	if err := k.ValidateAllPartiesAreSignersWithAuthz(ctx, existing.ScopeId, existing.Owners, signers, msgTypeURL); err != nil {
 		return err
 	}
 	return nil
 }
 
+// GetScopeSpecificationMigrationIssues checks whether an existing scope, along with its sessions and records,
+// is compatible with the provided scope specification. All problems found are returned (none means it is compatible).
+func (k Keeper) GetScopeSpecificationMigrationIssues(ctx sdk.Context, scope types.Scope, specID types.MetadataAddress) []string {
+	if err := specID.Validate(); err != nil {
+		return []string{fmt.Sprintf("invalid specification id: %v", err)}
+	}
+	if !specID.IsScopeSpecificationAddress() {
+		return []string{fmt.Sprintf("invalid specification id: is not scope specification id: %s", specID)}
+	}
+	if scope.SpecificationId.Equals(specID) {
+		return []string{fmt.Sprintf("scope %s already uses scope specification %s", scope.ScopeId, specID)}
+	}
+	spec, found := k.GetScopeSpecification(ctx, specID)
+	if !found {
+		return []string{fmt.Sprintf("scope specification %s not found", specID)}
+	}
+
+	var issues []string
+	if err := k.ValidateScopeOwners(scope.Owners, spec); err != nil {
+		issues = append(issues, err.Error())
+	}
+
+	allowed := func(contractSpecID types.MetadataAddress) bool {
+		for _, id := range spec.ContractSpecIds {
+			if id.Equals(contractSpecID) {
+				return true
+			}
+		}
+		return false
+	}
+
+	err := k.IterateSessions(ctx, scope.ScopeId, func(session types.Session) bool {
+		if !allowed(session.SpecificationId) {
+			issues = append(issues, fmt.Sprintf("session %s contract specification %s is not allowed by scope specification %s",
+				session.SessionId, session.SpecificationId, specID))
+		}
+		return false
+	})
+	if err != nil {
+		issues = append(issues, fmt.Sprintf("error iterating sessions: %v", err))
+	}
+
+	err = k.IterateRecords(ctx, scope.ScopeId, func(record types.Record) bool {
+		if record.SpecificationId.Empty() {
+			return false
+		}
+		contractSpecID, err := record.SpecificationId.AsContractSpecAddress()
+		if err != nil {
+			issues = append(issues, fmt.Sprintf("record %s has invalid record specification id %s: %v",
+				record.Name, record.SpecificationId, err))
+			return false
+		}
+		if !allowed(contractSpecID) {
+			issues = append(issues, fmt.Sprintf("record %s contract specification %s is not allowed by scope specification %s",
+				record.Name, contractSpecID, specID))
+		}
+		return false
+	})
+	if err != nil {
+		issues = append(issues, fmt.Sprintf("error iterating records: %v", err))
+	}
+
+	return issues
+}
+
+// ValidateScopeSpecificationMigration checks that an existing scope can be moved to the provided scope specification
+// and that all of the scope's owners have signed.
+func (k Keeper) ValidateScopeSpecificationMigration(
+	ctx sdk.Context,
+	existing types.Scope,
+	specID types.MetadataAddress,
+	signers []string,
+	msgTypeURL string,
+) error {
+	if issues := k.GetScopeSpecificationMigrationIssues(ctx, existing, specID); len(issues) > 0 {
+		return fmt.Errorf("scope %s cannot be migrated to scope specification %s: %s",
+			existing.ScopeId, specID, strings.Join(issues, "; "))
+	}
+	return k.ValidateAllPartiesAreSignersWithAuthz(ctx, existing.ScopeId, existing.Owners, signers, msgTypeURL)
+}
+
 // ValidateScopeOwners is stateful validation for scope owners against a scope specification.
 // This does NOT involve the Scope.ValidateOwnersBasic() function.
 func (k Keeper) ValidateScopeOwners(owners []types.Party, spec types.ScopeSpecification) error {
@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// writeScopeBundle validates and writes the scope, sessions, and records of a bundle, in that order.
+// Each item goes through the same validation as if it had been written with its own message.
+func (k Keeper) writeScopeBundle(ctx sdk.Context, bundle types.ScopeBundle, signers []string, auditBy string, msgTypeURL string) error {
+	existingScope, _ := k.GetScope(ctx, bundle.Scope.ScopeId)
+	if err := k.ValidateScopeUpdate(ctx, existingScope, bundle.Scope, signers, msgTypeURL); err != nil {
+		return fmt.Errorf("scope %s: %w", bundle.Scope.ScopeId, err)
+	}
+	k.SetScope(ctx, bundle.Scope)
+
+	for _, session := range bundle.Sessions {
+		session := session
+		var existing *types.Session
+		var existingAudit *types.AuditFields
+		if e, found := k.GetSession(ctx, session.SessionId); found {
+			existing = &e
+			existingAudit = existing.Audit
+		}
+		if err := k.ValidateSessionUpdate(ctx, existing, &session, signers, msgTypeURL); err != nil {
+			return fmt.Errorf("session %s: %w", session.SessionId, err)
+		}
+		session.Audit = existingAudit.UpdateAudit(ctx.BlockTime(), auditBy, "")
+		k.SetSession(ctx, session)
+	}
+
+	for _, record := range bundle.Records {
+		record := record
+		recordID := record.SessionId.MustGetAsRecordAddress(record.Name)
+		var existing *types.Record
+		if e, found := k.GetRecord(ctx, recordID); found {
+			existing = &e
+		}
+		if err := k.ValidateRecordUpdate(ctx, existing, &record, signers, nil, msgTypeURL); err != nil {
+			return fmt.Errorf("record %q: %w", record.Name, err)
+		}
+		k.SetRecord(ctx, record)
+		k.addRecordVersion(ctx, existing, record)
+		// Remove the old session if it doesn't have any records in it anymore.
+		if existing != nil && !existing.SessionId.Equals(record.SessionId) {
+			k.RemoveSession(ctx, existing.SessionId)
+		}
+	}
+
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -12,6 +13,7 @@ import (
 
 	simapp "github.com/provenance-io/provenance/app"
 	markertypes "github.com/provenance-io/provenance/x/marker/types"
+	"github.com/provenance-io/provenance/x/metadata/keeper"
 	"github.com/provenance-io/provenance/x/metadata/types"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
@@ -335,50 +337,50 @@ func (s *ScopeKeeperTestSuite) TestValidateScopeUpdate() {
 		},
 		{
 			name:     "adding data access with authz grant should be successful",
-			existing: types.Scope{scopeID, scopeSpecID, ownerPartyList(s.user1), []string{}, s.user1},
-			proposed: types.Scope{scopeID, scopeSpecID, ownerPartyList(s.user1), []string{s.user2}, s.user1},
+			existing: *types.NewScope(scopeID, scopeSpecID, ownerPartyList(s.user1), []string{}, s.user1),
+			proposed: *types.NewScope(scopeID, scopeSpecID, ownerPartyList(s.user1), []string{s.user2}, s.user1),
 			signers:  []string{s.user3}, // user 1 has granted scope-write to user 3
 			errorMsg: "",
 		},
 		{
 			name:     "multi owner adding data access with authz grant should be successful",
-			existing: types.Scope{scopeID, scopeSpecID, ownerPartyList(s.user1, s.user2), []string{}, s.user1},
-			proposed: types.Scope{scopeID, scopeSpecID, ownerPartyList(s.user1, s.user2), []string{s.user2}, s.user1},
+			existing: *types.NewScope(scopeID, scopeSpecID, ownerPartyList(s.user1, s.user2), []string{}, s.user1),
+			proposed: *types.NewScope(scopeID, scopeSpecID, ownerPartyList(s.user1, s.user2), []string{s.user2}, s.user1),
 			signers:  []string{s.user2, s.user3}, // user 1 has granted scope-write to user 3
 			errorMsg: "",
 		},
 		{
 			name:     "changing value owner with authz grant should be successful",
-			existing: types.Scope{scopeID, scopeSpecID, ownerPartyList(s.user1), []string{}, s.user1},
-			proposed: types.Scope{scopeID, scopeSpecID, ownerPartyList(s.user1), []string{}, s.user2},
+			existing: *types.NewScope(scopeID, scopeSpecID, ownerPartyList(s.user1), []string{}, s.user1),
+			proposed: *types.NewScope(scopeID, scopeSpecID, ownerPartyList(s.user1), []string{}, s.user2),
 			signers:  []string{s.user3}, // user 1 has granted scope-write to user 3
 			errorMsg: "",
 		},
 		{
 			name:     "changing value owner by authz granter should be successful",
-			existing: types.Scope{scopeID, scopeSpecID, ownerPartyList(s.user1), []string{}, s.user1},
-			proposed: types.Scope{scopeID, scopeSpecID, ownerPartyList(s.user1), []string{}, s.user2},
+			existing: *types.NewScope(scopeID, scopeSpecID, ownerPartyList(s.user1), []string{}, s.user1),
+			proposed: *types.NewScope(scopeID, scopeSpecID, ownerPartyList(s.user1), []string{}, s.user2),
 			signers:  []string{s.user1},
 			errorMsg: "",
 		},
 		{
 			name:     "changing value owner by non-authz grantee should fail",
-			existing: types.Scope{scopeID, scopeSpecID, ownerPartyList(s.user1), []string{}, s.user1},
-			proposed: types.Scope{scopeID, scopeSpecID, ownerPartyList(s.user1), []string{}, s.user2},
+			existing: *types.NewScope(scopeID, scopeSpecID, ownerPartyList(s.user1), []string{}, s.user1),
+			proposed: *types.NewScope(scopeID, scopeSpecID, ownerPartyList(s.user1), []string{}, s.user2),
 			signers:  []string{s.user2},
 			errorMsg: fmt.Sprintf("missing signature from existing value owner %s", s.user1),
 		},
 		{
 			name:     "changing value owner from non-authz granter with different signer should fail",
-			existing: types.Scope{scopeID, scopeSpecID, ownerPartyList(s.user1), []string{}, s.user2},
-			proposed: types.Scope{scopeID, scopeSpecID, ownerPartyList(s.user1), []string{}, s.user1},
+			existing: *types.NewScope(scopeID, scopeSpecID, ownerPartyList(s.user1), []string{}, s.user2),
+			proposed: *types.NewScope(scopeID, scopeSpecID, ownerPartyList(s.user1), []string{}, s.user1),
 			signers:  []string{s.user3},
 			errorMsg: fmt.Sprintf("missing signature from existing value owner %s", s.user2),
 		},
 		{
 			name:     "setting value owner from nothing to non-owner only signed by non-owner should fail",
-			existing: types.Scope{scopeID, scopeSpecID, ownerPartyList(s.user1), []string{}, ""},
-			proposed: types.Scope{scopeID, scopeSpecID, ownerPartyList(s.user1), []string{}, s.user2},
+			existing: *types.NewScope(scopeID, scopeSpecID, ownerPartyList(s.user1), []string{}, ""),
+			proposed: *types.NewScope(scopeID, scopeSpecID, ownerPartyList(s.user1), []string{}, s.user2),
 			signers:  []string{s.user2},
 			errorMsg: fmt.Sprintf("missing signature from [%s (PARTY_TYPE_OWNER)]", s.user1),
 		},
@@ -610,7 +612,7 @@ func (s *ScopeKeeperTestSuite) TestValidateScopeAddDataAccess() {
 		tc := tc
 
 		s.Run(n, func() {
-			err := s.app.MetadataKeeper.ValidateScopeAddDataAccess(s.ctx, tc.dataAccessAddrs, tc.existing, tc.signers, types.TypeURLMsgAddScopeDataAccessRequest)
+			err := s.app.MetadataKeeper.ValidateScopeAddDataAccess(s.ctx, tc.dataAccessAddrs, nil, tc.existing, tc.signers, types.TypeURLMsgAddScopeDataAccessRequest)
 			if tc.wantErr {
 				s.Error(err)
 				s.Equal(tc.errorMsg, err.Error())
@@ -890,3 +892,360 @@ func (s *ScopeKeeperTestSuite) TestScopeIndexing() {
 		}
 	})
 }
+
+func (s *ScopeKeeperTestSuite) TestScopeSpecificationMigration() {
+	msgServer := keeper.NewMsgServerImpl(s.app.MetadataKeeper)
+	owner := []types.PartyType{types.PartyType_PARTY_TYPE_OWNER}
+	cSpecUUID1, cSpecUUID2 := uuid.New(), uuid.New()
+	cSpecID1, cSpecID2 := types.ContractSpecMetadataAddress(cSpecUUID1), types.ContractSpecMetadataAddress(cSpecUUID2)
+	recSpecID := types.RecordSpecMetadataAddress(cSpecUUID1, "recordname")
+
+	newSpec := func(parties []types.PartyType, contractSpecIDs ...types.MetadataAddress) types.MetadataAddress {
+		specID := types.ScopeSpecMetadataAddress(uuid.New())
+		spec := types.NewScopeSpecification(specID, nil, []string{s.user1}, parties, contractSpecIDs)
+		s.app.MetadataKeeper.SetScopeSpecification(s.ctx, *spec)
+		return specID
+	}
+	otherContractSpec := newSpec(owner, cSpecID2)
+	moreParties := newSpec([]types.PartyType{types.PartyType_PARTY_TYPE_OWNER, types.PartyType_PARTY_TYPE_AFFILIATE}, cSpecID1)
+	compatible := newSpec(owner, cSpecID1, cSpecID2)
+	unknown := types.ScopeSpecMetadataAddress(uuid.New())
+
+	s.app.MetadataKeeper.SetScopeSpecification(s.ctx, *types.NewScopeSpecification(s.scopeSpecID, nil, []string{s.user1}, owner, []types.MetadataAddress{cSpecID1}))
+	s.app.MetadataKeeper.SetScope(s.ctx, *types.NewScope(s.scopeID, s.scopeSpecID, ownerPartyList(s.user1), []string{}, ""))
+	sessionID := types.SessionMetadataAddress(s.scopeUUID, uuid.New())
+	s.app.MetadataKeeper.SetSession(s.ctx, *types.NewSession("session", sessionID, cSpecID1, ownerPartyList(s.user1), nil))
+	process := types.NewProcess("process", &types.Process_Hash{Hash: "hash"}, "method")
+	s.app.MetadataKeeper.SetRecord(s.ctx, *types.NewRecord("recordname", sessionID, *process, []types.RecordInput{}, []types.RecordOutput{}, recSpecID))
+
+	check := func(specID types.MetadataAddress) *types.CheckScopeSpecificationMigrationResponse {
+		res, err := s.queryClient.CheckScopeSpecificationMigration(s.ctx.Context(),
+			&types.CheckScopeSpecificationMigrationRequest{ScopeId: s.scopeID.String(), SpecificationId: specID.String()})
+		s.Require().NoError(err, "CheckScopeSpecificationMigration")
+		return res
+	}
+	migrate := func(specID types.MetadataAddress, signers ...string) error {
+		_, err := msgServer.MigrateScopeSpecification(sdk.WrapSDKContext(s.ctx),
+			types.NewMsgMigrateScopeSpecificationRequest(s.scopeID, specID, signers))
+		return err
+	}
+
+	s.Run("incompatible specs are reported", func() {
+		tests := []struct {
+			name   string
+			specID types.MetadataAddress
+			issues []string
+		}{
+			{
+				name:   "same spec",
+				specID: s.scopeSpecID,
+				issues: []string{fmt.Sprintf("scope %s already uses scope specification %s", s.scopeID, s.scopeSpecID)},
+			},
+			{
+				name:   "unknown spec",
+				specID: unknown,
+				issues: []string{fmt.Sprintf("scope specification %s not found", unknown)},
+			},
+			{
+				name:   "missing party type",
+				specID: moreParties,
+				issues: []string{"missing party type required by spec: [AFFILIATE]"},
+			},
+			{
+				name:   "contract specs not allowed",
+				specID: otherContractSpec,
+				issues: []string{
+					fmt.Sprintf("session %s contract specification %s is not allowed by scope specification %s", sessionID, cSpecID1, otherContractSpec),
+					fmt.Sprintf("record recordname contract specification %s is not allowed by scope specification %s", cSpecID1, otherContractSpec),
+				},
+			},
+		}
+		for _, tc := range tests {
+			s.Run(tc.name, func() {
+				res := check(tc.specID)
+				s.Assert().False(res.Compatible, "compatible")
+				s.Assert().Equal(tc.issues, res.Issues, "issues")
+				err := migrate(tc.specID, s.user1)
+				s.Assert().ErrorContains(err, tc.issues[0], "MigrateScopeSpecification")
+			})
+		}
+	})
+
+	s.Run("compatible spec", func() {
+		res := check(compatible)
+		s.Assert().True(res.Compatible, "compatible")
+		s.Assert().Empty(res.Issues, "issues")
+	})
+
+	s.Run("owners must sign", func() {
+		s.Assert().Error(migrate(compatible, s.user2), "MigrateScopeSpecification")
+		scope, found := s.app.MetadataKeeper.GetScope(s.ctx, s.scopeID)
+		s.Require().True(found, "GetScope found")
+		s.Assert().Equal(s.scopeSpecID, scope.SpecificationId, "scope spec after failed migration")
+	})
+
+	s.Run("migrate", func() {
+		s.Require().NoError(migrate(compatible, s.user1), "MigrateScopeSpecification")
+		scope, found := s.app.MetadataKeeper.GetScope(s.ctx, s.scopeID)
+		s.Require().True(found, "GetScope found")
+		s.Assert().Equal(compatible, scope.SpecificationId, "scope spec after migration")
+
+		scopesForSpec := func(specID types.MetadataAddress) []types.MetadataAddress {
+			var scopeIDs []types.MetadataAddress
+			err := s.app.MetadataKeeper.IterateScopesForScopeSpec(s.ctx, specID, func(scopeID types.MetadataAddress) bool {
+				scopeIDs = append(scopeIDs, scopeID)
+				return false
+			})
+			s.Require().NoError(err, "IterateScopesForScopeSpec")
+			return scopeIDs
+		}
+		s.Assert().Empty(scopesForSpec(s.scopeSpecID), "scopes for old spec")
+		s.Assert().Equal([]types.MetadataAddress{s.scopeID}, scopesForSpec(compatible), "scopes for new spec")
+
+		events := s.ctx.EventManager().Events()
+		expected, err := sdk.TypedEventToEvent(types.NewEventScopeSpecificationMigrated(s.scopeID, s.scopeSpecID, compatible))
+		s.Require().NoError(err, "TypedEventToEvent")
+		s.Assert().Contains(events, expected, "emitted events")
+	})
+}
+
+func (s *ScopeKeeperTestSuite) TestDataAccessExpiration() {
+	msgServer := keeper.NewMsgServerImpl(s.app.MetadataKeeper)
+	store := s.ctx.KVStore(s.app.GetKey(types.ModuleName))
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	ctx := s.ctx.WithBlockTime(now)
+	expiration := now.Add(time.Hour)
+
+	s.app.MetadataKeeper.SetScopeSpecification(ctx, *types.NewScopeSpecification(s.scopeSpecID, nil, []string{s.user1},
+		[]types.PartyType{types.PartyType_PARTY_TYPE_OWNER}, []types.MetadataAddress{}))
+	s.app.MetadataKeeper.SetScope(ctx, *types.NewScope(s.scopeID, s.scopeSpecID, ownerPartyList(s.user1), []string{s.user1}, ""))
+
+	s.Run("expiration must be in the future", func() {
+		msg := types.NewMsgAddScopeDataAccessRequest(s.scopeID, []string{s.user2}, []string{s.user1})
+		past := now.Add(-1 * time.Minute)
+		msg.Expiration = &past
+		_, err := msgServer.AddScopeDataAccess(sdk.WrapSDKContext(ctx), msg)
+		s.Require().EqualError(err, "data access expiration 2026-01-01T11:59:00Z must be after the block time 2026-01-01T12:00:00Z")
+	})
+
+	s.Run("role and expiration are recorded and indexed", func() {
+		msg := types.NewMsgAddScopeDataAccessRequest(s.scopeID, []string{s.user2}, []string{s.user1})
+		msg.Role = "auditor"
+		msg.Expiration = &expiration
+		_, err := msgServer.AddScopeDataAccess(sdk.WrapSDKContext(ctx), msg)
+		s.Require().NoError(err, "AddScopeDataAccess")
+
+		scope, found := s.app.MetadataKeeper.GetScope(ctx, s.scopeID)
+		s.Require().True(found, "GetScope found")
+		s.Assert().Equal([]string{s.user1, s.user2}, scope.DataAccess, "DataAccess")
+		s.Require().Len(scope.DataAccessGrants, 1, "DataAccessGrants")
+		s.Assert().Equal(s.user2, scope.DataAccessGrants[0].Address, "grant Address")
+		s.Assert().Equal("auditor", scope.DataAccessGrants[0].Role, "grant Role")
+		s.Assert().True(expiration.Equal(*scope.DataAccessGrants[0].Expiration), "grant Expiration")
+		s.Assert().True(store.Has(types.GetDataAccessExpirationKey(expiration, s.scopeID, s.user2Addr)), "expiration index entry")
+
+		s.Assert().Empty(s.app.MetadataKeeper.GetScopesWithExpiredDataAccess(ctx, expiration.Add(-1*time.Second), 0), "expired before expiration")
+		s.Assert().Equal([]types.MetadataAddress{s.scopeID}, s.app.MetadataKeeper.GetScopesWithExpiredDataAccess(ctx, expiration, 0), "expired at expiration")
+	})
+
+	later := ctx.WithBlockTime(expiration)
+
+	s.Run("expired access is left out of queries", func() {
+		res, err := s.app.MetadataKeeper.Scope(sdk.WrapSDKContext(later), &types.ScopeRequest{ScopeId: s.scopeID.String()})
+		s.Require().NoError(err, "Scope query")
+		s.Assert().Equal([]string{s.user1}, res.Scope.Scope.DataAccess, "queried DataAccess")
+		s.Assert().Empty(res.Scope.Scope.DataAccessGrants, "queried DataAccessGrants")
+
+		scopes, err := s.app.MetadataKeeper.Scopes(sdk.WrapSDKContext(later), &types.ScopesRequest{DataAccess: s.user2})
+		s.Require().NoError(err, "Scopes query")
+		s.Assert().Empty(scopes.Scopes, "scopes with data access")
+	})
+
+	s.Run("expired access is pruned", func() {
+		em := sdk.NewEventManager()
+		s.app.MetadataKeeper.PruneExpiredDataAccess(later.WithEventManager(em))
+
+		scope, found := s.app.MetadataKeeper.GetScope(ctx, s.scopeID)
+		s.Require().True(found, "GetScope found")
+		s.Assert().Equal([]string{s.user1}, scope.DataAccess, "DataAccess")
+		s.Assert().Empty(scope.DataAccessGrants, "DataAccessGrants")
+		s.Assert().False(store.Has(types.GetDataAccessScopeCacheKey(s.user2Addr, s.scopeID)), "data access index entry")
+		s.Assert().False(store.Has(types.GetDataAccessExpirationKey(expiration, s.scopeID, s.user2Addr)), "expiration index entry")
+		s.Assert().Empty(s.app.MetadataKeeper.GetScopesWithExpiredDataAccess(later, expiration, 0), "expired after pruning")
+
+		expEvent, err := sdk.TypedEventToEvent(types.NewEventScopeDataAccessExpired(s.scopeID, []string{s.user2}))
+		s.Require().NoError(err, "TypedEventToEvent")
+		s.Assert().Contains(em.Events(), expEvent, "emitted events")
+	})
+
+	s.Run("pruning is limited per block", func() {
+		scopeIDs := make([]types.MetadataAddress, keeper.MaxScopesPrunedPerBlock+1)
+		for i := range scopeIDs {
+			scopeIDs[i] = types.ScopeMetadataAddress(uuid.New())
+			scope := *types.NewScope(scopeIDs[i], s.scopeSpecID, ownerPartyList(s.user1), []string{s.user2}, "")
+			scope.DataAccessGrants = []types.DataAccessGrant{{Address: s.user2, Expiration: &expiration}}
+			s.app.MetadataKeeper.SetScope(ctx, scope)
+		}
+		s.Assert().Len(s.app.MetadataKeeper.GetScopesWithExpiredDataAccess(later, expiration, 0), len(scopeIDs), "expired scopes without limit")
+		s.Assert().Len(s.app.MetadataKeeper.GetScopesWithExpiredDataAccess(later, expiration, 2), 2, "expired scopes with limit")
+
+		s.app.MetadataKeeper.PruneExpiredDataAccess(later)
+		s.Assert().Len(s.app.MetadataKeeper.GetScopesWithExpiredDataAccess(later, expiration, 0), 1, "expired scopes after first block")
+		s.app.MetadataKeeper.PruneExpiredDataAccess(later)
+		s.Assert().Empty(s.app.MetadataKeeper.GetScopesWithExpiredDataAccess(later, expiration, 0), "expired scopes after second block")
+		for _, scopeID := range scopeIDs {
+			s.app.MetadataKeeper.RemoveScope(ctx, scopeID)
+		}
+	})
+}
+
+func (s *ScopeKeeperTestSuite) TestDetailedEvents() {
+	ownerParty := types.Party{Address: s.user1, Role: types.PartyType_PARTY_TYPE_OWNER}
+	servicerParty := types.Party{Address: s.user2, Role: types.PartyType_PARTY_TYPE_SERVICER}
+	scope := types.Scope{
+		ScopeId:           s.scopeID,
+		SpecificationId:   s.scopeSpecID,
+		Owners:            []types.Party{ownerParty},
+		DataAccess:        []string{s.user1},
+		ValueOwnerAddress: s.user1,
+	}
+	sessionID := types.SessionMetadataAddress(s.scopeUUID, uuid.New())
+	session := types.Session{SessionId: sessionID, Parties: []types.Party{ownerParty}, Name: "first"}
+	record := types.Record{
+		Name:      "record",
+		SessionId: sessionID,
+		Outputs:   []types.RecordOutput{{Hash: "hash1", Status: types.ResultStatus_RESULT_STATUS_PASS}},
+	}
+	recordID := types.RecordMetadataAddress(s.scopeUUID, "record")
+
+	hasDetailedEvent := func(events sdk.Events) bool {
+		for _, event := range events {
+			for _, t := range types.DetailedEventTypes() {
+				if event.Type == t {
+					return true
+				}
+			}
+		}
+		return false
+	}
+	assertEvent := func(em *sdk.EventManager, expected proto.Message) {
+		expEvent, err := sdk.TypedEventToEvent(expected)
+		s.Require().NoError(err, "TypedEventToEvent")
+		s.Assert().Contains(em.Events(), expEvent, "emitted events")
+	}
+
+	s.Run("not emitted by default", func() {
+		em := sdk.NewEventManager()
+		ctx := s.ctx.WithEventManager(em)
+		s.app.MetadataKeeper.SetScope(ctx, scope)
+		s.app.MetadataKeeper.SetSession(ctx, session)
+		s.app.MetadataKeeper.SetRecord(ctx, record)
+		s.Assert().False(hasDetailedEvent(em.Events()), "has detailed events")
+	})
+	s.app.MetadataKeeper.RemoveScope(s.ctx, s.scopeID)
+
+	s.app.MetadataKeeper.SetParams(s.ctx, types.NewParams(0, false, true))
+	defer s.app.MetadataKeeper.SetParams(s.ctx, types.DefaultParams())
+
+	s.Run("created", func() {
+		em := sdk.NewEventManager()
+		ctx := s.ctx.WithEventManager(em)
+		s.app.MetadataKeeper.SetScope(ctx, scope)
+		s.app.MetadataKeeper.SetSession(ctx, session)
+		s.app.MetadataKeeper.SetRecord(ctx, record)
+		assertEvent(em, &types.EventScopeChanged{
+			ScopeAddr:         s.scopeID.String(),
+			AddedOwners:       []types.Party{ownerParty},
+			ValueOwner:        s.user1,
+			AddedDataAccess:   []string{s.user1},
+			SpecificationAddr: s.scopeSpecID.String(),
+		})
+		assertEvent(em, &types.EventSessionChanged{
+			SessionAddr:  sessionID.String(),
+			ScopeAddr:    s.scopeID.String(),
+			AddedParties: []types.Party{ownerParty},
+			Name:         "first",
+		})
+		assertEvent(em, &types.EventRecordChanged{
+			RecordAddr:   recordID.String(),
+			SessionAddr:  sessionID.String(),
+			ScopeAddr:    s.scopeID.String(),
+			OutputHashes: []string{"hash1"},
+		})
+	})
+
+	s.Run("updated", func() {
+		em := sdk.NewEventManager()
+		ctx := s.ctx.WithEventManager(em)
+		scope2 := scope
+		scope2.Owners = []types.Party{servicerParty}
+		scope2.DataAccess = []string{s.user2}
+		scope2.ValueOwnerAddress = s.user2
+		session2 := session
+		session2.Parties = []types.Party{ownerParty, servicerParty}
+		session2.Name = "second"
+		record2 := record
+		record2.Outputs = []types.RecordOutput{{Hash: "hash2", Status: types.ResultStatus_RESULT_STATUS_PASS}}
+		s.app.MetadataKeeper.SetScope(ctx, scope2)
+		s.app.MetadataKeeper.SetSession(ctx, session2)
+		s.app.MetadataKeeper.SetRecord(ctx, record2)
+		assertEvent(em, &types.EventScopeChanged{
+			ScopeAddr:          s.scopeID.String(),
+			AddedOwners:        []types.Party{servicerParty},
+			RemovedOwners:      []types.Party{ownerParty},
+			PreviousValueOwner: s.user1,
+			ValueOwner:         s.user2,
+			AddedDataAccess:    []string{s.user2},
+			RemovedDataAccess:  []string{s.user1},
+			SpecificationAddr:  s.scopeSpecID.String(),
+		})
+		assertEvent(em, &types.EventSessionChanged{
+			SessionAddr:  sessionID.String(),
+			ScopeAddr:    s.scopeID.String(),
+			AddedParties: []types.Party{servicerParty},
+			PreviousName: "first",
+			Name:         "second",
+		})
+		assertEvent(em, &types.EventRecordChanged{
+			RecordAddr:           recordID.String(),
+			SessionAddr:          sessionID.String(),
+			ScopeAddr:            s.scopeID.String(),
+			PreviousSessionAddr:  sessionID.String(),
+			PreviousOutputHashes: []string{"hash1"},
+			OutputHashes:         []string{"hash2"},
+		})
+	})
+
+	s.Run("data access grants changed", func() {
+		scope3 := scope
+		scope3.Owners = []types.Party{servicerParty}
+		scope3.DataAccess = []string{s.user2}
+		scope3.ValueOwnerAddress = s.user2
+		grant := types.DataAccessGrant{Address: s.user2, Role: "auditor"}
+		scope3.DataAccessGrants = []types.DataAccessGrant{grant}
+		em := sdk.NewEventManager()
+		s.app.MetadataKeeper.SetScope(s.ctx.WithEventManager(em), scope3)
+		assertEvent(em, &types.EventScopeChanged{
+			ScopeAddr:             s.scopeID.String(),
+			PreviousValueOwner:    s.user2,
+			ValueOwner:            s.user2,
+			SpecificationAddr:     s.scopeSpecID.String(),
+			AddedDataAccessGrants: []types.DataAccessGrant{grant},
+		})
+
+		expiration := s.ctx.BlockTime().Add(time.Hour).UTC()
+		scope4 := scope3
+		grant2 := types.DataAccessGrant{Address: s.user2, Role: "auditor", Expiration: &expiration}
+		scope4.DataAccessGrants = []types.DataAccessGrant{grant2}
+		em = sdk.NewEventManager()
+		s.app.MetadataKeeper.SetScope(s.ctx.WithEventManager(em), scope4)
+		assertEvent(em, &types.EventScopeChanged{
+			ScopeAddr:               s.scopeID.String(),
+			PreviousValueOwner:      s.user2,
+			ValueOwner:              s.user2,
+			SpecificationAddr:       s.scopeSpecID.String(),
+			AddedDataAccessGrants:   []types.DataAccessGrant{grant2},
+			RemovedDataAccessGrants: []types.DataAccessGrant{grant},
+		})
+	})
+}
@@ -29,15 +29,25 @@ func (k Keeper) SetSession(ctx sdk.Context, session types.Session) {
 	store := ctx.KVStore(k.storeKey)
 	b := k.cdc.MustMarshal(&session)
 
+	var oldSession *types.Session
 	var event proto.Message = types.NewEventSessionCreated(session.SessionId)
 	action := types.TLAction_Created
 	if store.Has(session.SessionId) {
 		event = types.NewEventSessionUpdated(session.SessionId)
 		action = types.TLAction_Updated
+		oldSession = &types.Session{}
+		if err := k.cdc.Unmarshal(store.Get(session.SessionId), oldSession); err != nil {
+			k.Logger(ctx).Error("could not unmarshal old session", "err", err, "sessionId", session.SessionId.String())
+			oldSession = nil
+		}
 	}
 
 	store.Set(session.SessionId, b)
+	k.indexSession(ctx, &session, oldSession)
 	k.EmitEvent(ctx, event)
+	if k.GetEmitDetailedEvents(ctx) {
+		k.EmitEvent(ctx, types.NewEventSessionChanged(oldSession, session))
+	}
 	defer types.GetIncObjFunc(types.TLType_Session, action)
 }
 
@@ -48,15 +58,61 @@ func (k Keeper) RemoveSession(ctx sdk.Context, id types.MetadataAddress) {
 	}
 	store := ctx.KVStore(k.storeKey)
 
-	if !store.Has(id) || k.sessionHasRecords(ctx, id) {
+	session, found := k.GetSession(ctx, id)
+	if !found || k.sessionHasRecords(ctx, id) {
 		return
 	}
 
 	store.Delete(id)
+	k.indexSession(ctx, nil, &session)
 	k.EmitEvent(ctx, types.NewEventSessionDeleted(id))
 	defer types.GetIncObjFunc(types.TLType_Session, types.TLAction_Deleted)
 }
 
+// getSessionIndexKeys gets the party index keys for a session.
+func getSessionIndexKeys(session *types.Session) [][]byte {
+	if session == nil || session.SessionId.Empty() {
+		return nil
+	}
+	rv := make([][]byte, 0, len(session.Parties))
+	for _, party := range session.Parties {
+		if addr, err := sdk.AccAddressFromBech32(party.Address); err == nil {
+			rv = append(rv, types.GetPartyRoleSessionCacheKey(addr, party.Role, session.SessionId))
+		}
+	}
+	return rv
+}
+
+// indexSession updates the party index entries for a session.
+//
+// When adding a new session:  indexSession(ctx, session, nil)
+//
+// When deleting a session:  indexSession(ctx, nil, session)
+//
+// When updating a session:  indexSession(ctx, newSession, oldSession)
+//
+// If both newSession and oldSession are not nil, it is assumed that they have the same SessionId.
+func (k Keeper) indexSession(ctx sdk.Context, newSession, oldSession *types.Session) {
+	newIndexKeys := getSessionIndexKeys(newSession)
+	oldIndexKeys := getSessionIndexKeys(oldSession)
+	newKeys := newKeyLookup()
+	newKeys.add(newIndexKeys...)
+	oldKeys := newKeyLookup()
+	oldKeys.add(oldIndexKeys...)
+
+	store := ctx.KVStore(k.storeKey)
+	for _, indexKey := range oldIndexKeys {
+		if !newKeys.has(indexKey) {
+			store.Delete(indexKey)
+		}
+	}
+	for _, indexKey := range newIndexKeys {
+		if !oldKeys.has(indexKey) {
+			store.Set(indexKey, []byte{0x01})
+		}
+	}
+}
+
 func (k Keeper) sessionHasRecords(ctx sdk.Context, id types.MetadataAddress) bool {
 	if !id.IsSessionAddress() {
 		return false
@@ -161,7 +217,7 @@ func (k Keeper) ValidateSessionUpdate(ctx sdk.Context, existing, proposed *types
 		return err
 	}
 
-	if err = k.ValidateAllPartiesAreSignersWithAuthz(ctx, scope.Owners, signers, msgTypeURL); err != nil {
+	if err = k.ValidateAllPartiesAreSignersWithAuthz(ctx, scope.ScopeId, scope.Owners, signers, msgTypeURL); err != nil {
 		return err
 	}
 
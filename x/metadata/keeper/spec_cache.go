@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// specCache holds the specifications read while processing a single message so that each is only read from state once.
+// A nil entry means the specification was not found.
+// It must only be used while processing something that does not also write specifications.
+type specCache struct {
+	scopeSpecs    map[string]*types.ScopeSpecification
+	contractSpecs map[string]*types.ContractSpecification
+	recordSpecs   map[string]*types.RecordSpecification
+	// sizes are the lengths of the stored values of the cached specifications.
+	sizes map[string]int
+}
+
+// newSpecCache creates a new, empty specCache.
+func newSpecCache() *specCache {
+	return &specCache{
+		scopeSpecs:    make(map[string]*types.ScopeSpecification),
+		contractSpecs: make(map[string]*types.ContractSpecification),
+		recordSpecs:   make(map[string]*types.RecordSpecification),
+		sizes:         make(map[string]int),
+	}
+}
+
+// consumeReadGas charges the gas that reading the cached specification from state would have cost.
+// This keeps the gas used for each item the same no matter whether its specifications were already read.
+func (c *specCache) consumeReadGas(ctx sdk.Context, specID types.MetadataAddress) {
+	gasConfig := ctx.KVGasConfig()
+	ctx.GasMeter().ConsumeGas(gasConfig.ReadCostFlat, storetypes.GasReadCostFlatDesc)
+	ctx.GasMeter().ConsumeGas(gasConfig.ReadCostPerByte*storetypes.Gas(len(specID)), storetypes.GasReadPerByteDesc)
+	ctx.GasMeter().ConsumeGas(gasConfig.ReadCostPerByte*storetypes.Gas(c.sizes[string(specID)]), storetypes.GasReadPerByteDesc)
+}
+
+// withSpecCache returns a copy of this keeper that caches the specifications it reads.
+// The returned keeper should be discarded once the message being processed is done.
+func (k Keeper) withSpecCache() Keeper {
+	k.specs = newSpecCache()
+	return k
+}
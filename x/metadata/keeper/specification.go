@@ -91,12 +91,28 @@ func (k Keeper) GetRecordSpecification(ctx sdk.Context, recordSpecID types.Metad
 	if !recordSpecID.IsRecordSpecificationAddress() {
 		return spec, false
 	}
+	if k.specs != nil {
+		if cached, ok := k.specs.recordSpecs[string(recordSpecID)]; ok {
+			k.specs.consumeReadGas(ctx, recordSpecID)
+			if cached == nil {
+				return types.RecordSpecification{}, false
+			}
+			return *cached, true
+		}
+	}
 	store := ctx.KVStore(k.storeKey)
 	b := store.Get(recordSpecID)
 	if b == nil {
+		if k.specs != nil {
+			k.specs.recordSpecs[string(recordSpecID)] = nil
+		}
 		return types.RecordSpecification{}, false
 	}
 	k.cdc.MustUnmarshal(b, &spec)
+	if k.specs != nil {
+		k.specs.recordSpecs[string(recordSpecID)] = &spec
+		k.specs.sizes[string(recordSpecID)] = len(b)
+	}
 	return spec, true
 }
 
@@ -204,12 +220,28 @@ func (k Keeper) GetContractSpecification(ctx sdk.Context, contractSpecID types.M
 	if !contractSpecID.IsContractSpecificationAddress() {
 		return spec, false
 	}
+	if k.specs != nil {
+		if cached, ok := k.specs.contractSpecs[string(contractSpecID)]; ok {
+			k.specs.consumeReadGas(ctx, contractSpecID)
+			if cached == nil {
+				return types.ContractSpecification{}, false
+			}
+			return *cached, true
+		}
+	}
 	store := ctx.KVStore(k.storeKey)
 	b := store.Get(contractSpecID)
 	if b == nil {
+		if k.specs != nil {
+			k.specs.contractSpecs[string(contractSpecID)] = nil
+		}
 		return types.ContractSpecification{}, false
 	}
 	k.cdc.MustUnmarshal(b, &spec)
+	if k.specs != nil {
+		k.specs.contractSpecs[string(contractSpecID)] = &spec
+		k.specs.sizes[string(contractSpecID)] = len(b)
+	}
 	return spec, true
 }
 
@@ -434,12 +466,28 @@ func (k Keeper) GetScopeSpecification(ctx sdk.Context, scopeSpecID types.Metadat
 	if !scopeSpecID.IsScopeSpecificationAddress() {
 		return spec, false
 	}
+	if k.specs != nil {
+		if cached, ok := k.specs.scopeSpecs[string(scopeSpecID)]; ok {
+			k.specs.consumeReadGas(ctx, scopeSpecID)
+			if cached == nil {
+				return types.ScopeSpecification{}, false
+			}
+			return *cached, true
+		}
+	}
 	store := ctx.KVStore(k.storeKey)
 	b := store.Get(scopeSpecID)
 	if b == nil {
+		if k.specs != nil {
+			k.specs.scopeSpecs[string(scopeSpecID)] = nil
+		}
 		return types.ScopeSpecification{}, false
 	}
 	k.cdc.MustUnmarshal(b, &spec)
+	if k.specs != nil {
+		k.specs.scopeSpecs[string(scopeSpecID)] = &spec
+		k.specs.sizes[string(scopeSpecID)] = len(b)
+	}
 	return spec, true
 }
 
@@ -7,6 +7,7 @@ import (
 	"github.com/google/uuid"
 
 	simapp "github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/x/metadata/keeper"
 	"github.com/provenance-io/provenance/x/metadata/types"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
@@ -186,6 +187,73 @@ func (s *SpecKeeperTestSuite) TestGetSetRemoveRecordSpecification() {
 	)
 }
 
+func (s *SpecKeeperTestSuite) TestSpecCacheGas() {
+	recordSpec := types.NewRecordSpecification(
+		types.RecordSpecMetadataAddress(s.contractSpecUUID1, "cachedrecord"), "cachedrecord", nil, "typename",
+		types.DefinitionType_DEFINITION_TYPE_RECORD, []types.PartyType{types.PartyType_PARTY_TYPE_OWNER},
+	)
+	contractSpec := types.NewContractSpecification(
+		s.contractSpecID1, nil, []string{s.user1}, []types.PartyType{types.PartyType_PARTY_TYPE_OWNER},
+		types.NewContractSpecificationSourceHash("sourcehash"), "classname",
+	)
+	scopeSpec := types.NewScopeSpecification(
+		s.scopeSpecID, nil, []string{s.user1}, []types.PartyType{types.PartyType_PARTY_TYPE_OWNER},
+		[]types.MetadataAddress{s.contractSpecID1},
+	)
+	s.app.MetadataKeeper.SetRecordSpecification(s.ctx, *recordSpec)
+	s.app.MetadataKeeper.SetContractSpecification(s.ctx, *contractSpec)
+	s.app.MetadataKeeper.SetScopeSpecification(s.ctx, *scopeSpec)
+	missingID := types.ScopeSpecMetadataAddress(uuid.New())
+
+	tests := []struct {
+		name string
+		get  func(k keeper.Keeper, ctx sdk.Context) bool
+	}{
+		{
+			name: "record specification",
+			get: func(k keeper.Keeper, ctx sdk.Context) bool {
+				_, found := k.GetRecordSpecification(ctx, recordSpec.SpecificationId)
+				return found
+			},
+		},
+		{
+			name: "contract specification",
+			get: func(k keeper.Keeper, ctx sdk.Context) bool {
+				_, found := k.GetContractSpecification(ctx, contractSpec.SpecificationId)
+				return found
+			},
+		},
+		{
+			name: "scope specification",
+			get: func(k keeper.Keeper, ctx sdk.Context) bool {
+				_, found := k.GetScopeSpecification(ctx, scopeSpec.SpecificationId)
+				return found
+			},
+		},
+		{
+			name: "missing specification",
+			get: func(k keeper.Keeper, ctx sdk.Context) bool {
+				_, found := k.GetScopeSpecification(ctx, missingID)
+				return !found
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			k := s.app.MetadataKeeper.WithSpecCache()
+			gasUsed := func() uint64 {
+				ctx := s.ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+				s.Require().True(tc.get(k, ctx), "get")
+				return ctx.GasMeter().GasConsumed()
+			}
+			uncachedGas := gasUsed()
+			s.Assert().NotZero(uncachedGas, "gas used for the first read")
+			s.Assert().Equal(uncachedGas, gasUsed(), "gas used for a cached read")
+		})
+	}
+}
+
 func (s *SpecKeeperTestSuite) TestIterateRecordSpecs() {
 	size := 10
 	specs := make([]*types.RecordSpecification, size)
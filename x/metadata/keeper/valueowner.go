@@ -0,0 +1,172 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// GetModuleAddress returns the address that manages the markers backing value owner tokens.
+func (k Keeper) GetModuleAddress() sdk.AccAddress {
+	return authtypes.NewModuleAddress(types.ModuleName)
+}
+
+// CreateValueOwnerToken creates a marker-backed token for a scope's value ownership, gives the full supply to the
+// existing value owner, and makes the marker the value owner of the scope.
+// The caller is responsible for making sure the existing value owner has authorized this.
+func (k Keeper) CreateValueOwnerToken(ctx sdk.Context, scope types.Scope, supply sdk.Int) (sdk.AccAddress, error) {
+	if len(scope.ValueOwnerAddress) == 0 {
+		return nil, fmt.Errorf("scope %s does not have a value owner to tokenize", scope.ScopeId)
+	}
+	holder, err := sdk.AccAddressFromBech32(scope.ValueOwnerAddress)
+	if err != nil {
+		return nil, fmt.Errorf("invalid value owner address %s: %w", scope.ValueOwnerAddress, err)
+	}
+	denom := types.ValueOwnerTokenDenom(scope.ScopeId)
+	markerAddr, err := markertypes.MarkerAddress(denom)
+	if err != nil {
+		return nil, fmt.Errorf("invalid value owner token denom %s: %w", denom, err)
+	}
+	if holder.Equals(markerAddr) {
+		return nil, fmt.Errorf("scope %s value owner is already tokenized", scope.ScopeId)
+	}
+	// Nothing else may use the denom, otherwise the token would not be the only claim on the scope's value ownership.
+	if _, err = k.markerKeeper.GetMarkerByDenom(ctx, denom); err == nil {
+		return nil, fmt.Errorf("value owner token denom %s is already in use by a marker", denom)
+	}
+	if k.bankKeeper.GetSupply(ctx, denom).Amount.IsPositive() {
+		return nil, fmt.Errorf("value owner token denom %s already has a supply", denom)
+	}
+	if acc := k.authKeeper.GetAccount(ctx, markerAddr); acc != nil {
+		return nil, fmt.Errorf("value owner token marker address %s is already in use", markerAddr)
+	}
+
+	moduleAddr := k.GetModuleAddress()
+	total := sdk.NewCoin(denom, supply)
+	marker := markertypes.NewMarkerAccount(
+		authtypes.NewBaseAccountWithAddress(markerAddr),
+		total,
+		moduleAddr,
+		[]markertypes.AccessGrant{*markertypes.NewAccessGrant(moduleAddr, []markertypes.Access{markertypes.Access_Withdraw})},
+		markertypes.StatusProposed,
+		markertypes.MarkerType_Coin,
+	)
+	marker.AllowGovernanceControl = false
+	if err = k.markerKeeper.AddMarkerAccount(ctx, marker); err != nil {
+		return nil, fmt.Errorf("could not create value owner token %s: %w", denom, err)
+	}
+	if err = k.markerKeeper.FinalizeMarker(ctx, moduleAddr, denom); err != nil {
+		return nil, fmt.Errorf("could not finalize value owner token %s: %w", denom, err)
+	}
+	if err = k.markerKeeper.ActivateMarker(ctx, moduleAddr, denom); err != nil {
+		return nil, fmt.Errorf("could not activate value owner token %s: %w", denom, err)
+	}
+	if err = k.markerKeeper.WithdrawCoins(ctx, moduleAddr, holder, denom, sdk.NewCoins(total)); err != nil {
+		return nil, fmt.Errorf("could not distribute value owner token %s: %w", denom, err)
+	}
+
+	scope.ValueOwnerAddress = markerAddr.String()
+	k.SetScope(ctx, scope)
+	k.EmitEvent(ctx, types.NewEventScopeValueOwnerTokenized(scope.ScopeId, total, holder.String()))
+	return markerAddr, nil
+}
+
+// IsValueOwnerTokenAndHeldBy checks that the address is the marker of a value owner token and that the signers
+// together hold its full supply.
+// First return boolean is whether or not the address is a value owner token marker.
+// Second return boolean is whether or not the signers hold the full supply of that token.
+// If the first return boolean is false, they'll both be false.
+func (k Keeper) IsValueOwnerTokenAndHeldBy(ctx sdk.Context, address string, signers []string) (isToken bool, holdsAll bool) {
+	denom, isToken := k.getValueOwnerTokenDenom(ctx, address)
+	if !isToken {
+		return false, false
+	}
+	supply := k.bankKeeper.GetSupply(ctx, denom).Amount
+	if !supply.IsPositive() {
+		return true, false
+	}
+	held := sdk.ZeroInt()
+	seen := make(map[string]bool)
+	for _, signer := range signers {
+		if seen[signer] {
+			continue
+		}
+		seen[signer] = true
+		saddr, err := sdk.AccAddressFromBech32(signer)
+		if err != nil {
+			continue
+		}
+		held = held.Add(k.bankKeeper.GetBalance(ctx, saddr, denom).Amount)
+	}
+	return true, held.GTE(supply)
+}
+
+// GetValueOwnerTokenHolder returns the address that holds the full supply of a value owner token.
+// An empty string is returned if there is no supply or it is split between several addresses.
+func (k Keeper) GetValueOwnerTokenHolder(ctx sdk.Context, denom string) (string, error) {
+	supply := k.bankKeeper.GetSupply(ctx, denom).Amount
+	if !supply.IsPositive() {
+		return "", nil
+	}
+	owners, err := k.bankKeeper.DenomOwners(sdk.WrapSDKContext(ctx), &banktypes.QueryDenomOwnersRequest{
+		Denom:      denom,
+		Pagination: &query.PageRequest{Limit: 2},
+	})
+	if err != nil {
+		return "", err
+	}
+	if len(owners.DenomOwners) != 1 || !owners.DenomOwners[0].Balance.Amount.Equal(supply) {
+		return "", nil
+	}
+	return owners.DenomOwners[0].Address, nil
+}
+
+// getValueOwnerTokenDenom returns the denom of the value owner token if the address is the marker for one.
+func (k Keeper) getValueOwnerTokenDenom(ctx sdk.Context, address string) (string, bool) {
+	addr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return "", false
+	}
+	acc := k.authKeeper.GetAccount(ctx, addr)
+	if acc == nil {
+		return "", false
+	}
+	marker, isMarker := acc.(*markertypes.MarkerAccount)
+	if !isMarker || !types.IsValueOwnerTokenDenom(marker.GetDenom()) || !marker.AddressHasAccess(k.GetModuleAddress(), markertypes.Access_Withdraw) {
+		return "", false
+	}
+	return marker.GetDenom(), true
+}
+
+// GetScopesWithValueOwnerTokenHeldBy returns the ids of tokenized scopes where the address holds the full supply of
+// the value owner token. The value owner index only has the markers of tokenized scopes, and the tokens can move
+// through plain bank transfers, so these are found from the address's balances instead.
+func (k Keeper) GetScopesWithValueOwnerTokenHeldBy(ctx sdk.Context, addr sdk.AccAddress) []types.MetadataAddress {
+	var scopeIDs []types.MetadataAddress
+	// Balances are iterated in denom order, so stop once past the value owner token denoms.
+	k.bankKeeper.IterateAccountBalances(ctx, addr, func(coin sdk.Coin) bool {
+		if !strings.HasPrefix(coin.Denom, types.ValueOwnerTokenDenomPrefix) {
+			return coin.Denom > types.ValueOwnerTokenDenomPrefix
+		}
+		scopeID, err := types.ScopeIDFromValueOwnerTokenDenom(coin.Denom)
+		if err != nil || !coin.Amount.IsPositive() || coin.Amount.LT(k.bankKeeper.GetSupply(ctx, coin.Denom).Amount) {
+			return false
+		}
+		scope, found := k.GetScope(ctx, scopeID)
+		if !found {
+			return false
+		}
+		if denom, isToken := k.getValueOwnerTokenDenom(ctx, scope.ValueOwnerAddress); isToken && denom == coin.Denom {
+			scopeIDs = append(scopeIDs, scopeID)
+		}
+		return false
+	})
+	return scopeIDs
+}
@@ -0,0 +1,128 @@
+package keeper_test
+
+import (
+	"github.com/google/uuid"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+	"github.com/provenance-io/provenance/x/metadata/keeper"
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+func (s *ScopeKeeperTestSuite) TestTokenizeScopeValueOwner() {
+	msgServer := keeper.NewMsgServerImpl(s.app.MetadataKeeper)
+	scopeSpec := types.NewScopeSpecification(s.scopeSpecID, nil, []string{s.user1}, []types.PartyType{types.PartyType_PARTY_TYPE_OWNER}, []types.MetadataAddress{})
+	s.app.MetadataKeeper.SetScopeSpecification(s.ctx, *scopeSpec)
+	scope := *types.NewScope(s.scopeID, s.scopeSpecID, ownerPartyList(s.user1), []string{}, s.user1)
+	s.app.MetadataKeeper.SetScope(s.ctx, scope)
+	denom := types.ValueOwnerTokenDenom(s.scopeID)
+	markerAddr := markertypes.MustGetMarkerAddress(denom).String()
+
+	tokenize := func(supply int64, signers ...string) (*types.MsgTokenizeScopeValueOwnerResponse, error) {
+		return msgServer.TokenizeScopeValueOwner(sdk.WrapSDKContext(s.ctx),
+			types.NewMsgTokenizeScopeValueOwnerRequest(s.scopeID, sdk.NewInt(supply), signers))
+	}
+	updateValueOwner := func(valueOwner string, signers ...string) error {
+		existing, found := s.app.MetadataKeeper.GetScope(s.ctx, s.scopeID)
+		s.Require().True(found, "GetScope found")
+		proposed := existing
+		proposed.ValueOwnerAddress = valueOwner
+		return s.app.MetadataKeeper.ValidateScopeUpdate(s.ctx, existing, proposed, signers, types.TypeURLMsgWriteScopeRequest)
+	}
+
+	s.Run("value owner must sign", func() {
+		_, err := tokenize(10, s.user2)
+		s.Assert().EqualError(err, "missing signature from existing value owner "+s.user1)
+	})
+
+	s.Run("tokenize", func() {
+		res, err := tokenize(10, s.user1)
+		s.Require().NoError(err, "TokenizeScopeValueOwner")
+		s.Assert().Equal(denom, res.Denom, "response denom")
+		s.Assert().Equal(markerAddr, res.MarkerAddress, "response marker address")
+		updated, found := s.app.MetadataKeeper.GetScope(s.ctx, s.scopeID)
+		s.Require().True(found, "GetScope found")
+		s.Assert().Equal(markerAddr, updated.ValueOwnerAddress, "scope value owner")
+		s.Assert().Equal(sdk.NewInt64Coin(denom, 10), s.app.BankKeeper.GetBalance(s.ctx, s.user1Addr, denom), "user1 balance")
+	})
+
+	s.Run("tokenize again", func() {
+		_, err := tokenize(1, s.user1)
+		s.Assert().EqualError(err, "scope "+s.scopeID.String()+" value owner is already tokenized")
+	})
+
+	s.Run("query by scope id and denom", func() {
+		expected := &types.ScopeValueOwnerTokenResponse{
+			ScopeId:       s.scopeID.String(),
+			Denom:         denom,
+			MarkerAddress: markerAddr,
+			Tokenized:     true,
+			Supply:        sdk.NewInt(10),
+			ValueOwner:    s.user1,
+		}
+		for _, req := range []*types.ScopeValueOwnerTokenRequest{{ScopeId: s.scopeID.String()}, {Denom: denom}} {
+			res, err := s.queryClient.ScopeValueOwnerToken(s.ctx.Context(), req)
+			s.Require().NoError(err, "ScopeValueOwnerToken(%v)", req)
+			res.Request = nil
+			s.Assert().Equal(expected, res, "ScopeValueOwnerToken(%v)", req)
+		}
+	})
+
+	s.Run("holder of full supply is the value owner", func() {
+		s.Assert().EqualError(updateValueOwner(s.user3, s.user2), "missing signatures from holders of the full supply of the value owner token held by "+markerAddr)
+		s.Assert().NoError(updateValueOwner(s.user3, s.user1), "full supply holder signing")
+	})
+
+	s.Run("fractional holders must all sign", func() {
+		s.Require().NoError(s.app.BankKeeper.SendCoins(s.ctx, s.user1Addr, s.user2Addr, sdk.NewCoins(sdk.NewInt64Coin(denom, 4))), "SendCoins")
+		res, err := s.queryClient.ScopeValueOwnerToken(s.ctx.Context(), &types.ScopeValueOwnerTokenRequest{Denom: denom})
+		s.Require().NoError(err, "ScopeValueOwnerToken")
+		s.Assert().Empty(res.ValueOwner, "value owner when supply is split")
+		s.Assert().Error(updateValueOwner(s.user3, s.user1), "partial holder signing")
+		s.Assert().NoError(updateValueOwner(s.user3, s.user1, s.user2), "all holders signing")
+	})
+
+	s.Run("transfer changes the value owner", func() {
+		s.Require().NoError(s.app.BankKeeper.SendCoins(s.ctx, s.user1Addr, s.user3Addr, sdk.NewCoins(sdk.NewInt64Coin(denom, 6))), "SendCoins user1 to user3")
+		s.Require().NoError(s.app.BankKeeper.SendCoins(s.ctx, s.user2Addr, s.user3Addr, sdk.NewCoins(sdk.NewInt64Coin(denom, 4))), "SendCoins user2 to user3")
+		res, err := s.queryClient.ScopeValueOwnerToken(s.ctx.Context(), &types.ScopeValueOwnerTokenRequest{ScopeId: s.scopeUUID.String()})
+		s.Require().NoError(err, "ScopeValueOwnerToken")
+		s.Assert().Equal(s.user3, res.ValueOwner, "value owner after transfer")
+		s.Assert().Error(updateValueOwner(s.user2, s.user1), "previous holder signing")
+		s.Assert().NoError(updateValueOwner(s.user2, s.user3), "new holder signing")
+	})
+
+	s.Run("value ownership follows the token", func() {
+		res, err := s.queryClient.ValueOwnership(s.ctx.Context(), &types.ValueOwnershipRequest{Address: s.user3})
+		s.Require().NoError(err, "ValueOwnership user3")
+		s.Assert().Equal([]string{s.scopeUUID.String()}, res.TokenScopeUuids, "user3 token scope uuids")
+		res, err = s.queryClient.ValueOwnership(s.ctx.Context(), &types.ValueOwnershipRequest{Address: s.user1})
+		s.Require().NoError(err, "ValueOwnership user1")
+		s.Assert().Empty(res.TokenScopeUuids, "user1 token scope uuids")
+		res, err = s.queryClient.ValueOwnership(s.ctx.Context(), &types.ValueOwnershipRequest{Address: markerAddr})
+		s.Require().NoError(err, "ValueOwnership marker")
+		s.Assert().Equal([]string{s.scopeUUID.String()}, res.ScopeUuids, "marker scope uuids")
+	})
+
+	s.Run("denom already in use", func() {
+		otherID := types.ScopeMetadataAddress(uuid.New())
+		s.app.MetadataKeeper.SetScope(s.ctx, *types.NewScope(otherID, nil, ownerPartyList(s.user1), []string{}, s.user1))
+		otherDenom := types.ValueOwnerTokenDenom(otherID)
+		s.Require().NoError(testutil.FundAccount(s.app.BankKeeper, s.ctx, s.user2Addr, sdk.NewCoins(sdk.NewInt64Coin(otherDenom, 1))), "FundAccount")
+		_, err := msgServer.TokenizeScopeValueOwner(sdk.WrapSDKContext(s.ctx),
+			types.NewMsgTokenizeScopeValueOwnerRequest(otherID, sdk.NewInt(10), []string{s.user1}))
+		s.Assert().EqualError(err, "value owner token denom "+otherDenom+" already has a supply")
+	})
+
+	s.Run("query errors", func() {
+		_, err := s.queryClient.ScopeValueOwnerToken(s.ctx.Context(), &types.ScopeValueOwnerTokenRequest{Denom: "nhash"})
+		s.Assert().ErrorContains(err, `denom "nhash" is not a value owner token denom`)
+		_, err = s.queryClient.ScopeValueOwnerToken(s.ctx.Context(), &types.ScopeValueOwnerTokenRequest{
+			ScopeId: types.ScopeMetadataAddress(s.scopeSpecUUID).String(),
+			Denom:   denom,
+		})
+		s.Assert().ErrorContains(err, "is not the value owner token of scope")
+	})
+}
@@ -41,8 +41,10 @@ func MigrateOSLocatorKeys(ctx sdk.Context, storeKey storetypes.StoreKey) error {
 	defer oldStoreIter.Close()
 
 	for ; oldStoreIter.Valid(); oldStoreIter.Next() {
+		// Legacy locators only have string fields, so their amino and proto encodings are the same.
+		// The proto codec is used so that unset timestamps stay unset.
 		var osLocator types.ObjectStoreLocator
-		err := types.ModuleCdc.UnmarshalInterface(oldStoreIter.Value(), &osLocator)
+		err := osLocator.Unmarshal(oldStoreIter.Value())
 		if err != nil {
 			return err
 		}
@@ -51,9 +53,9 @@ func MigrateOSLocatorKeys(ctx sdk.Context, storeKey storetypes.StoreKey) error {
 			return err
 		}
 
-		newStoreKey := types.GetOSLocatorKey(legacyAddress)
+		newStoreKey := types.GetOSLocatorOwnerIteratorPrefix(legacyAddress)
 
-		bz, err := types.ModuleCdc.Marshal(&osLocator)
+		bz, err := osLocator.Marshal()
 		if err != nil {
 			return err
 		}
@@ -127,14 +127,14 @@ func (s *MigrateTestSuite) TestMigrateOSLocatorKeys() {
 		s.Assert().Nil(result)
 
 		// Should find object store locator from updated key
-		key = types.GetOSLocatorKey(acc)
+		key = types.GetOSLocatorOwnerIteratorPrefix(acc)
 		s.Assert().Equal(types.OSLocatorAddressKeyPrefix, key[0:1])
 		s.Assert().Equal([]byte{byte(20)}, key[1:2], "length prefix should be size of address")
 		s.Assert().Equal(20, len(key[2:]))
 		result = store.Get(key)
 		s.Assert().NotNil(result)
 		var resultOSLocator types.ObjectStoreLocator
-		err = types.ModuleCdc.Unmarshal(result, &resultOSLocator)
+		err = resultOSLocator.Unmarshal(result)
 		s.Assert().NoError(err)
 		s.Assert().Equal(locator, resultOSLocator)
 	}
@@ -0,0 +1,136 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+var _ authz.Authorization = &MetadataScopeAuthorization{}
+
+// GetMessageTypeURLs return a hierarchical list of message type urls.
+// For example passing in `/provenance.metadata.v1.MsgAddScopeDataAccessRequest` would return a list containing
+// ("/provenance.metadata.v1.MsgAddScopeDataAccessRequest", "/provenance.metadata.v1.MsgWriteScopeRequest")
+func GetMessageTypeURLs(msgTypeURL string) []string {
+	urls := []string{}
+	if len(msgTypeURL) > 0 {
+		urls = append(urls, msgTypeURL)
+	}
+	switch msgTypeURL {
+	case TypeURLMsgAddScopeDataAccessRequest, TypeURLMsgDeleteScopeDataAccessRequest,
+		TypeURLMsgAddScopeOwnerRequest, TypeURLMsgDeleteScopeOwnerRequest,
+		TypeURLMsgTokenizeScopeValueOwnerRequest,
+		TypeURLMsgOfferScopeSaleRequest, TypeURLMsgCancelScopeSaleRequest,
+		TypeURLMsgMigrateScopeSpecificationRequest:
+		urls = append(urls, TypeURLMsgWriteScopeRequest)
+	case TypeURLMsgWriteRecordRequest:
+		urls = append(urls, TypeURLMsgWriteSessionRequest)
+	case TypeURLMsgAddContractSpecToScopeSpecRequest, TypeURLMsgDeleteContractSpecFromScopeSpecRequest:
+		urls = append(urls, TypeURLMsgWriteScopeSpecificationRequest)
+	case TypeURLMsgWriteRecordSpecificationRequest:
+		urls = append(urls, TypeURLMsgWriteContractSpecificationRequest)
+	case TypeURLMsgDeleteRecordSpecificationRequest:
+		urls = append(urls, TypeURLMsgDeleteContractSpecificationRequest)
+	}
+	return urls
+}
+
+// NewMetadataScopeAuthorization creates a new MetadataScopeAuthorization for the given message type url.
+func NewMetadataScopeAuthorization(msgTypeURL string) *MetadataScopeAuthorization {
+	return &MetadataScopeAuthorization{MsgTypeUrl: msgTypeURL}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a MetadataScopeAuthorization) MsgTypeURL() string {
+	return a.MsgTypeUrl
+}
+
+// Accept implements Authorization.Accept.
+// Since the scope of a message is not known here, authorizations that are limited to scopes are not accepted.
+// The metadata keeper uses AcceptScope instead.
+func (a MetadataScopeAuthorization) Accept(_ sdk.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	if msg == nil {
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("message cannot be nil")
+	}
+	return a.AcceptScope(sdk.MsgTypeURL(msg), nil, nil)
+}
+
+// AcceptScope checks whether this authorization applies to the given message type url and scope.
+// The scopeID and specID are the scope being acted on and its scope specification. They are empty if
+// the message does not act on a scope.
+func (a MetadataScopeAuthorization) AcceptScope(msgTypeURL string, scopeID, specID MetadataAddress) (authz.AcceptResponse, error) {
+	if len(a.AllowedMsgTypeUrls) > 0 && !containsString(a.AllowedMsgTypeUrls, msgTypeURL) {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("message type %s is not allowed", msgTypeURL)
+	}
+	if len(a.ScopeIds) > 0 {
+		found := false
+		for _, id := range a.ScopeIds {
+			if id.Equals(scopeID) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("scope %s is not allowed", scopeID)
+		}
+	}
+	if !a.SpecificationId.Empty() && !a.SpecificationId.Equals(specID) {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("scope specification %s is not allowed", specID)
+	}
+
+	switch a.MaxUses {
+	case 0:
+		return authz.AcceptResponse{Accept: true}, nil
+	case 1:
+		return authz.AcceptResponse{Accept: true, Delete: true}, nil
+	default:
+		updated := a
+		updated.MaxUses--
+		return authz.AcceptResponse{Accept: true, Updated: &updated}, nil
+	}
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a MetadataScopeAuthorization) ValidateBasic() error {
+	if !strings.HasPrefix(a.MsgTypeUrl, "/provenance.metadata.v1.Msg") {
+		return sdkerrors.ErrInvalidType.Wrapf("%q is not a metadata message type", a.MsgTypeUrl)
+	}
+	for i, url := range a.AllowedMsgTypeUrls {
+		if containsString(a.AllowedMsgTypeUrls[:i], url) {
+			return fmt.Errorf("duplicate allowed message type %s", url)
+		}
+		if !containsString(GetMessageTypeURLs(url), a.MsgTypeUrl) {
+			return sdkerrors.ErrInvalidType.Wrapf("allowed message type %s is not covered by %s", url, a.MsgTypeUrl)
+		}
+	}
+	if len(a.ScopeIds) > 0 && !a.SpecificationId.Empty() {
+		return fmt.Errorf("scope ids and specification id cannot both be set")
+	}
+	for i, id := range a.ScopeIds {
+		if !id.IsScopeAddress() {
+			return fmt.Errorf("invalid scope id %s: not a scope address", id)
+		}
+		for _, other := range a.ScopeIds[:i] {
+			if id.Equals(other) {
+				return fmt.Errorf("duplicate scope id %s", id)
+			}
+		}
+	}
+	if !a.SpecificationId.Empty() && !a.SpecificationId.IsScopeSpecificationAddress() {
+		return fmt.Errorf("invalid specification id %s: not a scope specification address", a.SpecificationId)
+	}
+	return nil
+}
+
+// containsString returns true if the string to find is in the provided slice of strings.
+func containsString(vals []string, toFind string) bool {
+	for _, val := range vals {
+		if val == toFind {
+			return true
+		}
+	}
+	return false
+}
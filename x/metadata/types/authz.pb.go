@@ -0,0 +1,536 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: provenance/metadata/v1/authz.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MetadataScopeAuthorization gives the grantee permission to sign metadata messages on behalf of the granter,
+// optionally limited to some scopes, some message types, and a number of uses.
+type MetadataScopeAuthorization struct {
+	// msg_type_url is the type url of the metadata message that this authorization is granted for.
+	// Like other metadata grants, it also applies to the messages below it in the metadata message hierarchy,
+	// e.g. a grant for MsgWriteScopeRequest also applies to MsgAddScopeOwnerRequest.
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty" yaml:"msg_type_url"`
+	// allowed_msg_type_urls optionally limits this authorization to some of the message types it applies to.
+	AllowedMsgTypeUrls []string `protobuf:"bytes,2,rep,name=allowed_msg_type_urls,json=allowedMsgTypeUrls,proto3" json:"allowed_msg_type_urls,omitempty" yaml:"allowed_msg_type_urls"`
+	// scope_ids optionally limits this authorization to these scopes.
+	ScopeIds []MetadataAddress `protobuf:"bytes,3,rep,name=scope_ids,json=scopeIds,proto3,customtype=MetadataAddress" json:"scope_ids" yaml:"scope_ids"`
+	// specification_id optionally limits this authorization to scopes that use this scope specification.
+	SpecificationId MetadataAddress `protobuf:"bytes,4,opt,name=specification_id,json=specificationId,proto3,customtype=MetadataAddress" json:"specification_id" yaml:"specification_id"`
+	// max_uses is the number of times this authorization can still be used. Zero means there is no limit.
+	// The authorization is removed after its last use.
+	MaxUses uint64 `protobuf:"varint,5,opt,name=max_uses,json=maxUses,proto3" json:"max_uses,omitempty" yaml:"max_uses"`
+}
+
+func (m *MetadataScopeAuthorization) Reset()         { *m = MetadataScopeAuthorization{} }
+func (m *MetadataScopeAuthorization) String() string { return proto.CompactTextString(m) }
+func (*MetadataScopeAuthorization) ProtoMessage()    {}
+func (*MetadataScopeAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f32299731a0af1ed, []int{0}
+}
+func (m *MetadataScopeAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MetadataScopeAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MetadataScopeAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MetadataScopeAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MetadataScopeAuthorization.Merge(m, src)
+}
+func (m *MetadataScopeAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *MetadataScopeAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_MetadataScopeAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MetadataScopeAuthorization proto.InternalMessageInfo
+
+func (m *MetadataScopeAuthorization) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *MetadataScopeAuthorization) GetAllowedMsgTypeUrls() []string {
+	if m != nil {
+		return m.AllowedMsgTypeUrls
+	}
+	return nil
+}
+
+func (m *MetadataScopeAuthorization) GetMaxUses() uint64 {
+	if m != nil {
+		return m.MaxUses
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*MetadataScopeAuthorization)(nil), "provenance.metadata.v1.MetadataScopeAuthorization")
+}
+
+func init() {
+	proto.RegisterFile("provenance/metadata/v1/authz.proto", fileDescriptor_f32299731a0af1ed)
+}
+
+var fileDescriptor_f32299731a0af1ed = []byte{
+	// 416 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x86, 0xb3, 0xa4, 0x40, 0xb3, 0x0a, 0x4a, 0x71, 0x81, 0xba, 0x11, 0xb2, 0xad, 0x3d, 0x59,
+	0x42, 0xb5, 0x55, 0xe0, 0x42, 0x6f, 0xf5, 0x89, 0x1e, 0x2a, 0x21, 0x97, 0x5e, 0x90, 0x90, 0xb5,
+	0xf5, 0x2e, 0xce, 0x0a, 0x6f, 0xd6, 0xf2, 0xac, 0x43, 0xd2, 0xa7, 0xe0, 0xc8, 0x83, 0xf0, 0x10,
+	0x15, 0xa7, 0x1e, 0x11, 0x07, 0x0b, 0x25, 0x6f, 0xe0, 0x27, 0x40, 0xb1, 0xdd, 0x26, 0x45, 0xe9,
+	0x6d, 0x67, 0xfe, 0x6f, 0x7e, 0xed, 0x8c, 0x7e, 0x4c, 0xb2, 0x5c, 0x4d, 0xf8, 0x98, 0x8e, 0x63,
+	0xee, 0x4b, 0xae, 0x29, 0xa3, 0x9a, 0xfa, 0x93, 0x43, 0x9f, 0x16, 0x7a, 0x74, 0xe9, 0x65, 0xb9,
+	0xd2, 0xca, 0x78, 0xb1, 0x62, 0xbc, 0x1b, 0xc6, 0x9b, 0x1c, 0x0e, 0x9f, 0x25, 0x2a, 0x51, 0x35,
+	0xe2, 0x2f, 0x5f, 0x0d, 0x3d, 0xdc, 0x8f, 0x15, 0x48, 0x05, 0x51, 0x23, 0x34, 0x45, 0x23, 0x91,
+	0x1f, 0x5d, 0x3c, 0x3c, 0x6d, 0x0d, 0xce, 0x62, 0x95, 0xf1, 0xe3, 0x42, 0x8f, 0x54, 0x2e, 0x2e,
+	0xa9, 0x16, 0x6a, 0x6c, 0xbc, 0xc3, 0x7d, 0x09, 0x49, 0xa4, 0x67, 0x19, 0x8f, 0x8a, 0x3c, 0x35,
+	0x91, 0x83, 0xdc, 0x5e, 0xb0, 0x57, 0x95, 0xf6, 0xee, 0x8c, 0xca, 0xf4, 0x88, 0xac, 0xab, 0x24,
+	0xc4, 0x12, 0x92, 0x8f, 0xb3, 0x8c, 0x9f, 0xe7, 0xa9, 0x71, 0x86, 0x9f, 0xd3, 0x34, 0x55, 0xdf,
+	0x38, 0x8b, 0xd6, 0x21, 0x30, 0x1f, 0x38, 0x5d, 0xb7, 0x17, 0x38, 0x55, 0x69, 0xbf, 0x6c, 0x3c,
+	0x36, 0x62, 0x24, 0x34, 0xda, 0xfe, 0xe9, 0xad, 0x27, 0x18, 0xef, 0x71, 0x0f, 0x96, 0xbf, 0x8c,
+	0x04, 0x03, 0xb3, 0xeb, 0x74, 0xdd, 0x7e, 0xf0, 0xea, 0xaa, 0xb4, 0x3b, 0x7f, 0x4a, 0x7b, 0x70,
+	0xb3, 0xc6, 0x31, 0x63, 0x39, 0x07, 0xa8, 0x4a, 0x7b, 0xa7, 0xf1, 0xbf, 0x9d, 0x20, 0xe1, 0x76,
+	0xfd, 0x3e, 0x61, 0x60, 0x7c, 0xc6, 0x3b, 0x90, 0xf1, 0x58, 0x7c, 0x11, 0x71, 0xbd, 0x6a, 0x24,
+	0x98, 0xb9, 0xe5, 0x20, 0xb7, 0x1f, 0xbc, 0xbe, 0xdf, 0x70, 0xaf, 0x35, 0xfc, 0x6f, 0x90, 0x84,
+	0x83, 0x3b, 0xad, 0x13, 0x66, 0x78, 0x78, 0x5b, 0xd2, 0x69, 0x54, 0x00, 0x07, 0xf3, 0xa1, 0x83,
+	0xdc, 0xad, 0x60, 0xb7, 0x2a, 0xed, 0x41, 0x7b, 0xb4, 0x56, 0x21, 0xe1, 0x63, 0x49, 0xa7, 0xe7,
+	0xc0, 0xe1, 0xe8, 0xe9, 0xaf, 0x9f, 0x07, 0x4f, 0xee, 0xdc, 0x3e, 0xf8, 0x7a, 0x35, 0xb7, 0xd0,
+	0xf5, 0xdc, 0x42, 0x7f, 0xe7, 0x16, 0xfa, 0xbe, 0xb0, 0x3a, 0xd7, 0x0b, 0xab, 0xf3, 0x7b, 0x61,
+	0x75, 0xf0, 0xbe, 0x50, 0xde, 0xe6, 0x00, 0x7c, 0x40, 0x9f, 0xde, 0x26, 0x42, 0x8f, 0x8a, 0x0b,
+	0x2f, 0x56, 0xd2, 0x5f, 0x41, 0x07, 0x42, 0xad, 0x55, 0xfe, 0x74, 0x95, 0xac, 0xe5, 0xc5, 0xe1,
+	0xe2, 0x51, 0x1d, 0x87, 0x37, 0xff, 0x06, 0x00, 0xc0, 0x3b, 0x65, 0x6d, 0x7d, 0x02, 0x00, 0x00,
+}
+
+func (m *MetadataScopeAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MetadataScopeAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MetadataScopeAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxUses != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.MaxUses))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.SpecificationId.Size()
+		i -= size
+		if _, err := m.SpecificationId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuthz(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.ScopeIds) > 0 {
+		for iNdEx := len(m.ScopeIds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.ScopeIds[iNdEx].Size()
+				i -= size
+				if _, err := m.ScopeIds[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.AllowedMsgTypeUrls) > 0 {
+		for iNdEx := len(m.AllowedMsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedMsgTypeUrls[iNdEx])
+			copy(dAtA[i:], m.AllowedMsgTypeUrls[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.AllowedMsgTypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuthz(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuthz(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MetadataScopeAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if len(m.AllowedMsgTypeUrls) > 0 {
+		for _, s := range m.AllowedMsgTypeUrls {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if len(m.ScopeIds) > 0 {
+		for _, e := range m.ScopeIds {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	l = m.SpecificationId.Size()
+	n += 1 + l + sovAuthz(uint64(l))
+	if m.MaxUses != 0 {
+		n += 1 + sovAuthz(uint64(m.MaxUses))
+	}
+	return n
+}
+
+func sovAuthz(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAuthz(x uint64) (n int) {
+	return sovAuthz(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MetadataScopeAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MetadataScopeAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MetadataScopeAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedMsgTypeUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedMsgTypeUrls = append(m.AllowedMsgTypeUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeIds", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v MetadataAddress
+			m.ScopeIds = append(m.ScopeIds, v)
+			if err := m.ScopeIds[len(m.ScopeIds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecificationId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SpecificationId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUses", wireType)
+			}
+			m.MaxUses = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxUses |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAuthz(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAuthz
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAuthz
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAuthz
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAuthz        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAuthz          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAuthz = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,150 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetadataScopeAuthorizationValidateBasic(t *testing.T) {
+	scopeID := ScopeMetadataAddress(uuid.New())
+	specID := ScopeSpecMetadataAddress(uuid.New())
+
+	tests := []struct {
+		name   string
+		auth   MetadataScopeAuthorization
+		expErr string
+	}{
+		{
+			name: "msg type only",
+			auth: MetadataScopeAuthorization{MsgTypeUrl: TypeURLMsgWriteScopeRequest},
+		},
+		{
+			name: "everything but a specification",
+			auth: MetadataScopeAuthorization{
+				MsgTypeUrl:         TypeURLMsgWriteScopeRequest,
+				AllowedMsgTypeUrls: []string{TypeURLMsgWriteScopeRequest, TypeURLMsgAddScopeOwnerRequest},
+				ScopeIds:           []MetadataAddress{scopeID},
+				MaxUses:            3,
+			},
+		},
+		{
+			name: "specification",
+			auth: MetadataScopeAuthorization{MsgTypeUrl: TypeURLMsgWriteSessionRequest, SpecificationId: specID},
+		},
+		{
+			name:   "not a metadata message",
+			auth:   MetadataScopeAuthorization{MsgTypeUrl: "/cosmos.bank.v1beta1.MsgSend"},
+			expErr: `"/cosmos.bank.v1beta1.MsgSend" is not a metadata message type: invalid type`,
+		},
+		{
+			name: "allowed type not covered",
+			auth: MetadataScopeAuthorization{
+				MsgTypeUrl:         TypeURLMsgWriteScopeRequest,
+				AllowedMsgTypeUrls: []string{TypeURLMsgWriteRecordRequest},
+			},
+			expErr: "allowed message type " + TypeURLMsgWriteRecordRequest + " is not covered by " + TypeURLMsgWriteScopeRequest + ": invalid type",
+		},
+		{
+			name: "duplicate allowed type",
+			auth: MetadataScopeAuthorization{
+				MsgTypeUrl:         TypeURLMsgWriteScopeRequest,
+				AllowedMsgTypeUrls: []string{TypeURLMsgAddScopeOwnerRequest, TypeURLMsgAddScopeOwnerRequest},
+			},
+			expErr: "duplicate allowed message type " + TypeURLMsgAddScopeOwnerRequest,
+		},
+		{
+			name: "scope ids and specification",
+			auth: MetadataScopeAuthorization{
+				MsgTypeUrl:      TypeURLMsgWriteScopeRequest,
+				ScopeIds:        []MetadataAddress{scopeID},
+				SpecificationId: specID,
+			},
+			expErr: "scope ids and specification id cannot both be set",
+		},
+		{
+			name:   "scope id is not a scope",
+			auth:   MetadataScopeAuthorization{MsgTypeUrl: TypeURLMsgWriteScopeRequest, ScopeIds: []MetadataAddress{specID}},
+			expErr: "invalid scope id " + specID.String() + ": not a scope address",
+		},
+		{
+			name:   "duplicate scope id",
+			auth:   MetadataScopeAuthorization{MsgTypeUrl: TypeURLMsgWriteScopeRequest, ScopeIds: []MetadataAddress{scopeID, scopeID}},
+			expErr: "duplicate scope id " + scopeID.String(),
+		},
+		{
+			name:   "specification is not a scope specification",
+			auth:   MetadataScopeAuthorization{MsgTypeUrl: TypeURLMsgWriteScopeRequest, SpecificationId: scopeID},
+			expErr: "invalid specification id " + scopeID.String() + ": not a scope specification address",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.auth.ValidateBasic()
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "ValidateBasic")
+			} else {
+				assert.NoError(t, err, "ValidateBasic")
+			}
+		})
+	}
+}
+
+func TestMetadataScopeAuthorizationAcceptScope(t *testing.T) {
+	scopeID := ScopeMetadataAddress(uuid.New())
+	otherScopeID := ScopeMetadataAddress(uuid.New())
+	specID := ScopeSpecMetadataAddress(uuid.New())
+
+	t.Run("max uses counts down", func(t *testing.T) {
+		auth := MetadataScopeAuthorization{MsgTypeUrl: TypeURLMsgWriteScopeRequest, MaxUses: 2}
+		resp, err := auth.AcceptScope(TypeURLMsgWriteScopeRequest, scopeID, specID)
+		require.NoError(t, err, "first AcceptScope")
+		assert.True(t, resp.Accept, "first Accept")
+		assert.False(t, resp.Delete, "first Delete")
+		require.NotNil(t, resp.Updated, "first Updated")
+		updated := resp.Updated.(*MetadataScopeAuthorization)
+		assert.Equal(t, uint64(1), updated.MaxUses, "updated MaxUses")
+		assert.Equal(t, uint64(2), auth.MaxUses, "original MaxUses")
+
+		resp, err = updated.AcceptScope(TypeURLMsgWriteScopeRequest, scopeID, specID)
+		require.NoError(t, err, "second AcceptScope")
+		assert.True(t, resp.Accept, "second Accept")
+		assert.True(t, resp.Delete, "second Delete")
+	})
+
+	t.Run("unlimited", func(t *testing.T) {
+		auth := MetadataScopeAuthorization{MsgTypeUrl: TypeURLMsgWriteScopeRequest}
+		resp, err := auth.AcceptScope(TypeURLMsgWriteScopeRequest, scopeID, specID)
+		require.NoError(t, err, "AcceptScope")
+		assert.True(t, resp.Accept, "Accept")
+		assert.False(t, resp.Delete, "Delete")
+		assert.Nil(t, resp.Updated, "Updated")
+	})
+
+	t.Run("wrong scope", func(t *testing.T) {
+		auth := MetadataScopeAuthorization{MsgTypeUrl: TypeURLMsgWriteScopeRequest, ScopeIds: []MetadataAddress{scopeID}}
+		_, err := auth.AcceptScope(TypeURLMsgWriteScopeRequest, otherScopeID, specID)
+		assert.EqualError(t, err, "scope "+otherScopeID.String()+" is not allowed: unauthorized", "AcceptScope")
+	})
+
+	t.Run("no scope for a scope restricted grant", func(t *testing.T) {
+		auth := MetadataScopeAuthorization{MsgTypeUrl: TypeURLMsgWriteScopeRequest, SpecificationId: specID}
+		_, err := auth.AcceptScope(TypeURLMsgWriteScopeRequest, nil, nil)
+		assert.Error(t, err, "AcceptScope")
+	})
+
+	t.Run("accept with message", func(t *testing.T) {
+		auth := MetadataScopeAuthorization{MsgTypeUrl: TypeURLMsgWriteScopeRequest, AllowedMsgTypeUrls: []string{TypeURLMsgAddScopeOwnerRequest}}
+		resp, err := auth.Accept(sdk.Context{}, &MsgAddScopeOwnerRequest{})
+		require.NoError(t, err, "Accept allowed")
+		assert.True(t, resp.Accept, "Accept allowed")
+		_, err = auth.Accept(sdk.Context{}, &MsgDeleteScopeOwnerRequest{})
+		assert.EqualError(t, err, "message type "+TypeURLMsgDeleteScopeOwnerRequest+" is not allowed: unauthorized", "Accept not allowed")
+		_, err = auth.Accept(sdk.Context{}, nil)
+		assert.EqualError(t, err, "message cannot be nil: invalid type", "Accept nil")
+	})
+}
@@ -6,16 +6,23 @@ import (
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 )
 
 // RegisterLegacyAminoCodec registers concrete types on the Amino codec
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgWriteScopeRequest{}, "provenance/metadata/WriteScopeRequest", nil)
+	cdc.RegisterConcrete(&MsgWriteScopeBundleRequest{}, "provenance/metadata/WriteScopeBundleRequest", nil)
 	cdc.RegisterConcrete(&MsgDeleteScopeRequest{}, "provenance/metadata/DeleteScopeRequest", nil)
 	cdc.RegisterConcrete(&MsgAddScopeDataAccessRequest{}, "provenance/metadata/AddScopeDataAccessRequest", nil)
 	cdc.RegisterConcrete(&MsgDeleteScopeDataAccessRequest{}, "provenance/metadata/DeleteScopeDataAccessRequest", nil)
 	cdc.RegisterConcrete(&MsgAddScopeOwnerRequest{}, "provenance/metadata/AddScopeOwnerRequest", nil)
 	cdc.RegisterConcrete(&MsgDeleteScopeOwnerRequest{}, "provenance/metadata/DeleteScopeOwnerRequest", nil)
+	cdc.RegisterConcrete(&MsgTokenizeScopeValueOwnerRequest{}, "provenance/metadata/TokenizeScopeValueOwnerRequest", nil)
+	cdc.RegisterConcrete(&MsgOfferScopeSaleRequest{}, "provenance/metadata/OfferScopeSaleRequest", nil)
+	cdc.RegisterConcrete(&MsgCancelScopeSaleRequest{}, "provenance/metadata/CancelScopeSaleRequest", nil)
+	cdc.RegisterConcrete(&MsgAcceptScopeSaleRequest{}, "provenance/metadata/AcceptScopeSaleRequest", nil)
+	cdc.RegisterConcrete(&MsgMigrateScopeSpecificationRequest{}, "provenance/metadata/MigrateScopeSpecificationRequest", nil)
 
 	cdc.RegisterConcrete(&MsgWriteSessionRequest{}, "provenance/metadata/WriteSessionRequest", nil)
 	cdc.RegisterConcrete(&MsgWriteRecordRequest{}, "provenance/metadata/WriteRecordRequest", nil)
@@ -42,11 +49,17 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgWriteScopeRequest{},
+		&MsgWriteScopeBundleRequest{},
 		&MsgDeleteScopeRequest{},
 		&MsgAddScopeDataAccessRequest{},
 		&MsgDeleteScopeDataAccessRequest{},
 		&MsgAddScopeOwnerRequest{},
 		&MsgDeleteScopeOwnerRequest{},
+		&MsgTokenizeScopeValueOwnerRequest{},
+		&MsgOfferScopeSaleRequest{},
+		&MsgCancelScopeSaleRequest{},
+		&MsgAcceptScopeSaleRequest{},
+		&MsgMigrateScopeSpecificationRequest{},
 		&MsgWriteSessionRequest{},
 		&MsgWriteRecordRequest{},
 		&MsgDeleteRecordRequest{},
@@ -67,6 +80,12 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgModifyOSLocatorRequest{},
 		&MsgDeleteOSLocatorRequest{},
 	)
+
+	registry.RegisterImplementations(
+		(*authz.Authorization)(nil),
+		&MetadataScopeAuthorization{},
+	)
+
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
 
@@ -14,4 +14,8 @@ var (
 	ErrOSLocatorURIToolong = cerrs.Register(ModuleName, 5, "uri length greater than allowed")
 	ErrNoRecordsFound      = cerrs.Register(ModuleName, 6, "No records found.")
 	ErrOSLocatorURIInvalid = cerrs.Register(ModuleName, 7, "uri is invalid")
+	// ErrP8eMessagesDisabled occurs when a p8e message is used while the DisableP8eMessages param is set.
+	ErrP8eMessagesDisabled = cerrs.Register(ModuleName, 8, "p8e messages are disabled")
+	// ErrOSLocatorLimitReached occurs when an owner tries to bind more locators than the MaxLocatorsPerOwner param allows.
+	ErrOSLocatorLimitReached = cerrs.Register(ModuleName, 9, "owner has reached the maximum number of locators")
 )
@@ -4,6 +4,7 @@ import (
 	"github.com/armon/go-metrics"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
 )
 
 // TelemetryCategory is an enum for metadata telemetry categories.
@@ -100,6 +101,16 @@ const (
 	TxEndpoint_AddScopeOwner         TxEndpoint = "AddScopeOwner"
 	TxEndpoint_DeleteScopeOwner      TxEndpoint = "DeleteScopeOwner"
 
+	TxEndpoint_TokenizeScopeValueOwner TxEndpoint = "TokenizeScopeValueOwner"
+
+	TxEndpoint_OfferScopeSale  TxEndpoint = "OfferScopeSale"
+	TxEndpoint_CancelScopeSale TxEndpoint = "CancelScopeSale"
+	TxEndpoint_AcceptScopeSale TxEndpoint = "AcceptScopeSale"
+
+	TxEndpoint_MigrateScopeSpecification TxEndpoint = "MigrateScopeSpecification"
+
+	TxEndpoint_WriteScopeBundle TxEndpoint = "WriteScopeBundle"
+
 	TxEndpoint_WriteSession TxEndpoint = "WriteSession"
 
 	TxEndpoint_WriteRecord  TxEndpoint = "WriteRecord"
@@ -155,6 +166,85 @@ func NewEventScopeDeleted(scopeID MetadataAddress) *EventScopeDeleted {
 	}
 }
 
+func NewEventScopeValueOwnerTokenized(scopeID MetadataAddress, supply sdk.Coin, holder string) *EventScopeValueOwnerTokenized {
+	return &EventScopeValueOwnerTokenized{
+		ScopeAddr: scopeID.String(),
+		Denom:     supply.Denom,
+		Supply:    supply.Amount.String(),
+		Holder:    holder,
+	}
+}
+
+func NewEventScopeSaleOffered(offer ScopeSaleOffer) *EventScopeSaleOffered {
+	return &EventScopeSaleOffered{
+		ScopeAddr: offer.ScopeId.String(),
+		Seller:    offer.Seller,
+		Buyer:     offer.Buyer,
+		Price:     offer.Price.String(),
+	}
+}
+
+func NewEventScopeSaleCancelled(scopeID MetadataAddress) *EventScopeSaleCancelled {
+	return &EventScopeSaleCancelled{
+		ScopeAddr: scopeID.String(),
+	}
+}
+
+func NewEventScopeSold(scopeID MetadataAddress, seller, buyer string, price sdk.Coins) *EventScopeSold {
+	return &EventScopeSold{
+		ScopeAddr: scopeID.String(),
+		Seller:    seller,
+		Buyer:     buyer,
+		Price:     price.String(),
+	}
+}
+
+func NewEventScopeDataAccessExpired(scopeID MetadataAddress, dataAccess []string) *EventScopeDataAccessExpired {
+	return &EventScopeDataAccessExpired{
+		ScopeAddr:  scopeID.String(),
+		DataAccess: dataAccess,
+	}
+}
+
+// NewEventScopeChanged creates an EventScopeChanged with the differences between the old and new scope.
+// The oldScope is nil when the scope is being created.
+func NewEventScopeChanged(oldScope *Scope, newScope Scope) *EventScopeChanged {
+	rv := &EventScopeChanged{
+		ScopeAddr:         newScope.ScopeId.String(),
+		ValueOwner:        newScope.ValueOwnerAddress,
+		SpecificationAddr: newScope.SpecificationId.String(),
+	}
+	var oldOwners []Party
+	var oldDataAccess []string
+	var oldGrants []DataAccessGrant
+	if oldScope != nil {
+		oldOwners = oldScope.Owners
+		oldDataAccess = oldScope.DataAccess
+		oldGrants = oldScope.DataAccessGrants
+		rv.PreviousValueOwner = oldScope.ValueOwnerAddress
+	}
+	rv.AddedOwners, rv.RemovedOwners = diffParties(oldOwners, newScope.Owners)
+	rv.AddedDataAccess, rv.RemovedDataAccess = diffStrings(oldDataAccess, newScope.DataAccess)
+	rv.AddedDataAccessGrants, rv.RemovedDataAccessGrants = diffDataAccessGrants(oldGrants, newScope.DataAccessGrants)
+	return rv
+}
+
+func NewEventScopeBundleWritten(scopeID MetadataAddress, sessionCount, recordCount int) *EventScopeBundleWritten {
+	return &EventScopeBundleWritten{
+		ScopeAddr:    scopeID.String(),
+		SessionCount: uint32(sessionCount),
+		RecordCount:  uint32(recordCount),
+	}
+}
+
+func NewEventScopeSpecificationMigrated(scopeID, oldSpecID, newSpecID MetadataAddress) *EventScopeSpecificationMigrated {
+	return &EventScopeSpecificationMigrated{
+		ScopeAddr:            scopeID.String(),
+		OldSpecificationAddr: oldSpecID.String(),
+		NewSpecificationAddr: newSpecID.String(),
+	}
+}
+
 func NewEventSessionCreated(sessionID MetadataAddress) *EventSessionCreated {
 	return &EventSessionCreated{
 		SessionAddr: sessionID.String(),
@@ -176,6 +266,24 @@ func NewEventSessionDeleted(sessionID MetadataAddress) *EventSessionDeleted {
 	}
 }
 
+// NewEventSessionChanged creates an EventSessionChanged with the differences between the old and new session.
+// The oldSession is nil when the session is being created.
+func NewEventSessionChanged(oldSession *Session, newSession Session) *EventSessionChanged {
+	rv := &EventSessionChanged{
+		SessionAddr:       newSession.SessionId.String(),
+		ScopeAddr:         newSession.SessionId.MustGetAsScopeAddress().String(),
+		Name:              newSession.Name,
+		SpecificationAddr: newSession.SpecificationId.String(),
+	}
+	var oldParties []Party
+	if oldSession != nil {
+		oldParties = oldSession.Parties
+		rv.PreviousName = oldSession.Name
+	}
+	rv.AddedParties, rv.RemovedParties = diffParties(oldParties, newSession.Parties)
+	return rv
+}
+
 func NewEventRecordCreated(recordID, sessionID MetadataAddress) *EventRecordCreated {
 	return &EventRecordCreated{
 		RecordAddr:  recordID.String(),
@@ -199,6 +307,24 @@ func NewEventRecordDeleted(recordID MetadataAddress) *EventRecordDeleted {
 	}
 }
 
+// NewEventRecordChanged creates an EventRecordChanged with the differences between the old and new record.
+// The oldRecord is nil when the record is being created.
+func NewEventRecordChanged(oldRecord *Record, newRecord Record) *EventRecordChanged {
+	recordID := newRecord.SessionId.MustGetAsRecordAddress(newRecord.Name)
+	rv := &EventRecordChanged{
+		RecordAddr:        recordID.String(),
+		SessionAddr:       newRecord.SessionId.String(),
+		ScopeAddr:         recordID.MustGetAsScopeAddress().String(),
+		OutputHashes:      outputHashes(newRecord.Outputs),
+		SpecificationAddr: newRecord.SpecificationId.String(),
+	}
+	if oldRecord != nil {
+		rv.PreviousSessionAddr = oldRecord.SessionId.String()
+		rv.PreviousOutputHashes = outputHashes(oldRecord.Outputs)
+	}
+	return rv
+}
+
 func NewEventScopeSpecificationCreated(scopeSpecificationID MetadataAddress) *EventScopeSpecificationCreated {
 	return &EventScopeSpecificationCreated{
 		ScopeSpecificationAddr: scopeSpecificationID.String(),
@@ -256,20 +382,112 @@ func NewEventRecordSpecificationDeleted(recordSpecificationID MetadataAddress) *
 	}
 }
 
-func NewEventOSLocatorCreated(owner string) *EventOSLocatorCreated {
+func NewEventOSLocatorCreated(owner, name string) *EventOSLocatorCreated {
 	return &EventOSLocatorCreated{
 		Owner: owner,
+		Name:  name,
 	}
 }
 
-func NewEventOSLocatorUpdated(owner string) *EventOSLocatorUpdated {
+func NewEventOSLocatorUpdated(owner, name string) *EventOSLocatorUpdated {
 	return &EventOSLocatorUpdated{
 		Owner: owner,
+		Name:  name,
 	}
 }
 
-func NewEventOSLocatorDeleted(owner string) *EventOSLocatorDeleted {
+func NewEventOSLocatorDeleted(owner, name string) *EventOSLocatorDeleted {
 	return &EventOSLocatorDeleted{
 		Owner: owner,
+		Name:  name,
+	}
+}
+
+// DetailedEventTypes gets the types of the events that are only emitted when the EmitDetailedEvents param is true.
+func DetailedEventTypes() []string {
+	return []string{
+		proto.MessageName(&EventScopeChanged{}),
+		proto.MessageName(&EventSessionChanged{}),
+		proto.MessageName(&EventRecordChanged{}),
+	}
+}
+
+// diffParties gets the parties in newParties that aren't in oldParties, and the parties in oldParties that aren't in newParties.
+func diffParties(oldParties, newParties []Party) (added, removed []Party) {
+	has := func(parties []Party, party Party) bool {
+		for _, p := range parties {
+			if p.Equals(party) {
+				return true
+			}
+		}
+		return false
+	}
+	for _, p := range newParties {
+		if !has(oldParties, p) {
+			added = append(added, p)
+		}
+	}
+	for _, p := range oldParties {
+		if !has(newParties, p) {
+			removed = append(removed, p)
+		}
+	}
+	return added, removed
+}
+
+// diffDataAccessGrants gets the grants in newGrants that aren't in oldGrants, and the grants in oldGrants that aren't in newGrants.
+// A grant that changed role or expiration is in both results.
+func diffDataAccessGrants(oldGrants, newGrants []DataAccessGrant) (added, removed []DataAccessGrant) {
+	has := func(grants []DataAccessGrant, grant DataAccessGrant) bool {
+		for _, g := range grants {
+			if g.Equals(grant) {
+				return true
+			}
+		}
+		return false
+	}
+	for _, g := range newGrants {
+		if !has(oldGrants, g) {
+			added = append(added, g)
+		}
+	}
+	for _, g := range oldGrants {
+		if !has(newGrants, g) {
+			removed = append(removed, g)
+		}
+	}
+	return added, removed
+}
+
+// diffStrings gets the entries in newVals that aren't in oldVals, and the entries in oldVals that aren't in newVals.
+func diffStrings(oldVals, newVals []string) (added, removed []string) {
+	oldSet := make(map[string]bool, len(oldVals))
+	for _, v := range oldVals {
+		oldSet[v] = true
+	}
+	newSet := make(map[string]bool, len(newVals))
+	for _, v := range newVals {
+		newSet[v] = true
+		if !oldSet[v] {
+			added = append(added, v)
+		}
+	}
+	for _, v := range oldVals {
+		if !newSet[v] {
+			removed = append(removed, v)
+		}
+	}
+	return added, removed
+}
+
+// outputHashes gets the hash of each of the outputs.
+func outputHashes(outputs []RecordOutput) []string {
+	if len(outputs) == 0 {
+		return nil
+	}
+	rv := make([]string, len(outputs))
+	for i, output := range outputs {
+		rv[i] = output.Hash
 	}
+	return rv
 }
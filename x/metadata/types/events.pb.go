@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
 	math "math"
@@ -224,26 +225,30 @@ func (m *EventScopeDeleted) GetScopeAddr() string {
 	return ""
 }
 
-// EventSessionCreated is an event message indicating a session has been created.
-type EventSessionCreated struct {
-	// session_addr is the bech32 address string of the session id that was created.
-	SessionAddr string `protobuf:"bytes,1,opt,name=session_addr,json=sessionAddr,proto3" json:"session_addr,omitempty"`
-	// scope_addr is the bech32 address string of the scope id this session belongs to.
-	ScopeAddr string `protobuf:"bytes,2,opt,name=scope_addr,json=scopeAddr,proto3" json:"scope_addr,omitempty"`
-}
-
-func (m *EventSessionCreated) Reset()         { *m = EventSessionCreated{} }
-func (m *EventSessionCreated) String() string { return proto.CompactTextString(m) }
-func (*EventSessionCreated) ProtoMessage()    {}
-func (*EventSessionCreated) Descriptor() ([]byte, []int) {
+// EventScopeValueOwnerTokenized is an event message indicating the value owner of a scope has been tokenized.
+type EventScopeValueOwnerTokenized struct {
+	// scope_addr is the bech32 address string of the scope id that was tokenized.
+	ScopeAddr string `protobuf:"bytes,1,opt,name=scope_addr,json=scopeAddr,proto3" json:"scope_addr,omitempty"`
+	// denom is the denom of the value owner token.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// supply is the total supply of the value owner token.
+	Supply string `protobuf:"bytes,3,opt,name=supply,proto3" json:"supply,omitempty"`
+	// holder is the bech32 address string of the previous value owner that received the full supply.
+	Holder string `protobuf:"bytes,4,opt,name=holder,proto3" json:"holder,omitempty"`
+}
+
+func (m *EventScopeValueOwnerTokenized) Reset()         { *m = EventScopeValueOwnerTokenized{} }
+func (m *EventScopeValueOwnerTokenized) String() string { return proto.CompactTextString(m) }
+func (*EventScopeValueOwnerTokenized) ProtoMessage()    {}
+func (*EventScopeValueOwnerTokenized) Descriptor() ([]byte, []int) {
 	return fileDescriptor_476cf6cf9459cf25, []int{4}
 }
-func (m *EventSessionCreated) XXX_Unmarshal(b []byte) error {
+func (m *EventScopeValueOwnerTokenized) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventSessionCreated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventScopeValueOwnerTokenized) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventSessionCreated.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventScopeValueOwnerTokenized.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -253,52 +258,70 @@ func (m *EventSessionCreated) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *EventSessionCreated) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventSessionCreated.Merge(m, src)
+func (m *EventScopeValueOwnerTokenized) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventScopeValueOwnerTokenized.Merge(m, src)
 }
-func (m *EventSessionCreated) XXX_Size() int {
+func (m *EventScopeValueOwnerTokenized) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventSessionCreated) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventSessionCreated.DiscardUnknown(m)
+func (m *EventScopeValueOwnerTokenized) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventScopeValueOwnerTokenized.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventSessionCreated proto.InternalMessageInfo
+var xxx_messageInfo_EventScopeValueOwnerTokenized proto.InternalMessageInfo
 
-func (m *EventSessionCreated) GetSessionAddr() string {
+func (m *EventScopeValueOwnerTokenized) GetScopeAddr() string {
 	if m != nil {
-		return m.SessionAddr
+		return m.ScopeAddr
 	}
 	return ""
 }
 
-func (m *EventSessionCreated) GetScopeAddr() string {
+func (m *EventScopeValueOwnerTokenized) GetDenom() string {
 	if m != nil {
-		return m.ScopeAddr
+		return m.Denom
 	}
 	return ""
 }
 
-// EventSessionUpdated is an event message indicating a session has been updated.
-type EventSessionUpdated struct {
-	// session_addr is the bech32 address string of the session id that was updated.
-	SessionAddr string `protobuf:"bytes,1,opt,name=session_addr,json=sessionAddr,proto3" json:"session_addr,omitempty"`
-	// scope_addr is the bech32 address string of the scope id this session belongs to.
-	ScopeAddr string `protobuf:"bytes,2,opt,name=scope_addr,json=scopeAddr,proto3" json:"scope_addr,omitempty"`
+func (m *EventScopeValueOwnerTokenized) GetSupply() string {
+	if m != nil {
+		return m.Supply
+	}
+	return ""
 }
 
-func (m *EventSessionUpdated) Reset()         { *m = EventSessionUpdated{} }
-func (m *EventSessionUpdated) String() string { return proto.CompactTextString(m) }
-func (*EventSessionUpdated) ProtoMessage()    {}
-func (*EventSessionUpdated) Descriptor() ([]byte, []int) {
+func (m *EventScopeValueOwnerTokenized) GetHolder() string {
+	if m != nil {
+		return m.Holder
+	}
+	return ""
+}
+
+// EventScopeSaleOffered is an event message indicating the value ownership of a scope has been offered for sale.
+type EventScopeSaleOffered struct {
+	// scope_addr is the bech32 address string of the scope id that was offered.
+	ScopeAddr string `protobuf:"bytes,1,opt,name=scope_addr,json=scopeAddr,proto3" json:"scope_addr,omitempty"`
+	// seller is the bech32 address string of the value owner that made the offer.
+	Seller string `protobuf:"bytes,2,opt,name=seller,proto3" json:"seller,omitempty"`
+	// buyer is the bech32 address string of the only address that can accept the offer, if any.
+	Buyer string `protobuf:"bytes,3,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	// price is the payment the buyer must send to the seller.
+	Price string `protobuf:"bytes,4,opt,name=price,proto3" json:"price,omitempty"`
+}
+
+func (m *EventScopeSaleOffered) Reset()         { *m = EventScopeSaleOffered{} }
+func (m *EventScopeSaleOffered) String() string { return proto.CompactTextString(m) }
+func (*EventScopeSaleOffered) ProtoMessage()    {}
+func (*EventScopeSaleOffered) Descriptor() ([]byte, []int) {
 	return fileDescriptor_476cf6cf9459cf25, []int{5}
 }
-func (m *EventSessionUpdated) XXX_Unmarshal(b []byte) error {
+func (m *EventScopeSaleOffered) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventSessionUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventScopeSaleOffered) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventSessionUpdated.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventScopeSaleOffered.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -308,52 +331,64 @@ func (m *EventSessionUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *EventSessionUpdated) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventSessionUpdated.Merge(m, src)
+func (m *EventScopeSaleOffered) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventScopeSaleOffered.Merge(m, src)
 }
-func (m *EventSessionUpdated) XXX_Size() int {
+func (m *EventScopeSaleOffered) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventSessionUpdated) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventSessionUpdated.DiscardUnknown(m)
+func (m *EventScopeSaleOffered) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventScopeSaleOffered.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventSessionUpdated proto.InternalMessageInfo
+var xxx_messageInfo_EventScopeSaleOffered proto.InternalMessageInfo
 
-func (m *EventSessionUpdated) GetSessionAddr() string {
+func (m *EventScopeSaleOffered) GetScopeAddr() string {
 	if m != nil {
-		return m.SessionAddr
+		return m.ScopeAddr
 	}
 	return ""
 }
 
-func (m *EventSessionUpdated) GetScopeAddr() string {
+func (m *EventScopeSaleOffered) GetSeller() string {
 	if m != nil {
-		return m.ScopeAddr
+		return m.Seller
 	}
 	return ""
 }
 
-// EventSessionDeleted is an event message indicating a session has been deleted.
-type EventSessionDeleted struct {
-	// session_addr is the bech32 address string of the session id that was deleted.
-	SessionAddr string `protobuf:"bytes,1,opt,name=session_addr,json=sessionAddr,proto3" json:"session_addr,omitempty"`
-	// scope_addr is the bech32 address string of the scope id this session belongs to.
-	ScopeAddr string `protobuf:"bytes,2,opt,name=scope_addr,json=scopeAddr,proto3" json:"scope_addr,omitempty"`
+func (m *EventScopeSaleOffered) GetBuyer() string {
+	if m != nil {
+		return m.Buyer
+	}
+	return ""
 }
 
-func (m *EventSessionDeleted) Reset()         { *m = EventSessionDeleted{} }
-func (m *EventSessionDeleted) String() string { return proto.CompactTextString(m) }
-func (*EventSessionDeleted) ProtoMessage()    {}
-func (*EventSessionDeleted) Descriptor() ([]byte, []int) {
+func (m *EventScopeSaleOffered) GetPrice() string {
+	if m != nil {
+		return m.Price
+	}
+	return ""
+}
+
+// EventScopeSaleCancelled is an event message indicating an offer to sell a scope has been cancelled.
+type EventScopeSaleCancelled struct {
+	// scope_addr is the bech32 address string of the scope id that was offered.
+	ScopeAddr string `protobuf:"bytes,1,opt,name=scope_addr,json=scopeAddr,proto3" json:"scope_addr,omitempty"`
+}
+
+func (m *EventScopeSaleCancelled) Reset()         { *m = EventScopeSaleCancelled{} }
+func (m *EventScopeSaleCancelled) String() string { return proto.CompactTextString(m) }
+func (*EventScopeSaleCancelled) ProtoMessage()    {}
+func (*EventScopeSaleCancelled) Descriptor() ([]byte, []int) {
 	return fileDescriptor_476cf6cf9459cf25, []int{6}
 }
-func (m *EventSessionDeleted) XXX_Unmarshal(b []byte) error {
+func (m *EventScopeSaleCancelled) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventSessionDeleted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventScopeSaleCancelled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventSessionDeleted.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventScopeSaleCancelled.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -363,54 +398,49 @@ func (m *EventSessionDeleted) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *EventSessionDeleted) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventSessionDeleted.Merge(m, src)
+func (m *EventScopeSaleCancelled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventScopeSaleCancelled.Merge(m, src)
 }
-func (m *EventSessionDeleted) XXX_Size() int {
+func (m *EventScopeSaleCancelled) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventSessionDeleted) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventSessionDeleted.DiscardUnknown(m)
+func (m *EventScopeSaleCancelled) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventScopeSaleCancelled.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventSessionDeleted proto.InternalMessageInfo
-
-func (m *EventSessionDeleted) GetSessionAddr() string {
-	if m != nil {
-		return m.SessionAddr
-	}
-	return ""
-}
+var xxx_messageInfo_EventScopeSaleCancelled proto.InternalMessageInfo
 
-func (m *EventSessionDeleted) GetScopeAddr() string {
+func (m *EventScopeSaleCancelled) GetScopeAddr() string {
 	if m != nil {
 		return m.ScopeAddr
 	}
 	return ""
 }
 
-// EventRecordCreated is an event message indicating a record has been created.
-type EventRecordCreated struct {
-	// record_addr is the bech32 address string of the record id that was created.
-	RecordAddr string `protobuf:"bytes,1,opt,name=record_addr,json=recordAddr,proto3" json:"record_addr,omitempty"`
-	// session_addr is the bech32 address string of the session id this record belongs to.
-	SessionAddr string `protobuf:"bytes,2,opt,name=session_addr,json=sessionAddr,proto3" json:"session_addr,omitempty"`
-	// scope_addr is the bech32 address string of the scope id this record belongs to.
-	ScopeAddr string `protobuf:"bytes,3,opt,name=scope_addr,json=scopeAddr,proto3" json:"scope_addr,omitempty"`
-}
-
-func (m *EventRecordCreated) Reset()         { *m = EventRecordCreated{} }
-func (m *EventRecordCreated) String() string { return proto.CompactTextString(m) }
-func (*EventRecordCreated) ProtoMessage()    {}
-func (*EventRecordCreated) Descriptor() ([]byte, []int) {
+// EventScopeSold is an event message indicating the value ownership of a scope has been sold.
+type EventScopeSold struct {
+	// scope_addr is the bech32 address string of the scope id that was sold.
+	ScopeAddr string `protobuf:"bytes,1,opt,name=scope_addr,json=scopeAddr,proto3" json:"scope_addr,omitempty"`
+	// seller is the bech32 address string of the previous value owner that received the payment.
+	Seller string `protobuf:"bytes,2,opt,name=seller,proto3" json:"seller,omitempty"`
+	// buyer is the bech32 address string of the new value owner that made the payment.
+	Buyer string `protobuf:"bytes,3,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	// price is the payment that was made.
+	Price string `protobuf:"bytes,4,opt,name=price,proto3" json:"price,omitempty"`
+}
+
+func (m *EventScopeSold) Reset()         { *m = EventScopeSold{} }
+func (m *EventScopeSold) String() string { return proto.CompactTextString(m) }
+func (*EventScopeSold) ProtoMessage()    {}
+func (*EventScopeSold) Descriptor() ([]byte, []int) {
 	return fileDescriptor_476cf6cf9459cf25, []int{7}
 }
-func (m *EventRecordCreated) XXX_Unmarshal(b []byte) error {
+func (m *EventScopeSold) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventRecordCreated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventScopeSold) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventRecordCreated.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventScopeSold.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -420,61 +450,68 @@ func (m *EventRecordCreated) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *EventRecordCreated) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventRecordCreated.Merge(m, src)
+func (m *EventScopeSold) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventScopeSold.Merge(m, src)
 }
-func (m *EventRecordCreated) XXX_Size() int {
+func (m *EventScopeSold) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventRecordCreated) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventRecordCreated.DiscardUnknown(m)
+func (m *EventScopeSold) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventScopeSold.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventRecordCreated proto.InternalMessageInfo
+var xxx_messageInfo_EventScopeSold proto.InternalMessageInfo
 
-func (m *EventRecordCreated) GetRecordAddr() string {
+func (m *EventScopeSold) GetScopeAddr() string {
 	if m != nil {
-		return m.RecordAddr
+		return m.ScopeAddr
 	}
 	return ""
 }
 
-func (m *EventRecordCreated) GetSessionAddr() string {
+func (m *EventScopeSold) GetSeller() string {
 	if m != nil {
-		return m.SessionAddr
+		return m.Seller
 	}
 	return ""
 }
 
-func (m *EventRecordCreated) GetScopeAddr() string {
+func (m *EventScopeSold) GetBuyer() string {
 	if m != nil {
-		return m.ScopeAddr
+		return m.Buyer
 	}
 	return ""
 }
 
-// EventRecordUpdated is an event message indicating a record has been updated.
-type EventRecordUpdated struct {
-	// record_addr is the bech32 address string of the record id that was updated.
-	RecordAddr string `protobuf:"bytes,1,opt,name=record_addr,json=recordAddr,proto3" json:"record_addr,omitempty"`
-	// session_addr is the bech32 address string of the session id this record belongs to.
-	SessionAddr string `protobuf:"bytes,2,opt,name=session_addr,json=sessionAddr,proto3" json:"session_addr,omitempty"`
-	// scope_addr is the bech32 address string of the scope id this record belongs to.
-	ScopeAddr string `protobuf:"bytes,3,opt,name=scope_addr,json=scopeAddr,proto3" json:"scope_addr,omitempty"`
+func (m *EventScopeSold) GetPrice() string {
+	if m != nil {
+		return m.Price
+	}
+	return ""
 }
 
-func (m *EventRecordUpdated) Reset()         { *m = EventRecordUpdated{} }
-func (m *EventRecordUpdated) String() string { return proto.CompactTextString(m) }
-func (*EventRecordUpdated) ProtoMessage()    {}
-func (*EventRecordUpdated) Descriptor() ([]byte, []int) {
+// EventScopeSpecificationMigrated is an event message indicating a scope has been moved to a different scope specification.
+type EventScopeSpecificationMigrated struct {
+	// scope_addr is the bech32 address string of the scope id that was migrated.
+	ScopeAddr string `protobuf:"bytes,1,opt,name=scope_addr,json=scopeAddr,proto3" json:"scope_addr,omitempty"`
+	// old_specification_addr is the bech32 address string of the scope specification the scope used to have.
+	OldSpecificationAddr string `protobuf:"bytes,2,opt,name=old_specification_addr,json=oldSpecificationAddr,proto3" json:"old_specification_addr,omitempty"`
+	// new_specification_addr is the bech32 address string of the scope specification the scope now has.
+	NewSpecificationAddr string `protobuf:"bytes,3,opt,name=new_specification_addr,json=newSpecificationAddr,proto3" json:"new_specification_addr,omitempty"`
+}
+
+func (m *EventScopeSpecificationMigrated) Reset()         { *m = EventScopeSpecificationMigrated{} }
+func (m *EventScopeSpecificationMigrated) String() string { return proto.CompactTextString(m) }
+func (*EventScopeSpecificationMigrated) ProtoMessage()    {}
+func (*EventScopeSpecificationMigrated) Descriptor() ([]byte, []int) {
 	return fileDescriptor_476cf6cf9459cf25, []int{8}
 }
-func (m *EventRecordUpdated) XXX_Unmarshal(b []byte) error {
+func (m *EventScopeSpecificationMigrated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventRecordUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventScopeSpecificationMigrated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventRecordUpdated.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventScopeSpecificationMigrated.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -484,59 +521,59 @@ func (m *EventRecordUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *EventRecordUpdated) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventRecordUpdated.Merge(m, src)
+func (m *EventScopeSpecificationMigrated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventScopeSpecificationMigrated.Merge(m, src)
 }
-func (m *EventRecordUpdated) XXX_Size() int {
+func (m *EventScopeSpecificationMigrated) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventRecordUpdated) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventRecordUpdated.DiscardUnknown(m)
+func (m *EventScopeSpecificationMigrated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventScopeSpecificationMigrated.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventRecordUpdated proto.InternalMessageInfo
+var xxx_messageInfo_EventScopeSpecificationMigrated proto.InternalMessageInfo
 
-func (m *EventRecordUpdated) GetRecordAddr() string {
+func (m *EventScopeSpecificationMigrated) GetScopeAddr() string {
 	if m != nil {
-		return m.RecordAddr
+		return m.ScopeAddr
 	}
 	return ""
 }
 
-func (m *EventRecordUpdated) GetSessionAddr() string {
+func (m *EventScopeSpecificationMigrated) GetOldSpecificationAddr() string {
 	if m != nil {
-		return m.SessionAddr
+		return m.OldSpecificationAddr
 	}
 	return ""
 }
 
-func (m *EventRecordUpdated) GetScopeAddr() string {
+func (m *EventScopeSpecificationMigrated) GetNewSpecificationAddr() string {
 	if m != nil {
-		return m.ScopeAddr
+		return m.NewSpecificationAddr
 	}
 	return ""
 }
 
-// EventRecordDeleted is an event message indicating a record has been deleted.
-type EventRecordDeleted struct {
-	// record is the bech32 address string of the record id that was deleted.
-	RecordAddr string `protobuf:"bytes,1,opt,name=record_addr,json=recordAddr,proto3" json:"record_addr,omitempty"`
-	// scope_addr is the bech32 address string of the scope id this record belonged to.
-	ScopeAddr string `protobuf:"bytes,3,opt,name=scope_addr,json=scopeAddr,proto3" json:"scope_addr,omitempty"`
+// EventScopeDataAccessExpired is an event message indicating data access to a scope has expired and been removed.
+type EventScopeDataAccessExpired struct {
+	// scope_addr is the bech32 address string of the scope id whose data access expired.
+	ScopeAddr string `protobuf:"bytes,1,opt,name=scope_addr,json=scopeAddr,proto3" json:"scope_addr,omitempty"`
+	// data_access is the bech32 address strings that no longer have data access.
+	DataAccess []string `protobuf:"bytes,2,rep,name=data_access,json=dataAccess,proto3" json:"data_access,omitempty"`
 }
 
-func (m *EventRecordDeleted) Reset()         { *m = EventRecordDeleted{} }
-func (m *EventRecordDeleted) String() string { return proto.CompactTextString(m) }
-func (*EventRecordDeleted) ProtoMessage()    {}
-func (*EventRecordDeleted) Descriptor() ([]byte, []int) {
+func (m *EventScopeDataAccessExpired) Reset()         { *m = EventScopeDataAccessExpired{} }
+func (m *EventScopeDataAccessExpired) String() string { return proto.CompactTextString(m) }
+func (*EventScopeDataAccessExpired) ProtoMessage()    {}
+func (*EventScopeDataAccessExpired) Descriptor() ([]byte, []int) {
 	return fileDescriptor_476cf6cf9459cf25, []int{9}
 }
-func (m *EventRecordDeleted) XXX_Unmarshal(b []byte) error {
+func (m *EventScopeDataAccessExpired) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventRecordDeleted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventScopeDataAccessExpired) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventRecordDeleted.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventScopeDataAccessExpired.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -546,51 +583,55 @@ func (m *EventRecordDeleted) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *EventRecordDeleted) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventRecordDeleted.Merge(m, src)
+func (m *EventScopeDataAccessExpired) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventScopeDataAccessExpired.Merge(m, src)
 }
-func (m *EventRecordDeleted) XXX_Size() int {
+func (m *EventScopeDataAccessExpired) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventRecordDeleted) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventRecordDeleted.DiscardUnknown(m)
+func (m *EventScopeDataAccessExpired) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventScopeDataAccessExpired.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventRecordDeleted proto.InternalMessageInfo
+var xxx_messageInfo_EventScopeDataAccessExpired proto.InternalMessageInfo
 
-func (m *EventRecordDeleted) GetRecordAddr() string {
+func (m *EventScopeDataAccessExpired) GetScopeAddr() string {
 	if m != nil {
-		return m.RecordAddr
+		return m.ScopeAddr
 	}
 	return ""
 }
 
-func (m *EventRecordDeleted) GetScopeAddr() string {
+func (m *EventScopeDataAccessExpired) GetDataAccess() []string {
 	if m != nil {
-		return m.ScopeAddr
+		return m.DataAccess
 	}
-	return ""
+	return nil
 }
 
-// EventScopeSpecificationCreated is an event message indicating a scope specification has been created.
-type EventScopeSpecificationCreated struct {
-	// scope_specification_addr is the bech32 address string of the specification id of the scope specification that was
-	// created.
-	ScopeSpecificationAddr string `protobuf:"bytes,1,opt,name=scope_specification_addr,json=scopeSpecificationAddr,proto3" json:"scope_specification_addr,omitempty"`
+// EventScopeBundleWritten is an event message indicating a scope, and some of its sessions and records, have been
+// written as part of a scope bundle. It is emitted in place of the individual scope, session, and record events.
+type EventScopeBundleWritten struct {
+	// scope_addr is the bech32 address string of the scope id that was written.
+	ScopeAddr string `protobuf:"bytes,1,opt,name=scope_addr,json=scopeAddr,proto3" json:"scope_addr,omitempty"`
+	// session_count is the number of sessions written in the scope.
+	SessionCount uint32 `protobuf:"varint,2,opt,name=session_count,json=sessionCount,proto3" json:"session_count,omitempty"`
+	// record_count is the number of records written in the scope.
+	RecordCount uint32 `protobuf:"varint,3,opt,name=record_count,json=recordCount,proto3" json:"record_count,omitempty"`
 }
 
-func (m *EventScopeSpecificationCreated) Reset()         { *m = EventScopeSpecificationCreated{} }
-func (m *EventScopeSpecificationCreated) String() string { return proto.CompactTextString(m) }
-func (*EventScopeSpecificationCreated) ProtoMessage()    {}
-func (*EventScopeSpecificationCreated) Descriptor() ([]byte, []int) {
+func (m *EventScopeBundleWritten) Reset()         { *m = EventScopeBundleWritten{} }
+func (m *EventScopeBundleWritten) String() string { return proto.CompactTextString(m) }
+func (*EventScopeBundleWritten) ProtoMessage()    {}
+func (*EventScopeBundleWritten) Descriptor() ([]byte, []int) {
 	return fileDescriptor_476cf6cf9459cf25, []int{10}
 }
-func (m *EventScopeSpecificationCreated) XXX_Unmarshal(b []byte) error {
+func (m *EventScopeBundleWritten) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventScopeSpecificationCreated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventScopeBundleWritten) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventScopeSpecificationCreated.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventScopeBundleWritten.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -600,44 +641,77 @@ func (m *EventScopeSpecificationCreated) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *EventScopeSpecificationCreated) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventScopeSpecificationCreated.Merge(m, src)
+func (m *EventScopeBundleWritten) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventScopeBundleWritten.Merge(m, src)
 }
-func (m *EventScopeSpecificationCreated) XXX_Size() int {
+func (m *EventScopeBundleWritten) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventScopeSpecificationCreated) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventScopeSpecificationCreated.DiscardUnknown(m)
+func (m *EventScopeBundleWritten) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventScopeBundleWritten.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventScopeSpecificationCreated proto.InternalMessageInfo
+var xxx_messageInfo_EventScopeBundleWritten proto.InternalMessageInfo
 
-func (m *EventScopeSpecificationCreated) GetScopeSpecificationAddr() string {
+func (m *EventScopeBundleWritten) GetScopeAddr() string {
 	if m != nil {
-		return m.ScopeSpecificationAddr
+		return m.ScopeAddr
 	}
 	return ""
 }
 
-// EventScopeSpecificationUpdated is an event message indicating a scope specification has been updated.
-type EventScopeSpecificationUpdated struct {
-	// scope_specification_addr is the bech32 address string of the specification id of the scope specification that was
-	// updated.
-	ScopeSpecificationAddr string `protobuf:"bytes,1,opt,name=scope_specification_addr,json=scopeSpecificationAddr,proto3" json:"scope_specification_addr,omitempty"`
+func (m *EventScopeBundleWritten) GetSessionCount() uint32 {
+	if m != nil {
+		return m.SessionCount
+	}
+	return 0
 }
 
-func (m *EventScopeSpecificationUpdated) Reset()         { *m = EventScopeSpecificationUpdated{} }
-func (m *EventScopeSpecificationUpdated) String() string { return proto.CompactTextString(m) }
-func (*EventScopeSpecificationUpdated) ProtoMessage()    {}
-func (*EventScopeSpecificationUpdated) Descriptor() ([]byte, []int) {
+func (m *EventScopeBundleWritten) GetRecordCount() uint32 {
+	if m != nil {
+		return m.RecordCount
+	}
+	return 0
+}
+
+// EventScopeChanged is an event message with the changes made to a scope that was created or updated.
+// It is only emitted when the emit_detailed_events param is true.
+type EventScopeChanged struct {
+	// scope_addr is the bech32 address string of the scope id that was changed.
+	ScopeAddr string `protobuf:"bytes,1,opt,name=scope_addr,json=scopeAddr,proto3" json:"scope_addr,omitempty"`
+	// added_owners are the owners the scope has now that it did not have before.
+	AddedOwners []Party `protobuf:"bytes,2,rep,name=added_owners,json=addedOwners,proto3" json:"added_owners"`
+	// removed_owners are the owners the scope had before that it no longer has.
+	RemovedOwners []Party `protobuf:"bytes,3,rep,name=removed_owners,json=removedOwners,proto3" json:"removed_owners"`
+	// previous_value_owner is the bech32 address string of the value owner the scope had before.
+	PreviousValueOwner string `protobuf:"bytes,4,opt,name=previous_value_owner,json=previousValueOwner,proto3" json:"previous_value_owner,omitempty"`
+	// value_owner is the bech32 address string of the value owner the scope has now.
+	ValueOwner string `protobuf:"bytes,5,opt,name=value_owner,json=valueOwner,proto3" json:"value_owner,omitempty"`
+	// added_data_access are the bech32 address strings that now have data access to the scope.
+	AddedDataAccess []string `protobuf:"bytes,6,rep,name=added_data_access,json=addedDataAccess,proto3" json:"added_data_access,omitempty"`
+	// removed_data_access are the bech32 address strings that no longer have data access to the scope.
+	RemovedDataAccess []string `protobuf:"bytes,7,rep,name=removed_data_access,json=removedDataAccess,proto3" json:"removed_data_access,omitempty"`
+	// specification_addr is the bech32 address string of the scope specification the scope has now.
+	SpecificationAddr string `protobuf:"bytes,8,opt,name=specification_addr,json=specificationAddr,proto3" json:"specification_addr,omitempty"`
+	// added_data_access_grants are the data access grants the scope has now that it did not have before.
+	// A grant whose role or expiration changed is in both the added and removed grants.
+	AddedDataAccessGrants []DataAccessGrant `protobuf:"bytes,9,rep,name=added_data_access_grants,json=addedDataAccessGrants,proto3" json:"added_data_access_grants"`
+	// removed_data_access_grants are the data access grants the scope had before that it no longer has.
+	RemovedDataAccessGrants []DataAccessGrant `protobuf:"bytes,10,rep,name=removed_data_access_grants,json=removedDataAccessGrants,proto3" json:"removed_data_access_grants"`
+}
+
+func (m *EventScopeChanged) Reset()         { *m = EventScopeChanged{} }
+func (m *EventScopeChanged) String() string { return proto.CompactTextString(m) }
+func (*EventScopeChanged) ProtoMessage()    {}
+func (*EventScopeChanged) Descriptor() ([]byte, []int) {
 	return fileDescriptor_476cf6cf9459cf25, []int{11}
 }
-func (m *EventScopeSpecificationUpdated) XXX_Unmarshal(b []byte) error {
+func (m *EventScopeChanged) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventScopeSpecificationUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventScopeChanged) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventScopeSpecificationUpdated.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventScopeChanged.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -647,91 +721,108 @@ func (m *EventScopeSpecificationUpdated) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *EventScopeSpecificationUpdated) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventScopeSpecificationUpdated.Merge(m, src)
+func (m *EventScopeChanged) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventScopeChanged.Merge(m, src)
 }
-func (m *EventScopeSpecificationUpdated) XXX_Size() int {
+func (m *EventScopeChanged) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventScopeSpecificationUpdated) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventScopeSpecificationUpdated.DiscardUnknown(m)
+func (m *EventScopeChanged) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventScopeChanged.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventScopeSpecificationUpdated proto.InternalMessageInfo
+var xxx_messageInfo_EventScopeChanged proto.InternalMessageInfo
 
-func (m *EventScopeSpecificationUpdated) GetScopeSpecificationAddr() string {
+func (m *EventScopeChanged) GetScopeAddr() string {
 	if m != nil {
-		return m.ScopeSpecificationAddr
+		return m.ScopeAddr
 	}
 	return ""
 }
 
-// EventScopeSpecificationDeleted is an event message indicating a scope specification has been deleted.
-type EventScopeSpecificationDeleted struct {
-	// scope_specification_addr is the bech32 address string of the specification id of the scope specification that was
-	// deleted.
-	ScopeSpecificationAddr string `protobuf:"bytes,1,opt,name=scope_specification_addr,json=scopeSpecificationAddr,proto3" json:"scope_specification_addr,omitempty"`
+func (m *EventScopeChanged) GetAddedOwners() []Party {
+	if m != nil {
+		return m.AddedOwners
+	}
+	return nil
 }
 
-func (m *EventScopeSpecificationDeleted) Reset()         { *m = EventScopeSpecificationDeleted{} }
-func (m *EventScopeSpecificationDeleted) String() string { return proto.CompactTextString(m) }
-func (*EventScopeSpecificationDeleted) ProtoMessage()    {}
-func (*EventScopeSpecificationDeleted) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{12}
+func (m *EventScopeChanged) GetRemovedOwners() []Party {
+	if m != nil {
+		return m.RemovedOwners
+	}
+	return nil
 }
-func (m *EventScopeSpecificationDeleted) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+
+func (m *EventScopeChanged) GetPreviousValueOwner() string {
+	if m != nil {
+		return m.PreviousValueOwner
+	}
+	return ""
 }
-func (m *EventScopeSpecificationDeleted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_EventScopeSpecificationDeleted.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+
+func (m *EventScopeChanged) GetValueOwner() string {
+	if m != nil {
+		return m.ValueOwner
 	}
+	return ""
 }
-func (m *EventScopeSpecificationDeleted) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventScopeSpecificationDeleted.Merge(m, src)
+
+func (m *EventScopeChanged) GetAddedDataAccess() []string {
+	if m != nil {
+		return m.AddedDataAccess
+	}
+	return nil
 }
-func (m *EventScopeSpecificationDeleted) XXX_Size() int {
-	return m.Size()
+
+func (m *EventScopeChanged) GetRemovedDataAccess() []string {
+	if m != nil {
+		return m.RemovedDataAccess
+	}
+	return nil
 }
-func (m *EventScopeSpecificationDeleted) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventScopeSpecificationDeleted.DiscardUnknown(m)
+
+func (m *EventScopeChanged) GetSpecificationAddr() string {
+	if m != nil {
+		return m.SpecificationAddr
+	}
+	return ""
 }
 
-var xxx_messageInfo_EventScopeSpecificationDeleted proto.InternalMessageInfo
+func (m *EventScopeChanged) GetAddedDataAccessGrants() []DataAccessGrant {
+	if m != nil {
+		return m.AddedDataAccessGrants
+	}
+	return nil
+}
 
-func (m *EventScopeSpecificationDeleted) GetScopeSpecificationAddr() string {
+func (m *EventScopeChanged) GetRemovedDataAccessGrants() []DataAccessGrant {
 	if m != nil {
-		return m.ScopeSpecificationAddr
+		return m.RemovedDataAccessGrants
 	}
-	return ""
+	return nil
 }
 
-// EventContractSpecificationCreated is an event message indicating a contract specification has been created.
-type EventContractSpecificationCreated struct {
-	// contract_specification_addr is the bech32 address string of the specification id of the contract specification that
-	// was created.
-	ContractSpecificationAddr string `protobuf:"bytes,1,opt,name=contract_specification_addr,json=contractSpecificationAddr,proto3" json:"contract_specification_addr,omitempty"`
+// EventSessionCreated is an event message indicating a session has been created.
+type EventSessionCreated struct {
+	// session_addr is the bech32 address string of the session id that was created.
+	SessionAddr string `protobuf:"bytes,1,opt,name=session_addr,json=sessionAddr,proto3" json:"session_addr,omitempty"`
+	// scope_addr is the bech32 address string of the scope id this session belongs to.
+	ScopeAddr string `protobuf:"bytes,2,opt,name=scope_addr,json=scopeAddr,proto3" json:"scope_addr,omitempty"`
 }
 
-func (m *EventContractSpecificationCreated) Reset()         { *m = EventContractSpecificationCreated{} }
-func (m *EventContractSpecificationCreated) String() string { return proto.CompactTextString(m) }
-func (*EventContractSpecificationCreated) ProtoMessage()    {}
-func (*EventContractSpecificationCreated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{13}
+func (m *EventSessionCreated) Reset()         { *m = EventSessionCreated{} }
+func (m *EventSessionCreated) String() string { return proto.CompactTextString(m) }
+func (*EventSessionCreated) ProtoMessage()    {}
+func (*EventSessionCreated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_476cf6cf9459cf25, []int{12}
 }
-func (m *EventContractSpecificationCreated) XXX_Unmarshal(b []byte) error {
+func (m *EventSessionCreated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventContractSpecificationCreated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventSessionCreated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventContractSpecificationCreated.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventSessionCreated.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -741,44 +832,52 @@ func (m *EventContractSpecificationCreated) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *EventContractSpecificationCreated) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventContractSpecificationCreated.Merge(m, src)
+func (m *EventSessionCreated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSessionCreated.Merge(m, src)
 }
-func (m *EventContractSpecificationCreated) XXX_Size() int {
+func (m *EventSessionCreated) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventContractSpecificationCreated) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventContractSpecificationCreated.DiscardUnknown(m)
+func (m *EventSessionCreated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSessionCreated.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventContractSpecificationCreated proto.InternalMessageInfo
+var xxx_messageInfo_EventSessionCreated proto.InternalMessageInfo
 
-func (m *EventContractSpecificationCreated) GetContractSpecificationAddr() string {
+func (m *EventSessionCreated) GetSessionAddr() string {
 	if m != nil {
-		return m.ContractSpecificationAddr
+		return m.SessionAddr
 	}
 	return ""
 }
 
-// EventContractSpecificationUpdated is an event message indicating a contract specification has been updated.
-type EventContractSpecificationUpdated struct {
-	// contract_specification_addr is the bech32 address string of the specification id of the contract specification that
-	// was updated.
-	ContractSpecificationAddr string `protobuf:"bytes,1,opt,name=contract_specification_addr,json=contractSpecificationAddr,proto3" json:"contract_specification_addr,omitempty"`
+func (m *EventSessionCreated) GetScopeAddr() string {
+	if m != nil {
+		return m.ScopeAddr
+	}
+	return ""
 }
 
-func (m *EventContractSpecificationUpdated) Reset()         { *m = EventContractSpecificationUpdated{} }
-func (m *EventContractSpecificationUpdated) String() string { return proto.CompactTextString(m) }
-func (*EventContractSpecificationUpdated) ProtoMessage()    {}
-func (*EventContractSpecificationUpdated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{14}
+// EventSessionUpdated is an event message indicating a session has been updated.
+type EventSessionUpdated struct {
+	// session_addr is the bech32 address string of the session id that was updated.
+	SessionAddr string `protobuf:"bytes,1,opt,name=session_addr,json=sessionAddr,proto3" json:"session_addr,omitempty"`
+	// scope_addr is the bech32 address string of the scope id this session belongs to.
+	ScopeAddr string `protobuf:"bytes,2,opt,name=scope_addr,json=scopeAddr,proto3" json:"scope_addr,omitempty"`
 }
-func (m *EventContractSpecificationUpdated) XXX_Unmarshal(b []byte) error {
+
+func (m *EventSessionUpdated) Reset()         { *m = EventSessionUpdated{} }
+func (m *EventSessionUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventSessionUpdated) ProtoMessage()    {}
+func (*EventSessionUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_476cf6cf9459cf25, []int{13}
+}
+func (m *EventSessionUpdated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventContractSpecificationUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventSessionUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventContractSpecificationUpdated.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventSessionUpdated.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -788,44 +887,52 @@ func (m *EventContractSpecificationUpdated) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *EventContractSpecificationUpdated) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventContractSpecificationUpdated.Merge(m, src)
+func (m *EventSessionUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSessionUpdated.Merge(m, src)
 }
-func (m *EventContractSpecificationUpdated) XXX_Size() int {
+func (m *EventSessionUpdated) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventContractSpecificationUpdated) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventContractSpecificationUpdated.DiscardUnknown(m)
+func (m *EventSessionUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSessionUpdated.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventContractSpecificationUpdated proto.InternalMessageInfo
+var xxx_messageInfo_EventSessionUpdated proto.InternalMessageInfo
 
-func (m *EventContractSpecificationUpdated) GetContractSpecificationAddr() string {
+func (m *EventSessionUpdated) GetSessionAddr() string {
 	if m != nil {
-		return m.ContractSpecificationAddr
+		return m.SessionAddr
 	}
 	return ""
 }
 
-// EventContractSpecificationDeleted is an event message indicating a contract specification has been deleted.
-type EventContractSpecificationDeleted struct {
-	// contract_specification_addr is the bech32 address string of the specification id of the contract specification that
-	// was deleted.
-	ContractSpecificationAddr string `protobuf:"bytes,1,opt,name=contract_specification_addr,json=contractSpecificationAddr,proto3" json:"contract_specification_addr,omitempty"`
+func (m *EventSessionUpdated) GetScopeAddr() string {
+	if m != nil {
+		return m.ScopeAddr
+	}
+	return ""
 }
 
-func (m *EventContractSpecificationDeleted) Reset()         { *m = EventContractSpecificationDeleted{} }
-func (m *EventContractSpecificationDeleted) String() string { return proto.CompactTextString(m) }
-func (*EventContractSpecificationDeleted) ProtoMessage()    {}
-func (*EventContractSpecificationDeleted) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{15}
+// EventSessionDeleted is an event message indicating a session has been deleted.
+type EventSessionDeleted struct {
+	// session_addr is the bech32 address string of the session id that was deleted.
+	SessionAddr string `protobuf:"bytes,1,opt,name=session_addr,json=sessionAddr,proto3" json:"session_addr,omitempty"`
+	// scope_addr is the bech32 address string of the scope id this session belongs to.
+	ScopeAddr string `protobuf:"bytes,2,opt,name=scope_addr,json=scopeAddr,proto3" json:"scope_addr,omitempty"`
 }
-func (m *EventContractSpecificationDeleted) XXX_Unmarshal(b []byte) error {
+
+func (m *EventSessionDeleted) Reset()         { *m = EventSessionDeleted{} }
+func (m *EventSessionDeleted) String() string { return proto.CompactTextString(m) }
+func (*EventSessionDeleted) ProtoMessage()    {}
+func (*EventSessionDeleted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_476cf6cf9459cf25, []int{14}
+}
+func (m *EventSessionDeleted) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventContractSpecificationDeleted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventSessionDeleted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventContractSpecificationDeleted.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventSessionDeleted.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -835,47 +942,63 @@ func (m *EventContractSpecificationDeleted) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *EventContractSpecificationDeleted) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventContractSpecificationDeleted.Merge(m, src)
+func (m *EventSessionDeleted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSessionDeleted.Merge(m, src)
 }
-func (m *EventContractSpecificationDeleted) XXX_Size() int {
+func (m *EventSessionDeleted) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventContractSpecificationDeleted) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventContractSpecificationDeleted.DiscardUnknown(m)
+func (m *EventSessionDeleted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSessionDeleted.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventContractSpecificationDeleted proto.InternalMessageInfo
+var xxx_messageInfo_EventSessionDeleted proto.InternalMessageInfo
 
-func (m *EventContractSpecificationDeleted) GetContractSpecificationAddr() string {
+func (m *EventSessionDeleted) GetSessionAddr() string {
 	if m != nil {
-		return m.ContractSpecificationAddr
+		return m.SessionAddr
 	}
 	return ""
 }
 
-// EventRecordSpecificationCreated is an event message indicating a record specification has been created.
-type EventRecordSpecificationCreated struct {
-	// record_specification_addr is the bech32 address string of the specification id of the record specification that was
-	// created.
-	RecordSpecificationAddr string `protobuf:"bytes,1,opt,name=record_specification_addr,json=recordSpecificationAddr,proto3" json:"record_specification_addr,omitempty"`
-	// contract_specification_addr is the bech32 address string of the contract specification id this record specification
-	// belongs to.
-	ContractSpecificationAddr string `protobuf:"bytes,2,opt,name=contract_specification_addr,json=contractSpecificationAddr,proto3" json:"contract_specification_addr,omitempty"`
+func (m *EventSessionDeleted) GetScopeAddr() string {
+	if m != nil {
+		return m.ScopeAddr
+	}
+	return ""
 }
 
-func (m *EventRecordSpecificationCreated) Reset()         { *m = EventRecordSpecificationCreated{} }
-func (m *EventRecordSpecificationCreated) String() string { return proto.CompactTextString(m) }
-func (*EventRecordSpecificationCreated) ProtoMessage()    {}
-func (*EventRecordSpecificationCreated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{16}
+// EventSessionChanged is an event message with the changes made to a session that was created or updated.
+// It is only emitted when the emit_detailed_events param is true.
+type EventSessionChanged struct {
+	// session_addr is the bech32 address string of the session id that was changed.
+	SessionAddr string `protobuf:"bytes,1,opt,name=session_addr,json=sessionAddr,proto3" json:"session_addr,omitempty"`
+	// scope_addr is the bech32 address string of the scope id this session belongs to.
+	ScopeAddr string `protobuf:"bytes,2,opt,name=scope_addr,json=scopeAddr,proto3" json:"scope_addr,omitempty"`
+	// added_parties are the parties the session has now that it did not have before.
+	AddedParties []Party `protobuf:"bytes,3,rep,name=added_parties,json=addedParties,proto3" json:"added_parties"`
+	// removed_parties are the parties the session had before that it no longer has.
+	RemovedParties []Party `protobuf:"bytes,4,rep,name=removed_parties,json=removedParties,proto3" json:"removed_parties"`
+	// previous_name is the name the session had before.
+	PreviousName string `protobuf:"bytes,5,opt,name=previous_name,json=previousName,proto3" json:"previous_name,omitempty"`
+	// name is the name the session has now.
+	Name string `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"`
+	// specification_addr is the bech32 address string of the contract specification the session has now.
+	SpecificationAddr string `protobuf:"bytes,7,opt,name=specification_addr,json=specificationAddr,proto3" json:"specification_addr,omitempty"`
+}
+
+func (m *EventSessionChanged) Reset()         { *m = EventSessionChanged{} }
+func (m *EventSessionChanged) String() string { return proto.CompactTextString(m) }
+func (*EventSessionChanged) ProtoMessage()    {}
+func (*EventSessionChanged) Descriptor() ([]byte, []int) {
+	return fileDescriptor_476cf6cf9459cf25, []int{15}
 }
-func (m *EventRecordSpecificationCreated) XXX_Unmarshal(b []byte) error {
+func (m *EventSessionChanged) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventRecordSpecificationCreated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventSessionChanged) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventRecordSpecificationCreated.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventSessionChanged.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -885,111 +1008,89 @@ func (m *EventRecordSpecificationCreated) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *EventRecordSpecificationCreated) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventRecordSpecificationCreated.Merge(m, src)
+func (m *EventSessionChanged) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSessionChanged.Merge(m, src)
 }
-func (m *EventRecordSpecificationCreated) XXX_Size() int {
+func (m *EventSessionChanged) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventRecordSpecificationCreated) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventRecordSpecificationCreated.DiscardUnknown(m)
+func (m *EventSessionChanged) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSessionChanged.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventRecordSpecificationCreated proto.InternalMessageInfo
+var xxx_messageInfo_EventSessionChanged proto.InternalMessageInfo
 
-func (m *EventRecordSpecificationCreated) GetRecordSpecificationAddr() string {
+func (m *EventSessionChanged) GetSessionAddr() string {
 	if m != nil {
-		return m.RecordSpecificationAddr
+		return m.SessionAddr
 	}
 	return ""
 }
 
-func (m *EventRecordSpecificationCreated) GetContractSpecificationAddr() string {
+func (m *EventSessionChanged) GetScopeAddr() string {
 	if m != nil {
-		return m.ContractSpecificationAddr
+		return m.ScopeAddr
 	}
 	return ""
 }
 
-// EventRecordSpecificationUpdated is an event message indicating a record specification has been updated.
-type EventRecordSpecificationUpdated struct {
-	// record_specification_addr is the bech32 address string of the specification id of the record specification that was
-	// updated.
-	RecordSpecificationAddr string `protobuf:"bytes,1,opt,name=record_specification_addr,json=recordSpecificationAddr,proto3" json:"record_specification_addr,omitempty"`
-	// contract_specification_addr is the bech32 address string of the contract specification id this record specification
-	// belongs to.
-	ContractSpecificationAddr string `protobuf:"bytes,2,opt,name=contract_specification_addr,json=contractSpecificationAddr,proto3" json:"contract_specification_addr,omitempty"`
+func (m *EventSessionChanged) GetAddedParties() []Party {
+	if m != nil {
+		return m.AddedParties
+	}
+	return nil
 }
 
-func (m *EventRecordSpecificationUpdated) Reset()         { *m = EventRecordSpecificationUpdated{} }
-func (m *EventRecordSpecificationUpdated) String() string { return proto.CompactTextString(m) }
-func (*EventRecordSpecificationUpdated) ProtoMessage()    {}
-func (*EventRecordSpecificationUpdated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{17}
+func (m *EventSessionChanged) GetRemovedParties() []Party {
+	if m != nil {
+		return m.RemovedParties
+	}
+	return nil
 }
-func (m *EventRecordSpecificationUpdated) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *EventRecordSpecificationUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_EventRecordSpecificationUpdated.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
-	}
-}
-func (m *EventRecordSpecificationUpdated) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventRecordSpecificationUpdated.Merge(m, src)
-}
-func (m *EventRecordSpecificationUpdated) XXX_Size() int {
-	return m.Size()
-}
-func (m *EventRecordSpecificationUpdated) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventRecordSpecificationUpdated.DiscardUnknown(m)
+
+func (m *EventSessionChanged) GetPreviousName() string {
+	if m != nil {
+		return m.PreviousName
+	}
+	return ""
 }
 
-var xxx_messageInfo_EventRecordSpecificationUpdated proto.InternalMessageInfo
-
-func (m *EventRecordSpecificationUpdated) GetRecordSpecificationAddr() string {
+func (m *EventSessionChanged) GetName() string {
 	if m != nil {
-		return m.RecordSpecificationAddr
+		return m.Name
 	}
 	return ""
 }
 
-func (m *EventRecordSpecificationUpdated) GetContractSpecificationAddr() string {
+func (m *EventSessionChanged) GetSpecificationAddr() string {
 	if m != nil {
-		return m.ContractSpecificationAddr
+		return m.SpecificationAddr
 	}
 	return ""
 }
 
-// EventRecordSpecificationDeleted is an event message indicating a record specification has been deleted.
-type EventRecordSpecificationDeleted struct {
-	// record_specification_addr is the bech32 address string of the specification id of the record specification that was
-	// deleted.
-	RecordSpecificationAddr string `protobuf:"bytes,1,opt,name=record_specification_addr,json=recordSpecificationAddr,proto3" json:"record_specification_addr,omitempty"`
-	// contract_specification_addr is the bech32 address string of the contract specification id this record specification
-	// belongs to.
-	ContractSpecificationAddr string `protobuf:"bytes,2,opt,name=contract_specification_addr,json=contractSpecificationAddr,proto3" json:"contract_specification_addr,omitempty"`
+// EventRecordCreated is an event message indicating a record has been created.
+type EventRecordCreated struct {
+	// record_addr is the bech32 address string of the record id that was created.
+	RecordAddr string `protobuf:"bytes,1,opt,name=record_addr,json=recordAddr,proto3" json:"record_addr,omitempty"`
+	// session_addr is the bech32 address string of the session id this record belongs to.
+	SessionAddr string `protobuf:"bytes,2,opt,name=session_addr,json=sessionAddr,proto3" json:"session_addr,omitempty"`
+	// scope_addr is the bech32 address string of the scope id this record belongs to.
+	ScopeAddr string `protobuf:"bytes,3,opt,name=scope_addr,json=scopeAddr,proto3" json:"scope_addr,omitempty"`
 }
 
-func (m *EventRecordSpecificationDeleted) Reset()         { *m = EventRecordSpecificationDeleted{} }
-func (m *EventRecordSpecificationDeleted) String() string { return proto.CompactTextString(m) }
-func (*EventRecordSpecificationDeleted) ProtoMessage()    {}
-func (*EventRecordSpecificationDeleted) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{18}
+func (m *EventRecordCreated) Reset()         { *m = EventRecordCreated{} }
+func (m *EventRecordCreated) String() string { return proto.CompactTextString(m) }
+func (*EventRecordCreated) ProtoMessage()    {}
+func (*EventRecordCreated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_476cf6cf9459cf25, []int{16}
 }
-func (m *EventRecordSpecificationDeleted) XXX_Unmarshal(b []byte) error {
+func (m *EventRecordCreated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventRecordSpecificationDeleted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventRecordCreated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventRecordSpecificationDeleted.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventRecordCreated.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -999,50 +1100,61 @@ func (m *EventRecordSpecificationDeleted) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *EventRecordSpecificationDeleted) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventRecordSpecificationDeleted.Merge(m, src)
+func (m *EventRecordCreated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRecordCreated.Merge(m, src)
 }
-func (m *EventRecordSpecificationDeleted) XXX_Size() int {
+func (m *EventRecordCreated) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventRecordSpecificationDeleted) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventRecordSpecificationDeleted.DiscardUnknown(m)
+func (m *EventRecordCreated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRecordCreated.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventRecordSpecificationDeleted proto.InternalMessageInfo
+var xxx_messageInfo_EventRecordCreated proto.InternalMessageInfo
 
-func (m *EventRecordSpecificationDeleted) GetRecordSpecificationAddr() string {
+func (m *EventRecordCreated) GetRecordAddr() string {
 	if m != nil {
-		return m.RecordSpecificationAddr
+		return m.RecordAddr
 	}
 	return ""
 }
 
-func (m *EventRecordSpecificationDeleted) GetContractSpecificationAddr() string {
+func (m *EventRecordCreated) GetSessionAddr() string {
 	if m != nil {
-		return m.ContractSpecificationAddr
+		return m.SessionAddr
 	}
 	return ""
 }
 
-// EventOSLocatorCreated is an event message indicating an object store locator has been created.
-type EventOSLocatorCreated struct {
-	// owner is the owner in the object store locator that was created.
-	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+func (m *EventRecordCreated) GetScopeAddr() string {
+	if m != nil {
+		return m.ScopeAddr
+	}
+	return ""
 }
 
-func (m *EventOSLocatorCreated) Reset()         { *m = EventOSLocatorCreated{} }
-func (m *EventOSLocatorCreated) String() string { return proto.CompactTextString(m) }
-func (*EventOSLocatorCreated) ProtoMessage()    {}
-func (*EventOSLocatorCreated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{19}
+// EventRecordUpdated is an event message indicating a record has been updated.
+type EventRecordUpdated struct {
+	// record_addr is the bech32 address string of the record id that was updated.
+	RecordAddr string `protobuf:"bytes,1,opt,name=record_addr,json=recordAddr,proto3" json:"record_addr,omitempty"`
+	// session_addr is the bech32 address string of the session id this record belongs to.
+	SessionAddr string `protobuf:"bytes,2,opt,name=session_addr,json=sessionAddr,proto3" json:"session_addr,omitempty"`
+	// scope_addr is the bech32 address string of the scope id this record belongs to.
+	ScopeAddr string `protobuf:"bytes,3,opt,name=scope_addr,json=scopeAddr,proto3" json:"scope_addr,omitempty"`
 }
-func (m *EventOSLocatorCreated) XXX_Unmarshal(b []byte) error {
+
+func (m *EventRecordUpdated) Reset()         { *m = EventRecordUpdated{} }
+func (m *EventRecordUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventRecordUpdated) ProtoMessage()    {}
+func (*EventRecordUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_476cf6cf9459cf25, []int{17}
+}
+func (m *EventRecordUpdated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventOSLocatorCreated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventRecordUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventOSLocatorCreated.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventRecordUpdated.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1052,43 +1164,59 @@ func (m *EventOSLocatorCreated) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *EventOSLocatorCreated) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventOSLocatorCreated.Merge(m, src)
+func (m *EventRecordUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRecordUpdated.Merge(m, src)
 }
-func (m *EventOSLocatorCreated) XXX_Size() int {
+func (m *EventRecordUpdated) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventOSLocatorCreated) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventOSLocatorCreated.DiscardUnknown(m)
+func (m *EventRecordUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRecordUpdated.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventOSLocatorCreated proto.InternalMessageInfo
+var xxx_messageInfo_EventRecordUpdated proto.InternalMessageInfo
 
-func (m *EventOSLocatorCreated) GetOwner() string {
+func (m *EventRecordUpdated) GetRecordAddr() string {
 	if m != nil {
-		return m.Owner
+		return m.RecordAddr
 	}
 	return ""
 }
 
-// EventOSLocatorUpdated is an event message indicating an object store locator has been updated.
-type EventOSLocatorUpdated struct {
-	// owner is the owner in the object store locator that was updated.
-	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+func (m *EventRecordUpdated) GetSessionAddr() string {
+	if m != nil {
+		return m.SessionAddr
+	}
+	return ""
 }
 
-func (m *EventOSLocatorUpdated) Reset()         { *m = EventOSLocatorUpdated{} }
-func (m *EventOSLocatorUpdated) String() string { return proto.CompactTextString(m) }
-func (*EventOSLocatorUpdated) ProtoMessage()    {}
-func (*EventOSLocatorUpdated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{20}
+func (m *EventRecordUpdated) GetScopeAddr() string {
+	if m != nil {
+		return m.ScopeAddr
+	}
+	return ""
 }
-func (m *EventOSLocatorUpdated) XXX_Unmarshal(b []byte) error {
+
+// EventRecordDeleted is an event message indicating a record has been deleted.
+type EventRecordDeleted struct {
+	// record is the bech32 address string of the record id that was deleted.
+	RecordAddr string `protobuf:"bytes,1,opt,name=record_addr,json=recordAddr,proto3" json:"record_addr,omitempty"`
+	// scope_addr is the bech32 address string of the scope id this record belonged to.
+	ScopeAddr string `protobuf:"bytes,3,opt,name=scope_addr,json=scopeAddr,proto3" json:"scope_addr,omitempty"`
+}
+
+func (m *EventRecordDeleted) Reset()         { *m = EventRecordDeleted{} }
+func (m *EventRecordDeleted) String() string { return proto.CompactTextString(m) }
+func (*EventRecordDeleted) ProtoMessage()    {}
+func (*EventRecordDeleted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_476cf6cf9459cf25, []int{18}
+}
+func (m *EventRecordDeleted) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventOSLocatorUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventRecordDeleted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventOSLocatorUpdated.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventRecordDeleted.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1098,43 +1226,63 @@ func (m *EventOSLocatorUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *EventOSLocatorUpdated) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventOSLocatorUpdated.Merge(m, src)
+func (m *EventRecordDeleted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRecordDeleted.Merge(m, src)
 }
-func (m *EventOSLocatorUpdated) XXX_Size() int {
+func (m *EventRecordDeleted) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventOSLocatorUpdated) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventOSLocatorUpdated.DiscardUnknown(m)
+func (m *EventRecordDeleted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRecordDeleted.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventOSLocatorUpdated proto.InternalMessageInfo
+var xxx_messageInfo_EventRecordDeleted proto.InternalMessageInfo
 
-func (m *EventOSLocatorUpdated) GetOwner() string {
+func (m *EventRecordDeleted) GetRecordAddr() string {
 	if m != nil {
-		return m.Owner
+		return m.RecordAddr
 	}
 	return ""
 }
 
-// EventOSLocatorDeleted is an event message indicating an object store locator has been deleted.
-type EventOSLocatorDeleted struct {
-	// owner is the owner in the object store locator that was deleted.
-	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+func (m *EventRecordDeleted) GetScopeAddr() string {
+	if m != nil {
+		return m.ScopeAddr
+	}
+	return ""
 }
 
-func (m *EventOSLocatorDeleted) Reset()         { *m = EventOSLocatorDeleted{} }
-func (m *EventOSLocatorDeleted) String() string { return proto.CompactTextString(m) }
-func (*EventOSLocatorDeleted) ProtoMessage()    {}
-func (*EventOSLocatorDeleted) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{21}
+// EventRecordChanged is an event message with the changes made to a record that was created or updated.
+// It is only emitted when the emit_detailed_events param is true.
+type EventRecordChanged struct {
+	// record_addr is the bech32 address string of the record id that was changed.
+	RecordAddr string `protobuf:"bytes,1,opt,name=record_addr,json=recordAddr,proto3" json:"record_addr,omitempty"`
+	// session_addr is the bech32 address string of the session id this record belongs to now.
+	SessionAddr string `protobuf:"bytes,2,opt,name=session_addr,json=sessionAddr,proto3" json:"session_addr,omitempty"`
+	// scope_addr is the bech32 address string of the scope id this record belongs to.
+	ScopeAddr string `protobuf:"bytes,3,opt,name=scope_addr,json=scopeAddr,proto3" json:"scope_addr,omitempty"`
+	// previous_session_addr is the bech32 address string of the session id this record belonged to before.
+	PreviousSessionAddr string `protobuf:"bytes,4,opt,name=previous_session_addr,json=previousSessionAddr,proto3" json:"previous_session_addr,omitempty"`
+	// previous_output_hashes are the hashes of the outputs the record had before.
+	PreviousOutputHashes []string `protobuf:"bytes,5,rep,name=previous_output_hashes,json=previousOutputHashes,proto3" json:"previous_output_hashes,omitempty"`
+	// output_hashes are the hashes of the outputs the record has now.
+	OutputHashes []string `protobuf:"bytes,6,rep,name=output_hashes,json=outputHashes,proto3" json:"output_hashes,omitempty"`
+	// specification_addr is the bech32 address string of the record specification the record has now.
+	SpecificationAddr string `protobuf:"bytes,7,opt,name=specification_addr,json=specificationAddr,proto3" json:"specification_addr,omitempty"`
+}
+
+func (m *EventRecordChanged) Reset()         { *m = EventRecordChanged{} }
+func (m *EventRecordChanged) String() string { return proto.CompactTextString(m) }
+func (*EventRecordChanged) ProtoMessage()    {}
+func (*EventRecordChanged) Descriptor() ([]byte, []int) {
+	return fileDescriptor_476cf6cf9459cf25, []int{19}
 }
-func (m *EventOSLocatorDeleted) XXX_Unmarshal(b []byte) error {
+func (m *EventRecordChanged) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventOSLocatorDeleted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventRecordChanged) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventOSLocatorDeleted.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventRecordChanged.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1144,1201 +1292,4933 @@ func (m *EventOSLocatorDeleted) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *EventOSLocatorDeleted) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventOSLocatorDeleted.Merge(m, src)
+func (m *EventRecordChanged) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRecordChanged.Merge(m, src)
 }
-func (m *EventOSLocatorDeleted) XXX_Size() int {
+func (m *EventRecordChanged) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventOSLocatorDeleted) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventOSLocatorDeleted.DiscardUnknown(m)
+func (m *EventRecordChanged) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRecordChanged.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventOSLocatorDeleted proto.InternalMessageInfo
+var xxx_messageInfo_EventRecordChanged proto.InternalMessageInfo
 
-func (m *EventOSLocatorDeleted) GetOwner() string {
+func (m *EventRecordChanged) GetRecordAddr() string {
 	if m != nil {
-		return m.Owner
+		return m.RecordAddr
 	}
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*EventTxCompleted)(nil), "provenance.metadata.v1.EventTxCompleted")
-	proto.RegisterType((*EventScopeCreated)(nil), "provenance.metadata.v1.EventScopeCreated")
-	proto.RegisterType((*EventScopeUpdated)(nil), "provenance.metadata.v1.EventScopeUpdated")
-	proto.RegisterType((*EventScopeDeleted)(nil), "provenance.metadata.v1.EventScopeDeleted")
-	proto.RegisterType((*EventSessionCreated)(nil), "provenance.metadata.v1.EventSessionCreated")
-	proto.RegisterType((*EventSessionUpdated)(nil), "provenance.metadata.v1.EventSessionUpdated")
-	proto.RegisterType((*EventSessionDeleted)(nil), "provenance.metadata.v1.EventSessionDeleted")
-	proto.RegisterType((*EventRecordCreated)(nil), "provenance.metadata.v1.EventRecordCreated")
-	proto.RegisterType((*EventRecordUpdated)(nil), "provenance.metadata.v1.EventRecordUpdated")
-	proto.RegisterType((*EventRecordDeleted)(nil), "provenance.metadata.v1.EventRecordDeleted")
-	proto.RegisterType((*EventScopeSpecificationCreated)(nil), "provenance.metadata.v1.EventScopeSpecificationCreated")
-	proto.RegisterType((*EventScopeSpecificationUpdated)(nil), "provenance.metadata.v1.EventScopeSpecificationUpdated")
-	proto.RegisterType((*EventScopeSpecificationDeleted)(nil), "provenance.metadata.v1.EventScopeSpecificationDeleted")
-	proto.RegisterType((*EventContractSpecificationCreated)(nil), "provenance.metadata.v1.EventContractSpecificationCreated")
-	proto.RegisterType((*EventContractSpecificationUpdated)(nil), "provenance.metadata.v1.EventContractSpecificationUpdated")
-	proto.RegisterType((*EventContractSpecificationDeleted)(nil), "provenance.metadata.v1.EventContractSpecificationDeleted")
-	proto.RegisterType((*EventRecordSpecificationCreated)(nil), "provenance.metadata.v1.EventRecordSpecificationCreated")
-	proto.RegisterType((*EventRecordSpecificationUpdated)(nil), "provenance.metadata.v1.EventRecordSpecificationUpdated")
-	proto.RegisterType((*EventRecordSpecificationDeleted)(nil), "provenance.metadata.v1.EventRecordSpecificationDeleted")
-	proto.RegisterType((*EventOSLocatorCreated)(nil), "provenance.metadata.v1.EventOSLocatorCreated")
-	proto.RegisterType((*EventOSLocatorUpdated)(nil), "provenance.metadata.v1.EventOSLocatorUpdated")
-	proto.RegisterType((*EventOSLocatorDeleted)(nil), "provenance.metadata.v1.EventOSLocatorDeleted")
+func (m *EventRecordChanged) GetSessionAddr() string {
+	if m != nil {
+		return m.SessionAddr
+	}
+	return ""
 }
 
-func init() {
-	proto.RegisterFile("provenance/metadata/v1/events.proto", fileDescriptor_476cf6cf9459cf25)
+func (m *EventRecordChanged) GetScopeAddr() string {
+	if m != nil {
+		return m.ScopeAddr
+	}
+	return ""
 }
 
-var fileDescriptor_476cf6cf9459cf25 = []byte{
-	// 508 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x96, 0xcf, 0x6e, 0xd3, 0x40,
-	0x10, 0xc6, 0xe3, 0x44, 0x14, 0x32, 0xe5, 0x00, 0x06, 0x82, 0x03, 0xc2, 0xfd, 0xc3, 0xa5, 0x97,
-	0xda, 0x2a, 0x70, 0x40, 0x1c, 0x90, 0x20, 0x70, 0x43, 0x02, 0x25, 0x45, 0x48, 0xbd, 0xc0, 0x76,
-	0x77, 0x28, 0x16, 0xcd, 0xae, 0xb5, 0xbb, 0x4d, 0xcb, 0x5b, 0xf0, 0x02, 0xbc, 0x0f, 0xc7, 0x1e,
-	0x39, 0xa2, 0xe4, 0x45, 0x50, 0xd6, 0x1e, 0xec, 0x36, 0x2e, 0x06, 0x42, 0x80, 0xe3, 0xcc, 0xce,
-	0x7c, 0xbf, 0xd9, 0x6f, 0xe7, 0xb0, 0x70, 0x3b, 0xd5, 0x6a, 0x84, 0x92, 0x49, 0x8e, 0xf1, 0x10,
-	0x2d, 0x13, 0xcc, 0xb2, 0x78, 0xb4, 0x15, 0xe3, 0x08, 0xa5, 0x35, 0x51, 0xaa, 0x95, 0x55, 0x7e,
-	0xa7, 0x28, 0x8a, 0xa8, 0x28, 0x1a, 0x6d, 0xad, 0xbf, 0x81, 0x4b, 0x4f, 0xa7, 0x75, 0xdb, 0x47,
-	0x3d, 0x35, 0x4c, 0xf7, 0xd1, 0xa2, 0xf0, 0x3b, 0xb0, 0x34, 0x54, 0xe2, 0x60, 0x1f, 0x03, 0x6f,
-	0xd5, 0xdb, 0x68, 0xf7, 0xf3, 0xc8, 0xbf, 0x01, 0x17, 0x50, 0x8a, 0x54, 0x25, 0xd2, 0x06, 0x4d,
-	0x77, 0xf2, 0x3d, 0xf6, 0x03, 0x38, 0x6f, 0x92, 0x3d, 0x89, 0xda, 0x04, 0xad, 0xd5, 0xd6, 0x46,
-	0xbb, 0x4f, 0xe1, 0xfa, 0x1d, 0xb8, 0xec, 0x08, 0x03, 0xae, 0x52, 0xec, 0x69, 0x64, 0x53, 0xc4,
-	0x2d, 0x00, 0x33, 0x8d, 0x5f, 0x33, 0x21, 0x74, 0x8e, 0x69, 0xbb, 0xcc, 0x23, 0x21, 0xf4, 0xc9,
-	0x9e, 0x97, 0xa9, 0xf8, 0xe5, 0x9e, 0x27, 0x98, 0x5d, 0xa5, 0xa6, 0xe7, 0x15, 0x5c, 0xc9, 0x7a,
-	0xd0, 0x98, 0x44, 0x49, 0x9a, 0x6e, 0x0d, 0x2e, 0x9a, 0x2c, 0x53, 0xee, 0x5b, 0xce, 0x73, 0xd3,
-	0xce, 0x53, 0xc2, 0xcd, 0x1a, 0x61, 0xba, 0xc2, 0x1f, 0x17, 0xa6, 0x7b, 0xce, 0x2f, 0x7c, 0x08,
-	0xbe, 0x13, 0xee, 0x23, 0x57, 0x5a, 0x90, 0x13, 0x2b, 0xb0, 0xac, 0x5d, 0xa2, 0x2c, 0x0b, 0x59,
-	0xca, 0xa9, 0x9e, 0x06, 0x37, 0xeb, 0xc0, 0xad, 0x1f, 0x83, 0xc9, 0xa9, 0xbf, 0x00, 0xde, 0x3e,
-	0x01, 0x26, 0x27, 0x6b, 0xc1, 0x35, 0xaa, 0x3b, 0x10, 0x16, 0x6b, 0x38, 0x48, 0x91, 0x27, 0x6f,
-	0x13, 0xce, 0x6c, 0x69, 0xbb, 0xee, 0x43, 0x90, 0x09, 0x98, 0xf2, 0x69, 0x19, 0xd7, 0x31, 0x33,
-	0xcd, 0x35, 0xda, 0x64, 0xdb, 0x22, 0xb4, 0xc9, 0x99, 0xdf, 0xd7, 0xe6, 0xb0, 0xe6, 0xb4, 0x7b,
-	0x4a, 0x5a, 0xcd, 0xb8, 0xad, 0xb4, 0xe5, 0x21, 0xdc, 0xe4, 0xf9, 0xf9, 0xd9, 0x84, 0x2e, 0xaf,
-	0x92, 0xa8, 0x87, 0x90, 0x3f, 0x0b, 0x85, 0x90, 0x51, 0xf3, 0x42, 0x3e, 0x79, 0xb0, 0x52, 0xda,
-	0xcc, 0x4a, 0xb7, 0x1e, 0x40, 0x37, 0x5f, 0xd3, 0x33, 0x09, 0xd7, 0xf5, 0x6c, 0xbb, 0xdb, 0xe0,
-	0x9a, 0xf9, 0x9a, 0xf3, 0xcc, 0x47, 0x46, 0xff, 0xaf, 0xf3, 0xd1, 0x1b, 0xfd, 0xcb, 0xf9, 0x36,
-	0xe1, 0x9a, 0x1b, 0xef, 0xf9, 0xe0, 0x99, 0xe2, 0xcc, 0x2a, 0x4d, 0x8f, 0x7a, 0x15, 0xce, 0xa9,
-	0x43, 0x89, 0x34, 0x40, 0x16, 0xcc, 0x96, 0x93, 0xc7, 0x3f, 0x59, 0x4e, 0x57, 0xae, 0x2c, 0x7f,
-	0xfc, 0xfe, 0xf3, 0x38, 0xf4, 0x8e, 0xc7, 0xa1, 0xf7, 0x75, 0x1c, 0x7a, 0x1f, 0x27, 0x61, 0xe3,
-	0x78, 0x12, 0x36, 0xbe, 0x4c, 0xc2, 0x06, 0x74, 0x13, 0x15, 0x55, 0xff, 0x1a, 0x5e, 0x78, 0x3b,
-	0xf7, 0xf6, 0x12, 0xfb, 0xee, 0x60, 0x37, 0xe2, 0x6a, 0x18, 0x17, 0x45, 0x9b, 0x89, 0x2a, 0x45,
-	0xf1, 0x51, 0xf1, 0x1f, 0xb1, 0x1f, 0x52, 0x34, 0xbb, 0x4b, 0xee, 0x33, 0x72, 0xf7, 0x5b, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x6c, 0x3a, 0xe4, 0x3b, 0xb3, 0x08, 0x00, 0x00,
+func (m *EventRecordChanged) GetPreviousSessionAddr() string {
+	if m != nil {
+		return m.PreviousSessionAddr
+	}
+	return ""
 }
 
-func (m *EventTxCompleted) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventRecordChanged) GetPreviousOutputHashes() []string {
+	if m != nil {
+		return m.PreviousOutputHashes
 	}
-	return dAtA[:n], nil
-}
-
-func (m *EventTxCompleted) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return nil
 }
 
-func (m *EventTxCompleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signers) > 0 {
-		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Signers[iNdEx])
-			copy(dAtA[i:], m.Signers[iNdEx])
-			i = encodeVarintEvents(dAtA, i, uint64(len(m.Signers[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.Endpoint) > 0 {
-		i -= len(m.Endpoint)
-		copy(dAtA[i:], m.Endpoint)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Endpoint)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Module) > 0 {
-		i -= len(m.Module)
-		copy(dAtA[i:], m.Module)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Module)))
-		i--
-		dAtA[i] = 0xa
+func (m *EventRecordChanged) GetOutputHashes() []string {
+	if m != nil {
+		return m.OutputHashes
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *EventScopeCreated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventRecordChanged) GetSpecificationAddr() string {
+	if m != nil {
+		return m.SpecificationAddr
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventScopeCreated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventScopeSpecificationCreated is an event message indicating a scope specification has been created.
+type EventScopeSpecificationCreated struct {
+	// scope_specification_addr is the bech32 address string of the specification id of the scope specification that was
+	// created.
+	ScopeSpecificationAddr string `protobuf:"bytes,1,opt,name=scope_specification_addr,json=scopeSpecificationAddr,proto3" json:"scope_specification_addr,omitempty"`
 }
 
-func (m *EventScopeCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ScopeAddr) > 0 {
-		i -= len(m.ScopeAddr)
-		copy(dAtA[i:], m.ScopeAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
-		i--
-		dAtA[i] = 0xa
+func (m *EventScopeSpecificationCreated) Reset()         { *m = EventScopeSpecificationCreated{} }
+func (m *EventScopeSpecificationCreated) String() string { return proto.CompactTextString(m) }
+func (*EventScopeSpecificationCreated) ProtoMessage()    {}
+func (*EventScopeSpecificationCreated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_476cf6cf9459cf25, []int{20}
+}
+func (m *EventScopeSpecificationCreated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventScopeSpecificationCreated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventScopeSpecificationCreated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EventScopeSpecificationCreated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventScopeSpecificationCreated.Merge(m, src)
+}
+func (m *EventScopeSpecificationCreated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventScopeSpecificationCreated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventScopeSpecificationCreated.DiscardUnknown(m)
 }
 
-func (m *EventScopeUpdated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventScopeSpecificationCreated proto.InternalMessageInfo
+
+func (m *EventScopeSpecificationCreated) GetScopeSpecificationAddr() string {
+	if m != nil {
+		return m.ScopeSpecificationAddr
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventScopeUpdated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventScopeSpecificationUpdated is an event message indicating a scope specification has been updated.
+type EventScopeSpecificationUpdated struct {
+	// scope_specification_addr is the bech32 address string of the specification id of the scope specification that was
+	// updated.
+	ScopeSpecificationAddr string `protobuf:"bytes,1,opt,name=scope_specification_addr,json=scopeSpecificationAddr,proto3" json:"scope_specification_addr,omitempty"`
 }
 
-func (m *EventScopeUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ScopeAddr) > 0 {
-		i -= len(m.ScopeAddr)
-		copy(dAtA[i:], m.ScopeAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
-		i--
-		dAtA[i] = 0xa
+func (m *EventScopeSpecificationUpdated) Reset()         { *m = EventScopeSpecificationUpdated{} }
+func (m *EventScopeSpecificationUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventScopeSpecificationUpdated) ProtoMessage()    {}
+func (*EventScopeSpecificationUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_476cf6cf9459cf25, []int{21}
+}
+func (m *EventScopeSpecificationUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventScopeSpecificationUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventScopeSpecificationUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EventScopeSpecificationUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventScopeSpecificationUpdated.Merge(m, src)
+}
+func (m *EventScopeSpecificationUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventScopeSpecificationUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventScopeSpecificationUpdated.DiscardUnknown(m)
 }
 
-func (m *EventScopeDeleted) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventScopeSpecificationUpdated proto.InternalMessageInfo
+
+func (m *EventScopeSpecificationUpdated) GetScopeSpecificationAddr() string {
+	if m != nil {
+		return m.ScopeSpecificationAddr
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventScopeDeleted) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventScopeSpecificationDeleted is an event message indicating a scope specification has been deleted.
+type EventScopeSpecificationDeleted struct {
+	// scope_specification_addr is the bech32 address string of the specification id of the scope specification that was
+	// deleted.
+	ScopeSpecificationAddr string `protobuf:"bytes,1,opt,name=scope_specification_addr,json=scopeSpecificationAddr,proto3" json:"scope_specification_addr,omitempty"`
 }
 
-func (m *EventScopeDeleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ScopeAddr) > 0 {
-		i -= len(m.ScopeAddr)
-		copy(dAtA[i:], m.ScopeAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *EventScopeSpecificationDeleted) Reset()         { *m = EventScopeSpecificationDeleted{} }
+func (m *EventScopeSpecificationDeleted) String() string { return proto.CompactTextString(m) }
+func (*EventScopeSpecificationDeleted) ProtoMessage()    {}
+func (*EventScopeSpecificationDeleted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_476cf6cf9459cf25, []int{22}
 }
-
-func (m *EventSessionCreated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventScopeSpecificationDeleted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventScopeSpecificationDeleted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventScopeSpecificationDeleted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *EventSessionCreated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventScopeSpecificationDeleted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventScopeSpecificationDeleted.Merge(m, src)
+}
+func (m *EventScopeSpecificationDeleted) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventScopeSpecificationDeleted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventScopeSpecificationDeleted.DiscardUnknown(m)
 }
 
-func (m *EventSessionCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ScopeAddr) > 0 {
-		i -= len(m.ScopeAddr)
-		copy(dAtA[i:], m.ScopeAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.SessionAddr) > 0 {
-		i -= len(m.SessionAddr)
-		copy(dAtA[i:], m.SessionAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.SessionAddr)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_EventScopeSpecificationDeleted proto.InternalMessageInfo
+
+func (m *EventScopeSpecificationDeleted) GetScopeSpecificationAddr() string {
+	if m != nil {
+		return m.ScopeSpecificationAddr
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventSessionUpdated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// EventContractSpecificationCreated is an event message indicating a contract specification has been created.
+type EventContractSpecificationCreated struct {
+	// contract_specification_addr is the bech32 address string of the specification id of the contract specification that
+	// was created.
+	ContractSpecificationAddr string `protobuf:"bytes,1,opt,name=contract_specification_addr,json=contractSpecificationAddr,proto3" json:"contract_specification_addr,omitempty"`
 }
 
-func (m *EventSessionUpdated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventContractSpecificationCreated) Reset()         { *m = EventContractSpecificationCreated{} }
+func (m *EventContractSpecificationCreated) String() string { return proto.CompactTextString(m) }
+func (*EventContractSpecificationCreated) ProtoMessage()    {}
+func (*EventContractSpecificationCreated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_476cf6cf9459cf25, []int{23}
 }
-
-func (m *EventSessionUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ScopeAddr) > 0 {
-		i -= len(m.ScopeAddr)
-		copy(dAtA[i:], m.ScopeAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.SessionAddr) > 0 {
-		i -= len(m.SessionAddr)
-		copy(dAtA[i:], m.SessionAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.SessionAddr)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *EventContractSpecificationCreated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *EventSessionDeleted) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventContractSpecificationCreated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventContractSpecificationCreated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *EventSessionDeleted) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventContractSpecificationCreated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventContractSpecificationCreated.Merge(m, src)
 }
-
-func (m *EventSessionDeleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ScopeAddr) > 0 {
-		i -= len(m.ScopeAddr)
-		copy(dAtA[i:], m.ScopeAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.SessionAddr) > 0 {
-		i -= len(m.SessionAddr)
-		copy(dAtA[i:], m.SessionAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.SessionAddr)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *EventContractSpecificationCreated) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *EventRecordCreated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *EventContractSpecificationCreated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventContractSpecificationCreated.DiscardUnknown(m)
 }
 
-func (m *EventRecordCreated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+var xxx_messageInfo_EventContractSpecificationCreated proto.InternalMessageInfo
 
-func (m *EventRecordCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ScopeAddr) > 0 {
-		i -= len(m.ScopeAddr)
-		copy(dAtA[i:], m.ScopeAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.SessionAddr) > 0 {
-		i -= len(m.SessionAddr)
-		copy(dAtA[i:], m.SessionAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.SessionAddr)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.RecordAddr) > 0 {
-		i -= len(m.RecordAddr)
-		copy(dAtA[i:], m.RecordAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.RecordAddr)))
-		i--
-		dAtA[i] = 0xa
+func (m *EventContractSpecificationCreated) GetContractSpecificationAddr() string {
+	if m != nil {
+		return m.ContractSpecificationAddr
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventRecordUpdated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// EventContractSpecificationUpdated is an event message indicating a contract specification has been updated.
+type EventContractSpecificationUpdated struct {
+	// contract_specification_addr is the bech32 address string of the specification id of the contract specification that
+	// was updated.
+	ContractSpecificationAddr string `protobuf:"bytes,1,opt,name=contract_specification_addr,json=contractSpecificationAddr,proto3" json:"contract_specification_addr,omitempty"`
 }
 
-func (m *EventRecordUpdated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventContractSpecificationUpdated) Reset()         { *m = EventContractSpecificationUpdated{} }
+func (m *EventContractSpecificationUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventContractSpecificationUpdated) ProtoMessage()    {}
+func (*EventContractSpecificationUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_476cf6cf9459cf25, []int{24}
 }
-
-func (m *EventRecordUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ScopeAddr) > 0 {
-		i -= len(m.ScopeAddr)
-		copy(dAtA[i:], m.ScopeAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.SessionAddr) > 0 {
-		i -= len(m.SessionAddr)
-		copy(dAtA[i:], m.SessionAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.SessionAddr)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.RecordAddr) > 0 {
-		i -= len(m.RecordAddr)
-		copy(dAtA[i:], m.RecordAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.RecordAddr)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *EventContractSpecificationUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *EventRecordDeleted) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventContractSpecificationUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventContractSpecificationUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *EventRecordDeleted) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventContractSpecificationUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventContractSpecificationUpdated.Merge(m, src)
 }
-
-func (m *EventRecordDeleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ScopeAddr) > 0 {
-		i -= len(m.ScopeAddr)
-		copy(dAtA[i:], m.ScopeAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.RecordAddr) > 0 {
-		i -= len(m.RecordAddr)
-		copy(dAtA[i:], m.RecordAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.RecordAddr)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *EventContractSpecificationUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventContractSpecificationUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventContractSpecificationUpdated.DiscardUnknown(m)
 }
 
-func (m *EventScopeSpecificationCreated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventContractSpecificationUpdated proto.InternalMessageInfo
+
+func (m *EventContractSpecificationUpdated) GetContractSpecificationAddr() string {
+	if m != nil {
+		return m.ContractSpecificationAddr
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventScopeSpecificationCreated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventContractSpecificationDeleted is an event message indicating a contract specification has been deleted.
+type EventContractSpecificationDeleted struct {
+	// contract_specification_addr is the bech32 address string of the specification id of the contract specification that
+	// was deleted.
+	ContractSpecificationAddr string `protobuf:"bytes,1,opt,name=contract_specification_addr,json=contractSpecificationAddr,proto3" json:"contract_specification_addr,omitempty"`
 }
 
-func (m *EventScopeSpecificationCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ScopeSpecificationAddr) > 0 {
-		i -= len(m.ScopeSpecificationAddr)
-		copy(dAtA[i:], m.ScopeSpecificationAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeSpecificationAddr)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *EventContractSpecificationDeleted) Reset()         { *m = EventContractSpecificationDeleted{} }
+func (m *EventContractSpecificationDeleted) String() string { return proto.CompactTextString(m) }
+func (*EventContractSpecificationDeleted) ProtoMessage()    {}
+func (*EventContractSpecificationDeleted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_476cf6cf9459cf25, []int{25}
 }
-
-func (m *EventScopeSpecificationUpdated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventContractSpecificationDeleted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventContractSpecificationDeleted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventContractSpecificationDeleted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *EventScopeSpecificationUpdated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventContractSpecificationDeleted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventContractSpecificationDeleted.Merge(m, src)
 }
-
-func (m *EventScopeSpecificationUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ScopeSpecificationAddr) > 0 {
-		i -= len(m.ScopeSpecificationAddr)
-		copy(dAtA[i:], m.ScopeSpecificationAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeSpecificationAddr)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *EventContractSpecificationDeleted) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventContractSpecificationDeleted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventContractSpecificationDeleted.DiscardUnknown(m)
 }
 
-func (m *EventScopeSpecificationDeleted) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventContractSpecificationDeleted proto.InternalMessageInfo
+
+func (m *EventContractSpecificationDeleted) GetContractSpecificationAddr() string {
+	if m != nil {
+		return m.ContractSpecificationAddr
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventScopeSpecificationDeleted) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventRecordSpecificationCreated is an event message indicating a record specification has been created.
+type EventRecordSpecificationCreated struct {
+	// record_specification_addr is the bech32 address string of the specification id of the record specification that was
+	// created.
+	RecordSpecificationAddr string `protobuf:"bytes,1,opt,name=record_specification_addr,json=recordSpecificationAddr,proto3" json:"record_specification_addr,omitempty"`
+	// contract_specification_addr is the bech32 address string of the contract specification id this record specification
+	// belongs to.
+	ContractSpecificationAddr string `protobuf:"bytes,2,opt,name=contract_specification_addr,json=contractSpecificationAddr,proto3" json:"contract_specification_addr,omitempty"`
 }
 
-func (m *EventScopeSpecificationDeleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ScopeSpecificationAddr) > 0 {
-		i -= len(m.ScopeSpecificationAddr)
-		copy(dAtA[i:], m.ScopeSpecificationAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeSpecificationAddr)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *EventRecordSpecificationCreated) Reset()         { *m = EventRecordSpecificationCreated{} }
+func (m *EventRecordSpecificationCreated) String() string { return proto.CompactTextString(m) }
+func (*EventRecordSpecificationCreated) ProtoMessage()    {}
+func (*EventRecordSpecificationCreated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_476cf6cf9459cf25, []int{26}
 }
-
-func (m *EventContractSpecificationCreated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventRecordSpecificationCreated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRecordSpecificationCreated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRecordSpecificationCreated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *EventContractSpecificationCreated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventRecordSpecificationCreated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRecordSpecificationCreated.Merge(m, src)
+}
+func (m *EventRecordSpecificationCreated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRecordSpecificationCreated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRecordSpecificationCreated.DiscardUnknown(m)
 }
 
-func (m *EventContractSpecificationCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ContractSpecificationAddr) > 0 {
-		i -= len(m.ContractSpecificationAddr)
-		copy(dAtA[i:], m.ContractSpecificationAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractSpecificationAddr)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_EventRecordSpecificationCreated proto.InternalMessageInfo
+
+func (m *EventRecordSpecificationCreated) GetRecordSpecificationAddr() string {
+	if m != nil {
+		return m.RecordSpecificationAddr
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventContractSpecificationUpdated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventRecordSpecificationCreated) GetContractSpecificationAddr() string {
+	if m != nil {
+		return m.ContractSpecificationAddr
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventContractSpecificationUpdated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventRecordSpecificationUpdated is an event message indicating a record specification has been updated.
+type EventRecordSpecificationUpdated struct {
+	// record_specification_addr is the bech32 address string of the specification id of the record specification that was
+	// updated.
+	RecordSpecificationAddr string `protobuf:"bytes,1,opt,name=record_specification_addr,json=recordSpecificationAddr,proto3" json:"record_specification_addr,omitempty"`
+	// contract_specification_addr is the bech32 address string of the contract specification id this record specification
+	// belongs to.
+	ContractSpecificationAddr string `protobuf:"bytes,2,opt,name=contract_specification_addr,json=contractSpecificationAddr,proto3" json:"contract_specification_addr,omitempty"`
 }
 
-func (m *EventContractSpecificationUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ContractSpecificationAddr) > 0 {
-		i -= len(m.ContractSpecificationAddr)
-		copy(dAtA[i:], m.ContractSpecificationAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractSpecificationAddr)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *EventRecordSpecificationUpdated) Reset()         { *m = EventRecordSpecificationUpdated{} }
+func (m *EventRecordSpecificationUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventRecordSpecificationUpdated) ProtoMessage()    {}
+func (*EventRecordSpecificationUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_476cf6cf9459cf25, []int{27}
 }
-
-func (m *EventContractSpecificationDeleted) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventRecordSpecificationUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRecordSpecificationUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRecordSpecificationUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *EventContractSpecificationDeleted) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventRecordSpecificationUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRecordSpecificationUpdated.Merge(m, src)
+}
+func (m *EventRecordSpecificationUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRecordSpecificationUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRecordSpecificationUpdated.DiscardUnknown(m)
 }
 
-func (m *EventContractSpecificationDeleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ContractSpecificationAddr) > 0 {
-		i -= len(m.ContractSpecificationAddr)
-		copy(dAtA[i:], m.ContractSpecificationAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractSpecificationAddr)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_EventRecordSpecificationUpdated proto.InternalMessageInfo
+
+func (m *EventRecordSpecificationUpdated) GetRecordSpecificationAddr() string {
+	if m != nil {
+		return m.RecordSpecificationAddr
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventRecordSpecificationCreated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventRecordSpecificationUpdated) GetContractSpecificationAddr() string {
+	if m != nil {
+		return m.ContractSpecificationAddr
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventRecordSpecificationCreated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *EventRecordSpecificationCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ContractSpecificationAddr) > 0 {
-		i -= len(m.ContractSpecificationAddr)
-		copy(dAtA[i:], m.ContractSpecificationAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractSpecificationAddr)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.RecordSpecificationAddr) > 0 {
-		i -= len(m.RecordSpecificationAddr)
-		copy(dAtA[i:], m.RecordSpecificationAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.RecordSpecificationAddr)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// EventRecordSpecificationDeleted is an event message indicating a record specification has been deleted.
+type EventRecordSpecificationDeleted struct {
+	// record_specification_addr is the bech32 address string of the specification id of the record specification that was
+	// deleted.
+	RecordSpecificationAddr string `protobuf:"bytes,1,opt,name=record_specification_addr,json=recordSpecificationAddr,proto3" json:"record_specification_addr,omitempty"`
+	// contract_specification_addr is the bech32 address string of the contract specification id this record specification
+	// belongs to.
+	ContractSpecificationAddr string `protobuf:"bytes,2,opt,name=contract_specification_addr,json=contractSpecificationAddr,proto3" json:"contract_specification_addr,omitempty"`
 }
 
-func (m *EventRecordSpecificationUpdated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventRecordSpecificationDeleted) Reset()         { *m = EventRecordSpecificationDeleted{} }
+func (m *EventRecordSpecificationDeleted) String() string { return proto.CompactTextString(m) }
+func (*EventRecordSpecificationDeleted) ProtoMessage()    {}
+func (*EventRecordSpecificationDeleted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_476cf6cf9459cf25, []int{28}
+}
+func (m *EventRecordSpecificationDeleted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRecordSpecificationDeleted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRecordSpecificationDeleted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *EventRecordSpecificationUpdated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventRecordSpecificationDeleted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRecordSpecificationDeleted.Merge(m, src)
+}
+func (m *EventRecordSpecificationDeleted) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRecordSpecificationDeleted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRecordSpecificationDeleted.DiscardUnknown(m)
 }
 
-func (m *EventRecordSpecificationUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ContractSpecificationAddr) > 0 {
-		i -= len(m.ContractSpecificationAddr)
-		copy(dAtA[i:], m.ContractSpecificationAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractSpecificationAddr)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.RecordSpecificationAddr) > 0 {
-		i -= len(m.RecordSpecificationAddr)
-		copy(dAtA[i:], m.RecordSpecificationAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.RecordSpecificationAddr)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_EventRecordSpecificationDeleted proto.InternalMessageInfo
+
+func (m *EventRecordSpecificationDeleted) GetRecordSpecificationAddr() string {
+	if m != nil {
+		return m.RecordSpecificationAddr
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventRecordSpecificationDeleted) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventRecordSpecificationDeleted) GetContractSpecificationAddr() string {
+	if m != nil {
+		return m.ContractSpecificationAddr
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventRecordSpecificationDeleted) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventOSLocatorCreated is an event message indicating an object store locator has been created.
+type EventOSLocatorCreated struct {
+	// owner is the owner in the object store locator that was created.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// name is the name of the object store locator that was created.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (m *EventRecordSpecificationDeleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ContractSpecificationAddr) > 0 {
-		i -= len(m.ContractSpecificationAddr)
-		copy(dAtA[i:], m.ContractSpecificationAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractSpecificationAddr)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.RecordSpecificationAddr) > 0 {
-		i -= len(m.RecordSpecificationAddr)
-		copy(dAtA[i:], m.RecordSpecificationAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.RecordSpecificationAddr)))
-		i--
-		dAtA[i] = 0xa
+func (m *EventOSLocatorCreated) Reset()         { *m = EventOSLocatorCreated{} }
+func (m *EventOSLocatorCreated) String() string { return proto.CompactTextString(m) }
+func (*EventOSLocatorCreated) ProtoMessage()    {}
+func (*EventOSLocatorCreated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_476cf6cf9459cf25, []int{29}
+}
+func (m *EventOSLocatorCreated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventOSLocatorCreated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventOSLocatorCreated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EventOSLocatorCreated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventOSLocatorCreated.Merge(m, src)
+}
+func (m *EventOSLocatorCreated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventOSLocatorCreated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventOSLocatorCreated.DiscardUnknown(m)
 }
 
-func (m *EventOSLocatorCreated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventOSLocatorCreated proto.InternalMessageInfo
+
+func (m *EventOSLocatorCreated) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventOSLocatorCreated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventOSLocatorCreated) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
 }
 
-func (m *EventOSLocatorCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// EventOSLocatorUpdated is an event message indicating an object store locator has been updated.
+type EventOSLocatorUpdated struct {
+	// owner is the owner in the object store locator that was updated.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// name is the name of the object store locator that was updated.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (m *EventOSLocatorUpdated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventOSLocatorUpdated) Reset()         { *m = EventOSLocatorUpdated{} }
+func (m *EventOSLocatorUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventOSLocatorUpdated) ProtoMessage()    {}
+func (*EventOSLocatorUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_476cf6cf9459cf25, []int{30}
+}
+func (m *EventOSLocatorUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventOSLocatorUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventOSLocatorUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *EventOSLocatorUpdated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventOSLocatorUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventOSLocatorUpdated.Merge(m, src)
+}
+func (m *EventOSLocatorUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventOSLocatorUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventOSLocatorUpdated.DiscardUnknown(m)
 }
 
-func (m *EventOSLocatorUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_EventOSLocatorUpdated proto.InternalMessageInfo
+
+func (m *EventOSLocatorUpdated) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventOSLocatorDeleted) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventOSLocatorUpdated) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventOSLocatorDeleted) MarshalTo(dAtA []byte) (int, error) {
+// EventOSLocatorDeleted is an event message indicating an object store locator has been deleted.
+type EventOSLocatorDeleted struct {
+	// owner is the owner in the object store locator that was deleted.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// name is the name of the object store locator that was deleted.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *EventOSLocatorDeleted) Reset()         { *m = EventOSLocatorDeleted{} }
+func (m *EventOSLocatorDeleted) String() string { return proto.CompactTextString(m) }
+func (*EventOSLocatorDeleted) ProtoMessage()    {}
+func (*EventOSLocatorDeleted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_476cf6cf9459cf25, []int{31}
+}
+func (m *EventOSLocatorDeleted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventOSLocatorDeleted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventOSLocatorDeleted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventOSLocatorDeleted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventOSLocatorDeleted.Merge(m, src)
+}
+func (m *EventOSLocatorDeleted) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventOSLocatorDeleted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventOSLocatorDeleted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventOSLocatorDeleted proto.InternalMessageInfo
+
+func (m *EventOSLocatorDeleted) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *EventOSLocatorDeleted) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*EventTxCompleted)(nil), "provenance.metadata.v1.EventTxCompleted")
+	proto.RegisterType((*EventScopeCreated)(nil), "provenance.metadata.v1.EventScopeCreated")
+	proto.RegisterType((*EventScopeUpdated)(nil), "provenance.metadata.v1.EventScopeUpdated")
+	proto.RegisterType((*EventScopeDeleted)(nil), "provenance.metadata.v1.EventScopeDeleted")
+	proto.RegisterType((*EventScopeValueOwnerTokenized)(nil), "provenance.metadata.v1.EventScopeValueOwnerTokenized")
+	proto.RegisterType((*EventScopeSaleOffered)(nil), "provenance.metadata.v1.EventScopeSaleOffered")
+	proto.RegisterType((*EventScopeSaleCancelled)(nil), "provenance.metadata.v1.EventScopeSaleCancelled")
+	proto.RegisterType((*EventScopeSold)(nil), "provenance.metadata.v1.EventScopeSold")
+	proto.RegisterType((*EventScopeSpecificationMigrated)(nil), "provenance.metadata.v1.EventScopeSpecificationMigrated")
+	proto.RegisterType((*EventScopeDataAccessExpired)(nil), "provenance.metadata.v1.EventScopeDataAccessExpired")
+	proto.RegisterType((*EventScopeBundleWritten)(nil), "provenance.metadata.v1.EventScopeBundleWritten")
+	proto.RegisterType((*EventScopeChanged)(nil), "provenance.metadata.v1.EventScopeChanged")
+	proto.RegisterType((*EventSessionCreated)(nil), "provenance.metadata.v1.EventSessionCreated")
+	proto.RegisterType((*EventSessionUpdated)(nil), "provenance.metadata.v1.EventSessionUpdated")
+	proto.RegisterType((*EventSessionDeleted)(nil), "provenance.metadata.v1.EventSessionDeleted")
+	proto.RegisterType((*EventSessionChanged)(nil), "provenance.metadata.v1.EventSessionChanged")
+	proto.RegisterType((*EventRecordCreated)(nil), "provenance.metadata.v1.EventRecordCreated")
+	proto.RegisterType((*EventRecordUpdated)(nil), "provenance.metadata.v1.EventRecordUpdated")
+	proto.RegisterType((*EventRecordDeleted)(nil), "provenance.metadata.v1.EventRecordDeleted")
+	proto.RegisterType((*EventRecordChanged)(nil), "provenance.metadata.v1.EventRecordChanged")
+	proto.RegisterType((*EventScopeSpecificationCreated)(nil), "provenance.metadata.v1.EventScopeSpecificationCreated")
+	proto.RegisterType((*EventScopeSpecificationUpdated)(nil), "provenance.metadata.v1.EventScopeSpecificationUpdated")
+	proto.RegisterType((*EventScopeSpecificationDeleted)(nil), "provenance.metadata.v1.EventScopeSpecificationDeleted")
+	proto.RegisterType((*EventContractSpecificationCreated)(nil), "provenance.metadata.v1.EventContractSpecificationCreated")
+	proto.RegisterType((*EventContractSpecificationUpdated)(nil), "provenance.metadata.v1.EventContractSpecificationUpdated")
+	proto.RegisterType((*EventContractSpecificationDeleted)(nil), "provenance.metadata.v1.EventContractSpecificationDeleted")
+	proto.RegisterType((*EventRecordSpecificationCreated)(nil), "provenance.metadata.v1.EventRecordSpecificationCreated")
+	proto.RegisterType((*EventRecordSpecificationUpdated)(nil), "provenance.metadata.v1.EventRecordSpecificationUpdated")
+	proto.RegisterType((*EventRecordSpecificationDeleted)(nil), "provenance.metadata.v1.EventRecordSpecificationDeleted")
+	proto.RegisterType((*EventOSLocatorCreated)(nil), "provenance.metadata.v1.EventOSLocatorCreated")
+	proto.RegisterType((*EventOSLocatorUpdated)(nil), "provenance.metadata.v1.EventOSLocatorUpdated")
+	proto.RegisterType((*EventOSLocatorDeleted)(nil), "provenance.metadata.v1.EventOSLocatorDeleted")
+}
+
+func init() {
+	proto.RegisterFile("provenance/metadata/v1/events.proto", fileDescriptor_476cf6cf9459cf25)
+}
+
+var fileDescriptor_476cf6cf9459cf25 = []byte{
+	// 1120 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcd, 0x4e, 0x1c, 0x47,
+	0x17, 0x65, 0x66, 0xf8, 0x31, 0x17, 0xb0, 0x3f, 0x9a, 0x01, 0x1a, 0x2c, 0x06, 0x33, 0x2c, 0x3e,
+	0x2b, 0x92, 0x67, 0x62, 0xc2, 0xc2, 0xca, 0x22, 0x12, 0x10, 0x27, 0x56, 0xe4, 0x04, 0x04, 0x24,
+	0x96, 0x2c, 0x45, 0x93, 0xa2, 0xfb, 0x32, 0x74, 0xdc, 0xd3, 0xd5, 0xaa, 0xaa, 0x1e, 0xc0, 0xab,
+	0x2c, 0xf2, 0x00, 0x79, 0x81, 0xbc, 0x41, 0xb6, 0x79, 0x07, 0x2f, 0xbd, 0x4b, 0x56, 0x51, 0x04,
+	0x2f, 0x91, 0x65, 0xd4, 0xf5, 0x33, 0xdd, 0x3d, 0x3f, 0xea, 0xc1, 0x13, 0x9c, 0xec, 0xa8, 0xaa,
+	0x7b, 0xcf, 0xb9, 0x75, 0xea, 0xdc, 0xaa, 0x66, 0x60, 0x33, 0x64, 0xb4, 0x8d, 0x01, 0x09, 0x1c,
+	0xac, 0xb7, 0x50, 0x10, 0x97, 0x08, 0x52, 0x6f, 0x3f, 0xae, 0x63, 0x1b, 0x03, 0xc1, 0x6b, 0x21,
+	0xa3, 0x82, 0x5a, 0x4b, 0x49, 0x50, 0xcd, 0x04, 0xd5, 0xda, 0x8f, 0x57, 0xcb, 0x4d, 0xda, 0xa4,
+	0x32, 0xa4, 0x1e, 0xff, 0xa5, 0xa2, 0x57, 0xab, 0x03, 0x20, 0xb9, 0x43, 0x43, 0x54, 0x31, 0xd5,
+	0xef, 0xe0, 0x7f, 0x4f, 0x63, 0x86, 0xe3, 0x8b, 0x3d, 0xda, 0x0a, 0x7d, 0x14, 0xe8, 0x5a, 0x4b,
+	0x30, 0xd9, 0xa2, 0x6e, 0xe4, 0xa3, 0x5d, 0x78, 0x50, 0x78, 0x38, 0x7d, 0xa8, 0x47, 0xd6, 0x2a,
+	0xdc, 0xc1, 0xc0, 0x0d, 0xa9, 0x17, 0x08, 0xbb, 0x28, 0x57, 0x3a, 0x63, 0xcb, 0x86, 0x29, 0xee,
+	0x35, 0x03, 0x64, 0xdc, 0x2e, 0x3d, 0x28, 0x3d, 0x9c, 0x3e, 0x34, 0xc3, 0xea, 0x16, 0xcc, 0x4b,
+	0x86, 0xa3, 0x98, 0x75, 0x8f, 0x21, 0x89, 0x29, 0xd6, 0x00, 0x64, 0x15, 0x0d, 0xe2, 0xba, 0x4c,
+	0xd3, 0x4c, 0xcb, 0x99, 0x1d, 0xd7, 0x65, 0xd9, 0x9c, 0xaf, 0x43, 0xf7, 0xc6, 0x39, 0x9f, 0xa2,
+	0x8f, 0x43, 0xe4, 0xfc, 0x58, 0x80, 0xb5, 0x24, 0xe9, 0x1b, 0xe2, 0x47, 0xb8, 0x7f, 0x1e, 0x20,
+	0x3b, 0xa6, 0xaf, 0x30, 0xf0, 0x5e, 0xe7, 0x02, 0x58, 0x65, 0x98, 0x70, 0x31, 0xa0, 0x2d, 0xad,
+	0x87, 0x1a, 0xc4, 0x02, 0xf2, 0x28, 0x0c, 0xfd, 0x4b, 0xbb, 0xa4, 0x04, 0x54, 0xa3, 0x78, 0xfe,
+	0x8c, 0xfa, 0x2e, 0x32, 0x7b, 0x5c, 0xcd, 0xab, 0x51, 0xf5, 0x35, 0x2c, 0x26, 0x55, 0x1c, 0x11,
+	0x1f, 0xf7, 0x4f, 0x4f, 0x91, 0xe5, 0xb3, 0xc7, 0x3c, 0xe8, 0xfb, 0xc8, 0x34, 0xbd, 0x1e, 0xc5,
+	0x55, 0x9d, 0x44, 0x97, 0xc8, 0x34, 0xbd, 0x1a, 0xc4, 0xb3, 0x21, 0xf3, 0x1c, 0xd4, 0xe4, 0x6a,
+	0x50, 0x7d, 0x02, 0xcb, 0x59, 0xee, 0xbd, 0xd8, 0x2f, 0xbe, 0x9f, 0x2f, 0x1e, 0x87, 0xbb, 0xa9,
+	0x4c, 0xea, 0xbf, 0x97, 0x72, 0x7f, 0x29, 0xc0, 0x7a, 0x8a, 0x35, 0x44, 0xc7, 0x3b, 0xf5, 0x1c,
+	0x22, 0x3c, 0x1a, 0x7c, 0xe9, 0x35, 0xd9, 0x10, 0x46, 0xb1, 0xb6, 0x61, 0x89, 0xfa, 0x6e, 0x83,
+	0xa7, 0x73, 0x55, 0xa8, 0x2a, 0xab, 0x4c, 0x7d, 0x37, 0x03, 0x6c, 0xb2, 0x02, 0x3c, 0xef, 0x97,
+	0xa5, 0xaa, 0x2e, 0x07, 0x78, 0xde, 0x93, 0x55, 0xfd, 0x16, 0xee, 0xa7, 0x4c, 0x49, 0x04, 0xd9,
+	0x71, 0x1c, 0xe4, 0xfc, 0xe9, 0x45, 0xe8, 0x0d, 0x71, 0xbe, 0xeb, 0x30, 0x13, 0xf7, 0x6c, 0x83,
+	0xc8, 0x24, 0xbb, 0x28, 0x1b, 0x0b, 0xdc, 0x0e, 0x4c, 0xf5, 0x87, 0x42, 0xfa, 0xf4, 0x76, 0xa3,
+	0xc0, 0xf5, 0xf1, 0x05, 0xf3, 0x84, 0xc0, 0x20, 0x0f, 0x7b, 0x13, 0xe6, 0x38, 0x72, 0x1e, 0xef,
+	0xc2, 0xa1, 0x91, 0xee, 0xe8, 0xb9, 0xc3, 0x59, 0x3d, 0xb9, 0x17, 0xcf, 0x59, 0x1b, 0x30, 0xcb,
+	0xd0, 0xa1, 0xcc, 0xd5, 0x31, 0x25, 0x19, 0x33, 0xa3, 0xe6, 0x64, 0x48, 0xf5, 0xaf, 0xf1, 0x4c,
+	0x7f, 0x9f, 0x91, 0xa0, 0x99, 0xbf, 0xb1, 0xcf, 0x60, 0x96, 0xb8, 0x2e, 0xba, 0x0d, 0x7a, 0x2e,
+	0xaf, 0x8c, 0x78, 0x67, 0x33, 0x5b, 0x6b, 0xb5, 0xfe, 0xd7, 0x5b, 0xed, 0x80, 0x30, 0x71, 0xb9,
+	0x3b, 0xfe, 0xe6, 0x8f, 0xf5, 0xb1, 0xc3, 0x19, 0x99, 0x28, 0xbb, 0x94, 0x5b, 0x5f, 0xc0, 0x5d,
+	0x86, 0x2d, 0xda, 0x4e, 0x90, 0x4a, 0xc3, 0x23, 0xcd, 0xe9, 0x54, 0x8d, 0xf5, 0x21, 0x94, 0x43,
+	0x86, 0x6d, 0x8f, 0x46, 0xbc, 0xd1, 0x8e, 0x6f, 0x02, 0x05, 0xa9, 0xed, 0x67, 0x99, 0xb5, 0xe4,
+	0x92, 0x88, 0x8f, 0x27, 0x1d, 0x38, 0x21, 0x03, 0xa1, 0x9d, 0x04, 0x7c, 0x00, 0xf3, 0x6a, 0x9b,
+	0xe9, 0x53, 0x9c, 0x94, 0xa7, 0x78, 0x4f, 0x2e, 0x24, 0x8e, 0xb0, 0x6a, 0xb0, 0x60, 0xb6, 0x92,
+	0x8e, 0x9e, 0x92, 0xd1, 0xf3, 0x7a, 0x29, 0x15, 0xff, 0x08, 0xac, 0x3e, 0x5e, 0xbc, 0x23, 0x6b,
+	0x98, 0xe7, 0x3d, 0xf6, 0x3d, 0x05, 0xbb, 0xa7, 0x94, 0x46, 0x93, 0x91, 0x40, 0x70, 0x7b, 0x5a,
+	0x6a, 0xf6, 0xff, 0x41, 0x9a, 0x25, 0xa4, 0x9f, 0xc7, 0xf1, 0x5a, 0xbd, 0xc5, 0xae, 0x0d, 0xc8,
+	0x35, 0x6e, 0x7d, 0x0f, 0xab, 0x7d, 0xb6, 0x61, 0x98, 0xe0, 0x5d, 0x98, 0x96, 0x7b, 0x36, 0xaf,
+	0xb8, 0xaa, 0x2f, 0x60, 0x41, 0x39, 0x4f, 0x5b, 0x56, 0xbf, 0x2d, 0x1b, 0x60, 0x4c, 0x9c, 0x76,
+	0xdf, 0x8c, 0x9e, 0x93, 0x6a, 0x64, 0xed, 0x59, 0xec, 0xbe, 0xd9, 0xba, 0x80, 0xcd, 0x03, 0xf4,
+	0x8f, 0x03, 0x9b, 0x57, 0x6a, 0x74, 0xe0, 0xdf, 0x8a, 0x5d, 0x5a, 0xe8, 0x3e, 0x1c, 0x19, 0xd9,
+	0x7a, 0x06, 0x73, 0xca, 0x38, 0x21, 0x61, 0xc2, 0xc3, 0x1b, 0x75, 0x98, 0x6a, 0xf2, 0x03, 0x95,
+	0x68, 0x3d, 0x87, 0x7b, 0xc6, 0x1a, 0x06, 0x6b, 0x7c, 0x78, 0x2c, 0xd3, 0xe8, 0x06, 0x6d, 0x13,
+	0xe6, 0x3a, 0xed, 0x1a, 0x90, 0x16, 0xea, 0xf6, 0x9b, 0x35, 0x93, 0x5f, 0x91, 0x16, 0x5a, 0x16,
+	0x8c, 0xcb, 0xb5, 0x49, 0xb9, 0x26, 0xff, 0x1e, 0xd0, 0x38, 0x53, 0x03, 0x1a, 0xa7, 0x7a, 0x0e,
+	0x96, 0x14, 0xf6, 0x50, 0xdd, 0x79, 0xda, 0x63, 0xeb, 0xa0, 0x2f, 0xc1, 0xb4, 0xac, 0xa0, 0xa6,
+	0xa4, 0x6c, 0xdd, 0xc2, 0x17, 0xf3, 0x84, 0x2f, 0x75, 0x1f, 0x69, 0x96, 0xd8, 0x78, 0xf0, 0x3d,
+	0x10, 0x1f, 0x67, 0x88, 0x8d, 0x47, 0x73, 0x89, 0x73, 0x50, 0x7f, 0x2d, 0x66, 0x85, 0xd4, 0x06,
+	0xbd, 0xfd, 0xfd, 0x58, 0x5b, 0xb0, 0xd8, 0x71, 0x4a, 0x06, 0x4a, 0xdd, 0xec, 0x0b, 0x66, 0xf1,
+	0x28, 0x05, 0xb9, 0x0d, 0x4b, 0x9d, 0x1c, 0x1a, 0x89, 0x30, 0x12, 0x8d, 0x33, 0xc2, 0xcf, 0x90,
+	0xdb, 0x13, 0xf2, 0x42, 0xee, 0x3c, 0x15, 0xfb, 0x72, 0xf1, 0x99, 0x5c, 0x8b, 0x3d, 0x99, 0x0d,
+	0x56, 0x77, 0xfd, 0x2c, 0x4d, 0x07, 0xdd, 0xd0, 0x7f, 0x2f, 0xa1, 0x32, 0xe0, 0x7b, 0xc7, 0x78,
+	0xf1, 0x09, 0xd8, 0x6a, 0xfb, 0x7d, 0x60, 0x95, 0x9e, 0x4b, 0xbc, 0x27, 0x39, 0x07, 0xdb, 0xd8,
+	0xed, 0x36, 0xb0, 0x8d, 0xa3, 0xde, 0x1d, 0xdb, 0x81, 0x0d, 0x89, 0xbd, 0x47, 0x03, 0xc1, 0x88,
+	0x23, 0xfa, 0xca, 0xf2, 0x09, 0xdc, 0x77, 0xf4, 0xfa, 0x60, 0x86, 0x15, 0xa7, 0x1f, 0x44, 0x3e,
+	0x89, 0xd1, 0xe7, 0x56, 0x49, 0x8c, 0x50, 0xa3, 0x92, 0xfc, 0x6c, 0xbe, 0x99, 0x55, 0xeb, 0xf5,
+	0x55, 0xeb, 0x63, 0x58, 0xd1, 0x7d, 0x38, 0x90, 0x61, 0x99, 0xf5, 0xa6, 0xcb, 0x66, 0xc9, 0xa9,
+	0xaf, 0x38, 0x4a, 0x7d, 0x46, 0xe8, 0xff, 0x6a, 0x7d, 0xe6, 0x8c, 0xfe, 0xcd, 0xfa, 0x76, 0xf4,
+	0xbf, 0x8f, 0xfb, 0x47, 0xcf, 0xa9, 0x43, 0x04, 0x65, 0xe6, 0x50, 0xcb, 0x30, 0xa1, 0x3e, 0x4d,
+	0x55, 0x01, 0x6a, 0xd0, 0x79, 0x14, 0x8b, 0xc9, 0xa3, 0xd8, 0x0b, 0x61, 0x74, 0x1f, 0x01, 0xc2,
+	0x48, 0x33, 0x34, 0xc4, 0xee, 0xab, 0x37, 0x57, 0x95, 0xc2, 0xdb, 0xab, 0x4a, 0xe1, 0xcf, 0xab,
+	0x4a, 0xe1, 0xa7, 0xeb, 0xca, 0xd8, 0xdb, 0xeb, 0xca, 0xd8, 0xef, 0xd7, 0x95, 0x31, 0x58, 0xf1,
+	0xe8, 0x80, 0x8f, 0x84, 0x83, 0xc2, 0xcb, 0xed, 0xa6, 0x27, 0xce, 0xa2, 0x93, 0x9a, 0x43, 0x5b,
+	0xf5, 0x24, 0xe8, 0x91, 0x47, 0x53, 0xa3, 0xfa, 0x45, 0xf2, 0x13, 0x88, 0xb8, 0x0c, 0x91, 0x9f,
+	0x4c, 0xca, 0x1f, 0x40, 0x3e, 0xfa, 0x7b, 0x00, 0x16, 0xf0, 0x2e, 0x9a, 0x79, 0x11, 0x00, 0x00,
+}
+
+func (m *EventTxCompleted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventTxCompleted) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventOSLocatorDeleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventTxCompleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Owner)))
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Endpoint) > 0 {
+		i -= len(m.Endpoint)
+		copy(dAtA[i:], m.Endpoint)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Endpoint)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Module)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvents(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EventScopeCreated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *EventTxCompleted) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Module)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.Endpoint)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovEvents(uint64(l))
-		}
-	}
-	return n
+
+func (m *EventScopeCreated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventScopeCreated) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EventScopeCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ScopeAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.ScopeAddr) > 0 {
+		i -= len(m.ScopeAddr)
+		copy(dAtA[i:], m.ScopeAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventScopeUpdated) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ScopeAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+func (m *EventScopeUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EventScopeDeleted) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EventScopeUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventScopeUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ScopeAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.ScopeAddr) > 0 {
+		i -= len(m.ScopeAddr)
+		copy(dAtA[i:], m.ScopeAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventSessionCreated) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventScopeDeleted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventScopeDeleted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventScopeDeleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.SessionAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.ScopeAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.ScopeAddr) > 0 {
+		i -= len(m.ScopeAddr)
+		copy(dAtA[i:], m.ScopeAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventSessionUpdated) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.SessionAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.ScopeAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+func (m *EventScopeValueOwnerTokenized) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EventSessionDeleted) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.SessionAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.ScopeAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	return n
+func (m *EventScopeValueOwnerTokenized) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventRecordCreated) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EventScopeValueOwnerTokenized) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.RecordAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.SessionAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Supply) > 0 {
+		i -= len(m.Supply)
+		copy(dAtA[i:], m.Supply)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Supply)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.ScopeAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.ScopeAddr) > 0 {
+		i -= len(m.ScopeAddr)
+		copy(dAtA[i:], m.ScopeAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventRecordUpdated) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.RecordAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.SessionAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.ScopeAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+func (m *EventScopeSaleOffered) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EventRecordDeleted) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EventScopeSaleOffered) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventScopeSaleOffered) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.RecordAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Price) > 0 {
+		i -= len(m.Price)
+		copy(dAtA[i:], m.Price)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Price)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.ScopeAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
-}
-
-func (m *EventScopeSpecificationCreated) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Seller) > 0 {
+		i -= len(m.Seller)
+		copy(dAtA[i:], m.Seller)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Seller)))
+		i--
+		dAtA[i] = 0x12
 	}
-	var l int
-	_ = l
-	l = len(m.ScopeSpecificationAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.ScopeAddr) > 0 {
+		i -= len(m.ScopeAddr)
+		copy(dAtA[i:], m.ScopeAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventScopeSpecificationUpdated) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ScopeSpecificationAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+func (m *EventScopeSaleCancelled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EventScopeSpecificationDeleted) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ScopeSpecificationAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	return n
+func (m *EventScopeSaleCancelled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventContractSpecificationCreated) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EventScopeSaleCancelled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ContractSpecificationAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.ScopeAddr) > 0 {
+		i -= len(m.ScopeAddr)
+		copy(dAtA[i:], m.ScopeAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventContractSpecificationUpdated) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ContractSpecificationAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+func (m *EventScopeSold) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EventContractSpecificationDeleted) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ContractSpecificationAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	return n
+func (m *EventScopeSold) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventRecordSpecificationCreated) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EventScopeSold) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.RecordSpecificationAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Price) > 0 {
+		i -= len(m.Price)
+		copy(dAtA[i:], m.Price)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Price)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.ContractSpecificationAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if len(m.Seller) > 0 {
+		i -= len(m.Seller)
+		copy(dAtA[i:], m.Seller)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Seller)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ScopeAddr) > 0 {
+		i -= len(m.ScopeAddr)
+		copy(dAtA[i:], m.ScopeAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventRecordSpecificationUpdated) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventScopeSpecificationMigrated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventScopeSpecificationMigrated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventScopeSpecificationMigrated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.RecordSpecificationAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.NewSpecificationAddr) > 0 {
+		i -= len(m.NewSpecificationAddr)
+		copy(dAtA[i:], m.NewSpecificationAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.NewSpecificationAddr)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.ContractSpecificationAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.OldSpecificationAddr) > 0 {
+		i -= len(m.OldSpecificationAddr)
+		copy(dAtA[i:], m.OldSpecificationAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OldSpecificationAddr)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.ScopeAddr) > 0 {
+		i -= len(m.ScopeAddr)
+		copy(dAtA[i:], m.ScopeAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventRecordSpecificationDeleted) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventScopeDataAccessExpired) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventScopeDataAccessExpired) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventScopeDataAccessExpired) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.RecordSpecificationAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.DataAccess) > 0 {
+		for iNdEx := len(m.DataAccess) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DataAccess[iNdEx])
+			copy(dAtA[i:], m.DataAccess[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.DataAccess[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	l = len(m.ContractSpecificationAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.ScopeAddr) > 0 {
+		i -= len(m.ScopeAddr)
+		copy(dAtA[i:], m.ScopeAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventOSLocatorCreated) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventScopeBundleWritten) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventScopeBundleWritten) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventScopeBundleWritten) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.RecordCount != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.RecordCount))
+		i--
+		dAtA[i] = 0x18
 	}
-	return n
+	if m.SessionCount != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SessionCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ScopeAddr) > 0 {
+		i -= len(m.ScopeAddr)
+		copy(dAtA[i:], m.ScopeAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventOSLocatorUpdated) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventScopeChanged) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventScopeChanged) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventScopeChanged) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.RemovedDataAccessGrants) > 0 {
+		for iNdEx := len(m.RemovedDataAccessGrants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RemovedDataAccessGrants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
 	}
-	return n
+	if len(m.AddedDataAccessGrants) > 0 {
+		for iNdEx := len(m.AddedDataAccessGrants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AddedDataAccessGrants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.SpecificationAddr) > 0 {
+		i -= len(m.SpecificationAddr)
+		copy(dAtA[i:], m.SpecificationAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SpecificationAddr)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.RemovedDataAccess) > 0 {
+		for iNdEx := len(m.RemovedDataAccess) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RemovedDataAccess[iNdEx])
+			copy(dAtA[i:], m.RemovedDataAccess[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.RemovedDataAccess[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.AddedDataAccess) > 0 {
+		for iNdEx := len(m.AddedDataAccess) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AddedDataAccess[iNdEx])
+			copy(dAtA[i:], m.AddedDataAccess[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.AddedDataAccess[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.ValueOwner) > 0 {
+		i -= len(m.ValueOwner)
+		copy(dAtA[i:], m.ValueOwner)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ValueOwner)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.PreviousValueOwner) > 0 {
+		i -= len(m.PreviousValueOwner)
+		copy(dAtA[i:], m.PreviousValueOwner)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PreviousValueOwner)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.RemovedOwners) > 0 {
+		for iNdEx := len(m.RemovedOwners) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RemovedOwners[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.AddedOwners) > 0 {
+		for iNdEx := len(m.AddedOwners) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AddedOwners[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ScopeAddr) > 0 {
+		i -= len(m.ScopeAddr)
+		copy(dAtA[i:], m.ScopeAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventOSLocatorDeleted) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventSessionCreated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSessionCreated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSessionCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.ScopeAddr) > 0 {
+		i -= len(m.ScopeAddr)
+		copy(dAtA[i:], m.ScopeAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.SessionAddr) > 0 {
+		i -= len(m.SessionAddr)
+		copy(dAtA[i:], m.SessionAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SessionAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func sovEvents(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *EventSessionUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozEvents(x uint64) (n int) {
-	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *EventSessionUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSessionUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ScopeAddr) > 0 {
+		i -= len(m.ScopeAddr)
+		copy(dAtA[i:], m.ScopeAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SessionAddr) > 0 {
+		i -= len(m.SessionAddr)
+		copy(dAtA[i:], m.SessionAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SessionAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSessionDeleted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSessionDeleted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSessionDeleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ScopeAddr) > 0 {
+		i -= len(m.ScopeAddr)
+		copy(dAtA[i:], m.ScopeAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SessionAddr) > 0 {
+		i -= len(m.SessionAddr)
+		copy(dAtA[i:], m.SessionAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SessionAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSessionChanged) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSessionChanged) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSessionChanged) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SpecificationAddr) > 0 {
+		i -= len(m.SpecificationAddr)
+		copy(dAtA[i:], m.SpecificationAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SpecificationAddr)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.PreviousName) > 0 {
+		i -= len(m.PreviousName)
+		copy(dAtA[i:], m.PreviousName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PreviousName)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.RemovedParties) > 0 {
+		for iNdEx := len(m.RemovedParties) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RemovedParties[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.AddedParties) > 0 {
+		for iNdEx := len(m.AddedParties) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AddedParties[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ScopeAddr) > 0 {
+		i -= len(m.ScopeAddr)
+		copy(dAtA[i:], m.ScopeAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SessionAddr) > 0 {
+		i -= len(m.SessionAddr)
+		copy(dAtA[i:], m.SessionAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SessionAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRecordCreated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRecordCreated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRecordCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ScopeAddr) > 0 {
+		i -= len(m.ScopeAddr)
+		copy(dAtA[i:], m.ScopeAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SessionAddr) > 0 {
+		i -= len(m.SessionAddr)
+		copy(dAtA[i:], m.SessionAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SessionAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RecordAddr) > 0 {
+		i -= len(m.RecordAddr)
+		copy(dAtA[i:], m.RecordAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RecordAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRecordUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRecordUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRecordUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ScopeAddr) > 0 {
+		i -= len(m.ScopeAddr)
+		copy(dAtA[i:], m.ScopeAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SessionAddr) > 0 {
+		i -= len(m.SessionAddr)
+		copy(dAtA[i:], m.SessionAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SessionAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RecordAddr) > 0 {
+		i -= len(m.RecordAddr)
+		copy(dAtA[i:], m.RecordAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RecordAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRecordDeleted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRecordDeleted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRecordDeleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ScopeAddr) > 0 {
+		i -= len(m.ScopeAddr)
+		copy(dAtA[i:], m.ScopeAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RecordAddr) > 0 {
+		i -= len(m.RecordAddr)
+		copy(dAtA[i:], m.RecordAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RecordAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRecordChanged) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRecordChanged) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRecordChanged) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SpecificationAddr) > 0 {
+		i -= len(m.SpecificationAddr)
+		copy(dAtA[i:], m.SpecificationAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SpecificationAddr)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.OutputHashes) > 0 {
+		for iNdEx := len(m.OutputHashes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.OutputHashes[iNdEx])
+			copy(dAtA[i:], m.OutputHashes[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.OutputHashes[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.PreviousOutputHashes) > 0 {
+		for iNdEx := len(m.PreviousOutputHashes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PreviousOutputHashes[iNdEx])
+			copy(dAtA[i:], m.PreviousOutputHashes[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.PreviousOutputHashes[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.PreviousSessionAddr) > 0 {
+		i -= len(m.PreviousSessionAddr)
+		copy(dAtA[i:], m.PreviousSessionAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PreviousSessionAddr)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ScopeAddr) > 0 {
+		i -= len(m.ScopeAddr)
+		copy(dAtA[i:], m.ScopeAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SessionAddr) > 0 {
+		i -= len(m.SessionAddr)
+		copy(dAtA[i:], m.SessionAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SessionAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RecordAddr) > 0 {
+		i -= len(m.RecordAddr)
+		copy(dAtA[i:], m.RecordAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RecordAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventScopeSpecificationCreated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventScopeSpecificationCreated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventScopeSpecificationCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ScopeSpecificationAddr) > 0 {
+		i -= len(m.ScopeSpecificationAddr)
+		copy(dAtA[i:], m.ScopeSpecificationAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeSpecificationAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventScopeSpecificationUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventScopeSpecificationUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventScopeSpecificationUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ScopeSpecificationAddr) > 0 {
+		i -= len(m.ScopeSpecificationAddr)
+		copy(dAtA[i:], m.ScopeSpecificationAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeSpecificationAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventScopeSpecificationDeleted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventScopeSpecificationDeleted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventScopeSpecificationDeleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ScopeSpecificationAddr) > 0 {
+		i -= len(m.ScopeSpecificationAddr)
+		copy(dAtA[i:], m.ScopeSpecificationAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeSpecificationAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventContractSpecificationCreated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventContractSpecificationCreated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventContractSpecificationCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractSpecificationAddr) > 0 {
+		i -= len(m.ContractSpecificationAddr)
+		copy(dAtA[i:], m.ContractSpecificationAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractSpecificationAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventContractSpecificationUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventContractSpecificationUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventContractSpecificationUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractSpecificationAddr) > 0 {
+		i -= len(m.ContractSpecificationAddr)
+		copy(dAtA[i:], m.ContractSpecificationAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractSpecificationAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventContractSpecificationDeleted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventContractSpecificationDeleted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventContractSpecificationDeleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractSpecificationAddr) > 0 {
+		i -= len(m.ContractSpecificationAddr)
+		copy(dAtA[i:], m.ContractSpecificationAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractSpecificationAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRecordSpecificationCreated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRecordSpecificationCreated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRecordSpecificationCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractSpecificationAddr) > 0 {
+		i -= len(m.ContractSpecificationAddr)
+		copy(dAtA[i:], m.ContractSpecificationAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractSpecificationAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RecordSpecificationAddr) > 0 {
+		i -= len(m.RecordSpecificationAddr)
+		copy(dAtA[i:], m.RecordSpecificationAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RecordSpecificationAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRecordSpecificationUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRecordSpecificationUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRecordSpecificationUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractSpecificationAddr) > 0 {
+		i -= len(m.ContractSpecificationAddr)
+		copy(dAtA[i:], m.ContractSpecificationAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractSpecificationAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RecordSpecificationAddr) > 0 {
+		i -= len(m.RecordSpecificationAddr)
+		copy(dAtA[i:], m.RecordSpecificationAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RecordSpecificationAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRecordSpecificationDeleted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRecordSpecificationDeleted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRecordSpecificationDeleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractSpecificationAddr) > 0 {
+		i -= len(m.ContractSpecificationAddr)
+		copy(dAtA[i:], m.ContractSpecificationAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractSpecificationAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RecordSpecificationAddr) > 0 {
+		i -= len(m.RecordSpecificationAddr)
+		copy(dAtA[i:], m.RecordSpecificationAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RecordSpecificationAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventOSLocatorCreated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventOSLocatorCreated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventOSLocatorCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventOSLocatorUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventOSLocatorUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventOSLocatorUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventOSLocatorDeleted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventOSLocatorDeleted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventOSLocatorDeleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventTxCompleted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Endpoint)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventScopeCreated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventScopeUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventScopeDeleted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventScopeValueOwnerTokenized) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Supply)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventScopeSaleOffered) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Seller)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Price)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventScopeSaleCancelled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventScopeSold) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Seller)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Price)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventScopeSpecificationMigrated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.OldSpecificationAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.NewSpecificationAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventScopeDataAccessExpired) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.DataAccess) > 0 {
+		for _, s := range m.DataAccess {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventScopeBundleWritten) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.SessionCount != 0 {
+		n += 1 + sovEvents(uint64(m.SessionCount))
+	}
+	if m.RecordCount != 0 {
+		n += 1 + sovEvents(uint64(m.RecordCount))
+	}
+	return n
+}
+
+func (m *EventScopeChanged) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.AddedOwners) > 0 {
+		for _, e := range m.AddedOwners {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.RemovedOwners) > 0 {
+		for _, e := range m.RemovedOwners {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	l = len(m.PreviousValueOwner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ValueOwner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.AddedDataAccess) > 0 {
+		for _, s := range m.AddedDataAccess {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.RemovedDataAccess) > 0 {
+		for _, s := range m.RemovedDataAccess {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	l = len(m.SpecificationAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.AddedDataAccessGrants) > 0 {
+		for _, e := range m.AddedDataAccessGrants {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.RemovedDataAccessGrants) > 0 {
+		for _, e := range m.RemovedDataAccessGrants {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventSessionCreated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SessionAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ScopeAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventSessionUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SessionAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ScopeAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventSessionDeleted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SessionAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ScopeAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventSessionChanged) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SessionAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ScopeAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.AddedParties) > 0 {
+		for _, e := range m.AddedParties {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.RemovedParties) > 0 {
+		for _, e := range m.RemovedParties {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	l = len(m.PreviousName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.SpecificationAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventRecordCreated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RecordAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.SessionAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ScopeAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventRecordUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RecordAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.SessionAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ScopeAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventRecordDeleted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RecordAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ScopeAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventRecordChanged) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RecordAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.SessionAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ScopeAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.PreviousSessionAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.PreviousOutputHashes) > 0 {
+		for _, s := range m.PreviousOutputHashes {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.OutputHashes) > 0 {
+		for _, s := range m.OutputHashes {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	l = len(m.SpecificationAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventScopeSpecificationCreated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeSpecificationAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventScopeSpecificationUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeSpecificationAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventScopeSpecificationDeleted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeSpecificationAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventContractSpecificationCreated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractSpecificationAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventContractSpecificationUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractSpecificationAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventContractSpecificationDeleted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractSpecificationAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventRecordSpecificationCreated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RecordSpecificationAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ContractSpecificationAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventRecordSpecificationUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RecordSpecificationAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ContractSpecificationAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventRecordSpecificationDeleted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RecordSpecificationAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ContractSpecificationAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventOSLocatorCreated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventOSLocatorUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventOSLocatorDeleted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventTxCompleted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventTxCompleted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventTxCompleted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Module = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Endpoint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventScopeCreated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventScopeCreated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventScopeCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventScopeUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventScopeUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventScopeUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventScopeDeleted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventScopeDeleted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventScopeDeleted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventScopeValueOwnerTokenized) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventScopeValueOwnerTokenized: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventScopeValueOwnerTokenized: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Supply = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventScopeSaleOffered) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventScopeSaleOffered: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventScopeSaleOffered: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Seller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buyer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Price = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventScopeSaleCancelled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventScopeSaleCancelled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventScopeSaleCancelled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventScopeSold) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventScopeSold: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventScopeSold: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Seller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buyer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Price = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventScopeSpecificationMigrated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventScopeSpecificationMigrated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventScopeSpecificationMigrated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldSpecificationAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldSpecificationAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewSpecificationAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewSpecificationAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventScopeDataAccessExpired) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventScopeDataAccessExpired: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventScopeDataAccessExpired: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataAccess", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataAccess = append(m.DataAccess, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventScopeBundleWritten) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventScopeBundleWritten: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventScopeBundleWritten: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionCount", wireType)
+			}
+			m.SessionCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SessionCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordCount", wireType)
+			}
+			m.RecordCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecordCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventScopeChanged) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventScopeChanged: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventScopeChanged: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddedOwners", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddedOwners = append(m.AddedOwners, Party{})
+			if err := m.AddedOwners[len(m.AddedOwners)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedOwners", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemovedOwners = append(m.RemovedOwners, Party{})
+			if err := m.RemovedOwners[len(m.RemovedOwners)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousValueOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousValueOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValueOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddedDataAccess", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddedDataAccess = append(m.AddedDataAccess, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedDataAccess", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemovedDataAccess = append(m.RemovedDataAccess, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecificationAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpecificationAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddedDataAccessGrants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddedDataAccessGrants = append(m.AddedDataAccessGrants, DataAccessGrant{})
+			if err := m.AddedDataAccessGrants[len(m.AddedDataAccessGrants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedDataAccessGrants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemovedDataAccessGrants = append(m.RemovedDataAccessGrants, DataAccessGrant{})
+			if err := m.RemovedDataAccessGrants[len(m.RemovedDataAccessGrants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSessionCreated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSessionCreated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSessionCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSessionUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSessionUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSessionUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSessionDeleted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSessionDeleted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSessionDeleted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *EventTxCompleted) Unmarshal(dAtA []byte) error {
+func (m *EventSessionChanged) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2361,15 +6241,15 @@ func (m *EventTxCompleted) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventTxCompleted: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSessionChanged: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventTxCompleted: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSessionChanged: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2397,11 +6277,11 @@ func (m *EventTxCompleted) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Module = string(dAtA[iNdEx:postIndex])
+			m.SessionAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2429,13 +6309,13 @@ func (m *EventTxCompleted) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Endpoint = string(dAtA[iNdEx:postIndex])
+			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AddedParties", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2445,77 +6325,63 @@ func (m *EventTxCompleted) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
+			m.AddedParties = append(m.AddedParties, Party{})
+			if err := m.AddedParties[len(m.AddedParties)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvents
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedParties", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventScopeCreated) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.RemovedParties = append(m.RemovedParties, Party{})
+			if err := m.RemovedParties[len(m.RemovedParties)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventScopeCreated: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventScopeCreated: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2543,61 +6409,43 @@ func (m *EventScopeCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			m.PreviousName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvents
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventScopeUpdated) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventScopeUpdated: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventScopeUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecificationAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2625,7 +6473,7 @@ func (m *EventScopeUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			m.SpecificationAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2648,7 +6496,7 @@ func (m *EventScopeUpdated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventScopeDeleted) Unmarshal(dAtA []byte) error {
+func (m *EventRecordCreated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2671,15 +6519,15 @@ func (m *EventScopeDeleted) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventScopeDeleted: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventRecordCreated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventScopeDeleted: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventRecordCreated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2707,59 +6555,9 @@ func (m *EventScopeDeleted) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			m.RecordAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventSessionCreated) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventSessionCreated: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSessionCreated: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field SessionAddr", wireType)
 			}
@@ -2791,7 +6589,7 @@ func (m *EventSessionCreated) Unmarshal(dAtA []byte) error {
 			}
 			m.SessionAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
 			}
@@ -2844,7 +6642,7 @@ func (m *EventSessionCreated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventSessionUpdated) Unmarshal(dAtA []byte) error {
+func (m *EventRecordUpdated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2867,13 +6665,45 @@ func (m *EventSessionUpdated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSessionUpdated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventRecordUpdated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSessionUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventRecordUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field SessionAddr", wireType)
 			}
@@ -2905,7 +6735,7 @@ func (m *EventSessionUpdated) Unmarshal(dAtA []byte) error {
 			}
 			m.SessionAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
 			}
@@ -2958,7 +6788,7 @@ func (m *EventSessionUpdated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventSessionDeleted) Unmarshal(dAtA []byte) error {
+func (m *EventRecordDeleted) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2981,15 +6811,15 @@ func (m *EventSessionDeleted) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSessionDeleted: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventRecordDeleted: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSessionDeleted: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventRecordDeleted: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3017,9 +6847,9 @@ func (m *EventSessionDeleted) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SessionAddr = string(dAtA[iNdEx:postIndex])
+			m.RecordAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
 			}
@@ -3072,7 +6902,7 @@ func (m *EventSessionDeleted) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventRecordCreated) Unmarshal(dAtA []byte) error {
+func (m *EventRecordChanged) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3095,10 +6925,10 @@ func (m *EventRecordCreated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventRecordCreated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventRecordChanged: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventRecordCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventRecordChanged: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3197,91 +7027,9 @@ func (m *EventRecordCreated) Unmarshal(dAtA []byte) error {
 			}
 			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventRecordUpdated) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventRecordUpdated: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventRecordUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordAddr", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RecordAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousSessionAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3309,11 +7057,11 @@ func (m *EventRecordUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SessionAddr = string(dAtA[iNdEx:postIndex])
+			m.PreviousSessionAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousOutputHashes", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3341,61 +7089,11 @@ func (m *EventRecordUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			m.PreviousOutputHashes = append(m.PreviousOutputHashes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventRecordDeleted) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventRecordDeleted: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventRecordDeleted: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputHashes", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3423,11 +7121,11 @@ func (m *EventRecordDeleted) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecordAddr = string(dAtA[iNdEx:postIndex])
+			m.OutputHashes = append(m.OutputHashes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecificationAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3455,7 +7153,7 @@ func (m *EventRecordDeleted) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			m.SpecificationAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4373,6 +8071,38 @@ func (m *EventOSLocatorCreated) Unmarshal(dAtA []byte) error {
 			}
 			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -4455,6 +8185,38 @@ func (m *EventOSLocatorUpdated) Unmarshal(dAtA []byte) error {
 			}
 			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -4537,6 +8299,38 @@ func (m *EventOSLocatorDeleted) Unmarshal(dAtA []byte) error {
 			}
 			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -0,0 +1,30 @@
+package types
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+)
+
+// MarkerKeeper defines the marker functionality needed to create value owner tokens and pay for scope sales.
+type MarkerKeeper interface {
+	AddMarkerAccount(ctx sdk.Context, marker markertypes.MarkerAccountI) error
+	FinalizeMarker(ctx sdk.Context, caller sdk.Address, denom string) error
+	ActivateMarker(ctx sdk.Context, caller sdk.Address, denom string) error
+	WithdrawCoins(ctx sdk.Context, caller sdk.AccAddress, recipient sdk.AccAddress, denom string, coins sdk.Coins) error
+	GetMarkerByDenom(ctx sdk.Context, denom string) (markertypes.MarkerAccountI, error)
+	TransferCoin(ctx sdk.Context, from, to, admin sdk.AccAddress, amount sdk.Coin) error
+}
+
+// BankKeeper defines the bank functionality needed to find the holders of value owner tokens and pay for scope sales.
+type BankKeeper interface {
+	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	IterateAccountBalances(ctx sdk.Context, addr sdk.AccAddress, cb func(coin sdk.Coin) (stop bool))
+	SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error
+	IsSendEnabledCoins(ctx sdk.Context, coins ...sdk.Coin) error
+	GetSupply(ctx sdk.Context, denom string) sdk.Coin
+	DenomOwners(goCtx context.Context, req *banktypes.QueryDenomOwnersRequest) (*banktypes.QueryDenomOwnersResponse, error)
+}
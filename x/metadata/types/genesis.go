@@ -1,7 +1,27 @@
 package types
 
+import "fmt"
+
 // Validate ensures the genesis state is valid.
 func (state GenesisState) Validate() error {
+	seen := make(map[string]bool, len(state.RecordVersions))
+	for i, recordVersion := range state.RecordVersions {
+		if !recordVersion.Record.SessionId.IsSessionAddress() {
+			return fmt.Errorf("invalid record version [%d]: session id %s is not a session address", i, recordVersion.Record.SessionId)
+		}
+		recordID, err := recordVersion.Record.SessionId.AsRecordAddress(recordVersion.Record.Name)
+		if err != nil {
+			return fmt.Errorf("invalid record version [%d]: %w", i, err)
+		}
+		if recordVersion.Version == 0 {
+			return fmt.Errorf("invalid record version [%d]: version cannot be zero", i)
+		}
+		key := string(GetRecordVersionKey(recordID, recordVersion.Version))
+		if seen[key] {
+			return fmt.Errorf("invalid record version [%d]: duplicate version %d of record %s", i, recordVersion.Version, recordID)
+		}
+		seen[key] = true
+	}
 	return nil
 }
 
@@ -36,6 +36,8 @@ type GenesisState struct {
 	RecordSpecifications   []RecordSpecification   `protobuf:"bytes,7,rep,name=record_specifications,json=recordSpecifications,proto3" json:"record_specifications"`
 	OSLocatorParams        OSLocatorParams         `protobuf:"bytes,8,opt,name=o_s_locator_params,json=oSLocatorParams,proto3" json:"o_s_locator_params"`
 	ObjectStoreLocators    []ObjectStoreLocator    `protobuf:"bytes,9,rep,name=object_store_locators,json=objectStoreLocators,proto3" json:"object_store_locators"`
+	ScopeSaleOffers        []ScopeSaleOffer        `protobuf:"bytes,10,rep,name=scope_sale_offers,json=scopeSaleOffers,proto3" json:"scope_sale_offers"`
+	RecordVersions         []RecordVersion         `protobuf:"bytes,11,rep,name=record_versions,json=recordVersions,proto3" json:"record_versions"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -80,37 +82,40 @@ func init() {
 }
 
 var fileDescriptor_a835c20198efc302 = []byte{
-	// 473 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0xcf, 0x6e, 0x13, 0x31,
-	0x10, 0xc6, 0x77, 0x69, 0xd9, 0x06, 0x17, 0x09, 0xc9, 0xa4, 0x65, 0xa9, 0xc4, 0xa6, 0xaa, 0x40,
-	0x44, 0x45, 0xdd, 0x55, 0x0b, 0x27, 0x40, 0x48, 0x94, 0x03, 0x17, 0xa4, 0x56, 0xdd, 0x5b, 0x2f,
-	0x2b, 0xc7, 0x71, 0x83, 0xa1, 0xd9, 0x59, 0x79, 0x4c, 0x04, 0x6f, 0xc0, 0x11, 0x89, 0x17, 0xe8,
-	0xe3, 0xf4, 0xd8, 0x23, 0x27, 0x84, 0x92, 0x0b, 0x8f, 0x51, 0xc5, 0xf6, 0x36, 0xcd, 0x1f, 0xe7,
-	0x96, 0xec, 0xfc, 0xbe, 0xef, 0x9b, 0xb1, 0xc7, 0xe4, 0x69, 0xa5, 0x60, 0x20, 0x4a, 0x56, 0x72,
-	0x91, 0xf5, 0x85, 0x66, 0x5d, 0xa6, 0x59, 0x36, 0xd8, 0xcf, 0x7a, 0xa2, 0x14, 0x28, 0x31, 0xad,
-	0x14, 0x68, 0xa0, 0x9b, 0x13, 0x2a, 0xad, 0xa9, 0x74, 0xb0, 0xbf, 0xd5, 0xec, 0x41, 0x0f, 0x0c,
-	0x92, 0x8d, 0x7f, 0x59, 0x7a, 0xeb, 0x99, 0xc7, 0xf3, 0x46, 0x69, 0xb1, 0x1d, 0x0f, 0x86, 0x1c,
-	0x2a, 0xe1, 0x98, 0x5d, 0x1f, 0x53, 0x09, 0x2e, 0xcf, 0x24, 0x67, 0x5a, 0x42, 0xe9, 0xd8, 0xb6,
-	0x87, 0x85, 0xce, 0x17, 0xc1, 0x35, 0x6a, 0x50, 0xce, 0x75, 0xe7, 0x77, 0x44, 0xee, 0x7f, 0xb4,
-	0x03, 0xe6, 0x9a, 0x69, 0x41, 0xdf, 0x92, 0xa8, 0x62, 0x8a, 0xf5, 0x31, 0x0e, 0xb7, 0xc3, 0xf6,
-	0xfa, 0x41, 0x92, 0x2e, 0x1e, 0x38, 0x3d, 0x36, 0xd4, 0xe1, 0xea, 0xe5, 0xdf, 0x56, 0x70, 0xe2,
-	0x34, 0xf4, 0x0d, 0x89, 0x4c, 0xcf, 0x18, 0xdf, 0xd9, 0x5e, 0x69, 0xaf, 0x1f, 0x3c, 0xf1, 0xa9,
-	0xf3, 0x31, 0x55, 0x8b, 0xad, 0x84, 0xbe, 0x27, 0x0d, 0x14, 0x88, 0x12, 0x4a, 0x8c, 0x57, 0x8c,
-	0xbc, 0xe5, 0x95, 0x5b, 0xce, 0x19, 0xdc, 0xc8, 0xe8, 0x3b, 0xb2, 0xa6, 0x04, 0x07, 0xd5, 0xc5,
-	0x78, 0xd5, 0x38, 0x78, 0xdb, 0x3f, 0x31, 0x98, 0x33, 0xa8, 0x45, 0x94, 0x93, 0xa6, 0x69, 0xa6,
-	0x98, 0x3a, 0x55, 0x8c, 0xef, 0x1a, 0xb3, 0xdd, 0xa5, 0xd3, 0xe4, 0xb7, 0x25, 0xce, 0xf8, 0x21,
-	0xce, 0x55, 0x90, 0x9e, 0x93, 0x47, 0x1c, 0x4a, 0xad, 0x18, 0xd7, 0xb3, 0x39, 0x91, 0xc9, 0xd9,
-	0xf3, 0xe5, 0x7c, 0x70, 0xb2, 0x45, 0x51, 0x9b, 0x7c, 0x51, 0x11, 0xe9, 0x19, 0xd9, 0xb0, 0xd3,
-	0xcd, 0x66, 0xad, 0x99, 0xac, 0x17, 0xcb, 0x0f, 0x68, 0x51, 0x52, 0x53, 0xcd, 0x97, 0x90, 0x9e,
-	0x12, 0x0a, 0x05, 0x16, 0xe7, 0xc0, 0x99, 0x06, 0x55, 0xb8, 0x25, 0x6a, 0x98, 0x25, 0x7a, 0xee,
-	0x0b, 0x39, 0xca, 0x3f, 0x59, 0x7e, 0x6a, 0x9b, 0x1e, 0xc0, 0xf4, 0x67, 0xda, 0x25, 0x1b, 0x76,
-	0x75, 0x0b, 0xb3, 0xbb, 0x75, 0x08, 0xc6, 0xf7, 0x96, 0xdf, 0xcb, 0x91, 0x11, 0xe5, 0x63, 0x8d,
-	0x33, 0xac, 0xef, 0x05, 0xe6, 0x2a, 0xf8, 0xba, 0xf1, 0xf3, 0xa2, 0x15, 0xfc, 0xbf, 0x68, 0x05,
-	0x87, 0x5f, 0x2f, 0x87, 0x49, 0x78, 0x35, 0x4c, 0xc2, 0x7f, 0xc3, 0x24, 0xfc, 0x35, 0x4a, 0x82,
-	0xab, 0x51, 0x12, 0xfc, 0x19, 0x25, 0x01, 0x79, 0x2c, 0xc1, 0x13, 0x76, 0x1c, 0x9e, 0xbe, 0xea,
-	0x49, 0xfd, 0xf9, 0x5b, 0x27, 0xe5, 0xd0, 0xcf, 0x26, 0xd0, 0x9e, 0x84, 0x5b, 0xff, 0xb2, 0xef,
-	0x93, 0x17, 0xa9, 0x7f, 0x54, 0x02, 0x3b, 0x91, 0x79, 0x89, 0x2f, 0xaf, 0x03, 0x00, 0x00, 0xff,
-	0xff, 0x3a, 0xcc, 0x7d, 0x59, 0x80, 0x04, 0x00, 0x00,
+	// 527 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x94, 0xc1, 0x6e, 0xd3, 0x4e,
+	0x10, 0xc6, 0xed, 0x7f, 0xfa, 0x4f, 0xc2, 0x06, 0x51, 0xb1, 0xa4, 0xc5, 0x54, 0xc2, 0x89, 0x2a,
+	0x0a, 0x51, 0x51, 0x6d, 0xb5, 0x70, 0x02, 0x84, 0x44, 0x39, 0x70, 0x41, 0x4a, 0xd5, 0x20, 0x84,
+	0x7a, 0xb1, 0x36, 0x9b, 0x4d, 0x30, 0x24, 0x1e, 0x6b, 0x67, 0x89, 0xe0, 0x0d, 0x38, 0xf2, 0x08,
+	0x7d, 0x17, 0x2e, 0x3d, 0xf6, 0xc8, 0x09, 0xa1, 0xe4, 0xc2, 0x63, 0xa0, 0xec, 0xae, 0x9b, 0xa4,
+	0x89, 0x7d, 0x4b, 0x76, 0x7f, 0xdf, 0xf7, 0xcd, 0xec, 0x8c, 0x4c, 0x1e, 0xa4, 0x12, 0xc6, 0x22,
+	0x61, 0x09, 0x17, 0xe1, 0x48, 0x28, 0xd6, 0x63, 0x8a, 0x85, 0xe3, 0xc3, 0x70, 0x20, 0x12, 0x81,
+	0x31, 0x06, 0xa9, 0x04, 0x05, 0x74, 0x7b, 0x4e, 0x05, 0x19, 0x15, 0x8c, 0x0f, 0x77, 0xea, 0x03,
+	0x18, 0x80, 0x46, 0xc2, 0xd9, 0x2f, 0x43, 0xef, 0xec, 0xe5, 0x78, 0x5e, 0x29, 0x0d, 0xb6, 0x9b,
+	0x83, 0x21, 0x87, 0x54, 0x58, 0x66, 0x3f, 0x8f, 0x49, 0x05, 0x8f, 0xfb, 0x31, 0x67, 0x2a, 0x86,
+	0xc4, 0xb2, 0xad, 0x1c, 0x16, 0xba, 0x9f, 0x04, 0x57, 0xa8, 0x40, 0x5a, 0xd7, 0xdd, 0x9f, 0x15,
+	0x72, 0xf3, 0x8d, 0x69, 0xb0, 0xa3, 0x98, 0x12, 0xf4, 0x05, 0x29, 0xa7, 0x4c, 0xb2, 0x11, 0x7a,
+	0x6e, 0xd3, 0x6d, 0xd5, 0x8e, 0xfc, 0x60, 0x7d, 0xc3, 0xc1, 0x89, 0xa6, 0x8e, 0x37, 0x2e, 0x7e,
+	0x37, 0x9c, 0x53, 0xab, 0xa1, 0xcf, 0x49, 0x59, 0xd7, 0x8c, 0xde, 0x7f, 0xcd, 0x52, 0xab, 0x76,
+	0x74, 0x3f, 0x4f, 0xdd, 0x99, 0x51, 0x99, 0xd8, 0x48, 0xe8, 0x2b, 0x52, 0x45, 0x81, 0x18, 0x43,
+	0x82, 0x5e, 0x49, 0xcb, 0x1b, 0xb9, 0x72, 0xc3, 0x59, 0x83, 0x2b, 0x19, 0x7d, 0x49, 0x2a, 0x52,
+	0x70, 0x90, 0x3d, 0xf4, 0x36, 0x9a, 0xa5, 0xa2, 0xf2, 0x4f, 0x35, 0x66, 0x0d, 0x32, 0x11, 0xe5,
+	0xa4, 0xae, 0x8b, 0x89, 0x96, 0x5e, 0x15, 0xbd, 0xff, 0xb5, 0xd9, 0x7e, 0x61, 0x37, 0x9d, 0x45,
+	0x89, 0x35, 0xbe, 0x83, 0x2b, 0x37, 0x48, 0x87, 0xe4, 0x2e, 0x87, 0x44, 0x49, 0xc6, 0xd5, 0xf5,
+	0x9c, 0xb2, 0xce, 0x39, 0xc8, 0xcb, 0x79, 0x6d, 0x65, 0xeb, 0xa2, 0xb6, 0xf9, 0xba, 0x4b, 0xa4,
+	0x7d, 0xb2, 0x65, 0xba, 0xbb, 0x9e, 0x55, 0xd1, 0x59, 0x8f, 0x8b, 0x1f, 0x68, 0x5d, 0x52, 0x5d,
+	0xae, 0x5e, 0x21, 0x3d, 0x23, 0x14, 0x22, 0x8c, 0x86, 0xc0, 0x99, 0x02, 0x19, 0xd9, 0x25, 0xaa,
+	0xea, 0x25, 0x7a, 0x94, 0x17, 0xd2, 0xee, 0xbc, 0x35, 0xfc, 0xd2, 0x36, 0x6d, 0xc2, 0xf2, 0x31,
+	0xed, 0x91, 0x2d, 0xb3, 0xba, 0x91, 0xde, 0xdd, 0x2c, 0x04, 0xbd, 0x1b, 0xc5, 0x73, 0x69, 0x6b,
+	0x51, 0x67, 0xa6, 0xb1, 0x86, 0xd9, 0x5c, 0x60, 0xe5, 0x06, 0xe9, 0x07, 0x72, 0xdb, 0x0e, 0x9f,
+	0x0d, 0x45, 0x04, 0xfd, 0xbe, 0x90, 0xe8, 0x11, 0x9d, 0xf0, 0xb0, 0x78, 0xf2, 0x6c, 0x28, 0xda,
+	0x33, 0x3c, 0xab, 0x1f, 0x97, 0x4e, 0x91, 0xbe, 0x23, 0x9b, 0x76, 0x06, 0x63, 0x21, 0xcd, 0x82,
+	0xd7, 0xb4, 0xef, 0x5e, 0xf1, 0xeb, 0xbf, 0x37, 0xb4, 0xb5, 0xbd, 0x25, 0x17, 0x0f, 0xf1, 0x59,
+	0xf5, 0xfb, 0x79, 0xc3, 0xf9, 0x7b, 0xde, 0x70, 0x8e, 0x3f, 0x5f, 0x4c, 0x7c, 0xf7, 0x72, 0xe2,
+	0xbb, 0x7f, 0x26, 0xbe, 0xfb, 0x63, 0xea, 0x3b, 0x97, 0x53, 0xdf, 0xf9, 0x35, 0xf5, 0x1d, 0x72,
+	0x2f, 0x86, 0x9c, 0x88, 0x13, 0xf7, 0xec, 0xe9, 0x20, 0x56, 0x1f, 0xbf, 0x74, 0x03, 0x0e, 0xa3,
+	0x70, 0x0e, 0x1d, 0xc4, 0xb0, 0xf0, 0x2f, 0xfc, 0x3a, 0xff, 0x82, 0xa8, 0x6f, 0xa9, 0xc0, 0x6e,
+	0x59, 0x7f, 0x39, 0x9e, 0xfc, 0x1b, 0x00, 0x79, 0xb9, 0xb3, 0x79, 0x30, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -133,6 +138,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RecordVersions) > 0 {
+		for iNdEx := len(m.RecordVersions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecordVersions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.ScopeSaleOffers) > 0 {
+		for iNdEx := len(m.ScopeSaleOffers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScopeSaleOffers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	if len(m.ObjectStoreLocators) > 0 {
 		for iNdEx := len(m.ObjectStoreLocators) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -317,6 +350,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ScopeSaleOffers) > 0 {
+		for _, e := range m.ScopeSaleOffers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RecordVersions) > 0 {
+		for _, e := range m.RecordVersions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -659,6 +704,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeSaleOffers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeSaleOffers = append(m.ScopeSaleOffers, ScopeSaleOffer{})
+			if err := m.ScopeSaleOffers[len(m.ScopeSaleOffers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordVersions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordVersions = append(m.RecordVersions, RecordVersion{})
+			if err := m.RecordVersions[len(m.RecordVersions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
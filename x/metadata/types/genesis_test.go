@@ -0,0 +1,70 @@
+package types
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenesisStateValidateRecordVersions(t *testing.T) {
+	scopeUUID := uuid.New()
+	sessionID := SessionMetadataAddress(scopeUUID, uuid.New())
+	recordID := RecordMetadataAddress(scopeUUID, "recordname")
+	version := func(sessionID MetadataAddress, name string, version uint64) RecordVersion {
+		return RecordVersion{Record: Record{Name: name, SessionId: sessionID}, Version: version}
+	}
+
+	tests := []struct {
+		name     string
+		versions []RecordVersion
+		expErr   string
+	}{
+		{
+			name: "no versions",
+		},
+		{
+			name:     "valid versions",
+			versions: []RecordVersion{version(sessionID, "recordname", 1), version(sessionID, "recordname", 2), version(sessionID, "other", 1)},
+		},
+		{
+			name:     "empty session id",
+			versions: []RecordVersion{version(sessionID, "recordname", 1), version(nil, "recordname", 1)},
+			expErr:   "invalid record version [1]: session id  is not a session address",
+		},
+		{
+			name:     "scope id instead of session id",
+			versions: []RecordVersion{version(ScopeMetadataAddress(scopeUUID), "recordname", 1)},
+			expErr:   fmt.Sprintf("invalid record version [0]: session id %s is not a session address", ScopeMetadataAddress(scopeUUID)),
+		},
+		{
+			name:     "no name",
+			versions: []RecordVersion{version(sessionID, "", 1)},
+			expErr:   "invalid record version [0]: missing name value for record metadata address",
+		},
+		{
+			name:     "version zero",
+			versions: []RecordVersion{version(sessionID, "recordname", 0)},
+			expErr:   "invalid record version [0]: version cannot be zero",
+		},
+		{
+			name:     "duplicate version",
+			versions: []RecordVersion{version(sessionID, "recordname", 1), version(sessionID, "recordname", 1)},
+			expErr:   fmt.Sprintf("invalid record version [1]: duplicate version 1 of record %s", recordID),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			state := DefaultGenesisState()
+			state.RecordVersions = tc.versions
+			err := state.Validate()
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "Validate")
+			} else {
+				assert.NoError(t, err, "Validate")
+			}
+		})
+	}
+}
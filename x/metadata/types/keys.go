@@ -1,6 +1,10 @@
 package types
 
 import (
+	"encoding/binary"
+	"fmt"
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
 )
@@ -40,7 +44,7 @@ const (
 //
 // - 0x05<session_specification_key_bytes><record_spec_name_hash>: RecordSpecification
 //
-// - 0x21<owner_address>: ObjectStoreLocator
+// - 0x21<owner_address><locator_name>: ObjectStoreLocator
 //
 // These keys are used for indexing and more specific iteration.
 // These keys are handled using the stuff in this file.
@@ -58,6 +62,20 @@ const (
 // - 0x14<contract_spec_id><scope_spec_id>: 0x01
 //
 // - 0x20<owner_address><contract_spec_id>: 0x01
+//
+// - 0x22<scope_id>: ScopeSaleOffer
+//
+// - 0x23<owner_address><owner_role><scope_id>: 0x01
+//
+// - 0x24<data_access_address><scope_id>: 0x01
+//
+// - 0x25<record_id><version>: RecordVersion
+//
+// - 0x26<expiration><scope_id><data_access_address>: 0x01
+//
+// - 0x27<party_address><party_role><session_id>: 0x01
+//
+// - 0x28<expiration><scope_id>: 0x01
 var (
 	// ScopeKeyPrefix is the key for scope records in metadata store
 	ScopeKeyPrefix = []byte{0x00}
@@ -78,6 +96,10 @@ var (
 	ScopeSpecScopeCacheKeyPrefix = []byte{0x11}
 	// ValueOwnerScopeCacheKeyPrefix for scope to value owner address cache lookup
 	ValueOwnerScopeCacheKeyPrefix = []byte{0x18}
+	// OwnerRoleScopeCacheKeyPrefix for scope to owner address and party role cache lookup
+	OwnerRoleScopeCacheKeyPrefix = []byte{0x23}
+	// DataAccessScopeCacheKeyPrefix for scope to data access address cache lookup
+	DataAccessScopeCacheKeyPrefix = []byte{0x24}
 
 	// AddressScopeSpecCacheKeyPrefix for scope spec lookup by address
 	AddressScopeSpecCacheKeyPrefix = []byte{0x19}
@@ -88,6 +110,21 @@ var (
 
 	// OSLocatorAddressKeyPrefix is the key for OSLocator Record by address
 	OSLocatorAddressKeyPrefix = []byte{0x21}
+
+	// ScopeSaleOfferKeyPrefix is the key for offers to sell the value ownership of a scope
+	ScopeSaleOfferKeyPrefix = []byte{0x22}
+
+	// RecordVersionKeyPrefix is the key for retained versions of records
+	RecordVersionKeyPrefix = []byte{0x25}
+
+	// DataAccessExpirationKeyPrefix is the key for the index of scope data access grants by expiration
+	DataAccessExpirationKeyPrefix = []byte{0x26}
+
+	// PartySessionCacheKeyPrefix for session to party address and role cache lookup
+	PartySessionCacheKeyPrefix = []byte{0x27}
+
+	// ScopeSaleOfferExpirationKeyPrefix is the key for the index of scope sale offers by expiration
+	ScopeSaleOfferExpirationKeyPrefix = []byte{0x28}
 )
 
 // GetAddressScopeCacheIteratorPrefix returns an iterator prefix for all scope cache entries assigned to a given address
@@ -120,6 +157,78 @@ func GetValueOwnerScopeCacheKey(addr sdk.AccAddress, scopeID MetadataAddress) []
 	return append(GetValueOwnerScopeCacheIteratorPrefix(addr), scopeID.Bytes()...)
 }
 
+// GetOwnerScopeCacheIteratorPrefix returns an iterator prefix for all scope cache entries for a given owner address in any role
+func GetOwnerScopeCacheIteratorPrefix(addr sdk.AccAddress) []byte {
+	return append(OwnerRoleScopeCacheKeyPrefix, address.MustLengthPrefix(addr.Bytes())...)
+}
+
+// GetOwnerRoleScopeCacheIteratorPrefix returns an iterator prefix for all scope cache entries for a given owner address and role
+func GetOwnerRoleScopeCacheIteratorPrefix(addr sdk.AccAddress, role PartyType) []byte {
+	// Party types are all small enough that a single byte is enough to hold them.
+	return append(GetOwnerScopeCacheIteratorPrefix(addr), byte(role))
+}
+
+// GetOwnerRoleScopeCacheKey returns the store key for an owner address + role cache entry
+func GetOwnerRoleScopeCacheKey(addr sdk.AccAddress, role PartyType, scopeID MetadataAddress) []byte {
+	return append(GetOwnerRoleScopeCacheIteratorPrefix(addr, role), scopeID.Bytes()...)
+}
+
+// GetDataAccessScopeCacheIteratorPrefix returns an iterator prefix for all scope cache entries for a given data access address
+func GetDataAccessScopeCacheIteratorPrefix(addr sdk.AccAddress) []byte {
+	return append(DataAccessScopeCacheKeyPrefix, address.MustLengthPrefix(addr.Bytes())...)
+}
+
+// GetDataAccessScopeCacheKey returns the store key for a data access address cache entry
+func GetDataAccessScopeCacheKey(addr sdk.AccAddress, scopeID MetadataAddress) []byte {
+	return append(GetDataAccessScopeCacheIteratorPrefix(addr), scopeID.Bytes()...)
+}
+
+// GetPartySessionCacheIteratorPrefix returns an iterator prefix for all session cache entries for a given party address in any role
+func GetPartySessionCacheIteratorPrefix(addr sdk.AccAddress) []byte {
+	return append(PartySessionCacheKeyPrefix, address.MustLengthPrefix(addr.Bytes())...)
+}
+
+// GetPartyRoleSessionCacheIteratorPrefix returns an iterator prefix for all session cache entries for a given party address and role
+func GetPartyRoleSessionCacheIteratorPrefix(addr sdk.AccAddress, role PartyType) []byte {
+	// Party types are all small enough that a single byte is enough to hold them.
+	return append(GetPartySessionCacheIteratorPrefix(addr), byte(role))
+}
+
+// GetPartyRoleSessionCacheKey returns the store key for a party address + role session cache entry
+func GetPartyRoleSessionCacheKey(addr sdk.AccAddress, role PartyType, sessionID MetadataAddress) []byte {
+	return append(GetPartyRoleSessionCacheIteratorPrefix(addr, role), sessionID.Bytes()...)
+}
+
+// GetDataAccessExpirationKeyPrefix returns the data access expiration index prefix for the given time.
+// The prefix has the format [0x26] :: [big-endian-unix-seconds]
+func GetDataAccessExpirationKeyPrefix(expiration time.Time) []byte {
+	key := make([]byte, len(DataAccessExpirationKeyPrefix)+8)
+	copy(key, DataAccessExpirationKeyPrefix)
+	binary.BigEndian.PutUint64(key[len(DataAccessExpirationKeyPrefix):], uint64(expiration.Unix()))
+	return key
+}
+
+// GetDataAccessExpirationKey returns the data access expiration index key for an address's access to a scope.
+// The key has the format [0x26] :: [big-endian-unix-seconds] :: [scope-id] :: [length-prefixed-addr-bytes]
+func GetDataAccessExpirationKey(expiration time.Time, scopeID MetadataAddress, addr sdk.AccAddress) []byte {
+	key := append(GetDataAccessExpirationKeyPrefix(expiration), scopeID.Bytes()...)
+	return append(key, address.MustLengthPrefix(addr.Bytes())...)
+}
+
+// ParseDataAccessExpirationKeyScopeID extracts the scope id from a data access expiration index key.
+func ParseDataAccessExpirationKeyScopeID(key []byte) (MetadataAddress, error) {
+	start := len(DataAccessExpirationKeyPrefix) + 8
+	end := start + 1 + 16 // type byte plus size of one uuid
+	if len(key) < end {
+		return nil, fmt.Errorf("data access expiration key too short: %d bytes", len(key))
+	}
+	scopeID := MetadataAddress(key[start:end])
+	if !scopeID.IsScopeAddress() {
+		return nil, fmt.Errorf("data access expiration key does not contain a scope id: %X", key)
+	}
+	return scopeID, nil
+}
+
 // GetAddressScopeSpecCacheIteratorPrefix returns an iterator prefix for all scope spec cache entries assigned to a given address
 func GetAddressScopeSpecCacheIteratorPrefix(addr sdk.AccAddress) []byte {
 	return append(AddressScopeSpecCacheKeyPrefix, address.MustLengthPrefix(addr.Bytes())...)
@@ -150,7 +259,55 @@ func GetAddressContractSpecCacheKey(addr sdk.AccAddress, contractSpecID Metadata
 	return append(GetAddressContractSpecCacheIteratorPrefix(addr), contractSpecID.Bytes()...)
 }
 
-// GetOSLocatorKey returns a store key for an object store locator entry
-func GetOSLocatorKey(addr sdk.AccAddress) []byte {
+// GetOSLocatorOwnerIteratorPrefix returns an iterator prefix for all object store locator entries of an owner
+func GetOSLocatorOwnerIteratorPrefix(addr sdk.AccAddress) []byte {
 	return append(OSLocatorAddressKeyPrefix, address.MustLengthPrefix(addr.Bytes())...)
 }
+
+// GetOSLocatorKey returns a store key for a named object store locator entry of an owner
+func GetOSLocatorKey(addr sdk.AccAddress, name string) []byte {
+	return append(GetOSLocatorOwnerIteratorPrefix(addr), []byte(NormalizeOSLocatorName(name))...)
+}
+
+// GetScopeSaleOfferKey returns the store key for the sale offer of a scope
+func GetScopeSaleOfferKey(scopeID MetadataAddress) []byte {
+	return append(ScopeSaleOfferKeyPrefix, scopeID.Bytes()...)
+}
+
+// GetScopeSaleOfferExpirationKeyPrefix returns the scope sale offer expiration index prefix for the given time.
+// The prefix has the format [0x28] :: [big-endian-unix-seconds]
+func GetScopeSaleOfferExpirationKeyPrefix(expiration time.Time) []byte {
+	key := make([]byte, len(ScopeSaleOfferExpirationKeyPrefix)+8)
+	copy(key, ScopeSaleOfferExpirationKeyPrefix)
+	binary.BigEndian.PutUint64(key[len(ScopeSaleOfferExpirationKeyPrefix):], uint64(expiration.Unix()))
+	return key
+}
+
+// GetScopeSaleOfferExpirationKey returns the scope sale offer expiration index key for a scope's offer.
+// The key has the format [0x28] :: [big-endian-unix-seconds] :: [scope-id]
+func GetScopeSaleOfferExpirationKey(expiration time.Time, scopeID MetadataAddress) []byte {
+	return append(GetScopeSaleOfferExpirationKeyPrefix(expiration), scopeID.Bytes()...)
+}
+
+// ParseScopeSaleOfferExpirationKeyScopeID extracts the scope id from a scope sale offer expiration index key.
+func ParseScopeSaleOfferExpirationKeyScopeID(key []byte) (MetadataAddress, error) {
+	start := len(ScopeSaleOfferExpirationKeyPrefix) + 8
+	if len(key) < start {
+		return nil, fmt.Errorf("scope sale offer expiration key too short: %d bytes", len(key))
+	}
+	scopeID := MetadataAddress(key[start:])
+	if !scopeID.IsScopeAddress() {
+		return nil, fmt.Errorf("scope sale offer expiration key does not contain a scope id: %X", key)
+	}
+	return scopeID, nil
+}
+
+// GetRecordVersionIteratorPrefix returns an iterator prefix for all retained versions of a record
+func GetRecordVersionIteratorPrefix(recordID MetadataAddress) []byte {
+	return append(RecordVersionKeyPrefix, recordID.Bytes()...)
+}
+
+// GetRecordVersionKey returns the store key for a retained version of a record
+func GetRecordVersionKey(recordID MetadataAddress, version uint64) []byte {
+	return append(GetRecordVersionIteratorPrefix(recordID), sdk.Uint64ToBigEndian(version)...)
+}
@@ -25,6 +25,14 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the set of params for the metadata module.
 type Params struct {
+	// max_record_versions is the number of versions of each record to retain in the record history.
+	// Zero disables record versioning.
+	MaxRecordVersions uint32 `protobuf:"varint,1,opt,name=max_record_versions,json=maxRecordVersions,proto3" json:"max_record_versions,omitempty" yaml:"max_record_versions"`
+	// disable_p8e_messages rejects the legacy MsgP8eMemorializeContractRequest and MsgWriteP8eContractSpecRequest messages
+	// once p8e-origin data has been migrated to the native format.
+	DisableP8EMessages bool `protobuf:"varint,2,opt,name=disable_p8e_messages,json=disableP8eMessages,proto3" json:"disable_p8e_messages,omitempty" yaml:"disable_p8e_messages"`
+	// emit_detailed_events adds events with the changed fields of scopes, sessions and records whenever they are written.
+	EmitDetailedEvents bool `protobuf:"varint,3,opt,name=emit_detailed_events,json=emitDetailedEvents,proto3" json:"emit_detailed_events,omitempty" yaml:"emit_detailed_events"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -59,6 +67,27 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetMaxRecordVersions() uint32 {
+	if m != nil {
+		return m.MaxRecordVersions
+	}
+	return 0
+}
+
+func (m *Params) GetDisableP8EMessages() bool {
+	if m != nil {
+		return m.DisableP8EMessages
+	}
+	return false
+}
+
+func (m *Params) GetEmitDetailedEvents() bool {
+	if m != nil {
+		return m.EmitDetailedEvents
+	}
+	return false
+}
+
 // ScopeIdInfo contains various info regarding a scope id.
 type ScopeIdInfo struct {
 	// scope_id is the raw bytes of the scope address.
@@ -560,70 +589,76 @@ func init() {
 }
 
 var fileDescriptor_786fb0ab3f663d79 = []byte{
-	// 994 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcf, 0x6f, 0xdc, 0x44,
-	0x14, 0x5e, 0x37, 0x21, 0x6d, 0x5e, 0x7e, 0xec, 0xc6, 0xdd, 0x4d, 0xb6, 0x69, 0xea, 0x49, 0xa7,
-	0x54, 0x5a, 0x85, 0xb2, 0x4b, 0x4b, 0x25, 0xa4, 0xdc, 0x08, 0xaa, 0x94, 0xaa, 0x2a, 0x8a, 0xbc,
-	0x02, 0x09, 0x84, 0x14, 0xb9, 0xb6, 0x93, 0x58, 0xb0, 0xeb, 0x95, 0xbd, 0x1b, 0xb5, 0xe2, 0xc0,
-	0xbf, 0xc0, 0x91, 0x63, 0xef, 0x9c, 0xf8, 0x2f, 0x7a, 0xac, 0xc4, 0x05, 0x71, 0x18, 0x41, 0xc2,
-	0x81, 0xf3, 0xfc, 0x05, 0xc8, 0x33, 0x63, 0xcf, 0x1b, 0xff, 0xb8, 0x71, 0xf3, 0x8c, 0xbf, 0xf7,
-	0x7d, 0xf6, 0xfb, 0x3e, 0xbf, 0xd9, 0x85, 0x87, 0xb3, 0x24, 0xbe, 0x0c, 0xa7, 0xde, 0xd4, 0x0f,
-	0x47, 0x93, 0x70, 0xee, 0x05, 0xde, 0xdc, 0x1b, 0x5d, 0x3e, 0x2e, 0xae, 0x87, 0xb3, 0x24, 0x9e,
-	0xc7, 0xf6, 0xb6, 0x86, 0x0d, 0x8b, 0x5b, 0x97, 0x8f, 0x77, 0xbb, 0xe7, 0xf1, 0x79, 0x2c, 0x20,
-	0xa3, 0xec, 0x4a, 0xa2, 0xa9, 0x0d, 0x2b, 0x27, 0x5e, 0xe2, 0x4d, 0xd2, 0xc3, 0x5b, 0xbf, 0xbc,
-	0x25, 0xad, 0x7f, 0xdf, 0x12, 0x8b, 0xfe, 0x7e, 0x03, 0xd6, 0xc6, 0x7e, 0x3c, 0x0b, 0x9f, 0x07,
-	0xcf, 0xa7, 0x67, 0xb1, 0xfd, 0x0c, 0x6e, 0xa5, 0xd9, 0xf2, 0x34, 0x0a, 0xfa, 0xd6, 0xbe, 0x35,
-	0x58, 0x3f, 0x3a, 0x78, 0xc7, 0x48, 0xeb, 0x4f, 0x46, 0xda, 0x2f, 0x95, 0xc0, 0xe7, 0x41, 0x90,
-	0x84, 0x69, 0xca, 0x19, 0x69, 0xbf, 0xf1, 0x26, 0x3f, 0x1c, 0xd2, 0xbc, 0x80, 0xba, 0x37, 0x53,
-	0x49, 0x65, 0x1f, 0x41, 0x3b, 0xdf, 0x3d, 0x9d, 0x25, 0xe1, 0x59, 0xf4, 0xba, 0x7f, 0x43, 0xb0,
-	0xed, 0x72, 0x46, 0xb6, 0xcd, 0x32, 0x05, 0xa0, 0xee, 0x86, 0xaa, 0x3e, 0x11, 0x6b, 0xfb, 0x25,
-	0xdc, 0x2e, 0x20, 0xf2, 0x62, 0xb1, 0x88, 0x82, 0xfe, 0x92, 0xe0, 0x71, 0x38, 0x23, 0xbb, 0x25,
-	0x1e, 0x0d, 0xa2, 0x6e, 0x47, 0x71, 0x89, 0x77, 0xfb, 0x6a, 0x11, 0x05, 0xf6, 0x53, 0x00, 0x09,
-	0xf0, 0x82, 0x20, 0xe9, 0x2f, 0xef, 0x5b, 0x83, 0xd5, 0xa3, 0x1e, 0x67, 0x64, 0x0b, 0xb3, 0x64,
-	0xf7, 0xa8, 0xbb, 0x2a, 0x16, 0xd9, 0x7b, 0xea, 0x2a, 0xa1, 0xfd, 0x41, 0x7d, 0x95, 0x94, 0x94,
-	0x55, 0x99, 0x16, 0xfd, 0x6d, 0x19, 0x36, 0xc6, 0x61, 0x9a, 0x46, 0xf1, 0x54, 0xf5, 0xf5, 0x05,
-	0x40, 0x2a, 0x37, 0x74, 0x67, 0x1f, 0x35, 0x77, 0x36, 0xa7, 0x2f, 0x4a, 0x32, 0xfa, 0x9c, 0xd0,
-	0x3e, 0x86, 0x2d, 0x7d, 0xc7, 0xec, 0xef, 0x1e, 0x67, 0xa4, 0x5f, 0x2e, 0x2e, 0x3a, 0xdc, 0x2e,
-	0x38, 0x54, 0x8f, 0xc7, 0xd0, 0x43, 0xb0, 0x4a, 0x97, 0xf7, 0x39, 0x23, 0x7b, 0x15, 0x36, 0xfc,
-	0xd2, 0x76, 0xc1, 0xa8, 0x3b, 0xfd, 0x0d, 0xec, 0x60, 0xb4, 0xba, 0x14, 0xb4, 0xcb, 0x82, 0x96,
-	0x72, 0x46, 0x9c, 0x2a, 0x2d, 0x02, 0x52, 0xb7, 0xab, 0x89, 0xe5, 0x85, 0xa0, 0x3e, 0x84, 0xf5,
-	0x1c, 0x26, 0x6c, 0x94, 0x86, 0xec, 0x70, 0x46, 0x6e, 0x9b, 0x7c, 0xd2, 0xc8, 0x35, 0xb5, 0x14,
-	0x56, 0xa2, 0x5a, 0xf1, 0x2c, 0x2b, 0x4d, 0xb5, 0xf2, 0x01, 0xf2, 0x5a, 0xa1, 0xeb, 0xc1, 0x46,
-	0x11, 0xb3, 0x68, 0x7a, 0x16, 0xf7, 0x6f, 0xee, 0x5b, 0x83, 0xb5, 0x27, 0x0f, 0x86, 0xf5, 0x1f,
-	0xe0, 0x10, 0x7d, 0x52, 0x47, 0x7d, 0xce, 0x48, 0xb7, 0x14, 0xd5, 0x8c, 0x23, 0x93, 0xd0, 0x30,
-	0x7a, 0xb5, 0x04, 0xeb, 0x6e, 0xe8, 0xc7, 0x49, 0xa0, 0x22, 0x73, 0x0c, 0xab, 0x89, 0x58, 0xeb,
-	0xc4, 0x7c, 0xd4, 0x9c, 0x98, 0x8e, 0x54, 0x28, 0x2a, 0xa8, 0x7b, 0x2b, 0x51, 0x6c, 0xf6, 0x33,
-	0xe8, 0x14, 0xfb, 0x66, 0x5c, 0xee, 0x72, 0x46, 0x76, 0x4a, 0x95, 0x45, 0x5a, 0x36, 0x73, 0x02,
-	0x15, 0x96, 0x13, 0xe8, 0x6a, 0x50, 0x25, 0x2b, 0x84, 0x33, 0x72, 0xb7, 0x4c, 0x85, 0xa3, 0xb2,
-	0x95, 0xd3, 0xe9, 0xa4, 0x8c, 0xa1, 0xa7, 0xb1, 0x17, 0x5e, 0x7a, 0x11, 0x06, 0xa7, 0x53, 0x6f,
-	0x12, 0xaa, 0x9c, 0xa0, 0xf8, 0xd5, 0xc2, 0xa8, 0x6b, 0xe7, 0x9c, 0xc7, 0x62, 0xf7, 0x4b, 0x6f,
-	0x12, 0xda, 0x9f, 0xc1, 0x9a, 0x42, 0xa3, 0x88, 0x6c, 0x73, 0x46, 0x6c, 0x83, 0x4a, 0x26, 0x04,
-	0xe4, 0x4a, 0x04, 0xa4, 0x62, 0xf2, 0xca, 0xff, 0x6e, 0xf2, 0xaf, 0x4b, 0xd0, 0x16, 0x65, 0xe3,
-	0x59, 0xe8, 0x2b, 0x9f, 0xc7, 0xb9, 0x6c, 0x3a, 0x0b, 0x7d, 0xed, 0xf5, 0xa8, 0xd9, 0x6b, 0x43,
-	0x48, 0x55, 0xe5, 0x42, 0x92, 0x38, 0xf3, 0xca, 0xb8, 0x6d, 0xda, 0x8e, 0xbc, 0xaa, 0x43, 0x51,
-	0x77, 0x0b, 0x71, 0x29, 0xf7, 0x23, 0xb8, 0x67, 0x62, 0xd1, 0x0a, 0xc5, 0x60, 0xc0, 0x19, 0xf9,
-	0xb0, 0x8e, 0xba, 0x04, 0xa7, 0x6e, 0x1f, 0x69, 0x14, 0x3d, 0x11, 0xb1, 0x28, 0x4e, 0x0f, 0x81,
-	0x46, 0xf3, 0xba, 0x72, 0x7a, 0x14, 0x80, 0xfc, 0xf4, 0xc8, 0x38, 0x84, 0x99, 0x26, 0x07, 0x9a,
-	0xde, 0xf5, 0x1c, 0xf2, 0x91, 0x34, 0x87, 0x18, 0xe3, 0xff, 0x2c, 0x81, 0xfd, 0x45, 0x3c, 0x9d,
-	0x27, 0x9e, 0x3f, 0x47, 0x86, 0x7d, 0x07, 0x1d, 0x5f, 0xed, 0x96, 0x3c, 0x7b, 0xd2, 0xec, 0x99,
-	0xfa, 0xca, 0xca, 0x85, 0xd4, 0xdd, 0xf4, 0x0d, 0x85, 0x6c, 0x7a, 0x96, 0x41, 0xa6, 0x79, 0x68,
-	0x7a, 0x36, 0x00, 0xa9, 0xdb, 0x35, 0x49, 0x95, 0x85, 0x3f, 0xc2, 0x83, 0x4a, 0x85, 0xb9, 0x81,
-	0x8c, 0x1c, 0x72, 0x46, 0x0e, 0x1a, 0x64, 0xaa, 0x45, 0xd4, 0x75, 0x4c, 0x49, 0xdc, 0x37, 0x61,
-	0xea, 0x0b, 0xb0, 0xcd, 0x32, 0xe4, 0xeb, 0x3d, 0xce, 0xc8, 0x9d, 0x3a, 0x2d, 0x69, 0x6d, 0x07,
-	0x53, 0x0b, 0x77, 0x2b, 0x64, 0xc8, 0xe0, 0x46, 0x32, 0xf5, 0xcb, 0xc0, 0x2f, 0x3d, 0x19, 0xfd,
-	0x7b, 0x19, 0x3a, 0x72, 0xf2, 0x22, 0x93, 0xbf, 0x06, 0x35, 0xfe, 0x4a, 0x16, 0x7f, 0xd2, 0x6c,
-	0x71, 0xcf, 0x98, 0x2f, 0x85, 0xc1, 0xeb, 0x09, 0xe2, 0x46, 0x23, 0xaf, 0xd6, 0xdc, 0xea, 0xc8,
-	0x2b, 0x5b, 0x6b, 0x63, 0x3a, 0x65, 0xec, 0x02, 0xee, 0x97, 0xd0, 0x8d, 0xb6, 0x3e, 0xe2, 0x8c,
-	0x0c, 0x6a, 0x05, 0xea, 0x9a, 0xb5, 0x87, 0xc5, 0x2a, 0x96, 0x7a, 0xb0, 0x5b, 0xe2, 0xa8, 0xce,
-	0xf0, 0x87, 0x9c, 0x91, 0xfb, 0xb5, 0x7a, 0xc6, 0x20, 0xdf, 0xc6, 0x42, 0x68, 0x98, 0xeb, 0xa3,
-	0x4b, 0x67, 0x46, 0xda, 0x5c, 0x3d, 0xba, 0x50, 0x62, 0x36, 0x35, 0x9d, 0xc8, 0xcb, 0x4f, 0xd0,
-	0xab, 0x84, 0x18, 0x8d, 0xf8, 0x83, 0xa6, 0x11, 0x5f, 0xfd, 0xfa, 0xb1, 0x43, 0xb5, 0x94, 0xd4,
-	0xb5, 0xfd, 0x6a, 0xd5, 0xf7, 0xef, 0xae, 0x1c, 0xeb, 0xfd, 0x95, 0x63, 0xfd, 0x75, 0xe5, 0x58,
-	0x3f, 0x5f, 0x3b, 0xad, 0xf7, 0xd7, 0x4e, 0xeb, 0x8f, 0x6b, 0xa7, 0x05, 0x77, 0xa2, 0xb8, 0x41,
-	0xfd, 0xc4, 0xfa, 0xf6, 0xe9, 0x79, 0x34, 0xbf, 0x58, 0xbc, 0x1a, 0xfa, 0xf1, 0x64, 0xa4, 0x41,
-	0x1f, 0x47, 0x31, 0x5a, 0x8d, 0x5e, 0xeb, 0xbf, 0x08, 0xf3, 0x37, 0xb3, 0x30, 0x7d, 0xb5, 0x22,
-	0x7e, 0xef, 0x7f, 0xfa, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x18, 0x3b, 0xb8, 0x76, 0x46, 0x0c,
-	0x00, 0x00,
+	// 1099 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xbf, 0x6f, 0xdb, 0x46,
+	0x14, 0x36, 0x6d, 0xd7, 0xb1, 0x4f, 0x92, 0x25, 0xd1, 0x92, 0xad, 0x38, 0x8e, 0x4e, 0xb9, 0x34,
+	0x80, 0xe0, 0xa6, 0x52, 0x93, 0x06, 0x68, 0xe0, 0xad, 0x6a, 0x0d, 0x38, 0x08, 0x1c, 0xa8, 0x14,
+	0x1a, 0xa0, 0x45, 0x01, 0xe1, 0x4c, 0x9e, 0x6d, 0xa2, 0xa6, 0x28, 0xf0, 0x28, 0xc1, 0x41, 0x87,
+	0xae, 0x1d, 0x3b, 0x76, 0xcc, 0xde, 0xa9, 0xff, 0x45, 0xc6, 0x00, 0x5d, 0x8a, 0x0e, 0x87, 0xd6,
+	0xee, 0xd0, 0x99, 0x7f, 0x41, 0xc1, 0xbb, 0x23, 0x79, 0xfc, 0xb5, 0x75, 0xbb, 0x7b, 0xfc, 0xde,
+	0xf7, 0x8e, 0xef, 0xfb, 0xf8, 0x4e, 0x02, 0x8f, 0xe6, 0x9e, 0xbb, 0x24, 0x33, 0x3c, 0x33, 0xc9,
+	0xd0, 0x21, 0x3e, 0xb6, 0xb0, 0x8f, 0x87, 0xcb, 0x27, 0xf1, 0x7a, 0x30, 0xf7, 0x5c, 0xdf, 0xd5,
+	0x77, 0x13, 0xd8, 0x20, 0x7e, 0xb4, 0x7c, 0xb2, 0xdf, 0xba, 0x70, 0x2f, 0x5c, 0x0e, 0x19, 0x86,
+	0x2b, 0x81, 0x46, 0x3f, 0xad, 0x82, 0x8d, 0x31, 0xf6, 0xb0, 0x43, 0xf5, 0x57, 0x60, 0xc7, 0xc1,
+	0xd7, 0x53, 0x8f, 0x98, 0xae, 0x67, 0x4d, 0x97, 0xc4, 0xa3, 0xb6, 0x3b, 0xa3, 0x1d, 0xad, 0xa7,
+	0xf5, 0x6b, 0xa3, 0x6e, 0xc0, 0xe0, 0xfe, 0x1b, 0xec, 0x5c, 0x1d, 0xa1, 0x02, 0x10, 0x32, 0x9a,
+	0x0e, 0xbe, 0x36, 0x78, 0xf0, 0xb5, 0x8c, 0xe9, 0x5f, 0x81, 0x96, 0x65, 0x53, 0x7c, 0x76, 0x45,
+	0xa6, 0xf3, 0xe7, 0x64, 0xea, 0x10, 0x4a, 0xf1, 0x05, 0xa1, 0x9d, 0xd5, 0x9e, 0xd6, 0xdf, 0x1c,
+	0xc1, 0x80, 0xc1, 0x7b, 0x82, 0xb0, 0x08, 0x85, 0x0c, 0x5d, 0x86, 0xc7, 0xcf, 0xc9, 0xa9, 0x0c,
+	0x86, 0x94, 0xc4, 0xb1, 0xfd, 0xa9, 0x45, 0x7c, 0x6c, 0x5f, 0x11, 0x6b, 0x4a, 0x96, 0x64, 0xe6,
+	0xd3, 0xce, 0x5a, 0x96, 0xb2, 0x08, 0x85, 0x0c, 0x3d, 0x0c, 0x7f, 0x29, 0xa3, 0xc7, 0x3c, 0x78,
+	0xb4, 0xf9, 0xcb, 0x5b, 0xb8, 0xf2, 0xef, 0x5b, 0xa8, 0xa1, 0xdf, 0x57, 0x41, 0x65, 0x62, 0xba,
+	0x73, 0xf2, 0xc2, 0x7a, 0x31, 0x3b, 0x77, 0xf5, 0x63, 0xb0, 0x49, 0xc3, 0xed, 0xd4, 0xb6, 0x78,
+	0x13, 0xaa, 0xa3, 0xc3, 0x77, 0x0c, 0xae, 0xfc, 0xc9, 0x60, 0xfd, 0x54, 0xf6, 0xf5, 0x73, 0xcb,
+	0xf2, 0x08, 0xa5, 0x01, 0x83, 0x75, 0x51, 0x37, 0x4a, 0x40, 0xc6, 0x1d, 0x2a, 0xa8, 0xf4, 0x11,
+	0xa8, 0x47, 0xd1, 0xe9, 0xdc, 0x23, 0xe7, 0xf6, 0x35, 0xef, 0x40, 0x75, 0xb4, 0x1f, 0x30, 0xb8,
+	0x9b, 0x4e, 0x93, 0x00, 0x64, 0xd4, 0x64, 0xf6, 0x98, 0xef, 0xf5, 0x53, 0xb0, 0x13, 0x43, 0xc4,
+	0x62, 0xb1, 0xb0, 0x2d, 0xfe, 0xda, 0x55, 0x55, 0x9a, 0x02, 0x10, 0x32, 0x1a, 0x92, 0x8b, 0xbf,
+	0xdb, 0xd7, 0x0b, 0xdb, 0xd2, 0x9f, 0x01, 0x20, 0x00, 0xd8, 0xb2, 0xbc, 0xce, 0x7a, 0x4f, 0xeb,
+	0x6f, 0x8d, 0xda, 0x01, 0x83, 0x4d, 0x95, 0x25, 0x7c, 0x86, 0x8c, 0x2d, 0xbe, 0x09, 0xdf, 0x33,
+	0xc9, 0xe2, 0xb5, 0x3f, 0x28, 0xce, 0x12, 0x25, 0xb7, 0x68, 0x54, 0x0b, 0xfd, 0xb6, 0x0e, 0x6a,
+	0x13, 0x42, 0x43, 0x4b, 0xc8, 0xbe, 0xbe, 0x04, 0x80, 0x8a, 0x40, 0xd2, 0xd9, 0xc7, 0xe5, 0x9d,
+	0x8d, 0xe8, 0xe3, 0x94, 0x90, 0x3e, 0x22, 0xd4, 0x4f, 0x40, 0x33, 0x79, 0x92, 0xee, 0xef, 0x41,
+	0xc0, 0x60, 0x27, 0x9b, 0x1c, 0x77, 0xb8, 0x1e, 0x73, 0xc8, 0x1e, 0x4f, 0x40, 0x5b, 0x81, 0xe5,
+	0xba, 0xdc, 0x0b, 0x18, 0x3c, 0xc8, 0xb1, 0xa9, 0x2f, 0xad, 0xc7, 0x8c, 0x49, 0xa7, 0xbf, 0x01,
+	0x7b, 0x2a, 0x5a, 0x2e, 0x39, 0xed, 0x3a, 0xa7, 0x45, 0x01, 0x83, 0xdd, 0x3c, 0xad, 0x02, 0x44,
+	0x46, 0x2b, 0x21, 0x16, 0x0b, 0x4e, 0x7d, 0x04, 0xaa, 0x11, 0x8c, 0xcb, 0x28, 0x04, 0xd9, 0x0b,
+	0x18, 0xdc, 0x49, 0xf3, 0x09, 0x21, 0x2b, 0x72, 0xcb, 0xa5, 0x54, 0x72, 0xf9, 0x59, 0x36, 0xca,
+	0x72, 0xc5, 0x01, 0x2a, 0x54, 0xa9, 0x8b, 0x41, 0x2d, 0xb6, 0x99, 0x3d, 0x3b, 0x77, 0x3b, 0x77,
+	0x7a, 0x5a, 0xbf, 0xf2, 0xf4, 0xe1, 0xa0, 0x78, 0xee, 0x0c, 0x94, 0x4f, 0x6a, 0xd4, 0x09, 0x18,
+	0x6c, 0x65, 0xac, 0x1a, 0x72, 0x84, 0x25, 0x12, 0x18, 0xba, 0x59, 0x03, 0x55, 0x31, 0x4c, 0xa4,
+	0x65, 0x4e, 0xc0, 0x96, 0x9c, 0x38, 0xb1, 0x63, 0x3e, 0x2a, 0x77, 0x4c, 0x43, 0x54, 0x88, 0x33,
+	0x90, 0xb1, 0xe9, 0x49, 0x36, 0xfd, 0x18, 0x34, 0xe2, 0x78, 0xda, 0x2e, 0xf7, 0x02, 0x06, 0xf7,
+	0x32, 0x99, 0xb1, 0x5b, 0xb6, 0x23, 0x02, 0x69, 0x96, 0x31, 0x68, 0x25, 0xa0, 0x9c, 0x57, 0x94,
+	0x41, 0x54, 0x84, 0x42, 0x46, 0x33, 0xa2, 0x4b, 0x9c, 0x32, 0x01, 0xed, 0x04, 0x7b, 0x89, 0xe9,
+	0x25, 0xb1, 0xa6, 0x33, 0xec, 0x90, 0xce, 0x7a, 0xd6, 0x7e, 0x85, 0x30, 0x64, 0xe8, 0x11, 0xe7,
+	0x09, 0x8f, 0xbe, 0xc2, 0x0e, 0xd1, 0x3f, 0x03, 0x15, 0x89, 0x56, 0x2c, 0xb2, 0x1b, 0x30, 0xa8,
+	0xa7, 0xa8, 0x84, 0x43, 0x80, 0xd8, 0x71, 0x83, 0xe4, 0x44, 0xde, 0xf8, 0xdf, 0x45, 0xfe, 0x75,
+	0x0d, 0xd4, 0x79, 0xda, 0x64, 0x4e, 0x4c, 0xa9, 0xf3, 0x24, 0x2a, 0x4b, 0xe7, 0xc4, 0x4c, 0xb4,
+	0x1e, 0x96, 0x6b, 0x9d, 0x2a, 0x24, 0xb3, 0xa2, 0x42, 0x82, 0x38, 0xd4, 0x2a, 0xf5, 0x38, 0x2d,
+	0xbb, 0xa2, 0x55, 0x11, 0x0a, 0x19, 0x4d, 0x85, 0x4b, 0xaa, 0x6f, 0x83, 0xfb, 0x69, 0xac, 0xb2,
+	0x53, 0x6c, 0xd0, 0x0f, 0x18, 0xfc, 0xb0, 0x88, 0x3a, 0x03, 0x47, 0x46, 0x47, 0xa9, 0x11, 0xf7,
+	0x84, 0xdb, 0x22, 0xbe, 0x3d, 0x38, 0x5a, 0x99, 0xd7, 0xb9, 0xdb, 0x23, 0x06, 0x44, 0xb7, 0x47,
+	0xc8, 0xc1, 0xc5, 0x4c, 0x73, 0x28, 0xd3, 0xbb, 0x98, 0x43, 0x1c, 0xa9, 0x46, 0xd5, 0x73, 0xa0,
+	0x7f, 0xd6, 0x80, 0xfe, 0x85, 0x3b, 0xf3, 0x3d, 0x6c, 0xfa, 0x8a, 0x60, 0xdf, 0x81, 0x86, 0x29,
+	0xa3, 0x19, 0xcd, 0x9e, 0x96, 0x6b, 0x26, 0xbf, 0xb2, 0x6c, 0x22, 0x32, 0xb6, 0xcd, 0x54, 0x85,
+	0x70, 0x7a, 0x66, 0x41, 0x69, 0xf1, 0x94, 0xe9, 0x59, 0x02, 0x44, 0x46, 0x2b, 0x4d, 0x2a, 0x25,
+	0xfc, 0x01, 0x3c, 0xcc, 0x65, 0xa4, 0x03, 0x8a, 0x90, 0x83, 0x80, 0xc1, 0xc3, 0x92, 0x32, 0xf9,
+	0x24, 0x64, 0x74, 0xd3, 0x25, 0xd5, 0xbe, 0x71, 0x51, 0x5f, 0x02, 0x3d, 0x9d, 0xa6, 0xe8, 0x7a,
+	0x3f, 0x60, 0xf0, 0x6e, 0x51, 0x2d, 0x21, 0x6d, 0x43, 0xa5, 0xe6, 0xea, 0xe6, 0xc8, 0x14, 0x81,
+	0x4b, 0xc9, 0xe4, 0x2f, 0x03, 0x33, 0x73, 0x32, 0xf4, 0xf7, 0x3a, 0x68, 0x88, 0xc9, 0xab, 0x88,
+	0xfc, 0x1a, 0xc8, 0xf1, 0x97, 0x91, 0xf8, 0x93, 0x72, 0x89, 0xdb, 0xa9, 0xf9, 0x12, 0x0b, 0x5c,
+	0xf5, 0x14, 0x6e, 0x65, 0xe4, 0x15, 0x8a, 0x9b, 0x1f, 0x79, 0x59, 0x69, 0x75, 0x95, 0x4e, 0x0a,
+	0xbb, 0x00, 0x0f, 0x32, 0xe8, 0x52, 0x59, 0x1f, 0x07, 0x0c, 0xf6, 0x0b, 0x0b, 0x14, 0x35, 0xeb,
+	0x40, 0x2d, 0x96, 0x93, 0x14, 0x83, 0xfd, 0x0c, 0x47, 0x7e, 0x86, 0x3f, 0x0a, 0x18, 0x7c, 0x50,
+	0x58, 0x2f, 0x35, 0xc8, 0x77, 0xd5, 0x42, 0xca, 0x30, 0x4f, 0xae, 0xae, 0xc4, 0x33, 0x42, 0xe6,
+	0xfc, 0xd5, 0xa5, 0x38, 0x66, 0x3b, 0xa1, 0xe3, 0x7e, 0xf9, 0x11, 0xb4, 0x73, 0x26, 0x56, 0x46,
+	0xfc, 0x61, 0xd9, 0x88, 0xcf, 0x7f, 0xfd, 0xaa, 0x42, 0x85, 0x94, 0xc8, 0xd0, 0xcd, 0x7c, 0xd6,
+	0xf7, 0xef, 0x6e, 0xba, 0xda, 0xfb, 0x9b, 0xae, 0xf6, 0xd7, 0x4d, 0x57, 0xfb, 0xf9, 0xb6, 0xbb,
+	0xf2, 0xfe, 0xb6, 0xbb, 0xf2, 0xc7, 0x6d, 0x77, 0x05, 0xdc, 0xb5, 0xdd, 0x92, 0xea, 0x63, 0xed,
+	0xdb, 0x67, 0x17, 0xb6, 0x7f, 0xb9, 0x38, 0x1b, 0x98, 0xae, 0x33, 0x4c, 0x40, 0x1f, 0xdb, 0xae,
+	0xb2, 0x1b, 0x5e, 0x27, 0xff, 0x8c, 0xfc, 0x37, 0x73, 0x42, 0xcf, 0x36, 0xf8, 0xdf, 0x9c, 0x4f,
+	0xff, 0x1b, 0x00, 0x9f, 0x70, 0x14, 0xe2, 0x3d, 0x0d, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -645,6 +680,15 @@ func (this *Params) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if this.MaxRecordVersions != that1.MaxRecordVersions {
+		return false
+	}
+	if this.DisableP8EMessages != that1.DisableP8EMessages {
+		return false
+	}
+	if this.EmitDetailedEvents != that1.EmitDetailedEvents {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -667,6 +711,31 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.EmitDetailedEvents {
+		i--
+		if m.EmitDetailedEvents {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.DisableP8EMessages {
+		i--
+		if m.DisableP8EMessages {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxRecordVersions != 0 {
+		i = encodeVarintMetadata(dAtA, i, uint64(m.MaxRecordVersions))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -1096,6 +1165,15 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.MaxRecordVersions != 0 {
+		n += 1 + sovMetadata(uint64(m.MaxRecordVersions))
+	}
+	if m.DisableP8EMessages {
+		n += 2
+	}
+	if m.EmitDetailedEvents {
+		n += 2
+	}
 	return n
 }
 
@@ -1312,6 +1390,65 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRecordVersions", wireType)
+			}
+			m.MaxRecordVersions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRecordVersions |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableP8EMessages", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DisableP8EMessages = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmitDetailedEvents", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EmitDetailedEvents = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetadata(dAtA[iNdEx:])
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/url"
 	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/google/uuid"
@@ -15,11 +16,17 @@ import (
 
 const (
 	TypeMsgWriteScopeRequest                      = "write_scope_request"
+	TypeMsgWriteScopeBundleRequest                = "write_scope_bundle_request"
 	TypeMsgDeleteScopeRequest                     = "delete_scope_request"
 	TypeMsgAddScopeDataAccessRequest              = "add_scope_data_access_request"
 	TypeMsgDeleteScopeDataAccessRequest           = "delete_scope_data_access_request"
 	TypeMsgAddScopeOwnerRequest                   = "add_scope_owner_request"
 	TypeMsgDeleteScopeOwnerRequest                = "delete_scope_owner_request"
+	TypeMsgTokenizeScopeValueOwnerRequest         = "tokenize_scope_value_owner_request"
+	TypeMsgOfferScopeSaleRequest                  = "offer_scope_sale_request"
+	TypeMsgCancelScopeSaleRequest                 = "cancel_scope_sale_request"
+	TypeMsgAcceptScopeSaleRequest                 = "accept_scope_sale_request"
+	TypeMsgMigrateScopeSpecificationRequest       = "migrate_scope_specification_request"
 	TypeMsgWriteSessionRequest                    = "write_session_request"
 	TypeMsgWriteRecordRequest                     = "write_record_request"
 	TypeMsgDeleteRecordRequest                    = "delete_record_request"
@@ -41,11 +48,17 @@ const (
 // Message type URLs are generated by running unit test in msg_test.go => TestPrintMessageTypeStrings
 const (
 	TypeURLMsgWriteScopeRequest                      = "/provenance.metadata.v1.MsgWriteScopeRequest"
+	TypeURLMsgWriteScopeBundleRequest                = "/provenance.metadata.v1.MsgWriteScopeBundleRequest"
 	TypeURLMsgDeleteScopeRequest                     = "/provenance.metadata.v1.MsgDeleteScopeRequest"
 	TypeURLMsgAddScopeDataAccessRequest              = "/provenance.metadata.v1.MsgAddScopeDataAccessRequest"
 	TypeURLMsgDeleteScopeDataAccessRequest           = "/provenance.metadata.v1.MsgDeleteScopeDataAccessRequest"
 	TypeURLMsgAddScopeOwnerRequest                   = "/provenance.metadata.v1.MsgAddScopeOwnerRequest"
 	TypeURLMsgDeleteScopeOwnerRequest                = "/provenance.metadata.v1.MsgDeleteScopeOwnerRequest"
+	TypeURLMsgTokenizeScopeValueOwnerRequest         = "/provenance.metadata.v1.MsgTokenizeScopeValueOwnerRequest"
+	TypeURLMsgOfferScopeSaleRequest                  = "/provenance.metadata.v1.MsgOfferScopeSaleRequest"
+	TypeURLMsgCancelScopeSaleRequest                 = "/provenance.metadata.v1.MsgCancelScopeSaleRequest"
+	TypeURLMsgAcceptScopeSaleRequest                 = "/provenance.metadata.v1.MsgAcceptScopeSaleRequest"
+	TypeURLMsgMigrateScopeSpecificationRequest       = "/provenance.metadata.v1.MsgMigrateScopeSpecificationRequest"
 	TypeURLMsgWriteSessionRequest                    = "/provenance.metadata.v1.MsgWriteSessionRequest"
 	TypeURLMsgWriteRecordRequest                     = "/provenance.metadata.v1.MsgWriteRecordRequest"
 	TypeURLMsgDeleteRecordRequest                    = "/provenance.metadata.v1.MsgDeleteRecordRequest"
@@ -67,11 +80,17 @@ const (
 // Compile time interface checks.
 var (
 	_ sdk.Msg = &MsgWriteScopeRequest{}
+	_ sdk.Msg = &MsgWriteScopeBundleRequest{}
 	_ sdk.Msg = &MsgDeleteScopeRequest{}
 	_ sdk.Msg = &MsgAddScopeDataAccessRequest{}
 	_ sdk.Msg = &MsgDeleteScopeDataAccessRequest{}
 	_ sdk.Msg = &MsgAddScopeOwnerRequest{}
 	_ sdk.Msg = &MsgDeleteScopeOwnerRequest{}
+	_ sdk.Msg = &MsgTokenizeScopeValueOwnerRequest{}
+	_ sdk.Msg = &MsgOfferScopeSaleRequest{}
+	_ sdk.Msg = &MsgCancelScopeSaleRequest{}
+	_ sdk.Msg = &MsgAcceptScopeSaleRequest{}
+	_ sdk.Msg = &MsgMigrateScopeSpecificationRequest{}
 	_ sdk.Msg = &MsgWriteSessionRequest{}
 	_ sdk.Msg = &MsgWriteRecordRequest{}
 	_ sdk.Msg = &MsgDeleteRecordRequest{}
@@ -194,6 +213,118 @@ func (msg *MsgWriteScopeRequest) ConvertOptionalFields() error {
 	return nil
 }
 
+// ------------------  MsgWriteScopeBundleRequest  ------------------
+
+// MaxScopeBundleItems is the most scopes, sessions, and records (combined) that can be in a MsgWriteScopeBundleRequest.
+const MaxScopeBundleItems = 500
+
+// NewMsgWriteScopeBundleRequest creates a new msg instance
+func NewMsgWriteScopeBundleRequest(bundles []ScopeBundle, signers []string) *MsgWriteScopeBundleRequest {
+	return &MsgWriteScopeBundleRequest{
+		Bundles: bundles,
+		Signers: signers,
+	}
+}
+
+func (msg MsgWriteScopeBundleRequest) String() string {
+	out, _ := yaml.Marshal(msg)
+	return string(out)
+}
+
+// Route returns the module route
+func (msg MsgWriteScopeBundleRequest) Route() string {
+	return ModuleName
+}
+
+// Type returns the type name for this msg
+func (msg MsgWriteScopeBundleRequest) Type() string {
+	return TypeMsgWriteScopeBundleRequest
+}
+
+func (msg MsgWriteScopeBundleRequest) MsgTypeURL() string {
+	return TypeURLMsgWriteScopeBundleRequest
+}
+
+// GetSigners returns the address(es) that must sign over msg.GetSignBytes()
+func (msg MsgWriteScopeBundleRequest) GetSigners() []sdk.AccAddress {
+	return stringsToAccAddresses(msg.Signers)
+}
+
+// GetSignBytes gets the bytes for the message signer to sign on
+func (msg MsgWriteScopeBundleRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic performs a quick validity check
+func (msg MsgWriteScopeBundleRequest) ValidateBasic() error {
+	if len(msg.Signers) < 1 {
+		return fmt.Errorf("at least one signer is required")
+	}
+	if len(msg.Bundles) == 0 {
+		return fmt.Errorf("at least one bundle is required")
+	}
+	items := 0
+	for i, bundle := range msg.Bundles {
+		for _, other := range msg.Bundles[:i] {
+			if bundle.Scope.ScopeId.Equals(other.Scope.ScopeId) {
+				return fmt.Errorf("duplicate scope %s in bundles", bundle.Scope.ScopeId)
+			}
+		}
+		if err := bundle.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid bundle [%d]: %w", i, err)
+		}
+		items += 1 + len(bundle.Sessions) + len(bundle.Records)
+	}
+	if items > MaxScopeBundleItems {
+		return fmt.Errorf("too many items in bundles: %d > %d", items, MaxScopeBundleItems)
+	}
+	return nil
+}
+
+// ValidateBasic performs a quick validity check on the scope, sessions, and records of this bundle
+// and makes sure the sessions and records are all part of the bundle's scope.
+func (b ScopeBundle) ValidateBasic() error {
+	if err := b.Scope.ValidateBasic(); err != nil {
+		return err
+	}
+	for i, session := range b.Sessions {
+		if err := session.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid session [%d]: %w", i, err)
+		}
+		if !b.Scope.ScopeId.Equals(scopeAddressOf(session.SessionId)) {
+			return fmt.Errorf("session %s is not part of scope %s", session.SessionId, b.Scope.ScopeId)
+		}
+		for _, other := range b.Sessions[:i] {
+			if session.SessionId.Equals(other.SessionId) {
+				return fmt.Errorf("duplicate session %s", session.SessionId)
+			}
+		}
+	}
+	for i, record := range b.Records {
+		if err := record.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid record [%d]: %w", i, err)
+		}
+		if !b.Scope.ScopeId.Equals(scopeAddressOf(record.SessionId)) {
+			return fmt.Errorf("record %q is not part of scope %s", record.Name, b.Scope.ScopeId)
+		}
+		for _, other := range b.Records[:i] {
+			if record.Name == other.Name {
+				return fmt.Errorf("duplicate record %q", record.Name)
+			}
+		}
+	}
+	return nil
+}
+
+// scopeAddressOf returns the scope address of the provided address, or nil if it doesn't have one.
+func scopeAddressOf(addr MetadataAddress) MetadataAddress {
+	scopeAddr, err := addr.AsScopeAddress()
+	if err != nil {
+		return nil
+	}
+	return scopeAddr
+}
+
 // ------------------  NewMsgDeleteScopeRequest  ------------------
 
 // NewMsgDeleteScopeRequest creates a new msg instance
@@ -292,11 +423,20 @@ func (msg MsgAddScopeDataAccessRequest) ValidateBasic() error {
 	if len(msg.DataAccess) < 1 {
 		return fmt.Errorf("at least one data access address is required")
 	}
-	for _, da := range msg.DataAccess {
+	for i, da := range msg.DataAccess {
 		_, err := sdk.AccAddressFromBech32(da)
 		if err != nil {
 			return fmt.Errorf("data access address is invalid: %s", da)
 		}
+		if containsString(msg.DataAccess[:i], da) {
+			return fmt.Errorf("duplicate data access address: %s", da)
+		}
+	}
+	if len(msg.Role) > maxDataAccessRoleLength {
+		return fmt.Errorf("data access role length %d exceeds maximum length of %d", len(msg.Role), maxDataAccessRoleLength)
+	}
+	if msg.Expiration != nil && msg.Expiration.IsZero() {
+		return fmt.Errorf("data access expiration cannot be the zero time")
 	}
 	if len(msg.Signers) < 1 {
 		return fmt.Errorf("at least one signer is required")
@@ -478,6 +618,297 @@ func (msg MsgDeleteScopeOwnerRequest) ValidateBasic() error {
 	return nil
 }
 
+// ------------------  MsgTokenizeScopeValueOwnerRequest  ------------------
+
+// NewMsgTokenizeScopeValueOwnerRequest creates a new msg instance
+func NewMsgTokenizeScopeValueOwnerRequest(scopeID MetadataAddress, supply sdk.Int, signers []string) *MsgTokenizeScopeValueOwnerRequest {
+	return &MsgTokenizeScopeValueOwnerRequest{
+		ScopeId: scopeID,
+		Supply:  supply,
+		Signers: signers,
+	}
+}
+
+func (msg MsgTokenizeScopeValueOwnerRequest) String() string {
+	out, _ := yaml.Marshal(msg)
+	return string(out)
+}
+
+// Route returns the module route
+func (msg MsgTokenizeScopeValueOwnerRequest) Route() string {
+	return ModuleName
+}
+
+// Type returns the type name for this msg
+func (msg MsgTokenizeScopeValueOwnerRequest) Type() string {
+	return TypeMsgTokenizeScopeValueOwnerRequest
+}
+
+func (msg MsgTokenizeScopeValueOwnerRequest) MsgTypeURL() string {
+	return TypeURLMsgTokenizeScopeValueOwnerRequest
+}
+
+// GetSigners returns the address(es) that must sign over msg.GetSignBytes()
+func (msg MsgTokenizeScopeValueOwnerRequest) GetSigners() []sdk.AccAddress {
+	return stringsToAccAddresses(msg.Signers)
+}
+
+// GetSignBytes gets the bytes for the message signer to sign on
+func (msg MsgTokenizeScopeValueOwnerRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic performs a quick validity check
+func (msg MsgTokenizeScopeValueOwnerRequest) ValidateBasic() error {
+	if !msg.ScopeId.IsScopeAddress() {
+		return fmt.Errorf("address is not a scope id: %v", msg.ScopeId.String())
+	}
+	if msg.Supply.IsNil() || !msg.Supply.IsPositive() {
+		return fmt.Errorf("supply must be greater than zero")
+	}
+	if len(msg.Signers) < 1 {
+		return fmt.Errorf("at least one signer is required")
+	}
+	return nil
+}
+
+// ------------------  MsgOfferScopeSaleRequest  ------------------
+
+// NewMsgOfferScopeSaleRequest creates a new msg instance
+func NewMsgOfferScopeSaleRequest(scopeID MetadataAddress, buyer string, price sdk.Coins, expiration time.Time, signers []string) *MsgOfferScopeSaleRequest {
+	return &MsgOfferScopeSaleRequest{
+		ScopeId:    scopeID,
+		Buyer:      buyer,
+		Price:      price,
+		Expiration: expiration,
+		Signers:    signers,
+	}
+}
+
+func (msg MsgOfferScopeSaleRequest) String() string {
+	out, _ := yaml.Marshal(msg)
+	return string(out)
+}
+
+// Route returns the module route
+func (msg MsgOfferScopeSaleRequest) Route() string {
+	return ModuleName
+}
+
+// Type returns the type name for this msg
+func (msg MsgOfferScopeSaleRequest) Type() string {
+	return TypeMsgOfferScopeSaleRequest
+}
+
+func (msg MsgOfferScopeSaleRequest) MsgTypeURL() string {
+	return TypeURLMsgOfferScopeSaleRequest
+}
+
+// GetSigners returns the address(es) that must sign over msg.GetSignBytes()
+func (msg MsgOfferScopeSaleRequest) GetSigners() []sdk.AccAddress {
+	return stringsToAccAddresses(msg.Signers)
+}
+
+// GetSignBytes gets the bytes for the message signer to sign on
+func (msg MsgOfferScopeSaleRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic performs a quick validity check
+func (msg MsgOfferScopeSaleRequest) ValidateBasic() error {
+	if !msg.ScopeId.IsScopeAddress() {
+		return fmt.Errorf("address is not a scope id: %v", msg.ScopeId.String())
+	}
+	if len(msg.Buyer) > 0 {
+		if _, err := sdk.AccAddressFromBech32(msg.Buyer); err != nil {
+			return fmt.Errorf("invalid buyer address: %w", err)
+		}
+	}
+	if err := validateScopeSalePrice(msg.Price); err != nil {
+		return err
+	}
+	if msg.Expiration.IsZero() {
+		return fmt.Errorf("expiration cannot be empty")
+	}
+	if len(msg.Signers) < 1 {
+		return fmt.Errorf("at least one signer is required")
+	}
+	return nil
+}
+
+// ------------------  MsgCancelScopeSaleRequest  ------------------
+
+// NewMsgCancelScopeSaleRequest creates a new msg instance
+func NewMsgCancelScopeSaleRequest(scopeID MetadataAddress, signers []string) *MsgCancelScopeSaleRequest {
+	return &MsgCancelScopeSaleRequest{
+		ScopeId: scopeID,
+		Signers: signers,
+	}
+}
+
+func (msg MsgCancelScopeSaleRequest) String() string {
+	out, _ := yaml.Marshal(msg)
+	return string(out)
+}
+
+// Route returns the module route
+func (msg MsgCancelScopeSaleRequest) Route() string {
+	return ModuleName
+}
+
+// Type returns the type name for this msg
+func (msg MsgCancelScopeSaleRequest) Type() string {
+	return TypeMsgCancelScopeSaleRequest
+}
+
+func (msg MsgCancelScopeSaleRequest) MsgTypeURL() string {
+	return TypeURLMsgCancelScopeSaleRequest
+}
+
+// GetSigners returns the address(es) that must sign over msg.GetSignBytes()
+func (msg MsgCancelScopeSaleRequest) GetSigners() []sdk.AccAddress {
+	return stringsToAccAddresses(msg.Signers)
+}
+
+// GetSignBytes gets the bytes for the message signer to sign on
+func (msg MsgCancelScopeSaleRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic performs a quick validity check
+func (msg MsgCancelScopeSaleRequest) ValidateBasic() error {
+	if !msg.ScopeId.IsScopeAddress() {
+		return fmt.Errorf("address is not a scope id: %v", msg.ScopeId.String())
+	}
+	if len(msg.Signers) < 1 {
+		return fmt.Errorf("at least one signer is required")
+	}
+	return nil
+}
+
+// ------------------  MsgAcceptScopeSaleRequest  ------------------
+
+// NewMsgAcceptScopeSaleRequest creates a new msg instance
+func NewMsgAcceptScopeSaleRequest(scopeID MetadataAddress, buyer string, price sdk.Coins, signers []string) *MsgAcceptScopeSaleRequest {
+	return &MsgAcceptScopeSaleRequest{
+		ScopeId: scopeID,
+		Buyer:   buyer,
+		Price:   price,
+		Signers: signers,
+	}
+}
+
+func (msg MsgAcceptScopeSaleRequest) String() string {
+	out, _ := yaml.Marshal(msg)
+	return string(out)
+}
+
+// Route returns the module route
+func (msg MsgAcceptScopeSaleRequest) Route() string {
+	return ModuleName
+}
+
+// Type returns the type name for this msg
+func (msg MsgAcceptScopeSaleRequest) Type() string {
+	return TypeMsgAcceptScopeSaleRequest
+}
+
+func (msg MsgAcceptScopeSaleRequest) MsgTypeURL() string {
+	return TypeURLMsgAcceptScopeSaleRequest
+}
+
+// GetSigners returns the address(es) that must sign over msg.GetSignBytes()
+func (msg MsgAcceptScopeSaleRequest) GetSigners() []sdk.AccAddress {
+	return stringsToAccAddresses(msg.Signers)
+}
+
+// GetSignBytes gets the bytes for the message signer to sign on
+func (msg MsgAcceptScopeSaleRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic performs a quick validity check
+func (msg MsgAcceptScopeSaleRequest) ValidateBasic() error {
+	if !msg.ScopeId.IsScopeAddress() {
+		return fmt.Errorf("address is not a scope id: %v", msg.ScopeId.String())
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Buyer); err != nil {
+		return fmt.Errorf("invalid buyer address: %w", err)
+	}
+	if err := validateScopeSalePrice(msg.Price); err != nil {
+		return err
+	}
+	if len(msg.Signers) < 1 {
+		return fmt.Errorf("at least one signer is required")
+	}
+	return nil
+}
+
+// ------------------  MsgMigrateScopeSpecificationRequest  ------------------
+
+// NewMsgMigrateScopeSpecificationRequest creates a new msg instance
+func NewMsgMigrateScopeSpecificationRequest(scopeID, specificationID MetadataAddress, signers []string) *MsgMigrateScopeSpecificationRequest {
+	return &MsgMigrateScopeSpecificationRequest{
+		ScopeId:         scopeID,
+		SpecificationId: specificationID,
+		Signers:         signers,
+	}
+}
+
+func (msg MsgMigrateScopeSpecificationRequest) String() string {
+	out, _ := yaml.Marshal(msg)
+	return string(out)
+}
+
+// Route returns the module route
+func (msg MsgMigrateScopeSpecificationRequest) Route() string {
+	return ModuleName
+}
+
+// Type returns the type name for this msg
+func (msg MsgMigrateScopeSpecificationRequest) Type() string {
+	return TypeMsgMigrateScopeSpecificationRequest
+}
+
+func (msg MsgMigrateScopeSpecificationRequest) MsgTypeURL() string {
+	return TypeURLMsgMigrateScopeSpecificationRequest
+}
+
+// GetSigners returns the address(es) that must sign over msg.GetSignBytes()
+func (msg MsgMigrateScopeSpecificationRequest) GetSigners() []sdk.AccAddress {
+	return stringsToAccAddresses(msg.Signers)
+}
+
+// GetSignBytes gets the bytes for the message signer to sign on
+func (msg MsgMigrateScopeSpecificationRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic performs a quick validity check
+func (msg MsgMigrateScopeSpecificationRequest) ValidateBasic() error {
+	if !msg.ScopeId.IsScopeAddress() {
+		return fmt.Errorf("address is not a scope id: %v", msg.ScopeId.String())
+	}
+	if !msg.SpecificationId.IsScopeSpecificationAddress() {
+		return fmt.Errorf("address is not a scope specification id: %v", msg.SpecificationId.String())
+	}
+	if len(msg.Signers) < 1 {
+		return fmt.Errorf("at least one signer is required")
+	}
+	return nil
+}
+
+// validateScopeSalePrice makes sure a scope sale price is a valid, non-empty set of coins.
+func validateScopeSalePrice(price sdk.Coins) error {
+	if price.Empty() {
+		return fmt.Errorf("price cannot be empty")
+	}
+	if err := price.Validate(); err != nil {
+		return fmt.Errorf("invalid price: %w", err)
+	}
+	return nil
+}
+
 // ------------------  MsgWriteSessionRequest  ------------------
 
 // NewMsgWriteSessionRequest creates a new msg instance
@@ -1238,7 +1669,7 @@ func (msg MsgBindOSLocatorRequest) MsgTypeURL() string {
 }
 
 func (msg MsgBindOSLocatorRequest) ValidateBasic() error {
-	err := ValidateOSLocatorObj(msg.Locator.Owner, msg.Locator.EncryptionKey, msg.Locator.LocatorUri)
+	err := msg.Locator.ValidateBasic()
 	if err != nil {
 		return err
 	}
@@ -1273,7 +1704,7 @@ func (msg MsgDeleteOSLocatorRequest) MsgTypeURL() string {
 }
 
 func (msg MsgDeleteOSLocatorRequest) ValidateBasic() error {
-	err := ValidateOSLocatorObj(msg.Locator.Owner, msg.Locator.EncryptionKey, msg.Locator.LocatorUri)
+	err := msg.Locator.ValidateBasic()
 	if err != nil {
 		return err
 	}
@@ -1325,9 +1756,10 @@ func ValidateOSLocatorObj(ownerAddr, encryptionKey string, uri string) error {
 
 // ------------------  MsgModifyOSLocatorRequest  ------------------
 
-func NewMsgModifyOSLocatorRequest(obj ObjectStoreLocator) *MsgModifyOSLocatorRequest {
+func NewMsgModifyOSLocatorRequest(obj ObjectStoreLocator, updateFields ...string) *MsgModifyOSLocatorRequest {
 	return &MsgModifyOSLocatorRequest{
-		Locator: obj,
+		Locator:      obj,
+		UpdateFields: updateFields,
 	}
 }
 
@@ -1344,12 +1776,12 @@ func (msg MsgModifyOSLocatorRequest) MsgTypeURL() string {
 }
 
 func (msg MsgModifyOSLocatorRequest) ValidateBasic() error {
-	err := ValidateOSLocatorObj(msg.Locator.Owner, msg.Locator.EncryptionKey, msg.Locator.LocatorUri)
+	err := msg.Locator.ValidateBasic()
 	if err != nil {
 		return err
 	}
 
-	return nil
+	return ValidateOSLocatorUpdateFields(msg.UpdateFields)
 }
 
 func (msg MsgModifyOSLocatorRequest) GetSignBytes() []byte {
@@ -1430,6 +1862,29 @@ func NewMsgDeleteScopeOwnerResponse() *MsgDeleteScopeOwnerResponse {
 	return &MsgDeleteScopeOwnerResponse{}
 }
 
+func NewMsgTokenizeScopeValueOwnerResponse(denom string, markerAddr sdk.AccAddress) *MsgTokenizeScopeValueOwnerResponse {
+	return &MsgTokenizeScopeValueOwnerResponse{
+		Denom:         denom,
+		MarkerAddress: markerAddr.String(),
+	}
+}
+
+func NewMsgOfferScopeSaleResponse() *MsgOfferScopeSaleResponse {
+	return &MsgOfferScopeSaleResponse{}
+}
+
+func NewMsgCancelScopeSaleResponse() *MsgCancelScopeSaleResponse {
+	return &MsgCancelScopeSaleResponse{}
+}
+
+func NewMsgAcceptScopeSaleResponse() *MsgAcceptScopeSaleResponse {
+	return &MsgAcceptScopeSaleResponse{}
+}
+
+func NewMsgMigrateScopeSpecificationResponse() *MsgMigrateScopeSpecificationResponse {
+	return &MsgMigrateScopeSpecificationResponse{}
+}
+
 func NewMsgWriteSessionResponse(sessionID MetadataAddress) *MsgWriteSessionResponse {
 	return &MsgWriteSessionResponse{
 		SessionIdInfo: GetSessionIDInfo(sessionID),
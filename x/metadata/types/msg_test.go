@@ -3,7 +3,9 @@ package types
 import (
 	"encoding/hex"
 	"fmt"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -96,6 +98,88 @@ func TestWriteScopeValidation(t *testing.T) {
 	require.Equal(t, sdk.AccAddress(x), requiredSigners[0])
 }
 
+func TestWriteScopeBundleValidateBasic(t *testing.T) {
+	owner := "cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck"
+	newBundle := func(scopeUUID uuid.UUID) ScopeBundle {
+		sessionID := SessionMetadataAddress(scopeUUID, uuid.New())
+		return ScopeBundle{
+			Scope: *NewScope(ScopeMetadataAddress(scopeUUID), ScopeSpecMetadataAddress(uuid.New()), ownerPartyList(owner), nil, ""),
+			Sessions: []Session{
+				*NewSession("session", sessionID, ContractSpecMetadataAddress(uuid.New()), ownerPartyList(owner), nil),
+			},
+			Records: []Record{
+				*NewRecord("record", sessionID, *NewProcess("process", &Process_Hash{Hash: "prochash"}, "method"), nil, nil, nil),
+			},
+		}
+	}
+	scopeUUID := uuid.New()
+	otherScopeUUID := uuid.New()
+	foreignSessionID := SessionMetadataAddress(otherScopeUUID, uuid.New())
+
+	withForeignSession := newBundle(scopeUUID)
+	withForeignSession.Sessions[0].SessionId = foreignSessionID
+	withForeignRecord := newBundle(scopeUUID)
+	withForeignRecord.Records[0].SessionId = foreignSessionID
+	withDupRecord := newBundle(scopeUUID)
+	withDupRecord.Records = append(withDupRecord.Records, withDupRecord.Records[0])
+
+	cases := map[string]struct {
+		msg      *MsgWriteScopeBundleRequest
+		wantErr  bool
+		errorMsg string
+	}{
+		"should fail to validate basic, requires at least one signer": {
+			NewMsgWriteScopeBundleRequest([]ScopeBundle{newBundle(scopeUUID)}, []string{}),
+			true,
+			"at least one signer is required",
+		},
+		"should fail to validate basic, requires at least one bundle": {
+			NewMsgWriteScopeBundleRequest(nil, []string{owner}),
+			true,
+			"at least one bundle is required",
+		},
+		"should fail to validate basic, duplicate scope": {
+			NewMsgWriteScopeBundleRequest([]ScopeBundle{newBundle(scopeUUID), newBundle(scopeUUID)}, []string{owner}),
+			true,
+			fmt.Sprintf("duplicate scope %s in bundles", ScopeMetadataAddress(scopeUUID)),
+		},
+		"should fail to validate basic, session from another scope": {
+			NewMsgWriteScopeBundleRequest([]ScopeBundle{withForeignSession}, []string{owner}),
+			true,
+			fmt.Sprintf("invalid bundle [0]: session %s is not part of scope %s", foreignSessionID, ScopeMetadataAddress(scopeUUID)),
+		},
+		"should fail to validate basic, record from another scope": {
+			NewMsgWriteScopeBundleRequest([]ScopeBundle{withForeignRecord}, []string{owner}),
+			true,
+			fmt.Sprintf("invalid bundle [0]: record \"record\" is not part of scope %s", ScopeMetadataAddress(scopeUUID)),
+		},
+		"should fail to validate basic, duplicate record": {
+			NewMsgWriteScopeBundleRequest([]ScopeBundle{withDupRecord}, []string{owner}),
+			true,
+			"invalid bundle [0]: duplicate record \"record\"",
+		},
+		"should successfully validate basic": {
+			NewMsgWriteScopeBundleRequest([]ScopeBundle{newBundle(scopeUUID), newBundle(otherScopeUUID)}, []string{owner}),
+			false,
+			"",
+		},
+	}
+
+	for n, tc := range cases {
+		tc := tc
+
+		t.Run(n, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.wantErr {
+				require.Error(t, err)
+				require.Equal(t, tc.errorMsg, err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestAddScopeDataAccessValidateBasic(t *testing.T) {
 	notAScopeId := RecordMetadataAddress(uuid.New(), "recordname")
 	actualScopeId := ScopeMetadataAddress(uuid.New())
@@ -120,6 +204,11 @@ func TestAddScopeDataAccessValidateBasic(t *testing.T) {
 			true,
 			"data access address is invalid: notabech32address",
 		},
+		"should fail to validate basic, duplicate data access address": {
+			NewMsgAddScopeDataAccessRequest(actualScopeId, []string{"cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck", "cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck"}, []string{"cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck"}),
+			true,
+			"duplicate data access address: cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck",
+		},
 		"should fail to validate basic, requires at least one signer": {
 			NewMsgAddScopeDataAccessRequest(actualScopeId, []string{"cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck"}, []string{}),
 			true,
@@ -495,6 +584,13 @@ func TestModifyOSLocator(t *testing.T) {
 	require.Equal(t, ModuleName, modifyRequest.Route())
 	require.Equal(t, TypeMsgModifyOSLocatorRequest, modifyRequest.Type())
 	require.Equal(t, "{\"type\":\"provenance/metadata/ModifyOSLocatorRequest\",\"value\":{\"locator\":{\"locator_uri\":\"http://foo.com\",\"owner\":\"cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck\"}}}", string(modifyRequest.GetSignBytes()))
+
+	modifyRequest.UpdateFields = []string{OSLocatorFieldPriority, OSLocatorFieldState}
+	require.NoError(t, modifyRequest.ValidateBasic())
+	modifyRequest.UpdateFields = []string{OSLocatorFieldPriority, "owner"}
+	require.EqualError(t, modifyRequest.ValidateBasic(), `unknown locator update field "owner"`)
+	modifyRequest.UpdateFields = []string{OSLocatorFieldPriority, OSLocatorFieldPriority}
+	require.EqualError(t, modifyRequest.ValidateBasic(), `duplicate locator update field "priority"`)
 }
 
 func TestDeleteOSLocator(t *testing.T) {
@@ -531,6 +627,22 @@ func TestBindOSLocatorInvalidURI(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestBindOSLocatorInvalidNameAndKeyWindow(t *testing.T) {
+	from := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	locator := ObjectStoreLocator{Owner: "cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck", LocatorUri: "http://foo.com", Name: "backup"}
+	locator.KeyValidFrom = &from
+	require.NoError(t, NewMsgBindOSLocatorRequest(locator).ValidateBasic())
+
+	locator.KeyValidUntil = &from
+	require.EqualError(t, NewMsgBindOSLocatorRequest(locator).ValidateBasic(),
+		"key valid until 2023-01-01T00:00:00Z must be after key valid from 2023-01-01T00:00:00Z")
+
+	locator.KeyValidUntil = nil
+	locator.Name = strings.Repeat("n", MaxOSLocatorNameLength+1)
+	require.EqualError(t, NewMsgBindOSLocatorRequest(locator).ValidateBasic(),
+		fmt.Sprintf("locator name %q exceeds maximum length of %d", locator.Name, MaxOSLocatorNameLength))
+}
+
 type MsgTypeURL interface {
 	MsgTypeURL() string
 }
@@ -538,11 +650,17 @@ type MsgTypeURL interface {
 func TestPrintMessageTypeStrings(t *testing.T) {
 	messageTypes := []sdk.Msg{
 		&MsgWriteScopeRequest{},
+		&MsgWriteScopeBundleRequest{},
 		&MsgDeleteScopeRequest{},
 		&MsgAddScopeDataAccessRequest{},
 		&MsgDeleteScopeDataAccessRequest{},
 		&MsgAddScopeOwnerRequest{},
 		&MsgDeleteScopeOwnerRequest{},
+		&MsgTokenizeScopeValueOwnerRequest{},
+		&MsgOfferScopeSaleRequest{},
+		&MsgCancelScopeSaleRequest{},
+		&MsgAcceptScopeSaleRequest{},
+		&MsgMigrateScopeSpecificationRequest{},
 		&MsgWriteSessionRequest{},
 		&MsgWriteRecordRequest{},
 		&MsgDeleteRecordRequest{},
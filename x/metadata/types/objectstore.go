@@ -1,14 +1,134 @@
 package types
 
 import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+const (
+	// DefaultOSLocatorName is the name used for an object store locator that was not given one.
+	DefaultOSLocatorName = "default"
+	// MaxOSLocatorNameLength is the maximum length of an object store locator name.
+	MaxOSLocatorNameLength = 32
+)
+
+// The names of the object store locator fields that can be listed in a MsgModifyOSLocatorRequest's update fields.
+const (
+	OSLocatorFieldLocatorURI    = "locator_uri"
+	OSLocatorFieldEncryptionKey = "encryption_key"
+	OSLocatorFieldPriority      = "priority"
+	OSLocatorFieldState         = "state"
+	OSLocatorFieldKeyValidFrom  = "key_valid_from"
+	OSLocatorFieldKeyValidUntil = "key_valid_until"
+)
+
 // NewOSLocatorRecord creates a oslocator for a given address.
 func NewOSLocatorRecord(ownerAddr, encryptionKey sdk.AccAddress, uri string) ObjectStoreLocator { //nolint:interfacer
 	return ObjectStoreLocator{
 		Owner:         ownerAddr.String(),
 		LocatorUri:    uri,
 		EncryptionKey: encryptionKey.String(),
+		Name:          DefaultOSLocatorName,
+	}
+}
+
+// NormalizeOSLocatorName returns the provided locator name, or the default locator name if it is empty.
+func NormalizeOSLocatorName(name string) string {
+	name = strings.TrimSpace(name)
+	if len(name) == 0 {
+		return DefaultOSLocatorName
+	}
+	return name
+}
+
+// ValidateBasic performs static checking of an ObjectStoreLocator.
+func (l ObjectStoreLocator) ValidateBasic() error {
+	if err := ValidateOSLocatorObj(l.Owner, l.EncryptionKey, l.LocatorUri); err != nil {
+		return err
+	}
+	if len(l.Name) > MaxOSLocatorNameLength {
+		return fmt.Errorf("locator name %q exceeds maximum length of %d", l.Name, MaxOSLocatorNameLength)
+	}
+	if l.Name != strings.TrimSpace(l.Name) {
+		return fmt.Errorf("locator name %q cannot have leading or trailing whitespace", l.Name)
+	}
+	if _, ok := ObjectStoreLocatorState_name[int32(l.State)]; !ok {
+		return fmt.Errorf("invalid locator state: %d", l.State)
+	}
+	if l.KeyValidFrom != nil && l.KeyValidUntil != nil && !l.KeyValidUntil.After(*l.KeyValidFrom) {
+		return fmt.Errorf("key valid until %s must be after key valid from %s",
+			l.KeyValidUntil.Format(time.RFC3339), l.KeyValidFrom.Format(time.RFC3339))
 	}
+	return nil
+}
+
+// ValidateOSLocatorUpdateFields returns an error if any of the provided field names is unknown or repeated.
+func ValidateOSLocatorUpdateFields(fields []string) error {
+	seen := make(map[string]bool, len(fields))
+	for _, field := range fields {
+		switch field {
+		case OSLocatorFieldLocatorURI, OSLocatorFieldEncryptionKey, OSLocatorFieldPriority, OSLocatorFieldState,
+			OSLocatorFieldKeyValidFrom, OSLocatorFieldKeyValidUntil:
+		default:
+			return fmt.Errorf("unknown locator update field %q", field)
+		}
+		if seen[field] {
+			return fmt.Errorf("duplicate locator update field %q", field)
+		}
+		seen[field] = true
+	}
+	return nil
+}
+
+// WithUpdates returns a copy of this locator with each of the named fields taken from update.
+// If no fields are named, the locator uri and encryption key are taken from update.
+func (l ObjectStoreLocator) WithUpdates(update ObjectStoreLocator, fields []string) ObjectStoreLocator {
+	if len(fields) == 0 {
+		fields = []string{OSLocatorFieldLocatorURI, OSLocatorFieldEncryptionKey}
+	}
+	for _, field := range fields {
+		switch field {
+		case OSLocatorFieldLocatorURI:
+			l.LocatorUri = update.LocatorUri
+		case OSLocatorFieldEncryptionKey:
+			l.EncryptionKey = update.EncryptionKey
+		case OSLocatorFieldPriority:
+			l.Priority = update.Priority
+		case OSLocatorFieldState:
+			l.State = update.State
+		case OSLocatorFieldKeyValidFrom:
+			l.KeyValidFrom = update.KeyValidFrom
+		case OSLocatorFieldKeyValidUntil:
+			l.KeyValidUntil = update.KeyValidUntil
+		}
+	}
+	return l
+}
+
+// IsActive returns true if the locator is not retired and its encryption key is valid at the provided time.
+func (l ObjectStoreLocator) IsActive(blockTime time.Time) bool {
+	if l.State != ObjectStoreLocatorState_OBJECT_STORE_LOCATOR_STATE_ACTIVE {
+		return false
+	}
+	if l.KeyValidFrom != nil && blockTime.Before(*l.KeyValidFrom) {
+		return false
+	}
+	if l.KeyValidUntil != nil && !blockTime.Before(*l.KeyValidUntil) {
+		return false
+	}
+	return true
+}
+
+// SortOSLocatorsByPriority sorts the provided locators by priority, then name.
+func SortOSLocatorsByPriority(locators []ObjectStoreLocator) {
+	sort.SliceStable(locators, func(i, j int) bool {
+		if locators[i].Priority != locators[j].Priority {
+			return locators[i].Priority < locators[j].Priority
+		}
+		return locators[i].Name < locators[j].Name
+	})
 }
@@ -7,15 +7,19 @@ import (
 	fmt "fmt"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -23,6 +27,34 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// ObjectStoreLocatorState defines whether an object store locator is in use.
+type ObjectStoreLocatorState int32
+
+const (
+	// OBJECT_STORE_LOCATOR_STATE_ACTIVE is a locator that is in use
+	ObjectStoreLocatorState_OBJECT_STORE_LOCATOR_STATE_ACTIVE ObjectStoreLocatorState = 0
+	// OBJECT_STORE_LOCATOR_STATE_RETIRED is a locator that is kept for reference but is no longer in use
+	ObjectStoreLocatorState_OBJECT_STORE_LOCATOR_STATE_RETIRED ObjectStoreLocatorState = 1
+)
+
+var ObjectStoreLocatorState_name = map[int32]string{
+	0: "OBJECT_STORE_LOCATOR_STATE_ACTIVE",
+	1: "OBJECT_STORE_LOCATOR_STATE_RETIRED",
+}
+
+var ObjectStoreLocatorState_value = map[string]int32{
+	"OBJECT_STORE_LOCATOR_STATE_ACTIVE":  0,
+	"OBJECT_STORE_LOCATOR_STATE_RETIRED": 1,
+}
+
+func (x ObjectStoreLocatorState) String() string {
+	return proto.EnumName(ObjectStoreLocatorState_name, int32(x))
+}
+
+func (ObjectStoreLocatorState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_3d17fc5ccfa1c263, []int{0}
+}
+
 // Defines an Locator object stored on chain, which represents a owner( blockchain address) associated with a endpoint
 // uri for it's associated object store.
 type ObjectStoreLocator struct {
@@ -32,6 +64,16 @@ type ObjectStoreLocator struct {
 	LocatorUri string `protobuf:"bytes,2,opt,name=locator_uri,json=locatorUri,proto3" json:"locator_uri,omitempty"`
 	// owners encryption key address
 	EncryptionKey string `protobuf:"bytes,3,opt,name=encryption_key,json=encryptionKey,proto3" json:"encryption_key,omitempty"`
+	// name distinguishes the locators of an owner. An empty name is treated as "default".
+	Name string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	// priority orders the locators of an owner, lower values are preferred.
+	Priority uint32 `protobuf:"varint,5,opt,name=priority,proto3" json:"priority,omitempty"`
+	// state is whether this locator is active or retired.
+	State ObjectStoreLocatorState `protobuf:"varint,6,opt,name=state,proto3,enum=provenance.metadata.v1.ObjectStoreLocatorState" json:"state,omitempty"`
+	// key_valid_from is the time from which the encryption key is valid, if restricted.
+	KeyValidFrom *time.Time `protobuf:"bytes,7,opt,name=key_valid_from,json=keyValidFrom,proto3,stdtime" json:"key_valid_from,omitempty"`
+	// key_valid_until is the time after which the encryption key is no longer valid, if restricted.
+	KeyValidUntil *time.Time `protobuf:"bytes,8,opt,name=key_valid_until,json=keyValidUntil,proto3,stdtime" json:"key_valid_until,omitempty"`
 }
 
 func (m *ObjectStoreLocator) Reset()         { *m = ObjectStoreLocator{} }
@@ -88,9 +130,46 @@ func (m *ObjectStoreLocator) GetEncryptionKey() string {
 	return ""
 }
 
+func (m *ObjectStoreLocator) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ObjectStoreLocator) GetPriority() uint32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *ObjectStoreLocator) GetState() ObjectStoreLocatorState {
+	if m != nil {
+		return m.State
+	}
+	return ObjectStoreLocatorState_OBJECT_STORE_LOCATOR_STATE_ACTIVE
+}
+
+func (m *ObjectStoreLocator) GetKeyValidFrom() *time.Time {
+	if m != nil {
+		return m.KeyValidFrom
+	}
+	return nil
+}
+
+func (m *ObjectStoreLocator) GetKeyValidUntil() *time.Time {
+	if m != nil {
+		return m.KeyValidUntil
+	}
+	return nil
+}
+
 // Params defines the parameters for the metadata-locator module methods.
 type OSLocatorParams struct {
 	MaxUriLength uint32 `protobuf:"varint,1,opt,name=max_uri_length,json=maxUriLength,proto3,customtype=uint32" json:"max_uri_length" yaml:"max_uri_length"`
+	// max_locators_per_owner is the maximum number of locators a single owner can have bound. Zero means no limit.
+	MaxLocatorsPerOwner uint32 `protobuf:"varint,2,opt,name=max_locators_per_owner,json=maxLocatorsPerOwner,proto3" json:"max_locators_per_owner,omitempty" yaml:"max_locators_per_owner"`
 }
 
 func (m *OSLocatorParams) Reset()         { *m = OSLocatorParams{} }
@@ -126,7 +205,15 @@ func (m *OSLocatorParams) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_OSLocatorParams proto.InternalMessageInfo
 
+func (m *OSLocatorParams) GetMaxLocatorsPerOwner() uint32 {
+	if m != nil {
+		return m.MaxLocatorsPerOwner
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("provenance.metadata.v1.ObjectStoreLocatorState", ObjectStoreLocatorState_name, ObjectStoreLocatorState_value)
 	proto.RegisterType((*ObjectStoreLocator)(nil), "provenance.metadata.v1.ObjectStoreLocator")
 	proto.RegisterType((*OSLocatorParams)(nil), "provenance.metadata.v1.OSLocatorParams")
 }
@@ -136,27 +223,42 @@ func init() {
 }
 
 var fileDescriptor_3d17fc5ccfa1c263 = []byte{
-	// 314 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0xc1, 0x4e, 0x32, 0x31,
-	0x1c, 0xc4, 0xb7, 0xdf, 0x17, 0x49, 0xac, 0x82, 0x49, 0x83, 0x06, 0x3d, 0x74, 0xcd, 0x26, 0x46,
-	0x2e, 0x6e, 0x83, 0x78, 0xf2, 0xc8, 0x55, 0x0c, 0x04, 0xc2, 0xc5, 0x0b, 0x96, 0xb5, 0x59, 0x2a,
-	0xb4, 0xff, 0x4d, 0x29, 0xc8, 0xbe, 0x85, 0x8f, 0xc5, 0x91, 0xa3, 0xf1, 0x40, 0x0c, 0xbc, 0x81,
-	0x4f, 0x60, 0xe8, 0x62, 0x56, 0x13, 0x6f, 0x9d, 0xe9, 0x2f, 0x33, 0xf9, 0x0f, 0xae, 0x26, 0x06,
-	0x66, 0x42, 0x73, 0x1d, 0x09, 0xa6, 0x84, 0xe5, 0x4f, 0xdc, 0x72, 0x36, 0xab, 0x31, 0x18, 0x3c,
-	0x8b, 0xc8, 0x4e, 0x2c, 0x18, 0x11, 0x26, 0x06, 0x2c, 0x90, 0x93, 0x9c, 0x0c, 0xbf, 0xc9, 0x70,
-	0x56, 0x3b, 0x2b, 0xc7, 0x10, 0x83, 0x43, 0xd8, 0xf6, 0x95, 0xd1, 0x81, 0xc1, 0xa4, 0xe5, 0x22,
-	0xba, 0xdb, 0x88, 0x26, 0x44, 0xdc, 0x82, 0x21, 0x65, 0xbc, 0x07, 0x2f, 0x5a, 0x98, 0x0a, 0x3a,
-	0x47, 0xd5, 0xfd, 0x4e, 0x26, 0x88, 0x8f, 0x0f, 0xc6, 0x19, 0xd0, 0x9f, 0x1a, 0x59, 0xf9, 0xe7,
-	0xfe, 0xf0, 0xce, 0xea, 0x19, 0x49, 0x2e, 0x70, 0x49, 0xe8, 0xc8, 0xa4, 0x89, 0x95, 0xa0, 0xfb,
-	0x23, 0x91, 0x56, 0xfe, 0x3b, 0xa6, 0x98, 0xbb, 0x77, 0x22, 0x0d, 0x1e, 0xf1, 0x51, 0xab, 0xbb,
-	0xab, 0x6a, 0x73, 0xc3, 0xd5, 0x84, 0xdc, 0xe3, 0x92, 0xe2, 0xf3, 0x6d, 0x6c, 0x7f, 0x2c, 0x74,
-	0x6c, 0x87, 0xae, 0xb9, 0xd8, 0xb8, 0x5c, 0xac, 0x7c, 0xef, 0x7d, 0xe5, 0x17, 0xa6, 0x52, 0xdb,
-	0xfa, 0xf5, 0xe7, 0xca, 0x3f, 0x4e, 0xb9, 0x1a, 0xdf, 0x06, 0xbf, 0xe9, 0xa0, 0x73, 0xa8, 0xf8,
-	0xbc, 0x67, 0x64, 0xd3, 0xc9, 0xc6, 0x68, 0xb1, 0xa6, 0x68, 0xb9, 0xa6, 0xe8, 0x63, 0x4d, 0xd1,
-	0xeb, 0x86, 0x7a, 0xcb, 0x0d, 0xf5, 0xde, 0x36, 0xd4, 0xc3, 0xa7, 0xd2, 0x5d, 0xfe, 0xc7, 0x40,
-	0x6d, 0xf4, 0x70, 0x13, 0x4b, 0x3b, 0x9c, 0x0e, 0xc2, 0x08, 0x14, 0xcb, 0xa1, 0x2b, 0x09, 0x3f,
-	0x14, 0x9b, 0xe7, 0xfb, 0xdb, 0x34, 0x11, 0x93, 0x41, 0xc1, 0x2d, 0x59, 0xff, 0x0a, 0x00, 0x00,
-	0xff, 0xff, 0x0b, 0x65, 0x9a, 0xf7, 0xa3, 0x01, 0x00, 0x00,
+	// 557 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x41, 0x6f, 0xd3, 0x3e,
+	0x18, 0xc6, 0xeb, 0xfd, 0xb7, 0xfd, 0x87, 0xb7, 0x76, 0x93, 0x19, 0x23, 0x54, 0x22, 0xe9, 0x22,
+	0x0d, 0x2a, 0x24, 0x12, 0x6d, 0xe3, 0xc4, 0x6d, 0x1d, 0x99, 0x18, 0x0c, 0xa5, 0x4a, 0xd3, 0x1e,
+	0xb8, 0x44, 0x6e, 0xe7, 0xa5, 0xa6, 0x71, 0x1c, 0x39, 0x6e, 0x69, 0xbe, 0xc5, 0x3e, 0x0e, 0x1f,
+	0x61, 0xc7, 0x1d, 0x11, 0x87, 0x82, 0xda, 0x6f, 0xb0, 0x2b, 0x17, 0x94, 0xa4, 0x25, 0xa0, 0x31,
+	0xc4, 0xcd, 0xcf, 0xeb, 0xdf, 0xfb, 0xd8, 0x79, 0xde, 0x18, 0xd6, 0x23, 0xc1, 0x47, 0x24, 0xc4,
+	0x61, 0x8f, 0x98, 0x8c, 0x48, 0x7c, 0x8e, 0x25, 0x36, 0x47, 0xfb, 0x26, 0xef, 0x7e, 0x20, 0x3d,
+	0x19, 0x4b, 0x2e, 0x88, 0x11, 0x09, 0x2e, 0x39, 0xda, 0x29, 0x48, 0x63, 0x41, 0x1a, 0xa3, 0xfd,
+	0xea, 0xb6, 0xcf, 0x7d, 0x9e, 0x21, 0x66, 0xba, 0xca, 0xe9, 0xaa, 0xe6, 0x73, 0xee, 0x07, 0xc4,
+	0xcc, 0x54, 0x77, 0x78, 0x61, 0x4a, 0xca, 0x48, 0x2c, 0x31, 0x8b, 0x72, 0x40, 0xff, 0xbe, 0x04,
+	0x91, 0x9d, 0x1d, 0xd2, 0x4a, 0x0f, 0x39, 0xe3, 0x3d, 0x2c, 0xb9, 0x40, 0xdb, 0x70, 0x85, 0x7f,
+	0x0c, 0x89, 0x50, 0x40, 0x0d, 0xd4, 0xef, 0x39, 0xb9, 0x40, 0x1a, 0x5c, 0x0f, 0x72, 0xc0, 0x1b,
+	0x0a, 0xaa, 0x2c, 0x65, 0x7b, 0x70, 0x5e, 0x6a, 0x0b, 0x8a, 0xf6, 0x60, 0x85, 0x84, 0x3d, 0x91,
+	0x44, 0x92, 0xf2, 0xd0, 0x1b, 0x90, 0x44, 0xf9, 0x2f, 0x63, 0xca, 0x45, 0xf5, 0x2d, 0x49, 0x10,
+	0x82, 0xcb, 0x21, 0x66, 0x44, 0x59, 0xce, 0x36, 0xb3, 0x35, 0xaa, 0xc2, 0xb5, 0x48, 0x50, 0x2e,
+	0xa8, 0x4c, 0x94, 0x95, 0x1a, 0xa8, 0x97, 0x9d, 0x9f, 0x1a, 0x59, 0x70, 0x25, 0x96, 0x58, 0x12,
+	0x65, 0xb5, 0x06, 0xea, 0x95, 0x03, 0xd3, 0xf8, 0x73, 0x06, 0xc6, 0xed, 0x0f, 0x69, 0xa5, 0x6d,
+	0x4e, 0xde, 0x8d, 0x4e, 0x60, 0x65, 0x40, 0x12, 0x6f, 0x84, 0x03, 0x7a, 0xee, 0x5d, 0x08, 0xce,
+	0x94, 0xff, 0x6b, 0xa0, 0xbe, 0x7e, 0x50, 0x35, 0xf2, 0x94, 0x8c, 0x45, 0x4a, 0x86, 0xbb, 0x48,
+	0xa9, 0xb1, 0x7c, 0xf9, 0x55, 0x03, 0xce, 0xc6, 0x80, 0x24, 0x9d, 0xb4, 0xed, 0x44, 0x70, 0x86,
+	0x5e, 0xc3, 0xcd, 0xc2, 0x67, 0x18, 0x4a, 0x1a, 0x28, 0x6b, 0xff, 0x68, 0x54, 0x5e, 0x18, 0xb5,
+	0xd3, 0x36, 0xfd, 0x13, 0x80, 0x9b, 0x76, 0x6b, 0x7e, 0xd7, 0x26, 0x16, 0x98, 0xc5, 0xe8, 0x1d,
+	0xac, 0x30, 0x3c, 0x4e, 0x03, 0xf6, 0x02, 0x12, 0xfa, 0xb2, 0x9f, 0xcd, 0xa0, 0xdc, 0x78, 0x7a,
+	0x35, 0xd1, 0x4a, 0x5f, 0x26, 0xda, 0xea, 0x90, 0x86, 0xf2, 0xf0, 0xe0, 0x66, 0xa2, 0x3d, 0x48,
+	0x30, 0x0b, 0x5e, 0xea, 0xbf, 0xd3, 0xba, 0xb3, 0xc1, 0xf0, 0xb8, 0x2d, 0xe8, 0x59, 0x26, 0x51,
+	0x07, 0xee, 0xa4, 0xc0, 0x7c, 0x48, 0xb1, 0x17, 0x11, 0xe1, 0xe5, 0xa3, 0x5d, 0xca, 0x6c, 0x77,
+	0x6f, 0x26, 0xda, 0xe3, 0xc2, 0xe8, 0x36, 0xa7, 0x3b, 0xf7, 0x19, 0x1e, 0xcf, 0xef, 0x18, 0x37,
+	0x89, 0xb0, 0xd3, 0xea, 0xb3, 0x3e, 0x7c, 0x78, 0x47, 0xdc, 0x68, 0x0f, 0xee, 0xda, 0x8d, 0x37,
+	0xd6, 0xb1, 0xeb, 0xb5, 0x5c, 0xdb, 0xb1, 0xbc, 0x33, 0xfb, 0xf8, 0xc8, 0xb5, 0x1d, 0xaf, 0xe5,
+	0x1e, 0xb9, 0x96, 0x77, 0x74, 0xec, 0x9e, 0x76, 0xac, 0xad, 0x12, 0x7a, 0x02, 0xf5, 0xbf, 0x60,
+	0x8e, 0xe5, 0x9e, 0x3a, 0xd6, 0xab, 0x2d, 0xd0, 0x18, 0x5c, 0x4d, 0x55, 0x70, 0x3d, 0x55, 0xc1,
+	0xb7, 0xa9, 0x0a, 0x2e, 0x67, 0x6a, 0xe9, 0x7a, 0xa6, 0x96, 0x3e, 0xcf, 0xd4, 0x12, 0x7c, 0x44,
+	0xf9, 0x1d, 0xbf, 0x42, 0x13, 0xbc, 0x7f, 0xe1, 0x53, 0xd9, 0x1f, 0x76, 0x8d, 0x1e, 0x67, 0x66,
+	0x01, 0x3d, 0xa7, 0xfc, 0x17, 0x65, 0x8e, 0x8b, 0xd7, 0x26, 0x93, 0x88, 0xc4, 0xdd, 0xd5, 0x6c,
+	0x74, 0x87, 0x3f, 0x06, 0x00, 0x67, 0x9a, 0x38, 0xe3, 0x91, 0x03, 0x00, 0x00,
 }
 
 func (m *ObjectStoreLocator) Marshal() (dAtA []byte, err error) {
@@ -179,6 +281,43 @@ func (m *ObjectStoreLocator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.KeyValidUntil != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.KeyValidUntil, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.KeyValidUntil):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintObjectstore(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.KeyValidFrom != nil {
+		n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.KeyValidFrom, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.KeyValidFrom):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintObjectstore(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.State != 0 {
+		i = encodeVarintObjectstore(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Priority != 0 {
+		i = encodeVarintObjectstore(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintObjectstore(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.EncryptionKey) > 0 {
 		i -= len(m.EncryptionKey)
 		copy(dAtA[i:], m.EncryptionKey)
@@ -223,6 +362,11 @@ func (m *OSLocatorParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxLocatorsPerOwner != 0 {
+		i = encodeVarintObjectstore(dAtA, i, uint64(m.MaxLocatorsPerOwner))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.MaxUriLength != 0 {
 		i = encodeVarintObjectstore(dAtA, i, uint64(m.MaxUriLength))
 		i--
@@ -260,6 +404,24 @@ func (m *ObjectStoreLocator) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovObjectstore(uint64(l))
 	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovObjectstore(uint64(l))
+	}
+	if m.Priority != 0 {
+		n += 1 + sovObjectstore(uint64(m.Priority))
+	}
+	if m.State != 0 {
+		n += 1 + sovObjectstore(uint64(m.State))
+	}
+	if m.KeyValidFrom != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.KeyValidFrom)
+		n += 1 + l + sovObjectstore(uint64(l))
+	}
+	if m.KeyValidUntil != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.KeyValidUntil)
+		n += 1 + l + sovObjectstore(uint64(l))
+	}
 	return n
 }
 
@@ -272,6 +434,9 @@ func (m *OSLocatorParams) Size() (n int) {
 	if m.MaxUriLength != 0 {
 		n += 1 + sovObjectstore(uint64(m.MaxUriLength))
 	}
+	if m.MaxLocatorsPerOwner != 0 {
+		n += 1 + sovObjectstore(uint64(m.MaxLocatorsPerOwner))
+	}
 	return n
 }
 
@@ -406,6 +571,148 @@ func (m *ObjectStoreLocator) Unmarshal(dAtA []byte) error {
 			}
 			m.EncryptionKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjectstore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthObjectstore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthObjectstore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjectstore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjectstore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= ObjectStoreLocatorState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyValidFrom", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjectstore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthObjectstore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthObjectstore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.KeyValidFrom == nil {
+				m.KeyValidFrom = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.KeyValidFrom, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyValidUntil", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjectstore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthObjectstore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthObjectstore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.KeyValidUntil == nil {
+				m.KeyValidUntil = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.KeyValidUntil, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipObjectstore(dAtA[iNdEx:])
@@ -475,6 +782,25 @@ func (m *OSLocatorParams) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLocatorsPerOwner", wireType)
+			}
+			m.MaxLocatorsPerOwner = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjectstore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxLocatorsPerOwner |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipObjectstore(dAtA[iNdEx:])
@@ -9,6 +9,8 @@ import (
 // Default parameter namespace
 const (
 	DefaultMaxURILength = 2048
+	// DefaultMaxLocatorsPerOwner is the default maximum number of object store locators an owner can have.
+	DefaultMaxLocatorsPerOwner = 10
 )
 
 // ParamKeyTable for metadata module
@@ -18,12 +20,13 @@ func OSParamKeyTable() paramtypes.KeyTable {
 
 // Parameter store keys
 var (
-	ParamStoreKeyMaxValueLength = []byte("MaxUriLength")
+	ParamStoreKeyMaxValueLength      = []byte("MaxUriLength")
+	ParamStoreKeyMaxLocatorsPerOwner = []byte("MaxLocatorsPerOwner")
 )
 
 // NewParams creates a new parameter object
-func NewOSLocatorParams(maxURILength uint32) OSLocatorParams {
-	return OSLocatorParams{MaxUriLength: maxURILength}
+func NewOSLocatorParams(maxURILength uint32, maxLocatorsPerOwner uint32) OSLocatorParams {
+	return OSLocatorParams{MaxUriLength: maxURILength, MaxLocatorsPerOwner: maxLocatorsPerOwner}
 }
 
 // ParamSetPairs implements the ParamSet interface and returns all the key/value pairs
@@ -31,12 +34,13 @@ func NewOSLocatorParams(maxURILength uint32) OSLocatorParams {
 func (p *OSLocatorParams) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(ParamStoreKeyMaxValueLength, &p.MaxUriLength, validateMaxURILength),
+		paramtypes.NewParamSetPair(ParamStoreKeyMaxLocatorsPerOwner, &p.MaxLocatorsPerOwner, validateMaxLocatorsPerOwner),
 	}
 }
 
 // DefaultParams defines the parameters for this module
 func DefaultOSLocatorParams() OSLocatorParams {
-	return NewOSLocatorParams(DefaultMaxURILength)
+	return NewOSLocatorParams(DefaultMaxURILength, DefaultMaxLocatorsPerOwner)
 }
 
 func validateMaxURILength(i interface{}) error {
@@ -47,3 +51,12 @@ func validateMaxURILength(i interface{}) error {
 
 	return nil
 }
+
+func validateMaxLocatorsPerOwner(i interface{}) error {
+	_, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
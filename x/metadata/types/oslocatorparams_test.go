@@ -8,20 +8,27 @@ import (
 )
 
 func TestCreateParams(t *testing.T) {
-	osParam := NewOSLocatorParams(3000)
+	osParam := NewOSLocatorParams(3000, 5)
 	require.Equal(t, int(3000), int(osParam.MaxUriLength))
+	require.Equal(t, int(5), int(osParam.MaxLocatorsPerOwner))
 }
 
 func TestCreateParamSet(t *testing.T) {
-	osParam := NewOSLocatorParams(3000)
+	osParam := NewOSLocatorParams(3000, 5)
 	paramsetPair := osParam.ParamSetPairs()
-	require.Equal(t, 1, len(paramsetPair))
+	require.Equal(t, 2, len(paramsetPair))
 }
 
 func TestValidateMaxURILengthI(t *testing.T) {
 	require.NoError(t, validateMaxURILength(uint32(maxURLLength)))
 }
 
+func TestValidateMaxLocatorsPerOwner(t *testing.T) {
+	require.NoError(t, validateMaxLocatorsPerOwner(uint32(1)))
+	require.NoError(t, validateMaxLocatorsPerOwner(uint32(0)))
+	require.EqualError(t, validateMaxLocatorsPerOwner(5), "invalid parameter type: int")
+}
+
 func TestOSParamKeyTable(t *testing.T) {
 	keyTable := OSParamKeyTable()
 	require.Panics(t, func() {
@@ -32,4 +39,5 @@ func TestOSParamKeyTable(t *testing.T) {
 func TestDefault(t *testing.T) {
 	metadataData := DefaultOSLocatorParams()
 	require.Equal(t, 2048, int(metadataData.MaxUriLength))
+	require.Equal(t, 10, int(metadataData.MaxLocatorsPerOwner))
 }
@@ -285,6 +285,7 @@ func emptyScope() *Scope {
 		Owners:            []Party{},
 		DataAccess:        []string{},
 		ValueOwnerAddress: "",
+		DataAccessGrants:  []DataAccessGrant{},
 	}
 }
 
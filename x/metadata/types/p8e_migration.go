@@ -0,0 +1,324 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha512"
+	"encoding/base64"
+	"fmt"
+	"sort"
+)
+
+// P8eDataStore provides the metadata needed to find and normalize data that was created through the p8e messages.
+type P8eDataStore interface {
+	// IterateContractSpecs processes all contract specifications with the given handler.
+	IterateContractSpecs(handler func(ContractSpecification) (stop bool)) error
+	// IterateRecords processes all records with the given handler.
+	IterateRecords(handler func(Record) (stop bool)) error
+	GetScope(id MetadataAddress) (Scope, bool)
+	GetSession(id MetadataAddress) (Session, bool)
+	GetRecord(id MetadataAddress) (Record, bool)
+	GetScopeSpecification(id MetadataAddress) (ScopeSpecification, bool)
+	GetRecordSpecification(id MetadataAddress) (RecordSpecification, bool)
+	SetRecord(record Record)
+}
+
+// P8eMigrationReport summarizes the p8e-origin data found by NormalizeP8eData.
+type P8eMigrationReport struct {
+	// ContractSpecifications is the number of contract specifications created by MsgWriteP8eContractSpecRequest.
+	ContractSpecifications int `json:"contract_specifications" yaml:"contract_specifications"`
+	// Scopes is the number of scopes with sessions using a p8e contract specification.
+	Scopes int `json:"scopes" yaml:"scopes"`
+	// Records is the number of records in sessions using a p8e contract specification.
+	Records int `json:"records" yaml:"records"`
+	// NormalizedRecords is the number of those records that were changed.
+	NormalizedRecords int `json:"normalized_records" yaml:"normalized_records"`
+	// Gaps are the problems that could not be fixed automatically.
+	Gaps []P8eDataGap `json:"gaps" yaml:"gaps"`
+}
+
+// P8eDataGap is a problem with a piece of p8e-origin data that needs manual attention.
+type P8eDataGap struct {
+	// ID is the bech32 metadata address of the entry with the problem.
+	ID string `json:"id" yaml:"id"`
+	// Issue describes the problem.
+	Issue string `json:"issue" yaml:"issue"`
+}
+
+// addGap adds a gap to this report.
+func (r *P8eMigrationReport) addGap(id MetadataAddress, format string, args ...interface{}) {
+	r.Gaps = append(r.Gaps, P8eDataGap{ID: id.String(), Issue: fmt.Sprintf(format, args...)})
+}
+
+// IsP8eContractSpecification returns true if the contract specification was created from a p8e contract spec.
+// Those have a hash source that is the base64 of the sha512 of the p8e contract spec,
+// and an id built from the first 16 bytes of that sha512.
+func IsP8eContractSpecification(spec *ContractSpecification) bool {
+	if spec == nil {
+		return false
+	}
+	source, ok := spec.Source.(*ContractSpecification_Hash)
+	if !ok {
+		return false
+	}
+	hash, err := base64.StdEncoding.DecodeString(source.Hash)
+	if err != nil || len(hash) != sha512.Size {
+		return false
+	}
+	specUUID, err := spec.SpecificationId.ContractSpecUUID()
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(hash[:16], specUUID[:])
+}
+
+// NormalizeP8eData finds the records created through MsgP8eMemorializeContractRequest, converts them to the
+// format used by the native messages, and reports anything that cannot be fixed automatically.
+//
+// The following are normalized:
+//   - Record input statuses are made to match their sources (proposed for a hash, record for a record id).
+//   - A missing record specification id is set from the session's contract specification and the record name.
+func NormalizeP8eData(store P8eDataStore) (*P8eMigrationReport, error) {
+	report := &P8eMigrationReport{Gaps: []P8eDataGap{}}
+
+	p8eSpecs := map[string]bool{}
+	err := store.IterateContractSpecs(func(spec ContractSpecification) bool {
+		if IsP8eContractSpecification(&spec) {
+			p8eSpecs[string(spec.SpecificationId)] = true
+		}
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+	report.ContractSpecifications = len(p8eSpecs)
+
+	var scopeIDs []MetadataAddress
+	scopeContractSpecs := map[string][]MetadataAddress{}
+	var normalized []Record
+	err = store.IterateRecords(func(record Record) bool {
+		session, found := store.GetSession(record.SessionId)
+		if !found || !p8eSpecs[string(session.SpecificationId)] {
+			return false
+		}
+		report.Records++
+
+		scopeID := record.SessionId.MustGetAsScopeAddress()
+		key := string(scopeID)
+		if _, known := scopeContractSpecs[key]; !known {
+			scopeIDs = append(scopeIDs, scopeID)
+		}
+		if !containsAddress(scopeContractSpecs[key], session.SpecificationId) {
+			scopeContractSpecs[key] = append(scopeContractSpecs[key], session.SpecificationId)
+		}
+
+		if normalizeP8eRecord(store, &record, session, report) {
+			normalized = append(normalized, record)
+		}
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(scopeIDs, func(i, j int) bool { return bytes.Compare(scopeIDs[i], scopeIDs[j]) < 0 })
+	report.Scopes = len(scopeIDs)
+	for _, scopeID := range scopeIDs {
+		scope, found := store.GetScope(scopeID)
+		if !found {
+			report.addGap(scopeID, "scope not found")
+			continue
+		}
+		scopeSpec, found := store.GetScopeSpecification(scope.SpecificationId)
+		if !found {
+			report.addGap(scopeID, "scope specification %s not found", scope.SpecificationId)
+			continue
+		}
+		for _, contractSpecID := range scopeContractSpecs[string(scopeID)] {
+			if !containsAddress(scopeSpec.ContractSpecIds, contractSpecID) {
+				report.addGap(scopeID, "contract specification %s is not allowed by scope specification %s",
+					contractSpecID, scope.SpecificationId)
+			}
+		}
+	}
+
+	for _, record := range normalized {
+		store.SetRecord(record)
+	}
+	report.NormalizedRecords = len(normalized)
+	return report, nil
+}
+
+// normalizeP8eRecord normalizes a p8e-origin record, adding any gaps to the report.
+// Returns true if the record was changed.
+func normalizeP8eRecord(store P8eDataStore, record *Record, session Session, report *P8eMigrationReport) bool {
+	changed := false
+	recordID := record.SessionId.MustGetAsRecordAddress(record.Name)
+	scopeID := record.SessionId.MustGetAsScopeAddress()
+
+	if record.SpecificationId.Empty() {
+		recSpecID, err := session.SpecificationId.AsRecordSpecAddress(record.Name)
+		if err != nil {
+			report.addGap(recordID, "could not get record specification id: %v", err)
+		} else if _, found := store.GetRecordSpecification(recSpecID); !found {
+			report.addGap(recordID, "record specification %s not found", recSpecID)
+		} else {
+			record.SpecificationId = recSpecID
+			changed = true
+		}
+	}
+
+	// Copy the inputs so that the store's entry isn't changed until SetRecord is called.
+	record.Inputs = append([]RecordInput{}, record.Inputs...)
+	for i := range record.Inputs {
+		input := &record.Inputs[i]
+		switch source := input.Source.(type) {
+		case *RecordInput_Hash:
+			if input.Status != RecordInputStatus_Proposed {
+				input.Status = RecordInputStatus_Proposed
+				changed = true
+			}
+		case *RecordInput_RecordId:
+			if input.Status != RecordInputStatus_Record {
+				input.Status = RecordInputStatus_Record
+				changed = true
+			}
+			sourceScopeID, err := source.RecordId.AsScopeAddress()
+			switch {
+			case err != nil:
+				report.addGap(recordID, "input %s source record id %s is invalid: %v", input.Name, source.RecordId, err)
+			case !sourceScopeID.Equals(scopeID):
+				report.addGap(recordID, "input %s source record %s is in scope %s", input.Name, source.RecordId, sourceScopeID)
+			default:
+				if _, found := store.GetRecord(source.RecordId); !found {
+					report.addGap(recordID, "input %s source record %s not found", input.Name, source.RecordId)
+				}
+			}
+		}
+	}
+
+	if record.Process.ProcessId == nil {
+		report.addGap(recordID, "process %s has no process id", record.Process.Name)
+	}
+	for i, output := range record.Outputs {
+		if output.Status == ResultStatus_RESULT_STATUS_UNSPECIFIED {
+			report.addGap(recordID, "output %d has an unspecified status", i)
+		}
+	}
+
+	return changed
+}
+
+// containsAddress returns true if the addr is in the list.
+func containsAddress(list []MetadataAddress, addr MetadataAddress) bool {
+	for _, a := range list {
+		if a.Equals(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// genesisP8eDataStore is a P8eDataStore backed by a GenesisState.
+type genesisP8eDataStore struct {
+	state       *GenesisState
+	scopes      map[string]int
+	sessions    map[string]int
+	records     map[string]int
+	scopeSpecs  map[string]int
+	recordSpecs map[string]int
+}
+
+var _ P8eDataStore = &genesisP8eDataStore{}
+
+// NewGenesisP8eDataStore creates a P8eDataStore that reads and updates the provided genesis state.
+func NewGenesisP8eDataStore(state *GenesisState) P8eDataStore {
+	rv := &genesisP8eDataStore{
+		state:       state,
+		scopes:      make(map[string]int, len(state.Scopes)),
+		sessions:    make(map[string]int, len(state.Sessions)),
+		records:     make(map[string]int, len(state.Records)),
+		scopeSpecs:  make(map[string]int, len(state.ScopeSpecifications)),
+		recordSpecs: make(map[string]int, len(state.RecordSpecifications)),
+	}
+	for i, scope := range state.Scopes {
+		rv.scopes[string(scope.ScopeId)] = i
+	}
+	for i, session := range state.Sessions {
+		rv.sessions[string(session.SessionId)] = i
+	}
+	for i, record := range state.Records {
+		if recordID, err := record.SessionId.AsRecordAddress(record.Name); err == nil {
+			rv.records[string(recordID)] = i
+		}
+	}
+	for i, spec := range state.ScopeSpecifications {
+		rv.scopeSpecs[string(spec.SpecificationId)] = i
+	}
+	for i, spec := range state.RecordSpecifications {
+		rv.recordSpecs[string(spec.SpecificationId)] = i
+	}
+	return rv
+}
+
+func (s *genesisP8eDataStore) IterateContractSpecs(handler func(ContractSpecification) (stop bool)) error {
+	for _, spec := range s.state.ContractSpecifications {
+		if handler(spec) {
+			break
+		}
+	}
+	return nil
+}
+
+func (s *genesisP8eDataStore) IterateRecords(handler func(Record) (stop bool)) error {
+	for _, record := range s.state.Records {
+		if handler(record) {
+			break
+		}
+	}
+	return nil
+}
+
+func (s *genesisP8eDataStore) GetScope(id MetadataAddress) (Scope, bool) {
+	if i, found := s.scopes[string(id)]; found {
+		return s.state.Scopes[i], true
+	}
+	return Scope{}, false
+}
+
+func (s *genesisP8eDataStore) GetSession(id MetadataAddress) (Session, bool) {
+	if i, found := s.sessions[string(id)]; found {
+		return s.state.Sessions[i], true
+	}
+	return Session{}, false
+}
+
+func (s *genesisP8eDataStore) GetRecord(id MetadataAddress) (Record, bool) {
+	if i, found := s.records[string(id)]; found {
+		return s.state.Records[i], true
+	}
+	return Record{}, false
+}
+
+func (s *genesisP8eDataStore) GetScopeSpecification(id MetadataAddress) (ScopeSpecification, bool) {
+	if i, found := s.scopeSpecs[string(id)]; found {
+		return s.state.ScopeSpecifications[i], true
+	}
+	return ScopeSpecification{}, false
+}
+
+func (s *genesisP8eDataStore) GetRecordSpecification(id MetadataAddress) (RecordSpecification, bool) {
+	if i, found := s.recordSpecs[string(id)]; found {
+		return s.state.RecordSpecifications[i], true
+	}
+	return RecordSpecification{}, false
+}
+
+func (s *genesisP8eDataStore) SetRecord(record Record) {
+	recordID := record.SessionId.MustGetAsRecordAddress(record.Name)
+	if i, found := s.records[string(recordID)]; found {
+		s.state.Records[i] = record
+		return
+	}
+	s.records[string(recordID)] = len(s.state.Records)
+	s.state.Records = append(s.state.Records, record)
+}
@@ -0,0 +1,119 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/metadata/types/p8e"
+)
+
+func TestIsP8eContractSpecification(t *testing.T) {
+	defSpec := createDefinitionSpec("perform_input_checks", "io.provenance.loan.LoanProtos$PartiesList", p8e.ProvenanceReference{Hash: "Adv+huolGTKofYCR0dw5GHm/R7sUWOwF32XR8r8r9kDy4il5U/LApxOWYHb05jhK4+eY4YzRMRiWcxU3Lx0+Mw=="}, 1)
+	oldSpec := createContractSpec([]*p8e.DefinitionSpec{&defSpec}, p8e.OutputSpec{Spec: &defSpec}, defSpec)
+	p8eSpec, _, err := ConvertP8eContractSpec(&oldSpec, []string{sdk.AccAddress("owner_______________").String()})
+	require.NoError(t, err, "ConvertP8eContractSpec")
+
+	otherID := p8eSpec
+	otherID.SpecificationId = ContractSpecMetadataAddress(uuid.New())
+	classSource := p8eSpec
+	classSource.Source = &ContractSpecification_ResourceId{ResourceId: ContractSpecMetadataAddress(uuid.New())}
+	shortHash := p8eSpec
+	shortHash.Source = &ContractSpecification_Hash{Hash: "c2hvcnQ="}
+
+	assert.True(t, IsP8eContractSpecification(&p8eSpec), "converted p8e spec")
+	assert.False(t, IsP8eContractSpecification(&otherID), "p8e hash with a different id")
+	assert.False(t, IsP8eContractSpecification(&classSource), "resource id source")
+	assert.False(t, IsP8eContractSpecification(&shortHash), "hash that is not a sha512")
+	assert.False(t, IsP8eContractSpecification(nil), "nil")
+}
+
+func TestNormalizeP8eData(t *testing.T) {
+	defSpec := createDefinitionSpec("perform_input_checks", "io.provenance.loan.LoanProtos$PartiesList", p8e.ProvenanceReference{Hash: "Adv+huolGTKofYCR0dw5GHm/R7sUWOwF32XR8r8r9kDy4il5U/LApxOWYHb05jhK4+eY4YzRMRiWcxU3Lx0+Mw=="}, 1)
+	oldSpec := createContractSpec([]*p8e.DefinitionSpec{&defSpec}, p8e.OutputSpec{Spec: &defSpec}, defSpec)
+	cSpec, recSpecs, err := ConvertP8eContractSpec(&oldSpec, []string{sdk.AccAddress("owner_______________").String()})
+	require.NoError(t, err, "ConvertP8eContractSpec")
+	require.Len(t, recSpecs, 1, "record specs")
+	nativeCSpec := ContractSpecification{
+		SpecificationId: ContractSpecMetadataAddress(uuid.New()),
+		Source:          &ContractSpecification_Hash{Hash: "native"},
+	}
+
+	scopeSpec := ScopeSpecification{
+		SpecificationId: ScopeSpecMetadataAddress(uuid.New()),
+		ContractSpecIds: []MetadataAddress{nativeCSpec.SpecificationId},
+	}
+	scopeUUID := uuid.New()
+	scope := Scope{ScopeId: ScopeMetadataAddress(scopeUUID), SpecificationId: scopeSpec.SpecificationId}
+	p8eSession := Session{SessionId: SessionMetadataAddress(scopeUUID, uuid.New()), SpecificationId: cSpec.SpecificationId}
+	nativeSession := Session{SessionId: SessionMetadataAddress(scopeUUID, uuid.New()), SpecificationId: nativeCSpec.SpecificationId}
+
+	checks := Record{
+		Name:      "perform_input_checks",
+		SessionId: p8eSession.SessionId,
+		Process:   Process{ProcessId: &Process_Hash{Hash: "processhash"}, Name: "checker", Method: "perform_input_checks"},
+		Inputs: []RecordInput{
+			{Name: "parties", TypeName: "PartiesList", Source: &RecordInput_Hash{Hash: "inputhash"}, Status: RecordInputStatus_Record},
+		},
+		Outputs: []RecordOutput{{Hash: "outputhash", Status: ResultStatus_RESULT_STATUS_PASS}},
+	}
+	missingSourceID := RecordMetadataAddress(scopeUUID, "missing")
+	other := Record{
+		Name:      "other",
+		SessionId: p8eSession.SessionId,
+		Process:   Process{Name: "other"},
+		Inputs: []RecordInput{
+			{Name: "missing", TypeName: "Missing", Source: &RecordInput_RecordId{RecordId: missingSourceID}, Status: RecordInputStatus_Record},
+		},
+		Outputs: []RecordOutput{{Hash: "outputhash", Status: ResultStatus_RESULT_STATUS_UNSPECIFIED}},
+	}
+	native := Record{
+		Name:      "native",
+		SessionId: nativeSession.SessionId,
+		Process:   Process{ProcessId: &Process_Hash{Hash: "processhash"}, Name: "native", Method: "native"},
+		Inputs: []RecordInput{
+			{Name: "input", TypeName: "Input", Source: &RecordInput_Hash{Hash: "inputhash"}, Status: RecordInputStatus_Record},
+		},
+	}
+
+	state := &GenesisState{
+		Scopes:                 []Scope{scope},
+		Sessions:               []Session{p8eSession, nativeSession},
+		Records:                []Record{checks, other, native},
+		ScopeSpecifications:    []ScopeSpecification{scopeSpec},
+		ContractSpecifications: []ContractSpecification{cSpec, nativeCSpec},
+		RecordSpecifications:   recSpecs,
+	}
+
+	report, err := NormalizeP8eData(NewGenesisP8eDataStore(state))
+	require.NoError(t, err, "NormalizeP8eData")
+
+	otherID := RecordMetadataAddress(scopeUUID, "other").String()
+	expReport := &P8eMigrationReport{
+		ContractSpecifications: 1,
+		Scopes:                 1,
+		Records:                2,
+		NormalizedRecords:      1,
+		Gaps: []P8eDataGap{
+			{ID: otherID, Issue: "record specification " + cSpec.SpecificationId.MustGetAsRecordSpecAddress("other").String() + " not found"},
+			{ID: otherID, Issue: "input missing source record " + missingSourceID.String() + " not found"},
+			{ID: otherID, Issue: "process other has no process id"},
+			{ID: otherID, Issue: "output 0 has an unspecified status"},
+			{ID: scope.ScopeId.String(), Issue: "contract specification " + cSpec.SpecificationId.String() +
+				" is not allowed by scope specification " + scopeSpec.SpecificationId.String()},
+		},
+	}
+	assert.Equal(t, expReport, report, "report")
+
+	expChecks := checks
+	expChecks.SpecificationId = recSpecs[0].SpecificationId
+	expChecks.Inputs = []RecordInput{
+		{Name: "parties", TypeName: "PartiesList", Source: &RecordInput_Hash{Hash: "inputhash"}, Status: RecordInputStatus_Proposed},
+	}
+	assert.Equal(t, []Record{expChecks, other, native}, state.Records, "records after normalization")
+	assert.NoError(t, state.Records[0].ValidateBasic(), "normalized record ValidateBasic")
+}
@@ -289,6 +289,20 @@ func (s *P8eTestSuite) TestEmptyScope() {
 				assert.Equal(t, "", scope.ValueOwnerAddress)
 			},
 		},
+		{
+			"DataAccessGrants",
+			"is not nil",
+			func(scope *Scope, t *testing.T) {
+				assert.NotNil(t, scope.DataAccessGrants)
+			},
+		},
+		{
+			"DataAccessGrants",
+			"length is 0",
+			func(scope *Scope, t *testing.T) {
+				assert.Equal(t, 0, len(scope.DataAccessGrants))
+			},
+		},
 	}
 
 	for i, tc := range tests {
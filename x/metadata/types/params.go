@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	yaml "gopkg.in/yaml.v2"
 
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
@@ -8,25 +10,50 @@ import (
 
 var _ paramtypes.ParamSet = &Params{}
 
+// Default parameter values
+const (
+	DefaultMaxRecordVersions  = uint32(0)
+	DefaultDisableP8eMessages = false
+	DefaultEmitDetailedEvents = false
+)
+
+// MaxMaxRecordVersions is the largest allowed value of the MaxRecordVersions param.
+const MaxMaxRecordVersions = uint32(100)
+
+// Parameter store keys
+var (
+	ParamStoreKeyMaxRecordVersions  = []byte("MaxRecordVersions")
+	ParamStoreKeyDisableP8eMessages = []byte("DisableP8eMessages")
+	ParamStoreKeyEmitDetailedEvents = []byte("EmitDetailedEvents")
+)
+
 // ParamKeyTable for metadata module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
 // NewParams creates a new parameter object
-func NewParams() Params {
-	return Params{}
+func NewParams(maxRecordVersions uint32, disableP8eMessages bool, emitDetailedEvents bool) Params {
+	return Params{
+		MaxRecordVersions:  maxRecordVersions,
+		DisableP8EMessages: disableP8eMessages,
+		EmitDetailedEvents: emitDetailedEvents,
+	}
 }
 
 // ParamSetPairs implements the ParamSet interface and returns all the key/value pairs
 // pairs of auth module's parameters.
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
-	return paramtypes.ParamSetPairs{}
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(ParamStoreKeyMaxRecordVersions, &p.MaxRecordVersions, validateMaxRecordVersions),
+		paramtypes.NewParamSetPair(ParamStoreKeyDisableP8eMessages, &p.DisableP8EMessages, validateDisableP8eMessages),
+		paramtypes.NewParamSetPair(ParamStoreKeyEmitDetailedEvents, &p.EmitDetailedEvents, validateEmitDetailedEvents),
+	}
 }
 
 // DefaultParams defines the parameters for this module
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(DefaultMaxRecordVersions, DefaultDisableP8eMessages, DefaultEmitDetailedEvents)
 }
 
 // String implements stringer interface
@@ -34,3 +61,33 @@ func (p Params) String() string {
 	out, _ := yaml.Marshal(p)
 	return string(out)
 }
+
+func validateMaxRecordVersions(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v > MaxMaxRecordVersions {
+		return fmt.Errorf("max record versions %d cannot be more than %d", v, MaxMaxRecordVersions)
+	}
+
+	return nil
+}
+
+func validateDisableP8eMessages(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateEmitDetailedEvents(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
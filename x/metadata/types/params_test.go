@@ -0,0 +1,14 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateMaxRecordVersions(t *testing.T) {
+	require.NoError(t, validateMaxRecordVersions(uint32(0)), "zero")
+	require.NoError(t, validateMaxRecordVersions(MaxMaxRecordVersions), "max")
+	require.EqualError(t, validateMaxRecordVersions(MaxMaxRecordVersions+1), "max record versions 101 cannot be more than 100", "max + 1")
+	require.EqualError(t, validateMaxRecordVersions(5), "invalid parameter type: int", "wrong type")
+}
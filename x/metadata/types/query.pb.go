@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
@@ -453,6 +454,162 @@ func (m *ScopesAllResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// ScopesRequest is the request type for the Query/Scopes RPC method.
+type ScopesRequest struct {
+	// specification_id can either be a uuid, e.g. dc83ea70-eacd-40fe-9adf-1cf6148bf8a2 or a bech32 scope specification
+	// address, e.g. scopespec1qnwg86nsatx5pl56muw0v9ytlz3qu3jx6m.
+	SpecificationId string `protobuf:"bytes,1,opt,name=specification_id,json=specificationId,proto3" json:"specification_id,omitempty" yaml:"specification_id"`
+	// owner is the bech32 address of a scope owner.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// owner_role is the role the owner must have in the scope.
+	OwnerRole PartyType `protobuf:"varint,3,opt,name=owner_role,json=ownerRole,proto3,enum=provenance.metadata.v1.PartyType" json:"owner_role,omitempty" yaml:"owner_role"`
+	// value_owner is the bech32 address of the scope value owner.
+	ValueOwner string `protobuf:"bytes,4,opt,name=value_owner,json=valueOwner,proto3" json:"value_owner,omitempty" yaml:"value_owner"`
+	// data_access is a bech32 address in the scope data access list.
+	DataAccess string `protobuf:"bytes,5,opt,name=data_access,json=dataAccess,proto3" json:"data_access,omitempty" yaml:"data_access"`
+	// pagination defines optional pagination parameters for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *ScopesRequest) Reset()         { *m = ScopesRequest{} }
+func (m *ScopesRequest) String() string { return proto.CompactTextString(m) }
+func (*ScopesRequest) ProtoMessage()    {}
+func (*ScopesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{7}
+}
+func (m *ScopesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScopesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScopesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScopesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScopesRequest.Merge(m, src)
+}
+func (m *ScopesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScopesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScopesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScopesRequest proto.InternalMessageInfo
+
+func (m *ScopesRequest) GetSpecificationId() string {
+	if m != nil {
+		return m.SpecificationId
+	}
+	return ""
+}
+
+func (m *ScopesRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *ScopesRequest) GetOwnerRole() PartyType {
+	if m != nil {
+		return m.OwnerRole
+	}
+	return PartyType_PARTY_TYPE_UNSPECIFIED
+}
+
+func (m *ScopesRequest) GetValueOwner() string {
+	if m != nil {
+		return m.ValueOwner
+	}
+	return ""
+}
+
+func (m *ScopesRequest) GetDataAccess() string {
+	if m != nil {
+		return m.DataAccess
+	}
+	return ""
+}
+
+func (m *ScopesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// ScopesResponse is the response type for the Query/Scopes RPC method.
+type ScopesResponse struct {
+	// scopes are the wrapped scopes.
+	Scopes []*ScopeWrapper `protobuf:"bytes,1,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	// request is a copy of the request that generated these results.
+	Request *ScopesRequest `protobuf:"bytes,98,opt,name=request,proto3" json:"request,omitempty"`
+	// pagination provides the pagination information of this response.
+	Pagination *query.PageResponse `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *ScopesResponse) Reset()         { *m = ScopesResponse{} }
+func (m *ScopesResponse) String() string { return proto.CompactTextString(m) }
+func (*ScopesResponse) ProtoMessage()    {}
+func (*ScopesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{8}
+}
+func (m *ScopesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScopesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScopesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScopesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScopesResponse.Merge(m, src)
+}
+func (m *ScopesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScopesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScopesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScopesResponse proto.InternalMessageInfo
+
+func (m *ScopesResponse) GetScopes() []*ScopeWrapper {
+	if m != nil {
+		return m.Scopes
+	}
+	return nil
+}
+
+func (m *ScopesResponse) GetRequest() *ScopesRequest {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+func (m *ScopesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // SessionsRequest is the request type for the Query/Sessions RPC method.
 type SessionsRequest struct {
 	// scope_id can either be a uuid, e.g. 91978ba2-5f35-459a-86a7-feca1b0512e0 or a bech32 scope address, e.g.
@@ -476,7 +633,7 @@ func (m *SessionsRequest) Reset()         { *m = SessionsRequest{} }
 func (m *SessionsRequest) String() string { return proto.CompactTextString(m) }
 func (*SessionsRequest) ProtoMessage()    {}
 func (*SessionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{7}
+	return fileDescriptor_a68790bc0b96eeb9, []int{9}
 }
 func (m *SessionsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -563,7 +720,7 @@ func (m *SessionsResponse) Reset()         { *m = SessionsResponse{} }
 func (m *SessionsResponse) String() string { return proto.CompactTextString(m) }
 func (*SessionsResponse) ProtoMessage()    {}
 func (*SessionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{8}
+	return fileDescriptor_a68790bc0b96eeb9, []int{10}
 }
 func (m *SessionsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -634,7 +791,7 @@ func (m *SessionWrapper) Reset()         { *m = SessionWrapper{} }
 func (m *SessionWrapper) String() string { return proto.CompactTextString(m) }
 func (*SessionWrapper) ProtoMessage()    {}
 func (*SessionWrapper) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{9}
+	return fileDescriptor_a68790bc0b96eeb9, []int{11}
 }
 func (m *SessionWrapper) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -694,7 +851,7 @@ func (m *SessionsAllRequest) Reset()         { *m = SessionsAllRequest{} }
 func (m *SessionsAllRequest) String() string { return proto.CompactTextString(m) }
 func (*SessionsAllRequest) ProtoMessage()    {}
 func (*SessionsAllRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{10}
+	return fileDescriptor_a68790bc0b96eeb9, []int{12}
 }
 func (m *SessionsAllRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -744,7 +901,7 @@ func (m *SessionsAllResponse) Reset()         { *m = SessionsAllResponse{} }
 func (m *SessionsAllResponse) String() string { return proto.CompactTextString(m) }
 func (*SessionsAllResponse) ProtoMessage()    {}
 func (*SessionsAllResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{11}
+	return fileDescriptor_a68790bc0b96eeb9, []int{13}
 }
 func (m *SessionsAllResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -794,6 +951,134 @@ func (m *SessionsAllResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// SessionsByPartyRequest is the request type for the Query/SessionsByParty RPC method.
+type SessionsByPartyRequest struct {
+	// party is the bech32 address of a session party.
+	Party string `protobuf:"bytes,1,opt,name=party,proto3" json:"party,omitempty"`
+	// role is the role the party must have in the session.
+	Role PartyType `protobuf:"varint,2,opt,name=role,proto3,enum=provenance.metadata.v1.PartyType" json:"role,omitempty"`
+	// pagination defines optional pagination parameters for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *SessionsByPartyRequest) Reset()         { *m = SessionsByPartyRequest{} }
+func (m *SessionsByPartyRequest) String() string { return proto.CompactTextString(m) }
+func (*SessionsByPartyRequest) ProtoMessage()    {}
+func (*SessionsByPartyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{14}
+}
+func (m *SessionsByPartyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SessionsByPartyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SessionsByPartyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SessionsByPartyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SessionsByPartyRequest.Merge(m, src)
+}
+func (m *SessionsByPartyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SessionsByPartyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SessionsByPartyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SessionsByPartyRequest proto.InternalMessageInfo
+
+func (m *SessionsByPartyRequest) GetParty() string {
+	if m != nil {
+		return m.Party
+	}
+	return ""
+}
+
+func (m *SessionsByPartyRequest) GetRole() PartyType {
+	if m != nil {
+		return m.Role
+	}
+	return PartyType_PARTY_TYPE_UNSPECIFIED
+}
+
+func (m *SessionsByPartyRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// SessionsByPartyResponse is the response type for the Query/SessionsByParty RPC method.
+type SessionsByPartyResponse struct {
+	// sessions are the wrapped sessions.
+	Sessions []*SessionWrapper `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	// request is a copy of the request that generated these results.
+	Request *SessionsByPartyRequest `protobuf:"bytes,98,opt,name=request,proto3" json:"request,omitempty"`
+	// pagination provides the pagination information of this response.
+	Pagination *query.PageResponse `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *SessionsByPartyResponse) Reset()         { *m = SessionsByPartyResponse{} }
+func (m *SessionsByPartyResponse) String() string { return proto.CompactTextString(m) }
+func (*SessionsByPartyResponse) ProtoMessage()    {}
+func (*SessionsByPartyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{15}
+}
+func (m *SessionsByPartyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SessionsByPartyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SessionsByPartyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SessionsByPartyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SessionsByPartyResponse.Merge(m, src)
+}
+func (m *SessionsByPartyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SessionsByPartyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SessionsByPartyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SessionsByPartyResponse proto.InternalMessageInfo
+
+func (m *SessionsByPartyResponse) GetSessions() []*SessionWrapper {
+	if m != nil {
+		return m.Sessions
+	}
+	return nil
+}
+
+func (m *SessionsByPartyResponse) GetRequest() *SessionsByPartyRequest {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+func (m *SessionsByPartyResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // RecordsRequest is the request type for the Query/Records RPC method.
 type RecordsRequest struct {
 	// record_addr is a bech32 record address, e.g. record1q2ge0zaztu65tx5x5llv5xc9ztsw42dq2jdvmdazuwzcaddhh8gmu3mcze3.
@@ -817,7 +1102,7 @@ func (m *RecordsRequest) Reset()         { *m = RecordsRequest{} }
 func (m *RecordsRequest) String() string { return proto.CompactTextString(m) }
 func (*RecordsRequest) ProtoMessage()    {}
 func (*RecordsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{12}
+	return fileDescriptor_a68790bc0b96eeb9, []int{16}
 }
 func (m *RecordsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -904,7 +1189,7 @@ func (m *RecordsResponse) Reset()         { *m = RecordsResponse{} }
 func (m *RecordsResponse) String() string { return proto.CompactTextString(m) }
 func (*RecordsResponse) ProtoMessage()    {}
 func (*RecordsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{13}
+	return fileDescriptor_a68790bc0b96eeb9, []int{17}
 }
 func (m *RecordsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -975,7 +1260,7 @@ func (m *RecordWrapper) Reset()         { *m = RecordWrapper{} }
 func (m *RecordWrapper) String() string { return proto.CompactTextString(m) }
 func (*RecordWrapper) ProtoMessage()    {}
 func (*RecordWrapper) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{14}
+	return fileDescriptor_a68790bc0b96eeb9, []int{18}
 }
 func (m *RecordWrapper) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1035,7 +1320,7 @@ func (m *RecordsAllRequest) Reset()         { *m = RecordsAllRequest{} }
 func (m *RecordsAllRequest) String() string { return proto.CompactTextString(m) }
 func (*RecordsAllRequest) ProtoMessage()    {}
 func (*RecordsAllRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{15}
+	return fileDescriptor_a68790bc0b96eeb9, []int{19}
 }
 func (m *RecordsAllRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1085,7 +1370,7 @@ func (m *RecordsAllResponse) Reset()         { *m = RecordsAllResponse{} }
 func (m *RecordsAllResponse) String() string { return proto.CompactTextString(m) }
 func (*RecordsAllResponse) ProtoMessage()    {}
 func (*RecordsAllResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{16}
+	return fileDescriptor_a68790bc0b96eeb9, []int{20}
 }
 func (m *RecordsAllResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1135,25 +1420,31 @@ func (m *RecordsAllResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
-// OwnershipRequest is the request type for the Query/Ownership RPC method.
-type OwnershipRequest struct {
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+// RecordHistoryRequest is the request type for the Query/RecordHistory RPC method.
+type RecordHistoryRequest struct {
+	// record_addr is a bech32 record address, e.g. record1q2ge0zaztu65tx5x5llv5xc9ztsw42dq2jdvmdazuwzcaddhh8gmu3mcze3.
+	RecordAddr string `protobuf:"bytes,1,opt,name=record_addr,json=recordAddr,proto3" json:"record_addr,omitempty" yaml:"record_addr"`
+	// scope_id can either be a uuid, e.g. 91978ba2-5f35-459a-86a7-feca1b0512e0 or a bech32 scope address, e.g.
+	// scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel. It is only used (with name) when record_addr is not provided.
+	ScopeId string `protobuf:"bytes,2,opt,name=scope_id,json=scopeId,proto3" json:"scope_id,omitempty" yaml:"scope_id"`
+	// name is the name of the record. It is only used (with scope_id) when record_addr is not provided.
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
 	// pagination defines optional pagination parameters for the request.
 	Pagination *query.PageRequest `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *OwnershipRequest) Reset()         { *m = OwnershipRequest{} }
-func (m *OwnershipRequest) String() string { return proto.CompactTextString(m) }
-func (*OwnershipRequest) ProtoMessage()    {}
-func (*OwnershipRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{17}
+func (m *RecordHistoryRequest) Reset()         { *m = RecordHistoryRequest{} }
+func (m *RecordHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*RecordHistoryRequest) ProtoMessage()    {}
+func (*RecordHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{21}
 }
-func (m *OwnershipRequest) XXX_Unmarshal(b []byte) error {
+func (m *RecordHistoryRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *OwnershipRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RecordHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_OwnershipRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RecordHistoryRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1163,47 +1454,179 @@ func (m *OwnershipRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *OwnershipRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_OwnershipRequest.Merge(m, src)
+func (m *RecordHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordHistoryRequest.Merge(m, src)
 }
-func (m *OwnershipRequest) XXX_Size() int {
+func (m *RecordHistoryRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *OwnershipRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_OwnershipRequest.DiscardUnknown(m)
+func (m *RecordHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordHistoryRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_OwnershipRequest proto.InternalMessageInfo
+var xxx_messageInfo_RecordHistoryRequest proto.InternalMessageInfo
 
-func (m *OwnershipRequest) GetAddress() string {
+func (m *RecordHistoryRequest) GetRecordAddr() string {
 	if m != nil {
-		return m.Address
+		return m.RecordAddr
 	}
 	return ""
 }
 
-func (m *OwnershipRequest) GetPagination() *query.PageRequest {
+func (m *RecordHistoryRequest) GetScopeId() string {
+	if m != nil {
+		return m.ScopeId
+	}
+	return ""
+}
+
+func (m *RecordHistoryRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *RecordHistoryRequest) GetPagination() *query.PageRequest {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-// OwnershipResponse is the response type for the Query/Ownership RPC method.
-type OwnershipResponse struct {
-	// A list of scope ids (uuid) associated with the given address.
-	ScopeUuids []string `protobuf:"bytes,1,rep,name=scope_uuids,json=scopeUuids,proto3" json:"scope_uuids,omitempty" yaml:"scope_uuids"`
+// RecordHistoryResponse is the response type for the Query/RecordHistory RPC method.
+type RecordHistoryResponse struct {
+	// versions are the retained versions of the record.
+	Versions []RecordVersion `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions"`
 	// request is a copy of the request that generated these results.
-	Request *OwnershipRequest `protobuf:"bytes,98,opt,name=request,proto3" json:"request,omitempty"`
+	Request *RecordHistoryRequest `protobuf:"bytes,98,opt,name=request,proto3" json:"request,omitempty"`
 	// pagination provides the pagination information of this response.
 	Pagination *query.PageResponse `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *OwnershipResponse) Reset()         { *m = OwnershipResponse{} }
-func (m *OwnershipResponse) String() string { return proto.CompactTextString(m) }
-func (*OwnershipResponse) ProtoMessage()    {}
-func (*OwnershipResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{18}
+func (m *RecordHistoryResponse) Reset()         { *m = RecordHistoryResponse{} }
+func (m *RecordHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*RecordHistoryResponse) ProtoMessage()    {}
+func (*RecordHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{22}
+}
+func (m *RecordHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecordHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecordHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RecordHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordHistoryResponse.Merge(m, src)
+}
+func (m *RecordHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecordHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecordHistoryResponse proto.InternalMessageInfo
+
+func (m *RecordHistoryResponse) GetVersions() []RecordVersion {
+	if m != nil {
+		return m.Versions
+	}
+	return nil
+}
+
+func (m *RecordHistoryResponse) GetRequest() *RecordHistoryRequest {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+func (m *RecordHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// OwnershipRequest is the request type for the Query/Ownership RPC method.
+type OwnershipRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// pagination defines optional pagination parameters for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *OwnershipRequest) Reset()         { *m = OwnershipRequest{} }
+func (m *OwnershipRequest) String() string { return proto.CompactTextString(m) }
+func (*OwnershipRequest) ProtoMessage()    {}
+func (*OwnershipRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{23}
+}
+func (m *OwnershipRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OwnershipRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OwnershipRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OwnershipRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OwnershipRequest.Merge(m, src)
+}
+func (m *OwnershipRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *OwnershipRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OwnershipRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OwnershipRequest proto.InternalMessageInfo
+
+func (m *OwnershipRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *OwnershipRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// OwnershipResponse is the response type for the Query/Ownership RPC method.
+type OwnershipResponse struct {
+	// A list of scope ids (uuid) associated with the given address.
+	ScopeUuids []string `protobuf:"bytes,1,rep,name=scope_uuids,json=scopeUuids,proto3" json:"scope_uuids,omitempty" yaml:"scope_uuids"`
+	// request is a copy of the request that generated these results.
+	Request *OwnershipRequest `protobuf:"bytes,98,opt,name=request,proto3" json:"request,omitempty"`
+	// pagination provides the pagination information of this response.
+	Pagination *query.PageResponse `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *OwnershipResponse) Reset()         { *m = OwnershipResponse{} }
+func (m *OwnershipResponse) String() string { return proto.CompactTextString(m) }
+func (*OwnershipResponse) ProtoMessage()    {}
+func (*OwnershipResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{24}
 }
 func (m *OwnershipResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1264,7 +1687,7 @@ func (m *ValueOwnershipRequest) Reset()         { *m = ValueOwnershipRequest{} }
 func (m *ValueOwnershipRequest) String() string { return proto.CompactTextString(m) }
 func (*ValueOwnershipRequest) ProtoMessage()    {}
 func (*ValueOwnershipRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{19}
+	return fileDescriptor_a68790bc0b96eeb9, []int{25}
 }
 func (m *ValueOwnershipRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1311,6 +1734,9 @@ func (m *ValueOwnershipRequest) GetPagination() *query.PageRequest {
 type ValueOwnershipResponse struct {
 	// A list of scope ids (uuid) associated with the given address.
 	ScopeUuids []string `protobuf:"bytes,1,rep,name=scope_uuids,json=scopeUuids,proto3" json:"scope_uuids,omitempty" yaml:"scope_uuids"`
+	// A list of scope ids (uuid) of tokenized scopes where the given address holds the full supply of the value owner
+	// token. These are looked up from the address's balances and are not paginated.
+	TokenScopeUuids []string `protobuf:"bytes,2,rep,name=token_scope_uuids,json=tokenScopeUuids,proto3" json:"token_scope_uuids,omitempty" yaml:"token_scope_uuids"`
 	// request is a copy of the request that generated these results.
 	Request *ValueOwnershipRequest `protobuf:"bytes,98,opt,name=request,proto3" json:"request,omitempty"`
 	// pagination provides the pagination information of this response.
@@ -1321,7 +1747,7 @@ func (m *ValueOwnershipResponse) Reset()         { *m = ValueOwnershipResponse{}
 func (m *ValueOwnershipResponse) String() string { return proto.CompactTextString(m) }
 func (*ValueOwnershipResponse) ProtoMessage()    {}
 func (*ValueOwnershipResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{20}
+	return fileDescriptor_a68790bc0b96eeb9, []int{26}
 }
 func (m *ValueOwnershipResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1357,6 +1783,13 @@ func (m *ValueOwnershipResponse) GetScopeUuids() []string {
 	return nil
 }
 
+func (m *ValueOwnershipResponse) GetTokenScopeUuids() []string {
+	if m != nil {
+		return m.TokenScopeUuids
+	}
+	return nil
+}
+
 func (m *ValueOwnershipResponse) GetRequest() *ValueOwnershipRequest {
 	if m != nil {
 		return m.Request
@@ -1371,25 +1804,27 @@ func (m *ValueOwnershipResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
-// ScopeSpecificationRequest is the request type for the Query/ScopeSpecification RPC method.
-type ScopeSpecificationRequest struct {
-	// specification_id can either be a uuid, e.g. dc83ea70-eacd-40fe-9adf-1cf6148bf8a2 or a bech32 scope specification
-	// address, e.g. scopespec1qnwg86nsatx5pl56muw0v9ytlz3qu3jx6m.
-	SpecificationId string `protobuf:"bytes,1,opt,name=specification_id,json=specificationId,proto3" json:"specification_id,omitempty" yaml:"specification_id"`
+// ScopeValueOwnerTokenRequest is the request type for the Query/ScopeValueOwnerToken RPC method.
+type ScopeValueOwnerTokenRequest struct {
+	// scope_id can either be a uuid, e.g. 91978ba2-5f35-459a-86a7-feca1b0512e0 or a bech32 scope address, e.g.
+	// scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel.
+	ScopeId string `protobuf:"bytes,1,opt,name=scope_id,json=scopeId,proto3" json:"scope_id,omitempty" yaml:"scope_id"`
+	// denom is the denom of a value owner token, e.g. scopevalue/scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func (m *ScopeSpecificationRequest) Reset()         { *m = ScopeSpecificationRequest{} }
-func (m *ScopeSpecificationRequest) String() string { return proto.CompactTextString(m) }
-func (*ScopeSpecificationRequest) ProtoMessage()    {}
-func (*ScopeSpecificationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{21}
+func (m *ScopeValueOwnerTokenRequest) Reset()         { *m = ScopeValueOwnerTokenRequest{} }
+func (m *ScopeValueOwnerTokenRequest) String() string { return proto.CompactTextString(m) }
+func (*ScopeValueOwnerTokenRequest) ProtoMessage()    {}
+func (*ScopeValueOwnerTokenRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{27}
 }
-func (m *ScopeSpecificationRequest) XXX_Unmarshal(b []byte) error {
+func (m *ScopeValueOwnerTokenRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ScopeSpecificationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ScopeValueOwnerTokenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ScopeSpecificationRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ScopeValueOwnerTokenRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1399,45 +1834,63 @@ func (m *ScopeSpecificationRequest) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *ScopeSpecificationRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ScopeSpecificationRequest.Merge(m, src)
+func (m *ScopeValueOwnerTokenRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScopeValueOwnerTokenRequest.Merge(m, src)
 }
-func (m *ScopeSpecificationRequest) XXX_Size() int {
+func (m *ScopeValueOwnerTokenRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *ScopeSpecificationRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ScopeSpecificationRequest.DiscardUnknown(m)
+func (m *ScopeValueOwnerTokenRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScopeValueOwnerTokenRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ScopeSpecificationRequest proto.InternalMessageInfo
+var xxx_messageInfo_ScopeValueOwnerTokenRequest proto.InternalMessageInfo
 
-func (m *ScopeSpecificationRequest) GetSpecificationId() string {
+func (m *ScopeValueOwnerTokenRequest) GetScopeId() string {
 	if m != nil {
-		return m.SpecificationId
+		return m.ScopeId
 	}
 	return ""
 }
 
-// ScopeSpecificationResponse is the response type for the Query/ScopeSpecification RPC method.
-type ScopeSpecificationResponse struct {
-	// scope_specification is the wrapped scope specification.
-	ScopeSpecification *ScopeSpecificationWrapper `protobuf:"bytes,1,opt,name=scope_specification,json=scopeSpecification,proto3" json:"scope_specification,omitempty" yaml:"scope_specification"`
+func (m *ScopeValueOwnerTokenRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// ScopeValueOwnerTokenResponse is the response type for the Query/ScopeValueOwnerToken RPC method.
+type ScopeValueOwnerTokenResponse struct {
+	// scope_id is the bech32 address of the scope.
+	ScopeId string `protobuf:"bytes,1,opt,name=scope_id,json=scopeId,proto3" json:"scope_id,omitempty" yaml:"scope_id"`
+	// denom is the denom of the value owner token of the scope.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// marker_address is the bech32 address of the marker for the value owner token.
+	MarkerAddress string `protobuf:"bytes,3,opt,name=marker_address,json=markerAddress,proto3" json:"marker_address,omitempty" yaml:"marker_address"`
+	// tokenized is true if the value owner of the scope is the marker for the value owner token.
+	Tokenized bool `protobuf:"varint,4,opt,name=tokenized,proto3" json:"tokenized,omitempty"`
+	// supply is the total supply of the value owner token.
+	Supply github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=supply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"supply"`
+	// value_owner is the address that holds the full supply of the value owner token.
+	// It is empty if the scope is not tokenized or no single address holds the full supply.
+	ValueOwner string `protobuf:"bytes,6,opt,name=value_owner,json=valueOwner,proto3" json:"value_owner,omitempty" yaml:"value_owner"`
 	// request is a copy of the request that generated these results.
-	Request *ScopeSpecificationRequest `protobuf:"bytes,98,opt,name=request,proto3" json:"request,omitempty"`
+	Request *ScopeValueOwnerTokenRequest `protobuf:"bytes,98,opt,name=request,proto3" json:"request,omitempty"`
 }
 
-func (m *ScopeSpecificationResponse) Reset()         { *m = ScopeSpecificationResponse{} }
-func (m *ScopeSpecificationResponse) String() string { return proto.CompactTextString(m) }
-func (*ScopeSpecificationResponse) ProtoMessage()    {}
-func (*ScopeSpecificationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{22}
+func (m *ScopeValueOwnerTokenResponse) Reset()         { *m = ScopeValueOwnerTokenResponse{} }
+func (m *ScopeValueOwnerTokenResponse) String() string { return proto.CompactTextString(m) }
+func (*ScopeValueOwnerTokenResponse) ProtoMessage()    {}
+func (*ScopeValueOwnerTokenResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{28}
 }
-func (m *ScopeSpecificationResponse) XXX_Unmarshal(b []byte) error {
+func (m *ScopeValueOwnerTokenResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ScopeSpecificationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ScopeValueOwnerTokenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ScopeSpecificationResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ScopeValueOwnerTokenResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1447,52 +1900,79 @@ func (m *ScopeSpecificationResponse) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *ScopeSpecificationResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ScopeSpecificationResponse.Merge(m, src)
+func (m *ScopeValueOwnerTokenResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScopeValueOwnerTokenResponse.Merge(m, src)
 }
-func (m *ScopeSpecificationResponse) XXX_Size() int {
+func (m *ScopeValueOwnerTokenResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *ScopeSpecificationResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ScopeSpecificationResponse.DiscardUnknown(m)
+func (m *ScopeValueOwnerTokenResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScopeValueOwnerTokenResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ScopeSpecificationResponse proto.InternalMessageInfo
+var xxx_messageInfo_ScopeValueOwnerTokenResponse proto.InternalMessageInfo
 
-func (m *ScopeSpecificationResponse) GetScopeSpecification() *ScopeSpecificationWrapper {
+func (m *ScopeValueOwnerTokenResponse) GetScopeId() string {
 	if m != nil {
-		return m.ScopeSpecification
+		return m.ScopeId
 	}
-	return nil
+	return ""
 }
 
-func (m *ScopeSpecificationResponse) GetRequest() *ScopeSpecificationRequest {
+func (m *ScopeValueOwnerTokenResponse) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *ScopeValueOwnerTokenResponse) GetMarkerAddress() string {
+	if m != nil {
+		return m.MarkerAddress
+	}
+	return ""
+}
+
+func (m *ScopeValueOwnerTokenResponse) GetTokenized() bool {
+	if m != nil {
+		return m.Tokenized
+	}
+	return false
+}
+
+func (m *ScopeValueOwnerTokenResponse) GetValueOwner() string {
+	if m != nil {
+		return m.ValueOwner
+	}
+	return ""
+}
+
+func (m *ScopeValueOwnerTokenResponse) GetRequest() *ScopeValueOwnerTokenRequest {
 	if m != nil {
 		return m.Request
 	}
 	return nil
 }
 
-// ScopeSpecificationWrapper contains a single scope specification and some extra identifiers for it.
-type ScopeSpecificationWrapper struct {
-	// specification is the on-chain scope specification message.
-	Specification *ScopeSpecification `protobuf:"bytes,1,opt,name=specification,proto3" json:"specification,omitempty"`
-	// scope_spec_id_info contains information about the id/address of the scope specification.
-	ScopeSpecIdInfo *ScopeSpecIdInfo `protobuf:"bytes,2,opt,name=scope_spec_id_info,json=scopeSpecIdInfo,proto3" json:"scope_spec_id_info,omitempty" yaml:"scope_spec_id_info"`
+// ScopeSaleOfferRequest is the request type for the Query/ScopeSaleOffer RPC method.
+type ScopeSaleOfferRequest struct {
+	// scope_id can either be a uuid, e.g. 91978ba2-5f35-459a-86a7-feca1b0512e0 or a bech32 scope address, e.g.
+	// scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel.
+	ScopeId string `protobuf:"bytes,1,opt,name=scope_id,json=scopeId,proto3" json:"scope_id,omitempty" yaml:"scope_id"`
 }
 
-func (m *ScopeSpecificationWrapper) Reset()         { *m = ScopeSpecificationWrapper{} }
-func (m *ScopeSpecificationWrapper) String() string { return proto.CompactTextString(m) }
-func (*ScopeSpecificationWrapper) ProtoMessage()    {}
-func (*ScopeSpecificationWrapper) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{23}
+func (m *ScopeSaleOfferRequest) Reset()         { *m = ScopeSaleOfferRequest{} }
+func (m *ScopeSaleOfferRequest) String() string { return proto.CompactTextString(m) }
+func (*ScopeSaleOfferRequest) ProtoMessage()    {}
+func (*ScopeSaleOfferRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{29}
 }
-func (m *ScopeSpecificationWrapper) XXX_Unmarshal(b []byte) error {
+func (m *ScopeSaleOfferRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ScopeSpecificationWrapper) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ScopeSaleOfferRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ScopeSpecificationWrapper.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ScopeSaleOfferRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1502,50 +1982,47 @@ func (m *ScopeSpecificationWrapper) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *ScopeSpecificationWrapper) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ScopeSpecificationWrapper.Merge(m, src)
+func (m *ScopeSaleOfferRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScopeSaleOfferRequest.Merge(m, src)
 }
-func (m *ScopeSpecificationWrapper) XXX_Size() int {
+func (m *ScopeSaleOfferRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *ScopeSpecificationWrapper) XXX_DiscardUnknown() {
-	xxx_messageInfo_ScopeSpecificationWrapper.DiscardUnknown(m)
+func (m *ScopeSaleOfferRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScopeSaleOfferRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ScopeSpecificationWrapper proto.InternalMessageInfo
-
-func (m *ScopeSpecificationWrapper) GetSpecification() *ScopeSpecification {
-	if m != nil {
-		return m.Specification
-	}
-	return nil
-}
+var xxx_messageInfo_ScopeSaleOfferRequest proto.InternalMessageInfo
 
-func (m *ScopeSpecificationWrapper) GetScopeSpecIdInfo() *ScopeSpecIdInfo {
+func (m *ScopeSaleOfferRequest) GetScopeId() string {
 	if m != nil {
-		return m.ScopeSpecIdInfo
+		return m.ScopeId
 	}
-	return nil
+	return ""
 }
 
-// ScopeSpecificationsAllRequest is the request type for the Query/ScopeSpecificationsAll RPC method.
-type ScopeSpecificationsAllRequest struct {
-	// pagination defines optional pagination parameters for the request.
-	Pagination *query.PageRequest `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+// ScopeSaleOfferResponse is the response type for the Query/ScopeSaleOffer RPC method.
+type ScopeSaleOfferResponse struct {
+	// offer is the open offer to sell the value ownership of the scope.
+	Offer *ScopeSaleOffer `protobuf:"bytes,1,opt,name=offer,proto3" json:"offer,omitempty"`
+	// expired is true if the offer can no longer be accepted.
+	Expired bool `protobuf:"varint,2,opt,name=expired,proto3" json:"expired,omitempty"`
+	// request is a copy of the request that generated these results.
+	Request *ScopeSaleOfferRequest `protobuf:"bytes,98,opt,name=request,proto3" json:"request,omitempty"`
 }
 
-func (m *ScopeSpecificationsAllRequest) Reset()         { *m = ScopeSpecificationsAllRequest{} }
-func (m *ScopeSpecificationsAllRequest) String() string { return proto.CompactTextString(m) }
-func (*ScopeSpecificationsAllRequest) ProtoMessage()    {}
-func (*ScopeSpecificationsAllRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{24}
+func (m *ScopeSaleOfferResponse) Reset()         { *m = ScopeSaleOfferResponse{} }
+func (m *ScopeSaleOfferResponse) String() string { return proto.CompactTextString(m) }
+func (*ScopeSaleOfferResponse) ProtoMessage()    {}
+func (*ScopeSaleOfferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{30}
 }
-func (m *ScopeSpecificationsAllRequest) XXX_Unmarshal(b []byte) error {
+func (m *ScopeSaleOfferResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ScopeSpecificationsAllRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ScopeSaleOfferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ScopeSpecificationsAllRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ScopeSaleOfferResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1555,47 +2032,64 @@ func (m *ScopeSpecificationsAllRequest) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *ScopeSpecificationsAllRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ScopeSpecificationsAllRequest.Merge(m, src)
+func (m *ScopeSaleOfferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScopeSaleOfferResponse.Merge(m, src)
 }
-func (m *ScopeSpecificationsAllRequest) XXX_Size() int {
+func (m *ScopeSaleOfferResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *ScopeSpecificationsAllRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ScopeSpecificationsAllRequest.DiscardUnknown(m)
+func (m *ScopeSaleOfferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScopeSaleOfferResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ScopeSpecificationsAllRequest proto.InternalMessageInfo
+var xxx_messageInfo_ScopeSaleOfferResponse proto.InternalMessageInfo
 
-func (m *ScopeSpecificationsAllRequest) GetPagination() *query.PageRequest {
+func (m *ScopeSaleOfferResponse) GetOffer() *ScopeSaleOffer {
 	if m != nil {
-		return m.Pagination
+		return m.Offer
 	}
 	return nil
 }
 
-// ScopeSpecificationsAllResponse is the response type for the Query/ScopeSpecificationsAll RPC method.
-type ScopeSpecificationsAllResponse struct {
-	// scope_specifications are the wrapped scope specifications.
-	ScopeSpecifications []*ScopeSpecificationWrapper `protobuf:"bytes,1,rep,name=scope_specifications,json=scopeSpecifications,proto3" json:"scope_specifications,omitempty" yaml:"scope_specifications"`
-	// request is a copy of the request that generated these results.
-	Request *ScopeSpecificationsAllRequest `protobuf:"bytes,98,opt,name=request,proto3" json:"request,omitempty"`
-	// pagination provides the pagination information of this response.
-	Pagination *query.PageResponse `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+func (m *ScopeSaleOfferResponse) GetExpired() bool {
+	if m != nil {
+		return m.Expired
+	}
+	return false
 }
 
-func (m *ScopeSpecificationsAllResponse) Reset()         { *m = ScopeSpecificationsAllResponse{} }
-func (m *ScopeSpecificationsAllResponse) String() string { return proto.CompactTextString(m) }
-func (*ScopeSpecificationsAllResponse) ProtoMessage()    {}
-func (*ScopeSpecificationsAllResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{25}
+func (m *ScopeSaleOfferResponse) GetRequest() *ScopeSaleOfferRequest {
+	if m != nil {
+		return m.Request
+	}
+	return nil
 }
-func (m *ScopeSpecificationsAllResponse) XXX_Unmarshal(b []byte) error {
+
+// CheckScopeSpecificationMigrationRequest is the request type for the Query/CheckScopeSpecificationMigration RPC method.
+type CheckScopeSpecificationMigrationRequest struct {
+	// scope_id can either be a uuid, e.g. 91978ba2-5f35-459a-86a7-feca1b0512e0 or a bech32 scope address, e.g.
+	// scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel.
+	ScopeId string `protobuf:"bytes,1,opt,name=scope_id,json=scopeId,proto3" json:"scope_id,omitempty" yaml:"scope_id"`
+	// specification_id is the scope specification to migrate to. It can either be a uuid, e.g.
+	// dc83ea70-eacd-40fe-9adf-1cf6148bf8a2 or a bech32 scope specification address, e.g.
+	// scopespec1qnwg86nsatx5pl56muw0v9ytlz3qu3jx6m.
+	SpecificationId string `protobuf:"bytes,2,opt,name=specification_id,json=specificationId,proto3" json:"specification_id,omitempty" yaml:"specification_id"`
+}
+
+func (m *CheckScopeSpecificationMigrationRequest) Reset() {
+	*m = CheckScopeSpecificationMigrationRequest{}
+}
+func (m *CheckScopeSpecificationMigrationRequest) String() string { return proto.CompactTextString(m) }
+func (*CheckScopeSpecificationMigrationRequest) ProtoMessage()    {}
+func (*CheckScopeSpecificationMigrationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{31}
+}
+func (m *CheckScopeSpecificationMigrationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ScopeSpecificationsAllResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CheckScopeSpecificationMigrationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ScopeSpecificationsAllResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CheckScopeSpecificationMigrationRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1605,63 +2099,118 @@ func (m *ScopeSpecificationsAllResponse) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *ScopeSpecificationsAllResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ScopeSpecificationsAllResponse.Merge(m, src)
+func (m *CheckScopeSpecificationMigrationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckScopeSpecificationMigrationRequest.Merge(m, src)
 }
-func (m *ScopeSpecificationsAllResponse) XXX_Size() int {
+func (m *CheckScopeSpecificationMigrationRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *ScopeSpecificationsAllResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ScopeSpecificationsAllResponse.DiscardUnknown(m)
+func (m *CheckScopeSpecificationMigrationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckScopeSpecificationMigrationRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ScopeSpecificationsAllResponse proto.InternalMessageInfo
+var xxx_messageInfo_CheckScopeSpecificationMigrationRequest proto.InternalMessageInfo
 
-func (m *ScopeSpecificationsAllResponse) GetScopeSpecifications() []*ScopeSpecificationWrapper {
+func (m *CheckScopeSpecificationMigrationRequest) GetScopeId() string {
 	if m != nil {
-		return m.ScopeSpecifications
+		return m.ScopeId
 	}
-	return nil
+	return ""
 }
 
-func (m *ScopeSpecificationsAllResponse) GetRequest() *ScopeSpecificationsAllRequest {
+func (m *CheckScopeSpecificationMigrationRequest) GetSpecificationId() string {
 	if m != nil {
-		return m.Request
+		return m.SpecificationId
+	}
+	return ""
+}
+
+// CheckScopeSpecificationMigrationResponse is the response type for the Query/CheckScopeSpecificationMigration RPC
+// method.
+type CheckScopeSpecificationMigrationResponse struct {
+	// compatible is true if the scope can be migrated to the specification (given the required signatures).
+	Compatible bool `protobuf:"varint,1,opt,name=compatible,proto3" json:"compatible,omitempty"`
+	// issues are all the reasons the scope cannot be migrated to the specification.
+	Issues []string `protobuf:"bytes,2,rep,name=issues,proto3" json:"issues,omitempty"`
+	// request is a copy of the request that generated these results.
+	Request *CheckScopeSpecificationMigrationRequest `protobuf:"bytes,98,opt,name=request,proto3" json:"request,omitempty"`
+}
+
+func (m *CheckScopeSpecificationMigrationResponse) Reset() {
+	*m = CheckScopeSpecificationMigrationResponse{}
+}
+func (m *CheckScopeSpecificationMigrationResponse) String() string { return proto.CompactTextString(m) }
+func (*CheckScopeSpecificationMigrationResponse) ProtoMessage()    {}
+func (*CheckScopeSpecificationMigrationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{32}
+}
+func (m *CheckScopeSpecificationMigrationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CheckScopeSpecificationMigrationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CheckScopeSpecificationMigrationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CheckScopeSpecificationMigrationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckScopeSpecificationMigrationResponse.Merge(m, src)
+}
+func (m *CheckScopeSpecificationMigrationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CheckScopeSpecificationMigrationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckScopeSpecificationMigrationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckScopeSpecificationMigrationResponse proto.InternalMessageInfo
+
+func (m *CheckScopeSpecificationMigrationResponse) GetCompatible() bool {
+	if m != nil {
+		return m.Compatible
+	}
+	return false
+}
+
+func (m *CheckScopeSpecificationMigrationResponse) GetIssues() []string {
+	if m != nil {
+		return m.Issues
 	}
 	return nil
 }
 
-func (m *ScopeSpecificationsAllResponse) GetPagination() *query.PageResponse {
+func (m *CheckScopeSpecificationMigrationResponse) GetRequest() *CheckScopeSpecificationMigrationRequest {
 	if m != nil {
-		return m.Pagination
+		return m.Request
 	}
 	return nil
 }
 
-// ContractSpecificationRequest is the request type for the Query/ContractSpecification RPC method.
-type ContractSpecificationRequest struct {
-	// specification_id can either be a uuid, e.g. def6bc0a-c9dd-4874-948f-5206e6060a84 or a bech32 contract specification
-	// address, e.g. contractspec1q000d0q2e8w5say53afqdesxp2zqzkr4fn.
-	// It can also be a record specification address, e.g.
-	// recspec1qh00d0q2e8w5say53afqdesxp2zw42dq2jdvmdazuwzcaddhh8gmuqhez44.
+// ScopeSpecificationRequest is the request type for the Query/ScopeSpecification RPC method.
+type ScopeSpecificationRequest struct {
+	// specification_id can either be a uuid, e.g. dc83ea70-eacd-40fe-9adf-1cf6148bf8a2 or a bech32 scope specification
+	// address, e.g. scopespec1qnwg86nsatx5pl56muw0v9ytlz3qu3jx6m.
 	SpecificationId string `protobuf:"bytes,1,opt,name=specification_id,json=specificationId,proto3" json:"specification_id,omitempty" yaml:"specification_id"`
-	// include_record_specs is a flag for whether or not the record specifications in this contract specification should
-	// be included in the result.
-	IncludeRecordSpecs bool `protobuf:"varint,10,opt,name=include_record_specs,json=includeRecordSpecs,proto3" json:"include_record_specs,omitempty" yaml:"include_record_specs"`
 }
 
-func (m *ContractSpecificationRequest) Reset()         { *m = ContractSpecificationRequest{} }
-func (m *ContractSpecificationRequest) String() string { return proto.CompactTextString(m) }
-func (*ContractSpecificationRequest) ProtoMessage()    {}
-func (*ContractSpecificationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{26}
+func (m *ScopeSpecificationRequest) Reset()         { *m = ScopeSpecificationRequest{} }
+func (m *ScopeSpecificationRequest) String() string { return proto.CompactTextString(m) }
+func (*ScopeSpecificationRequest) ProtoMessage()    {}
+func (*ScopeSpecificationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{33}
 }
-func (m *ContractSpecificationRequest) XXX_Unmarshal(b []byte) error {
+func (m *ScopeSpecificationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ContractSpecificationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ScopeSpecificationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ContractSpecificationRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ScopeSpecificationRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1671,55 +2220,45 @@ func (m *ContractSpecificationRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *ContractSpecificationRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ContractSpecificationRequest.Merge(m, src)
+func (m *ScopeSpecificationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScopeSpecificationRequest.Merge(m, src)
 }
-func (m *ContractSpecificationRequest) XXX_Size() int {
+func (m *ScopeSpecificationRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *ContractSpecificationRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ContractSpecificationRequest.DiscardUnknown(m)
+func (m *ScopeSpecificationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScopeSpecificationRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ContractSpecificationRequest proto.InternalMessageInfo
+var xxx_messageInfo_ScopeSpecificationRequest proto.InternalMessageInfo
 
-func (m *ContractSpecificationRequest) GetSpecificationId() string {
+func (m *ScopeSpecificationRequest) GetSpecificationId() string {
 	if m != nil {
 		return m.SpecificationId
 	}
 	return ""
 }
 
-func (m *ContractSpecificationRequest) GetIncludeRecordSpecs() bool {
-	if m != nil {
-		return m.IncludeRecordSpecs
-	}
-	return false
-}
-
-// ContractSpecificationResponse is the response type for the Query/ContractSpecification RPC method.
-type ContractSpecificationResponse struct {
-	// contract_specification is the wrapped contract specification.
-	ContractSpecification *ContractSpecificationWrapper `protobuf:"bytes,1,opt,name=contract_specification,json=contractSpecification,proto3" json:"contract_specification,omitempty" yaml:"contract_specification"`
-	// record_specifications is any number or wrapped record specifications associated with this contract_specification
-	// (if requested).
-	RecordSpecifications []*RecordSpecificationWrapper `protobuf:"bytes,3,rep,name=record_specifications,json=recordSpecifications,proto3" json:"record_specifications,omitempty" yaml:"record_specifications,omitempty"`
+// ScopeSpecificationResponse is the response type for the Query/ScopeSpecification RPC method.
+type ScopeSpecificationResponse struct {
+	// scope_specification is the wrapped scope specification.
+	ScopeSpecification *ScopeSpecificationWrapper `protobuf:"bytes,1,opt,name=scope_specification,json=scopeSpecification,proto3" json:"scope_specification,omitempty" yaml:"scope_specification"`
 	// request is a copy of the request that generated these results.
-	Request *ContractSpecificationRequest `protobuf:"bytes,98,opt,name=request,proto3" json:"request,omitempty"`
+	Request *ScopeSpecificationRequest `protobuf:"bytes,98,opt,name=request,proto3" json:"request,omitempty"`
 }
 
-func (m *ContractSpecificationResponse) Reset()         { *m = ContractSpecificationResponse{} }
-func (m *ContractSpecificationResponse) String() string { return proto.CompactTextString(m) }
-func (*ContractSpecificationResponse) ProtoMessage()    {}
-func (*ContractSpecificationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{27}
+func (m *ScopeSpecificationResponse) Reset()         { *m = ScopeSpecificationResponse{} }
+func (m *ScopeSpecificationResponse) String() string { return proto.CompactTextString(m) }
+func (*ScopeSpecificationResponse) ProtoMessage()    {}
+func (*ScopeSpecificationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{34}
 }
-func (m *ContractSpecificationResponse) XXX_Unmarshal(b []byte) error {
+func (m *ScopeSpecificationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ContractSpecificationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ScopeSpecificationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ContractSpecificationResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ScopeSpecificationResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1729,59 +2268,52 @@ func (m *ContractSpecificationResponse) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *ContractSpecificationResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ContractSpecificationResponse.Merge(m, src)
+func (m *ScopeSpecificationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScopeSpecificationResponse.Merge(m, src)
 }
-func (m *ContractSpecificationResponse) XXX_Size() int {
+func (m *ScopeSpecificationResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *ContractSpecificationResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ContractSpecificationResponse.DiscardUnknown(m)
+func (m *ScopeSpecificationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScopeSpecificationResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ContractSpecificationResponse proto.InternalMessageInfo
-
-func (m *ContractSpecificationResponse) GetContractSpecification() *ContractSpecificationWrapper {
-	if m != nil {
-		return m.ContractSpecification
-	}
-	return nil
-}
+var xxx_messageInfo_ScopeSpecificationResponse proto.InternalMessageInfo
 
-func (m *ContractSpecificationResponse) GetRecordSpecifications() []*RecordSpecificationWrapper {
+func (m *ScopeSpecificationResponse) GetScopeSpecification() *ScopeSpecificationWrapper {
 	if m != nil {
-		return m.RecordSpecifications
+		return m.ScopeSpecification
 	}
 	return nil
 }
 
-func (m *ContractSpecificationResponse) GetRequest() *ContractSpecificationRequest {
+func (m *ScopeSpecificationResponse) GetRequest() *ScopeSpecificationRequest {
 	if m != nil {
 		return m.Request
 	}
 	return nil
 }
 
-// ContractSpecificationWrapper contains a single contract specification and some extra identifiers for it.
-type ContractSpecificationWrapper struct {
-	// specification is the on-chain contract specification message.
-	Specification *ContractSpecification `protobuf:"bytes,1,opt,name=specification,proto3" json:"specification,omitempty"`
-	// contract_spec_id_info contains information about the id/address of the contract specification.
-	ContractSpecIdInfo *ContractSpecIdInfo `protobuf:"bytes,2,opt,name=contract_spec_id_info,json=contractSpecIdInfo,proto3" json:"contract_spec_id_info,omitempty" yaml:"contract_spec_id_info"`
+// ScopeSpecificationWrapper contains a single scope specification and some extra identifiers for it.
+type ScopeSpecificationWrapper struct {
+	// specification is the on-chain scope specification message.
+	Specification *ScopeSpecification `protobuf:"bytes,1,opt,name=specification,proto3" json:"specification,omitempty"`
+	// scope_spec_id_info contains information about the id/address of the scope specification.
+	ScopeSpecIdInfo *ScopeSpecIdInfo `protobuf:"bytes,2,opt,name=scope_spec_id_info,json=scopeSpecIdInfo,proto3" json:"scope_spec_id_info,omitempty" yaml:"scope_spec_id_info"`
 }
 
-func (m *ContractSpecificationWrapper) Reset()         { *m = ContractSpecificationWrapper{} }
-func (m *ContractSpecificationWrapper) String() string { return proto.CompactTextString(m) }
-func (*ContractSpecificationWrapper) ProtoMessage()    {}
-func (*ContractSpecificationWrapper) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{28}
+func (m *ScopeSpecificationWrapper) Reset()         { *m = ScopeSpecificationWrapper{} }
+func (m *ScopeSpecificationWrapper) String() string { return proto.CompactTextString(m) }
+func (*ScopeSpecificationWrapper) ProtoMessage()    {}
+func (*ScopeSpecificationWrapper) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{35}
 }
-func (m *ContractSpecificationWrapper) XXX_Unmarshal(b []byte) error {
+func (m *ScopeSpecificationWrapper) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ContractSpecificationWrapper) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ScopeSpecificationWrapper) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ContractSpecificationWrapper.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ScopeSpecificationWrapper.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1791,50 +2323,50 @@ func (m *ContractSpecificationWrapper) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *ContractSpecificationWrapper) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ContractSpecificationWrapper.Merge(m, src)
+func (m *ScopeSpecificationWrapper) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScopeSpecificationWrapper.Merge(m, src)
 }
-func (m *ContractSpecificationWrapper) XXX_Size() int {
+func (m *ScopeSpecificationWrapper) XXX_Size() int {
 	return m.Size()
 }
-func (m *ContractSpecificationWrapper) XXX_DiscardUnknown() {
-	xxx_messageInfo_ContractSpecificationWrapper.DiscardUnknown(m)
+func (m *ScopeSpecificationWrapper) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScopeSpecificationWrapper.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ContractSpecificationWrapper proto.InternalMessageInfo
+var xxx_messageInfo_ScopeSpecificationWrapper proto.InternalMessageInfo
 
-func (m *ContractSpecificationWrapper) GetSpecification() *ContractSpecification {
+func (m *ScopeSpecificationWrapper) GetSpecification() *ScopeSpecification {
 	if m != nil {
 		return m.Specification
 	}
 	return nil
 }
 
-func (m *ContractSpecificationWrapper) GetContractSpecIdInfo() *ContractSpecIdInfo {
+func (m *ScopeSpecificationWrapper) GetScopeSpecIdInfo() *ScopeSpecIdInfo {
 	if m != nil {
-		return m.ContractSpecIdInfo
+		return m.ScopeSpecIdInfo
 	}
 	return nil
 }
 
-// ContractSpecificationsAllRequest is the request type for the Query/ContractSpecificationsAll RPC method.
-type ContractSpecificationsAllRequest struct {
+// ScopeSpecificationsAllRequest is the request type for the Query/ScopeSpecificationsAll RPC method.
+type ScopeSpecificationsAllRequest struct {
 	// pagination defines optional pagination parameters for the request.
 	Pagination *query.PageRequest `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *ContractSpecificationsAllRequest) Reset()         { *m = ContractSpecificationsAllRequest{} }
-func (m *ContractSpecificationsAllRequest) String() string { return proto.CompactTextString(m) }
-func (*ContractSpecificationsAllRequest) ProtoMessage()    {}
-func (*ContractSpecificationsAllRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{29}
+func (m *ScopeSpecificationsAllRequest) Reset()         { *m = ScopeSpecificationsAllRequest{} }
+func (m *ScopeSpecificationsAllRequest) String() string { return proto.CompactTextString(m) }
+func (*ScopeSpecificationsAllRequest) ProtoMessage()    {}
+func (*ScopeSpecificationsAllRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{36}
 }
-func (m *ContractSpecificationsAllRequest) XXX_Unmarshal(b []byte) error {
+func (m *ScopeSpecificationsAllRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ContractSpecificationsAllRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ScopeSpecificationsAllRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ContractSpecificationsAllRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ScopeSpecificationsAllRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1844,47 +2376,47 @@ func (m *ContractSpecificationsAllRequest) XXX_Marshal(b []byte, deterministic b
 		return b[:n], nil
 	}
 }
-func (m *ContractSpecificationsAllRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ContractSpecificationsAllRequest.Merge(m, src)
+func (m *ScopeSpecificationsAllRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScopeSpecificationsAllRequest.Merge(m, src)
 }
-func (m *ContractSpecificationsAllRequest) XXX_Size() int {
+func (m *ScopeSpecificationsAllRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *ContractSpecificationsAllRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ContractSpecificationsAllRequest.DiscardUnknown(m)
+func (m *ScopeSpecificationsAllRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScopeSpecificationsAllRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ContractSpecificationsAllRequest proto.InternalMessageInfo
+var xxx_messageInfo_ScopeSpecificationsAllRequest proto.InternalMessageInfo
 
-func (m *ContractSpecificationsAllRequest) GetPagination() *query.PageRequest {
+func (m *ScopeSpecificationsAllRequest) GetPagination() *query.PageRequest {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-// ContractSpecificationsAllResponse is the response type for the Query/ContractSpecificationsAll RPC method.
-type ContractSpecificationsAllResponse struct {
-	// contract_specifications are the wrapped contract specifications.
-	ContractSpecifications []*ContractSpecificationWrapper `protobuf:"bytes,1,rep,name=contract_specifications,json=contractSpecifications,proto3" json:"contract_specifications,omitempty" yaml:"contract_specifications"`
+// ScopeSpecificationsAllResponse is the response type for the Query/ScopeSpecificationsAll RPC method.
+type ScopeSpecificationsAllResponse struct {
+	// scope_specifications are the wrapped scope specifications.
+	ScopeSpecifications []*ScopeSpecificationWrapper `protobuf:"bytes,1,rep,name=scope_specifications,json=scopeSpecifications,proto3" json:"scope_specifications,omitempty" yaml:"scope_specifications"`
 	// request is a copy of the request that generated these results.
-	Request *ContractSpecificationsAllRequest `protobuf:"bytes,98,opt,name=request,proto3" json:"request,omitempty"`
+	Request *ScopeSpecificationsAllRequest `protobuf:"bytes,98,opt,name=request,proto3" json:"request,omitempty"`
 	// pagination provides the pagination information of this response.
 	Pagination *query.PageResponse `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *ContractSpecificationsAllResponse) Reset()         { *m = ContractSpecificationsAllResponse{} }
-func (m *ContractSpecificationsAllResponse) String() string { return proto.CompactTextString(m) }
-func (*ContractSpecificationsAllResponse) ProtoMessage()    {}
-func (*ContractSpecificationsAllResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{30}
+func (m *ScopeSpecificationsAllResponse) Reset()         { *m = ScopeSpecificationsAllResponse{} }
+func (m *ScopeSpecificationsAllResponse) String() string { return proto.CompactTextString(m) }
+func (*ScopeSpecificationsAllResponse) ProtoMessage()    {}
+func (*ScopeSpecificationsAllResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{37}
 }
-func (m *ContractSpecificationsAllResponse) XXX_Unmarshal(b []byte) error {
+func (m *ScopeSpecificationsAllResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ContractSpecificationsAllResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ScopeSpecificationsAllResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ContractSpecificationsAllResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ScopeSpecificationsAllResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1894,65 +2426,63 @@ func (m *ContractSpecificationsAllResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *ContractSpecificationsAllResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ContractSpecificationsAllResponse.Merge(m, src)
+func (m *ScopeSpecificationsAllResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScopeSpecificationsAllResponse.Merge(m, src)
 }
-func (m *ContractSpecificationsAllResponse) XXX_Size() int {
+func (m *ScopeSpecificationsAllResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *ContractSpecificationsAllResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ContractSpecificationsAllResponse.DiscardUnknown(m)
+func (m *ScopeSpecificationsAllResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScopeSpecificationsAllResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ContractSpecificationsAllResponse proto.InternalMessageInfo
+var xxx_messageInfo_ScopeSpecificationsAllResponse proto.InternalMessageInfo
 
-func (m *ContractSpecificationsAllResponse) GetContractSpecifications() []*ContractSpecificationWrapper {
+func (m *ScopeSpecificationsAllResponse) GetScopeSpecifications() []*ScopeSpecificationWrapper {
 	if m != nil {
-		return m.ContractSpecifications
+		return m.ScopeSpecifications
 	}
 	return nil
 }
 
-func (m *ContractSpecificationsAllResponse) GetRequest() *ContractSpecificationsAllRequest {
+func (m *ScopeSpecificationsAllResponse) GetRequest() *ScopeSpecificationsAllRequest {
 	if m != nil {
 		return m.Request
 	}
 	return nil
 }
 
-func (m *ContractSpecificationsAllResponse) GetPagination() *query.PageResponse {
+func (m *ScopeSpecificationsAllResponse) GetPagination() *query.PageResponse {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-// RecordSpecificationsForContractSpecificationRequest is the request type for the
-// Query/RecordSpecificationsForContractSpecification RPC method.
-type RecordSpecificationsForContractSpecificationRequest struct {
+// ContractSpecificationRequest is the request type for the Query/ContractSpecification RPC method.
+type ContractSpecificationRequest struct {
 	// specification_id can either be a uuid, e.g. def6bc0a-c9dd-4874-948f-5206e6060a84 or a bech32 contract specification
 	// address, e.g. contractspec1q000d0q2e8w5say53afqdesxp2zqzkr4fn.
 	// It can also be a record specification address, e.g.
 	// recspec1qh00d0q2e8w5say53afqdesxp2zw42dq2jdvmdazuwzcaddhh8gmuqhez44.
 	SpecificationId string `protobuf:"bytes,1,opt,name=specification_id,json=specificationId,proto3" json:"specification_id,omitempty" yaml:"specification_id"`
+	// include_record_specs is a flag for whether or not the record specifications in this contract specification should
+	// be included in the result.
+	IncludeRecordSpecs bool `protobuf:"varint,10,opt,name=include_record_specs,json=includeRecordSpecs,proto3" json:"include_record_specs,omitempty" yaml:"include_record_specs"`
 }
 
-func (m *RecordSpecificationsForContractSpecificationRequest) Reset() {
-	*m = RecordSpecificationsForContractSpecificationRequest{}
-}
-func (m *RecordSpecificationsForContractSpecificationRequest) String() string {
-	return proto.CompactTextString(m)
-}
-func (*RecordSpecificationsForContractSpecificationRequest) ProtoMessage() {}
-func (*RecordSpecificationsForContractSpecificationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{31}
+func (m *ContractSpecificationRequest) Reset()         { *m = ContractSpecificationRequest{} }
+func (m *ContractSpecificationRequest) String() string { return proto.CompactTextString(m) }
+func (*ContractSpecificationRequest) ProtoMessage()    {}
+func (*ContractSpecificationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{38}
 }
-func (m *RecordSpecificationsForContractSpecificationRequest) XXX_Unmarshal(b []byte) error {
+func (m *ContractSpecificationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RecordSpecificationsForContractSpecificationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ContractSpecificationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RecordSpecificationsForContractSpecificationRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ContractSpecificationRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1962,54 +2492,55 @@ func (m *RecordSpecificationsForContractSpecificationRequest) XXX_Marshal(b []by
 		return b[:n], nil
 	}
 }
-func (m *RecordSpecificationsForContractSpecificationRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RecordSpecificationsForContractSpecificationRequest.Merge(m, src)
+func (m *ContractSpecificationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractSpecificationRequest.Merge(m, src)
 }
-func (m *RecordSpecificationsForContractSpecificationRequest) XXX_Size() int {
+func (m *ContractSpecificationRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *RecordSpecificationsForContractSpecificationRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RecordSpecificationsForContractSpecificationRequest.DiscardUnknown(m)
+func (m *ContractSpecificationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractSpecificationRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RecordSpecificationsForContractSpecificationRequest proto.InternalMessageInfo
+var xxx_messageInfo_ContractSpecificationRequest proto.InternalMessageInfo
 
-func (m *RecordSpecificationsForContractSpecificationRequest) GetSpecificationId() string {
+func (m *ContractSpecificationRequest) GetSpecificationId() string {
 	if m != nil {
 		return m.SpecificationId
 	}
 	return ""
 }
 
-// RecordSpecificationsForContractSpecificationResponse is the response type for the
-// Query/RecordSpecificationsForContractSpecification RPC method.
-type RecordSpecificationsForContractSpecificationResponse struct {
-	// record_specifications is any number of wrapped record specifications associated with this contract_specification.
-	RecordSpecifications []*RecordSpecificationWrapper `protobuf:"bytes,1,rep,name=record_specifications,json=recordSpecifications,proto3" json:"record_specifications,omitempty" yaml:"record_specifications"`
-	// contract_specification_uuid is the uuid of this contract specification.
-	ContractSpecificationUuid string `protobuf:"bytes,2,opt,name=contract_specification_uuid,json=contractSpecificationUuid,proto3" json:"contract_specification_uuid,omitempty" yaml:"contract_specification_uuid"`
-	// contract_specification_addr is the contract specification address as a bech32 encoded string.
-	ContractSpecificationAddr string `protobuf:"bytes,3,opt,name=contract_specification_addr,json=contractSpecificationAddr,proto3" json:"contract_specification_addr,omitempty" yaml:"contract_specification_addr"`
-	// request is a copy of the request that generated these results.
-	Request *RecordSpecificationsForContractSpecificationRequest `protobuf:"bytes,98,opt,name=request,proto3" json:"request,omitempty"`
+func (m *ContractSpecificationRequest) GetIncludeRecordSpecs() bool {
+	if m != nil {
+		return m.IncludeRecordSpecs
+	}
+	return false
 }
 
-func (m *RecordSpecificationsForContractSpecificationResponse) Reset() {
-	*m = RecordSpecificationsForContractSpecificationResponse{}
-}
-func (m *RecordSpecificationsForContractSpecificationResponse) String() string {
-	return proto.CompactTextString(m)
+// ContractSpecificationResponse is the response type for the Query/ContractSpecification RPC method.
+type ContractSpecificationResponse struct {
+	// contract_specification is the wrapped contract specification.
+	ContractSpecification *ContractSpecificationWrapper `protobuf:"bytes,1,opt,name=contract_specification,json=contractSpecification,proto3" json:"contract_specification,omitempty" yaml:"contract_specification"`
+	// record_specifications is any number or wrapped record specifications associated with this contract_specification
+	// (if requested).
+	RecordSpecifications []*RecordSpecificationWrapper `protobuf:"bytes,3,rep,name=record_specifications,json=recordSpecifications,proto3" json:"record_specifications,omitempty" yaml:"record_specifications,omitempty"`
+	// request is a copy of the request that generated these results.
+	Request *ContractSpecificationRequest `protobuf:"bytes,98,opt,name=request,proto3" json:"request,omitempty"`
 }
-func (*RecordSpecificationsForContractSpecificationResponse) ProtoMessage() {}
-func (*RecordSpecificationsForContractSpecificationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{32}
+
+func (m *ContractSpecificationResponse) Reset()         { *m = ContractSpecificationResponse{} }
+func (m *ContractSpecificationResponse) String() string { return proto.CompactTextString(m) }
+func (*ContractSpecificationResponse) ProtoMessage()    {}
+func (*ContractSpecificationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{39}
 }
-func (m *RecordSpecificationsForContractSpecificationResponse) XXX_Unmarshal(b []byte) error {
+func (m *ContractSpecificationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RecordSpecificationsForContractSpecificationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ContractSpecificationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RecordSpecificationsForContractSpecificationResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ContractSpecificationResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2019,71 +2550,59 @@ func (m *RecordSpecificationsForContractSpecificationResponse) XXX_Marshal(b []b
 		return b[:n], nil
 	}
 }
-func (m *RecordSpecificationsForContractSpecificationResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RecordSpecificationsForContractSpecificationResponse.Merge(m, src)
+func (m *ContractSpecificationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractSpecificationResponse.Merge(m, src)
 }
-func (m *RecordSpecificationsForContractSpecificationResponse) XXX_Size() int {
+func (m *ContractSpecificationResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *RecordSpecificationsForContractSpecificationResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_RecordSpecificationsForContractSpecificationResponse.DiscardUnknown(m)
+func (m *ContractSpecificationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractSpecificationResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RecordSpecificationsForContractSpecificationResponse proto.InternalMessageInfo
+var xxx_messageInfo_ContractSpecificationResponse proto.InternalMessageInfo
 
-func (m *RecordSpecificationsForContractSpecificationResponse) GetRecordSpecifications() []*RecordSpecificationWrapper {
+func (m *ContractSpecificationResponse) GetContractSpecification() *ContractSpecificationWrapper {
 	if m != nil {
-		return m.RecordSpecifications
+		return m.ContractSpecification
 	}
 	return nil
 }
 
-func (m *RecordSpecificationsForContractSpecificationResponse) GetContractSpecificationUuid() string {
-	if m != nil {
-		return m.ContractSpecificationUuid
-	}
-	return ""
-}
-
-func (m *RecordSpecificationsForContractSpecificationResponse) GetContractSpecificationAddr() string {
+func (m *ContractSpecificationResponse) GetRecordSpecifications() []*RecordSpecificationWrapper {
 	if m != nil {
-		return m.ContractSpecificationAddr
+		return m.RecordSpecifications
 	}
-	return ""
+	return nil
 }
 
-func (m *RecordSpecificationsForContractSpecificationResponse) GetRequest() *RecordSpecificationsForContractSpecificationRequest {
+func (m *ContractSpecificationResponse) GetRequest() *ContractSpecificationRequest {
 	if m != nil {
 		return m.Request
 	}
 	return nil
 }
 
-// RecordSpecificationRequest is the request type for the Query/RecordSpecification RPC method.
-type RecordSpecificationRequest struct {
-	// specification_id can either be a uuid, e.g. def6bc0a-c9dd-4874-948f-5206e6060a84 or a bech32 contract specification
-	// address, e.g. contractspec1q000d0q2e8w5say53afqdesxp2zqzkr4fn.
-	// It can also be a record specification address, e.g.
-	// recspec1qh00d0q2e8w5say53afqdesxp2zw42dq2jdvmdazuwzcaddhh8gmuqhez44.
-	SpecificationId string `protobuf:"bytes,1,opt,name=specification_id,json=specificationId,proto3" json:"specification_id,omitempty" yaml:"specification_id"`
-	// name is the name of the record to look up.
-	// It is required if the specification_id is a uuid or contract specification address.
-	// It is ignored if the specification_id is a record specification address.
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+// ContractSpecificationWrapper contains a single contract specification and some extra identifiers for it.
+type ContractSpecificationWrapper struct {
+	// specification is the on-chain contract specification message.
+	Specification *ContractSpecification `protobuf:"bytes,1,opt,name=specification,proto3" json:"specification,omitempty"`
+	// contract_spec_id_info contains information about the id/address of the contract specification.
+	ContractSpecIdInfo *ContractSpecIdInfo `protobuf:"bytes,2,opt,name=contract_spec_id_info,json=contractSpecIdInfo,proto3" json:"contract_spec_id_info,omitempty" yaml:"contract_spec_id_info"`
 }
 
-func (m *RecordSpecificationRequest) Reset()         { *m = RecordSpecificationRequest{} }
-func (m *RecordSpecificationRequest) String() string { return proto.CompactTextString(m) }
-func (*RecordSpecificationRequest) ProtoMessage()    {}
-func (*RecordSpecificationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{33}
+func (m *ContractSpecificationWrapper) Reset()         { *m = ContractSpecificationWrapper{} }
+func (m *ContractSpecificationWrapper) String() string { return proto.CompactTextString(m) }
+func (*ContractSpecificationWrapper) ProtoMessage()    {}
+func (*ContractSpecificationWrapper) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{40}
 }
-func (m *RecordSpecificationRequest) XXX_Unmarshal(b []byte) error {
+func (m *ContractSpecificationWrapper) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RecordSpecificationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ContractSpecificationWrapper) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RecordSpecificationRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ContractSpecificationWrapper.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2093,52 +2612,50 @@ func (m *RecordSpecificationRequest) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *RecordSpecificationRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RecordSpecificationRequest.Merge(m, src)
+func (m *ContractSpecificationWrapper) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractSpecificationWrapper.Merge(m, src)
 }
-func (m *RecordSpecificationRequest) XXX_Size() int {
+func (m *ContractSpecificationWrapper) XXX_Size() int {
 	return m.Size()
 }
-func (m *RecordSpecificationRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RecordSpecificationRequest.DiscardUnknown(m)
+func (m *ContractSpecificationWrapper) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractSpecificationWrapper.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RecordSpecificationRequest proto.InternalMessageInfo
+var xxx_messageInfo_ContractSpecificationWrapper proto.InternalMessageInfo
 
-func (m *RecordSpecificationRequest) GetSpecificationId() string {
+func (m *ContractSpecificationWrapper) GetSpecification() *ContractSpecification {
 	if m != nil {
-		return m.SpecificationId
+		return m.Specification
 	}
-	return ""
+	return nil
 }
 
-func (m *RecordSpecificationRequest) GetName() string {
+func (m *ContractSpecificationWrapper) GetContractSpecIdInfo() *ContractSpecIdInfo {
 	if m != nil {
-		return m.Name
+		return m.ContractSpecIdInfo
 	}
-	return ""
+	return nil
 }
 
-// RecordSpecificationResponse is the response type for the Query/RecordSpecification RPC method.
-type RecordSpecificationResponse struct {
-	// record_specification is the wrapped record specification.
-	RecordSpecification *RecordSpecificationWrapper `protobuf:"bytes,1,opt,name=record_specification,json=recordSpecification,proto3" json:"record_specification,omitempty" yaml:"record_specification"`
-	// request is a copy of the request that generated these results.
-	Request *RecordSpecificationRequest `protobuf:"bytes,98,opt,name=request,proto3" json:"request,omitempty"`
+// ContractSpecificationsAllRequest is the request type for the Query/ContractSpecificationsAll RPC method.
+type ContractSpecificationsAllRequest struct {
+	// pagination defines optional pagination parameters for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *RecordSpecificationResponse) Reset()         { *m = RecordSpecificationResponse{} }
-func (m *RecordSpecificationResponse) String() string { return proto.CompactTextString(m) }
-func (*RecordSpecificationResponse) ProtoMessage()    {}
-func (*RecordSpecificationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{34}
+func (m *ContractSpecificationsAllRequest) Reset()         { *m = ContractSpecificationsAllRequest{} }
+func (m *ContractSpecificationsAllRequest) String() string { return proto.CompactTextString(m) }
+func (*ContractSpecificationsAllRequest) ProtoMessage()    {}
+func (*ContractSpecificationsAllRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{41}
 }
-func (m *RecordSpecificationResponse) XXX_Unmarshal(b []byte) error {
+func (m *ContractSpecificationsAllRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RecordSpecificationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ContractSpecificationsAllRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RecordSpecificationResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ContractSpecificationsAllRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2148,52 +2665,47 @@ func (m *RecordSpecificationResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *RecordSpecificationResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RecordSpecificationResponse.Merge(m, src)
-}
-func (m *RecordSpecificationResponse) XXX_Size() int {
+func (m *ContractSpecificationsAllRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractSpecificationsAllRequest.Merge(m, src)
+}
+func (m *ContractSpecificationsAllRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *RecordSpecificationResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_RecordSpecificationResponse.DiscardUnknown(m)
+func (m *ContractSpecificationsAllRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractSpecificationsAllRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RecordSpecificationResponse proto.InternalMessageInfo
-
-func (m *RecordSpecificationResponse) GetRecordSpecification() *RecordSpecificationWrapper {
-	if m != nil {
-		return m.RecordSpecification
-	}
-	return nil
-}
+var xxx_messageInfo_ContractSpecificationsAllRequest proto.InternalMessageInfo
 
-func (m *RecordSpecificationResponse) GetRequest() *RecordSpecificationRequest {
+func (m *ContractSpecificationsAllRequest) GetPagination() *query.PageRequest {
 	if m != nil {
-		return m.Request
+		return m.Pagination
 	}
 	return nil
 }
 
-// RecordSpecificationWrapper contains a single record specification and some extra identifiers for it.
-type RecordSpecificationWrapper struct {
-	// specification is the on-chain record specification message.
-	Specification *RecordSpecification `protobuf:"bytes,1,opt,name=specification,proto3" json:"specification,omitempty"`
-	// record_spec_id_info contains information about the id/address of the record specification.
-	RecordSpecIdInfo *RecordSpecIdInfo `protobuf:"bytes,2,opt,name=record_spec_id_info,json=recordSpecIdInfo,proto3" json:"record_spec_id_info,omitempty" yaml:"record_spec_id_info"`
+// ContractSpecificationsAllResponse is the response type for the Query/ContractSpecificationsAll RPC method.
+type ContractSpecificationsAllResponse struct {
+	// contract_specifications are the wrapped contract specifications.
+	ContractSpecifications []*ContractSpecificationWrapper `protobuf:"bytes,1,rep,name=contract_specifications,json=contractSpecifications,proto3" json:"contract_specifications,omitempty" yaml:"contract_specifications"`
+	// request is a copy of the request that generated these results.
+	Request *ContractSpecificationsAllRequest `protobuf:"bytes,98,opt,name=request,proto3" json:"request,omitempty"`
+	// pagination provides the pagination information of this response.
+	Pagination *query.PageResponse `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *RecordSpecificationWrapper) Reset()         { *m = RecordSpecificationWrapper{} }
-func (m *RecordSpecificationWrapper) String() string { return proto.CompactTextString(m) }
-func (*RecordSpecificationWrapper) ProtoMessage()    {}
-func (*RecordSpecificationWrapper) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{35}
+func (m *ContractSpecificationsAllResponse) Reset()         { *m = ContractSpecificationsAllResponse{} }
+func (m *ContractSpecificationsAllResponse) String() string { return proto.CompactTextString(m) }
+func (*ContractSpecificationsAllResponse) ProtoMessage()    {}
+func (*ContractSpecificationsAllResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{42}
 }
-func (m *RecordSpecificationWrapper) XXX_Unmarshal(b []byte) error {
+func (m *ContractSpecificationsAllResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RecordSpecificationWrapper) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ContractSpecificationsAllResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RecordSpecificationWrapper.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ContractSpecificationsAllResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2203,50 +2715,65 @@ func (m *RecordSpecificationWrapper) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *RecordSpecificationWrapper) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RecordSpecificationWrapper.Merge(m, src)
+func (m *ContractSpecificationsAllResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractSpecificationsAllResponse.Merge(m, src)
 }
-func (m *RecordSpecificationWrapper) XXX_Size() int {
+func (m *ContractSpecificationsAllResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *RecordSpecificationWrapper) XXX_DiscardUnknown() {
-	xxx_messageInfo_RecordSpecificationWrapper.DiscardUnknown(m)
+func (m *ContractSpecificationsAllResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractSpecificationsAllResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RecordSpecificationWrapper proto.InternalMessageInfo
+var xxx_messageInfo_ContractSpecificationsAllResponse proto.InternalMessageInfo
 
-func (m *RecordSpecificationWrapper) GetSpecification() *RecordSpecification {
+func (m *ContractSpecificationsAllResponse) GetContractSpecifications() []*ContractSpecificationWrapper {
 	if m != nil {
-		return m.Specification
+		return m.ContractSpecifications
 	}
 	return nil
 }
 
-func (m *RecordSpecificationWrapper) GetRecordSpecIdInfo() *RecordSpecIdInfo {
+func (m *ContractSpecificationsAllResponse) GetRequest() *ContractSpecificationsAllRequest {
 	if m != nil {
-		return m.RecordSpecIdInfo
+		return m.Request
 	}
 	return nil
 }
 
-// RecordSpecificationsAllRequest is the request type for the Query/RecordSpecificationsAll RPC method.
-type RecordSpecificationsAllRequest struct {
-	// pagination defines optional pagination parameters for the request.
-	Pagination *query.PageRequest `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+func (m *ContractSpecificationsAllResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *RecordSpecificationsAllRequest) Reset()         { *m = RecordSpecificationsAllRequest{} }
-func (m *RecordSpecificationsAllRequest) String() string { return proto.CompactTextString(m) }
-func (*RecordSpecificationsAllRequest) ProtoMessage()    {}
-func (*RecordSpecificationsAllRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{36}
+// RecordSpecificationsForContractSpecificationRequest is the request type for the
+// Query/RecordSpecificationsForContractSpecification RPC method.
+type RecordSpecificationsForContractSpecificationRequest struct {
+	// specification_id can either be a uuid, e.g. def6bc0a-c9dd-4874-948f-5206e6060a84 or a bech32 contract specification
+	// address, e.g. contractspec1q000d0q2e8w5say53afqdesxp2zqzkr4fn.
+	// It can also be a record specification address, e.g.
+	// recspec1qh00d0q2e8w5say53afqdesxp2zw42dq2jdvmdazuwzcaddhh8gmuqhez44.
+	SpecificationId string `protobuf:"bytes,1,opt,name=specification_id,json=specificationId,proto3" json:"specification_id,omitempty" yaml:"specification_id"`
 }
-func (m *RecordSpecificationsAllRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *RecordSpecificationsForContractSpecificationRequest) Reset() {
+	*m = RecordSpecificationsForContractSpecificationRequest{}
+}
+func (m *RecordSpecificationsForContractSpecificationRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*RecordSpecificationsForContractSpecificationRequest) ProtoMessage() {}
+func (*RecordSpecificationsForContractSpecificationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{43}
+}
+func (m *RecordSpecificationsForContractSpecificationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RecordSpecificationsAllRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RecordSpecificationsForContractSpecificationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RecordSpecificationsAllRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RecordSpecificationsForContractSpecificationRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2256,47 +2783,54 @@ func (m *RecordSpecificationsAllRequest) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *RecordSpecificationsAllRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RecordSpecificationsAllRequest.Merge(m, src)
+func (m *RecordSpecificationsForContractSpecificationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordSpecificationsForContractSpecificationRequest.Merge(m, src)
 }
-func (m *RecordSpecificationsAllRequest) XXX_Size() int {
+func (m *RecordSpecificationsForContractSpecificationRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *RecordSpecificationsAllRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RecordSpecificationsAllRequest.DiscardUnknown(m)
+func (m *RecordSpecificationsForContractSpecificationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordSpecificationsForContractSpecificationRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RecordSpecificationsAllRequest proto.InternalMessageInfo
+var xxx_messageInfo_RecordSpecificationsForContractSpecificationRequest proto.InternalMessageInfo
 
-func (m *RecordSpecificationsAllRequest) GetPagination() *query.PageRequest {
+func (m *RecordSpecificationsForContractSpecificationRequest) GetSpecificationId() string {
 	if m != nil {
-		return m.Pagination
+		return m.SpecificationId
 	}
-	return nil
+	return ""
 }
 
-// RecordSpecificationsAllResponse is the response type for the Query/RecordSpecificationsAll RPC method.
-type RecordSpecificationsAllResponse struct {
-	// record_specifications are the wrapped record specifications.
+// RecordSpecificationsForContractSpecificationResponse is the response type for the
+// Query/RecordSpecificationsForContractSpecification RPC method.
+type RecordSpecificationsForContractSpecificationResponse struct {
+	// record_specifications is any number of wrapped record specifications associated with this contract_specification.
 	RecordSpecifications []*RecordSpecificationWrapper `protobuf:"bytes,1,rep,name=record_specifications,json=recordSpecifications,proto3" json:"record_specifications,omitempty" yaml:"record_specifications"`
+	// contract_specification_uuid is the uuid of this contract specification.
+	ContractSpecificationUuid string `protobuf:"bytes,2,opt,name=contract_specification_uuid,json=contractSpecificationUuid,proto3" json:"contract_specification_uuid,omitempty" yaml:"contract_specification_uuid"`
+	// contract_specification_addr is the contract specification address as a bech32 encoded string.
+	ContractSpecificationAddr string `protobuf:"bytes,3,opt,name=contract_specification_addr,json=contractSpecificationAddr,proto3" json:"contract_specification_addr,omitempty" yaml:"contract_specification_addr"`
 	// request is a copy of the request that generated these results.
-	Request *RecordSpecificationsAllRequest `protobuf:"bytes,98,opt,name=request,proto3" json:"request,omitempty"`
-	// pagination provides the pagination information of this response.
-	Pagination *query.PageResponse `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Request *RecordSpecificationsForContractSpecificationRequest `protobuf:"bytes,98,opt,name=request,proto3" json:"request,omitempty"`
 }
 
-func (m *RecordSpecificationsAllResponse) Reset()         { *m = RecordSpecificationsAllResponse{} }
-func (m *RecordSpecificationsAllResponse) String() string { return proto.CompactTextString(m) }
-func (*RecordSpecificationsAllResponse) ProtoMessage()    {}
-func (*RecordSpecificationsAllResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{37}
+func (m *RecordSpecificationsForContractSpecificationResponse) Reset() {
+	*m = RecordSpecificationsForContractSpecificationResponse{}
 }
-func (m *RecordSpecificationsAllResponse) XXX_Unmarshal(b []byte) error {
+func (m *RecordSpecificationsForContractSpecificationResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*RecordSpecificationsForContractSpecificationResponse) ProtoMessage() {}
+func (*RecordSpecificationsForContractSpecificationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{44}
+}
+func (m *RecordSpecificationsForContractSpecificationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RecordSpecificationsAllResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RecordSpecificationsForContractSpecificationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RecordSpecificationsAllResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RecordSpecificationsForContractSpecificationResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2306,55 +2840,71 @@ func (m *RecordSpecificationsAllResponse) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *RecordSpecificationsAllResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RecordSpecificationsAllResponse.Merge(m, src)
+func (m *RecordSpecificationsForContractSpecificationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordSpecificationsForContractSpecificationResponse.Merge(m, src)
 }
-func (m *RecordSpecificationsAllResponse) XXX_Size() int {
+func (m *RecordSpecificationsForContractSpecificationResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *RecordSpecificationsAllResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_RecordSpecificationsAllResponse.DiscardUnknown(m)
+func (m *RecordSpecificationsForContractSpecificationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordSpecificationsForContractSpecificationResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RecordSpecificationsAllResponse proto.InternalMessageInfo
+var xxx_messageInfo_RecordSpecificationsForContractSpecificationResponse proto.InternalMessageInfo
 
-func (m *RecordSpecificationsAllResponse) GetRecordSpecifications() []*RecordSpecificationWrapper {
+func (m *RecordSpecificationsForContractSpecificationResponse) GetRecordSpecifications() []*RecordSpecificationWrapper {
 	if m != nil {
 		return m.RecordSpecifications
 	}
 	return nil
 }
 
-func (m *RecordSpecificationsAllResponse) GetRequest() *RecordSpecificationsAllRequest {
+func (m *RecordSpecificationsForContractSpecificationResponse) GetContractSpecificationUuid() string {
 	if m != nil {
-		return m.Request
+		return m.ContractSpecificationUuid
 	}
-	return nil
+	return ""
 }
 
-func (m *RecordSpecificationsAllResponse) GetPagination() *query.PageResponse {
+func (m *RecordSpecificationsForContractSpecificationResponse) GetContractSpecificationAddr() string {
 	if m != nil {
-		return m.Pagination
+		return m.ContractSpecificationAddr
+	}
+	return ""
+}
+
+func (m *RecordSpecificationsForContractSpecificationResponse) GetRequest() *RecordSpecificationsForContractSpecificationRequest {
+	if m != nil {
+		return m.Request
 	}
 	return nil
 }
 
-// OSLocatorParamsRequest is the request type for the Query/OSLocatorParams RPC method.
-type OSLocatorParamsRequest struct {
+// RecordSpecificationRequest is the request type for the Query/RecordSpecification RPC method.
+type RecordSpecificationRequest struct {
+	// specification_id can either be a uuid, e.g. def6bc0a-c9dd-4874-948f-5206e6060a84 or a bech32 contract specification
+	// address, e.g. contractspec1q000d0q2e8w5say53afqdesxp2zqzkr4fn.
+	// It can also be a record specification address, e.g.
+	// recspec1qh00d0q2e8w5say53afqdesxp2zw42dq2jdvmdazuwzcaddhh8gmuqhez44.
+	SpecificationId string `protobuf:"bytes,1,opt,name=specification_id,json=specificationId,proto3" json:"specification_id,omitempty" yaml:"specification_id"`
+	// name is the name of the record to look up.
+	// It is required if the specification_id is a uuid or contract specification address.
+	// It is ignored if the specification_id is a record specification address.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (m *OSLocatorParamsRequest) Reset()         { *m = OSLocatorParamsRequest{} }
-func (m *OSLocatorParamsRequest) String() string { return proto.CompactTextString(m) }
-func (*OSLocatorParamsRequest) ProtoMessage()    {}
-func (*OSLocatorParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{38}
+func (m *RecordSpecificationRequest) Reset()         { *m = RecordSpecificationRequest{} }
+func (m *RecordSpecificationRequest) String() string { return proto.CompactTextString(m) }
+func (*RecordSpecificationRequest) ProtoMessage()    {}
+func (*RecordSpecificationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{45}
 }
-func (m *OSLocatorParamsRequest) XXX_Unmarshal(b []byte) error {
+func (m *RecordSpecificationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *OSLocatorParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RecordSpecificationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_OSLocatorParamsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RecordSpecificationRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2364,90 +2914,52 @@ func (m *OSLocatorParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *OSLocatorParamsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_OSLocatorParamsRequest.Merge(m, src)
+func (m *RecordSpecificationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordSpecificationRequest.Merge(m, src)
 }
-func (m *OSLocatorParamsRequest) XXX_Size() int {
+func (m *RecordSpecificationRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *OSLocatorParamsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_OSLocatorParamsRequest.DiscardUnknown(m)
+func (m *RecordSpecificationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordSpecificationRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_OSLocatorParamsRequest proto.InternalMessageInfo
+var xxx_messageInfo_RecordSpecificationRequest proto.InternalMessageInfo
 
-// OSLocatorParamsResponse is the response type for the Query/OSLocatorParams RPC method.
-type OSLocatorParamsResponse struct {
-	// params defines the parameters of the module.
-	Params OSLocatorParams `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	// request is a copy of the request that generated these results.
-	Request *OSLocatorParamsRequest `protobuf:"bytes,98,opt,name=request,proto3" json:"request,omitempty"`
+func (m *RecordSpecificationRequest) GetSpecificationId() string {
+	if m != nil {
+		return m.SpecificationId
+	}
+	return ""
 }
 
-func (m *OSLocatorParamsResponse) Reset()         { *m = OSLocatorParamsResponse{} }
-func (m *OSLocatorParamsResponse) String() string { return proto.CompactTextString(m) }
-func (*OSLocatorParamsResponse) ProtoMessage()    {}
-func (*OSLocatorParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{39}
-}
-func (m *OSLocatorParamsResponse) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *OSLocatorParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_OSLocatorParamsResponse.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
-	}
-}
-func (m *OSLocatorParamsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_OSLocatorParamsResponse.Merge(m, src)
-}
-func (m *OSLocatorParamsResponse) XXX_Size() int {
-	return m.Size()
-}
-func (m *OSLocatorParamsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_OSLocatorParamsResponse.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_OSLocatorParamsResponse proto.InternalMessageInfo
-
-func (m *OSLocatorParamsResponse) GetParams() OSLocatorParams {
-	if m != nil {
-		return m.Params
-	}
-	return OSLocatorParams{}
-}
-
-func (m *OSLocatorParamsResponse) GetRequest() *OSLocatorParamsRequest {
+func (m *RecordSpecificationRequest) GetName() string {
 	if m != nil {
-		return m.Request
+		return m.Name
 	}
-	return nil
+	return ""
 }
 
-// OSLocatorRequest is the request type for the Query/OSLocator RPC method.
-type OSLocatorRequest struct {
-	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+// RecordSpecificationResponse is the response type for the Query/RecordSpecification RPC method.
+type RecordSpecificationResponse struct {
+	// record_specification is the wrapped record specification.
+	RecordSpecification *RecordSpecificationWrapper `protobuf:"bytes,1,opt,name=record_specification,json=recordSpecification,proto3" json:"record_specification,omitempty" yaml:"record_specification"`
+	// request is a copy of the request that generated these results.
+	Request *RecordSpecificationRequest `protobuf:"bytes,98,opt,name=request,proto3" json:"request,omitempty"`
 }
 
-func (m *OSLocatorRequest) Reset()         { *m = OSLocatorRequest{} }
-func (m *OSLocatorRequest) String() string { return proto.CompactTextString(m) }
-func (*OSLocatorRequest) ProtoMessage()    {}
-func (*OSLocatorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{40}
+func (m *RecordSpecificationResponse) Reset()         { *m = RecordSpecificationResponse{} }
+func (m *RecordSpecificationResponse) String() string { return proto.CompactTextString(m) }
+func (*RecordSpecificationResponse) ProtoMessage()    {}
+func (*RecordSpecificationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{46}
 }
-func (m *OSLocatorRequest) XXX_Unmarshal(b []byte) error {
+func (m *RecordSpecificationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *OSLocatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RecordSpecificationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_OSLocatorRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RecordSpecificationResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2457,44 +2969,52 @@ func (m *OSLocatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *OSLocatorRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_OSLocatorRequest.Merge(m, src)
+func (m *RecordSpecificationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordSpecificationResponse.Merge(m, src)
 }
-func (m *OSLocatorRequest) XXX_Size() int {
+func (m *RecordSpecificationResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *OSLocatorRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_OSLocatorRequest.DiscardUnknown(m)
+func (m *RecordSpecificationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordSpecificationResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_OSLocatorRequest proto.InternalMessageInfo
+var xxx_messageInfo_RecordSpecificationResponse proto.InternalMessageInfo
 
-func (m *OSLocatorRequest) GetOwner() string {
+func (m *RecordSpecificationResponse) GetRecordSpecification() *RecordSpecificationWrapper {
 	if m != nil {
-		return m.Owner
+		return m.RecordSpecification
 	}
-	return ""
+	return nil
 }
 
-// OSLocatorResponse is the response type for the Query/OSLocator RPC method.
-type OSLocatorResponse struct {
-	Locator *ObjectStoreLocator `protobuf:"bytes,1,opt,name=locator,proto3" json:"locator,omitempty"`
-	// request is a copy of the request that generated these results.
-	Request *OSLocatorRequest `protobuf:"bytes,98,opt,name=request,proto3" json:"request,omitempty"`
+func (m *RecordSpecificationResponse) GetRequest() *RecordSpecificationRequest {
+	if m != nil {
+		return m.Request
+	}
+	return nil
 }
 
-func (m *OSLocatorResponse) Reset()         { *m = OSLocatorResponse{} }
-func (m *OSLocatorResponse) String() string { return proto.CompactTextString(m) }
-func (*OSLocatorResponse) ProtoMessage()    {}
-func (*OSLocatorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{41}
+// RecordSpecificationWrapper contains a single record specification and some extra identifiers for it.
+type RecordSpecificationWrapper struct {
+	// specification is the on-chain record specification message.
+	Specification *RecordSpecification `protobuf:"bytes,1,opt,name=specification,proto3" json:"specification,omitempty"`
+	// record_spec_id_info contains information about the id/address of the record specification.
+	RecordSpecIdInfo *RecordSpecIdInfo `protobuf:"bytes,2,opt,name=record_spec_id_info,json=recordSpecIdInfo,proto3" json:"record_spec_id_info,omitempty" yaml:"record_spec_id_info"`
 }
-func (m *OSLocatorResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *RecordSpecificationWrapper) Reset()         { *m = RecordSpecificationWrapper{} }
+func (m *RecordSpecificationWrapper) String() string { return proto.CompactTextString(m) }
+func (*RecordSpecificationWrapper) ProtoMessage()    {}
+func (*RecordSpecificationWrapper) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{47}
+}
+func (m *RecordSpecificationWrapper) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *OSLocatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RecordSpecificationWrapper) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_OSLocatorResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RecordSpecificationWrapper.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2504,51 +3024,50 @@ func (m *OSLocatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *OSLocatorResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_OSLocatorResponse.Merge(m, src)
+func (m *RecordSpecificationWrapper) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordSpecificationWrapper.Merge(m, src)
 }
-func (m *OSLocatorResponse) XXX_Size() int {
+func (m *RecordSpecificationWrapper) XXX_Size() int {
 	return m.Size()
 }
-func (m *OSLocatorResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_OSLocatorResponse.DiscardUnknown(m)
+func (m *RecordSpecificationWrapper) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordSpecificationWrapper.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_OSLocatorResponse proto.InternalMessageInfo
+var xxx_messageInfo_RecordSpecificationWrapper proto.InternalMessageInfo
 
-func (m *OSLocatorResponse) GetLocator() *ObjectStoreLocator {
+func (m *RecordSpecificationWrapper) GetSpecification() *RecordSpecification {
 	if m != nil {
-		return m.Locator
+		return m.Specification
 	}
 	return nil
 }
 
-func (m *OSLocatorResponse) GetRequest() *OSLocatorRequest {
+func (m *RecordSpecificationWrapper) GetRecordSpecIdInfo() *RecordSpecIdInfo {
 	if m != nil {
-		return m.Request
+		return m.RecordSpecIdInfo
 	}
 	return nil
 }
 
-// OSLocatorsByURIRequest is the request type for the Query/OSLocatorsByURI RPC method.
-type OSLocatorsByURIRequest struct {
-	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+// RecordSpecificationsAllRequest is the request type for the Query/RecordSpecificationsAll RPC method.
+type RecordSpecificationsAllRequest struct {
 	// pagination defines optional pagination parameters for the request.
 	Pagination *query.PageRequest `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *OSLocatorsByURIRequest) Reset()         { *m = OSLocatorsByURIRequest{} }
-func (m *OSLocatorsByURIRequest) String() string { return proto.CompactTextString(m) }
-func (*OSLocatorsByURIRequest) ProtoMessage()    {}
-func (*OSLocatorsByURIRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{42}
+func (m *RecordSpecificationsAllRequest) Reset()         { *m = RecordSpecificationsAllRequest{} }
+func (m *RecordSpecificationsAllRequest) String() string { return proto.CompactTextString(m) }
+func (*RecordSpecificationsAllRequest) ProtoMessage()    {}
+func (*RecordSpecificationsAllRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{48}
 }
-func (m *OSLocatorsByURIRequest) XXX_Unmarshal(b []byte) error {
+func (m *RecordSpecificationsAllRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *OSLocatorsByURIRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RecordSpecificationsAllRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_OSLocatorsByURIRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RecordSpecificationsAllRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2558,53 +3077,47 @@ func (m *OSLocatorsByURIRequest) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *OSLocatorsByURIRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_OSLocatorsByURIRequest.Merge(m, src)
+func (m *RecordSpecificationsAllRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordSpecificationsAllRequest.Merge(m, src)
 }
-func (m *OSLocatorsByURIRequest) XXX_Size() int {
+func (m *RecordSpecificationsAllRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *OSLocatorsByURIRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_OSLocatorsByURIRequest.DiscardUnknown(m)
+func (m *RecordSpecificationsAllRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordSpecificationsAllRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_OSLocatorsByURIRequest proto.InternalMessageInfo
-
-func (m *OSLocatorsByURIRequest) GetUri() string {
-	if m != nil {
-		return m.Uri
-	}
-	return ""
-}
+var xxx_messageInfo_RecordSpecificationsAllRequest proto.InternalMessageInfo
 
-func (m *OSLocatorsByURIRequest) GetPagination() *query.PageRequest {
+func (m *RecordSpecificationsAllRequest) GetPagination() *query.PageRequest {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-// OSLocatorsByURIResponse is the response type for the Query/OSLocatorsByURI RPC method.
-type OSLocatorsByURIResponse struct {
-	Locators []ObjectStoreLocator `protobuf:"bytes,1,rep,name=locators,proto3" json:"locators"`
+// RecordSpecificationsAllResponse is the response type for the Query/RecordSpecificationsAll RPC method.
+type RecordSpecificationsAllResponse struct {
+	// record_specifications are the wrapped record specifications.
+	RecordSpecifications []*RecordSpecificationWrapper `protobuf:"bytes,1,rep,name=record_specifications,json=recordSpecifications,proto3" json:"record_specifications,omitempty" yaml:"record_specifications"`
 	// request is a copy of the request that generated these results.
-	Request *OSLocatorsByURIRequest `protobuf:"bytes,98,opt,name=request,proto3" json:"request,omitempty"`
+	Request *RecordSpecificationsAllRequest `protobuf:"bytes,98,opt,name=request,proto3" json:"request,omitempty"`
 	// pagination provides the pagination information of this response.
 	Pagination *query.PageResponse `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *OSLocatorsByURIResponse) Reset()         { *m = OSLocatorsByURIResponse{} }
-func (m *OSLocatorsByURIResponse) String() string { return proto.CompactTextString(m) }
-func (*OSLocatorsByURIResponse) ProtoMessage()    {}
-func (*OSLocatorsByURIResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{43}
+func (m *RecordSpecificationsAllResponse) Reset()         { *m = RecordSpecificationsAllResponse{} }
+func (m *RecordSpecificationsAllResponse) String() string { return proto.CompactTextString(m) }
+func (*RecordSpecificationsAllResponse) ProtoMessage()    {}
+func (*RecordSpecificationsAllResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{49}
 }
-func (m *OSLocatorsByURIResponse) XXX_Unmarshal(b []byte) error {
+func (m *RecordSpecificationsAllResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *OSLocatorsByURIResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RecordSpecificationsAllResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_OSLocatorsByURIResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RecordSpecificationsAllResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2614,56 +3127,55 @@ func (m *OSLocatorsByURIResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *OSLocatorsByURIResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_OSLocatorsByURIResponse.Merge(m, src)
+func (m *RecordSpecificationsAllResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordSpecificationsAllResponse.Merge(m, src)
 }
-func (m *OSLocatorsByURIResponse) XXX_Size() int {
+func (m *RecordSpecificationsAllResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *OSLocatorsByURIResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_OSLocatorsByURIResponse.DiscardUnknown(m)
+func (m *RecordSpecificationsAllResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordSpecificationsAllResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_OSLocatorsByURIResponse proto.InternalMessageInfo
+var xxx_messageInfo_RecordSpecificationsAllResponse proto.InternalMessageInfo
 
-func (m *OSLocatorsByURIResponse) GetLocators() []ObjectStoreLocator {
+func (m *RecordSpecificationsAllResponse) GetRecordSpecifications() []*RecordSpecificationWrapper {
 	if m != nil {
-		return m.Locators
+		return m.RecordSpecifications
 	}
 	return nil
 }
 
-func (m *OSLocatorsByURIResponse) GetRequest() *OSLocatorsByURIRequest {
+func (m *RecordSpecificationsAllResponse) GetRequest() *RecordSpecificationsAllRequest {
 	if m != nil {
 		return m.Request
 	}
 	return nil
 }
 
-func (m *OSLocatorsByURIResponse) GetPagination() *query.PageResponse {
+func (m *RecordSpecificationsAllResponse) GetPagination() *query.PageResponse {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-// OSLocatorsByScopeRequest is the request type for the Query/OSLocatorsByScope RPC method.
-type OSLocatorsByScopeRequest struct {
-	ScopeId string `protobuf:"bytes,1,opt,name=scope_id,json=scopeId,proto3" json:"scope_id,omitempty" yaml:"scope_id"`
+// OSLocatorParamsRequest is the request type for the Query/OSLocatorParams RPC method.
+type OSLocatorParamsRequest struct {
 }
 
-func (m *OSLocatorsByScopeRequest) Reset()         { *m = OSLocatorsByScopeRequest{} }
-func (m *OSLocatorsByScopeRequest) String() string { return proto.CompactTextString(m) }
-func (*OSLocatorsByScopeRequest) ProtoMessage()    {}
-func (*OSLocatorsByScopeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{44}
+func (m *OSLocatorParamsRequest) Reset()         { *m = OSLocatorParamsRequest{} }
+func (m *OSLocatorParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*OSLocatorParamsRequest) ProtoMessage()    {}
+func (*OSLocatorParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{50}
 }
-func (m *OSLocatorsByScopeRequest) XXX_Unmarshal(b []byte) error {
+func (m *OSLocatorParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *OSLocatorsByScopeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *OSLocatorParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_OSLocatorsByScopeRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_OSLocatorParamsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2673,44 +3185,149 @@ func (m *OSLocatorsByScopeRequest) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *OSLocatorsByScopeRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_OSLocatorsByScopeRequest.Merge(m, src)
+func (m *OSLocatorParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OSLocatorParamsRequest.Merge(m, src)
 }
-func (m *OSLocatorsByScopeRequest) XXX_Size() int {
+func (m *OSLocatorParamsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *OSLocatorsByScopeRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_OSLocatorsByScopeRequest.DiscardUnknown(m)
+func (m *OSLocatorParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OSLocatorParamsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_OSLocatorsByScopeRequest proto.InternalMessageInfo
+var xxx_messageInfo_OSLocatorParamsRequest proto.InternalMessageInfo
 
-func (m *OSLocatorsByScopeRequest) GetScopeId() string {
-	if m != nil {
-		return m.ScopeId
-	}
-	return ""
+// OSLocatorParamsResponse is the response type for the Query/OSLocatorParams RPC method.
+type OSLocatorParamsResponse struct {
+	// params defines the parameters of the module.
+	Params OSLocatorParams `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// request is a copy of the request that generated these results.
+	Request *OSLocatorParamsRequest `protobuf:"bytes,98,opt,name=request,proto3" json:"request,omitempty"`
 }
 
-// OSLocatorsByScopeResponse is the response type for the Query/OSLocatorsByScope RPC method.
-type OSLocatorsByScopeResponse struct {
-	Locators []ObjectStoreLocator `protobuf:"bytes,1,rep,name=locators,proto3" json:"locators"`
+func (m *OSLocatorParamsResponse) Reset()         { *m = OSLocatorParamsResponse{} }
+func (m *OSLocatorParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*OSLocatorParamsResponse) ProtoMessage()    {}
+func (*OSLocatorParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{51}
+}
+func (m *OSLocatorParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OSLocatorParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OSLocatorParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OSLocatorParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OSLocatorParamsResponse.Merge(m, src)
+}
+func (m *OSLocatorParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *OSLocatorParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_OSLocatorParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OSLocatorParamsResponse proto.InternalMessageInfo
+
+func (m *OSLocatorParamsResponse) GetParams() OSLocatorParams {
+	if m != nil {
+		return m.Params
+	}
+	return OSLocatorParams{}
+}
+
+func (m *OSLocatorParamsResponse) GetRequest() *OSLocatorParamsRequest {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+// OSLocatorRequest is the request type for the Query/OSLocator RPC method.
+type OSLocatorRequest struct {
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// name is the optional name of a single locator to get. If empty, all of the owner's locators are returned.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *OSLocatorRequest) Reset()         { *m = OSLocatorRequest{} }
+func (m *OSLocatorRequest) String() string { return proto.CompactTextString(m) }
+func (*OSLocatorRequest) ProtoMessage()    {}
+func (*OSLocatorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{52}
+}
+func (m *OSLocatorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OSLocatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OSLocatorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OSLocatorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OSLocatorRequest.Merge(m, src)
+}
+func (m *OSLocatorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *OSLocatorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OSLocatorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OSLocatorRequest proto.InternalMessageInfo
+
+func (m *OSLocatorRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *OSLocatorRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+// OSLocatorResponse is the response type for the Query/OSLocator RPC method.
+type OSLocatorResponse struct {
+	// locator is the requested locator, or the owner's preferred locator if no name was requested.
+	Locator *ObjectStoreLocator `protobuf:"bytes,1,opt,name=locator,proto3" json:"locator,omitempty"`
+	// locators are all of the owner's locators ordered by priority, or just the requested one if a name was provided.
+	Locators []ObjectStoreLocator `protobuf:"bytes,2,rep,name=locators,proto3" json:"locators"`
 	// request is a copy of the request that generated these results.
-	Request *OSLocatorsByScopeRequest `protobuf:"bytes,98,opt,name=request,proto3" json:"request,omitempty"`
+	Request *OSLocatorRequest `protobuf:"bytes,98,opt,name=request,proto3" json:"request,omitempty"`
 }
 
-func (m *OSLocatorsByScopeResponse) Reset()         { *m = OSLocatorsByScopeResponse{} }
-func (m *OSLocatorsByScopeResponse) String() string { return proto.CompactTextString(m) }
-func (*OSLocatorsByScopeResponse) ProtoMessage()    {}
-func (*OSLocatorsByScopeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{45}
+func (m *OSLocatorResponse) Reset()         { *m = OSLocatorResponse{} }
+func (m *OSLocatorResponse) String() string { return proto.CompactTextString(m) }
+func (*OSLocatorResponse) ProtoMessage()    {}
+func (*OSLocatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{53}
 }
-func (m *OSLocatorsByScopeResponse) XXX_Unmarshal(b []byte) error {
+func (m *OSLocatorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *OSLocatorsByScopeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *OSLocatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_OSLocatorsByScopeResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_OSLocatorResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2720,50 +3337,58 @@ func (m *OSLocatorsByScopeResponse) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *OSLocatorsByScopeResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_OSLocatorsByScopeResponse.Merge(m, src)
+func (m *OSLocatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OSLocatorResponse.Merge(m, src)
 }
-func (m *OSLocatorsByScopeResponse) XXX_Size() int {
+func (m *OSLocatorResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *OSLocatorsByScopeResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_OSLocatorsByScopeResponse.DiscardUnknown(m)
+func (m *OSLocatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_OSLocatorResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_OSLocatorsByScopeResponse proto.InternalMessageInfo
+var xxx_messageInfo_OSLocatorResponse proto.InternalMessageInfo
 
-func (m *OSLocatorsByScopeResponse) GetLocators() []ObjectStoreLocator {
+func (m *OSLocatorResponse) GetLocator() *ObjectStoreLocator {
+	if m != nil {
+		return m.Locator
+	}
+	return nil
+}
+
+func (m *OSLocatorResponse) GetLocators() []ObjectStoreLocator {
 	if m != nil {
 		return m.Locators
 	}
 	return nil
 }
 
-func (m *OSLocatorsByScopeResponse) GetRequest() *OSLocatorsByScopeRequest {
+func (m *OSLocatorResponse) GetRequest() *OSLocatorRequest {
 	if m != nil {
 		return m.Request
 	}
 	return nil
 }
 
-// OSAllLocatorsRequest is the request type for the Query/OSAllLocators RPC method.
-type OSAllLocatorsRequest struct {
+// OSLocatorsByURIRequest is the request type for the Query/OSLocatorsByURI RPC method.
+type OSLocatorsByURIRequest struct {
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
 	// pagination defines optional pagination parameters for the request.
 	Pagination *query.PageRequest `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *OSAllLocatorsRequest) Reset()         { *m = OSAllLocatorsRequest{} }
-func (m *OSAllLocatorsRequest) String() string { return proto.CompactTextString(m) }
-func (*OSAllLocatorsRequest) ProtoMessage()    {}
-func (*OSAllLocatorsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{46}
+func (m *OSLocatorsByURIRequest) Reset()         { *m = OSLocatorsByURIRequest{} }
+func (m *OSLocatorsByURIRequest) String() string { return proto.CompactTextString(m) }
+func (*OSLocatorsByURIRequest) ProtoMessage()    {}
+func (*OSLocatorsByURIRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{54}
 }
-func (m *OSAllLocatorsRequest) XXX_Unmarshal(b []byte) error {
+func (m *OSLocatorsByURIRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *OSAllLocatorsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *OSLocatorsByURIRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_OSAllLocatorsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_OSLocatorsByURIRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2773,46 +3398,53 @@ func (m *OSAllLocatorsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *OSAllLocatorsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_OSAllLocatorsRequest.Merge(m, src)
+func (m *OSLocatorsByURIRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OSLocatorsByURIRequest.Merge(m, src)
 }
-func (m *OSAllLocatorsRequest) XXX_Size() int {
+func (m *OSLocatorsByURIRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *OSAllLocatorsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_OSAllLocatorsRequest.DiscardUnknown(m)
+func (m *OSLocatorsByURIRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OSLocatorsByURIRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_OSAllLocatorsRequest proto.InternalMessageInfo
+var xxx_messageInfo_OSLocatorsByURIRequest proto.InternalMessageInfo
 
-func (m *OSAllLocatorsRequest) GetPagination() *query.PageRequest {
+func (m *OSLocatorsByURIRequest) GetUri() string {
+	if m != nil {
+		return m.Uri
+	}
+	return ""
+}
+
+func (m *OSLocatorsByURIRequest) GetPagination() *query.PageRequest {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-// OSAllLocatorsResponse is the response type for the Query/OSAllLocators RPC method.
-type OSAllLocatorsResponse struct {
+// OSLocatorsByURIResponse is the response type for the Query/OSLocatorsByURI RPC method.
+type OSLocatorsByURIResponse struct {
 	Locators []ObjectStoreLocator `protobuf:"bytes,1,rep,name=locators,proto3" json:"locators"`
 	// request is a copy of the request that generated these results.
-	Request *OSAllLocatorsRequest `protobuf:"bytes,98,opt,name=request,proto3" json:"request,omitempty"`
+	Request *OSLocatorsByURIRequest `protobuf:"bytes,98,opt,name=request,proto3" json:"request,omitempty"`
 	// pagination provides the pagination information of this response.
 	Pagination *query.PageResponse `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *OSAllLocatorsResponse) Reset()         { *m = OSAllLocatorsResponse{} }
-func (m *OSAllLocatorsResponse) String() string { return proto.CompactTextString(m) }
-func (*OSAllLocatorsResponse) ProtoMessage()    {}
-func (*OSAllLocatorsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{47}
+func (m *OSLocatorsByURIResponse) Reset()         { *m = OSLocatorsByURIResponse{} }
+func (m *OSLocatorsByURIResponse) String() string { return proto.CompactTextString(m) }
+func (*OSLocatorsByURIResponse) ProtoMessage()    {}
+func (*OSLocatorsByURIResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{55}
 }
-func (m *OSAllLocatorsResponse) XXX_Unmarshal(b []byte) error {
+func (m *OSLocatorsByURIResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *OSAllLocatorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *OSLocatorsByURIResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_OSAllLocatorsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_OSLocatorsByURIResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2822,67 +3454,287 @@ func (m *OSAllLocatorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *OSAllLocatorsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_OSAllLocatorsResponse.Merge(m, src)
+func (m *OSLocatorsByURIResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OSLocatorsByURIResponse.Merge(m, src)
 }
-func (m *OSAllLocatorsResponse) XXX_Size() int {
+func (m *OSLocatorsByURIResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *OSAllLocatorsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_OSAllLocatorsResponse.DiscardUnknown(m)
+func (m *OSLocatorsByURIResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_OSLocatorsByURIResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_OSAllLocatorsResponse proto.InternalMessageInfo
+var xxx_messageInfo_OSLocatorsByURIResponse proto.InternalMessageInfo
 
-func (m *OSAllLocatorsResponse) GetLocators() []ObjectStoreLocator {
+func (m *OSLocatorsByURIResponse) GetLocators() []ObjectStoreLocator {
 	if m != nil {
 		return m.Locators
 	}
 	return nil
 }
 
-func (m *OSAllLocatorsResponse) GetRequest() *OSAllLocatorsRequest {
+func (m *OSLocatorsByURIResponse) GetRequest() *OSLocatorsByURIRequest {
 	if m != nil {
 		return m.Request
 	}
 	return nil
 }
 
-func (m *OSAllLocatorsResponse) GetPagination() *query.PageResponse {
+func (m *OSLocatorsByURIResponse) GetPagination() *query.PageResponse {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "provenance.metadata.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "provenance.metadata.v1.QueryParamsResponse")
-	proto.RegisterType((*ScopeRequest)(nil), "provenance.metadata.v1.ScopeRequest")
-	proto.RegisterType((*ScopeResponse)(nil), "provenance.metadata.v1.ScopeResponse")
-	proto.RegisterType((*ScopeWrapper)(nil), "provenance.metadata.v1.ScopeWrapper")
-	proto.RegisterType((*ScopesAllRequest)(nil), "provenance.metadata.v1.ScopesAllRequest")
-	proto.RegisterType((*ScopesAllResponse)(nil), "provenance.metadata.v1.ScopesAllResponse")
-	proto.RegisterType((*SessionsRequest)(nil), "provenance.metadata.v1.SessionsRequest")
-	proto.RegisterType((*SessionsResponse)(nil), "provenance.metadata.v1.SessionsResponse")
-	proto.RegisterType((*SessionWrapper)(nil), "provenance.metadata.v1.SessionWrapper")
-	proto.RegisterType((*SessionsAllRequest)(nil), "provenance.metadata.v1.SessionsAllRequest")
-	proto.RegisterType((*SessionsAllResponse)(nil), "provenance.metadata.v1.SessionsAllResponse")
-	proto.RegisterType((*RecordsRequest)(nil), "provenance.metadata.v1.RecordsRequest")
-	proto.RegisterType((*RecordsResponse)(nil), "provenance.metadata.v1.RecordsResponse")
-	proto.RegisterType((*RecordWrapper)(nil), "provenance.metadata.v1.RecordWrapper")
-	proto.RegisterType((*RecordsAllRequest)(nil), "provenance.metadata.v1.RecordsAllRequest")
-	proto.RegisterType((*RecordsAllResponse)(nil), "provenance.metadata.v1.RecordsAllResponse")
-	proto.RegisterType((*OwnershipRequest)(nil), "provenance.metadata.v1.OwnershipRequest")
-	proto.RegisterType((*OwnershipResponse)(nil), "provenance.metadata.v1.OwnershipResponse")
-	proto.RegisterType((*ValueOwnershipRequest)(nil), "provenance.metadata.v1.ValueOwnershipRequest")
-	proto.RegisterType((*ValueOwnershipResponse)(nil), "provenance.metadata.v1.ValueOwnershipResponse")
-	proto.RegisterType((*ScopeSpecificationRequest)(nil), "provenance.metadata.v1.ScopeSpecificationRequest")
-	proto.RegisterType((*ScopeSpecificationResponse)(nil), "provenance.metadata.v1.ScopeSpecificationResponse")
-	proto.RegisterType((*ScopeSpecificationWrapper)(nil), "provenance.metadata.v1.ScopeSpecificationWrapper")
-	proto.RegisterType((*ScopeSpecificationsAllRequest)(nil), "provenance.metadata.v1.ScopeSpecificationsAllRequest")
-	proto.RegisterType((*ScopeSpecificationsAllResponse)(nil), "provenance.metadata.v1.ScopeSpecificationsAllResponse")
-	proto.RegisterType((*ContractSpecificationRequest)(nil), "provenance.metadata.v1.ContractSpecificationRequest")
+// OSLocatorsByScopeRequest is the request type for the Query/OSLocatorsByScope RPC method.
+type OSLocatorsByScopeRequest struct {
+	ScopeId string `protobuf:"bytes,1,opt,name=scope_id,json=scopeId,proto3" json:"scope_id,omitempty" yaml:"scope_id"`
+}
+
+func (m *OSLocatorsByScopeRequest) Reset()         { *m = OSLocatorsByScopeRequest{} }
+func (m *OSLocatorsByScopeRequest) String() string { return proto.CompactTextString(m) }
+func (*OSLocatorsByScopeRequest) ProtoMessage()    {}
+func (*OSLocatorsByScopeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{56}
+}
+func (m *OSLocatorsByScopeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OSLocatorsByScopeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OSLocatorsByScopeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OSLocatorsByScopeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OSLocatorsByScopeRequest.Merge(m, src)
+}
+func (m *OSLocatorsByScopeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *OSLocatorsByScopeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OSLocatorsByScopeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OSLocatorsByScopeRequest proto.InternalMessageInfo
+
+func (m *OSLocatorsByScopeRequest) GetScopeId() string {
+	if m != nil {
+		return m.ScopeId
+	}
+	return ""
+}
+
+// OSLocatorsByScopeResponse is the response type for the Query/OSLocatorsByScope RPC method.
+type OSLocatorsByScopeResponse struct {
+	Locators []ObjectStoreLocator `protobuf:"bytes,1,rep,name=locators,proto3" json:"locators"`
+	// request is a copy of the request that generated these results.
+	Request *OSLocatorsByScopeRequest `protobuf:"bytes,98,opt,name=request,proto3" json:"request,omitempty"`
+}
+
+func (m *OSLocatorsByScopeResponse) Reset()         { *m = OSLocatorsByScopeResponse{} }
+func (m *OSLocatorsByScopeResponse) String() string { return proto.CompactTextString(m) }
+func (*OSLocatorsByScopeResponse) ProtoMessage()    {}
+func (*OSLocatorsByScopeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{57}
+}
+func (m *OSLocatorsByScopeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OSLocatorsByScopeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OSLocatorsByScopeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OSLocatorsByScopeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OSLocatorsByScopeResponse.Merge(m, src)
+}
+func (m *OSLocatorsByScopeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *OSLocatorsByScopeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_OSLocatorsByScopeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OSLocatorsByScopeResponse proto.InternalMessageInfo
+
+func (m *OSLocatorsByScopeResponse) GetLocators() []ObjectStoreLocator {
+	if m != nil {
+		return m.Locators
+	}
+	return nil
+}
+
+func (m *OSLocatorsByScopeResponse) GetRequest() *OSLocatorsByScopeRequest {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+// OSAllLocatorsRequest is the request type for the Query/OSAllLocators RPC method.
+type OSAllLocatorsRequest struct {
+	// pagination defines optional pagination parameters for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *OSAllLocatorsRequest) Reset()         { *m = OSAllLocatorsRequest{} }
+func (m *OSAllLocatorsRequest) String() string { return proto.CompactTextString(m) }
+func (*OSAllLocatorsRequest) ProtoMessage()    {}
+func (*OSAllLocatorsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{58}
+}
+func (m *OSAllLocatorsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OSAllLocatorsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OSAllLocatorsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OSAllLocatorsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OSAllLocatorsRequest.Merge(m, src)
+}
+func (m *OSAllLocatorsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *OSAllLocatorsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OSAllLocatorsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OSAllLocatorsRequest proto.InternalMessageInfo
+
+func (m *OSAllLocatorsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// OSAllLocatorsResponse is the response type for the Query/OSAllLocators RPC method.
+type OSAllLocatorsResponse struct {
+	Locators []ObjectStoreLocator `protobuf:"bytes,1,rep,name=locators,proto3" json:"locators"`
+	// request is a copy of the request that generated these results.
+	Request *OSAllLocatorsRequest `protobuf:"bytes,98,opt,name=request,proto3" json:"request,omitempty"`
+	// pagination provides the pagination information of this response.
+	Pagination *query.PageResponse `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *OSAllLocatorsResponse) Reset()         { *m = OSAllLocatorsResponse{} }
+func (m *OSAllLocatorsResponse) String() string { return proto.CompactTextString(m) }
+func (*OSAllLocatorsResponse) ProtoMessage()    {}
+func (*OSAllLocatorsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{59}
+}
+func (m *OSAllLocatorsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OSAllLocatorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OSAllLocatorsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OSAllLocatorsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OSAllLocatorsResponse.Merge(m, src)
+}
+func (m *OSAllLocatorsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *OSAllLocatorsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_OSAllLocatorsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OSAllLocatorsResponse proto.InternalMessageInfo
+
+func (m *OSAllLocatorsResponse) GetLocators() []ObjectStoreLocator {
+	if m != nil {
+		return m.Locators
+	}
+	return nil
+}
+
+func (m *OSAllLocatorsResponse) GetRequest() *OSAllLocatorsRequest {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+func (m *OSAllLocatorsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "provenance.metadata.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "provenance.metadata.v1.QueryParamsResponse")
+	proto.RegisterType((*ScopeRequest)(nil), "provenance.metadata.v1.ScopeRequest")
+	proto.RegisterType((*ScopeResponse)(nil), "provenance.metadata.v1.ScopeResponse")
+	proto.RegisterType((*ScopeWrapper)(nil), "provenance.metadata.v1.ScopeWrapper")
+	proto.RegisterType((*ScopesAllRequest)(nil), "provenance.metadata.v1.ScopesAllRequest")
+	proto.RegisterType((*ScopesAllResponse)(nil), "provenance.metadata.v1.ScopesAllResponse")
+	proto.RegisterType((*ScopesRequest)(nil), "provenance.metadata.v1.ScopesRequest")
+	proto.RegisterType((*ScopesResponse)(nil), "provenance.metadata.v1.ScopesResponse")
+	proto.RegisterType((*SessionsRequest)(nil), "provenance.metadata.v1.SessionsRequest")
+	proto.RegisterType((*SessionsResponse)(nil), "provenance.metadata.v1.SessionsResponse")
+	proto.RegisterType((*SessionWrapper)(nil), "provenance.metadata.v1.SessionWrapper")
+	proto.RegisterType((*SessionsAllRequest)(nil), "provenance.metadata.v1.SessionsAllRequest")
+	proto.RegisterType((*SessionsAllResponse)(nil), "provenance.metadata.v1.SessionsAllResponse")
+	proto.RegisterType((*SessionsByPartyRequest)(nil), "provenance.metadata.v1.SessionsByPartyRequest")
+	proto.RegisterType((*SessionsByPartyResponse)(nil), "provenance.metadata.v1.SessionsByPartyResponse")
+	proto.RegisterType((*RecordsRequest)(nil), "provenance.metadata.v1.RecordsRequest")
+	proto.RegisterType((*RecordsResponse)(nil), "provenance.metadata.v1.RecordsResponse")
+	proto.RegisterType((*RecordWrapper)(nil), "provenance.metadata.v1.RecordWrapper")
+	proto.RegisterType((*RecordsAllRequest)(nil), "provenance.metadata.v1.RecordsAllRequest")
+	proto.RegisterType((*RecordsAllResponse)(nil), "provenance.metadata.v1.RecordsAllResponse")
+	proto.RegisterType((*RecordHistoryRequest)(nil), "provenance.metadata.v1.RecordHistoryRequest")
+	proto.RegisterType((*RecordHistoryResponse)(nil), "provenance.metadata.v1.RecordHistoryResponse")
+	proto.RegisterType((*OwnershipRequest)(nil), "provenance.metadata.v1.OwnershipRequest")
+	proto.RegisterType((*OwnershipResponse)(nil), "provenance.metadata.v1.OwnershipResponse")
+	proto.RegisterType((*ValueOwnershipRequest)(nil), "provenance.metadata.v1.ValueOwnershipRequest")
+	proto.RegisterType((*ValueOwnershipResponse)(nil), "provenance.metadata.v1.ValueOwnershipResponse")
+	proto.RegisterType((*ScopeValueOwnerTokenRequest)(nil), "provenance.metadata.v1.ScopeValueOwnerTokenRequest")
+	proto.RegisterType((*ScopeValueOwnerTokenResponse)(nil), "provenance.metadata.v1.ScopeValueOwnerTokenResponse")
+	proto.RegisterType((*ScopeSaleOfferRequest)(nil), "provenance.metadata.v1.ScopeSaleOfferRequest")
+	proto.RegisterType((*ScopeSaleOfferResponse)(nil), "provenance.metadata.v1.ScopeSaleOfferResponse")
+	proto.RegisterType((*CheckScopeSpecificationMigrationRequest)(nil), "provenance.metadata.v1.CheckScopeSpecificationMigrationRequest")
+	proto.RegisterType((*CheckScopeSpecificationMigrationResponse)(nil), "provenance.metadata.v1.CheckScopeSpecificationMigrationResponse")
+	proto.RegisterType((*ScopeSpecificationRequest)(nil), "provenance.metadata.v1.ScopeSpecificationRequest")
+	proto.RegisterType((*ScopeSpecificationResponse)(nil), "provenance.metadata.v1.ScopeSpecificationResponse")
+	proto.RegisterType((*ScopeSpecificationWrapper)(nil), "provenance.metadata.v1.ScopeSpecificationWrapper")
+	proto.RegisterType((*ScopeSpecificationsAllRequest)(nil), "provenance.metadata.v1.ScopeSpecificationsAllRequest")
+	proto.RegisterType((*ScopeSpecificationsAllResponse)(nil), "provenance.metadata.v1.ScopeSpecificationsAllResponse")
+	proto.RegisterType((*ContractSpecificationRequest)(nil), "provenance.metadata.v1.ContractSpecificationRequest")
 	proto.RegisterType((*ContractSpecificationResponse)(nil), "provenance.metadata.v1.ContractSpecificationResponse")
 	proto.RegisterType((*ContractSpecificationWrapper)(nil), "provenance.metadata.v1.ContractSpecificationWrapper")
 	proto.RegisterType((*ContractSpecificationsAllRequest)(nil), "provenance.metadata.v1.ContractSpecificationsAllRequest")
@@ -2911,178 +3763,223 @@ func init() {
 }
 
 var fileDescriptor_a68790bc0b96eeb9 = []byte{
-	// 2732 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5b, 0x5b, 0x6c, 0x1c, 0x57,
-	0x19, 0xce, 0x99, 0x4d, 0xe2, 0xe4, 0x77, 0x1c, 0x3b, 0xbf, 0x2f, 0x59, 0x4f, 0x92, 0x5d, 0x77,
-	0x9a, 0x38, 0x8e, 0x93, 0xec, 0xd6, 0x97, 0xe6, 0xa6, 0x94, 0x10, 0xa7, 0x49, 0x70, 0x13, 0x9a,
-	0x64, 0xac, 0x16, 0xc9, 0x5c, 0xac, 0xf1, 0xee, 0xc4, 0xd9, 0xb2, 0xde, 0xd9, 0xce, 0xac, 0x93,
-	0x5a, 0x96, 0x85, 0x54, 0x01, 0x12, 0x22, 0xaa, 0x5a, 0x15, 0x2a, 0x2e, 0x42, 0x48, 0x48, 0x15,
-	0xa2, 0xe2, 0x05, 0x24, 0x54, 0x55, 0xbc, 0x20, 0x10, 0x28, 0x42, 0x42, 0x44, 0x82, 0x07, 0x78,
-	0x59, 0xa1, 0x84, 0x87, 0xbe, 0xc0, 0xc3, 0x0a, 0x55, 0x82, 0x27, 0x34, 0x67, 0xce, 0x99, 0x3d,
-	0x73, 0xdb, 0x9d, 0xd9, 0x78, 0x03, 0x6f, 0xde, 0x99, 0xff, 0x7e, 0xbe, 0xf3, 0x9d, 0x39, 0xff,
-	0x39, 0x06, 0xa5, 0x6a, 0x1a, 0x77, 0xf5, 0x8a, 0x56, 0x29, 0xe8, 0xf9, 0x55, 0xbd, 0xa6, 0x15,
-	0xb5, 0x9a, 0x96, 0xbf, 0x3b, 0x95, 0x7f, 0x7d, 0x4d, 0x37, 0xd7, 0x73, 0x55, 0xd3, 0xa8, 0x19,
-	0x38, 0xd2, 0x94, 0xc9, 0x71, 0x99, 0xdc, 0xdd, 0x29, 0x79, 0x68, 0xc5, 0x58, 0x31, 0xa8, 0x48,
-	0xde, 0xfe, 0xcb, 0x91, 0x96, 0x27, 0x0b, 0x86, 0xb5, 0x6a, 0x58, 0xf9, 0x65, 0xcd, 0xd2, 0x1d,
-	0x33, 0xf9, 0xbb, 0x53, 0xcb, 0x7a, 0x4d, 0x9b, 0xca, 0x57, 0xb5, 0x95, 0x52, 0x45, 0xab, 0x95,
-	0x8c, 0x0a, 0x93, 0x3d, 0xb8, 0x62, 0x18, 0x2b, 0x65, 0x3d, 0xaf, 0x55, 0x4b, 0x79, 0xad, 0x52,
-	0x31, 0x6a, 0xf4, 0xa5, 0xc5, 0xde, 0x1e, 0x89, 0x88, 0xcd, 0x8d, 0xc1, 0x11, 0x8b, 0x4a, 0xc1,
-	0x2a, 0x18, 0x55, 0x9d, 0x07, 0x15, 0x25, 0x53, 0xd5, 0x0b, 0xa5, 0xdb, 0xa5, 0x82, 0x18, 0xd4,
-	0x44, 0x84, 0xac, 0xb1, 0xfc, 0x9a, 0x5e, 0xa8, 0x59, 0x35, 0xc3, 0x64, 0x56, 0x95, 0x21, 0xc0,
-	0x5b, 0x76, 0x82, 0x37, 0x35, 0x53, 0x5b, 0xb5, 0x54, 0xfd, 0xf5, 0x35, 0xdd, 0xaa, 0x29, 0xdf,
-	0x25, 0x30, 0xe8, 0x79, 0x6c, 0x55, 0x8d, 0x8a, 0xa5, 0xe3, 0x79, 0xd8, 0x59, 0xa5, 0x4f, 0xd2,
-	0x64, 0x8c, 0x4c, 0xf4, 0x4e, 0x67, 0x72, 0xe1, 0x75, 0xcd, 0x39, 0x7a, 0x73, 0xdb, 0x1f, 0xd4,
-	0xb3, 0xdb, 0x54, 0xa6, 0x83, 0x2f, 0x42, 0x8f, 0xe9, 0x38, 0x48, 0x2f, 0x53, 0xf5, 0xc9, 0x28,
-	0xf5, 0x60, 0x48, 0x2a, 0x57, 0x55, 0x7e, 0x25, 0xc1, 0x9e, 0x05, 0xbb, 0x2e, 0xec, 0x0d, 0xe6,
-	0x60, 0x17, 0xad, 0xd3, 0x52, 0xa9, 0x48, 0xc3, 0xda, 0x3d, 0x37, 0xd8, 0xa8, 0x67, 0xfb, 0xd7,
-	0xb5, 0xd5, 0xf2, 0x39, 0x85, 0xbf, 0x51, 0xd4, 0x1e, 0xfa, 0xe7, 0x7c, 0x11, 0xcf, 0xc1, 0x1e,
-	0x4b, 0xb7, 0xac, 0x92, 0x51, 0x59, 0xd2, 0x8a, 0x45, 0x33, 0x2d, 0x51, 0x9d, 0xfd, 0x8d, 0x7a,
-	0x76, 0x90, 0xe9, 0x08, 0x6f, 0x15, 0xb5, 0x97, 0xfd, 0xbc, 0x58, 0x2c, 0x9a, 0x78, 0x1a, 0x7a,
-	0x4d, 0xbd, 0x60, 0x98, 0x45, 0x47, 0x35, 0x45, 0x55, 0x47, 0x1a, 0xf5, 0x2c, 0x3a, 0xaa, 0xc2,
-	0x4b, 0x45, 0x05, 0xe7, 0x17, 0x55, 0xbc, 0x02, 0x03, 0xa5, 0x4a, 0xa1, 0xbc, 0x56, 0xd4, 0x97,
-	0x98, 0x3d, 0x2b, 0x0d, 0x63, 0x64, 0x62, 0xd7, 0xdc, 0x81, 0x46, 0x3d, 0xbb, 0xdf, 0xd1, 0xf6,
-	0x4b, 0x28, 0x6a, 0x3f, 0x7b, 0xb4, 0xc0, 0x9e, 0xe0, 0x25, 0xe0, 0x8f, 0x96, 0x1c, 0xeb, 0x56,
-	0xba, 0x97, 0x9a, 0x91, 0x1b, 0xf5, 0xec, 0x88, 0xd7, 0x0c, 0x13, 0x50, 0xd4, 0xbd, 0xec, 0x89,
-	0xca, 0x1e, 0xfc, 0x41, 0x82, 0x3e, 0x56, 0x42, 0x36, 0xb0, 0xe7, 0x60, 0x07, 0x2d, 0x0f, 0x1b,
-	0xd7, 0xc3, 0x51, 0x03, 0x43, 0xb5, 0x3e, 0x67, 0x6a, 0xd5, 0xaa, 0x6e, 0xaa, 0x8e, 0x0a, 0x6a,
-	0xb0, 0xcb, 0x4d, 0x49, 0x1a, 0x4b, 0x4d, 0xf4, 0x4e, 0x8f, 0x47, 0xaa, 0x3b, 0x72, 0xcc, 0xc0,
-	0xdc, 0xa1, 0x46, 0x3d, 0x3b, 0xea, 0xa9, 0xb9, 0x75, 0xc2, 0x58, 0x2d, 0xd5, 0xf4, 0xd5, 0x6a,
-	0x6d, 0x5d, 0x51, 0x5d, 0xb3, 0xf8, 0x45, 0x1b, 0x39, 0x4e, 0xb6, 0x29, 0xea, 0xe1, 0x48, 0x94,
-	0x07, 0x27, 0x45, 0xee, 0xe0, 0x60, 0xa3, 0x9e, 0x4d, 0x8b, 0x23, 0xe3, 0xb1, 0xcf, 0x6d, 0xe2,
-	0xa7, 0xfc, 0xc0, 0x6c, 0x9d, 0x7f, 0x00, 0x92, 0xdf, 0xe7, 0x90, 0x64, 0x7e, 0x71, 0xc6, 0x5b,
-	0xce, 0x43, 0xad, 0xcd, 0xb9, 0x75, 0xec, 0xe3, 0x68, 0x5d, 0x2a, 0x55, 0x6e, 0x1b, 0x14, 0x98,
-	0xbd, 0xd3, 0xcf, 0xb6, 0x54, 0x9e, 0x2f, 0xce, 0x57, 0x6e, 0x1b, 0x73, 0xe9, 0x46, 0x3d, 0x3b,
-	0xe4, 0x45, 0x3c, 0xb5, 0x61, 0xc3, 0xb7, 0x29, 0x86, 0x16, 0xa0, 0xf3, 0xda, 0x26, 0x0d, 0xd7,
-	0x4f, 0x8a, 0xfa, 0x39, 0xda, 0xd2, 0xcf, 0x42, 0x55, 0x2f, 0x30, 0x5f, 0xe2, 0xa8, 0x05, 0x8c,
-	0x29, 0x6a, 0xbf, 0xe5, 0x95, 0x57, 0x16, 0x61, 0x80, 0x9a, 0xb0, 0x2e, 0x96, 0xcb, 0x7c, 0xce,
-	0x5e, 0x01, 0x68, 0x32, 0x69, 0xba, 0x40, 0x03, 0x18, 0xcf, 0x39, 0xb4, 0x9b, 0xb3, 0x69, 0x37,
-	0xe7, 0xb0, 0x37, 0xa3, 0xdd, 0xdc, 0x4d, 0x6d, 0xc5, 0x2d, 0xbb, 0xa0, 0xa9, 0xd4, 0x09, 0xec,
-	0x13, 0x8c, 0x37, 0x69, 0x8a, 0x06, 0x61, 0xd3, 0x54, 0x2a, 0x36, 0x9c, 0x99, 0x0e, 0xce, 0xf9,
-	0xd1, 0x30, 0xd1, 0x52, 0x5d, 0x48, 0xcb, 0x45, 0x04, 0x5e, 0x0d, 0xc9, 0xef, 0x68, 0xdb, 0xfc,
-	0x9c, 0xf0, 0x3d, 0x09, 0xfe, 0x43, 0x82, 0x7e, 0x3e, 0xf9, 0x3b, 0x25, 0xbc, 0x59, 0x00, 0x4e,
-	0x69, 0xa5, 0x22, 0xa3, 0xbb, 0xe1, 0x46, 0x3d, 0xbb, 0xcf, 0x4b, 0x77, 0xb6, 0xce, 0x6e, 0xf6,
-	0x63, 0xbe, 0xd8, 0x39, 0xd5, 0x35, 0x15, 0x2b, 0xda, 0xaa, 0x9e, 0xde, 0x1e, 0xa1, 0x68, 0xbf,
-	0x74, 0x15, 0x5f, 0xd6, 0x56, 0x75, 0x7c, 0x01, 0xfa, 0x5c, 0x06, 0xa4, 0xb3, 0xc7, 0x21, 0x48,
-	0x01, 0xdb, 0x9e, 0xd7, 0x8a, 0xba, 0x87, 0xb3, 0x23, 0x9d, 0x3f, 0x5b, 0x42, 0x8d, 0x0f, 0x25,
-	0x18, 0x68, 0xd6, 0x9b, 0xe1, 0xe9, 0xd5, 0x0e, 0xd8, 0x51, 0xf4, 0x4a, 0x95, 0x45, 0xe6, 0x61,
-	0x33, 0x7e, 0xae, 0x53, 0xe6, 0x7c, 0x7a, 0xd4, 0x78, 0xd1, 0x3f, 0x19, 0x8e, 0xb6, 0x89, 0x30,
-	0xb8, 0x60, 0x7f, 0x28, 0xc1, 0x5e, 0x6f, 0xf8, 0x78, 0x16, 0x7a, 0x58, 0x02, 0xac, 0xa4, 0xd9,
-	0x36, 0x56, 0x55, 0x2e, 0x8f, 0x25, 0xe8, 0x6f, 0x02, 0x56, 0xe4, 0xc9, 0x23, 0x6d, 0x4c, 0x30,
-	0xf6, 0x12, 0x87, 0xc5, 0x6b, 0x47, 0x51, 0xfb, 0x2c, 0x51, 0x14, 0xbf, 0x02, 0xc3, 0x05, 0xa3,
-	0x52, 0x33, 0xb5, 0x42, 0x2d, 0x8c, 0x30, 0x23, 0xbf, 0x5e, 0x2e, 0x31, 0x25, 0x81, 0x33, 0xc7,
-	0x1a, 0xf5, 0xec, 0x41, 0xc7, 0x6b, 0xa8, 0x49, 0x45, 0xc5, 0x42, 0x40, 0x4b, 0xf9, 0x02, 0x20,
-	0xaf, 0x6a, 0x17, 0xb8, 0xf3, 0x63, 0x02, 0x83, 0x1e, 0xf3, 0x0c, 0xed, 0x22, 0x2a, 0x49, 0x87,
-	0xa8, 0x8c, 0xff, 0xa9, 0x17, 0x4c, 0xb0, 0x0b, 0x2c, 0xfa, 0x7b, 0x09, 0xf6, 0xb2, 0x19, 0xce,
-	0xab, 0xe8, 0xa3, 0x37, 0x12, 0x9b, 0xde, 0x44, 0xf6, 0x95, 0x12, 0xb3, 0x6f, 0x2a, 0x26, 0xfb,
-	0x22, 0x6c, 0x6f, 0xb2, 0xa7, 0x4a, 0xff, 0x7e, 0x52, 0x7e, 0x0c, 0xfb, 0x04, 0xed, 0x4d, 0xfe,
-	0x09, 0xaa, 0xfc, 0x51, 0x82, 0x7e, 0xb7, 0x98, 0x5d, 0x66, 0xc8, 0xa7, 0xf0, 0x6d, 0x79, 0xa1,
-	0x33, 0x02, 0x6d, 0x52, 0xe4, 0xa7, 0xfd, 0x58, 0x1f, 0x6f, 0x6d, 0x20, 0xc8, 0x90, 0x3f, 0x96,
-	0xa0, 0xcf, 0x63, 0x1c, 0x4f, 0xc1, 0x4e, 0xc7, 0x7c, 0xbb, 0x8d, 0x96, 0xa3, 0xa6, 0x32, 0x69,
-	0xd4, 0x61, 0x2f, 0x03, 0xae, 0x97, 0x1c, 0x0f, 0xb7, 0xd6, 0x67, 0x2c, 0x35, 0xda, 0xa8, 0x67,
-	0x87, 0x3d, 0xf0, 0x77, 0xe9, 0x69, 0x8f, 0x29, 0x08, 0xe2, 0x3d, 0x18, 0x64, 0x02, 0x21, 0xbc,
-	0x38, 0xd1, 0xda, 0x97, 0xc0, 0x8a, 0x99, 0x46, 0x3d, 0x2b, 0x7b, 0xfc, 0x79, 0x39, 0x71, 0xc0,
-	0xf4, 0x69, 0x28, 0x9f, 0x87, 0x7d, 0xac, 0x88, 0x5d, 0x20, 0xc4, 0xc7, 0x04, 0x50, 0xb4, 0xce,
-	0xb0, 0x2d, 0x00, 0x84, 0x74, 0x04, 0x90, 0x4b, 0x7e, 0x80, 0x1c, 0x6b, 0x03, 0x90, 0xae, 0x72,
-	0x61, 0x0d, 0x06, 0x6e, 0xdc, 0xab, 0xe8, 0xa6, 0x75, 0xa7, 0x54, 0xe5, 0x15, 0x4c, 0x43, 0x8f,
-	0x4d, 0x74, 0xba, 0xe5, 0x6c, 0xec, 0x77, 0xab, 0xfc, 0xe7, 0x96, 0xd5, 0xf6, 0xaf, 0x04, 0xf6,
-	0x09, 0x6e, 0x59, 0x69, 0x4f, 0x83, 0xb3, 0x3d, 0x59, 0x5a, 0x5b, 0x2b, 0xb1, 0xf2, 0x7a, 0x48,
-	0x58, 0x78, 0xa9, 0xa8, 0x40, 0x7f, 0xbd, 0x62, 0xff, 0x48, 0xf0, 0x8d, 0xee, 0xcf, 0xb5, 0x0b,
-	0x15, 0x5d, 0x87, 0xe1, 0x57, 0xb5, 0xf2, 0x9a, 0xfe, 0x3f, 0x28, 0xeb, 0x63, 0x02, 0x23, 0x7e,
-	0xdf, 0x4f, 0x5a, 0xdb, 0xab, 0xfe, 0xda, 0x9e, 0x8c, 0xaa, 0x6d, 0x68, 0xd6, 0x5d, 0x28, 0x70,
-	0x01, 0x46, 0xdd, 0x4d, 0xa8, 0xdb, 0xea, 0x6a, 0xce, 0xfe, 0x01, 0x4f, 0x0b, 0xac, 0xb9, 0x2b,
-	0x12, 0x96, 0x35, 0xbf, 0x84, 0xbd, 0x4d, 0x15, 0x1f, 0xcd, 0x17, 0x95, 0x7f, 0x12, 0x90, 0xc3,
-	0xbc, 0xb0, 0x72, 0xbe, 0x49, 0x60, 0xb0, 0xb9, 0xdd, 0x75, 0xdf, 0x33, 0x7e, 0x9e, 0x6a, 0xbb,
-	0x79, 0x76, 0x35, 0xf8, 0x02, 0x25, 0x90, 0x5f, 0x88, 0x5d, 0x45, 0x45, 0x2b, 0xa0, 0x8a, 0xd7,
-	0xfc, 0x43, 0x93, 0xc0, 0x6f, 0x60, 0xd5, 0x79, 0x44, 0xc2, 0xca, 0xca, 0x57, 0xa0, 0x9b, 0xd0,
-	0x17, 0x96, 0xe8, 0x64, 0x02, 0x87, 0x5e, 0x03, 0x11, 0xcd, 0x07, 0xa9, 0xbb, 0xcd, 0x87, 0x15,
-	0x38, 0x14, 0x8c, 0xac, 0x1b, 0x8b, 0xc7, 0xaf, 0x25, 0xc8, 0x44, 0x79, 0x62, 0x10, 0xfa, 0x1a,
-	0x81, 0xa1, 0x90, 0xa1, 0xe6, 0xcb, 0x4a, 0x07, 0x18, 0xca, 0x36, 0xea, 0xd9, 0x03, 0x91, 0x18,
-	0xb2, 0x14, 0x75, 0x30, 0x08, 0x22, 0x0b, 0x6f, 0xf8, 0x51, 0xf4, 0x7c, 0x7c, 0xcf, 0xdd, 0x5d,
-	0x9b, 0x3e, 0x22, 0x70, 0x50, 0xdc, 0x3d, 0x75, 0x6b, 0xb2, 0xe3, 0x2d, 0x18, 0xf2, 0xb6, 0x02,
-	0x68, 0xe5, 0x78, 0x4b, 0x56, 0x28, 0x6b, 0x98, 0x94, 0xa2, 0xa2, 0xa7, 0x6b, 0xb0, 0x40, 0x1f,
-	0xbe, 0x97, 0x82, 0x43, 0x11, 0xb1, 0xb3, 0xf1, 0x7f, 0x8b, 0xc0, 0x88, 0x67, 0xf7, 0xe7, 0x9f,
-	0x5c, 0xb3, 0x71, 0x76, 0x94, 0x01, 0x10, 0x3c, 0xd3, 0xa8, 0x67, 0x0f, 0x85, 0xec, 0x2d, 0x05,
-	0x2e, 0x19, 0x2e, 0x84, 0x19, 0xc0, 0x77, 0x09, 0x0c, 0x0b, 0x89, 0x09, 0x88, 0x74, 0xbe, 0x84,
-	0xa7, 0xdb, 0x7f, 0xc9, 0x05, 0xa2, 0x99, 0x6c, 0xd4, 0xb3, 0xe3, 0x81, 0x6f, 0xba, 0xa6, 0x69,
-	0xf1, 0x23, 0x7c, 0xc8, 0x0c, 0xda, 0xb1, 0xf0, 0x65, 0x3f, 0x3c, 0x93, 0x95, 0x25, 0xc0, 0x73,
-	0xff, 0x8a, 0x02, 0x15, 0xa7, 0xba, 0x85, 0x70, 0xaa, 0x3b, 0x99, 0xcc, 0xad, 0x8f, 0xed, 0x22,
-	0x9b, 0x07, 0xd2, 0x53, 0x6a, 0x1e, 0xbc, 0x06, 0x63, 0xa1, 0x81, 0x76, 0x83, 0xfc, 0xfe, 0x2c,
-	0xc1, 0x33, 0x2d, 0x9c, 0x31, 0xfc, 0xbf, 0x43, 0x60, 0x7f, 0x38, 0x42, 0x39, 0x05, 0x76, 0x36,
-	0x01, 0x94, 0x46, 0x3d, 0x9b, 0x69, 0x35, 0x01, 0x2c, 0x45, 0x1d, 0x09, 0x9d, 0x01, 0x16, 0xaa,
-	0x7e, 0xb0, 0x9d, 0x49, 0x14, 0x42, 0x77, 0xe9, 0x70, 0x13, 0x66, 0x42, 0x66, 0x9a, 0x75, 0xc5,
-	0x30, 0x9f, 0x06, 0x49, 0x2a, 0xff, 0x4e, 0xc1, 0x6c, 0x32, 0xff, 0x6c, 0xa0, 0xbf, 0x11, 0xc9,
-	0x2b, 0xa4, 0x63, 0x5e, 0x11, 0x26, 0x41, 0xa8, 0xe9, 0x28, 0x36, 0xb9, 0x0d, 0x07, 0xc2, 0x41,
-	0x41, 0x3f, 0x7d, 0x59, 0x07, 0x67, 0xbc, 0x51, 0xcf, 0x2a, 0xad, 0x10, 0x44, 0x85, 0x15, 0x75,
-	0x34, 0x14, 0x45, 0xf6, 0x67, 0x73, 0x0b, 0x3f, 0x42, 0xfb, 0xbc, 0xbd, 0x1f, 0xa7, 0xdf, 0x14,
-	0xee, 0x87, 0xb6, 0x9f, 0x74, 0x3f, 0x60, 0xaf, 0x25, 0x28, 0x66, 0x3b, 0xe8, 0x34, 0x49, 0xf3,
-	0x0d, 0x90, 0x43, 0xf4, 0xb7, 0x7a, 0x19, 0xe6, 0x5d, 0x2e, 0xa9, 0xd9, 0xe5, 0xb2, 0xe9, 0xfa,
-	0x40, 0xa8, 0x6b, 0x06, 0xae, 0xaf, 0x13, 0x18, 0x0a, 0x43, 0x00, 0x63, 0xed, 0x4e, 0xb0, 0x25,
-	0xac, 0xf7, 0x61, 0x96, 0x15, 0x75, 0x30, 0x04, 0x5a, 0x78, 0xdd, 0x3f, 0x12, 0x49, 0x5c, 0x07,
-	0x0a, 0xfe, 0x31, 0x09, 0xad, 0x38, 0x5f, 0xa3, 0x6e, 0x85, 0xaf, 0x51, 0xc7, 0x93, 0xb8, 0xf4,
-	0xad, 0x50, 0x11, 0x4d, 0x1c, 0xa9, 0xeb, 0x4d, 0x9c, 0x3b, 0x90, 0x09, 0xc3, 0x66, 0x17, 0xd6,
-	0xa5, 0x07, 0x12, 0x64, 0x23, 0x5d, 0xfd, 0x1f, 0x92, 0xd5, 0x4d, 0x3f, 0xa4, 0x4e, 0x25, 0x99,
-	0xdc, 0x5d, 0x5d, 0x8b, 0xd2, 0x30, 0x72, 0x63, 0xe1, 0xba, 0x51, 0xd0, 0x6a, 0x86, 0xe9, 0xbd,
-	0x2c, 0xf2, 0x01, 0x81, 0xfd, 0x81, 0x57, 0xac, 0xb8, 0x97, 0x7d, 0x17, 0x46, 0x22, 0xf7, 0x79,
-	0x3e, 0x03, 0xbe, 0x9b, 0x23, 0x9f, 0xf1, 0xd7, 0x25, 0x17, 0xd3, 0x4e, 0x60, 0x9a, 0x4d, 0xc0,
-	0x80, 0x2b, 0xc2, 0xd1, 0x36, 0x04, 0x3b, 0x8c, 0x7b, 0x15, 0x9d, 0x1d, 0x02, 0xa8, 0xce, 0x0f,
-	0xe5, 0x07, 0x04, 0xf6, 0x09, 0xa2, 0x2c, 0xa1, 0x17, 0xa1, 0xa7, 0xec, 0x3c, 0x6a, 0xb7, 0x21,
-	0xbe, 0x41, 0xef, 0xda, 0x2c, 0xd4, 0x0c, 0x53, 0xe7, 0x46, 0xb8, 0x6a, 0x92, 0xf6, 0x95, 0x2f,
-	0xd8, 0x66, 0x26, 0xa6, 0x30, 0x20, 0xd6, 0xdc, 0xfa, 0x2b, 0xea, 0x3c, 0xcf, 0x67, 0x00, 0x52,
-	0x6b, 0x66, 0x89, 0x65, 0x63, 0xff, 0xb9, 0x65, 0xf3, 0xe9, 0x3f, 0xe2, 0x50, 0x73, 0xa7, 0xac,
-	0x32, 0xd7, 0x61, 0x17, 0x4b, 0x8f, 0xcf, 0x9c, 0x04, 0xa5, 0x61, 0xe3, 0xed, 0x5a, 0xe8, 0x64,
-	0xc4, 0x3d, 0x45, 0xe8, 0xc2, 0x0c, 0x78, 0x09, 0xd2, 0xa2, 0xaf, 0x27, 0xb9, 0x83, 0xa4, 0xfc,
-	0x82, 0xc0, 0x68, 0x88, 0xb1, 0xae, 0x94, 0xf2, 0x25, 0x7f, 0x29, 0x9f, 0x8b, 0x53, 0xca, 0xf0,
-	0x9b, 0x2e, 0x5f, 0x82, 0xa1, 0x1b, 0x0b, 0x17, 0xcb, 0x65, 0x2e, 0xb7, 0xd5, 0x84, 0xfd, 0x09,
-	0x81, 0x61, 0x9f, 0x83, 0xae, 0xd4, 0xe4, 0x8a, 0xbf, 0x26, 0x27, 0xa2, 0x6b, 0x12, 0x4c, 0x77,
-	0xeb, 0xc1, 0x35, 0xfd, 0x3b, 0x05, 0x76, 0xd0, 0x5b, 0x6f, 0xf6, 0x7a, 0xb4, 0xd3, 0x21, 0x2f,
-	0x4c, 0x70, 0x3f, 0x4e, 0x3e, 0x1e, 0x4b, 0xd6, 0xf1, 0xac, 0x8c, 0xbf, 0xf9, 0xa7, 0xbf, 0xbf,
-	0x2b, 0x8d, 0x61, 0x26, 0x1f, 0x71, 0x51, 0x90, 0xf1, 0xee, 0x27, 0x04, 0x76, 0x38, 0x87, 0x87,
-	0xb1, 0x6e, 0x44, 0xc9, 0x47, 0xda, 0x48, 0x31, 0xf7, 0x3f, 0x24, 0xd4, 0xff, 0x77, 0x08, 0x4e,
-	0xe4, 0x5b, 0xdd, 0x7c, 0xcc, 0x6f, 0xf0, 0xa9, 0xb3, 0xb9, 0x78, 0x0a, 0x67, 0x23, 0x65, 0x9d,
-	0xa3, 0xbc, 0xfc, 0x86, 0x78, 0x71, 0x6f, 0xd3, 0x31, 0xb1, 0x38, 0x8b, 0xd3, 0x51, 0x7a, 0xce,
-	0x12, 0x9c, 0xdf, 0x10, 0x8e, 0x7a, 0x99, 0x16, 0xde, 0x27, 0xb0, 0xdb, 0xbd, 0xdd, 0x83, 0xb1,
-	0x2f, 0x00, 0xc9, 0xc7, 0x62, 0x48, 0xb2, 0x22, 0x4c, 0xd2, 0x1a, 0x1c, 0x46, 0xa5, 0x65, 0x09,
-	0xac, 0xbc, 0x56, 0x2e, 0xe3, 0xfd, 0x14, 0xec, 0x72, 0xaf, 0x00, 0xc6, 0xbd, 0x81, 0x21, 0x4f,
-	0xb4, 0x17, 0x64, 0xb1, 0xfc, 0x54, 0xa2, 0xc1, 0xbc, 0x2f, 0xe1, 0x89, 0xd8, 0x45, 0xb6, 0x07,
-	0x65, 0x06, 0xa7, 0xe2, 0x0e, 0x20, 0x37, 0x60, 0x2d, 0x5e, 0xc0, 0x17, 0x92, 0x2a, 0x79, 0xbd,
-	0xb6, 0x80, 0x42, 0xf8, 0x90, 0x3a, 0xba, 0x8b, 0x57, 0xf1, 0x72, 0x6c, 0xc7, 0x3e, 0x43, 0xf6,
-	0xce, 0xc4, 0x35, 0x84, 0xdf, 0x22, 0xd0, 0x2b, 0xdc, 0x5b, 0xc0, 0x04, 0x97, 0x1b, 0xa2, 0xe7,
-	0x69, 0xc8, 0x55, 0x0c, 0xe5, 0x04, 0x1d, 0x96, 0x71, 0x3c, 0xdc, 0x66, 0x54, 0x1c, 0x94, 0xbc,
-	0xb5, 0x1d, 0x7a, 0xd8, 0x09, 0x22, 0xc6, 0x3c, 0x83, 0x96, 0x8f, 0xb6, 0x95, 0x63, 0xa1, 0xfc,
-	0x2c, 0x45, 0x63, 0xf9, 0x20, 0x15, 0x0d, 0x91, 0xb0, 0xe2, 0x2f, 0x4e, 0xe3, 0x73, 0x09, 0x8b,
-	0x6e, 0x2d, 0x9e, 0xc1, 0x53, 0x89, 0x07, 0x8a, 0x8e, 0x50, 0xa2, 0x21, 0x0e, 0xc3, 0x96, 0x1b,
-	0xc2, 0x67, 0xf1, 0xda, 0x56, 0x18, 0xe2, 0x71, 0x25, 0x61, 0x2f, 0x31, 0x8c, 0xf3, 0x78, 0xae,
-	0x03, 0x3d, 0xe6, 0x15, 0xdf, 0x26, 0x00, 0xcd, 0x23, 0x65, 0x8c, 0x7f, 0xec, 0x2c, 0x4f, 0xc6,
-	0x11, 0x65, 0xc8, 0x38, 0x4e, 0x81, 0x71, 0x04, 0x9f, 0x6d, 0x8d, 0x0b, 0x07, 0xa3, 0xdf, 0x26,
-	0xb0, 0xdb, 0x3d, 0x31, 0xc4, 0xd8, 0xa7, 0xb6, 0xd1, 0xc4, 0x1a, 0x38, 0xf8, 0x54, 0x66, 0x68,
-	0x3c, 0x27, 0xf1, 0x78, 0x54, 0x3c, 0x06, 0x57, 0xc9, 0x6f, 0xb0, 0xf3, 0xd8, 0x4d, 0xfc, 0x09,
-	0x81, 0xbd, 0xde, 0xe3, 0x4c, 0x4c, 0x76, 0xec, 0x29, 0xe7, 0xe2, 0x8a, 0xb3, 0x30, 0xcf, 0xd0,
-	0x30, 0x5b, 0x4c, 0x8f, 0xbb, 0xb6, 0x5e, 0x58, 0xac, 0x1f, 0x11, 0xc0, 0xe0, 0xc9, 0x0c, 0x26,
-	0x3f, 0x0b, 0x94, 0xa7, 0x93, 0xa8, 0xb0, 0xb8, 0xcf, 0xd3, 0xb8, 0x5b, 0x01, 0x9a, 0xae, 0x5b,
-	0x55, 0xbd, 0x90, 0xdf, 0xf0, 0xb7, 0x80, 0x36, 0xf1, 0x43, 0x02, 0x23, 0xe1, 0xa7, 0x4a, 0xd8,
-	0xd9, 0x29, 0x94, 0x7c, 0x2a, 0xa9, 0x1a, 0xcb, 0x23, 0x47, 0xf3, 0x98, 0xc0, 0xf1, 0xb6, 0x79,
-	0x38, 0xc8, 0xfd, 0x2d, 0x81, 0xe1, 0xd0, 0xde, 0x19, 0x76, 0x74, 0x3e, 0x21, 0x3f, 0x9f, 0x50,
-	0x8b, 0x85, 0x7d, 0x81, 0x86, 0x7d, 0x16, 0x4f, 0x47, 0x85, 0xcd, 0x5b, 0x87, 0x51, 0x23, 0xf0,
-	0x1b, 0x02, 0xa3, 0x91, 0xbd, 0x6c, 0xec, 0xb8, 0xfd, 0x2d, 0x9f, 0xed, 0x40, 0x93, 0xe5, 0x34,
-	0x45, 0x73, 0x3a, 0x8e, 0xc7, 0xe2, 0xe4, 0xe4, 0x8c, 0xc6, 0x7b, 0x12, 0x9c, 0x48, 0xd2, 0xe0,
-	0xc4, 0xad, 0x6c, 0x93, 0xca, 0xd7, 0xb7, 0xc6, 0x18, 0x4b, 0xff, 0x1a, 0x4d, 0xff, 0x32, 0x5e,
-	0xea, 0x70, 0x48, 0x39, 0xc1, 0xda, 0xc5, 0xc1, 0xfb, 0x12, 0x0c, 0x86, 0x44, 0x81, 0x1d, 0x34,
-	0x27, 0xe5, 0x99, 0x44, 0x3a, 0x2c, 0x9b, 0x6f, 0x3a, 0x1f, 0xf7, 0x5f, 0x25, 0xf8, 0x7c, 0x9b,
-	0x05, 0x21, 0x3c, 0x9b, 0xc5, 0x6b, 0x38, 0xff, 0xe4, 0x85, 0xe0, 0x4b, 0xe0, 0x2f, 0x09, 0xec,
-	0x8f, 0xe8, 0x95, 0x61, 0x87, 0xcd, 0x35, 0xf9, 0x74, 0x62, 0x3d, 0x56, 0x9a, 0x3c, 0xad, 0xcc,
-	0x31, 0x3c, 0xda, 0xbe, 0x30, 0x0e, 0xca, 0x7f, 0x44, 0xa0, 0xdf, 0xd7, 0xd1, 0xc2, 0x84, 0xad,
-	0x2f, 0x39, 0x1f, 0x5b, 0x3e, 0x2e, 0x31, 0xb2, 0x5d, 0x34, 0xdf, 0x24, 0xbe, 0x63, 0x2f, 0xe9,
-	0xdc, 0x16, 0xc6, 0xee, 0x64, 0xb5, 0x58, 0xd2, 0xfd, 0x5d, 0xb7, 0xf6, 0x85, 0xe3, 0x21, 0x6d,
-	0xd0, 0xf5, 0x72, 0x13, 0xdf, 0x17, 0x0b, 0xe7, 0x34, 0x86, 0x30, 0x61, 0x07, 0x29, 0x46, 0xe1,
-	0xbc, 0x1d, 0xb0, 0xf6, 0x34, 0xc6, 0xa3, 0x5c, 0x33, 0x4b, 0xf9, 0x8d, 0x35, 0xb3, 0xb4, 0x89,
-	0x3f, 0x17, 0x9b, 0x8c, 0xbc, 0xeb, 0x82, 0x89, 0x1b, 0x34, 0xf2, 0x54, 0x02, 0x8d, 0xb8, 0xdf,
-	0x1f, 0x3c, 0x5a, 0xff, 0xf7, 0x2e, 0x7e, 0x8f, 0x40, 0x9f, 0xa7, 0x2d, 0x82, 0x89, 0xba, 0x27,
-	0xf2, 0xc9, 0x98, 0xd2, 0x71, 0x37, 0x41, 0xbc, 0xab, 0x63, 0x4f, 0x99, 0xb9, 0x2f, 0x3f, 0x78,
-	0x94, 0x21, 0x0f, 0x1f, 0x65, 0xc8, 0xdf, 0x1e, 0x65, 0xc8, 0xdb, 0x8f, 0x33, 0xdb, 0x1e, 0x3e,
-	0xce, 0x6c, 0xfb, 0xcb, 0xe3, 0xcc, 0x36, 0x18, 0x2d, 0x19, 0x11, 0x8e, 0x6f, 0x92, 0xc5, 0xd9,
-	0x95, 0x52, 0xed, 0xce, 0xda, 0x72, 0xae, 0x60, 0xac, 0x0a, 0x6e, 0x4e, 0x96, 0x0c, 0xd1, 0xe9,
-	0x1b, 0x4d, 0xb7, 0xb5, 0xf5, 0xaa, 0x6e, 0x2d, 0xef, 0xa4, 0xff, 0x44, 0x39, 0xf3, 0xdf, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x24, 0xdd, 0xa4, 0x6f, 0x83, 0x3a, 0x00, 0x00,
+	// 3447 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5c, 0x5d, 0x68, 0x1c, 0xd7,
+	0xf5, 0xf7, 0xdd, 0xb5, 0x64, 0xf9, 0xc8, 0xfa, 0xf0, 0xd1, 0x87, 0xa5, 0xb1, 0xad, 0x55, 0x26,
+	0xb6, 0x2c, 0x5b, 0xd6, 0x6e, 0xf4, 0x61, 0x3b, 0x31, 0x4e, 0x6c, 0xcb, 0x89, 0x1d, 0xc5, 0x4e,
+	0xec, 0x8c, 0xf2, 0xc1, 0x5f, 0xf9, 0xff, 0xff, 0x62, 0xb4, 0x3b, 0x96, 0x37, 0xde, 0xdd, 0xd9,
+	0xcc, 0xac, 0x1c, 0xab, 0x42, 0x4d, 0x09, 0x6d, 0xa1, 0x34, 0x84, 0x84, 0xa4, 0xa1, 0x1f, 0x0f,
+	0xa5, 0x85, 0x50, 0x9a, 0x36, 0x0f, 0x2d, 0x94, 0x10, 0x4a, 0xa1, 0xb4, 0x14, 0x42, 0xa1, 0x34,
+	0xd0, 0x3e, 0xa4, 0x2d, 0x2c, 0xc5, 0xce, 0x43, 0xa0, 0x4d, 0x69, 0x97, 0x12, 0x68, 0x9f, 0xca,
+	0xdc, 0x7b, 0x67, 0xe7, 0xce, 0xec, 0xcc, 0xee, 0xcc, 0x7a, 0xd7, 0xf4, 0xc9, 0x9a, 0x99, 0xf3,
+	0x75, 0xcf, 0xf9, 0xdd, 0x73, 0x66, 0xce, 0x3d, 0x6b, 0x90, 0x8b, 0x86, 0x7e, 0x43, 0x2b, 0xa8,
+	0x85, 0xb4, 0x96, 0xca, 0x6b, 0x25, 0x35, 0xa3, 0x96, 0xd4, 0xd4, 0x8d, 0x99, 0xd4, 0x0b, 0xeb,
+	0x9a, 0xb1, 0x91, 0x2c, 0x1a, 0x7a, 0x49, 0xc7, 0x61, 0x87, 0x26, 0x69, 0xd3, 0x24, 0x6f, 0xcc,
+	0x48, 0x83, 0x6b, 0xfa, 0x9a, 0x4e, 0x49, 0x52, 0xd6, 0x5f, 0x8c, 0x5a, 0x3a, 0x92, 0xd6, 0xcd,
+	0xbc, 0x6e, 0xa6, 0x56, 0x55, 0x53, 0x63, 0x62, 0x52, 0x37, 0x66, 0x56, 0xb5, 0x92, 0x3a, 0x93,
+	0x2a, 0xaa, 0x6b, 0xd9, 0x82, 0x5a, 0xca, 0xea, 0x05, 0x4e, 0xbb, 0x6f, 0x4d, 0xd7, 0xd7, 0x72,
+	0x5a, 0x4a, 0x2d, 0x66, 0x53, 0x6a, 0xa1, 0xa0, 0x97, 0xe8, 0x43, 0x93, 0x3f, 0x3d, 0x18, 0x60,
+	0x5b, 0xd5, 0x06, 0x46, 0x16, 0xb4, 0x04, 0x33, 0xad, 0x17, 0x35, 0xdb, 0xa8, 0x20, 0x9a, 0xa2,
+	0x96, 0xce, 0x5e, 0xcd, 0xa6, 0x45, 0xa3, 0x26, 0x03, 0x68, 0xf5, 0xd5, 0xe7, 0xb5, 0x74, 0xc9,
+	0x2c, 0xe9, 0x06, 0x97, 0x2a, 0x0f, 0x02, 0x3e, 0x69, 0x2d, 0xf0, 0x8a, 0x6a, 0xa8, 0x79, 0x53,
+	0xd1, 0x5e, 0x58, 0xd7, 0xcc, 0x92, 0xfc, 0x0d, 0x02, 0x03, 0xae, 0xdb, 0x66, 0x51, 0x2f, 0x98,
+	0x1a, 0x9e, 0x82, 0xce, 0x22, 0xbd, 0x33, 0x42, 0xc6, 0xc9, 0x64, 0xf7, 0xec, 0x58, 0xd2, 0xdf,
+	0xaf, 0x49, 0xc6, 0xb7, 0xb0, 0xfd, 0x83, 0x72, 0x62, 0x9b, 0xc2, 0x79, 0xf0, 0x61, 0xd8, 0x61,
+	0x30, 0x05, 0x23, 0xab, 0x94, 0xfd, 0x48, 0x10, 0x7b, 0xad, 0x49, 0x8a, 0xcd, 0x2a, 0xff, 0x3c,
+	0x06, 0xbb, 0x96, 0x2c, 0xbf, 0xf0, 0x27, 0x98, 0x84, 0x2e, 0xea, 0xa7, 0x95, 0x6c, 0x86, 0x9a,
+	0xb5, 0x73, 0x61, 0xa0, 0x52, 0x4e, 0xf4, 0x6d, 0xa8, 0xf9, 0xdc, 0x49, 0xd9, 0x7e, 0x22, 0x2b,
+	0x3b, 0xe8, 0x9f, 0x8b, 0x19, 0x3c, 0x09, 0xbb, 0x4c, 0xcd, 0x34, 0xb3, 0x7a, 0x61, 0x45, 0xcd,
+	0x64, 0x8c, 0x91, 0x18, 0xe5, 0xd9, 0x53, 0x29, 0x27, 0x06, 0x38, 0x8f, 0xf0, 0x54, 0x56, 0xba,
+	0xf9, 0xe5, 0xd9, 0x4c, 0xc6, 0xc0, 0x13, 0xd0, 0x6d, 0x68, 0x69, 0xdd, 0xc8, 0x30, 0xd6, 0x38,
+	0x65, 0x1d, 0xae, 0x94, 0x13, 0xc8, 0x58, 0x85, 0x87, 0xb2, 0x02, 0xec, 0x8a, 0x32, 0x9e, 0x87,
+	0xfe, 0x6c, 0x21, 0x9d, 0x5b, 0xcf, 0x68, 0x2b, 0x5c, 0x9e, 0x39, 0x02, 0xe3, 0x64, 0xb2, 0x6b,
+	0x61, 0x6f, 0xa5, 0x9c, 0xd8, 0xc3, 0xb8, 0xbd, 0x14, 0xb2, 0xd2, 0xc7, 0x6f, 0x2d, 0xf1, 0x3b,
+	0x78, 0x0e, 0xec, 0x5b, 0x2b, 0x4c, 0xba, 0x39, 0xd2, 0x4d, 0xc5, 0x48, 0x95, 0x72, 0x62, 0xd8,
+	0x2d, 0x86, 0x13, 0xc8, 0x4a, 0x2f, 0xbf, 0xa3, 0xf0, 0x1b, 0xbf, 0x89, 0x41, 0x0f, 0x77, 0x21,
+	0x0f, 0xec, 0x49, 0xe8, 0xa0, 0xee, 0xe1, 0x71, 0x3d, 0x10, 0x14, 0x18, 0xca, 0xf5, 0xac, 0xa1,
+	0x16, 0x8b, 0x9a, 0xa1, 0x30, 0x16, 0x54, 0xa1, 0xab, 0xba, 0xa4, 0xd8, 0x78, 0x7c, 0xb2, 0x7b,
+	0x76, 0x22, 0x90, 0x9d, 0xd1, 0x71, 0x01, 0x0b, 0xfb, 0x2b, 0xe5, 0xc4, 0xa8, 0xcb, 0xe7, 0xe6,
+	0x51, 0x3d, 0x9f, 0x2d, 0x69, 0xf9, 0x62, 0x69, 0x43, 0x56, 0xaa, 0x62, 0xf1, 0xff, 0x2c, 0xe4,
+	0xb0, 0xd5, 0xc6, 0xa9, 0x86, 0x83, 0x41, 0x1a, 0xd8, 0x12, 0x6d, 0x05, 0xfb, 0x2a, 0xe5, 0xc4,
+	0x88, 0x18, 0x19, 0x97, 0x7c, 0x5b, 0x26, 0x3e, 0xe4, 0x05, 0x66, 0xfd, 0xf5, 0xd7, 0x40, 0xf2,
+	0x5b, 0x36, 0x24, 0xb9, 0x5e, 0x9c, 0x73, 0xbb, 0x73, 0x7f, 0x7d, 0x71, 0x55, 0x3f, 0xf6, 0xd8,
+	0x68, 0x5d, 0xc9, 0x16, 0xae, 0xea, 0x14, 0x98, 0xdd, 0xb3, 0xf7, 0xd6, 0x65, 0x5e, 0xcc, 0x2c,
+	0x16, 0xae, 0xea, 0x0b, 0x23, 0x95, 0x72, 0x62, 0xd0, 0x8d, 0x78, 0x2a, 0xc3, 0x82, 0xaf, 0x43,
+	0x86, 0x26, 0x20, 0x7b, 0x6c, 0x16, 0xb5, 0x74, 0x55, 0x4f, 0x9c, 0xea, 0x39, 0x54, 0x57, 0xcf,
+	0x52, 0x51, 0x4b, 0x73, 0x5d, 0x62, 0xd4, 0x6a, 0x84, 0xc9, 0x4a, 0x9f, 0xe9, 0xa6, 0x97, 0x97,
+	0xa1, 0x9f, 0x8a, 0x30, 0xcf, 0xe6, 0x72, 0xf6, 0x9e, 0x3d, 0x0f, 0xe0, 0x64, 0xd2, 0x91, 0x34,
+	0x35, 0x60, 0x22, 0xc9, 0xd2, 0x6e, 0xd2, 0x4a, 0xbb, 0x49, 0x96, 0xbd, 0x79, 0xda, 0x4d, 0x5e,
+	0x51, 0xd7, 0xaa, 0x6e, 0x17, 0x38, 0xe5, 0x32, 0x81, 0xdd, 0x82, 0x70, 0x27, 0x4d, 0x51, 0x23,
+	0xac, 0x34, 0x15, 0x0f, 0x0d, 0x67, 0xce, 0x83, 0x0b, 0x5e, 0x34, 0x4c, 0xd6, 0x65, 0x17, 0x96,
+	0x55, 0x45, 0x04, 0x5e, 0xf0, 0x59, 0xdf, 0xa1, 0x86, 0xeb, 0x63, 0xe6, 0xbb, 0x16, 0xf8, 0x85,
+	0x38, 0xdf, 0xaa, 0xa6, 0xe3, 0xba, 0x7e, 0x57, 0xca, 0x77, 0xd2, 0x9e, 0x90, 0x49, 0xbc, 0x14,
+	0x56, 0x58, 0xc4, 0x5b, 0x8b, 0x19, 0x1c, 0x84, 0x0e, 0xfd, 0xc5, 0x82, 0xc6, 0xf3, 0x9f, 0xc2,
+	0x2e, 0xf0, 0x59, 0x00, 0xfa, 0xc7, 0x8a, 0xa1, 0xe7, 0x34, 0x8a, 0x8c, 0xde, 0xd9, 0x7b, 0xea,
+	0x64, 0xf9, 0xd2, 0xc6, 0x53, 0x1b, 0x45, 0x6d, 0x61, 0xa8, 0x52, 0x4e, 0xec, 0x66, 0xaa, 0x1d,
+	0x76, 0x59, 0xd9, 0x49, 0x2f, 0x14, 0x3d, 0xa7, 0x59, 0x99, 0xf3, 0x86, 0x9a, 0x5b, 0xd7, 0x56,
+	0x98, 0xd2, 0xed, 0xde, 0xcc, 0x29, 0x3c, 0x94, 0x15, 0xa0, 0x57, 0x97, 0xa9, 0x45, 0x27, 0xa0,
+	0xdb, 0xd2, 0xb7, 0xa2, 0xa6, 0xd3, 0x9a, 0x69, 0x8e, 0x74, 0x78, 0x19, 0x85, 0x87, 0xb2, 0x02,
+	0xd6, 0xd5, 0x59, 0x7a, 0xd1, 0x32, 0x8c, 0x7d, 0x44, 0xa0, 0xd7, 0x0e, 0x41, 0x4b, 0x00, 0x76,
+	0xda, 0x0b, 0xb0, 0x83, 0x75, 0xd9, 0xcd, 0xf6, 0xa1, 0xeb, 0xd3, 0x18, 0xf4, 0xd9, 0xa5, 0xa5,
+	0xd9, 0x72, 0x3a, 0x0f, 0x60, 0x17, 0xcc, 0x6c, 0x86, 0x17, 0x53, 0x01, 0x0e, 0xce, 0x33, 0x59,
+	0xd9, 0xc9, 0x2f, 0x16, 0x33, 0xcd, 0x17, 0x52, 0x87, 0xb1, 0xa0, 0xe6, 0xb5, 0x5a, 0x1c, 0x09,
+	0x0f, 0xab, 0x8c, 0x4f, 0xa8, 0x79, 0x0d, 0x1f, 0x84, 0x9e, 0x6a, 0x7d, 0xa5, 0xb9, 0x99, 0x95,
+	0x5f, 0x21, 0x73, 0xba, 0x1e, 0xcb, 0xca, 0x2e, 0xbb, 0xf6, 0x5a, 0x97, 0xad, 0x29, 0xbc, 0x1f,
+	0xc6, 0xa0, 0xdf, 0xf1, 0x37, 0x07, 0xd3, 0x33, 0x4d, 0xd4, 0x5e, 0x51, 0x2b, 0x65, 0x16, 0xeb,
+	0x1a, 0xaf, 0x27, 0x0b, 0xcd, 0xd6, 0xe5, 0xbb, 0x57, 0x78, 0xcf, 0x7a, 0x77, 0xc2, 0xa1, 0x06,
+	0x16, 0xd6, 0xbe, 0x0e, 0xbe, 0x17, 0x83, 0x5e, 0xb7, 0xf9, 0xf8, 0x00, 0xec, 0xe0, 0x0b, 0xe0,
+	0x2e, 0x4d, 0x34, 0x90, 0xaa, 0xd8, 0xf4, 0x98, 0x85, 0x3e, 0x07, 0xb0, 0x62, 0x15, 0x3e, 0xd8,
+	0x40, 0x04, 0xaf, 0x8d, 0x62, 0x58, 0xdc, 0x72, 0x64, 0xa5, 0xc7, 0x14, 0x49, 0xf1, 0x25, 0x18,
+	0x4a, 0xeb, 0x85, 0x92, 0xa1, 0xa6, 0x4b, 0x7e, 0xe5, 0x38, 0xf0, 0xdd, 0xf8, 0x1c, 0x67, 0x12,
+	0x2a, 0xf2, 0x78, 0xa5, 0x9c, 0xd8, 0xc7, 0xb4, 0xfa, 0x8a, 0x94, 0x15, 0x4c, 0xd7, 0x70, 0xc9,
+	0xff, 0x0b, 0x68, 0x7b, 0xb5, 0x0d, 0x95, 0xf9, 0x13, 0x02, 0x03, 0x2e, 0xf1, 0x1c, 0xed, 0x22,
+	0x2a, 0x49, 0x93, 0xa8, 0x0c, 0xff, 0x21, 0x51, 0xbb, 0xc0, 0x36, 0x64, 0xd1, 0x77, 0x09, 0x0c,
+	0xdb, 0x8a, 0x16, 0x36, 0x68, 0x51, 0xb4, 0xbd, 0x39, 0x08, 0x1d, 0x45, 0xeb, 0x9a, 0x65, 0x52,
+	0x85, 0x5d, 0xe0, 0x31, 0xd8, 0x4e, 0xcb, 0x6b, 0x2c, 0x64, 0x79, 0x55, 0x28, 0x79, 0xcb, 0x42,
+	0xf3, 0x77, 0x02, 0x7b, 0x6a, 0xec, 0x6d, 0x61, 0x78, 0x1e, 0xf5, 0x86, 0x27, 0xd9, 0x28, 0x3c,
+	0x6e, 0xaf, 0xb5, 0x21, 0x44, 0xbf, 0x8e, 0x41, 0x2f, 0x4f, 0xc2, 0x76, 0x68, 0x3c, 0x15, 0x88,
+	0x84, 0xae, 0x40, 0x62, 0x81, 0x8c, 0x45, 0x2e, 0x90, 0xf1, 0x90, 0x05, 0x12, 0x61, 0xbb, 0x53,
+	0xe0, 0x94, 0xed, 0x85, 0x16, 0x94, 0x30, 0xbf, 0x6f, 0xd0, 0xee, 0xe8, 0xdf, 0xa0, 0xf2, 0x6f,
+	0x63, 0xd0, 0x57, 0x75, 0x66, 0x9b, 0x8b, 0xd8, 0x5d, 0xf8, 0xb8, 0x3c, 0xdd, 0x5c, 0x8d, 0x73,
+	0xaa, 0xd8, 0x19, 0x2f, 0xde, 0x27, 0xea, 0x0b, 0xa8, 0x2d, 0x62, 0xdf, 0x8b, 0x41, 0x8f, 0x4b,
+	0x38, 0x1e, 0x87, 0x4e, 0x26, 0xbe, 0x51, 0xa7, 0x85, 0xb1, 0x29, 0x9c, 0x1a, 0x35, 0xe8, 0xe5,
+	0xc0, 0x75, 0xd7, 0xaf, 0x03, 0xf5, 0xf9, 0x79, 0x21, 0x19, 0xad, 0x94, 0x13, 0x43, 0x2e, 0xf8,
+	0x57, 0x2b, 0xc8, 0x2e, 0x43, 0x20, 0xc4, 0x17, 0x61, 0x80, 0x13, 0xf8, 0x94, 0xae, 0xc9, 0xfa,
+	0xba, 0x84, 0xc2, 0x35, 0x56, 0x29, 0x27, 0x24, 0x97, 0x3e, 0x77, 0xd9, 0xea, 0x37, 0x3c, 0x1c,
+	0xf2, 0x73, 0xb0, 0x9b, 0x3b, 0xb1, 0x0d, 0x35, 0xeb, 0x36, 0x01, 0x14, 0xa5, 0x73, 0x6c, 0x0b,
+	0x00, 0x21, 0x4d, 0x01, 0xe4, 0x9c, 0x17, 0x20, 0x87, 0x1b, 0x00, 0xa4, 0xad, 0xe5, 0xea, 0x4f,
+	0x04, 0x06, 0x99, 0x9e, 0x47, 0xb3, 0x66, 0x49, 0x37, 0x36, 0xee, 0x7a, 0x46, 0xb4, 0x73, 0x5b,
+	0x5c, 0xc8, 0x6d, 0xad, 0x8a, 0xe1, 0x3f, 0x08, 0x0c, 0x79, 0x56, 0xc7, 0xc3, 0x78, 0x01, 0xba,
+	0x6e, 0x68, 0x86, 0x58, 0xda, 0x1a, 0xc4, 0xf1, 0x19, 0x46, 0xcd, 0xbb, 0x98, 0x55, 0x66, 0x3c,
+	0xef, 0x0d, 0xe7, 0xd1, 0xfa, 0x72, 0xdc, 0x6e, 0x6e, 0x43, 0x44, 0x4b, 0xd0, 0x4f, 0xbf, 0x95,
+	0xcd, 0x6b, 0xd9, 0xa2, 0x1d, 0xcc, 0x11, 0xd8, 0x61, 0x05, 0xca, 0xfa, 0x64, 0x66, 0xef, 0x1e,
+	0xf6, 0x65, 0xcb, 0x3c, 0xfd, 0x07, 0x02, 0xbb, 0x05, 0xb5, 0xdc, 0xcb, 0x27, 0x80, 0x75, 0x9c,
+	0x56, 0xd6, 0xd7, 0xb3, 0x7c, 0xc3, 0xb8, 0x40, 0x24, 0x3c, 0x94, 0x15, 0xa0, 0x57, 0x4f, 0x5b,
+	0x17, 0x11, 0xda, 0x2e, 0xde, 0xb5, 0xb6, 0xc1, 0xa3, 0x1b, 0x30, 0xf4, 0x4c, 0xb5, 0x05, 0x71,
+	0x77, 0xdd, 0xfa, 0x6e, 0x0c, 0x86, 0xbd, 0xba, 0xef, 0xd4, 0xb7, 0x8f, 0xc2, 0xee, 0x92, 0x7e,
+	0x5d, 0x2b, 0xac, 0x88, 0xec, 0x31, 0xca, 0x2e, 0x7c, 0xa9, 0xd5, 0x90, 0xc8, 0x4a, 0x1f, 0xbd,
+	0xb7, 0xe4, 0x48, 0xba, 0xe0, 0x8d, 0xd2, 0x74, 0x50, 0x94, 0x7c, 0xfd, 0xd7, 0x86, 0x50, 0xa5,
+	0x61, 0x2f, 0xb5, 0xcf, 0xd1, 0xf7, 0x94, 0x65, 0x73, 0xb3, 0xed, 0x8c, 0x41, 0xe8, 0xc8, 0x68,
+	0x05, 0x3d, 0x6f, 0xb7, 0xc5, 0xe8, 0x85, 0xfc, 0x66, 0x1c, 0xf6, 0xf9, 0x6b, 0xe1, 0xa1, 0x69,
+	0x89, 0x1a, 0x3c, 0x03, 0xbd, 0x79, 0xd5, 0xb8, 0xae, 0x19, 0x2b, 0x36, 0xc8, 0xd8, 0xeb, 0xa2,
+	0x50, 0x97, 0xdd, 0xcf, 0x65, 0xa5, 0x87, 0xdd, 0x38, 0xcb, 0x51, 0xb8, 0x0f, 0x76, 0xd2, 0x90,
+	0x65, 0x3f, 0xa7, 0x65, 0xe8, 0xbb, 0x63, 0x97, 0xe2, 0xdc, 0xc0, 0xf3, 0xd0, 0x69, 0xae, 0x17,
+	0x8b, 0xb9, 0x0d, 0xde, 0x46, 0x4b, 0x5a, 0x99, 0xed, 0x8f, 0xe5, 0xc4, 0xc4, 0x5a, 0xb6, 0x74,
+	0x6d, 0x7d, 0x35, 0x99, 0xd6, 0xf3, 0x29, 0x7e, 0xf6, 0xc5, 0xfe, 0x99, 0x36, 0x33, 0xd7, 0x53,
+	0xa5, 0x8d, 0xa2, 0x66, 0x26, 0x17, 0x0b, 0x25, 0x85, 0x73, 0x7b, 0x9b, 0x79, 0x9d, 0xa1, 0x9b,
+	0x79, 0x8f, 0x7b, 0xe1, 0x33, 0x57, 0xf7, 0x45, 0xd1, 0x3f, 0xa6, 0xce, 0x7b, 0xd3, 0x05, 0x18,
+	0xa2, 0x74, 0x4b, 0x6a, 0x4e, 0xbb, 0x7c, 0xf5, 0xaa, 0x66, 0x34, 0x19, 0x75, 0xf9, 0x7d, 0xeb,
+	0x13, 0xce, 0x23, 0xa9, 0xda, 0xeb, 0xeb, 0xd0, 0xad, 0x1b, 0x23, 0xa4, 0xfe, 0xbb, 0x9d, 0x87,
+	0x9d, 0x31, 0x59, 0xf9, 0x42, 0xbb, 0x59, 0xcc, 0x1a, 0x1a, 0xab, 0x8c, 0x5d, 0x8a, 0x7d, 0x19,
+	0x61, 0x27, 0xf9, 0x2e, 0xd1, 0x71, 0xc2, 0x77, 0x08, 0x1c, 0x3a, 0x77, 0x4d, 0x4b, 0x5f, 0xaf,
+	0x36, 0xea, 0xab, 0x6d, 0xde, 0xc7, 0xb3, 0x6b, 0x06, 0xfd, 0xa3, 0xd9, 0xdd, 0xe0, 0xd7, 0x6c,
+	0x8e, 0x45, 0x6f, 0x36, 0xcb, 0x3f, 0x23, 0x30, 0xd9, 0xd8, 0x46, 0xee, 0xf1, 0x31, 0x80, 0xb4,
+	0x9e, 0x2f, 0xaa, 0xa5, 0xec, 0x6a, 0x8e, 0x7d, 0x50, 0x74, 0x29, 0xc2, 0x1d, 0x1c, 0x86, 0xce,
+	0xac, 0x69, 0xae, 0x6b, 0x3c, 0x85, 0x29, 0xfc, 0x0a, 0xff, 0xc7, 0xeb, 0xd1, 0xd3, 0x81, 0x3d,
+	0x94, 0x70, 0xee, 0x72, 0x7c, 0x9c, 0x86, 0xd1, 0x5a, 0xf2, 0x16, 0x77, 0xe4, 0xe5, 0xbf, 0x11,
+	0x90, 0xfc, 0xb4, 0x70, 0xb7, 0xbc, 0x4c, 0x60, 0xc0, 0x39, 0x70, 0xa9, 0x3e, 0xe7, 0xb8, 0x9c,
+	0x69, 0x78, 0x7c, 0x53, 0xe5, 0xb0, 0xbf, 0x90, 0x84, 0xb7, 0x6f, 0x1f, 0xb9, 0xb2, 0x82, 0x66,
+	0x0d, 0x2b, 0x5e, 0xf4, 0xfa, 0x38, 0x82, 0xde, 0x1a, 0xaf, 0xde, 0x22, 0x30, 0x1a, 0x68, 0x1e,
+	0x5e, 0x81, 0x1e, 0xbf, 0x85, 0x1e, 0x89, 0xa0, 0xd0, 0x2d, 0x20, 0xe0, 0xf8, 0x2b, 0xd6, 0xde,
+	0xe3, 0xaf, 0x35, 0xd8, 0x5f, 0x6b, 0x59, 0x3b, 0xbe, 0x5e, 0x7e, 0x11, 0x83, 0xb1, 0x20, 0x4d,
+	0x1c, 0x42, 0x5f, 0x22, 0x30, 0xe8, 0x13, 0x6a, 0xfb, 0x7d, 0xb8, 0x09, 0x0c, 0x25, 0x2a, 0xe5,
+	0xc4, 0xde, 0x40, 0x0c, 0x99, 0xb2, 0x32, 0x50, 0x0b, 0x22, 0x13, 0x2f, 0x7b, 0x51, 0x74, 0x2c,
+	0xbc, 0xe6, 0xf6, 0x7e, 0x1c, 0xbd, 0x4f, 0x60, 0x9f, 0xd8, 0x61, 0x6d, 0xd7, 0x66, 0xc7, 0x27,
+	0x61, 0xd0, 0x7d, 0x5c, 0x40, 0x3d, 0x67, 0x0f, 0x05, 0x08, 0x6e, 0xf5, 0xa3, 0x92, 0x15, 0x74,
+	0x9d, 0x2c, 0x2c, 0xd1, 0x9b, 0x6f, 0xc5, 0x61, 0x7f, 0x80, 0xed, 0x3c, 0xfe, 0xaf, 0x12, 0x18,
+	0x76, 0x75, 0x88, 0xbd, 0x9b, 0x6b, 0x3e, 0x4c, 0xd7, 0xb9, 0x06, 0x04, 0xf7, 0x54, 0xca, 0x89,
+	0xfd, 0x3e, 0xfd, 0x67, 0x21, 0x97, 0x0c, 0xa5, 0xfd, 0x04, 0xe0, 0x1b, 0x04, 0x86, 0x84, 0x85,
+	0x09, 0x88, 0x64, 0xad, 0x98, 0xd9, 0xc6, 0xad, 0x84, 0x1a, 0x6b, 0x8e, 0x54, 0xca, 0x89, 0x89,
+	0x9a, 0xa6, 0x82, 0x23, 0x5a, 0xec, 0x02, 0x0d, 0x1a, 0xb5, 0x72, 0x4c, 0x7c, 0xc2, 0x0b, 0xcf,
+	0x68, 0x6e, 0xa9, 0xc9, 0x73, 0xff, 0x0c, 0x02, 0x95, 0x9d, 0xea, 0x96, 0xfc, 0x53, 0xdd, 0x74,
+	0x34, 0xb5, 0x9e, 0x6c, 0x17, 0x78, 0xc0, 0x10, 0xbb, 0x4b, 0x07, 0x0c, 0xcf, 0xc3, 0xb8, 0xaf,
+	0xa1, 0xed, 0x48, 0x7e, 0xbf, 0x8f, 0xc1, 0x3d, 0x75, 0x94, 0x71, 0xfc, 0xbf, 0x4e, 0x60, 0x8f,
+	0x3f, 0x42, 0xed, 0x14, 0xd8, 0xdc, 0x06, 0x90, 0x2b, 0xe5, 0xc4, 0x58, 0xbd, 0x0d, 0x60, 0xca,
+	0xca, 0xb0, 0xef, 0x0e, 0x30, 0x51, 0xf1, 0x82, 0xed, 0xfe, 0x48, 0x26, 0xb4, 0x37, 0x1d, 0x6e,
+	0xc1, 0x9c, 0xcf, 0x4e, 0x33, 0xcf, 0xeb, 0xc6, 0xdd, 0x48, 0x92, 0xf2, 0xbf, 0xe2, 0x30, 0x1f,
+	0x4d, 0x3f, 0x0f, 0xf4, 0x57, 0x02, 0xf3, 0x0a, 0x69, 0x3a, 0xaf, 0x08, 0x9b, 0xc0, 0x57, 0x74,
+	0x50, 0x36, 0xb9, 0x0a, 0x7b, 0xfd, 0x41, 0x41, 0x3f, 0xb2, 0xf9, 0xeb, 0xf4, 0x44, 0xa5, 0x9c,
+	0x90, 0xeb, 0x21, 0x88, 0x12, 0xcb, 0xca, 0xa8, 0x2f, 0x8a, 0xac, 0x6f, 0xf3, 0x3a, 0x7a, 0x84,
+	0x23, 0xf6, 0xc6, 0x7a, 0x58, 0x7b, 0xcf, 0x5f, 0x0f, 0xed, 0xf6, 0x69, 0x5e, 0xc0, 0x5e, 0x8c,
+	0xe0, 0xcc, 0x46, 0xd0, 0x71, 0x92, 0xe6, 0x4d, 0x90, 0x7c, 0xf8, 0x5b, 0x5d, 0x86, 0xed, 0x56,
+	0x64, 0xcc, 0x69, 0x45, 0x5a, 0xe9, 0x7a, 0xaf, 0xaf, 0x6a, 0x0e, 0xae, 0x2f, 0x13, 0x18, 0xf4,
+	0x43, 0x00, 0xcf, 0xda, 0xcd, 0x60, 0x4b, 0xa8, 0xf7, 0x7e, 0x92, 0x65, 0x65, 0xc0, 0x07, 0x5a,
+	0x78, 0xc9, 0x1b, 0x89, 0x28, 0xaa, 0x6b, 0x1c, 0xfe, 0x09, 0x01, 0x29, 0xd8, 0x44, 0x7c, 0xd2,
+	0xbf, 0x46, 0x4d, 0x45, 0x51, 0xe9, 0xa9, 0x50, 0x01, 0xa7, 0x08, 0xb1, 0xb6, 0x9f, 0x22, 0x5c,
+	0x83, 0x31, 0x3f, 0x6c, 0xb6, 0xa1, 0x2e, 0x7d, 0x10, 0x83, 0x44, 0xa0, 0xaa, 0xff, 0xc2, 0x64,
+	0x75, 0xc5, 0x0b, 0xa9, 0xe3, 0x51, 0x36, 0x77, 0x5b, 0x6b, 0xd1, 0x08, 0x0c, 0x5f, 0x5e, 0xba,
+	0xa4, 0xa7, 0xd5, 0x92, 0x6e, 0xb8, 0xc7, 0x95, 0xdf, 0x21, 0xb0, 0xa7, 0xe6, 0x11, 0x77, 0xee,
+	0x23, 0x9e, 0x91, 0xe5, 0xc0, 0xef, 0x3c, 0x8f, 0x00, 0xcf, 0xec, 0x72, 0xf8, 0x33, 0x6d, 0x7f,
+	0x1b, 0x9d, 0x6d, 0x76, 0x0a, 0xfa, 0xab, 0x24, 0xc2, 0x98, 0x00, 0xeb, 0xa4, 0x11, 0x71, 0x16,
+	0xcf, 0x2f, 0x37, 0xfd, 0xc5, 0x6a, 0xba, 0x3b, 0xec, 0x7c, 0x91, 0x0f, 0xc3, 0x8e, 0x1c, 0xbb,
+	0xd5, 0xe8, 0x23, 0xf9, 0x32, 0x9d, 0x00, 0x5f, 0x2a, 0xe9, 0x86, 0x66, 0x0b, 0xb1, 0x59, 0xf1,
+	0x12, 0x74, 0xf1, 0x3f, 0xed, 0xb3, 0xd6, 0x08, 0x62, 0xec, 0x53, 0x12, 0x5b, 0x42, 0x94, 0x7e,
+	0xbe, 0xc7, 0x1d, 0x8e, 0xaf, 0x0c, 0x21, 0xe4, 0xe6, 0xc2, 0xc6, 0xd3, 0xca, 0xa2, 0xed, 0xb1,
+	0x7e, 0x88, 0xaf, 0x1b, 0x59, 0xee, 0x2f, 0xeb, 0xcf, 0x96, 0xed, 0xd8, 0x7f, 0x8b, 0x60, 0xb2,
+	0x95, 0x72, 0x3f, 0x8b, 0x1e, 0x22, 0x77, 0xec, 0xa1, 0x26, 0x30, 0xe5, 0x72, 0x42, 0x1b, 0xf6,
+	0xd8, 0x63, 0x30, 0x22, 0xea, 0xba, 0x93, 0x39, 0x7b, 0xf9, 0x27, 0x04, 0x46, 0x7d, 0x84, 0xb5,
+	0xc5, 0x95, 0x8f, 0x79, 0x5d, 0x79, 0x5f, 0x18, 0x57, 0xfa, 0x4f, 0x73, 0xff, 0x3f, 0x0c, 0x5e,
+	0x5e, 0x3a, 0x9b, 0xcb, 0xd9, 0x74, 0xad, 0x2e, 0x09, 0x9f, 0x11, 0x18, 0xf2, 0x28, 0x68, 0x8b,
+	0x4f, 0xc2, 0x1f, 0x53, 0xfa, 0x2d, 0xb7, 0xf5, 0xe0, 0x9a, 0xfd, 0x74, 0x0a, 0x3a, 0xe8, 0x2f,
+	0x3b, 0xac, 0x8a, 0xd7, 0xc9, 0xd2, 0x23, 0x46, 0xf8, 0x0d, 0x88, 0x34, 0x15, 0x8a, 0x96, 0x69,
+	0x96, 0x27, 0x5e, 0xfe, 0xdd, 0xc7, 0x6f, 0xc4, 0xc6, 0x71, 0x2c, 0x15, 0xf0, 0x63, 0x18, 0x9e,
+	0xd9, 0x3f, 0x23, 0xd0, 0xc1, 0xe6, 0x63, 0x42, 0x4d, 0xfd, 0x4b, 0x07, 0x1b, 0x50, 0x71, 0xf5,
+	0xdf, 0x26, 0x54, 0xff, 0xd7, 0xc9, 0xf2, 0x71, 0x9c, 0x0f, 0x32, 0x81, 0xcf, 0xa0, 0xa4, 0x36,
+	0xc5, 0x9f, 0x9c, 0x6c, 0xb1, 0x9f, 0xfd, 0x2c, 0xcf, 0xe3, 0x6c, 0x10, 0x1f, 0x2b, 0xdd, 0xa9,
+	0x4d, 0xe1, 0x44, 0x9e, 0x73, 0xe1, 0x64, 0xaa, 0xde, 0x6f, 0x89, 0x52, 0x9b, 0xf6, 0x46, 0xdd,
+	0xc2, 0x57, 0x08, 0xec, 0xac, 0x4e, 0xb0, 0x63, 0xe8, 0x21, 0x77, 0xe9, 0x70, 0x08, 0x4a, 0xee,
+	0x84, 0x23, 0xd4, 0x07, 0x07, 0x50, 0xae, 0x6b, 0x94, 0x99, 0x52, 0x73, 0x39, 0x7c, 0x09, 0x3a,
+	0x99, 0x00, 0x0c, 0x37, 0x0e, 0x2d, 0x4d, 0x34, 0x22, 0x0b, 0x0b, 0x04, 0x66, 0x04, 0xbe, 0x12,
+	0x87, 0xae, 0xea, 0xef, 0x6c, 0xc2, 0x0e, 0xa2, 0x4a, 0x93, 0x8d, 0x09, 0xb9, 0x1d, 0x3f, 0x8c,
+	0x51, 0x43, 0xde, 0x8e, 0x2d, 0xcf, 0xe1, 0x4c, 0xd8, 0x28, 0xd9, 0x10, 0x31, 0x97, 0x4f, 0xe3,
+	0x83, 0x51, 0x99, 0x1c, 0x5c, 0x65, 0x33, 0x5b, 0xf5, 0x70, 0xe8, 0x8f, 0x27, 0xc6, 0xbb, 0x7c,
+	0x01, 0x1f, 0x09, 0xad, 0xd8, 0x23, 0xa8, 0xa0, 0xe6, 0xb5, 0xaa, 0x20, 0x3c, 0x1a, 0x7a, 0x1b,
+	0x58, 0xf0, 0x7c, 0x93, 0x40, 0xb7, 0x30, 0xbe, 0x89, 0x11, 0x66, 0x3c, 0xa5, 0xa9, 0x50, 0xb4,
+	0x3c, 0x2e, 0x47, 0x69, 0x58, 0x26, 0xf0, 0x40, 0x03, 0xf3, 0x18, 0x4c, 0xdf, 0x21, 0xd0, 0xe7,
+	0x19, 0x5b, 0xc4, 0x88, 0xf3, 0x8d, 0x52, 0x2a, 0x34, 0x3d, 0x37, 0xf1, 0x38, 0x35, 0xf1, 0x3e,
+	0x4c, 0x36, 0x34, 0x91, 0x0e, 0x98, 0xa6, 0x36, 0xe9, 0x3f, 0x5b, 0xf8, 0xea, 0x76, 0xd8, 0xc1,
+	0x47, 0x8a, 0x30, 0xe4, 0x50, 0x9a, 0x74, 0xa8, 0x21, 0x1d, 0x37, 0xea, 0x47, 0x71, 0x6a, 0xd5,
+	0x3b, 0xf1, 0xe5, 0x59, 0xbc, 0x2f, 0x22, 0x42, 0xcc, 0xe5, 0xfb, 0xf1, 0x78, 0x64, 0x54, 0x51,
+	0x38, 0x45, 0xc2, 0xa3, 0x1f, 0xb2, 0xaa, 0x26, 0x3c, 0x8e, 0x17, 0x5b, 0x21, 0xc8, 0xb6, 0x2b,
+	0x4a, 0x9e, 0x17, 0xcd, 0x38, 0x85, 0x27, 0x9b, 0xe0, 0xe3, 0x5a, 0x83, 0x37, 0x95, 0xdf, 0x9e,
+	0xc6, 0xd7, 0x08, 0x80, 0x33, 0x63, 0x86, 0xe1, 0xe7, 0xd0, 0xa4, 0x23, 0x61, 0x48, 0x39, 0x32,
+	0xa6, 0x28, 0x30, 0x0e, 0xe2, 0xbd, 0xf5, 0x6d, 0x63, 0x1b, 0xea, 0xaf, 0x04, 0x7a, 0x5c, 0x73,
+	0x52, 0x18, 0x69, 0x9c, 0x4a, 0x9a, 0x0e, 0x49, 0xcd, 0x6d, 0xfb, 0x3c, 0xb5, 0xed, 0xe6, 0xf2,
+	0x19, 0x7c, 0xa8, 0x39, 0xfc, 0xa5, 0xae, 0x71, 0x33, 0xa3, 0x65, 0x53, 0x9b, 0xeb, 0x6b, 0x04,
+	0x76, 0x56, 0xa7, 0x62, 0x30, 0xf4, 0x8c, 0x93, 0x74, 0x38, 0x04, 0x25, 0x5f, 0xe2, 0x1c, 0x5d,
+	0xe2, 0x34, 0x4e, 0x05, 0x19, 0xa8, 0xdb, 0x2c, 0xa9, 0x4d, 0x3e, 0x47, 0xb2, 0x85, 0xdf, 0x27,
+	0xd0, 0xeb, 0x1e, 0xd9, 0xc1, 0x68, 0xa3, 0x3d, 0x52, 0x32, 0x2c, 0x39, 0x37, 0xf3, 0x7e, 0x6a,
+	0x66, 0x9d, 0xdc, 0x41, 0xe7, 0x46, 0xfc, 0x6c, 0xfd, 0x98, 0xc0, 0xa0, 0xdf, 0x7c, 0x08, 0x36,
+	0x33, 0x4d, 0x22, 0xcd, 0x47, 0x63, 0xe2, 0xd6, 0xab, 0xd4, 0xfa, 0xe7, 0x96, 0xeb, 0xbc, 0xdc,
+	0x50, 0xfb, 0x4b, 0xcc, 0xb0, 0xd0, 0x58, 0x13, 0x98, 0x7e, 0x60, 0xff, 0xf0, 0xac, 0x3a, 0xfb,
+	0x81, 0xd1, 0x66, 0x44, 0xa4, 0x64, 0x58, 0x72, 0xbe, 0xa8, 0x93, 0x74, 0x51, 0x75, 0x5e, 0x3c,
+	0x6b, 0xf3, 0xa2, 0x9a, 0xd3, 0xd8, 0xa4, 0x4b, 0x85, 0xc0, 0x78, 0xa3, 0xb9, 0x0a, 0xbc, 0xd3,
+	0x89, 0x0c, 0xe9, 0x4c, 0xf3, 0x02, 0xf8, 0x1a, 0x2f, 0xd2, 0x35, 0x3e, 0x82, 0xe7, 0x42, 0xaf,
+	0x31, 0x4f, 0x65, 0x58, 0xb7, 0x3c, 0xfd, 0xe4, 0x2d, 0x7c, 0x9f, 0x00, 0xd6, 0x2a, 0xc5, 0xe8,
+	0x43, 0x11, 0xd2, 0x6c, 0x14, 0x16, 0xbe, 0x94, 0x53, 0x74, 0x29, 0xf5, 0xea, 0x8e, 0xc5, 0x6b,
+	0x59, 0xed, 0x67, 0xfb, 0x7b, 0xd5, 0x99, 0x27, 0x6f, 0x0f, 0x0f, 0x9b, 0x3b, 0x8e, 0x97, 0x8e,
+	0x47, 0x65, 0xe3, 0xeb, 0x48, 0xd2, 0x75, 0x4c, 0xe2, 0x44, 0xc3, 0x75, 0xb0, 0x92, 0xf1, 0x2b,
+	0x02, 0x43, 0xbe, 0x87, 0x08, 0xd8, 0xd4, 0x41, 0xad, 0x74, 0x2c, 0x22, 0x17, 0x37, 0xfb, 0x34,
+	0x35, 0xfb, 0x01, 0x3c, 0x11, 0x64, 0xb6, 0x7d, 0x86, 0x12, 0x14, 0x81, 0x5f, 0x12, 0x18, 0x0d,
+	0x3c, 0xd4, 0xc3, 0xa6, 0xcf, 0x01, 0xa5, 0x07, 0x9a, 0xe0, 0xe4, 0x6b, 0x9a, 0xa1, 0x6b, 0x9a,
+	0xc2, 0xc3, 0x61, 0xd6, 0xc4, 0xa2, 0xf1, 0x56, 0x0c, 0x8e, 0x46, 0x39, 0xe9, 0xc1, 0x56, 0x9e,
+	0x17, 0x49, 0x97, 0x5a, 0x23, 0x2c, 0x6c, 0x72, 0x68, 0x10, 0x52, 0xfb, 0xcd, 0xc6, 0x72, 0x0e,
+	0xbe, 0x12, 0x83, 0x01, 0x1f, 0x2b, 0xb0, 0x89, 0x53, 0x1a, 0x69, 0x2e, 0x12, 0x0f, 0x5f, 0xcd,
+	0x57, 0x59, 0x0f, 0xe2, 0x8b, 0x64, 0xf9, 0x22, 0x2e, 0xde, 0xf9, 0x8a, 0xec, 0x57, 0xce, 0x63,
+	0x0d, 0x5e, 0xeb, 0x02, 0xd0, 0xfe, 0x53, 0x02, 0x7b, 0x02, 0x0e, 0x0d, 0xb0, 0xc9, 0x53, 0x06,
+	0xe9, 0x44, 0x64, 0x3e, 0xee, 0x9a, 0x14, 0xf5, 0xcc, 0x61, 0x3c, 0xd4, 0x78, 0x2d, 0x0c, 0xe5,
+	0xdf, 0x25, 0xd0, 0xe7, 0x69, 0xed, 0x63, 0xc4, 0x33, 0x00, 0x29, 0x15, 0x9a, 0x3e, 0x6c, 0x62,
+	0xe4, 0xcd, 0x3e, 0xbb, 0x97, 0xf5, 0xba, 0xf5, 0x72, 0x69, 0xcb, 0xc2, 0xd0, 0x0d, 0x77, 0xe9,
+	0x70, 0x08, 0xca, 0xb0, 0x8e, 0xb3, 0x4d, 0xda, 0xa4, 0x6f, 0x6e, 0x5b, 0xf8, 0xb6, 0xe8, 0x38,
+	0xd6, 0xbf, 0xc6, 0x88, 0x8d, 0x6e, 0x29, 0x15, 0x9a, 0x3e, 0x6c, 0x1a, 0xb3, 0xad, 0x5c, 0x37,
+	0xb2, 0xa9, 0xcd, 0x75, 0x23, 0xbb, 0x85, 0x3f, 0x16, 0x4f, 0x56, 0xec, 0xe6, 0x30, 0x46, 0xee,
+	0x23, 0x4b, 0x33, 0x11, 0x38, 0xc2, 0xbe, 0x09, 0xdb, 0xd6, 0xd6, 0xf4, 0xf0, 0xbe, 0x49, 0xa0,
+	0xc7, 0xd5, 0xbd, 0xc5, 0x48, 0x4d, 0x5e, 0x69, 0x3a, 0x24, 0x75, 0xd8, 0x56, 0x09, 0x37, 0x94,
+	0x6e, 0x99, 0x85, 0xeb, 0x1f, 0xdc, 0x1a, 0x23, 0x1f, 0xde, 0x1a, 0x23, 0x7f, 0xbe, 0x35, 0x46,
+	0x5e, 0xbb, 0x3d, 0xb6, 0xed, 0xc3, 0xdb, 0x63, 0xdb, 0x3e, 0xba, 0x3d, 0xb6, 0x0d, 0x46, 0xb3,
+	0x7a, 0x80, 0xe2, 0x2b, 0x64, 0x79, 0x5e, 0x18, 0x46, 0x77, 0x88, 0xa6, 0xb3, 0xba, 0xa8, 0xf4,
+	0xa6, 0xa3, 0x96, 0x8e, 0xa7, 0xaf, 0x76, 0xd2, 0xff, 0xcf, 0x68, 0xee, 0x3f, 0x03, 0x00, 0xf3,
+	0x2f, 0x5a, 0x68, 0x0e, 0x4a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -3120,6 +4017,12 @@ type QueryClient interface {
 	Scope(ctx context.Context, in *ScopeRequest, opts ...grpc.CallOption) (*ScopeResponse, error)
 	// ScopesAll retrieves all scopes.
 	ScopesAll(ctx context.Context, in *ScopesAllRequest, opts ...grpc.CallOption) (*ScopesAllResponse, error)
+	// Scopes searches for scopes matching all of the provided filters.
+	//
+	// The specification_id can either be a scope specification uuid or a bech32 scope specification address.
+	// The owner_role can only be provided with an owner, and limits the owner filter to parties with that role.
+	// If no filters are provided, all scopes are returned.
+	Scopes(ctx context.Context, in *ScopesRequest, opts ...grpc.CallOption) (*ScopesResponse, error)
 	// Sessions searches for sessions.
 	//
 	// The scope_id can either be scope uuid, e.g. 91978ba2-5f35-459a-86a7-feca1b0512e0 or a scope address, e.g.
@@ -3150,6 +4053,10 @@ type QueryClient interface {
 	Sessions(ctx context.Context, in *SessionsRequest, opts ...grpc.CallOption) (*SessionsResponse, error)
 	// SessionsAll retrieves all sessions.
 	SessionsAll(ctx context.Context, in *SessionsAllRequest, opts ...grpc.CallOption) (*SessionsAllResponse, error)
+	// SessionsByParty retrieves the sessions that an address is a party to.
+	//
+	// The role can be provided to limit the results to sessions where the party has that role.
+	SessionsByParty(ctx context.Context, in *SessionsByPartyRequest, opts ...grpc.CallOption) (*SessionsByPartyResponse, error)
 	// Records searches for records.
 	//
 	// The record_addr, if provided, must be a bech32 record address, e.g.
@@ -3175,10 +4082,29 @@ type QueryClient interface {
 	Records(ctx context.Context, in *RecordsRequest, opts ...grpc.CallOption) (*RecordsResponse, error)
 	// RecordsAll retrieves all records.
 	RecordsAll(ctx context.Context, in *RecordsAllRequest, opts ...grpc.CallOption) (*RecordsAllResponse, error)
+	// RecordHistory retrieves the retained versions of a record, oldest first.
+	//
+	// Versions are only retained while the max_record_versions param is greater than zero.
+	RecordHistory(ctx context.Context, in *RecordHistoryRequest, opts ...grpc.CallOption) (*RecordHistoryResponse, error)
 	// Ownership returns the scope identifiers that list the given address as either a data or value owner.
 	Ownership(ctx context.Context, in *OwnershipRequest, opts ...grpc.CallOption) (*OwnershipResponse, error)
 	// ValueOwnership returns the scope identifiers that list the given address as the value owner.
 	ValueOwnership(ctx context.Context, in *ValueOwnershipRequest, opts ...grpc.CallOption) (*ValueOwnershipResponse, error)
+	// ScopeValueOwnerToken returns the value owner token of a scope.
+	//
+	// Either a scope_id or a denom must be provided. The scope_id can either be scope uuid, e.g.
+	// 91978ba2-5f35-459a-86a7-feca1b0512e0 or a scope address, e.g. scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel. The denom
+	// is the denom of the value owner token, e.g. scopevalue/scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel.
+	ScopeValueOwnerToken(ctx context.Context, in *ScopeValueOwnerTokenRequest, opts ...grpc.CallOption) (*ScopeValueOwnerTokenResponse, error)
+	// ScopeSaleOffer returns the open offer to sell the value ownership of a scope.
+	//
+	// The scope_id can either be scope uuid, e.g. 91978ba2-5f35-459a-86a7-feca1b0512e0 or a scope address, e.g.
+	// scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel.
+	ScopeSaleOffer(ctx context.Context, in *ScopeSaleOfferRequest, opts ...grpc.CallOption) (*ScopeSaleOfferResponse, error)
+	// CheckScopeSpecificationMigration is a dry run of migrating a scope to a different scope specification.
+	// It reports all the reasons the scope's owners, sessions and records are not compatible with the target specification.
+	// Signatures are not checked.
+	CheckScopeSpecificationMigration(ctx context.Context, in *CheckScopeSpecificationMigrationRequest, opts ...grpc.CallOption) (*CheckScopeSpecificationMigrationResponse, error)
 	// ScopeSpecification returns a scope specification for the given specification id.
 	//
 	// The specification_id can either be a uuid, e.g. dc83ea70-eacd-40fe-9adf-1cf6148bf8a2 or a bech32 scope
@@ -3215,7 +4141,7 @@ type QueryClient interface {
 	OSLocator(ctx context.Context, in *OSLocatorRequest, opts ...grpc.CallOption) (*OSLocatorResponse, error)
 	// OSLocatorsByURI returns all ObjectStoreLocator entries for a locator uri.
 	OSLocatorsByURI(ctx context.Context, in *OSLocatorsByURIRequest, opts ...grpc.CallOption) (*OSLocatorsByURIResponse, error)
-	// OSLocatorsByScope returns all ObjectStoreLocator entries for a for all signer's present in the specified scope.
+	// OSLocatorsByScope returns all active ObjectStoreLocator entries for all owners present in the specified scope.
 	OSLocatorsByScope(ctx context.Context, in *OSLocatorsByScopeRequest, opts ...grpc.CallOption) (*OSLocatorsByScopeResponse, error)
 	// OSAllLocators returns all ObjectStoreLocator entries.
 	OSAllLocators(ctx context.Context, in *OSAllLocatorsRequest, opts ...grpc.CallOption) (*OSAllLocatorsResponse, error)
@@ -3256,6 +4182,15 @@ func (c *queryClient) ScopesAll(ctx context.Context, in *ScopesAllRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) Scopes(ctx context.Context, in *ScopesRequest, opts ...grpc.CallOption) (*ScopesResponse, error) {
+	out := new(ScopesResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/Scopes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Sessions(ctx context.Context, in *SessionsRequest, opts ...grpc.CallOption) (*SessionsResponse, error) {
 	out := new(SessionsResponse)
 	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/Sessions", in, out, opts...)
@@ -3274,6 +4209,15 @@ func (c *queryClient) SessionsAll(ctx context.Context, in *SessionsAllRequest, o
 	return out, nil
 }
 
+func (c *queryClient) SessionsByParty(ctx context.Context, in *SessionsByPartyRequest, opts ...grpc.CallOption) (*SessionsByPartyResponse, error) {
+	out := new(SessionsByPartyResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/SessionsByParty", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Records(ctx context.Context, in *RecordsRequest, opts ...grpc.CallOption) (*RecordsResponse, error) {
 	out := new(RecordsResponse)
 	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/Records", in, out, opts...)
@@ -3292,17 +4236,26 @@ func (c *queryClient) RecordsAll(ctx context.Context, in *RecordsAllRequest, opt
 	return out, nil
 }
 
-func (c *queryClient) Ownership(ctx context.Context, in *OwnershipRequest, opts ...grpc.CallOption) (*OwnershipResponse, error) {
-	out := new(OwnershipResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/Ownership", in, out, opts...)
+func (c *queryClient) RecordHistory(ctx context.Context, in *RecordHistoryRequest, opts ...grpc.CallOption) (*RecordHistoryResponse, error) {
+	out := new(RecordHistoryResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/RecordHistory", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *queryClient) ValueOwnership(ctx context.Context, in *ValueOwnershipRequest, opts ...grpc.CallOption) (*ValueOwnershipResponse, error) {
-	out := new(ValueOwnershipResponse)
+func (c *queryClient) Ownership(ctx context.Context, in *OwnershipRequest, opts ...grpc.CallOption) (*OwnershipResponse, error) {
+	out := new(OwnershipResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/Ownership", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValueOwnership(ctx context.Context, in *ValueOwnershipRequest, opts ...grpc.CallOption) (*ValueOwnershipResponse, error) {
+	out := new(ValueOwnershipResponse)
 	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/ValueOwnership", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -3310,6 +4263,33 @@ func (c *queryClient) ValueOwnership(ctx context.Context, in *ValueOwnershipRequ
 	return out, nil
 }
 
+func (c *queryClient) ScopeValueOwnerToken(ctx context.Context, in *ScopeValueOwnerTokenRequest, opts ...grpc.CallOption) (*ScopeValueOwnerTokenResponse, error) {
+	out := new(ScopeValueOwnerTokenResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/ScopeValueOwnerToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ScopeSaleOffer(ctx context.Context, in *ScopeSaleOfferRequest, opts ...grpc.CallOption) (*ScopeSaleOfferResponse, error) {
+	out := new(ScopeSaleOfferResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/ScopeSaleOffer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CheckScopeSpecificationMigration(ctx context.Context, in *CheckScopeSpecificationMigrationRequest, opts ...grpc.CallOption) (*CheckScopeSpecificationMigrationResponse, error) {
+	out := new(CheckScopeSpecificationMigrationResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/CheckScopeSpecificationMigration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) ScopeSpecification(ctx context.Context, in *ScopeSpecificationRequest, opts ...grpc.CallOption) (*ScopeSpecificationResponse, error) {
 	out := new(ScopeSpecificationResponse)
 	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/ScopeSpecification", in, out, opts...)
@@ -3443,6 +4423,12 @@ type QueryServer interface {
 	Scope(context.Context, *ScopeRequest) (*ScopeResponse, error)
 	// ScopesAll retrieves all scopes.
 	ScopesAll(context.Context, *ScopesAllRequest) (*ScopesAllResponse, error)
+	// Scopes searches for scopes matching all of the provided filters.
+	//
+	// The specification_id can either be a scope specification uuid or a bech32 scope specification address.
+	// The owner_role can only be provided with an owner, and limits the owner filter to parties with that role.
+	// If no filters are provided, all scopes are returned.
+	Scopes(context.Context, *ScopesRequest) (*ScopesResponse, error)
 	// Sessions searches for sessions.
 	//
 	// The scope_id can either be scope uuid, e.g. 91978ba2-5f35-459a-86a7-feca1b0512e0 or a scope address, e.g.
@@ -3473,6 +4459,10 @@ type QueryServer interface {
 	Sessions(context.Context, *SessionsRequest) (*SessionsResponse, error)
 	// SessionsAll retrieves all sessions.
 	SessionsAll(context.Context, *SessionsAllRequest) (*SessionsAllResponse, error)
+	// SessionsByParty retrieves the sessions that an address is a party to.
+	//
+	// The role can be provided to limit the results to sessions where the party has that role.
+	SessionsByParty(context.Context, *SessionsByPartyRequest) (*SessionsByPartyResponse, error)
 	// Records searches for records.
 	//
 	// The record_addr, if provided, must be a bech32 record address, e.g.
@@ -3498,10 +4488,29 @@ type QueryServer interface {
 	Records(context.Context, *RecordsRequest) (*RecordsResponse, error)
 	// RecordsAll retrieves all records.
 	RecordsAll(context.Context, *RecordsAllRequest) (*RecordsAllResponse, error)
+	// RecordHistory retrieves the retained versions of a record, oldest first.
+	//
+	// Versions are only retained while the max_record_versions param is greater than zero.
+	RecordHistory(context.Context, *RecordHistoryRequest) (*RecordHistoryResponse, error)
 	// Ownership returns the scope identifiers that list the given address as either a data or value owner.
 	Ownership(context.Context, *OwnershipRequest) (*OwnershipResponse, error)
 	// ValueOwnership returns the scope identifiers that list the given address as the value owner.
 	ValueOwnership(context.Context, *ValueOwnershipRequest) (*ValueOwnershipResponse, error)
+	// ScopeValueOwnerToken returns the value owner token of a scope.
+	//
+	// Either a scope_id or a denom must be provided. The scope_id can either be scope uuid, e.g.
+	// 91978ba2-5f35-459a-86a7-feca1b0512e0 or a scope address, e.g. scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel. The denom
+	// is the denom of the value owner token, e.g. scopevalue/scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel.
+	ScopeValueOwnerToken(context.Context, *ScopeValueOwnerTokenRequest) (*ScopeValueOwnerTokenResponse, error)
+	// ScopeSaleOffer returns the open offer to sell the value ownership of a scope.
+	//
+	// The scope_id can either be scope uuid, e.g. 91978ba2-5f35-459a-86a7-feca1b0512e0 or a scope address, e.g.
+	// scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel.
+	ScopeSaleOffer(context.Context, *ScopeSaleOfferRequest) (*ScopeSaleOfferResponse, error)
+	// CheckScopeSpecificationMigration is a dry run of migrating a scope to a different scope specification.
+	// It reports all the reasons the scope's owners, sessions and records are not compatible with the target specification.
+	// Signatures are not checked.
+	CheckScopeSpecificationMigration(context.Context, *CheckScopeSpecificationMigrationRequest) (*CheckScopeSpecificationMigrationResponse, error)
 	// ScopeSpecification returns a scope specification for the given specification id.
 	//
 	// The specification_id can either be a uuid, e.g. dc83ea70-eacd-40fe-9adf-1cf6148bf8a2 or a bech32 scope
@@ -3538,7 +4547,7 @@ type QueryServer interface {
 	OSLocator(context.Context, *OSLocatorRequest) (*OSLocatorResponse, error)
 	// OSLocatorsByURI returns all ObjectStoreLocator entries for a locator uri.
 	OSLocatorsByURI(context.Context, *OSLocatorsByURIRequest) (*OSLocatorsByURIResponse, error)
-	// OSLocatorsByScope returns all ObjectStoreLocator entries for a for all signer's present in the specified scope.
+	// OSLocatorsByScope returns all active ObjectStoreLocator entries for all owners present in the specified scope.
 	OSLocatorsByScope(context.Context, *OSLocatorsByScopeRequest) (*OSLocatorsByScopeResponse, error)
 	// OSAllLocators returns all ObjectStoreLocator entries.
 	OSAllLocators(context.Context, *OSAllLocatorsRequest) (*OSAllLocatorsResponse, error)
@@ -3557,24 +4566,42 @@ func (*UnimplementedQueryServer) Scope(ctx context.Context, req *ScopeRequest) (
 func (*UnimplementedQueryServer) ScopesAll(ctx context.Context, req *ScopesAllRequest) (*ScopesAllResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ScopesAll not implemented")
 }
+func (*UnimplementedQueryServer) Scopes(ctx context.Context, req *ScopesRequest) (*ScopesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Scopes not implemented")
+}
 func (*UnimplementedQueryServer) Sessions(ctx context.Context, req *SessionsRequest) (*SessionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Sessions not implemented")
 }
 func (*UnimplementedQueryServer) SessionsAll(ctx context.Context, req *SessionsAllRequest) (*SessionsAllResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SessionsAll not implemented")
 }
+func (*UnimplementedQueryServer) SessionsByParty(ctx context.Context, req *SessionsByPartyRequest) (*SessionsByPartyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SessionsByParty not implemented")
+}
 func (*UnimplementedQueryServer) Records(ctx context.Context, req *RecordsRequest) (*RecordsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Records not implemented")
 }
 func (*UnimplementedQueryServer) RecordsAll(ctx context.Context, req *RecordsAllRequest) (*RecordsAllResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RecordsAll not implemented")
 }
+func (*UnimplementedQueryServer) RecordHistory(ctx context.Context, req *RecordHistoryRequest) (*RecordHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordHistory not implemented")
+}
 func (*UnimplementedQueryServer) Ownership(ctx context.Context, req *OwnershipRequest) (*OwnershipResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Ownership not implemented")
 }
 func (*UnimplementedQueryServer) ValueOwnership(ctx context.Context, req *ValueOwnershipRequest) (*ValueOwnershipResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ValueOwnership not implemented")
 }
+func (*UnimplementedQueryServer) ScopeValueOwnerToken(ctx context.Context, req *ScopeValueOwnerTokenRequest) (*ScopeValueOwnerTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScopeValueOwnerToken not implemented")
+}
+func (*UnimplementedQueryServer) ScopeSaleOffer(ctx context.Context, req *ScopeSaleOfferRequest) (*ScopeSaleOfferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScopeSaleOffer not implemented")
+}
+func (*UnimplementedQueryServer) CheckScopeSpecificationMigration(ctx context.Context, req *CheckScopeSpecificationMigrationRequest) (*CheckScopeSpecificationMigrationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckScopeSpecificationMigration not implemented")
+}
 func (*UnimplementedQueryServer) ScopeSpecification(ctx context.Context, req *ScopeSpecificationRequest) (*ScopeSpecificationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ScopeSpecification not implemented")
 }
@@ -3670,6 +4697,24 @@ func _Query_ScopesAll_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Scopes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScopesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Scopes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/Scopes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Scopes(ctx, req.(*ScopesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Sessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SessionsRequest)
 	if err := dec(in); err != nil {
@@ -3706,6 +4751,24 @@ func _Query_SessionsAll_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SessionsByParty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SessionsByPartyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SessionsByParty(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/SessionsByParty",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SessionsByParty(ctx, req.(*SessionsByPartyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Records_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RecordsRequest)
 	if err := dec(in); err != nil {
@@ -3742,6 +4805,24 @@ func _Query_RecordsAll_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_RecordHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RecordHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/RecordHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RecordHistory(ctx, req.(*RecordHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Ownership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(OwnershipRequest)
 	if err := dec(in); err != nil {
@@ -3778,6 +4859,60 @@ func _Query_ValueOwnership_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ScopeValueOwnerToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScopeValueOwnerTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScopeValueOwnerToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/ScopeValueOwnerToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScopeValueOwnerToken(ctx, req.(*ScopeValueOwnerTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ScopeSaleOffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScopeSaleOfferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScopeSaleOffer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/ScopeSaleOffer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScopeSaleOffer(ctx, req.(*ScopeSaleOfferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CheckScopeSpecificationMigration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckScopeSpecificationMigrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CheckScopeSpecificationMigration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/CheckScopeSpecificationMigration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CheckScopeSpecificationMigration(ctx, req.(*CheckScopeSpecificationMigrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_ScopeSpecification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ScopeSpecificationRequest)
 	if err := dec(in); err != nil {
@@ -4010,6 +5145,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ScopesAll",
 			Handler:    _Query_ScopesAll_Handler,
 		},
+		{
+			MethodName: "Scopes",
+			Handler:    _Query_Scopes_Handler,
+		},
 		{
 			MethodName: "Sessions",
 			Handler:    _Query_Sessions_Handler,
@@ -4018,6 +5157,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SessionsAll",
 			Handler:    _Query_SessionsAll_Handler,
 		},
+		{
+			MethodName: "SessionsByParty",
+			Handler:    _Query_SessionsByParty_Handler,
+		},
 		{
 			MethodName: "Records",
 			Handler:    _Query_Records_Handler,
@@ -4026,6 +5169,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RecordsAll",
 			Handler:    _Query_RecordsAll_Handler,
 		},
+		{
+			MethodName: "RecordHistory",
+			Handler:    _Query_RecordHistory_Handler,
+		},
 		{
 			MethodName: "Ownership",
 			Handler:    _Query_Ownership_Handler,
@@ -4034,6 +5181,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ValueOwnership",
 			Handler:    _Query_ValueOwnership_Handler,
 		},
+		{
+			MethodName: "ScopeValueOwnerToken",
+			Handler:    _Query_ScopeValueOwnerToken_Handler,
+		},
+		{
+			MethodName: "ScopeSaleOffer",
+			Handler:    _Query_ScopeSaleOffer_Handler,
+		},
+		{
+			MethodName: "CheckScopeSpecificationMigration",
+			Handler:    _Query_CheckScopeSpecificationMigration_Handler,
+		},
 		{
 			MethodName: "ScopeSpecification",
 			Handler:    _Query_ScopeSpecification_Handler,
@@ -4459,6 +5618,141 @@ func (m *ScopesAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ScopesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScopesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScopesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.DataAccess) > 0 {
+		i -= len(m.DataAccess)
+		copy(dAtA[i:], m.DataAccess)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DataAccess)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ValueOwner) > 0 {
+		i -= len(m.ValueOwner)
+		copy(dAtA[i:], m.ValueOwner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValueOwner)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.OwnerRole != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OwnerRole))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SpecificationId) > 0 {
+		i -= len(m.SpecificationId)
+		copy(dAtA[i:], m.SpecificationId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SpecificationId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScopesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScopesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScopesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.Scopes) > 0 {
+		for iNdEx := len(m.Scopes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Scopes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *SessionsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4768,7 +6062,7 @@ func (m *SessionsAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *RecordsRequest) Marshal() (dAtA []byte, err error) {
+func (m *SessionsByPartyRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4778,20 +6072,134 @@ func (m *RecordsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *SessionsByPartyRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SessionsByPartyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.IncludeSessions {
-		i--
-		if m.IncludeSessions {
-			dAtA[i] = 1
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.Role != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Party) > 0 {
+		i -= len(m.Party)
+		copy(dAtA[i:], m.Party)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Party)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SessionsByPartyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SessionsByPartyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SessionsByPartyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.Sessions) > 0 {
+		for iNdEx := len(m.Sessions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sessions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RecordsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IncludeSessions {
+		i--
+		if m.IncludeSessions {
+			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
@@ -5077,7 +6485,7 @@ func (m *RecordsAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *OwnershipRequest) Marshal() (dAtA []byte, err error) {
+func (m *RecordHistoryRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5087,12 +6495,12 @@ func (m *OwnershipRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *OwnershipRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *RecordHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OwnershipRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RecordHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5111,17 +6519,31 @@ func (m *OwnershipRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x9a
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ScopeId) > 0 {
+		i -= len(m.ScopeId)
+		copy(dAtA[i:], m.ScopeId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ScopeId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RecordAddr) > 0 {
+		i -= len(m.RecordAddr)
+		copy(dAtA[i:], m.RecordAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RecordAddr)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *OwnershipResponse) Marshal() (dAtA []byte, err error) {
+func (m *RecordHistoryResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5131,12 +6553,12 @@ func (m *OwnershipResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *OwnershipResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *RecordHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OwnershipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RecordHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5169,11 +6591,16 @@ func (m *OwnershipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x92
 	}
-	if len(m.ScopeUuids) > 0 {
-		for iNdEx := len(m.ScopeUuids) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.ScopeUuids[iNdEx])
-			copy(dAtA[i:], m.ScopeUuids[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.ScopeUuids[iNdEx])))
+	if len(m.Versions) > 0 {
+		for iNdEx := len(m.Versions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Versions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
 			i--
 			dAtA[i] = 0xa
 		}
@@ -5181,7 +6608,7 @@ func (m *OwnershipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ValueOwnershipRequest) Marshal() (dAtA []byte, err error) {
+func (m *OwnershipRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5191,12 +6618,12 @@ func (m *ValueOwnershipRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ValueOwnershipRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *OwnershipRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ValueOwnershipRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *OwnershipRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5225,7 +6652,7 @@ func (m *ValueOwnershipRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ValueOwnershipResponse) Marshal() (dAtA []byte, err error) {
+func (m *OwnershipResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5235,12 +6662,12 @@ func (m *ValueOwnershipResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ValueOwnershipResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *OwnershipResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ValueOwnershipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *OwnershipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5285,7 +6712,7 @@ func (m *ValueOwnershipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *ScopeSpecificationRequest) Marshal() (dAtA []byte, err error) {
+func (m *ValueOwnershipRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5295,27 +6722,41 @@ func (m *ScopeSpecificationRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ScopeSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ValueOwnershipRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ScopeSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ValueOwnershipRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.SpecificationId) > 0 {
-		i -= len(m.SpecificationId)
-		copy(dAtA[i:], m.SpecificationId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.SpecificationId)))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ScopeSpecificationResponse) Marshal() (dAtA []byte, err error) {
+func (m *ValueOwnershipResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5325,19 +6766,19 @@ func (m *ScopeSpecificationResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ScopeSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ValueOwnershipResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ScopeSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ValueOwnershipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Request != nil {
+	if m.Pagination != nil {
 		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5347,11 +6788,11 @@ func (m *ScopeSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 		i--
 		dAtA[i] = 0x6
 		i--
-		dAtA[i] = 0x92
+		dAtA[i] = 0x9a
 	}
-	if m.ScopeSpecification != nil {
+	if m.Request != nil {
 		{
-			size, err := m.ScopeSpecification.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5359,12 +6800,32 @@ func (m *ScopeSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.TokenScopeUuids) > 0 {
+		for iNdEx := len(m.TokenScopeUuids) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TokenScopeUuids[iNdEx])
+			copy(dAtA[i:], m.TokenScopeUuids[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenScopeUuids[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ScopeUuids) > 0 {
+		for iNdEx := len(m.ScopeUuids) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ScopeUuids[iNdEx])
+			copy(dAtA[i:], m.ScopeUuids[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.ScopeUuids[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ScopeSpecificationWrapper) Marshal() (dAtA []byte, err error) {
+func (m *ScopeValueOwnerTokenRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5374,44 +6835,34 @@ func (m *ScopeSpecificationWrapper) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ScopeSpecificationWrapper) MarshalTo(dAtA []byte) (int, error) {
+func (m *ScopeValueOwnerTokenRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ScopeSpecificationWrapper) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ScopeValueOwnerTokenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ScopeSpecIdInfo != nil {
-		{
-			size, err := m.ScopeSpecIdInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.Specification != nil {
-		{
-			size, err := m.Specification.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
+	if len(m.ScopeId) > 0 {
+		i -= len(m.ScopeId)
+		copy(dAtA[i:], m.ScopeId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ScopeId)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ScopeSpecificationsAllRequest) Marshal() (dAtA []byte, err error) {
+func (m *ScopeValueOwnerTokenResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5421,19 +6872,19 @@ func (m *ScopeSpecificationsAllRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ScopeSpecificationsAllRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ScopeValueOwnerTokenResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ScopeSpecificationsAllRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ScopeValueOwnerTokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
+	if m.Request != nil {
 		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5443,12 +6894,60 @@ func (m *ScopeSpecificationsAllRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 		i--
 		dAtA[i] = 0x6
 		i--
-		dAtA[i] = 0x9a
+		dAtA[i] = 0x92
 	}
-	return len(dAtA) - i, nil
+	if len(m.ValueOwner) > 0 {
+		i -= len(m.ValueOwner)
+		copy(dAtA[i:], m.ValueOwner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValueOwner)))
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size := m.Supply.Size()
+		i -= size
+		if _, err := m.Supply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.Tokenized {
+		i--
+		if m.Tokenized {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.MarkerAddress) > 0 {
+		i -= len(m.MarkerAddress)
+		copy(dAtA[i:], m.MarkerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MarkerAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ScopeId) > 0 {
+		i -= len(m.ScopeId)
+		copy(dAtA[i:], m.ScopeId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ScopeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ScopeSpecificationsAllResponse) Marshal() (dAtA []byte, err error) {
+func (m *ScopeSaleOfferRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5458,19 +6957,49 @@ func (m *ScopeSpecificationsAllResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ScopeSpecificationsAllResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ScopeSaleOfferRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ScopeSpecificationsAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ScopeSaleOfferRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
+	if len(m.ScopeId) > 0 {
+		i -= len(m.ScopeId)
+		copy(dAtA[i:], m.ScopeId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ScopeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScopeSaleOfferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScopeSaleOfferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScopeSaleOfferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Request != nil {
 		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5480,11 +7009,21 @@ func (m *ScopeSpecificationsAllResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 		i--
 		dAtA[i] = 0x6
 		i--
-		dAtA[i] = 0x9a
+		dAtA[i] = 0x92
 	}
-	if m.Request != nil {
+	if m.Expired {
+		i--
+		if m.Expired {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Offer != nil {
 		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Offer.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5492,28 +7031,49 @@ func (m *ScopeSpecificationsAllResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x6
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CheckScopeSpecificationMigrationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckScopeSpecificationMigrationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckScopeSpecificationMigrationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SpecificationId) > 0 {
+		i -= len(m.SpecificationId)
+		copy(dAtA[i:], m.SpecificationId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SpecificationId)))
 		i--
-		dAtA[i] = 0x92
+		dAtA[i] = 0x12
 	}
-	if len(m.ScopeSpecifications) > 0 {
-		for iNdEx := len(m.ScopeSpecifications) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ScopeSpecifications[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if len(m.ScopeId) > 0 {
+		i -= len(m.ScopeId)
+		copy(dAtA[i:], m.ScopeId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ScopeId)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ContractSpecificationRequest) Marshal() (dAtA []byte, err error) {
+func (m *CheckScopeSpecificationMigrationResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5523,26 +7083,72 @@ func (m *ContractSpecificationRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ContractSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *CheckScopeSpecificationMigrationResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ContractSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CheckScopeSpecificationMigrationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.IncludeRecordSpecs {
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
-		if m.IncludeRecordSpecs {
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.Issues) > 0 {
+		for iNdEx := len(m.Issues) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Issues[iNdEx])
+			copy(dAtA[i:], m.Issues[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Issues[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Compatible {
+		i--
+		if m.Compatible {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x50
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScopeSpecificationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ScopeSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScopeSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if len(m.SpecificationId) > 0 {
 		i -= len(m.SpecificationId)
 		copy(dAtA[i:], m.SpecificationId)
@@ -5553,7 +7159,7 @@ func (m *ContractSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *ContractSpecificationResponse) Marshal() (dAtA []byte, err error) {
+func (m *ScopeSpecificationResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5563,12 +7169,12 @@ func (m *ContractSpecificationResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ContractSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ScopeSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ContractSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ScopeSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5587,23 +7193,9 @@ func (m *ContractSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 		i--
 		dAtA[i] = 0x92
 	}
-	if len(m.RecordSpecifications) > 0 {
-		for iNdEx := len(m.RecordSpecifications) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RecordSpecifications[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if m.ContractSpecification != nil {
+	if m.ScopeSpecification != nil {
 		{
-			size, err := m.ContractSpecification.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ScopeSpecification.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5616,7 +7208,7 @@ func (m *ContractSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *ContractSpecificationWrapper) Marshal() (dAtA []byte, err error) {
+func (m *ScopeSpecificationWrapper) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5626,19 +7218,19 @@ func (m *ContractSpecificationWrapper) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ContractSpecificationWrapper) MarshalTo(dAtA []byte) (int, error) {
+func (m *ScopeSpecificationWrapper) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ContractSpecificationWrapper) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ScopeSpecificationWrapper) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ContractSpecIdInfo != nil {
+	if m.ScopeSpecIdInfo != nil {
 		{
-			size, err := m.ContractSpecIdInfo.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ScopeSpecIdInfo.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5663,7 +7255,7 @@ func (m *ContractSpecificationWrapper) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *ContractSpecificationsAllRequest) Marshal() (dAtA []byte, err error) {
+func (m *ScopeSpecificationsAllRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5673,12 +7265,12 @@ func (m *ContractSpecificationsAllRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ContractSpecificationsAllRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ScopeSpecificationsAllRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ContractSpecificationsAllRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ScopeSpecificationsAllRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5700,7 +7292,7 @@ func (m *ContractSpecificationsAllRequest) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func (m *ContractSpecificationsAllResponse) Marshal() (dAtA []byte, err error) {
+func (m *ScopeSpecificationsAllResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5710,12 +7302,12 @@ func (m *ContractSpecificationsAllResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ContractSpecificationsAllResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ScopeSpecificationsAllResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ContractSpecificationsAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ScopeSpecificationsAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5748,10 +7340,10 @@ func (m *ContractSpecificationsAllResponse) MarshalToSizedBuffer(dAtA []byte) (i
 		i--
 		dAtA[i] = 0x92
 	}
-	if len(m.ContractSpecifications) > 0 {
-		for iNdEx := len(m.ContractSpecifications) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.ScopeSpecifications) > 0 {
+		for iNdEx := len(m.ScopeSpecifications) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.ContractSpecifications[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.ScopeSpecifications[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -5765,7 +7357,7 @@ func (m *ContractSpecificationsAllResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
-func (m *RecordSpecificationsForContractSpecificationRequest) Marshal() (dAtA []byte, err error) {
+func (m *ContractSpecificationRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5775,16 +7367,26 @@ func (m *RecordSpecificationsForContractSpecificationRequest) Marshal() (dAtA []
 	return dAtA[:n], nil
 }
 
-func (m *RecordSpecificationsForContractSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ContractSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RecordSpecificationsForContractSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ContractSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.IncludeRecordSpecs {
+		i--
+		if m.IncludeRecordSpecs {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
 	if len(m.SpecificationId) > 0 {
 		i -= len(m.SpecificationId)
 		copy(dAtA[i:], m.SpecificationId)
@@ -5795,7 +7397,7 @@ func (m *RecordSpecificationsForContractSpecificationRequest) MarshalToSizedBuff
 	return len(dAtA) - i, nil
 }
 
-func (m *RecordSpecificationsForContractSpecificationResponse) Marshal() (dAtA []byte, err error) {
+func (m *ContractSpecificationResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5805,12 +7407,12 @@ func (m *RecordSpecificationsForContractSpecificationResponse) Marshal() (dAtA [
 	return dAtA[:n], nil
 }
 
-func (m *RecordSpecificationsForContractSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ContractSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RecordSpecificationsForContractSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ContractSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5829,20 +7431,6 @@ func (m *RecordSpecificationsForContractSpecificationResponse) MarshalToSizedBuf
 		i--
 		dAtA[i] = 0x92
 	}
-	if len(m.ContractSpecificationAddr) > 0 {
-		i -= len(m.ContractSpecificationAddr)
-		copy(dAtA[i:], m.ContractSpecificationAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractSpecificationAddr)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ContractSpecificationUuid) > 0 {
-		i -= len(m.ContractSpecificationUuid)
-		copy(dAtA[i:], m.ContractSpecificationUuid)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractSpecificationUuid)))
-		i--
-		dAtA[i] = 0x12
-	}
 	if len(m.RecordSpecifications) > 0 {
 		for iNdEx := len(m.RecordSpecifications) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -5854,86 +7442,12 @@ func (m *RecordSpecificationsForContractSpecificationResponse) MarshalToSizedBuf
 				i = encodeVarintQuery(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *RecordSpecificationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *RecordSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *RecordSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.SpecificationId) > 0 {
-		i -= len(m.SpecificationId)
-		copy(dAtA[i:], m.SpecificationId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.SpecificationId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *RecordSpecificationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *RecordSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *RecordSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Request != nil {
-		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+			dAtA[i] = 0x1a
 		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x92
 	}
-	if m.RecordSpecification != nil {
+	if m.ContractSpecification != nil {
 		{
-			size, err := m.RecordSpecification.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ContractSpecification.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5946,7 +7460,7 @@ func (m *RecordSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *RecordSpecificationWrapper) Marshal() (dAtA []byte, err error) {
+func (m *ContractSpecificationWrapper) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5956,19 +7470,19 @@ func (m *RecordSpecificationWrapper) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RecordSpecificationWrapper) MarshalTo(dAtA []byte) (int, error) {
+func (m *ContractSpecificationWrapper) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RecordSpecificationWrapper) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ContractSpecificationWrapper) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.RecordSpecIdInfo != nil {
+	if m.ContractSpecIdInfo != nil {
 		{
-			size, err := m.RecordSpecIdInfo.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ContractSpecIdInfo.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5993,7 +7507,7 @@ func (m *RecordSpecificationWrapper) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *RecordSpecificationsAllRequest) Marshal() (dAtA []byte, err error) {
+func (m *ContractSpecificationsAllRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6003,12 +7517,12 @@ func (m *RecordSpecificationsAllRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RecordSpecificationsAllRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ContractSpecificationsAllRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RecordSpecificationsAllRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ContractSpecificationsAllRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6030,7 +7544,7 @@ func (m *RecordSpecificationsAllRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *RecordSpecificationsAllResponse) Marshal() (dAtA []byte, err error) {
+func (m *ContractSpecificationsAllResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6040,12 +7554,12 @@ func (m *RecordSpecificationsAllResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RecordSpecificationsAllResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ContractSpecificationsAllResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RecordSpecificationsAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ContractSpecificationsAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6078,10 +7592,10 @@ func (m *RecordSpecificationsAllResponse) MarshalToSizedBuffer(dAtA []byte) (int
 		i--
 		dAtA[i] = 0x92
 	}
-	if len(m.RecordSpecifications) > 0 {
-		for iNdEx := len(m.RecordSpecifications) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.ContractSpecifications) > 0 {
+		for iNdEx := len(m.ContractSpecifications) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.RecordSpecifications[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.ContractSpecifications[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -6095,7 +7609,7 @@ func (m *RecordSpecificationsAllResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func (m *OSLocatorParamsRequest) Marshal() (dAtA []byte, err error) {
+func (m *RecordSpecificationsForContractSpecificationRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6105,20 +7619,27 @@ func (m *OSLocatorParamsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *OSLocatorParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *RecordSpecificationsForContractSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OSLocatorParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RecordSpecificationsForContractSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.SpecificationId) > 0 {
+		i -= len(m.SpecificationId)
+		copy(dAtA[i:], m.SpecificationId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SpecificationId)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *OSLocatorParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *RecordSpecificationsForContractSpecificationResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6128,12 +7649,12 @@ func (m *OSLocatorParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *OSLocatorParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *RecordSpecificationsForContractSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OSLocatorParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RecordSpecificationsForContractSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6152,20 +7673,38 @@ func (m *OSLocatorParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i--
 		dAtA[i] = 0x92
 	}
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.ContractSpecificationAddr) > 0 {
+		i -= len(m.ContractSpecificationAddr)
+		copy(dAtA[i:], m.ContractSpecificationAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractSpecificationAddr)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ContractSpecificationUuid) > 0 {
+		i -= len(m.ContractSpecificationUuid)
+		copy(dAtA[i:], m.ContractSpecificationUuid)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractSpecificationUuid)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RecordSpecifications) > 0 {
+		for iNdEx := len(m.RecordSpecifications) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecordSpecifications[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *OSLocatorRequest) Marshal() (dAtA []byte, err error) {
+func (m *RecordSpecificationRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6175,27 +7714,34 @@ func (m *OSLocatorRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *OSLocatorRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *RecordSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OSLocatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RecordSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SpecificationId) > 0 {
+		i -= len(m.SpecificationId)
+		copy(dAtA[i:], m.SpecificationId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SpecificationId)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *OSLocatorResponse) Marshal() (dAtA []byte, err error) {
+func (m *RecordSpecificationResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6205,12 +7751,12 @@ func (m *OSLocatorResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *OSLocatorResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *RecordSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OSLocatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RecordSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6229,9 +7775,9 @@ func (m *OSLocatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x92
 	}
-	if m.Locator != nil {
+	if m.RecordSpecification != nil {
 		{
-			size, err := m.Locator.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.RecordSpecification.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6244,7 +7790,7 @@ func (m *OSLocatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *OSLocatorsByURIRequest) Marshal() (dAtA []byte, err error) {
+func (m *RecordSpecificationWrapper) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6254,19 +7800,19 @@ func (m *OSLocatorsByURIRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *OSLocatorsByURIRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *RecordSpecificationWrapper) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OSLocatorsByURIRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RecordSpecificationWrapper) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
+	if m.RecordSpecIdInfo != nil {
 		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.RecordSpecIdInfo.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6274,21 +7820,24 @@ func (m *OSLocatorsByURIRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x9a
+		dAtA[i] = 0x12
 	}
-	if len(m.Uri) > 0 {
-		i -= len(m.Uri)
-		copy(dAtA[i:], m.Uri)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Uri)))
+	if m.Specification != nil {
+		{
+			size, err := m.Specification.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *OSLocatorsByURIResponse) Marshal() (dAtA []byte, err error) {
+func (m *RecordSpecificationsAllRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6298,12 +7847,12 @@ func (m *OSLocatorsByURIResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *OSLocatorsByURIResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *RecordSpecificationsAllRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OSLocatorsByURIResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RecordSpecificationsAllRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6322,9 +7871,32 @@ func (m *OSLocatorsByURIResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i--
 		dAtA[i] = 0x9a
 	}
-	if m.Request != nil {
+	return len(dAtA) - i, nil
+}
+
+func (m *RecordSpecificationsAllResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordSpecificationsAllResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordSpecificationsAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
 		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6334,12 +7906,26 @@ func (m *OSLocatorsByURIResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i--
 		dAtA[i] = 0x6
 		i--
-		dAtA[i] = 0x92
+		dAtA[i] = 0x9a
 	}
-	if len(m.Locators) > 0 {
-		for iNdEx := len(m.Locators) - 1; iNdEx >= 0; iNdEx-- {
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.RecordSpecifications) > 0 {
+		for iNdEx := len(m.RecordSpecifications) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Locators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.RecordSpecifications[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -6353,7 +7939,7 @@ func (m *OSLocatorsByURIResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *OSLocatorsByScopeRequest) Marshal() (dAtA []byte, err error) {
+func (m *OSLocatorParamsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6363,27 +7949,104 @@ func (m *OSLocatorsByScopeRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *OSLocatorsByScopeRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *OSLocatorParamsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OSLocatorsByScopeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *OSLocatorParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ScopeId) > 0 {
-		i -= len(m.ScopeId)
-		copy(dAtA[i:], m.ScopeId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ScopeId)))
+	return len(dAtA) - i, nil
+}
+
+func (m *OSLocatorParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OSLocatorParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OSLocatorParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x92
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *OSLocatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OSLocatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OSLocatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *OSLocatorsByScopeResponse) Marshal() (dAtA []byte, err error) {
+func (m *OSLocatorResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6393,12 +8056,12 @@ func (m *OSLocatorsByScopeResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *OSLocatorsByScopeResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *OSLocatorResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OSLocatorsByScopeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *OSLocatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6428,13 +8091,25 @@ func (m *OSLocatorsByScopeResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 				i = encodeVarintQuery(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Locator != nil {
+		{
+			size, err := m.Locator.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *OSAllLocatorsRequest) Marshal() (dAtA []byte, err error) {
+func (m *OSLocatorsByURIRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6444,12 +8119,12 @@ func (m *OSAllLocatorsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *OSAllLocatorsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *OSLocatorsByURIRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OSAllLocatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *OSLocatorsByURIRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6468,10 +8143,17 @@ func (m *OSAllLocatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x9a
 	}
+	if len(m.Uri) > 0 {
+		i -= len(m.Uri)
+		copy(dAtA[i:], m.Uri)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Uri)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *OSAllLocatorsResponse) Marshal() (dAtA []byte, err error) {
+func (m *OSLocatorsByURIResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6481,12 +8163,12 @@ func (m *OSAllLocatorsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *OSAllLocatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *OSLocatorsByURIResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OSAllLocatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *OSLocatorsByURIResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6536,155 +8218,225 @@ func (m *OSAllLocatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *OSLocatorsByScopeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *OSLocatorsByScopeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *OSLocatorsByScopeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 2 + l + sovQuery(uint64(l))
+	if len(m.ScopeId) > 0 {
+		i -= len(m.ScopeId)
+		copy(dAtA[i:], m.ScopeId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ScopeId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ScopeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ScopeId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.SessionAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.RecordAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.IncludeSessions {
-		n += 2
-	}
-	if m.IncludeRecords {
-		n += 2
+func (m *OSLocatorsByScopeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ScopeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *OSLocatorsByScopeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OSLocatorsByScopeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Scope != nil {
-		l = m.Scope.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if len(m.Sessions) > 0 {
-		for _, e := range m.Sessions {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if len(m.Records) > 0 {
-		for _, e := range m.Records {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
 	if m.Request != nil {
-		l = m.Request.Size()
-		n += 2 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x92
 	}
-	return n
+	if len(m.Locators) > 0 {
+		for iNdEx := len(m.Locators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Locators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ScopeWrapper) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *OSAllLocatorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *OSAllLocatorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OSAllLocatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Scope != nil {
-		l = m.Scope.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
 	}
-	if m.ScopeIdInfo != nil {
-		l = m.ScopeIdInfo.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *OSAllLocatorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.ScopeSpecIdInfo != nil {
-		l = m.ScopeSpecIdInfo.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *OSAllLocatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OSAllLocatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
 	}
-	return n
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.Locators) > 0 {
+		for iNdEx := len(m.Locators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Locators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ScopesAllRequest) Size() (n int) {
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
-	}
 	return n
 }
 
-func (m *ScopesAllResponse) Size() (n int) {
+func (m *QueryParamsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Scopes) > 0 {
-		for _, e := range m.Scopes {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
 	if m.Request != nil {
 		l = m.Request.Size()
 		n += 2 + l + sovQuery(uint64(l))
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
-	}
 	return n
 }
 
-func (m *SessionsRequest) Size() (n int) {
+func (m *ScopeRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -6694,7 +8446,7 @@ func (m *SessionsRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	l = len(m.SessionId)
+	l = len(m.SessionAddr)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
@@ -6702,11 +8454,7 @@ func (m *SessionsRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	l = len(m.RecordName)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.IncludeScope {
+	if m.IncludeSessions {
 		n += 2
 	}
 	if m.IncludeRecords {
@@ -6715,7 +8463,7 @@ func (m *SessionsRequest) Size() (n int) {
 	return n
 }
 
-func (m *SessionsResponse) Size() (n int) {
+func (m *ScopeResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -6744,28 +8492,28 @@ func (m *SessionsResponse) Size() (n int) {
 	return n
 }
 
-func (m *SessionWrapper) Size() (n int) {
+func (m *ScopeWrapper) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Session != nil {
-		l = m.Session.Size()
+	if m.Scope != nil {
+		l = m.Scope.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	if m.SessionIdInfo != nil {
-		l = m.SessionIdInfo.Size()
+	if m.ScopeIdInfo != nil {
+		l = m.ScopeIdInfo.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	if m.ContractSpecIdInfo != nil {
-		l = m.ContractSpecIdInfo.Size()
+	if m.ScopeSpecIdInfo != nil {
+		l = m.ScopeSpecIdInfo.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *SessionsAllRequest) Size() (n int) {
+func (m *ScopesAllRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -6778,14 +8526,14 @@ func (m *SessionsAllRequest) Size() (n int) {
 	return n
 }
 
-func (m *SessionsAllResponse) Size() (n int) {
+func (m *ScopesAllResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Sessions) > 0 {
-		for _, e := range m.Sessions {
+	if len(m.Scopes) > 0 {
+		for _, e := range m.Scopes {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
@@ -6801,55 +8549,46 @@ func (m *SessionsAllResponse) Size() (n int) {
 	return n
 }
 
-func (m *RecordsRequest) Size() (n int) {
+func (m *ScopesRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.RecordAddr)
+	l = len(m.SpecificationId)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	l = len(m.ScopeId)
+	l = len(m.Owner)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	l = len(m.SessionId)
+	if m.OwnerRole != 0 {
+		n += 1 + sovQuery(uint64(m.OwnerRole))
+	}
+	l = len(m.ValueOwner)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	l = len(m.Name)
+	l = len(m.DataAccess)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	if m.IncludeScope {
-		n += 2
-	}
-	if m.IncludeSessions {
-		n += 2
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *RecordsResponse) Size() (n int) {
+func (m *ScopesResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Scope != nil {
-		l = m.Scope.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if len(m.Sessions) > 0 {
-		for _, e := range m.Sessions {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if len(m.Records) > 0 {
-		for _, e := range m.Records {
+	if len(m.Scopes) > 0 {
+		for _, e := range m.Scopes {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
@@ -6858,49 +8597,60 @@ func (m *RecordsResponse) Size() (n int) {
 		l = m.Request.Size()
 		n += 2 + l + sovQuery(uint64(l))
 	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
-func (m *RecordWrapper) Size() (n int) {
+func (m *SessionsRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Record != nil {
-		l = m.Record.Size()
+	l = len(m.ScopeId)
+	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	if m.RecordIdInfo != nil {
-		l = m.RecordIdInfo.Size()
+	l = len(m.SessionId)
+	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	if m.RecordSpecIdInfo != nil {
-		l = m.RecordSpecIdInfo.Size()
+	l = len(m.RecordAddr)
+	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	return n
-}
-
-func (m *RecordsAllRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	l = len(m.RecordName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
+	if m.IncludeScope {
+		n += 2
+	}
+	if m.IncludeRecords {
+		n += 2
 	}
 	return n
 }
 
-func (m *RecordsAllResponse) Size() (n int) {
+func (m *SessionsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if m.Scope != nil {
+		l = m.Scope.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Sessions) > 0 {
+		for _, e := range m.Sessions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
 	if len(m.Records) > 0 {
 		for _, e := range m.Records {
 			l = e.Size()
@@ -6911,23 +8661,36 @@ func (m *RecordsAllResponse) Size() (n int) {
 		l = m.Request.Size()
 		n += 2 + l + sovQuery(uint64(l))
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
-	}
 	return n
 }
 
-func (m *OwnershipRequest) Size() (n int) {
+func (m *SessionWrapper) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
+	if m.Session != nil {
+		l = m.Session.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.SessionIdInfo != nil {
+		l = m.SessionIdInfo.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.ContractSpecIdInfo != nil {
+		l = m.ContractSpecIdInfo.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *SessionsAllRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	if m.Pagination != nil {
 		l = m.Pagination.Size()
 		n += 2 + l + sovQuery(uint64(l))
@@ -6935,15 +8698,15 @@ func (m *OwnershipRequest) Size() (n int) {
 	return n
 }
 
-func (m *OwnershipResponse) Size() (n int) {
+func (m *SessionsAllResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.ScopeUuids) > 0 {
-		for _, s := range m.ScopeUuids {
-			l = len(s)
+	if len(m.Sessions) > 0 {
+		for _, e := range m.Sessions {
+			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
@@ -6958,16 +8721,19 @@ func (m *OwnershipResponse) Size() (n int) {
 	return n
 }
 
-func (m *ValueOwnershipRequest) Size() (n int) {
+func (m *SessionsByPartyRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Address)
+	l = len(m.Party)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.Role != 0 {
+		n += 1 + sovQuery(uint64(m.Role))
+	}
 	if m.Pagination != nil {
 		l = m.Pagination.Size()
 		n += 2 + l + sovQuery(uint64(l))
@@ -6975,15 +8741,15 @@ func (m *ValueOwnershipRequest) Size() (n int) {
 	return n
 }
 
-func (m *ValueOwnershipResponse) Size() (n int) {
+func (m *SessionsByPartyResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.ScopeUuids) > 0 {
-		for _, s := range m.ScopeUuids {
-			l = len(s)
+	if len(m.Sessions) > 0 {
+		for _, e := range m.Sessions {
+			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
@@ -6998,29 +8764,59 @@ func (m *ValueOwnershipResponse) Size() (n int) {
 	return n
 }
 
-func (m *ScopeSpecificationRequest) Size() (n int) {
+func (m *RecordsRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.SpecificationId)
+	l = len(m.RecordAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ScopeId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.SessionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Name)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.IncludeScope {
+		n += 2
+	}
+	if m.IncludeSessions {
+		n += 2
+	}
 	return n
 }
 
-func (m *ScopeSpecificationResponse) Size() (n int) {
+func (m *RecordsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.ScopeSpecification != nil {
-		l = m.ScopeSpecification.Size()
+	if m.Scope != nil {
+		l = m.Scope.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if len(m.Sessions) > 0 {
+		for _, e := range m.Sessions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
 	if m.Request != nil {
 		l = m.Request.Size()
 		n += 2 + l + sovQuery(uint64(l))
@@ -7028,24 +8824,28 @@ func (m *ScopeSpecificationResponse) Size() (n int) {
 	return n
 }
 
-func (m *ScopeSpecificationWrapper) Size() (n int) {
+func (m *RecordWrapper) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Specification != nil {
-		l = m.Specification.Size()
+	if m.Record != nil {
+		l = m.Record.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	if m.ScopeSpecIdInfo != nil {
-		l = m.ScopeSpecIdInfo.Size()
+	if m.RecordIdInfo != nil {
+		l = m.RecordIdInfo.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.RecordSpecIdInfo != nil {
+		l = m.RecordSpecIdInfo.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *ScopeSpecificationsAllRequest) Size() (n int) {
+func (m *RecordsAllRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -7058,14 +8858,14 @@ func (m *ScopeSpecificationsAllRequest) Size() (n int) {
 	return n
 }
 
-func (m *ScopeSpecificationsAllResponse) Size() (n int) {
+func (m *RecordsAllResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.ScopeSpecifications) > 0 {
-		for _, e := range m.ScopeSpecifications {
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
@@ -7081,34 +8881,39 @@ func (m *ScopeSpecificationsAllResponse) Size() (n int) {
 	return n
 }
 
-func (m *ContractSpecificationRequest) Size() (n int) {
+func (m *RecordHistoryRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.SpecificationId)
+	l = len(m.RecordAddr)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	if m.IncludeRecordSpecs {
-		n += 2
+	l = len(m.ScopeId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *ContractSpecificationResponse) Size() (n int) {
+func (m *RecordHistoryResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.ContractSpecification != nil {
-		l = m.ContractSpecification.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if len(m.RecordSpecifications) > 0 {
-		for _, e := range m.RecordSpecifications {
+	if len(m.Versions) > 0 {
+		for _, e := range m.Versions {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
@@ -7117,32 +8922,23 @@ func (m *ContractSpecificationResponse) Size() (n int) {
 		l = m.Request.Size()
 		n += 2 + l + sovQuery(uint64(l))
 	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
-func (m *ContractSpecificationWrapper) Size() (n int) {
+func (m *OwnershipRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Specification != nil {
-		l = m.Specification.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.ContractSpecIdInfo != nil {
-		l = m.ContractSpecIdInfo.Size()
+	l = len(m.Address)
+	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	return n
-}
-
-func (m *ContractSpecificationsAllRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
 	if m.Pagination != nil {
 		l = m.Pagination.Size()
 		n += 2 + l + sovQuery(uint64(l))
@@ -7150,15 +8946,15 @@ func (m *ContractSpecificationsAllRequest) Size() (n int) {
 	return n
 }
 
-func (m *ContractSpecificationsAllResponse) Size() (n int) {
+func (m *OwnershipResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.ContractSpecifications) > 0 {
-		for _, e := range m.ContractSpecifications {
-			l = e.Size()
+	if len(m.ScopeUuids) > 0 {
+		for _, s := range m.ScopeUuids {
+			l = len(s)
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
@@ -7173,71 +8969,94 @@ func (m *ContractSpecificationsAllResponse) Size() (n int) {
 	return n
 }
 
-func (m *RecordSpecificationsForContractSpecificationRequest) Size() (n int) {
+func (m *ValueOwnershipRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.SpecificationId)
+	l = len(m.Address)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
-func (m *RecordSpecificationsForContractSpecificationResponse) Size() (n int) {
+func (m *ValueOwnershipResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.RecordSpecifications) > 0 {
-		for _, e := range m.RecordSpecifications {
-			l = e.Size()
+	if len(m.ScopeUuids) > 0 {
+		for _, s := range m.ScopeUuids {
+			l = len(s)
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	l = len(m.ContractSpecificationUuid)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.ContractSpecificationAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.TokenScopeUuids) > 0 {
+		for _, s := range m.TokenScopeUuids {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
 	}
 	if m.Request != nil {
 		l = m.Request.Size()
 		n += 2 + l + sovQuery(uint64(l))
 	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
-func (m *RecordSpecificationRequest) Size() (n int) {
+func (m *ScopeValueOwnerTokenRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.SpecificationId)
+	l = len(m.ScopeId)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	l = len(m.Name)
+	l = len(m.Denom)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *RecordSpecificationResponse) Size() (n int) {
+func (m *ScopeValueOwnerTokenResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.RecordSpecification != nil {
-		l = m.RecordSpecification.Size()
+	l = len(m.ScopeId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.MarkerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Tokenized {
+		n += 2
+	}
+	l = m.Supply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.ValueOwner)
+	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	if m.Request != nil {
@@ -7247,45 +9066,68 @@ func (m *RecordSpecificationResponse) Size() (n int) {
 	return n
 }
 
-func (m *RecordSpecificationWrapper) Size() (n int) {
+func (m *ScopeSaleOfferRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Specification != nil {
-		l = m.Specification.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.RecordSpecIdInfo != nil {
-		l = m.RecordSpecIdInfo.Size()
+	l = len(m.ScopeId)
+	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *RecordSpecificationsAllRequest) Size() (n int) {
+func (m *ScopeSaleOfferResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
+	if m.Offer != nil {
+		l = m.Offer.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Expired {
+		n += 2
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
 		n += 2 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *RecordSpecificationsAllResponse) Size() (n int) {
+func (m *CheckScopeSpecificationMigrationRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.RecordSpecifications) > 0 {
-		for _, e := range m.RecordSpecifications {
-			l = e.Size()
+	l = len(m.ScopeId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.SpecificationId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *CheckScopeSpecificationMigrationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Compatible {
+		n += 2
+	}
+	if len(m.Issues) > 0 {
+		for _, s := range m.Issues {
+			l = len(s)
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
@@ -7293,60 +9135,124 @@ func (m *RecordSpecificationsAllResponse) Size() (n int) {
 		l = m.Request.Size()
 		n += 2 + l + sovQuery(uint64(l))
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
+	return n
+}
+
+func (m *ScopeSpecificationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SpecificationId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ScopeSpecificationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ScopeSpecification != nil {
+		l = m.ScopeSpecification.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
 		n += 2 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *OSLocatorParamsRequest) Size() (n int) {
+func (m *ScopeSpecificationWrapper) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if m.Specification != nil {
+		l = m.Specification.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ScopeSpecIdInfo != nil {
+		l = m.ScopeSpecIdInfo.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
-func (m *OSLocatorParamsResponse) Size() (n int) {
+func (m *ScopeSpecificationsAllRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ScopeSpecificationsAllResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ScopeSpecifications) > 0 {
+		for _, e := range m.ScopeSpecifications {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
 	if m.Request != nil {
 		l = m.Request.Size()
 		n += 2 + l + sovQuery(uint64(l))
 	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
-func (m *OSLocatorRequest) Size() (n int) {
+func (m *ContractSpecificationRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Owner)
+	l = len(m.SpecificationId)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.IncludeRecordSpecs {
+		n += 2
+	}
 	return n
 }
 
-func (m *OSLocatorResponse) Size() (n int) {
+func (m *ContractSpecificationResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Locator != nil {
-		l = m.Locator.Size()
+	if m.ContractSpecification != nil {
+		l = m.ContractSpecification.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if len(m.RecordSpecifications) > 0 {
+		for _, e := range m.RecordSpecifications {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
 	if m.Request != nil {
 		l = m.Request.Size()
 		n += 2 + l + sovQuery(uint64(l))
@@ -7354,16 +9260,29 @@ func (m *OSLocatorResponse) Size() (n int) {
 	return n
 }
 
-func (m *OSLocatorsByURIRequest) Size() (n int) {
+func (m *ContractSpecificationWrapper) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Uri)
-	if l > 0 {
+	if m.Specification != nil {
+		l = m.Specification.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ContractSpecIdInfo != nil {
+		l = m.ContractSpecIdInfo.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	return n
+}
+
+func (m *ContractSpecificationsAllRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	if m.Pagination != nil {
 		l = m.Pagination.Size()
 		n += 2 + l + sovQuery(uint64(l))
@@ -7371,14 +9290,14 @@ func (m *OSLocatorsByURIRequest) Size() (n int) {
 	return n
 }
 
-func (m *OSLocatorsByURIResponse) Size() (n int) {
+func (m *ContractSpecificationsAllResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Locators) > 0 {
-		for _, e := range m.Locators {
+	if len(m.ContractSpecifications) > 0 {
+		for _, e := range m.ContractSpecifications {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
@@ -7394,31 +9313,39 @@ func (m *OSLocatorsByURIResponse) Size() (n int) {
 	return n
 }
 
-func (m *OSLocatorsByScopeRequest) Size() (n int) {
+func (m *RecordSpecificationsForContractSpecificationRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.ScopeId)
+	l = len(m.SpecificationId)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *OSLocatorsByScopeResponse) Size() (n int) {
+func (m *RecordSpecificationsForContractSpecificationResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Locators) > 0 {
-		for _, e := range m.Locators {
+	if len(m.RecordSpecifications) > 0 {
+		for _, e := range m.RecordSpecifications {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
+	l = len(m.ContractSpecificationUuid)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ContractSpecificationAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	if m.Request != nil {
 		l = m.Request.Size()
 		n += 2 + l + sovQuery(uint64(l))
@@ -7426,7 +9353,58 @@ func (m *OSLocatorsByScopeResponse) Size() (n int) {
 	return n
 }
 
-func (m *OSAllLocatorsRequest) Size() (n int) {
+func (m *RecordSpecificationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SpecificationId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RecordSpecificationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RecordSpecification != nil {
+		l = m.RecordSpecification.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RecordSpecificationWrapper) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Specification != nil {
+		l = m.Specification.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.RecordSpecIdInfo != nil {
+		l = m.RecordSpecIdInfo.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RecordSpecificationsAllRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -7439,14 +9417,14 @@ func (m *OSAllLocatorsRequest) Size() (n int) {
 	return n
 }
 
-func (m *OSAllLocatorsResponse) Size() (n int) {
+func (m *RecordSpecificationsAllResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Locators) > 0 {
-		for _, e := range m.Locators {
+	if len(m.RecordSpecifications) > 0 {
+		for _, e := range m.RecordSpecifications {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
@@ -7462,32 +9440,204 @@ func (m *OSAllLocatorsResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *OSLocatorParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *OSLocatorParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
+
+func (m *OSLocatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *OSLocatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Locator != nil {
+		l = m.Locator.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Locators) > 0 {
+		for _, e := range m.Locators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *OSLocatorsByURIRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Uri)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *OSLocatorsByURIResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Locators) > 0 {
+		for _, e := range m.Locators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *OSLocatorsByScopeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *OSLocatorsByScopeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Locators) > 0 {
+		for _, e := range m.Locators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *OSAllLocatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *OSAllLocatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Locators) > 0 {
+		for _, e := range m.Locators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
@@ -7579,12 +9729,1411 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 98:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			iNdEx = postIndex
+		case 98:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &QueryParamsRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScopeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScopeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScopeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeSessions", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeSessions = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRecords", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeRecords = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScopeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScopeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScopeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Scope == nil {
+				m.Scope = &ScopeWrapper{}
+			}
+			if err := m.Scope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sessions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sessions = append(m.Sessions, &SessionWrapper{})
+			if err := m.Sessions[len(m.Sessions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, &RecordWrapper{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 98:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &ScopeRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScopeWrapper) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScopeWrapper: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScopeWrapper: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Scope == nil {
+				m.Scope = &Scope{}
+			}
+			if err := m.Scope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeIdInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScopeIdInfo == nil {
+				m.ScopeIdInfo = &ScopeIdInfo{}
+			}
+			if err := m.ScopeIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeSpecIdInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScopeSpecIdInfo == nil {
+				m.ScopeSpecIdInfo = &ScopeSpecIdInfo{}
+			}
+			if err := m.ScopeSpecIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScopesAllRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScopesAllRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScopesAllRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScopesAllResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScopesAllResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScopesAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scopes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Scopes = append(m.Scopes, &ScopeWrapper{})
+			if err := m.Scopes[len(m.Scopes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 98:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &ScopesAllRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScopesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScopesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScopesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecificationId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpecificationId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerRole", wireType)
+			}
+			m.OwnerRole = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OwnerRole |= PartyType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValueOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataAccess", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataAccess = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScopesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScopesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScopesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scopes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Scopes = append(m.Scopes, &ScopeWrapper{})
+			if err := m.Scopes[len(m.Scopes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 98:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &ScopesRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SessionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SessionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SessionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeScope", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeScope = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRecords", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7594,28 +11143,12 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Request == nil {
-				m.Request = &QueryParamsRequest{}
-			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.IncludeRecords = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7637,7 +11170,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ScopeRequest) Unmarshal(dAtA []byte) error {
+func (m *SessionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7660,17 +11193,17 @@ func (m *ScopeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ScopeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SessionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ScopeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SessionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7680,29 +11213,33 @@ func (m *ScopeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScopeId = string(dAtA[iNdEx:postIndex])
+			if m.Scope == nil {
+				m.Scope = &ScopeWrapper{}
+			}
+			if err := m.Scope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sessions", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7712,29 +11249,31 @@ func (m *ScopeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SessionAddr = string(dAtA[iNdEx:postIndex])
+			m.Sessions = append(m.Sessions, &SessionWrapper{})
+			if err := m.Sessions[len(m.Sessions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7744,29 +11283,31 @@ func (m *ScopeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecordAddr = string(dAtA[iNdEx:postIndex])
+			m.Records = append(m.Records, &RecordWrapper{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncludeSessions", wireType)
+		case 98:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7776,32 +11317,28 @@ func (m *ScopeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IncludeSessions = bool(v != 0)
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRecords", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			m.IncludeRecords = bool(v != 0)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &SessionsRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7823,7 +11360,7 @@ func (m *ScopeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ScopeResponse) Unmarshal(dAtA []byte) error {
+func (m *SessionWrapper) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7846,15 +11383,15 @@ func (m *ScopeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ScopeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SessionWrapper: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ScopeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SessionWrapper: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Session", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7881,16 +11418,16 @@ func (m *ScopeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Scope == nil {
-				m.Scope = &ScopeWrapper{}
+			if m.Session == nil {
+				m.Session = &Session{}
 			}
-			if err := m.Scope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Session.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sessions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionIdInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7917,14 +11454,16 @@ func (m *ScopeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sessions = append(m.Sessions, &SessionWrapper{})
-			if err := m.Sessions[len(m.Sessions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.SessionIdInfo == nil {
+				m.SessionIdInfo = &SessionIdInfo{}
+			}
+			if err := m.SessionIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecIdInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7951,14 +11490,66 @@ func (m *ScopeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Records = append(m.Records, &RecordWrapper{})
-			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ContractSpecIdInfo == nil {
+				m.ContractSpecIdInfo = &ContractSpecIdInfo{}
+			}
+			if err := m.ContractSpecIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 98:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SessionsAllRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SessionsAllRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SessionsAllRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 99:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7985,10 +11576,10 @@ func (m *ScopeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Request == nil {
-				m.Request = &ScopeRequest{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8013,7 +11604,7 @@ func (m *ScopeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ScopeWrapper) Unmarshal(dAtA []byte) error {
+func (m *SessionsAllResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8036,15 +11627,15 @@ func (m *ScopeWrapper) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ScopeWrapper: wiretype end group for non-group")
+			return fmt.Errorf("proto: SessionsAllResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ScopeWrapper: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SessionsAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sessions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8071,16 +11662,14 @@ func (m *ScopeWrapper) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Scope == nil {
-				m.Scope = &Scope{}
-			}
-			if err := m.Scope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Sessions = append(m.Sessions, &SessionWrapper{})
+			if err := m.Sessions[len(m.Sessions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 98:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeIdInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8107,16 +11696,16 @@ func (m *ScopeWrapper) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ScopeIdInfo == nil {
-				m.ScopeIdInfo = &ScopeIdInfo{}
+			if m.Request == nil {
+				m.Request = &SessionsAllRequest{}
 			}
-			if err := m.ScopeIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 99:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeSpecIdInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8143,10 +11732,10 @@ func (m *ScopeWrapper) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ScopeSpecIdInfo == nil {
-				m.ScopeSpecIdInfo = &ScopeSpecIdInfo{}
-			}
-			if err := m.ScopeSpecIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8171,7 +11760,7 @@ func (m *ScopeWrapper) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ScopesAllRequest) Unmarshal(dAtA []byte) error {
+func (m *SessionsByPartyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8194,12 +11783,63 @@ func (m *ScopesAllRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ScopesAllRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SessionsByPartyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ScopesAllRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SessionsByPartyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Party", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Party = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= PartyType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 99:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
@@ -8257,7 +11897,7 @@ func (m *ScopesAllRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ScopesAllResponse) Unmarshal(dAtA []byte) error {
+func (m *SessionsByPartyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8280,15 +11920,15 @@ func (m *ScopesAllResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ScopesAllResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SessionsByPartyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ScopesAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SessionsByPartyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scopes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sessions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8315,8 +11955,8 @@ func (m *ScopesAllResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Scopes = append(m.Scopes, &ScopeWrapper{})
-			if err := m.Scopes[len(m.Scopes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Sessions = append(m.Sessions, &SessionWrapper{})
+			if err := m.Sessions[len(m.Sessions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8350,7 +11990,7 @@ func (m *ScopesAllResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Request == nil {
-				m.Request = &ScopesAllRequest{}
+				m.Request = &SessionsByPartyRequest{}
 			}
 			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -8413,7 +12053,7 @@ func (m *ScopesAllResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SessionsRequest) Unmarshal(dAtA []byte) error {
+func (m *RecordsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8436,15 +12076,15 @@ func (m *SessionsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SessionsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecordsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SessionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8472,11 +12112,11 @@ func (m *SessionsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScopeId = string(dAtA[iNdEx:postIndex])
+			m.RecordAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8504,11 +12144,11 @@ func (m *SessionsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SessionId = string(dAtA[iNdEx:postIndex])
+			m.ScopeId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8536,11 +12176,11 @@ func (m *SessionsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecordAddr = string(dAtA[iNdEx:postIndex])
+			m.SessionId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8568,7 +12208,7 @@ func (m *SessionsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecordName = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 10:
 			if wireType != 0 {
@@ -8589,12 +12229,186 @@ func (m *SessionsRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.IncludeScope = bool(v != 0)
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRecords", wireType)
+			m.IncludeScope = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeSessions", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeSessions = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecordsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecordsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Scope == nil {
+				m.Scope = &ScopeWrapper{}
+			}
+			if err := m.Scope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sessions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sessions = append(m.Sessions, &SessionWrapper{})
+			if err := m.Sessions[len(m.Sessions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, &RecordWrapper{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var v int
+			iNdEx = postIndex
+		case 98:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8604,12 +12418,28 @@ func (m *SessionsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IncludeRecords = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &RecordsRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8631,7 +12461,7 @@ func (m *SessionsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SessionsResponse) Unmarshal(dAtA []byte) error {
+func (m *RecordWrapper) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8654,15 +12484,15 @@ func (m *SessionsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SessionsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecordWrapper: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SessionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordWrapper: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8689,16 +12519,16 @@ func (m *SessionsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Scope == nil {
-				m.Scope = &ScopeWrapper{}
+			if m.Record == nil {
+				m.Record = &Record{}
 			}
-			if err := m.Scope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sessions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordIdInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8725,14 +12555,16 @@ func (m *SessionsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sessions = append(m.Sessions, &SessionWrapper{})
-			if err := m.Sessions[len(m.Sessions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.RecordIdInfo == nil {
+				m.RecordIdInfo = &RecordIdInfo{}
+			}
+			if err := m.RecordIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordSpecIdInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8759,14 +12591,66 @@ func (m *SessionsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Records = append(m.Records, &RecordWrapper{})
-			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.RecordSpecIdInfo == nil {
+				m.RecordSpecIdInfo = &RecordSpecIdInfo{}
+			}
+			if err := m.RecordSpecIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 98:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecordsAllRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecordsAllRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecordsAllRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 99:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8793,10 +12677,10 @@ func (m *SessionsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Request == nil {
-				m.Request = &SessionsRequest{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8821,7 +12705,7 @@ func (m *SessionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SessionWrapper) Unmarshal(dAtA []byte) error {
+func (m *RecordsAllResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8844,15 +12728,15 @@ func (m *SessionWrapper) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SessionWrapper: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecordsAllResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SessionWrapper: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordsAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Session", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8879,16 +12763,14 @@ func (m *SessionWrapper) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Session == nil {
-				m.Session = &Session{}
-			}
-			if err := m.Session.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Records = append(m.Records, &RecordWrapper{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 98:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionIdInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8915,16 +12797,16 @@ func (m *SessionWrapper) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SessionIdInfo == nil {
-				m.SessionIdInfo = &SessionIdInfo{}
+			if m.Request == nil {
+				m.Request = &RecordsAllRequest{}
 			}
-			if err := m.SessionIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 99:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecIdInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8951,10 +12833,10 @@ func (m *SessionWrapper) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ContractSpecIdInfo == nil {
-				m.ContractSpecIdInfo = &ContractSpecIdInfo{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			if err := m.ContractSpecIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8979,7 +12861,7 @@ func (m *SessionWrapper) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SessionsAllRequest) Unmarshal(dAtA []byte) error {
+func (m *RecordHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9002,12 +12884,108 @@ func (m *SessionsAllRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SessionsAllRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecordHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SessionsAllRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 99:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
@@ -9065,7 +13043,7 @@ func (m *SessionsAllRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SessionsAllResponse) Unmarshal(dAtA []byte) error {
+func (m *RecordHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9088,15 +13066,15 @@ func (m *SessionsAllResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SessionsAllResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecordHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SessionsAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sessions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Versions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9123,8 +13101,8 @@ func (m *SessionsAllResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sessions = append(m.Sessions, &SessionWrapper{})
-			if err := m.Sessions[len(m.Sessions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Versions = append(m.Versions, RecordVersion{})
+			if err := m.Versions[len(m.Versions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9158,7 +13136,7 @@ func (m *SessionsAllResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Request == nil {
-				m.Request = &SessionsAllRequest{}
+				m.Request = &RecordHistoryRequest{}
 			}
 			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -9221,7 +13199,7 @@ func (m *SessionsAllResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecordsRequest) Unmarshal(dAtA []byte) error {
+func (m *OwnershipRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9244,15 +13222,15 @@ func (m *RecordsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: OwnershipRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OwnershipRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9280,13 +13258,13 @@ func (m *RecordsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecordAddr = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 99:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9296,27 +13274,81 @@ func (m *RecordsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScopeId = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OwnershipResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OwnershipResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OwnershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeUuids", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9344,13 +13376,13 @@ func (m *RecordsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SessionId = string(dAtA[iNdEx:postIndex])
+			m.ScopeUuids = append(m.ScopeUuids, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
+		case 98:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9360,29 +13392,33 @@ func (m *RecordsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.Request == nil {
+				m.Request = &OwnershipRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncludeScope", wireType)
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9392,32 +13428,28 @@ func (m *RecordsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IncludeScope = bool(v != 0)
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncludeSessions", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			m.IncludeSessions = bool(v != 0)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9439,7 +13471,7 @@ func (m *RecordsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecordsResponse) Unmarshal(dAtA []byte) error {
+func (m *ValueOwnershipRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9462,87 +13494,17 @@ func (m *RecordsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValueOwnershipRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValueOwnershipRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Scope == nil {
-				m.Scope = &ScopeWrapper{}
-			}
-			if err := m.Scope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sessions", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Sessions = append(m.Sessions, &SessionWrapper{})
-			if err := m.Sessions[len(m.Sessions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9552,29 +13514,27 @@ func (m *RecordsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Records = append(m.Records, &RecordWrapper{})
-			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 98:
+		case 99:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9601,10 +13561,10 @@ func (m *RecordsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Request == nil {
-				m.Request = &RecordsRequest{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9629,7 +13589,7 @@ func (m *RecordsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecordWrapper) Unmarshal(dAtA []byte) error {
+func (m *ValueOwnershipResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9652,17 +13612,17 @@ func (m *RecordWrapper) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordWrapper: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValueOwnershipResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordWrapper: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValueOwnershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeUuids", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9672,31 +13632,59 @@ func (m *RecordWrapper) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Record == nil {
-				m.Record = &Record{}
+			m.ScopeUuids = append(m.ScopeUuids, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenScopeUuids", wireType)
 			}
-			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.TokenScopeUuids = append(m.TokenScopeUuids, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 98:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordIdInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9723,16 +13711,16 @@ func (m *RecordWrapper) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RecordIdInfo == nil {
-				m.RecordIdInfo = &RecordIdInfo{}
+			if m.Request == nil {
+				m.Request = &ValueOwnershipRequest{}
 			}
-			if err := m.RecordIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 99:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordSpecIdInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9759,10 +13747,10 @@ func (m *RecordWrapper) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RecordSpecIdInfo == nil {
-				m.RecordSpecIdInfo = &RecordSpecIdInfo{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			if err := m.RecordSpecIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9787,7 +13775,7 @@ func (m *RecordWrapper) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecordsAllRequest) Unmarshal(dAtA []byte) error {
+func (m *ScopeValueOwnerTokenRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9810,17 +13798,17 @@ func (m *RecordsAllRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordsAllRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScopeValueOwnerTokenRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordsAllRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScopeValueOwnerTokenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 99:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9830,27 +13818,55 @@ func (m *RecordsAllRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			m.ScopeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9873,7 +13889,7 @@ func (m *RecordsAllRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecordsAllResponse) Unmarshal(dAtA []byte) error {
+func (m *ScopeValueOwnerTokenResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9896,17 +13912,17 @@ func (m *RecordsAllResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordsAllResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScopeValueOwnerTokenResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordsAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScopeValueOwnerTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9916,31 +13932,147 @@ func (m *RecordsAllResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Records = append(m.Records, &RecordWrapper{})
-			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ScopeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarkerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokenized", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Tokenized = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Supply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 98:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueOwner", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9950,31 +14082,27 @@ func (m *RecordsAllResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Request == nil {
-				m.Request = &RecordsAllRequest{}
-			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValueOwner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 99:
+		case 98:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10001,10 +14129,10 @@ func (m *RecordsAllResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			if m.Request == nil {
+				m.Request = &ScopeValueOwnerTokenRequest{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10029,7 +14157,7 @@ func (m *RecordsAllResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OwnershipRequest) Unmarshal(dAtA []byte) error {
+func (m *ScopeSaleOfferRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10052,15 +14180,15 @@ func (m *OwnershipRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OwnershipRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScopeSaleOfferRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OwnershipRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScopeSaleOfferRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10088,43 +14216,7 @@ func (m *OwnershipRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 99:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ScopeId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10147,7 +14239,7 @@ func (m *OwnershipRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OwnershipResponse) Unmarshal(dAtA []byte) error {
+func (m *ScopeSaleOfferResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10170,17 +14262,17 @@ func (m *OwnershipResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OwnershipResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScopeSaleOfferResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OwnershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScopeSaleOfferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeUuids", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Offer", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10190,29 +14282,33 @@ func (m *OwnershipResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScopeUuids = append(m.ScopeUuids, string(dAtA[iNdEx:postIndex]))
+			if m.Offer == nil {
+				m.Offer = &ScopeSaleOffer{}
+			}
+			if err := m.Offer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 98:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expired", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10222,31 +14318,15 @@ func (m *OwnershipResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Request == nil {
-				m.Request = &OwnershipRequest{}
-			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 99:
+			m.Expired = bool(v != 0)
+		case 98:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10273,10 +14353,10 @@ func (m *OwnershipResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			if m.Request == nil {
+				m.Request = &ScopeSaleOfferRequest{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10301,7 +14381,7 @@ func (m *OwnershipResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValueOwnershipRequest) Unmarshal(dAtA []byte) error {
+func (m *CheckScopeSpecificationMigrationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10324,15 +14404,15 @@ func (m *ValueOwnershipRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValueOwnershipRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CheckScopeSpecificationMigrationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValueOwnershipRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CheckScopeSpecificationMigrationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10360,13 +14440,13 @@ func (m *ValueOwnershipRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.ScopeId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 99:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecificationId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10376,27 +14456,23 @@ func (m *ValueOwnershipRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SpecificationId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10419,7 +14495,7 @@ func (m *ValueOwnershipRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValueOwnershipResponse) Unmarshal(dAtA []byte) error {
+func (m *CheckScopeSpecificationMigrationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10442,17 +14518,17 @@ func (m *ValueOwnershipResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValueOwnershipResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CheckScopeSpecificationMigrationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValueOwnershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CheckScopeSpecificationMigrationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeUuids", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Compatible", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10462,29 +14538,17 @@ func (m *ValueOwnershipResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ScopeUuids = append(m.ScopeUuids, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 98:
+			m.Compatible = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Issues", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10494,31 +14558,27 @@ func (m *ValueOwnershipResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Request == nil {
-				m.Request = &ValueOwnershipRequest{}
-			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Issues = append(m.Issues, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 99:
+		case 98:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10545,10 +14605,10 @@ func (m *ValueOwnershipResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			if m.Request == nil {
+				m.Request = &CheckScopeSpecificationMigrationRequest{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -12859,6 +16919,38 @@ func (m *OSLocatorRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -12945,6 +17037,40 @@ func (m *OSLocatorResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Locators = append(m.Locators, ObjectStoreLocator{})
+			if err := m.Locators[len(m.Locators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 98:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
@@ -14,6 +14,8 @@ import (
 const (
 	// A sane default for maximum length of an audit message string (memo)
 	maxAuditMessageLength = 200
+	// The maximum length of a data access grant role label.
+	maxDataAccessRoleLength = 64
 )
 
 // NewScope creates a new instance.
@@ -38,6 +40,7 @@ func (s Scope) Equals(t Scope) bool {
 		s.SpecificationId.Equals(t.SpecificationId) &&
 		EqualParties(s.Owners, t.Owners) &&
 		equivalentDataAssessors(s.DataAccess, t.DataAccess) &&
+		equivalentDataAccessGrants(s.DataAccessGrants, t.DataAccessGrants) &&
 		s.ValueOwnerAddress == t.ValueOwnerAddress
 }
 
@@ -67,6 +70,19 @@ func (s Scope) ValidateBasic() error {
 			return fmt.Errorf("invalid address in data access on scope: %w", err)
 		}
 	}
+	for i, g := range s.DataAccessGrants {
+		if err = g.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid data access grant on scope: %w", err)
+		}
+		if !containsString(s.DataAccess, g.Address) {
+			return fmt.Errorf("data access grant address %s is not in the scope's data access list", g.Address)
+		}
+		for _, other := range s.DataAccessGrants[:i] {
+			if other.Address == g.Address {
+				return fmt.Errorf("duplicate data access grant for address %s", g.Address)
+			}
+		}
+	}
 	if len(s.ValueOwnerAddress) > 0 {
 		if _, err = sdk.AccAddressFromBech32(s.ValueOwnerAddress); err != nil {
 			return fmt.Errorf("invalid value owner address on scope: %w", err)
@@ -104,6 +120,16 @@ func (s *Scope) RemoveDataAccess(addresses []string) {
 	}
 
 	s.DataAccess = newDataAccess
+
+	if len(s.DataAccessGrants) > 0 {
+		newGrants := []DataAccessGrant{}
+		for _, g := range s.DataAccessGrants {
+			if !containsString(addresses, g.Address) {
+				newGrants = append(newGrants, g)
+			}
+		}
+		s.DataAccessGrants = newGrants
+	}
 }
 
 func (s *Scope) AddDataAccess(addresses []string) {
@@ -121,6 +147,47 @@ func (s *Scope) AddDataAccess(addresses []string) {
 	}
 }
 
+// AddDataAccessGrants adds the addresses to the data access list along with a grant for each
+// that has the provided role and expiration. If both role and expiration are empty, no grants are added.
+// Each address gets at most one grant; an address that already has a grant keeps it.
+func (s *Scope) AddDataAccessGrants(addresses []string, role string, expiration *time.Time) {
+	s.AddDataAccess(addresses)
+	if len(role) == 0 && expiration == nil {
+		return
+	}
+	for _, addr := range addresses {
+		if s.hasDataAccessGrant(addr) {
+			continue
+		}
+		s.DataAccessGrants = append(s.DataAccessGrants, DataAccessGrant{Address: addr, Role: role, Expiration: expiration})
+	}
+}
+
+// hasDataAccessGrant returns true if the scope has a data access grant for the address.
+func (s Scope) hasDataAccessGrant(addr string) bool {
+	for _, g := range s.DataAccessGrants {
+		if g.Address == addr {
+			return true
+		}
+	}
+	return false
+}
+
+// RemoveExpiredDataAccess removes all data access that has expired as of the provided block time.
+// The addresses that were removed are returned.
+func (s *Scope) RemoveExpiredDataAccess(blockTime time.Time) []string {
+	var expired []string
+	for _, g := range s.DataAccessGrants {
+		if g.IsExpired(blockTime) {
+			expired = append(expired, g.Address)
+		}
+	}
+	if len(expired) > 0 {
+		s.RemoveDataAccess(expired)
+	}
+	return expired
+}
+
 // GetOwnerIndexWithAddress gets the index of this scopes owners list that has the provided address,
 // and a boolean for whether or not it's found.
 func (s *Scope) GetOwnerIndexWithAddress(address string) (int, bool) {
@@ -510,6 +577,23 @@ p1Loop:
 	return true
 }
 
+// equivalentDataAccessGrants returns true if both lists contain equal grants, regardless of order.
+func equivalentDataAccessGrants(g1, g2 []DataAccessGrant) bool {
+	if len(g1) != len(g2) {
+		return false
+	}
+g1Loop:
+	for _, a := range g1 {
+		for _, b := range g2 {
+			if a.Equals(b) {
+				continue g1Loop
+			}
+		}
+		return false
+	}
+	return true
+}
+
 // equivalentDataAssessors returns true if all the entries in s1 are in s2, and vice versa.
 func equivalentDataAssessors(s1, s2 []string) bool {
 s1Loop:
@@ -532,3 +616,33 @@ s2Loop:
 	}
 	return true
 }
+
+// ValidateBasic performs basic format checking of a data access grant.
+func (g DataAccessGrant) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(g.Address); err != nil {
+		return fmt.Errorf("invalid address: %w", err)
+	}
+	if len(g.Role) > maxDataAccessRoleLength {
+		return fmt.Errorf("role length %d exceeds maximum length of %d", len(g.Role), maxDataAccessRoleLength)
+	}
+	if g.Expiration != nil && g.Expiration.IsZero() {
+		return errors.New("expiration cannot be the zero time")
+	}
+	return nil
+}
+
+// Equals returns true if this grant has the same address, role, and expiration as the provided one.
+func (g DataAccessGrant) Equals(o DataAccessGrant) bool {
+	if g.Address != o.Address || g.Role != o.Role {
+		return false
+	}
+	if g.Expiration == nil || o.Expiration == nil {
+		return g.Expiration == nil && o.Expiration == nil
+	}
+	return g.Expiration.Equal(*o.Expiration)
+}
+
+// IsExpired returns true if this grant has an expiration that is not after the provided block time.
+func (g DataAccessGrant) IsExpired(blockTime time.Time) bool {
+	return g.Expiration != nil && !g.Expiration.After(blockTime)
+}
@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
@@ -110,6 +112,9 @@ type Scope struct {
 	// An address that controls the value associated with this scope.  Standard blockchain accounts and marker accounts
 	// are supported for this value.  This attribute may only be changed by the entity indicated once it is set.
 	ValueOwnerAddress string `protobuf:"bytes,5,opt,name=value_owner_address,json=valueOwnerAddress,proto3" json:"value_owner_address,omitempty" yaml:"value_owner_address"`
+	// Optional details about entries in data_access, such as a role or an expiration.
+	// Each grant's address must also be in data_access. Addresses without a grant have access that does not expire.
+	DataAccessGrants []DataAccessGrant `protobuf:"bytes,6,rep,name=data_access_grants,json=dataAccessGrants,proto3" json:"data_access_grants,omitempty" yaml:"data_access_grants,omitempty"`
 }
 
 func (m *Scope) Reset()      { *m = Scope{} }
@@ -165,12 +170,82 @@ func (m *Scope) GetValueOwnerAddress() string {
 	return ""
 }
 
+func (m *Scope) GetDataAccessGrants() []DataAccessGrant {
+	if m != nil {
+		return m.DataAccessGrants
+	}
+	return nil
+}
+
+// DataAccessGrant contains the details of an address's access to the off-chain data associated with a scope.
+type DataAccessGrant struct {
+	// address is the bech32 address that has data access.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// role is an optional label describing the purpose of the access, e.g. "auditor".
+	Role string `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	// expiration is an optional time at which the access lapses. Expired access is removed at the end of the block.
+	Expiration *time.Time `protobuf:"bytes,3,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty" yaml:"expiration,omitempty"`
+}
+
+func (m *DataAccessGrant) Reset()         { *m = DataAccessGrant{} }
+func (m *DataAccessGrant) String() string { return proto.CompactTextString(m) }
+func (*DataAccessGrant) ProtoMessage()    {}
+func (*DataAccessGrant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_edeea634bfb18aba, []int{1}
+}
+func (m *DataAccessGrant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DataAccessGrant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DataAccessGrant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DataAccessGrant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DataAccessGrant.Merge(m, src)
+}
+func (m *DataAccessGrant) XXX_Size() int {
+	return m.Size()
+}
+func (m *DataAccessGrant) XXX_DiscardUnknown() {
+	xxx_messageInfo_DataAccessGrant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DataAccessGrant proto.InternalMessageInfo
+
+func (m *DataAccessGrant) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *DataAccessGrant) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+func (m *DataAccessGrant) GetExpiration() *time.Time {
+	if m != nil {
+		return m.Expiration
+	}
+	return nil
+}
+
+// A Session is created for an execution context against a specific specification instance
 //
-//A Session is created for an execution context against a specific specification instance
-//
-//The context will have a specification and set of parties involved.  The Session may be updated several
-//times so long as the parties listed are signers on the transaction.  NOTE: When there are no Records within a Scope
-//that reference a Session it is removed.
+// The context will have a specification and set of parties involved.  The Session may be updated several
+// times so long as the parties listed are signers on the transaction.  NOTE: When there are no Records within a Scope
+// that reference a Session it is removed.
 type Session struct {
 	SessionId MetadataAddress `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3,customtype=MetadataAddress" json:"session_id" yaml:"session_id"`
 	// unique id of the contract specification that was used to create this session.
@@ -188,7 +263,7 @@ type Session struct {
 func (m *Session) Reset()      { *m = Session{} }
 func (*Session) ProtoMessage() {}
 func (*Session) Descriptor() ([]byte, []int) {
-	return fileDescriptor_edeea634bfb18aba, []int{1}
+	return fileDescriptor_edeea634bfb18aba, []int{2}
 }
 func (m *Session) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -264,7 +339,7 @@ type Record struct {
 func (m *Record) Reset()      { *m = Record{} }
 func (*Record) ProtoMessage() {}
 func (*Record) Descriptor() ([]byte, []int) {
-	return fileDescriptor_edeea634bfb18aba, []int{2}
+	return fileDescriptor_edeea634bfb18aba, []int{3}
 }
 func (m *Record) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -321,6 +396,80 @@ func (m *Record) GetOutputs() []RecordOutput {
 	return nil
 }
 
+// RecordVersion is a retained version of a record.
+type RecordVersion struct {
+	// record is the record as it was written.
+	Record Record `protobuf:"bytes,1,opt,name=record,proto3" json:"record"`
+	// version is the sequence number of this version of the record, starting at 1.
+	Version uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// height is the block height at which this version was written.
+	// It is zero for a version that was written before record versioning was enabled.
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// deleted is true if this version records the removal of the record. Its record is the record as it was when removed.
+	Deleted bool `protobuf:"varint,4,opt,name=deleted,proto3" json:"deleted,omitempty"`
+}
+
+func (m *RecordVersion) Reset()         { *m = RecordVersion{} }
+func (m *RecordVersion) String() string { return proto.CompactTextString(m) }
+func (*RecordVersion) ProtoMessage()    {}
+func (*RecordVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_edeea634bfb18aba, []int{4}
+}
+func (m *RecordVersion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecordVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecordVersion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RecordVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordVersion.Merge(m, src)
+}
+func (m *RecordVersion) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecordVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordVersion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecordVersion proto.InternalMessageInfo
+
+func (m *RecordVersion) GetRecord() Record {
+	if m != nil {
+		return m.Record
+	}
+	return Record{}
+}
+
+func (m *RecordVersion) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *RecordVersion) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *RecordVersion) GetDeleted() bool {
+	if m != nil {
+		return m.Deleted
+	}
+	return false
+}
+
 // Process contains information used to uniquely identify what was used to generate this record
 type Process struct {
 	// unique identifier for this process
@@ -338,7 +487,7 @@ type Process struct {
 func (m *Process) Reset()      { *m = Process{} }
 func (*Process) ProtoMessage() {}
 func (*Process) Descriptor() ([]byte, []int) {
-	return fileDescriptor_edeea634bfb18aba, []int{3}
+	return fileDescriptor_edeea634bfb18aba, []int{5}
 }
 func (m *Process) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -445,7 +594,7 @@ type RecordInput struct {
 func (m *RecordInput) Reset()      { *m = RecordInput{} }
 func (*RecordInput) ProtoMessage() {}
 func (*RecordInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_edeea634bfb18aba, []int{4}
+	return fileDescriptor_edeea634bfb18aba, []int{6}
 }
 func (m *RecordInput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -544,7 +693,7 @@ type RecordOutput struct {
 func (m *RecordOutput) Reset()      { *m = RecordOutput{} }
 func (*RecordOutput) ProtoMessage() {}
 func (*RecordOutput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_edeea634bfb18aba, []int{5}
+	return fileDescriptor_edeea634bfb18aba, []int{7}
 }
 func (m *RecordOutput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -598,7 +747,7 @@ type Party struct {
 func (m *Party) Reset()      { *m = Party{} }
 func (*Party) ProtoMessage() {}
 func (*Party) Descriptor() ([]byte, []int) {
-	return fileDescriptor_edeea634bfb18aba, []int{6}
+	return fileDescriptor_edeea634bfb18aba, []int{8}
 }
 func (m *Party) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -661,7 +810,7 @@ func (m *AuditFields) Reset()         { *m = AuditFields{} }
 func (m *AuditFields) String() string { return proto.CompactTextString(m) }
 func (*AuditFields) ProtoMessage()    {}
 func (*AuditFields) Descriptor() ([]byte, []int) {
-	return fileDescriptor_edeea634bfb18aba, []int{7}
+	return fileDescriptor_edeea634bfb18aba, []int{9}
 }
 func (m *AuditFields) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -732,17 +881,95 @@ func (m *AuditFields) GetMessage() string {
 	return ""
 }
 
+// ScopeSaleOffer is an offer from the value owner of a scope to transfer the value ownership of the scope for a payment.
+type ScopeSaleOffer struct {
+	// scope_id is the scope being offered.
+	ScopeId MetadataAddress `protobuf:"bytes,1,opt,name=scope_id,json=scopeId,proto3,customtype=MetadataAddress" json:"scope_id" yaml:"scope_id"`
+	// seller is the value owner that made the offer. It receives the payment.
+	Seller string `protobuf:"bytes,2,opt,name=seller,proto3" json:"seller,omitempty"`
+	// buyer is the only address that can accept the offer. If empty, any address can accept it.
+	Buyer string `protobuf:"bytes,3,opt,name=buyer,proto3" json:"buyer,omitempty" yaml:"buyer,omitempty"`
+	// price is the payment the buyer must send to the seller.
+	Price github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=price,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"price"`
+	// expiration is the time after which the offer can no longer be accepted.
+	Expiration time.Time `protobuf:"bytes,5,opt,name=expiration,proto3,stdtime" json:"expiration"`
+}
+
+func (m *ScopeSaleOffer) Reset()         { *m = ScopeSaleOffer{} }
+func (m *ScopeSaleOffer) String() string { return proto.CompactTextString(m) }
+func (*ScopeSaleOffer) ProtoMessage()    {}
+func (*ScopeSaleOffer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_edeea634bfb18aba, []int{10}
+}
+func (m *ScopeSaleOffer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScopeSaleOffer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScopeSaleOffer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScopeSaleOffer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScopeSaleOffer.Merge(m, src)
+}
+func (m *ScopeSaleOffer) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScopeSaleOffer) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScopeSaleOffer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScopeSaleOffer proto.InternalMessageInfo
+
+func (m *ScopeSaleOffer) GetSeller() string {
+	if m != nil {
+		return m.Seller
+	}
+	return ""
+}
+
+func (m *ScopeSaleOffer) GetBuyer() string {
+	if m != nil {
+		return m.Buyer
+	}
+	return ""
+}
+
+func (m *ScopeSaleOffer) GetPrice() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Price
+	}
+	return nil
+}
+
+func (m *ScopeSaleOffer) GetExpiration() time.Time {
+	if m != nil {
+		return m.Expiration
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterEnum("provenance.metadata.v1.RecordInputStatus", RecordInputStatus_name, RecordInputStatus_value)
 	proto.RegisterEnum("provenance.metadata.v1.ResultStatus", ResultStatus_name, ResultStatus_value)
 	proto.RegisterType((*Scope)(nil), "provenance.metadata.v1.Scope")
+	proto.RegisterType((*DataAccessGrant)(nil), "provenance.metadata.v1.DataAccessGrant")
 	proto.RegisterType((*Session)(nil), "provenance.metadata.v1.Session")
 	proto.RegisterType((*Record)(nil), "provenance.metadata.v1.Record")
+	proto.RegisterType((*RecordVersion)(nil), "provenance.metadata.v1.RecordVersion")
 	proto.RegisterType((*Process)(nil), "provenance.metadata.v1.Process")
 	proto.RegisterType((*RecordInput)(nil), "provenance.metadata.v1.RecordInput")
 	proto.RegisterType((*RecordOutput)(nil), "provenance.metadata.v1.RecordOutput")
 	proto.RegisterType((*Party)(nil), "provenance.metadata.v1.Party")
 	proto.RegisterType((*AuditFields)(nil), "provenance.metadata.v1.AuditFields")
+	proto.RegisterType((*ScopeSaleOffer)(nil), "provenance.metadata.v1.ScopeSaleOffer")
 }
 
 func init() {
@@ -750,81 +977,99 @@ func init() {
 }
 
 var fileDescriptor_edeea634bfb18aba = []byte{
-	// 1180 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4f, 0x6f, 0x1a, 0x57,
-	0x10, 0x67, 0x01, 0x83, 0x19, 0x68, 0x8d, 0x5f, 0x2c, 0x42, 0x68, 0xcc, 0xd2, 0x6d, 0xa5, 0xb8,
-	0x6e, 0x0a, 0xb5, 0xfb, 0x4f, 0x4a, 0xff, 0x89, 0xb5, 0xb1, 0x82, 0x92, 0xda, 0x68, 0x31, 0x97,
-	0x4a, 0x2d, 0x5a, 0x76, 0x9f, 0xf1, 0x2a, 0xc0, 0x5b, 0xed, 0x3e, 0x9c, 0xa0, 0xde, 0x2a, 0x55,
-	0x95, 0x7c, 0xca, 0x31, 0x17, 0x4b, 0xed, 0x07, 0xe8, 0xf7, 0xc8, 0x31, 0xc7, 0xaa, 0x87, 0x6d,
-	0x65, 0xdf, 0x7c, 0xe4, 0xd6, 0x5b, 0xb5, 0xef, 0xbd, 0x85, 0x25, 0x06, 0x2b, 0x55, 0x9b, 0xdb,
-	0xce, 0xcc, 0x6f, 0xe6, 0xcd, 0xfc, 0x66, 0xde, 0xbc, 0x05, 0xc5, 0x76, 0xc8, 0x09, 0x1e, 0xe8,
-	0x03, 0x03, 0x57, 0xfa, 0x98, 0xea, 0xa6, 0x4e, 0xf5, 0xca, 0xc9, 0x56, 0xc5, 0x35, 0x88, 0x8d,
-	0xcb, 0xb6, 0x43, 0x28, 0x41, 0xb9, 0x29, 0xa6, 0x1c, 0x60, 0xca, 0x27, 0x5b, 0x85, 0xb5, 0x2e,
-	0xe9, 0x12, 0x06, 0xa9, 0xf8, 0x5f, 0x1c, 0x5d, 0x90, 0xbb, 0x84, 0x74, 0x7b, 0xb8, 0xc2, 0xa4,
-	0xce, 0xf0, 0xa8, 0x42, 0xad, 0x3e, 0x76, 0xa9, 0xde, 0xb7, 0x05, 0xa0, 0xf4, 0x32, 0xc0, 0xc4,
-	0xae, 0xe1, 0x58, 0x36, 0x25, 0x8e, 0x40, 0x6c, 0x2e, 0x4a, 0xca, 0xc6, 0x86, 0x75, 0x64, 0x19,
-	0x3a, 0xb5, 0xc8, 0x80, 0x63, 0x95, 0xbf, 0xa3, 0xb0, 0xd4, 0xf4, 0x93, 0x45, 0x35, 0x58, 0x66,
-	0x59, 0xb7, 0x2d, 0x33, 0x2f, 0x95, 0xa4, 0x8d, 0x8c, 0xba, 0xf9, 0xdc, 0x93, 0x23, 0x7f, 0x78,
-	0xf2, 0xca, 0x37, 0x22, 0x48, 0xd5, 0x34, 0x1d, 0xec, 0xba, 0x63, 0x4f, 0x5e, 0x19, 0xe9, 0xfd,
-	0xde, 0x3d, 0x25, 0x70, 0x50, 0xb4, 0x24, 0xfb, 0xac, 0x9b, 0xe8, 0x3b, 0xc8, 0xce, 0x9c, 0xe3,
-	0x87, 0x8b, 0xb2, 0x70, 0xdb, 0x8b, 0xc3, 0xdd, 0x14, 0xe1, 0x5e, 0x72, 0x54, 0xb4, 0x95, 0x19,
-	0x55, 0xdd, 0x44, 0x9f, 0x43, 0x82, 0x3c, 0x1e, 0x60, 0xc7, 0xcd, 0xc7, 0x4a, 0xb1, 0x8d, 0xf4,
-	0xf6, 0x7a, 0x79, 0x3e, 0xbb, 0xe5, 0x86, 0xee, 0xd0, 0x91, 0x1a, 0xf7, 0xcf, 0xd4, 0x84, 0x0b,
-	0xfa, 0x0c, 0xd2, 0xbe, 0xb9, 0xad, 0x1b, 0x06, 0x76, 0xdd, 0x7c, 0xbc, 0x14, 0xdb, 0x48, 0xa9,
-	0xb9, 0xb1, 0x27, 0x23, 0x7e, 0x7e, 0xc8, 0xa8, 0x68, 0xc0, 0x52, 0x64, 0x02, 0xda, 0x87, 0x1b,
-	0x27, 0x7a, 0x6f, 0x88, 0xdb, 0x2c, 0x50, 0x5b, 0xe7, 0x89, 0xe7, 0x97, 0x4a, 0xd2, 0x46, 0x4a,
-	0x2d, 0x8e, 0x3d, 0xb9, 0xc0, 0x03, 0xcc, 0x01, 0x29, 0xda, 0x2a, 0xd3, 0x1e, 0xf8, 0x4a, 0x51,
-	0xf1, 0xbd, 0xf8, 0xb3, 0x5f, 0xe4, 0x88, 0xf2, 0x2c, 0x06, 0xc9, 0x26, 0x76, 0x5d, 0x8b, 0x0c,
-	0xd0, 0x03, 0x00, 0x97, 0x7f, 0x4e, 0xf9, 0xbf, 0xbb, 0x98, 0xb0, 0x55, 0x41, 0xd8, 0xc4, 0x45,
-	0xd1, 0x52, 0x42, 0x78, 0xfd, 0x3d, 0xf8, 0x12, 0x92, 0xb6, 0xee, 0x50, 0x0b, 0xff, 0xab, 0x26,
-	0x04, 0x3e, 0xe8, 0x7d, 0x88, 0x0f, 0xf4, 0x3e, 0xce, 0xc7, 0x19, 0x7b, 0x37, 0x2f, 0x3d, 0x39,
-	0x4e, 0x47, 0x36, 0x1e, 0x7b, 0x72, 0x9a, 0xa7, 0xe0, 0x4b, 0x8a, 0xc6, 0x40, 0x28, 0x0f, 0x49,
-	0x83, 0x0c, 0x28, 0x7e, 0x42, 0x19, 0xdb, 0x19, 0x2d, 0x10, 0x51, 0x0b, 0x96, 0xf4, 0xa1, 0x69,
-	0xd1, 0xbc, 0x51, 0x92, 0x36, 0xd2, 0xdb, 0xef, 0x2c, 0xca, 0xa1, 0xea, 0x83, 0xf6, 0x2c, 0xdc,
-	0x33, 0x5d, 0xb5, 0x30, 0xf6, 0xe4, 0x1c, 0x3f, 0x84, 0xf9, 0xde, 0x25, 0x7d, 0x8b, 0xe2, 0xbe,
-	0x4d, 0x47, 0x8a, 0xc6, 0xa3, 0x89, 0xd6, 0xfc, 0x16, 0x83, 0x84, 0x86, 0x0d, 0xe2, 0x98, 0xe8,
-	0x8e, 0x48, 0x57, 0x62, 0xe9, 0xde, 0xb8, 0xf4, 0xe4, 0xa8, 0x65, 0x8e, 0x3d, 0x39, 0xc5, 0xe3,
-	0xf8, 0x0c, 0xf1, 0x54, 0x67, 0x5b, 0x18, 0xfd, 0x6f, 0x2d, 0xfc, 0x1a, 0x92, 0xb6, 0x43, 0xd8,
-	0x98, 0xc6, 0x58, 0x7d, 0xf2, 0x42, 0x8e, 0x39, 0x6c, 0xc2, 0x32, 0x17, 0x51, 0x15, 0x12, 0xd6,
-	0xc0, 0x1e, 0x52, 0x3e, 0xe6, 0xd7, 0xf0, 0xc3, 0xcb, 0xac, 0xfb, 0xd8, 0xe0, 0xba, 0x70, 0x47,
-	0xb4, 0x0b, 0x49, 0x32, 0xa4, 0x2c, 0xc6, 0x12, 0x8b, 0xf1, 0xee, 0xf5, 0x31, 0x0e, 0x18, 0x38,
-	0x48, 0x44, 0xb8, 0xce, 0x1d, 0xc6, 0xc4, 0xff, 0x36, 0x8c, 0xa2, 0x5f, 0x3f, 0x40, 0x52, 0xf0,
-	0x80, 0x0a, 0x90, 0x0c, 0xee, 0x27, 0x6b, 0xd9, 0xfd, 0x88, 0x16, 0x28, 0xd0, 0x1a, 0xc4, 0x8f,
-	0x75, 0xf7, 0x98, 0x35, 0xc7, 0x37, 0x30, 0x09, 0x21, 0xd1, 0x61, 0x9f, 0xe8, 0x94, 0x68, 0x66,
-	0x0e, 0x12, 0x7d, 0x4c, 0x8f, 0x89, 0xc9, 0xc7, 0x54, 0x13, 0x12, 0x3f, 0x4e, 0xcd, 0x00, 0x08,
-	0x9e, 0xfd, 0xa4, 0x7e, 0x8a, 0x42, 0x3a, 0xc4, 0xe2, 0x24, 0x9e, 0x14, 0x8a, 0xb7, 0x07, 0x29,
-	0x87, 0x41, 0xa6, 0xb3, 0x71, 0x67, 0x7e, 0xe9, 0x59, 0x5e, 0xfa, 0x04, 0xad, 0xdc, 0x8f, 0x68,
-	0xcb, 0x5c, 0xaa, 0x9b, 0x93, 0x0a, 0x62, 0x33, 0x15, 0x6c, 0x41, 0xca, 0xbf, 0x34, 0xed, 0xd0,
-	0xbd, 0x5a, 0x9b, 0x86, 0x9a, 0x98, 0x14, 0x6d, 0xd9, 0xff, 0xde, 0xf7, 0x13, 0xaa, 0x42, 0xc2,
-	0xa5, 0x3a, 0x1d, 0xf2, 0x2d, 0xf6, 0xe6, 0xf6, 0x7b, 0xaf, 0x30, 0x1f, 0x4d, 0xe6, 0xa0, 0x09,
-	0x47, 0xc1, 0xc5, 0x32, 0x24, 0x5c, 0x32, 0x74, 0x0c, 0xac, 0x1c, 0x41, 0x26, 0x3c, 0x08, 0x3e,
-	0x0f, 0x2c, 0x57, 0xc1, 0x03, 0xcb, 0xf4, 0x8b, 0xc9, 0xb1, 0x51, 0x76, 0xec, 0x35, 0x23, 0xe5,
-	0x0e, 0x7b, 0x73, 0x4f, 0x54, 0xbe, 0x87, 0x25, 0xb6, 0x58, 0xfc, 0xe5, 0x30, 0xd3, 0xea, 0x69,
-	0xa3, 0x3f, 0x81, 0xb8, 0x43, 0x7a, 0x58, 0x1c, 0xf2, 0xf6, 0xb5, 0xfb, 0xe9, 0x70, 0x64, 0x63,
-	0x8d, 0xc1, 0x45, 0xfc, 0x9f, 0xe3, 0x90, 0x0e, 0x6d, 0x0d, 0xf4, 0xa3, 0x04, 0x19, 0xc3, 0xc1,
-	0x3a, 0xc5, 0x66, 0xdb, 0xd4, 0x29, 0x6f, 0x6c, 0x7a, 0xbb, 0x50, 0xe6, 0x2f, 0x71, 0x39, 0x78,
-	0x89, 0xcb, 0x87, 0xc1, 0x53, 0xad, 0xee, 0xf8, 0xa3, 0x7d, 0xe9, 0xc9, 0xb9, 0xb0, 0xdf, 0x74,
-	0xdb, 0x8c, 0x3d, 0x79, 0x9d, 0xf7, 0x66, 0xbe, 0x5d, 0x79, 0xfa, 0xa7, 0x2c, 0x69, 0x69, 0x61,
-	0xdc, 0xd5, 0x29, 0x46, 0x5f, 0x01, 0x04, 0xd8, 0xce, 0x88, 0x0f, 0xb0, 0x2a, 0x8f, 0x3d, 0xf9,
-	0xad, 0xd9, 0x38, 0x9d, 0x51, 0x78, 0xa7, 0xa5, 0x84, 0x5a, 0x1d, 0xb1, 0x22, 0x86, 0xb6, 0x39,
-	0x2d, 0x22, 0xf6, 0xea, 0x45, 0x84, 0xfd, 0xe6, 0x15, 0x31, 0xdf, 0x2e, 0x8a, 0x10, 0xc6, 0xa0,
-	0x88, 0x00, 0xdb, 0x19, 0x89, 0x41, 0x0d, 0x15, 0x31, 0xb5, 0xcd, 0x14, 0x21, 0xd4, 0xea, 0x08,
-	0x7d, 0x0a, 0xc9, 0x13, 0xec, 0xf8, 0x2b, 0x92, 0x4d, 0xed, 0x1b, 0xea, 0xed, 0xb1, 0x27, 0xe7,
-	0xc5, 0xdb, 0xcb, 0x0d, 0x61, 0xcf, 0x00, 0xec, 0xfb, 0xf5, 0xb1, 0xeb, 0xea, 0x5d, 0xcc, 0x56,
-	0x4f, 0x2a, 0xec, 0x27, 0x0c, 0x33, 0x7e, 0x42, 0xb7, 0xf9, 0xab, 0x04, 0xab, 0x57, 0xe6, 0x1f,
-	0x7d, 0x08, 0xb2, 0x56, 0xdb, 0x39, 0xd0, 0x76, 0xdb, 0xf5, 0xfd, 0x46, 0xeb, 0xb0, 0xdd, 0x3c,
-	0xac, 0x1e, 0xb6, 0x9a, 0xed, 0xd6, 0x7e, 0xb3, 0x51, 0xdb, 0xa9, 0xef, 0xd5, 0x6b, 0xbb, 0xd9,
-	0x48, 0x21, 0x7d, 0x7a, 0x56, 0x4a, 0xb6, 0x06, 0x8f, 0x06, 0xe4, 0xf1, 0x00, 0x95, 0xe1, 0xf6,
-	0x3c, 0x8f, 0x86, 0x76, 0xd0, 0x38, 0x68, 0xd6, 0x76, 0xb3, 0x52, 0x21, 0x73, 0x7a, 0x56, 0x5a,
-	0x6e, 0x38, 0xc4, 0x26, 0x2e, 0x36, 0xd1, 0x26, 0x14, 0xe6, 0xe1, 0xb9, 0x2e, 0x1b, 0x2d, 0xc0,
-	0xe9, 0x59, 0x49, 0xbc, 0x4f, 0x9b, 0x43, 0xff, 0xd6, 0x4d, 0xef, 0x0a, 0x5a, 0x87, 0x5b, 0x5a,
-	0xad, 0xd9, 0x7a, 0x38, 0x3f, 0x2f, 0x94, 0x03, 0x34, 0x6b, 0x6e, 0x54, 0x9b, 0xcd, 0xac, 0x74,
-	0x55, 0xdf, 0x7c, 0x50, 0x6f, 0x64, 0xa3, 0x57, 0xf5, 0x7b, 0xd5, 0xfa, 0xc3, 0x6c, 0x4c, 0x7d,
-	0xf4, 0xfc, 0xbc, 0x28, 0xbd, 0x38, 0x2f, 0x4a, 0x7f, 0x9d, 0x17, 0xa5, 0xa7, 0x17, 0xc5, 0xc8,
-	0x8b, 0x8b, 0x62, 0xe4, 0xf7, 0x8b, 0x62, 0x04, 0x6e, 0x59, 0x64, 0xc1, 0x7d, 0x6b, 0x48, 0xdf,
-	0x7e, 0xdc, 0xb5, 0xe8, 0xf1, 0xb0, 0x53, 0x36, 0x48, 0xbf, 0x32, 0x05, 0x7d, 0x60, 0x91, 0x90,
-	0x54, 0x79, 0x32, 0xfd, 0x6d, 0xf5, 0xf7, 0x95, 0xdb, 0x49, 0xb0, 0xe9, 0xfc, 0xe8, 0x9f, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x99, 0x3c, 0x21, 0x36, 0x6f, 0x0b, 0x00, 0x00,
+	// 1463 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x4b, 0x6f, 0x1b, 0xd5,
+	0x17, 0xf7, 0xf8, 0x19, 0x1f, 0xa7, 0x8d, 0x7b, 0x5b, 0xa5, 0xae, 0xff, 0xad, 0xc7, 0xff, 0x29,
+	0x52, 0x42, 0x68, 0xed, 0x26, 0x40, 0x91, 0x4a, 0x01, 0x65, 0xf2, 0xa0, 0x56, 0x4b, 0x62, 0x8d,
+	0x13, 0x16, 0x48, 0x60, 0x8d, 0x67, 0x6e, 0x9c, 0x51, 0x6d, 0xdf, 0xd1, 0xcc, 0x75, 0x5a, 0x8b,
+	0x1d, 0x12, 0x42, 0xea, 0xaa, 0xcb, 0xb2, 0x28, 0x02, 0xc4, 0x0a, 0x89, 0xef, 0x51, 0xb1, 0xea,
+	0x12, 0xb1, 0x98, 0xa2, 0x76, 0xd7, 0xa5, 0x3f, 0x01, 0xba, 0x8f, 0xb1, 0xc7, 0x89, 0x93, 0x16,
+	0x51, 0x56, 0xbe, 0xe7, 0x79, 0xcf, 0xe3, 0xe7, 0x73, 0xee, 0x80, 0xe6, 0x7a, 0xe4, 0x00, 0xf7,
+	0xcc, 0x9e, 0x85, 0xab, 0x5d, 0x4c, 0x4d, 0xdb, 0xa4, 0x66, 0xf5, 0x60, 0xb9, 0xea, 0x5b, 0xc4,
+	0xc5, 0x15, 0xd7, 0x23, 0x94, 0xa0, 0xf9, 0xb1, 0x4e, 0x25, 0xd4, 0xa9, 0x1c, 0x2c, 0x17, 0x4b,
+	0x16, 0xf1, 0xbb, 0xc4, 0xaf, 0xb6, 0x4c, 0x1f, 0x57, 0x0f, 0x96, 0x5b, 0x98, 0x9a, 0xcb, 0x55,
+	0x8b, 0x38, 0x3d, 0x61, 0x57, 0x3c, 0xd7, 0x26, 0x6d, 0xc2, 0x8f, 0x55, 0x76, 0x92, 0x5c, 0xb5,
+	0x4d, 0x48, 0xbb, 0x83, 0xab, 0x9c, 0x6a, 0xf5, 0xf7, 0xaa, 0xd4, 0xe9, 0x62, 0x9f, 0x9a, 0x5d,
+	0x57, 0x2a, 0x94, 0x0f, 0x2b, 0xd8, 0xd8, 0xb7, 0x3c, 0xc7, 0xa5, 0xc4, 0x93, 0x1a, 0x4b, 0xc7,
+	0x05, 0xed, 0x62, 0xcb, 0xd9, 0x73, 0x2c, 0x93, 0x3a, 0x44, 0x06, 0xa1, 0xfd, 0x90, 0x84, 0x54,
+	0x83, 0x25, 0x83, 0x36, 0x60, 0x86, 0x67, 0xd5, 0x74, 0xec, 0x82, 0x52, 0x56, 0x16, 0x67, 0xf5,
+	0xa5, 0x27, 0x81, 0x1a, 0xfb, 0x33, 0x50, 0xe7, 0x3e, 0x93, 0x4e, 0x56, 0x6d, 0xdb, 0xc3, 0xbe,
+	0x3f, 0x0c, 0xd4, 0xb9, 0x81, 0xd9, 0xed, 0xdc, 0xd0, 0x42, 0x03, 0xcd, 0xc8, 0xf0, 0x63, 0xcd,
+	0x46, 0x5f, 0x42, 0x7e, 0xe2, 0x1e, 0xe6, 0x2e, 0xce, 0xdd, 0xad, 0x1c, 0xef, 0xee, 0xbc, 0x74,
+	0x77, 0xc8, 0x50, 0x33, 0xe6, 0x26, 0x58, 0x35, 0x1b, 0x7d, 0x08, 0x69, 0x72, 0xaf, 0x87, 0x3d,
+	0xbf, 0x90, 0x28, 0x27, 0x16, 0x73, 0x2b, 0x97, 0x2a, 0xd3, 0xab, 0x5f, 0xa9, 0x9b, 0x1e, 0x1d,
+	0xe8, 0x49, 0x76, 0xa7, 0x21, 0x4d, 0xd0, 0x07, 0x90, 0x63, 0xe2, 0xa6, 0x69, 0x59, 0xd8, 0xf7,
+	0x0b, 0xc9, 0x72, 0x62, 0x31, 0xab, 0xcf, 0x0f, 0x03, 0x15, 0x89, 0xfb, 0x23, 0x42, 0xcd, 0x00,
+	0x1e, 0x22, 0x27, 0xd0, 0x16, 0x9c, 0x3d, 0x30, 0x3b, 0x7d, 0xdc, 0xe4, 0x8e, 0x9a, 0xa6, 0x08,
+	0xbc, 0x90, 0x2a, 0x2b, 0x8b, 0x59, 0xbd, 0x34, 0x0c, 0xd4, 0xa2, 0x70, 0x30, 0x45, 0x49, 0x33,
+	0xce, 0x70, 0xee, 0x36, 0x63, 0xca, 0x8c, 0xd1, 0xcf, 0x0a, 0xa0, 0xc8, 0x65, 0xcd, 0xb6, 0x67,
+	0xf6, 0xa8, 0x5f, 0x48, 0xf3, 0x94, 0x16, 0x8e, 0x4b, 0x69, 0x7d, 0x14, 0xd0, 0xa7, 0x4c, 0x5f,
+	0xbf, 0xcd, 0x92, 0x7b, 0x19, 0xa8, 0x17, 0x8f, 0xba, 0xba, 0x42, 0xba, 0x0e, 0xc5, 0x5d, 0x97,
+	0x0e, 0x86, 0x81, 0x7a, 0xf9, 0x48, 0x76, 0x47, 0xb4, 0x34, 0x23, 0x6f, 0x4f, 0x7a, 0xf7, 0x6f,
+	0x24, 0x1f, 0xfd, 0xa8, 0xc6, 0xb4, 0x5f, 0x14, 0x98, 0x3b, 0x74, 0x31, 0x2a, 0x40, 0x26, 0x2c,
+	0x01, 0x43, 0x4a, 0xd6, 0x08, 0x49, 0x84, 0x20, 0xe9, 0x91, 0x0e, 0xe6, 0x1d, 0xcf, 0x1a, 0xfc,
+	0x8c, 0x2c, 0x00, 0x7c, 0xdf, 0x75, 0x3c, 0xde, 0xc2, 0x42, 0xa2, 0xac, 0x2c, 0xe6, 0x56, 0x8a,
+	0x15, 0x81, 0xe2, 0x4a, 0x88, 0xe2, 0xca, 0x4e, 0x08, 0x73, 0x7d, 0xe1, 0x49, 0xa0, 0x2a, 0xc3,
+	0x40, 0xfd, 0x9f, 0x08, 0x7b, 0x6c, 0x1b, 0x09, 0xf7, 0xe1, 0x33, 0x55, 0x31, 0x22, 0x6e, 0xb5,
+	0x47, 0x09, 0xc8, 0x34, 0xb0, 0xef, 0x3b, 0xa4, 0x87, 0x6e, 0x03, 0xf8, 0xe2, 0x38, 0xc6, 0xf2,
+	0x95, 0xe3, 0xc1, 0x77, 0x46, 0x82, 0x6f, 0x64, 0xa2, 0x19, 0x59, 0x49, 0xfc, 0xf7, 0x78, 0xfe,
+	0x08, 0x32, 0xae, 0xe9, 0x51, 0x07, 0xff, 0x23, 0x40, 0x87, 0x36, 0xe8, 0x1d, 0x48, 0xf6, 0xcc,
+	0x2e, 0x2e, 0x24, 0x39, 0x12, 0xcf, 0xbf, 0x0c, 0xd4, 0x24, 0x1d, 0xb8, 0x78, 0x18, 0xa8, 0x39,
+	0x11, 0x02, 0xa3, 0x34, 0x83, 0x2b, 0xb1, 0xb6, 0x59, 0xa4, 0x47, 0xf1, 0x7d, 0xca, 0x91, 0x3b,
+	0x6b, 0x84, 0x24, 0xda, 0x85, 0x94, 0xd9, 0xb7, 0x1d, 0x5a, 0xb0, 0x78, 0x77, 0x2e, 0x1f, 0x17,
+	0xc3, 0x2a, 0x53, 0xda, 0x74, 0x70, 0xc7, 0xf6, 0xf5, 0xe2, 0x30, 0x50, 0xe7, 0xc5, 0x25, 0xdc,
+	0x36, 0x0a, 0x26, 0xe1, 0x4d, 0x22, 0xe8, 0xb7, 0x04, 0xa4, 0x0d, 0x6c, 0x11, 0xcf, 0x46, 0x0b,
+	0x32, 0x5c, 0x8e, 0x1a, 0xfd, 0xec, 0xcb, 0x40, 0x8d, 0x3b, 0xf6, 0x30, 0x50, 0xb3, 0xc2, 0x0f,
+	0xab, 0x90, 0x08, 0x75, 0xb2, 0x85, 0xf1, 0x7f, 0xd7, 0xc2, 0x4f, 0x20, 0xe3, 0x7a, 0x84, 0xff,
+	0xe5, 0x05, 0xfa, 0xd4, 0x63, 0x6b, 0x2c, 0xd4, 0x46, 0x55, 0x16, 0x24, 0x5a, 0x85, 0xb4, 0xd3,
+	0x73, 0xfb, 0x54, 0x8c, 0x8c, 0x13, 0xea, 0x23, 0xd2, 0xac, 0x31, 0xdd, 0x70, 0xf4, 0x08, 0x43,
+	0xb4, 0x0e, 0x19, 0xd2, 0xa7, 0xdc, 0x47, 0x8a, 0xfb, 0x78, 0xeb, 0x64, 0x1f, 0xdb, 0x7d, 0x3a,
+	0x76, 0x12, 0x9a, 0x4e, 0x05, 0x63, 0xfa, 0x8d, 0x81, 0x51, 0xf6, 0xeb, 0x7b, 0x05, 0x4e, 0x89,
+	0x20, 0x3e, 0xc7, 0x1e, 0xff, 0x43, 0xdd, 0x84, 0xb4, 0xc7, 0x19, 0xbc, 0x71, 0xb9, 0x95, 0xd2,
+	0xc9, 0xb1, 0x87, 0xa9, 0x0b, 0x1b, 0x06, 0xbb, 0x03, 0xe1, 0x88, 0x37, 0x32, 0x69, 0x84, 0x24,
+	0x9a, 0x87, 0xf4, 0x3e, 0x76, 0xda, 0xfb, 0x94, 0xf7, 0x25, 0x61, 0x48, 0x8a, 0x59, 0xd8, 0xb8,
+	0x83, 0x29, 0xb6, 0x39, 0xb0, 0x67, 0x8c, 0x90, 0xd4, 0xbe, 0x86, 0x8c, 0xec, 0x11, 0x2a, 0x1e,
+	0x1a, 0x42, 0xb7, 0x62, 0xe3, 0x31, 0x74, 0x0e, 0x92, 0xfb, 0xa6, 0xbf, 0x2f, 0xc6, 0xd0, 0xad,
+	0x98, 0xc1, 0x29, 0x36, 0x9c, 0x38, 0xfa, 0x12, 0x62, 0x38, 0xb1, 0x33, 0x0b, 0xa1, 0x8b, 0xe9,
+	0x3e, 0x11, 0x37, 0x65, 0x0d, 0x49, 0x89, 0x52, 0xe8, 0xb3, 0x00, 0x12, 0x03, 0xac, 0x60, 0xdf,
+	0xc6, 0x21, 0x17, 0xe9, 0xf0, 0xc8, 0x9f, 0x12, 0xf1, 0xb7, 0x09, 0x59, 0x91, 0xf6, 0x18, 0xb7,
+	0x0b, 0xd3, 0xdb, 0x92, 0x17, 0x6d, 0x19, 0x69, 0x6b, 0xb7, 0x62, 0xc6, 0x8c, 0xa0, 0x6a, 0xf6,
+	0x28, 0x83, 0xc4, 0x44, 0x06, 0xcb, 0x90, 0x65, 0x7f, 0xe8, 0x66, 0xe4, 0x3f, 0x7f, 0x6e, 0xec,
+	0x6a, 0x24, 0xd2, 0x8c, 0x19, 0x76, 0xde, 0x62, 0x01, 0xad, 0x42, 0xda, 0xa7, 0x26, 0xed, 0x8b,
+	0x6d, 0x75, 0x7a, 0xe5, 0xed, 0xd7, 0xc0, 0x6e, 0x83, 0x1b, 0x18, 0xd2, 0x50, 0xd6, 0x62, 0x06,
+	0xd2, 0x3e, 0xe9, 0x7b, 0x16, 0xd6, 0xf6, 0x60, 0x36, 0x0a, 0x52, 0x56, 0x07, 0x1e, 0xab, 0xac,
+	0x03, 0x8f, 0xf4, 0xe6, 0xe8, 0xda, 0x38, 0xbf, 0xf6, 0x04, 0xb8, 0xfb, 0xfd, 0xce, 0xd4, 0x1b,
+	0xb5, 0xaf, 0x20, 0xc5, 0x87, 0xde, 0x09, 0xfb, 0xe6, 0xfd, 0xc8, 0xbe, 0x39, 0xbd, 0xf2, 0xff,
+	0x13, 0x67, 0xe7, 0xce, 0xc0, 0xc5, 0x62, 0x25, 0x49, 0xff, 0xdf, 0x25, 0x21, 0x17, 0x99, 0x68,
+	0xe8, 0x1b, 0x05, 0x66, 0x2d, 0x0f, 0x9b, 0x14, 0xdb, 0x4d, 0xdb, 0xa4, 0xb8, 0xa0, 0xbc, 0x72,
+	0x57, 0xad, 0xc9, 0x15, 0x3c, 0x1f, 0xb5, 0x9b, 0x58, 0xbe, 0x97, 0x44, 0x6f, 0xa6, 0xcb, 0xc5,
+	0x1e, 0xcb, 0x49, 0xe1, 0xba, 0x49, 0x31, 0xfa, 0x18, 0x20, 0xd4, 0x6d, 0x0d, 0x04, 0x80, 0x75,
+	0x75, 0xbc, 0x0d, 0xc7, 0xb2, 0xe8, 0xbc, 0xcd, 0x4a, 0xb6, 0x3e, 0xe0, 0x49, 0xf4, 0x5d, 0x7b,
+	0x9c, 0x44, 0xe2, 0xf5, 0x93, 0x88, 0xda, 0x4d, 0x4b, 0x62, 0xba, 0x5c, 0x26, 0x21, 0x85, 0x61,
+	0x12, 0xa1, 0x6e, 0x6b, 0x50, 0x48, 0x1e, 0x4e, 0x62, 0x2c, 0x9b, 0x48, 0x42, 0xb2, 0xf5, 0x01,
+	0xba, 0x3e, 0x1e, 0x19, 0x0c, 0xb5, 0xa7, 0xf4, 0x8b, 0xc3, 0x40, 0x2d, 0x08, 0x63, 0x29, 0x88,
+	0x5a, 0x8e, 0x06, 0xca, 0x75, 0xc8, 0x74, 0xb1, 0xef, 0x9b, 0x6d, 0xcc, 0xc7, 0x62, 0x36, 0x6a,
+	0x27, 0x05, 0x13, 0x76, 0x92, 0xa7, 0xfd, 0x1e, 0x87, 0xd3, 0xfc, 0x15, 0xdc, 0x30, 0x3b, 0x78,
+	0x7b, 0x6f, 0x0f, 0x7b, 0x6f, 0xea, 0x39, 0x3c, 0x0f, 0x69, 0x1f, 0x77, 0x3a, 0xd8, 0x93, 0x4f,
+	0x22, 0x49, 0xa1, 0x6b, 0x90, 0x6a, 0xf5, 0x07, 0xd8, 0x13, 0x7f, 0xf0, 0xe8, 0x32, 0xe5, 0xec,
+	0x89, 0x65, 0xca, 0x39, 0xc8, 0x84, 0x94, 0xeb, 0x39, 0x16, 0x96, 0x3b, 0xe8, 0x42, 0x45, 0x7c,
+	0x5e, 0x54, 0xd8, 0xe7, 0x45, 0x45, 0x7e, 0x5e, 0x54, 0xd6, 0x88, 0xd3, 0xd3, 0xaf, 0xb1, 0x40,
+	0x7f, 0x7d, 0xa6, 0x2e, 0xb6, 0x1d, 0xba, 0xdf, 0x6f, 0x55, 0x2c, 0xd2, 0xad, 0xca, 0x6f, 0x11,
+	0xf1, 0x73, 0xd5, 0xb7, 0xef, 0x56, 0xd9, 0x84, 0xf0, 0xb9, 0x81, 0x6f, 0x08, 0xcf, 0x68, 0x7d,
+	0xe2, 0xa5, 0x96, 0x7a, 0x25, 0x70, 0x66, 0xd8, 0x45, 0x87, 0x9f, 0x62, 0x4b, 0x3f, 0x29, 0x70,
+	0xe6, 0xc8, 0x30, 0x41, 0xd7, 0x40, 0x35, 0x36, 0xd6, 0xb6, 0x8d, 0xf5, 0x66, 0x6d, 0xab, 0xbe,
+	0xbb, 0xd3, 0x6c, 0xec, 0xac, 0xee, 0xec, 0x36, 0x9a, 0xbb, 0x5b, 0x8d, 0xfa, 0xc6, 0x5a, 0x6d,
+	0xb3, 0xb6, 0xb1, 0x9e, 0x8f, 0x15, 0x73, 0x0f, 0x1e, 0x97, 0x33, 0xbb, 0xbd, 0xbb, 0x3d, 0x72,
+	0xaf, 0x87, 0x2a, 0x70, 0x71, 0x9a, 0x45, 0xdd, 0xd8, 0xae, 0x6f, 0x37, 0x36, 0xd6, 0xf3, 0x4a,
+	0x71, 0xf6, 0xc1, 0xe3, 0xf2, 0x4c, 0xdd, 0x23, 0x2e, 0xf1, 0xb1, 0x8d, 0x96, 0xa0, 0x38, 0x4d,
+	0x5f, 0xf0, 0xf2, 0xf1, 0x22, 0x3c, 0x78, 0x5c, 0x96, 0x0f, 0x91, 0xa5, 0x3e, 0xcc, 0x46, 0x07,
+	0x0f, 0xba, 0x04, 0x17, 0x8c, 0x8d, 0xc6, 0xee, 0x9d, 0xe9, 0x71, 0xa1, 0x79, 0x40, 0x93, 0xe2,
+	0xfa, 0x6a, 0xa3, 0x91, 0x57, 0x8e, 0xf2, 0x1b, 0xb7, 0x6b, 0xf5, 0x7c, 0xfc, 0x28, 0x7f, 0x73,
+	0xb5, 0x76, 0x27, 0x9f, 0xd0, 0xef, 0x3e, 0x79, 0x5e, 0x52, 0x9e, 0x3e, 0x2f, 0x29, 0x7f, 0x3d,
+	0x2f, 0x29, 0x0f, 0x5f, 0x94, 0x62, 0x4f, 0x5f, 0x94, 0x62, 0x7f, 0xbc, 0x28, 0xc5, 0xe0, 0x82,
+	0x43, 0x8e, 0x19, 0x5e, 0x75, 0xe5, 0x8b, 0xf7, 0x22, 0x8d, 0x1c, 0x2b, 0x5d, 0x75, 0x48, 0x84,
+	0xaa, 0xde, 0x1f, 0x7f, 0xeb, 0xf1, 0xd6, 0xb6, 0xd2, 0xbc, 0x63, 0xef, 0xfe, 0x3d, 0x00, 0xf9,
+	0x1c, 0x12, 0xc8, 0xc4, 0x0e, 0x00, 0x00,
 }
 
 func (m *Scope) Marshal() (dAtA []byte, err error) {
@@ -847,6 +1092,20 @@ func (m *Scope) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DataAccessGrants) > 0 {
+		for iNdEx := len(m.DataAccessGrants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DataAccessGrants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintScope(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.ValueOwnerAddress) > 0 {
 		i -= len(m.ValueOwnerAddress)
 		copy(dAtA[i:], m.ValueOwnerAddress)
@@ -900,6 +1159,53 @@ func (m *Scope) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DataAccessGrant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DataAccessGrant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DataAccessGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expiration != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintScope(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Role) > 0 {
+		i -= len(m.Role)
+		copy(dAtA[i:], m.Role)
+		i = encodeVarintScope(dAtA, i, uint64(len(m.Role)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintScope(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Session) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1073,6 +1379,59 @@ func (m *Record) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RecordVersion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordVersion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Deleted {
+		i--
+		if m.Deleted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Height != 0 {
+		i = encodeVarintScope(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Version != 0 {
+		i = encodeVarintScope(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintScope(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Process) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1340,12 +1699,12 @@ func (m *AuditFields) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x22
 	}
-	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdatedDate, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedDate):])
-	if err3 != nil {
-		return 0, err3
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdatedDate, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedDate):])
+	if err5 != nil {
+		return 0, err5
 	}
-	i -= n3
-	i = encodeVarintScope(dAtA, i, uint64(n3))
+	i -= n5
+	i = encodeVarintScope(dAtA, i, uint64(n5))
 	i--
 	dAtA[i] = 0x1a
 	if len(m.CreatedBy) > 0 {
@@ -1355,42 +1714,111 @@ func (m *AuditFields) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
-	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedDate, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedDate):])
-	if err4 != nil {
-		return 0, err4
+	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedDate, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedDate):])
+	if err6 != nil {
+		return 0, err6
 	}
-	i -= n4
-	i = encodeVarintScope(dAtA, i, uint64(n4))
+	i -= n6
+	i = encodeVarintScope(dAtA, i, uint64(n6))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintScope(dAtA []byte, offset int, v uint64) int {
-	offset -= sovScope(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ScopeSaleOffer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Scope) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *ScopeSaleOffer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScopeSaleOffer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.ScopeId.Size()
-	n += 1 + l + sovScope(uint64(l))
-	l = m.SpecificationId.Size()
-	n += 1 + l + sovScope(uint64(l))
-	if len(m.Owners) > 0 {
-		for _, e := range m.Owners {
-			l = e.Size()
-			n += 1 + l + sovScope(uint64(l))
+	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiration):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintScope(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Price) > 0 {
+		for iNdEx := len(m.Price) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Price[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintScope(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintScope(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Seller) > 0 {
+		i -= len(m.Seller)
+		copy(dAtA[i:], m.Seller)
+		i = encodeVarintScope(dAtA, i, uint64(len(m.Seller)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.ScopeId.Size()
+		i -= size
+		if _, err := m.ScopeId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintScope(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintScope(dAtA []byte, offset int, v uint64) int {
+	offset -= sovScope(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Scope) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ScopeId.Size()
+	n += 1 + l + sovScope(uint64(l))
+	l = m.SpecificationId.Size()
+	n += 1 + l + sovScope(uint64(l))
+	if len(m.Owners) > 0 {
+		for _, e := range m.Owners {
+			l = e.Size()
+			n += 1 + l + sovScope(uint64(l))
 		}
 	}
 	if len(m.DataAccess) > 0 {
@@ -1403,6 +1831,33 @@ func (m *Scope) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovScope(uint64(l))
 	}
+	if len(m.DataAccessGrants) > 0 {
+		for _, e := range m.DataAccessGrants {
+			l = e.Size()
+			n += 1 + l + sovScope(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DataAccessGrant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovScope(uint64(l))
+	}
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovScope(uint64(l))
+	}
+	if m.Expiration != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration)
+		n += 1 + l + sovScope(uint64(l))
+	}
 	return n
 }
 
@@ -1468,6 +1923,26 @@ func (m *Record) Size() (n int) {
 	return n
 }
 
+func (m *RecordVersion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Record.Size()
+	n += 1 + l + sovScope(uint64(l))
+	if m.Version != 0 {
+		n += 1 + sovScope(uint64(m.Version))
+	}
+	if m.Height != 0 {
+		n += 1 + sovScope(uint64(m.Height))
+	}
+	if m.Deleted {
+		n += 2
+	}
+	return n
+}
+
 func (m *Process) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1611,6 +2086,33 @@ func (m *AuditFields) Size() (n int) {
 	return n
 }
 
+func (m *ScopeSaleOffer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ScopeId.Size()
+	n += 1 + l + sovScope(uint64(l))
+	l = len(m.Seller)
+	if l > 0 {
+		n += 1 + l + sovScope(uint64(l))
+	}
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovScope(uint64(l))
+	}
+	if len(m.Price) > 0 {
+		for _, e := range m.Price {
+			l = e.Size()
+			n += 1 + l + sovScope(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiration)
+	n += 1 + l + sovScope(uint64(l))
+	return n
+}
+
 func sovScope(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1810,6 +2312,190 @@ func (m *Scope) Unmarshal(dAtA []byte) error {
 			}
 			m.ValueOwnerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataAccessGrants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataAccessGrants = append(m.DataAccessGrants, DataAccessGrant{})
+			if err := m.DataAccessGrants[len(m.DataAccessGrants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScope(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthScope
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DataAccessGrant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScope
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DataAccessGrant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DataAccessGrant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Role = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipScope(dAtA[iNdEx:])
@@ -2246,7 +2932,124 @@ func (m *Record) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Outputs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Outputs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Outputs = append(m.Outputs, RecordOutput{})
+			if err := m.Outputs[len(m.Outputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecificationId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SpecificationId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScope(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthScope
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecordVersion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScope
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecordVersion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecordVersion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2273,16 +3076,15 @@ func (m *Record) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Outputs = append(m.Outputs, RecordOutput{})
-			if err := m.Outputs[len(m.Outputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpecificationId", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			var byteLen int
+			m.Version = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowScope
@@ -2292,25 +3094,50 @@ func (m *Record) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Version |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthScope
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthScope
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deleted", wireType)
 			}
-			if err := m.SpecificationId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
+			m.Deleted = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipScope(dAtA[iNdEx:])
@@ -3144,6 +3971,220 @@ func (m *AuditFields) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ScopeSaleOffer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScope
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScopeSaleOffer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScopeSaleOffer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ScopeId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Seller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buyer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Price = append(m.Price, types.Coin{})
+			if err := m.Price[len(m.Price)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScope(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthScope
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipScope(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3,6 +3,7 @@ package types
 import (
 	"encoding/hex"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -102,6 +103,34 @@ func (s *ScopeTestSuite) TestScopeValidateBasic() {
 			"invalid scope owners: invalid party address [:invalid]: decoding bech32 failed: invalid separator index -1",
 			true,
 		},
+		{
+			"valid data access grant",
+			withGrants(NewScope(ScopeMetadataAddress(uuid.New()), ScopeSpecMetadataAddress(uuid.New()), ownerPartyList(s.Addr), []string{s.Addr}, ""),
+				DataAccessGrant{Address: s.Addr, Role: "auditor"}),
+			"",
+			false,
+		},
+		{
+			"data access grant address not in data access",
+			withGrants(NewScope(ScopeMetadataAddress(uuid.New()), ScopeSpecMetadataAddress(uuid.New()), ownerPartyList(s.Addr), []string{}, ""),
+				DataAccessGrant{Address: s.Addr, Role: "auditor"}),
+			fmt.Sprintf("data access grant address %s is not in the scope's data access list", s.Addr),
+			true,
+		},
+		{
+			"duplicate data access grant",
+			withGrants(NewScope(ScopeMetadataAddress(uuid.New()), ScopeSpecMetadataAddress(uuid.New()), ownerPartyList(s.Addr), []string{s.Addr}, ""),
+				DataAccessGrant{Address: s.Addr, Role: "auditor"}, DataAccessGrant{Address: s.Addr, Role: "vendor"}),
+			fmt.Sprintf("duplicate data access grant for address %s", s.Addr),
+			true,
+		},
+		{
+			"data access grant role too long",
+			withGrants(NewScope(ScopeMetadataAddress(uuid.New()), ScopeSpecMetadataAddress(uuid.New()), ownerPartyList(s.Addr), []string{s.Addr}, ""),
+				DataAccessGrant{Address: s.Addr, Role: strings.Repeat("r", 65)}),
+			"invalid data access grant on scope: role length 65 exceeds maximum length of 64",
+			true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -119,6 +148,41 @@ func (s *ScopeTestSuite) TestScopeValidateBasic() {
 	}
 }
 
+func withGrants(scope *Scope, grants ...DataAccessGrant) *Scope {
+	scope.DataAccessGrants = grants
+	return scope
+}
+
+func (s *ScopeTestSuite) TestScopeRemoveExpiredDataAccess() {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	past, future := now.Add(-1*time.Second), now.Add(time.Hour)
+	other := sdk.AccAddress("other_address_______").String()
+	third := sdk.AccAddress("third_address_______").String()
+	scope := withGrants(NewScope(ScopeMetadataAddress(uuid.New()), ScopeSpecMetadataAddress(uuid.New()), ownerPartyList(s.Addr), []string{s.Addr, other, third}, ""),
+		DataAccessGrant{Address: s.Addr, Role: "auditor", Expiration: &past},
+		DataAccessGrant{Address: other, Expiration: &future},
+		DataAccessGrant{Address: third, Role: "vendor", Expiration: &now},
+	)
+
+	expired := scope.RemoveExpiredDataAccess(now)
+	s.Assert().Equal([]string{s.Addr, third}, expired, "expired addresses")
+	s.Assert().Equal([]string{other}, scope.DataAccess, "remaining data access")
+	s.Assert().Equal([]DataAccessGrant{{Address: other, Expiration: &future}}, scope.DataAccessGrants, "remaining grants")
+	s.Assert().Empty(scope.RemoveExpiredDataAccess(now), "expired addresses on second call")
+}
+
+func (s *ScopeTestSuite) TestScopeAddDataAccessGrants() {
+	other := sdk.AccAddress("other_address_______").String()
+	scope := withGrants(NewScope(ScopeMetadataAddress(uuid.New()), ScopeSpecMetadataAddress(uuid.New()), ownerPartyList(s.Addr), []string{s.Addr}, ""),
+		DataAccessGrant{Address: s.Addr, Role: "vendor"},
+	)
+
+	scope.AddDataAccessGrants([]string{other, other, s.Addr}, "auditor", nil)
+	s.Assert().Equal([]string{s.Addr, other}, scope.DataAccess, "data access")
+	s.Assert().Equal([]DataAccessGrant{{Address: s.Addr, Role: "vendor"}, {Address: other, Role: "auditor"}}, scope.DataAccessGrants, "grants")
+	s.Assert().NoError(scope.ValidateBasic(), "ValidateBasic")
+}
+
 func (s *ScopeTestSuite) TestScopeAddAccess() {
 	tests := []struct {
 		name       string
@@ -6,22 +6,28 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	p8e "github.com/provenance-io/provenance/x/metadata/types/p8e"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -126,6 +132,137 @@ func (m *MsgWriteScopeResponse) GetScopeIdInfo() *ScopeIdInfo {
 	return nil
 }
 
+// MsgWriteScopeBundleRequest is the request type for the Msg/WriteScopeBundle RPC method.
+type MsgWriteScopeBundleRequest struct {
+	// bundles are the scopes, with their sessions and records, to add or update.
+	// They are applied in order, and either all of them are written or none are.
+	Bundles []ScopeBundle `protobuf:"bytes,1,rep,name=bundles,proto3" json:"bundles"`
+	// signers is the list of address of those signing this request.
+	Signers []string `protobuf:"bytes,2,rep,name=signers,proto3" json:"signers,omitempty"`
+}
+
+func (m *MsgWriteScopeBundleRequest) Reset()      { *m = MsgWriteScopeBundleRequest{} }
+func (*MsgWriteScopeBundleRequest) ProtoMessage() {}
+func (*MsgWriteScopeBundleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{2}
+}
+func (m *MsgWriteScopeBundleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWriteScopeBundleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWriteScopeBundleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWriteScopeBundleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWriteScopeBundleRequest.Merge(m, src)
+}
+func (m *MsgWriteScopeBundleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWriteScopeBundleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWriteScopeBundleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWriteScopeBundleRequest proto.InternalMessageInfo
+
+// ScopeBundle is a scope along with sessions and records in it to write.
+type ScopeBundle struct {
+	// scope is the Scope you want added or updated.
+	Scope Scope `protobuf:"bytes,1,opt,name=scope,proto3" json:"scope"`
+	// sessions are the sessions in the scope to add or update.
+	Sessions []Session `protobuf:"bytes,2,rep,name=sessions,proto3" json:"sessions"`
+	// records are the records in the scope to add or update.
+	// Each record's session must either already exist or be one of this bundle's sessions.
+	Records []Record `protobuf:"bytes,3,rep,name=records,proto3" json:"records"`
+}
+
+func (m *ScopeBundle) Reset()         { *m = ScopeBundle{} }
+func (m *ScopeBundle) String() string { return proto.CompactTextString(m) }
+func (*ScopeBundle) ProtoMessage()    {}
+func (*ScopeBundle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{3}
+}
+func (m *ScopeBundle) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScopeBundle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScopeBundle.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScopeBundle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScopeBundle.Merge(m, src)
+}
+func (m *ScopeBundle) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScopeBundle) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScopeBundle.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScopeBundle proto.InternalMessageInfo
+
+// MsgWriteScopeBundleResponse is the response type for the Msg/WriteScopeBundle RPC method.
+type MsgWriteScopeBundleResponse struct {
+	// scope_id_infos contains information about the id/address of each scope that was added or updated.
+	ScopeIdInfos []*ScopeIdInfo `protobuf:"bytes,1,rep,name=scope_id_infos,json=scopeIdInfos,proto3" json:"scope_id_infos,omitempty" yaml:"scope_id_infos"`
+}
+
+func (m *MsgWriteScopeBundleResponse) Reset()         { *m = MsgWriteScopeBundleResponse{} }
+func (m *MsgWriteScopeBundleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWriteScopeBundleResponse) ProtoMessage()    {}
+func (*MsgWriteScopeBundleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{4}
+}
+func (m *MsgWriteScopeBundleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWriteScopeBundleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWriteScopeBundleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWriteScopeBundleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWriteScopeBundleResponse.Merge(m, src)
+}
+func (m *MsgWriteScopeBundleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWriteScopeBundleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWriteScopeBundleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWriteScopeBundleResponse proto.InternalMessageInfo
+
+func (m *MsgWriteScopeBundleResponse) GetScopeIdInfos() []*ScopeIdInfo {
+	if m != nil {
+		return m.ScopeIdInfos
+	}
+	return nil
+}
+
 // MsgDeleteScopeRequest is the request type for the Msg/DeleteScope RPC method.
 type MsgDeleteScopeRequest struct {
 	// Unique ID for the scope to delete
@@ -136,7 +273,7 @@ type MsgDeleteScopeRequest struct {
 func (m *MsgDeleteScopeRequest) Reset()      { *m = MsgDeleteScopeRequest{} }
 func (*MsgDeleteScopeRequest) ProtoMessage() {}
 func (*MsgDeleteScopeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{2}
+	return fileDescriptor_3a3a0892f91e3036, []int{5}
 }
 func (m *MsgDeleteScopeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -173,7 +310,7 @@ func (m *MsgDeleteScopeResponse) Reset()         { *m = MsgDeleteScopeResponse{}
 func (m *MsgDeleteScopeResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDeleteScopeResponse) ProtoMessage()    {}
 func (*MsgDeleteScopeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{3}
+	return fileDescriptor_3a3a0892f91e3036, []int{6}
 }
 func (m *MsgDeleteScopeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -210,12 +347,16 @@ type MsgAddScopeDataAccessRequest struct {
 	DataAccess []string `protobuf:"bytes,2,rep,name=data_access,json=dataAccess,proto3" json:"data_access,omitempty" yaml:"data_access"`
 	// signers is the list of address of those signing this request.
 	Signers []string `protobuf:"bytes,3,rep,name=signers,proto3" json:"signers,omitempty"`
+	// role is an optional label describing the purpose of the access being added, e.g. "auditor".
+	Role string `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	// expiration is an optional time at which the access being added lapses.
+	Expiration *time.Time `protobuf:"bytes,5,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty" yaml:"expiration,omitempty"`
 }
 
 func (m *MsgAddScopeDataAccessRequest) Reset()      { *m = MsgAddScopeDataAccessRequest{} }
 func (*MsgAddScopeDataAccessRequest) ProtoMessage() {}
 func (*MsgAddScopeDataAccessRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{4}
+	return fileDescriptor_3a3a0892f91e3036, []int{7}
 }
 func (m *MsgAddScopeDataAccessRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -252,7 +393,7 @@ func (m *MsgAddScopeDataAccessResponse) Reset()         { *m = MsgAddScopeDataAc
 func (m *MsgAddScopeDataAccessResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgAddScopeDataAccessResponse) ProtoMessage()    {}
 func (*MsgAddScopeDataAccessResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{5}
+	return fileDescriptor_3a3a0892f91e3036, []int{8}
 }
 func (m *MsgAddScopeDataAccessResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -294,7 +435,7 @@ type MsgDeleteScopeDataAccessRequest struct {
 func (m *MsgDeleteScopeDataAccessRequest) Reset()      { *m = MsgDeleteScopeDataAccessRequest{} }
 func (*MsgDeleteScopeDataAccessRequest) ProtoMessage() {}
 func (*MsgDeleteScopeDataAccessRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{6}
+	return fileDescriptor_3a3a0892f91e3036, []int{9}
 }
 func (m *MsgDeleteScopeDataAccessRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -331,7 +472,7 @@ func (m *MsgDeleteScopeDataAccessResponse) Reset()         { *m = MsgDeleteScope
 func (m *MsgDeleteScopeDataAccessResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDeleteScopeDataAccessResponse) ProtoMessage()    {}
 func (*MsgDeleteScopeDataAccessResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{7}
+	return fileDescriptor_3a3a0892f91e3036, []int{10}
 }
 func (m *MsgDeleteScopeDataAccessResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -373,7 +514,7 @@ type MsgAddScopeOwnerRequest struct {
 func (m *MsgAddScopeOwnerRequest) Reset()      { *m = MsgAddScopeOwnerRequest{} }
 func (*MsgAddScopeOwnerRequest) ProtoMessage() {}
 func (*MsgAddScopeOwnerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{8}
+	return fileDescriptor_3a3a0892f91e3036, []int{11}
 }
 func (m *MsgAddScopeOwnerRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -410,7 +551,7 @@ func (m *MsgAddScopeOwnerResponse) Reset()         { *m = MsgAddScopeOwnerRespon
 func (m *MsgAddScopeOwnerResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgAddScopeOwnerResponse) ProtoMessage()    {}
 func (*MsgAddScopeOwnerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{9}
+	return fileDescriptor_3a3a0892f91e3036, []int{12}
 }
 func (m *MsgAddScopeOwnerResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -452,7 +593,7 @@ type MsgDeleteScopeOwnerRequest struct {
 func (m *MsgDeleteScopeOwnerRequest) Reset()      { *m = MsgDeleteScopeOwnerRequest{} }
 func (*MsgDeleteScopeOwnerRequest) ProtoMessage() {}
 func (*MsgDeleteScopeOwnerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{10}
+	return fileDescriptor_3a3a0892f91e3036, []int{13}
 }
 func (m *MsgDeleteScopeOwnerRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -489,7 +630,7 @@ func (m *MsgDeleteScopeOwnerResponse) Reset()         { *m = MsgDeleteScopeOwner
 func (m *MsgDeleteScopeOwnerResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDeleteScopeOwnerResponse) ProtoMessage()    {}
 func (*MsgDeleteScopeOwnerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{11}
+	return fileDescriptor_3a3a0892f91e3036, []int{14}
 }
 func (m *MsgDeleteScopeOwnerResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -518,38 +659,30 @@ func (m *MsgDeleteScopeOwnerResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDeleteScopeOwnerResponse proto.InternalMessageInfo
 
-// MsgWriteSessionRequest is the request type for the Msg/WriteSession RPC method.
-type MsgWriteSessionRequest struct {
-	// session is the Session you want added or updated.
-	Session Session `protobuf:"bytes,1,opt,name=session,proto3" json:"session"`
+// MsgTokenizeScopeValueOwnerRequest is the request to replace the value owner of a scope with a marker-backed token.
+//
+// The full supply of the token is given to the existing value owner. From then on, the signers of a request must
+// together hold the full supply of the token in order to act as the value owner of the scope.
+type MsgTokenizeScopeValueOwnerRequest struct {
+	// scope MetadataAddress of the scope to tokenize
+	ScopeId MetadataAddress `protobuf:"bytes,1,opt,name=scope_id,json=scopeId,proto3,customtype=MetadataAddress" json:"scope_id" yaml:"scope_id"`
+	// supply is the number of tokens to mint. Use 1 for whole ownership, or more to allow fractional ownership.
+	Supply github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=supply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"supply" yaml:"supply"`
 	// signers is the list of address of those signing this request.
-	Signers []string `protobuf:"bytes,2,rep,name=signers,proto3" json:"signers,omitempty"`
-	// SessionIDComponents is an optional (alternate) way of defining what the session_id should be in the provided
-	// session. If provided, it must have both a scope and session_uuid. Those components will be used to create the
-	// MetadataAddress for the session which will override the session_id in the provided session. If not provided (or
-	// all empty), nothing special happens.
-	// If there is a value in session.session_id that is different from the one created from these components, an error is
-	// returned.
-	SessionIdComponents *SessionIdComponents `protobuf:"bytes,3,opt,name=session_id_components,json=sessionIdComponents,proto3" json:"session_id_components,omitempty" yaml:"session_id_components"`
-	// spec_uuid is an optional contract specification uuid string, e.g. "def6bc0a-c9dd-4874-948f-5206e6060a84"
-	// If provided, it will be used to generate the MetadataAddress for the contract specification which will override the
-	// specification_id in the provided session. If not provided (or it is an empty string), nothing special happens.
-	// If there is a value in session.specification_id that is different from the one created from this uuid, an error is
-	// returned.
-	SpecUuid string `protobuf:"bytes,4,opt,name=spec_uuid,json=specUuid,proto3" json:"spec_uuid,omitempty" yaml:"spec_uuid"`
+	Signers []string `protobuf:"bytes,3,rep,name=signers,proto3" json:"signers,omitempty"`
 }
 
-func (m *MsgWriteSessionRequest) Reset()      { *m = MsgWriteSessionRequest{} }
-func (*MsgWriteSessionRequest) ProtoMessage() {}
-func (*MsgWriteSessionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{12}
+func (m *MsgTokenizeScopeValueOwnerRequest) Reset()      { *m = MsgTokenizeScopeValueOwnerRequest{} }
+func (*MsgTokenizeScopeValueOwnerRequest) ProtoMessage() {}
+func (*MsgTokenizeScopeValueOwnerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{15}
 }
-func (m *MsgWriteSessionRequest) XXX_Unmarshal(b []byte) error {
+func (m *MsgTokenizeScopeValueOwnerRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgWriteSessionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgTokenizeScopeValueOwnerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgWriteSessionRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgTokenizeScopeValueOwnerRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -559,42 +692,38 @@ func (m *MsgWriteSessionRequest) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *MsgWriteSessionRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgWriteSessionRequest.Merge(m, src)
+func (m *MsgTokenizeScopeValueOwnerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTokenizeScopeValueOwnerRequest.Merge(m, src)
 }
-func (m *MsgWriteSessionRequest) XXX_Size() int {
+func (m *MsgTokenizeScopeValueOwnerRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgWriteSessionRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgWriteSessionRequest.DiscardUnknown(m)
+func (m *MsgTokenizeScopeValueOwnerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTokenizeScopeValueOwnerRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgWriteSessionRequest proto.InternalMessageInfo
+var xxx_messageInfo_MsgTokenizeScopeValueOwnerRequest proto.InternalMessageInfo
 
-// SessionIDComponents contains fields for the components that make up a session id.
-type SessionIdComponents struct {
-	// scope is used to define the scope this session belongs to.
-	//
-	// Types that are valid to be assigned to ScopeIdentifier:
-	//	*SessionIdComponents_ScopeUuid
-	//	*SessionIdComponents_ScopeAddr
-	ScopeIdentifier isSessionIdComponents_ScopeIdentifier `protobuf_oneof:"scope_identifier"`
-	// session_uuid is a uuid string for identifying this session, e.g. "5803f8bc-6067-4eb5-951f-2121671c2ec0"
-	SessionUuid string `protobuf:"bytes,3,opt,name=session_uuid,json=sessionUuid,proto3" json:"session_uuid,omitempty" yaml:"session_uuid"`
+// MsgTokenizeScopeValueOwnerResponse is the response from tokenizing the value owner of a scope.
+type MsgTokenizeScopeValueOwnerResponse struct {
+	// denom is the denom of the value owner token.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// marker_address is the bech32 address of the marker that is now the value owner of the scope.
+	MarkerAddress string `protobuf:"bytes,2,opt,name=marker_address,json=markerAddress,proto3" json:"marker_address,omitempty" yaml:"marker_address"`
 }
 
-func (m *SessionIdComponents) Reset()         { *m = SessionIdComponents{} }
-func (m *SessionIdComponents) String() string { return proto.CompactTextString(m) }
-func (*SessionIdComponents) ProtoMessage()    {}
-func (*SessionIdComponents) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{13}
+func (m *MsgTokenizeScopeValueOwnerResponse) Reset()         { *m = MsgTokenizeScopeValueOwnerResponse{} }
+func (m *MsgTokenizeScopeValueOwnerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTokenizeScopeValueOwnerResponse) ProtoMessage()    {}
+func (*MsgTokenizeScopeValueOwnerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{16}
 }
-func (m *SessionIdComponents) XXX_Unmarshal(b []byte) error {
+func (m *MsgTokenizeScopeValueOwnerResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SessionIdComponents) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgTokenizeScopeValueOwnerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SessionIdComponents.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgTokenizeScopeValueOwnerResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -604,88 +733,57 @@ func (m *SessionIdComponents) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *SessionIdComponents) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SessionIdComponents.Merge(m, src)
+func (m *MsgTokenizeScopeValueOwnerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTokenizeScopeValueOwnerResponse.Merge(m, src)
 }
-func (m *SessionIdComponents) XXX_Size() int {
+func (m *MsgTokenizeScopeValueOwnerResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *SessionIdComponents) XXX_DiscardUnknown() {
-	xxx_messageInfo_SessionIdComponents.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_SessionIdComponents proto.InternalMessageInfo
-
-type isSessionIdComponents_ScopeIdentifier interface {
-	isSessionIdComponents_ScopeIdentifier()
-	MarshalTo([]byte) (int, error)
-	Size() int
-}
-
-type SessionIdComponents_ScopeUuid struct {
-	ScopeUuid string `protobuf:"bytes,1,opt,name=scope_uuid,json=scopeUuid,proto3,oneof" json:"scope_uuid,omitempty" yaml:"scope_uuid"`
-}
-type SessionIdComponents_ScopeAddr struct {
-	ScopeAddr string `protobuf:"bytes,2,opt,name=scope_addr,json=scopeAddr,proto3,oneof" json:"scope_addr,omitempty" yaml:"scope_addr"`
+func (m *MsgTokenizeScopeValueOwnerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTokenizeScopeValueOwnerResponse.DiscardUnknown(m)
 }
 
-func (*SessionIdComponents_ScopeUuid) isSessionIdComponents_ScopeIdentifier() {}
-func (*SessionIdComponents_ScopeAddr) isSessionIdComponents_ScopeIdentifier() {}
+var xxx_messageInfo_MsgTokenizeScopeValueOwnerResponse proto.InternalMessageInfo
 
-func (m *SessionIdComponents) GetScopeIdentifier() isSessionIdComponents_ScopeIdentifier {
+func (m *MsgTokenizeScopeValueOwnerResponse) GetDenom() string {
 	if m != nil {
-		return m.ScopeIdentifier
-	}
-	return nil
-}
-
-func (m *SessionIdComponents) GetScopeUuid() string {
-	if x, ok := m.GetScopeIdentifier().(*SessionIdComponents_ScopeUuid); ok {
-		return x.ScopeUuid
-	}
-	return ""
-}
-
-func (m *SessionIdComponents) GetScopeAddr() string {
-	if x, ok := m.GetScopeIdentifier().(*SessionIdComponents_ScopeAddr); ok {
-		return x.ScopeAddr
+		return m.Denom
 	}
 	return ""
 }
 
-func (m *SessionIdComponents) GetSessionUuid() string {
+func (m *MsgTokenizeScopeValueOwnerResponse) GetMarkerAddress() string {
 	if m != nil {
-		return m.SessionUuid
+		return m.MarkerAddress
 	}
 	return ""
 }
 
-// XXX_OneofWrappers is for the internal use of the proto package.
-func (*SessionIdComponents) XXX_OneofWrappers() []interface{} {
-	return []interface{}{
-		(*SessionIdComponents_ScopeUuid)(nil),
-		(*SessionIdComponents_ScopeAddr)(nil),
-	}
-}
-
-// MsgWriteSessionResponse is the response type for the Msg/WriteSession RPC method.
-type MsgWriteSessionResponse struct {
-	// session_id_info contains information about the id/address of the session that was added or updated.
-	SessionIdInfo *SessionIdInfo `protobuf:"bytes,1,opt,name=session_id_info,json=sessionIdInfo,proto3" json:"session_id_info,omitempty" yaml:"session_id_info"`
+// MsgOfferScopeSaleRequest is the request to offer the value ownership of a scope for a payment.
+type MsgOfferScopeSaleRequest struct {
+	// scope MetadataAddress of the scope being offered
+	ScopeId MetadataAddress `protobuf:"bytes,1,opt,name=scope_id,json=scopeId,proto3,customtype=MetadataAddress" json:"scope_id" yaml:"scope_id"`
+	// buyer is the only address that can accept the offer. If empty, any address can accept it.
+	Buyer string `protobuf:"bytes,2,opt,name=buyer,proto3" json:"buyer,omitempty" yaml:"buyer,omitempty"`
+	// price is the payment the buyer must send to the value owner.
+	Price github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=price,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"price"`
+	// expiration is the time after which the offer can no longer be accepted.
+	Expiration time.Time `protobuf:"bytes,4,opt,name=expiration,proto3,stdtime" json:"expiration"`
+	// signers is the list of address of those signing this request.
+	Signers []string `protobuf:"bytes,5,rep,name=signers,proto3" json:"signers,omitempty"`
 }
 
-func (m *MsgWriteSessionResponse) Reset()         { *m = MsgWriteSessionResponse{} }
-func (m *MsgWriteSessionResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgWriteSessionResponse) ProtoMessage()    {}
-func (*MsgWriteSessionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{14}
+func (m *MsgOfferScopeSaleRequest) Reset()      { *m = MsgOfferScopeSaleRequest{} }
+func (*MsgOfferScopeSaleRequest) ProtoMessage() {}
+func (*MsgOfferScopeSaleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{17}
 }
-func (m *MsgWriteSessionResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgOfferScopeSaleRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgWriteSessionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgOfferScopeSaleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgWriteSessionResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgOfferScopeSaleRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -695,60 +793,34 @@ func (m *MsgWriteSessionResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *MsgWriteSessionResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgWriteSessionResponse.Merge(m, src)
+func (m *MsgOfferScopeSaleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgOfferScopeSaleRequest.Merge(m, src)
 }
-func (m *MsgWriteSessionResponse) XXX_Size() int {
+func (m *MsgOfferScopeSaleRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgWriteSessionResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgWriteSessionResponse.DiscardUnknown(m)
+func (m *MsgOfferScopeSaleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgOfferScopeSaleRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgWriteSessionResponse proto.InternalMessageInfo
-
-func (m *MsgWriteSessionResponse) GetSessionIdInfo() *SessionIdInfo {
-	if m != nil {
-		return m.SessionIdInfo
-	}
-	return nil
-}
+var xxx_messageInfo_MsgOfferScopeSaleRequest proto.InternalMessageInfo
 
-// MsgWriteRecordRequest is the request type for the Msg/WriteRecord RPC method.
-type MsgWriteRecordRequest struct {
-	// record is the Record you want added or updated.
-	Record Record `protobuf:"bytes,1,opt,name=record,proto3" json:"record"`
-	// signers is the list of address of those signing this request.
-	Signers []string `protobuf:"bytes,2,rep,name=signers,proto3" json:"signers,omitempty"`
-	// SessionIDComponents is an optional (alternate) way of defining what the session_id should be in the provided
-	// record. If provided, it must have both a scope and session_uuid. Those components will be used to create the
-	// MetadataAddress for the session which will override the session_id in the provided record. If not provided (or
-	// all empty), nothing special happens.
-	// If there is a value in record.session_id that is different from the one created from these components, an error is
-	// returned.
-	SessionIdComponents *SessionIdComponents `protobuf:"bytes,3,opt,name=session_id_components,json=sessionIdComponents,proto3" json:"session_id_components,omitempty" yaml:"session_id_components"`
-	// contract_spec_uuid is an optional contract specification uuid string, e.g. "def6bc0a-c9dd-4874-948f-5206e6060a84"
-	// If provided, it will be combined with the record name to generate the MetadataAddress for the record specification
-	// which will override the specification_id in the provided record. If not provided (or it is an empty string),
-	// nothing special happens.
-	// If there is a value in record.specification_id that is different from the one created from this uuid and
-	// record.name, an error is returned.
-	ContractSpecUuid string `protobuf:"bytes,4,opt,name=contract_spec_uuid,json=contractSpecUuid,proto3" json:"contract_spec_uuid,omitempty" yaml:"contract_spec_uuid"`
-	// parties is the list of parties involved with this record.
-	Parties []Party `protobuf:"bytes,5,rep,name=parties,proto3" json:"parties"`
+// MsgOfferScopeSaleResponse is the response from offering the value ownership of a scope for a payment.
+type MsgOfferScopeSaleResponse struct {
 }
 
-func (m *MsgWriteRecordRequest) Reset()      { *m = MsgWriteRecordRequest{} }
-func (*MsgWriteRecordRequest) ProtoMessage() {}
-func (*MsgWriteRecordRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{15}
+func (m *MsgOfferScopeSaleResponse) Reset()         { *m = MsgOfferScopeSaleResponse{} }
+func (m *MsgOfferScopeSaleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgOfferScopeSaleResponse) ProtoMessage()    {}
+func (*MsgOfferScopeSaleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{18}
 }
-func (m *MsgWriteRecordRequest) XXX_Unmarshal(b []byte) error {
+func (m *MsgOfferScopeSaleResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgWriteRecordRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgOfferScopeSaleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgWriteRecordRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgOfferScopeSaleResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -758,36 +830,37 @@ func (m *MsgWriteRecordRequest) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *MsgWriteRecordRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgWriteRecordRequest.Merge(m, src)
+func (m *MsgOfferScopeSaleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgOfferScopeSaleResponse.Merge(m, src)
 }
-func (m *MsgWriteRecordRequest) XXX_Size() int {
+func (m *MsgOfferScopeSaleResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgWriteRecordRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgWriteRecordRequest.DiscardUnknown(m)
+func (m *MsgOfferScopeSaleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgOfferScopeSaleResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgWriteRecordRequest proto.InternalMessageInfo
+var xxx_messageInfo_MsgOfferScopeSaleResponse proto.InternalMessageInfo
 
-// MsgWriteRecordResponse is the response type for the Msg/WriteRecord RPC method.
-type MsgWriteRecordResponse struct {
-	// record_id_info contains information about the id/address of the record that was added or updated.
-	RecordIdInfo *RecordIdInfo `protobuf:"bytes,1,opt,name=record_id_info,json=recordIdInfo,proto3" json:"record_id_info,omitempty" yaml:"record_id_info"`
+// MsgCancelScopeSaleRequest is the request to cancel an offer to sell the value ownership of a scope.
+type MsgCancelScopeSaleRequest struct {
+	// scope MetadataAddress of the scope that was offered
+	ScopeId MetadataAddress `protobuf:"bytes,1,opt,name=scope_id,json=scopeId,proto3,customtype=MetadataAddress" json:"scope_id" yaml:"scope_id"`
+	// signers is the list of address of those signing this request.
+	Signers []string `protobuf:"bytes,2,rep,name=signers,proto3" json:"signers,omitempty"`
 }
 
-func (m *MsgWriteRecordResponse) Reset()         { *m = MsgWriteRecordResponse{} }
-func (m *MsgWriteRecordResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgWriteRecordResponse) ProtoMessage()    {}
-func (*MsgWriteRecordResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{16}
+func (m *MsgCancelScopeSaleRequest) Reset()      { *m = MsgCancelScopeSaleRequest{} }
+func (*MsgCancelScopeSaleRequest) ProtoMessage() {}
+func (*MsgCancelScopeSaleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{19}
 }
-func (m *MsgWriteRecordResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgCancelScopeSaleRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgWriteRecordResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCancelScopeSaleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgWriteRecordResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCancelScopeSaleRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -797,42 +870,34 @@ func (m *MsgWriteRecordResponse) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *MsgWriteRecordResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgWriteRecordResponse.Merge(m, src)
+func (m *MsgCancelScopeSaleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelScopeSaleRequest.Merge(m, src)
 }
-func (m *MsgWriteRecordResponse) XXX_Size() int {
+func (m *MsgCancelScopeSaleRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgWriteRecordResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgWriteRecordResponse.DiscardUnknown(m)
+func (m *MsgCancelScopeSaleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelScopeSaleRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgWriteRecordResponse proto.InternalMessageInfo
-
-func (m *MsgWriteRecordResponse) GetRecordIdInfo() *RecordIdInfo {
-	if m != nil {
-		return m.RecordIdInfo
-	}
-	return nil
-}
+var xxx_messageInfo_MsgCancelScopeSaleRequest proto.InternalMessageInfo
 
-// MsgDeleteRecordRequest is the request type for the Msg/DeleteRecord RPC method.
-type MsgDeleteRecordRequest struct {
-	RecordId MetadataAddress `protobuf:"bytes,1,opt,name=record_id,json=recordId,proto3,customtype=MetadataAddress" json:"record_id" yaml:"record_id"`
-	Signers  []string        `protobuf:"bytes,2,rep,name=signers,proto3" json:"signers,omitempty"`
+// MsgCancelScopeSaleResponse is the response from cancelling an offer to sell the value ownership of a scope.
+type MsgCancelScopeSaleResponse struct {
 }
 
-func (m *MsgDeleteRecordRequest) Reset()      { *m = MsgDeleteRecordRequest{} }
-func (*MsgDeleteRecordRequest) ProtoMessage() {}
-func (*MsgDeleteRecordRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{17}
+func (m *MsgCancelScopeSaleResponse) Reset()         { *m = MsgCancelScopeSaleResponse{} }
+func (m *MsgCancelScopeSaleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelScopeSaleResponse) ProtoMessage()    {}
+func (*MsgCancelScopeSaleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{20}
 }
-func (m *MsgDeleteRecordRequest) XXX_Unmarshal(b []byte) error {
+func (m *MsgCancelScopeSaleResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgDeleteRecordRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCancelScopeSaleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgDeleteRecordRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCancelScopeSaleResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -842,34 +907,41 @@ func (m *MsgDeleteRecordRequest) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *MsgDeleteRecordRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgDeleteRecordRequest.Merge(m, src)
+func (m *MsgCancelScopeSaleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelScopeSaleResponse.Merge(m, src)
 }
-func (m *MsgDeleteRecordRequest) XXX_Size() int {
+func (m *MsgCancelScopeSaleResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgDeleteRecordRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgDeleteRecordRequest.DiscardUnknown(m)
+func (m *MsgCancelScopeSaleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelScopeSaleResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgDeleteRecordRequest proto.InternalMessageInfo
+var xxx_messageInfo_MsgCancelScopeSaleResponse proto.InternalMessageInfo
 
-// MsgDeleteRecordResponse is the response type for the Msg/DeleteRecord RPC method.
-type MsgDeleteRecordResponse struct {
+// MsgAcceptScopeSaleRequest is the request to pay for a scope and become its value owner.
+type MsgAcceptScopeSaleRequest struct {
+	// scope MetadataAddress of the scope being bought
+	ScopeId MetadataAddress `protobuf:"bytes,1,opt,name=scope_id,json=scopeId,proto3,customtype=MetadataAddress" json:"scope_id" yaml:"scope_id"`
+	// buyer is the address that pays the price and becomes the new value owner. It can be a marker.
+	Buyer string `protobuf:"bytes,2,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	// price is the payment being made. It must equal the price of the offer.
+	Price github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=price,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"price"`
+	// signers is the list of address of those signing this request.
+	Signers []string `protobuf:"bytes,4,rep,name=signers,proto3" json:"signers,omitempty"`
 }
 
-func (m *MsgDeleteRecordResponse) Reset()         { *m = MsgDeleteRecordResponse{} }
-func (m *MsgDeleteRecordResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgDeleteRecordResponse) ProtoMessage()    {}
-func (*MsgDeleteRecordResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{18}
+func (m *MsgAcceptScopeSaleRequest) Reset()      { *m = MsgAcceptScopeSaleRequest{} }
+func (*MsgAcceptScopeSaleRequest) ProtoMessage() {}
+func (*MsgAcceptScopeSaleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{21}
 }
-func (m *MsgDeleteRecordResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgAcceptScopeSaleRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgDeleteRecordResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgAcceptScopeSaleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgDeleteRecordResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgAcceptScopeSaleRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -879,44 +951,34 @@ func (m *MsgDeleteRecordResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *MsgDeleteRecordResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgDeleteRecordResponse.Merge(m, src)
+func (m *MsgAcceptScopeSaleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptScopeSaleRequest.Merge(m, src)
 }
-func (m *MsgDeleteRecordResponse) XXX_Size() int {
+func (m *MsgAcceptScopeSaleRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgDeleteRecordResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgDeleteRecordResponse.DiscardUnknown(m)
+func (m *MsgAcceptScopeSaleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptScopeSaleRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgDeleteRecordResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgAcceptScopeSaleRequest proto.InternalMessageInfo
 
-// MsgWriteScopeSpecificationRequest is the request type for the Msg/WriteScopeSpecification RPC method.
-type MsgWriteScopeSpecificationRequest struct {
-	// specification is the ScopeSpecification you want added or updated.
-	Specification ScopeSpecification `protobuf:"bytes,1,opt,name=specification,proto3" json:"specification"`
-	// signers is the list of address of those signing this request.
-	Signers []string `protobuf:"bytes,2,rep,name=signers,proto3" json:"signers,omitempty"`
-	// spec_uuid is an optional scope specification uuid string, e.g. "dc83ea70-eacd-40fe-9adf-1cf6148bf8a2"
-	// If provided, it will be used to generate the MetadataAddress for the scope specification which will override the
-	// specification_id in the provided specification. If not provided (or it is an empty string), nothing special
-	// happens.
-	// If there is a value in specification.specification_id that is different from the one created from this uuid, an
-	// error is returned.
-	SpecUuid string `protobuf:"bytes,3,opt,name=spec_uuid,json=specUuid,proto3" json:"spec_uuid,omitempty" yaml:"spec_uuid"`
+// MsgAcceptScopeSaleResponse is the response from paying for a scope and becoming its value owner.
+type MsgAcceptScopeSaleResponse struct {
 }
 
-func (m *MsgWriteScopeSpecificationRequest) Reset()      { *m = MsgWriteScopeSpecificationRequest{} }
-func (*MsgWriteScopeSpecificationRequest) ProtoMessage() {}
-func (*MsgWriteScopeSpecificationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{19}
+func (m *MsgAcceptScopeSaleResponse) Reset()         { *m = MsgAcceptScopeSaleResponse{} }
+func (m *MsgAcceptScopeSaleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptScopeSaleResponse) ProtoMessage()    {}
+func (*MsgAcceptScopeSaleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{22}
 }
-func (m *MsgWriteScopeSpecificationRequest) XXX_Unmarshal(b []byte) error {
+func (m *MsgAcceptScopeSaleResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgWriteScopeSpecificationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgAcceptScopeSaleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgWriteScopeSpecificationRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgAcceptScopeSaleResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -926,36 +988,39 @@ func (m *MsgWriteScopeSpecificationRequest) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *MsgWriteScopeSpecificationRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgWriteScopeSpecificationRequest.Merge(m, src)
+func (m *MsgAcceptScopeSaleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptScopeSaleResponse.Merge(m, src)
 }
-func (m *MsgWriteScopeSpecificationRequest) XXX_Size() int {
+func (m *MsgAcceptScopeSaleResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgWriteScopeSpecificationRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgWriteScopeSpecificationRequest.DiscardUnknown(m)
+func (m *MsgAcceptScopeSaleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptScopeSaleResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgWriteScopeSpecificationRequest proto.InternalMessageInfo
+var xxx_messageInfo_MsgAcceptScopeSaleResponse proto.InternalMessageInfo
 
-// MsgWriteScopeSpecificationResponse is the response type for the Msg/WriteScopeSpecification RPC method.
-type MsgWriteScopeSpecificationResponse struct {
-	// scope_spec_id_info contains information about the id/address of the scope specification that was added or updated.
-	ScopeSpecIdInfo *ScopeSpecIdInfo `protobuf:"bytes,1,opt,name=scope_spec_id_info,json=scopeSpecIdInfo,proto3" json:"scope_spec_id_info,omitempty" yaml:"scope_spec_id_info"`
+// MsgMigrateScopeSpecificationRequest is the request to move a scope to a different scope specification.
+type MsgMigrateScopeSpecificationRequest struct {
+	// scope MetadataAddress of the scope being migrated
+	ScopeId MetadataAddress `protobuf:"bytes,1,opt,name=scope_id,json=scopeId,proto3,customtype=MetadataAddress" json:"scope_id" yaml:"scope_id"`
+	// specification_id is the MetadataAddress of the scope specification to migrate the scope to
+	SpecificationId MetadataAddress `protobuf:"bytes,2,opt,name=specification_id,json=specificationId,proto3,customtype=MetadataAddress" json:"specification_id" yaml:"specification_id"`
+	// signers is the list of address of those signing this request.
+	Signers []string `protobuf:"bytes,3,rep,name=signers,proto3" json:"signers,omitempty"`
 }
 
-func (m *MsgWriteScopeSpecificationResponse) Reset()         { *m = MsgWriteScopeSpecificationResponse{} }
-func (m *MsgWriteScopeSpecificationResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgWriteScopeSpecificationResponse) ProtoMessage()    {}
-func (*MsgWriteScopeSpecificationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{20}
+func (m *MsgMigrateScopeSpecificationRequest) Reset()      { *m = MsgMigrateScopeSpecificationRequest{} }
+func (*MsgMigrateScopeSpecificationRequest) ProtoMessage() {}
+func (*MsgMigrateScopeSpecificationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{23}
 }
-func (m *MsgWriteScopeSpecificationResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgMigrateScopeSpecificationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgWriteScopeSpecificationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgMigrateScopeSpecificationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgWriteScopeSpecificationResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgMigrateScopeSpecificationRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -965,43 +1030,34 @@ func (m *MsgWriteScopeSpecificationResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *MsgWriteScopeSpecificationResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgWriteScopeSpecificationResponse.Merge(m, src)
+func (m *MsgMigrateScopeSpecificationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateScopeSpecificationRequest.Merge(m, src)
 }
-func (m *MsgWriteScopeSpecificationResponse) XXX_Size() int {
+func (m *MsgMigrateScopeSpecificationRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgWriteScopeSpecificationResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgWriteScopeSpecificationResponse.DiscardUnknown(m)
+func (m *MsgMigrateScopeSpecificationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateScopeSpecificationRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgWriteScopeSpecificationResponse proto.InternalMessageInfo
-
-func (m *MsgWriteScopeSpecificationResponse) GetScopeSpecIdInfo() *ScopeSpecIdInfo {
-	if m != nil {
-		return m.ScopeSpecIdInfo
-	}
-	return nil
-}
+var xxx_messageInfo_MsgMigrateScopeSpecificationRequest proto.InternalMessageInfo
 
-// MsgDeleteScopeSpecificationRequest is the request type for the Msg/DeleteScopeSpecification RPC method.
-type MsgDeleteScopeSpecificationRequest struct {
-	// MetadataAddress for the scope specification to delete.
-	SpecificationId MetadataAddress `protobuf:"bytes,1,opt,name=specification_id,json=specificationId,proto3,customtype=MetadataAddress" json:"specification_id" yaml:"specification_id"`
-	Signers         []string        `protobuf:"bytes,2,rep,name=signers,proto3" json:"signers,omitempty"`
+// MsgMigrateScopeSpecificationResponse is the response from moving a scope to a different scope specification.
+type MsgMigrateScopeSpecificationResponse struct {
 }
 
-func (m *MsgDeleteScopeSpecificationRequest) Reset()      { *m = MsgDeleteScopeSpecificationRequest{} }
-func (*MsgDeleteScopeSpecificationRequest) ProtoMessage() {}
-func (*MsgDeleteScopeSpecificationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{21}
+func (m *MsgMigrateScopeSpecificationResponse) Reset()         { *m = MsgMigrateScopeSpecificationResponse{} }
+func (m *MsgMigrateScopeSpecificationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateScopeSpecificationResponse) ProtoMessage()    {}
+func (*MsgMigrateScopeSpecificationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{24}
 }
-func (m *MsgDeleteScopeSpecificationRequest) XXX_Unmarshal(b []byte) error {
+func (m *MsgMigrateScopeSpecificationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgDeleteScopeSpecificationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgMigrateScopeSpecificationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgDeleteScopeSpecificationRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgMigrateScopeSpecificationResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1011,34 +1067,50 @@ func (m *MsgDeleteScopeSpecificationRequest) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *MsgDeleteScopeSpecificationRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgDeleteScopeSpecificationRequest.Merge(m, src)
+func (m *MsgMigrateScopeSpecificationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateScopeSpecificationResponse.Merge(m, src)
 }
-func (m *MsgDeleteScopeSpecificationRequest) XXX_Size() int {
+func (m *MsgMigrateScopeSpecificationResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgDeleteScopeSpecificationRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgDeleteScopeSpecificationRequest.DiscardUnknown(m)
+func (m *MsgMigrateScopeSpecificationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateScopeSpecificationResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgDeleteScopeSpecificationRequest proto.InternalMessageInfo
+var xxx_messageInfo_MsgMigrateScopeSpecificationResponse proto.InternalMessageInfo
 
-// MsgDeleteScopeSpecificationResponse is the response type for the Msg/DeleteScopeSpecification RPC method.
-type MsgDeleteScopeSpecificationResponse struct {
+// MsgWriteSessionRequest is the request type for the Msg/WriteSession RPC method.
+type MsgWriteSessionRequest struct {
+	// session is the Session you want added or updated.
+	Session Session `protobuf:"bytes,1,opt,name=session,proto3" json:"session"`
+	// signers is the list of address of those signing this request.
+	Signers []string `protobuf:"bytes,2,rep,name=signers,proto3" json:"signers,omitempty"`
+	// SessionIDComponents is an optional (alternate) way of defining what the session_id should be in the provided
+	// session. If provided, it must have both a scope and session_uuid. Those components will be used to create the
+	// MetadataAddress for the session which will override the session_id in the provided session. If not provided (or
+	// all empty), nothing special happens.
+	// If there is a value in session.session_id that is different from the one created from these components, an error is
+	// returned.
+	SessionIdComponents *SessionIdComponents `protobuf:"bytes,3,opt,name=session_id_components,json=sessionIdComponents,proto3" json:"session_id_components,omitempty" yaml:"session_id_components"`
+	// spec_uuid is an optional contract specification uuid string, e.g. "def6bc0a-c9dd-4874-948f-5206e6060a84"
+	// If provided, it will be used to generate the MetadataAddress for the contract specification which will override the
+	// specification_id in the provided session. If not provided (or it is an empty string), nothing special happens.
+	// If there is a value in session.specification_id that is different from the one created from this uuid, an error is
+	// returned.
+	SpecUuid string `protobuf:"bytes,4,opt,name=spec_uuid,json=specUuid,proto3" json:"spec_uuid,omitempty" yaml:"spec_uuid"`
 }
 
-func (m *MsgDeleteScopeSpecificationResponse) Reset()         { *m = MsgDeleteScopeSpecificationResponse{} }
-func (m *MsgDeleteScopeSpecificationResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgDeleteScopeSpecificationResponse) ProtoMessage()    {}
-func (*MsgDeleteScopeSpecificationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{22}
+func (m *MsgWriteSessionRequest) Reset()      { *m = MsgWriteSessionRequest{} }
+func (*MsgWriteSessionRequest) ProtoMessage() {}
+func (*MsgWriteSessionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{25}
 }
-func (m *MsgDeleteScopeSpecificationResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgWriteSessionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgDeleteScopeSpecificationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgWriteSessionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgDeleteScopeSpecificationResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgWriteSessionRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1048,44 +1120,42 @@ func (m *MsgDeleteScopeSpecificationResponse) XXX_Marshal(b []byte, deterministi
 		return b[:n], nil
 	}
 }
-func (m *MsgDeleteScopeSpecificationResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgDeleteScopeSpecificationResponse.Merge(m, src)
+func (m *MsgWriteSessionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWriteSessionRequest.Merge(m, src)
 }
-func (m *MsgDeleteScopeSpecificationResponse) XXX_Size() int {
+func (m *MsgWriteSessionRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgDeleteScopeSpecificationResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgDeleteScopeSpecificationResponse.DiscardUnknown(m)
+func (m *MsgWriteSessionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWriteSessionRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgDeleteScopeSpecificationResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgWriteSessionRequest proto.InternalMessageInfo
 
-// MsgWriteContractSpecificationRequest is the request type for the Msg/WriteContractSpecification RPC method.
-type MsgWriteContractSpecificationRequest struct {
-	// specification is the ContractSpecification you want added or updated.
-	Specification ContractSpecification `protobuf:"bytes,1,opt,name=specification,proto3" json:"specification"`
-	// signers is the list of address of those signing this request.
-	Signers []string `protobuf:"bytes,2,rep,name=signers,proto3" json:"signers,omitempty"`
-	// spec_uuid is an optional contract specification uuid string, e.g. "def6bc0a-c9dd-4874-948f-5206e6060a84"
-	// If provided, it will be used to generate the MetadataAddress for the contract specification which will override the
-	// specification_id in the provided specification. If not provided (or it is an empty string), nothing special
-	// happens.
-	// If there is a value in specification.specification_id that is different from the one created from this uuid, an
-	// error is returned.
-	SpecUuid string `protobuf:"bytes,3,opt,name=spec_uuid,json=specUuid,proto3" json:"spec_uuid,omitempty" yaml:"spec_uuid"`
+// SessionIDComponents contains fields for the components that make up a session id.
+type SessionIdComponents struct {
+	// scope is used to define the scope this session belongs to.
+	//
+	// Types that are valid to be assigned to ScopeIdentifier:
+	//	*SessionIdComponents_ScopeUuid
+	//	*SessionIdComponents_ScopeAddr
+	ScopeIdentifier isSessionIdComponents_ScopeIdentifier `protobuf_oneof:"scope_identifier"`
+	// session_uuid is a uuid string for identifying this session, e.g. "5803f8bc-6067-4eb5-951f-2121671c2ec0"
+	SessionUuid string `protobuf:"bytes,3,opt,name=session_uuid,json=sessionUuid,proto3" json:"session_uuid,omitempty" yaml:"session_uuid"`
 }
 
-func (m *MsgWriteContractSpecificationRequest) Reset()      { *m = MsgWriteContractSpecificationRequest{} }
-func (*MsgWriteContractSpecificationRequest) ProtoMessage() {}
-func (*MsgWriteContractSpecificationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{23}
+func (m *SessionIdComponents) Reset()         { *m = SessionIdComponents{} }
+func (m *SessionIdComponents) String() string { return proto.CompactTextString(m) }
+func (*SessionIdComponents) ProtoMessage()    {}
+func (*SessionIdComponents) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{26}
 }
-func (m *MsgWriteContractSpecificationRequest) XXX_Unmarshal(b []byte) error {
+func (m *SessionIdComponents) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgWriteContractSpecificationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SessionIdComponents) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgWriteContractSpecificationRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SessionIdComponents.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1095,37 +1165,88 @@ func (m *MsgWriteContractSpecificationRequest) XXX_Marshal(b []byte, determinist
 		return b[:n], nil
 	}
 }
-func (m *MsgWriteContractSpecificationRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgWriteContractSpecificationRequest.Merge(m, src)
+func (m *SessionIdComponents) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SessionIdComponents.Merge(m, src)
 }
-func (m *MsgWriteContractSpecificationRequest) XXX_Size() int {
+func (m *SessionIdComponents) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgWriteContractSpecificationRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgWriteContractSpecificationRequest.DiscardUnknown(m)
+func (m *SessionIdComponents) XXX_DiscardUnknown() {
+	xxx_messageInfo_SessionIdComponents.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgWriteContractSpecificationRequest proto.InternalMessageInfo
+var xxx_messageInfo_SessionIdComponents proto.InternalMessageInfo
 
-// MsgWriteContractSpecificationResponse is the response type for the Msg/WriteContractSpecification RPC method.
-type MsgWriteContractSpecificationResponse struct {
-	// contract_spec_id_info contains information about the id/address of the contract specification that was added or
-	// updated.
-	ContractSpecIdInfo *ContractSpecIdInfo `protobuf:"bytes,1,opt,name=contract_spec_id_info,json=contractSpecIdInfo,proto3" json:"contract_spec_id_info,omitempty" yaml:"contract_spec_id_info"`
+type isSessionIdComponents_ScopeIdentifier interface {
+	isSessionIdComponents_ScopeIdentifier()
+	MarshalTo([]byte) (int, error)
+	Size() int
 }
 
-func (m *MsgWriteContractSpecificationResponse) Reset()         { *m = MsgWriteContractSpecificationResponse{} }
-func (m *MsgWriteContractSpecificationResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgWriteContractSpecificationResponse) ProtoMessage()    {}
-func (*MsgWriteContractSpecificationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{24}
+type SessionIdComponents_ScopeUuid struct {
+	ScopeUuid string `protobuf:"bytes,1,opt,name=scope_uuid,json=scopeUuid,proto3,oneof" json:"scope_uuid,omitempty" yaml:"scope_uuid"`
 }
-func (m *MsgWriteContractSpecificationResponse) XXX_Unmarshal(b []byte) error {
+type SessionIdComponents_ScopeAddr struct {
+	ScopeAddr string `protobuf:"bytes,2,opt,name=scope_addr,json=scopeAddr,proto3,oneof" json:"scope_addr,omitempty" yaml:"scope_addr"`
+}
+
+func (*SessionIdComponents_ScopeUuid) isSessionIdComponents_ScopeIdentifier() {}
+func (*SessionIdComponents_ScopeAddr) isSessionIdComponents_ScopeIdentifier() {}
+
+func (m *SessionIdComponents) GetScopeIdentifier() isSessionIdComponents_ScopeIdentifier {
+	if m != nil {
+		return m.ScopeIdentifier
+	}
+	return nil
+}
+
+func (m *SessionIdComponents) GetScopeUuid() string {
+	if x, ok := m.GetScopeIdentifier().(*SessionIdComponents_ScopeUuid); ok {
+		return x.ScopeUuid
+	}
+	return ""
+}
+
+func (m *SessionIdComponents) GetScopeAddr() string {
+	if x, ok := m.GetScopeIdentifier().(*SessionIdComponents_ScopeAddr); ok {
+		return x.ScopeAddr
+	}
+	return ""
+}
+
+func (m *SessionIdComponents) GetSessionUuid() string {
+	if m != nil {
+		return m.SessionUuid
+	}
+	return ""
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*SessionIdComponents) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*SessionIdComponents_ScopeUuid)(nil),
+		(*SessionIdComponents_ScopeAddr)(nil),
+	}
+}
+
+// MsgWriteSessionResponse is the response type for the Msg/WriteSession RPC method.
+type MsgWriteSessionResponse struct {
+	// session_id_info contains information about the id/address of the session that was added or updated.
+	SessionIdInfo *SessionIdInfo `protobuf:"bytes,1,opt,name=session_id_info,json=sessionIdInfo,proto3" json:"session_id_info,omitempty" yaml:"session_id_info"`
+}
+
+func (m *MsgWriteSessionResponse) Reset()         { *m = MsgWriteSessionResponse{} }
+func (m *MsgWriteSessionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWriteSessionResponse) ProtoMessage()    {}
+func (*MsgWriteSessionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{27}
+}
+func (m *MsgWriteSessionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgWriteContractSpecificationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgWriteSessionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgWriteContractSpecificationResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgWriteSessionResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1135,45 +1256,60 @@ func (m *MsgWriteContractSpecificationResponse) XXX_Marshal(b []byte, determinis
 		return b[:n], nil
 	}
 }
-func (m *MsgWriteContractSpecificationResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgWriteContractSpecificationResponse.Merge(m, src)
+func (m *MsgWriteSessionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWriteSessionResponse.Merge(m, src)
 }
-func (m *MsgWriteContractSpecificationResponse) XXX_Size() int {
+func (m *MsgWriteSessionResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgWriteContractSpecificationResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgWriteContractSpecificationResponse.DiscardUnknown(m)
+func (m *MsgWriteSessionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWriteSessionResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgWriteContractSpecificationResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgWriteSessionResponse proto.InternalMessageInfo
 
-func (m *MsgWriteContractSpecificationResponse) GetContractSpecIdInfo() *ContractSpecIdInfo {
+func (m *MsgWriteSessionResponse) GetSessionIdInfo() *SessionIdInfo {
 	if m != nil {
-		return m.ContractSpecIdInfo
+		return m.SessionIdInfo
 	}
 	return nil
 }
 
-// MsgAddContractSpecToScopeSpecRequest is the request type for the Msg/AddContractSpecToScopeSpec RPC method.
-type MsgAddContractSpecToScopeSpecRequest struct {
-	// MetadataAddress for the contract specification to add.
-	ContractSpecificationId MetadataAddress `protobuf:"bytes,1,opt,name=contract_specification_id,json=contractSpecificationId,proto3,customtype=MetadataAddress" json:"contract_specification_id" yaml:"specification_id"`
-	// MetadataAddress for the scope specification to add contract specification to.
-	ScopeSpecificationId MetadataAddress `protobuf:"bytes,2,opt,name=scope_specification_id,json=scopeSpecificationId,proto3,customtype=MetadataAddress" json:"scope_specification_id" yaml:"specification_id"`
-	Signers              []string        `protobuf:"bytes,3,rep,name=signers,proto3" json:"signers,omitempty"`
+// MsgWriteRecordRequest is the request type for the Msg/WriteRecord RPC method.
+type MsgWriteRecordRequest struct {
+	// record is the Record you want added or updated.
+	Record Record `protobuf:"bytes,1,opt,name=record,proto3" json:"record"`
+	// signers is the list of address of those signing this request.
+	Signers []string `protobuf:"bytes,2,rep,name=signers,proto3" json:"signers,omitempty"`
+	// SessionIDComponents is an optional (alternate) way of defining what the session_id should be in the provided
+	// record. If provided, it must have both a scope and session_uuid. Those components will be used to create the
+	// MetadataAddress for the session which will override the session_id in the provided record. If not provided (or
+	// all empty), nothing special happens.
+	// If there is a value in record.session_id that is different from the one created from these components, an error is
+	// returned.
+	SessionIdComponents *SessionIdComponents `protobuf:"bytes,3,opt,name=session_id_components,json=sessionIdComponents,proto3" json:"session_id_components,omitempty" yaml:"session_id_components"`
+	// contract_spec_uuid is an optional contract specification uuid string, e.g. "def6bc0a-c9dd-4874-948f-5206e6060a84"
+	// If provided, it will be combined with the record name to generate the MetadataAddress for the record specification
+	// which will override the specification_id in the provided record. If not provided (or it is an empty string),
+	// nothing special happens.
+	// If there is a value in record.specification_id that is different from the one created from this uuid and
+	// record.name, an error is returned.
+	ContractSpecUuid string `protobuf:"bytes,4,opt,name=contract_spec_uuid,json=contractSpecUuid,proto3" json:"contract_spec_uuid,omitempty" yaml:"contract_spec_uuid"`
+	// parties is the list of parties involved with this record.
+	Parties []Party `protobuf:"bytes,5,rep,name=parties,proto3" json:"parties"`
 }
 
-func (m *MsgAddContractSpecToScopeSpecRequest) Reset()      { *m = MsgAddContractSpecToScopeSpecRequest{} }
-func (*MsgAddContractSpecToScopeSpecRequest) ProtoMessage() {}
-func (*MsgAddContractSpecToScopeSpecRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{25}
+func (m *MsgWriteRecordRequest) Reset()      { *m = MsgWriteRecordRequest{} }
+func (*MsgWriteRecordRequest) ProtoMessage() {}
+func (*MsgWriteRecordRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{28}
 }
-func (m *MsgAddContractSpecToScopeSpecRequest) XXX_Unmarshal(b []byte) error {
+func (m *MsgWriteRecordRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgAddContractSpecToScopeSpecRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgWriteRecordRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgAddContractSpecToScopeSpecRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgWriteRecordRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1183,34 +1319,36 @@ func (m *MsgAddContractSpecToScopeSpecRequest) XXX_Marshal(b []byte, determinist
 		return b[:n], nil
 	}
 }
-func (m *MsgAddContractSpecToScopeSpecRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgAddContractSpecToScopeSpecRequest.Merge(m, src)
+func (m *MsgWriteRecordRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWriteRecordRequest.Merge(m, src)
 }
-func (m *MsgAddContractSpecToScopeSpecRequest) XXX_Size() int {
+func (m *MsgWriteRecordRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgAddContractSpecToScopeSpecRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgAddContractSpecToScopeSpecRequest.DiscardUnknown(m)
+func (m *MsgWriteRecordRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWriteRecordRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgAddContractSpecToScopeSpecRequest proto.InternalMessageInfo
+var xxx_messageInfo_MsgWriteRecordRequest proto.InternalMessageInfo
 
-// MsgAddContractSpecToScopeSpecResponse is the response type for the Msg/AddContractSpecToScopeSpec RPC method.
-type MsgAddContractSpecToScopeSpecResponse struct {
+// MsgWriteRecordResponse is the response type for the Msg/WriteRecord RPC method.
+type MsgWriteRecordResponse struct {
+	// record_id_info contains information about the id/address of the record that was added or updated.
+	RecordIdInfo *RecordIdInfo `protobuf:"bytes,1,opt,name=record_id_info,json=recordIdInfo,proto3" json:"record_id_info,omitempty" yaml:"record_id_info"`
 }
 
-func (m *MsgAddContractSpecToScopeSpecResponse) Reset()         { *m = MsgAddContractSpecToScopeSpecResponse{} }
-func (m *MsgAddContractSpecToScopeSpecResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgAddContractSpecToScopeSpecResponse) ProtoMessage()    {}
-func (*MsgAddContractSpecToScopeSpecResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{26}
+func (m *MsgWriteRecordResponse) Reset()         { *m = MsgWriteRecordResponse{} }
+func (m *MsgWriteRecordResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWriteRecordResponse) ProtoMessage()    {}
+func (*MsgWriteRecordResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{29}
 }
-func (m *MsgAddContractSpecToScopeSpecResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgWriteRecordResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgAddContractSpecToScopeSpecResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgWriteRecordResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgAddContractSpecToScopeSpecResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgWriteRecordResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1220,40 +1358,42 @@ func (m *MsgAddContractSpecToScopeSpecResponse) XXX_Marshal(b []byte, determinis
 		return b[:n], nil
 	}
 }
-func (m *MsgAddContractSpecToScopeSpecResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgAddContractSpecToScopeSpecResponse.Merge(m, src)
+func (m *MsgWriteRecordResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWriteRecordResponse.Merge(m, src)
 }
-func (m *MsgAddContractSpecToScopeSpecResponse) XXX_Size() int {
+func (m *MsgWriteRecordResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgAddContractSpecToScopeSpecResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgAddContractSpecToScopeSpecResponse.DiscardUnknown(m)
+func (m *MsgWriteRecordResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWriteRecordResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgAddContractSpecToScopeSpecResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgWriteRecordResponse proto.InternalMessageInfo
 
-// MsgDeleteContractSpecFromScopeSpecRequest is the request type for the Msg/DeleteContractSpecFromScopeSpec RPC method.
-type MsgDeleteContractSpecFromScopeSpecRequest struct {
-	// MetadataAddress for the contract specification to add.
-	ContractSpecificationId MetadataAddress `protobuf:"bytes,1,opt,name=contract_specification_id,json=contractSpecificationId,proto3,customtype=MetadataAddress" json:"contract_specification_id" yaml:"specification_id"`
-	// MetadataAddress for the scope specification to add contract specification to.
-	ScopeSpecificationId MetadataAddress `protobuf:"bytes,2,opt,name=scope_specification_id,json=scopeSpecificationId,proto3,customtype=MetadataAddress" json:"scope_specification_id" yaml:"specification_id"`
-	Signers              []string        `protobuf:"bytes,3,rep,name=signers,proto3" json:"signers,omitempty"`
+func (m *MsgWriteRecordResponse) GetRecordIdInfo() *RecordIdInfo {
+	if m != nil {
+		return m.RecordIdInfo
+	}
+	return nil
 }
 
-func (m *MsgDeleteContractSpecFromScopeSpecRequest) Reset() {
-	*m = MsgDeleteContractSpecFromScopeSpecRequest{}
+// MsgDeleteRecordRequest is the request type for the Msg/DeleteRecord RPC method.
+type MsgDeleteRecordRequest struct {
+	RecordId MetadataAddress `protobuf:"bytes,1,opt,name=record_id,json=recordId,proto3,customtype=MetadataAddress" json:"record_id" yaml:"record_id"`
+	Signers  []string        `protobuf:"bytes,2,rep,name=signers,proto3" json:"signers,omitempty"`
 }
-func (*MsgDeleteContractSpecFromScopeSpecRequest) ProtoMessage() {}
-func (*MsgDeleteContractSpecFromScopeSpecRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{27}
+
+func (m *MsgDeleteRecordRequest) Reset()      { *m = MsgDeleteRecordRequest{} }
+func (*MsgDeleteRecordRequest) ProtoMessage() {}
+func (*MsgDeleteRecordRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{30}
 }
-func (m *MsgDeleteContractSpecFromScopeSpecRequest) XXX_Unmarshal(b []byte) error {
+func (m *MsgDeleteRecordRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgDeleteContractSpecFromScopeSpecRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgDeleteRecordRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgDeleteContractSpecFromScopeSpecRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgDeleteRecordRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1263,39 +1403,34 @@ func (m *MsgDeleteContractSpecFromScopeSpecRequest) XXX_Marshal(b []byte, determ
 		return b[:n], nil
 	}
 }
-func (m *MsgDeleteContractSpecFromScopeSpecRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgDeleteContractSpecFromScopeSpecRequest.Merge(m, src)
+func (m *MsgDeleteRecordRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteRecordRequest.Merge(m, src)
 }
-func (m *MsgDeleteContractSpecFromScopeSpecRequest) XXX_Size() int {
+func (m *MsgDeleteRecordRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgDeleteContractSpecFromScopeSpecRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgDeleteContractSpecFromScopeSpecRequest.DiscardUnknown(m)
+func (m *MsgDeleteRecordRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteRecordRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgDeleteContractSpecFromScopeSpecRequest proto.InternalMessageInfo
+var xxx_messageInfo_MsgDeleteRecordRequest proto.InternalMessageInfo
 
-// MsgDeleteContractSpecFromScopeSpecResponse is the response type for the Msg/DeleteContractSpecFromScopeSpec RPC
-// method.
-type MsgDeleteContractSpecFromScopeSpecResponse struct {
+// MsgDeleteRecordResponse is the response type for the Msg/DeleteRecord RPC method.
+type MsgDeleteRecordResponse struct {
 }
 
-func (m *MsgDeleteContractSpecFromScopeSpecResponse) Reset() {
-	*m = MsgDeleteContractSpecFromScopeSpecResponse{}
-}
-func (m *MsgDeleteContractSpecFromScopeSpecResponse) String() string {
-	return proto.CompactTextString(m)
-}
-func (*MsgDeleteContractSpecFromScopeSpecResponse) ProtoMessage() {}
-func (*MsgDeleteContractSpecFromScopeSpecResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{28}
+func (m *MsgDeleteRecordResponse) Reset()         { *m = MsgDeleteRecordResponse{} }
+func (m *MsgDeleteRecordResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteRecordResponse) ProtoMessage()    {}
+func (*MsgDeleteRecordResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{31}
 }
-func (m *MsgDeleteContractSpecFromScopeSpecResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgDeleteRecordResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgDeleteContractSpecFromScopeSpecResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgDeleteRecordResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgDeleteContractSpecFromScopeSpecResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgDeleteRecordResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1305,36 +1440,44 @@ func (m *MsgDeleteContractSpecFromScopeSpecResponse) XXX_Marshal(b []byte, deter
 		return b[:n], nil
 	}
 }
-func (m *MsgDeleteContractSpecFromScopeSpecResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgDeleteContractSpecFromScopeSpecResponse.Merge(m, src)
+func (m *MsgDeleteRecordResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteRecordResponse.Merge(m, src)
 }
-func (m *MsgDeleteContractSpecFromScopeSpecResponse) XXX_Size() int {
+func (m *MsgDeleteRecordResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgDeleteContractSpecFromScopeSpecResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgDeleteContractSpecFromScopeSpecResponse.DiscardUnknown(m)
+func (m *MsgDeleteRecordResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteRecordResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgDeleteContractSpecFromScopeSpecResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgDeleteRecordResponse proto.InternalMessageInfo
 
-// MsgDeleteContractSpecificationRequest is the request type for the Msg/DeleteContractSpecification RPC method.
-type MsgDeleteContractSpecificationRequest struct {
-	// MetadataAddress for the contract specification to delete.
-	SpecificationId MetadataAddress `protobuf:"bytes,1,opt,name=specification_id,json=specificationId,proto3,customtype=MetadataAddress" json:"specification_id" yaml:"specification_id"`
-	Signers         []string        `protobuf:"bytes,2,rep,name=signers,proto3" json:"signers,omitempty"`
+// MsgWriteScopeSpecificationRequest is the request type for the Msg/WriteScopeSpecification RPC method.
+type MsgWriteScopeSpecificationRequest struct {
+	// specification is the ScopeSpecification you want added or updated.
+	Specification ScopeSpecification `protobuf:"bytes,1,opt,name=specification,proto3" json:"specification"`
+	// signers is the list of address of those signing this request.
+	Signers []string `protobuf:"bytes,2,rep,name=signers,proto3" json:"signers,omitempty"`
+	// spec_uuid is an optional scope specification uuid string, e.g. "dc83ea70-eacd-40fe-9adf-1cf6148bf8a2"
+	// If provided, it will be used to generate the MetadataAddress for the scope specification which will override the
+	// specification_id in the provided specification. If not provided (or it is an empty string), nothing special
+	// happens.
+	// If there is a value in specification.specification_id that is different from the one created from this uuid, an
+	// error is returned.
+	SpecUuid string `protobuf:"bytes,3,opt,name=spec_uuid,json=specUuid,proto3" json:"spec_uuid,omitempty" yaml:"spec_uuid"`
 }
 
-func (m *MsgDeleteContractSpecificationRequest) Reset()      { *m = MsgDeleteContractSpecificationRequest{} }
-func (*MsgDeleteContractSpecificationRequest) ProtoMessage() {}
-func (*MsgDeleteContractSpecificationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{29}
+func (m *MsgWriteScopeSpecificationRequest) Reset()      { *m = MsgWriteScopeSpecificationRequest{} }
+func (*MsgWriteScopeSpecificationRequest) ProtoMessage() {}
+func (*MsgWriteScopeSpecificationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{32}
 }
-func (m *MsgDeleteContractSpecificationRequest) XXX_Unmarshal(b []byte) error {
+func (m *MsgWriteScopeSpecificationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgDeleteContractSpecificationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgWriteScopeSpecificationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgDeleteContractSpecificationRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgWriteScopeSpecificationRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1344,36 +1487,36 @@ func (m *MsgDeleteContractSpecificationRequest) XXX_Marshal(b []byte, determinis
 		return b[:n], nil
 	}
 }
-func (m *MsgDeleteContractSpecificationRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgDeleteContractSpecificationRequest.Merge(m, src)
+func (m *MsgWriteScopeSpecificationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWriteScopeSpecificationRequest.Merge(m, src)
 }
-func (m *MsgDeleteContractSpecificationRequest) XXX_Size() int {
+func (m *MsgWriteScopeSpecificationRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgDeleteContractSpecificationRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgDeleteContractSpecificationRequest.DiscardUnknown(m)
+func (m *MsgWriteScopeSpecificationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWriteScopeSpecificationRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgDeleteContractSpecificationRequest proto.InternalMessageInfo
+var xxx_messageInfo_MsgWriteScopeSpecificationRequest proto.InternalMessageInfo
 
-// MsgDeleteContractSpecificationResponse is the response type for the Msg/DeleteContractSpecification RPC method.
-type MsgDeleteContractSpecificationResponse struct {
+// MsgWriteScopeSpecificationResponse is the response type for the Msg/WriteScopeSpecification RPC method.
+type MsgWriteScopeSpecificationResponse struct {
+	// scope_spec_id_info contains information about the id/address of the scope specification that was added or updated.
+	ScopeSpecIdInfo *ScopeSpecIdInfo `protobuf:"bytes,1,opt,name=scope_spec_id_info,json=scopeSpecIdInfo,proto3" json:"scope_spec_id_info,omitempty" yaml:"scope_spec_id_info"`
 }
 
-func (m *MsgDeleteContractSpecificationResponse) Reset() {
-	*m = MsgDeleteContractSpecificationResponse{}
-}
-func (m *MsgDeleteContractSpecificationResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgDeleteContractSpecificationResponse) ProtoMessage()    {}
-func (*MsgDeleteContractSpecificationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{30}
+func (m *MsgWriteScopeSpecificationResponse) Reset()         { *m = MsgWriteScopeSpecificationResponse{} }
+func (m *MsgWriteScopeSpecificationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWriteScopeSpecificationResponse) ProtoMessage()    {}
+func (*MsgWriteScopeSpecificationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{33}
 }
-func (m *MsgDeleteContractSpecificationResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgWriteScopeSpecificationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgDeleteContractSpecificationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgWriteScopeSpecificationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgDeleteContractSpecificationResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgWriteScopeSpecificationResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1383,44 +1526,43 @@ func (m *MsgDeleteContractSpecificationResponse) XXX_Marshal(b []byte, determini
 		return b[:n], nil
 	}
 }
-func (m *MsgDeleteContractSpecificationResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgDeleteContractSpecificationResponse.Merge(m, src)
+func (m *MsgWriteScopeSpecificationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWriteScopeSpecificationResponse.Merge(m, src)
 }
-func (m *MsgDeleteContractSpecificationResponse) XXX_Size() int {
+func (m *MsgWriteScopeSpecificationResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgDeleteContractSpecificationResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgDeleteContractSpecificationResponse.DiscardUnknown(m)
+func (m *MsgWriteScopeSpecificationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWriteScopeSpecificationResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgDeleteContractSpecificationResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgWriteScopeSpecificationResponse proto.InternalMessageInfo
 
-// MsgWriteRecordSpecificationRequest is the request type for the Msg/WriteRecordSpecification RPC method.
-type MsgWriteRecordSpecificationRequest struct {
-	// specification is the RecordSpecification you want added or updated.
-	Specification RecordSpecification `protobuf:"bytes,1,opt,name=specification,proto3" json:"specification"`
-	// signers is the list of address of those signing this request.
-	Signers []string `protobuf:"bytes,2,rep,name=signers,proto3" json:"signers,omitempty"`
-	// contract_spec_uuid is an optional contract specification uuid string, e.g. "def6bc0a-c9dd-4874-948f-5206e6060a84"
-	// If provided, it will be combined with the record specification name to generate the MetadataAddress for the record
-	// specification which will override the specification_id in the provided specification. If not provided (or it is an
-	// empty string), nothing special happens.
-	// If there is a value in specification.specification_id that is different from the one created from this uuid and
-	// specification.name, an error is returned.
-	ContractSpecUuid string `protobuf:"bytes,3,opt,name=contract_spec_uuid,json=contractSpecUuid,proto3" json:"contract_spec_uuid,omitempty" yaml:"contract_spec_uuid"`
+func (m *MsgWriteScopeSpecificationResponse) GetScopeSpecIdInfo() *ScopeSpecIdInfo {
+	if m != nil {
+		return m.ScopeSpecIdInfo
+	}
+	return nil
 }
 
-func (m *MsgWriteRecordSpecificationRequest) Reset()      { *m = MsgWriteRecordSpecificationRequest{} }
-func (*MsgWriteRecordSpecificationRequest) ProtoMessage() {}
-func (*MsgWriteRecordSpecificationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{31}
+// MsgDeleteScopeSpecificationRequest is the request type for the Msg/DeleteScopeSpecification RPC method.
+type MsgDeleteScopeSpecificationRequest struct {
+	// MetadataAddress for the scope specification to delete.
+	SpecificationId MetadataAddress `protobuf:"bytes,1,opt,name=specification_id,json=specificationId,proto3,customtype=MetadataAddress" json:"specification_id" yaml:"specification_id"`
+	Signers         []string        `protobuf:"bytes,2,rep,name=signers,proto3" json:"signers,omitempty"`
 }
-func (m *MsgWriteRecordSpecificationRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *MsgDeleteScopeSpecificationRequest) Reset()      { *m = MsgDeleteScopeSpecificationRequest{} }
+func (*MsgDeleteScopeSpecificationRequest) ProtoMessage() {}
+func (*MsgDeleteScopeSpecificationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{34}
+}
+func (m *MsgDeleteScopeSpecificationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgWriteRecordSpecificationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgDeleteScopeSpecificationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgWriteRecordSpecificationRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgDeleteScopeSpecificationRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1430,37 +1572,34 @@ func (m *MsgWriteRecordSpecificationRequest) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *MsgWriteRecordSpecificationRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgWriteRecordSpecificationRequest.Merge(m, src)
+func (m *MsgDeleteScopeSpecificationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteScopeSpecificationRequest.Merge(m, src)
 }
-func (m *MsgWriteRecordSpecificationRequest) XXX_Size() int {
+func (m *MsgDeleteScopeSpecificationRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgWriteRecordSpecificationRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgWriteRecordSpecificationRequest.DiscardUnknown(m)
+func (m *MsgDeleteScopeSpecificationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteScopeSpecificationRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgWriteRecordSpecificationRequest proto.InternalMessageInfo
+var xxx_messageInfo_MsgDeleteScopeSpecificationRequest proto.InternalMessageInfo
 
-// MsgWriteRecordSpecificationResponse is the response type for the Msg/WriteRecordSpecification RPC method.
-type MsgWriteRecordSpecificationResponse struct {
-	// record_spec_id_info contains information about the id/address of the record specification that was added or
-	// updated.
-	RecordSpecIdInfo *RecordSpecIdInfo `protobuf:"bytes,1,opt,name=record_spec_id_info,json=recordSpecIdInfo,proto3" json:"record_spec_id_info,omitempty" yaml:"record_spec_id_info"`
+// MsgDeleteScopeSpecificationResponse is the response type for the Msg/DeleteScopeSpecification RPC method.
+type MsgDeleteScopeSpecificationResponse struct {
 }
 
-func (m *MsgWriteRecordSpecificationResponse) Reset()         { *m = MsgWriteRecordSpecificationResponse{} }
-func (m *MsgWriteRecordSpecificationResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgWriteRecordSpecificationResponse) ProtoMessage()    {}
-func (*MsgWriteRecordSpecificationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{32}
+func (m *MsgDeleteScopeSpecificationResponse) Reset()         { *m = MsgDeleteScopeSpecificationResponse{} }
+func (m *MsgDeleteScopeSpecificationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteScopeSpecificationResponse) ProtoMessage()    {}
+func (*MsgDeleteScopeSpecificationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{35}
 }
-func (m *MsgWriteRecordSpecificationResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgDeleteScopeSpecificationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgWriteRecordSpecificationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgDeleteScopeSpecificationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgWriteRecordSpecificationResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgDeleteScopeSpecificationResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1470,43 +1609,44 @@ func (m *MsgWriteRecordSpecificationResponse) XXX_Marshal(b []byte, deterministi
 		return b[:n], nil
 	}
 }
-func (m *MsgWriteRecordSpecificationResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgWriteRecordSpecificationResponse.Merge(m, src)
+func (m *MsgDeleteScopeSpecificationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteScopeSpecificationResponse.Merge(m, src)
 }
-func (m *MsgWriteRecordSpecificationResponse) XXX_Size() int {
+func (m *MsgDeleteScopeSpecificationResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgWriteRecordSpecificationResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgWriteRecordSpecificationResponse.DiscardUnknown(m)
+func (m *MsgDeleteScopeSpecificationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteScopeSpecificationResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgWriteRecordSpecificationResponse proto.InternalMessageInfo
-
-func (m *MsgWriteRecordSpecificationResponse) GetRecordSpecIdInfo() *RecordSpecIdInfo {
-	if m != nil {
-		return m.RecordSpecIdInfo
-	}
-	return nil
-}
+var xxx_messageInfo_MsgDeleteScopeSpecificationResponse proto.InternalMessageInfo
 
-// MsgDeleteRecordSpecificationRequest is the request type for the Msg/DeleteRecordSpecification RPC method.
-type MsgDeleteRecordSpecificationRequest struct {
-	// MetadataAddress for the record specification to delete.
-	SpecificationId MetadataAddress `protobuf:"bytes,1,opt,name=specification_id,json=specificationId,proto3,customtype=MetadataAddress" json:"specification_id" yaml:"specification_id"`
-	Signers         []string        `protobuf:"bytes,2,rep,name=signers,proto3" json:"signers,omitempty"`
+// MsgWriteContractSpecificationRequest is the request type for the Msg/WriteContractSpecification RPC method.
+type MsgWriteContractSpecificationRequest struct {
+	// specification is the ContractSpecification you want added or updated.
+	Specification ContractSpecification `protobuf:"bytes,1,opt,name=specification,proto3" json:"specification"`
+	// signers is the list of address of those signing this request.
+	Signers []string `protobuf:"bytes,2,rep,name=signers,proto3" json:"signers,omitempty"`
+	// spec_uuid is an optional contract specification uuid string, e.g. "def6bc0a-c9dd-4874-948f-5206e6060a84"
+	// If provided, it will be used to generate the MetadataAddress for the contract specification which will override the
+	// specification_id in the provided specification. If not provided (or it is an empty string), nothing special
+	// happens.
+	// If there is a value in specification.specification_id that is different from the one created from this uuid, an
+	// error is returned.
+	SpecUuid string `protobuf:"bytes,3,opt,name=spec_uuid,json=specUuid,proto3" json:"spec_uuid,omitempty" yaml:"spec_uuid"`
 }
 
-func (m *MsgDeleteRecordSpecificationRequest) Reset()      { *m = MsgDeleteRecordSpecificationRequest{} }
-func (*MsgDeleteRecordSpecificationRequest) ProtoMessage() {}
-func (*MsgDeleteRecordSpecificationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{33}
+func (m *MsgWriteContractSpecificationRequest) Reset()      { *m = MsgWriteContractSpecificationRequest{} }
+func (*MsgWriteContractSpecificationRequest) ProtoMessage() {}
+func (*MsgWriteContractSpecificationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{36}
 }
-func (m *MsgDeleteRecordSpecificationRequest) XXX_Unmarshal(b []byte) error {
+func (m *MsgWriteContractSpecificationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgDeleteRecordSpecificationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgWriteContractSpecificationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgDeleteRecordSpecificationRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgWriteContractSpecificationRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1516,34 +1656,37 @@ func (m *MsgDeleteRecordSpecificationRequest) XXX_Marshal(b []byte, deterministi
 		return b[:n], nil
 	}
 }
-func (m *MsgDeleteRecordSpecificationRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgDeleteRecordSpecificationRequest.Merge(m, src)
+func (m *MsgWriteContractSpecificationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWriteContractSpecificationRequest.Merge(m, src)
 }
-func (m *MsgDeleteRecordSpecificationRequest) XXX_Size() int {
+func (m *MsgWriteContractSpecificationRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgDeleteRecordSpecificationRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgDeleteRecordSpecificationRequest.DiscardUnknown(m)
+func (m *MsgWriteContractSpecificationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWriteContractSpecificationRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgDeleteRecordSpecificationRequest proto.InternalMessageInfo
+var xxx_messageInfo_MsgWriteContractSpecificationRequest proto.InternalMessageInfo
 
-// MsgDeleteRecordSpecificationResponse is the response type for the Msg/DeleteRecordSpecification RPC method.
-type MsgDeleteRecordSpecificationResponse struct {
+// MsgWriteContractSpecificationResponse is the response type for the Msg/WriteContractSpecification RPC method.
+type MsgWriteContractSpecificationResponse struct {
+	// contract_spec_id_info contains information about the id/address of the contract specification that was added or
+	// updated.
+	ContractSpecIdInfo *ContractSpecIdInfo `protobuf:"bytes,1,opt,name=contract_spec_id_info,json=contractSpecIdInfo,proto3" json:"contract_spec_id_info,omitempty" yaml:"contract_spec_id_info"`
 }
 
-func (m *MsgDeleteRecordSpecificationResponse) Reset()         { *m = MsgDeleteRecordSpecificationResponse{} }
-func (m *MsgDeleteRecordSpecificationResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgDeleteRecordSpecificationResponse) ProtoMessage()    {}
-func (*MsgDeleteRecordSpecificationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{34}
+func (m *MsgWriteContractSpecificationResponse) Reset()         { *m = MsgWriteContractSpecificationResponse{} }
+func (m *MsgWriteContractSpecificationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWriteContractSpecificationResponse) ProtoMessage()    {}
+func (*MsgWriteContractSpecificationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{37}
 }
-func (m *MsgDeleteRecordSpecificationResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgWriteContractSpecificationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgDeleteRecordSpecificationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgWriteContractSpecificationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgDeleteRecordSpecificationResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgWriteContractSpecificationResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1553,36 +1696,45 @@ func (m *MsgDeleteRecordSpecificationResponse) XXX_Marshal(b []byte, determinist
 		return b[:n], nil
 	}
 }
-func (m *MsgDeleteRecordSpecificationResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgDeleteRecordSpecificationResponse.Merge(m, src)
+func (m *MsgWriteContractSpecificationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWriteContractSpecificationResponse.Merge(m, src)
 }
-func (m *MsgDeleteRecordSpecificationResponse) XXX_Size() int {
+func (m *MsgWriteContractSpecificationResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgDeleteRecordSpecificationResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgDeleteRecordSpecificationResponse.DiscardUnknown(m)
+func (m *MsgWriteContractSpecificationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWriteContractSpecificationResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgDeleteRecordSpecificationResponse proto.InternalMessageInfo
-
-// MsgWriteP8eContractSpecRequest is the request type for the Msg/WriteP8eContractSpec RPC method.
-type MsgWriteP8EContractSpecRequest struct {
-	// ContractSpec v39 p8e ContractSpect to be converted into a v40
-	Contractspec p8e.ContractSpec `protobuf:"bytes,1,opt,name=contractspec,proto3" json:"contractspec"`
-	Signers      []string         `protobuf:"bytes,2,rep,name=signers,proto3" json:"signers,omitempty"`
-}
+var xxx_messageInfo_MsgWriteContractSpecificationResponse proto.InternalMessageInfo
 
-func (m *MsgWriteP8EContractSpecRequest) Reset()      { *m = MsgWriteP8EContractSpecRequest{} }
-func (*MsgWriteP8EContractSpecRequest) ProtoMessage() {}
-func (*MsgWriteP8EContractSpecRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{35}
+func (m *MsgWriteContractSpecificationResponse) GetContractSpecIdInfo() *ContractSpecIdInfo {
+	if m != nil {
+		return m.ContractSpecIdInfo
+	}
+	return nil
 }
-func (m *MsgWriteP8EContractSpecRequest) XXX_Unmarshal(b []byte) error {
+
+// MsgAddContractSpecToScopeSpecRequest is the request type for the Msg/AddContractSpecToScopeSpec RPC method.
+type MsgAddContractSpecToScopeSpecRequest struct {
+	// MetadataAddress for the contract specification to add.
+	ContractSpecificationId MetadataAddress `protobuf:"bytes,1,opt,name=contract_specification_id,json=contractSpecificationId,proto3,customtype=MetadataAddress" json:"contract_specification_id" yaml:"specification_id"`
+	// MetadataAddress for the scope specification to add contract specification to.
+	ScopeSpecificationId MetadataAddress `protobuf:"bytes,2,opt,name=scope_specification_id,json=scopeSpecificationId,proto3,customtype=MetadataAddress" json:"scope_specification_id" yaml:"specification_id"`
+	Signers              []string        `protobuf:"bytes,3,rep,name=signers,proto3" json:"signers,omitempty"`
+}
+
+func (m *MsgAddContractSpecToScopeSpecRequest) Reset()      { *m = MsgAddContractSpecToScopeSpecRequest{} }
+func (*MsgAddContractSpecToScopeSpecRequest) ProtoMessage() {}
+func (*MsgAddContractSpecToScopeSpecRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{38}
+}
+func (m *MsgAddContractSpecToScopeSpecRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgWriteP8EContractSpecRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgAddContractSpecToScopeSpecRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgWriteP8EContractSpecRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgAddContractSpecToScopeSpecRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1592,40 +1744,34 @@ func (m *MsgWriteP8EContractSpecRequest) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *MsgWriteP8EContractSpecRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgWriteP8EContractSpecRequest.Merge(m, src)
+func (m *MsgAddContractSpecToScopeSpecRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddContractSpecToScopeSpecRequest.Merge(m, src)
 }
-func (m *MsgWriteP8EContractSpecRequest) XXX_Size() int {
+func (m *MsgAddContractSpecToScopeSpecRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgWriteP8EContractSpecRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgWriteP8EContractSpecRequest.DiscardUnknown(m)
+func (m *MsgAddContractSpecToScopeSpecRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddContractSpecToScopeSpecRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgWriteP8EContractSpecRequest proto.InternalMessageInfo
+var xxx_messageInfo_MsgAddContractSpecToScopeSpecRequest proto.InternalMessageInfo
 
-// MsgWriteP8eContractSpecResponse is the response type for the Msg/WriteP8eContractSpec RPC method.
-type MsgWriteP8EContractSpecResponse struct {
-	// contract_spec_id_info contains information about the id/address of the contract specification that was added or
-	// updated.
-	ContractSpecIdInfo *ContractSpecIdInfo `protobuf:"bytes,1,opt,name=contract_spec_id_info,json=contractSpecIdInfo,proto3" json:"contract_spec_id_info,omitempty" yaml:"contract_spec_id_info"`
-	// record_spec_id_infos contains information about the ids/addresses of the record specifications that were added or
-	// updated.
-	RecordSpecIdInfos []*RecordSpecIdInfo `protobuf:"bytes,2,rep,name=record_spec_id_infos,json=recordSpecIdInfos,proto3" json:"record_spec_id_infos,omitempty" yaml:"record_spec_id_infos"`
+// MsgAddContractSpecToScopeSpecResponse is the response type for the Msg/AddContractSpecToScopeSpec RPC method.
+type MsgAddContractSpecToScopeSpecResponse struct {
 }
 
-func (m *MsgWriteP8EContractSpecResponse) Reset()         { *m = MsgWriteP8EContractSpecResponse{} }
-func (m *MsgWriteP8EContractSpecResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgWriteP8EContractSpecResponse) ProtoMessage()    {}
-func (*MsgWriteP8EContractSpecResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{36}
+func (m *MsgAddContractSpecToScopeSpecResponse) Reset()         { *m = MsgAddContractSpecToScopeSpecResponse{} }
+func (m *MsgAddContractSpecToScopeSpecResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddContractSpecToScopeSpecResponse) ProtoMessage()    {}
+func (*MsgAddContractSpecToScopeSpecResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{39}
 }
-func (m *MsgWriteP8EContractSpecResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgAddContractSpecToScopeSpecResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgWriteP8EContractSpecResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgAddContractSpecToScopeSpecResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgWriteP8EContractSpecResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgAddContractSpecToScopeSpecResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1635,61 +1781,40 @@ func (m *MsgWriteP8EContractSpecResponse) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *MsgWriteP8EContractSpecResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgWriteP8EContractSpecResponse.Merge(m, src)
+func (m *MsgAddContractSpecToScopeSpecResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddContractSpecToScopeSpecResponse.Merge(m, src)
 }
-func (m *MsgWriteP8EContractSpecResponse) XXX_Size() int {
+func (m *MsgAddContractSpecToScopeSpecResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgWriteP8EContractSpecResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgWriteP8EContractSpecResponse.DiscardUnknown(m)
+func (m *MsgAddContractSpecToScopeSpecResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddContractSpecToScopeSpecResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgWriteP8EContractSpecResponse proto.InternalMessageInfo
-
-func (m *MsgWriteP8EContractSpecResponse) GetContractSpecIdInfo() *ContractSpecIdInfo {
-	if m != nil {
-		return m.ContractSpecIdInfo
-	}
-	return nil
-}
+var xxx_messageInfo_MsgAddContractSpecToScopeSpecResponse proto.InternalMessageInfo
 
-func (m *MsgWriteP8EContractSpecResponse) GetRecordSpecIdInfos() []*RecordSpecIdInfo {
-	if m != nil {
-		return m.RecordSpecIdInfos
-	}
-	return nil
+// MsgDeleteContractSpecFromScopeSpecRequest is the request type for the Msg/DeleteContractSpecFromScopeSpec RPC method.
+type MsgDeleteContractSpecFromScopeSpecRequest struct {
+	// MetadataAddress for the contract specification to add.
+	ContractSpecificationId MetadataAddress `protobuf:"bytes,1,opt,name=contract_specification_id,json=contractSpecificationId,proto3,customtype=MetadataAddress" json:"contract_specification_id" yaml:"specification_id"`
+	// MetadataAddress for the scope specification to add contract specification to.
+	ScopeSpecificationId MetadataAddress `protobuf:"bytes,2,opt,name=scope_specification_id,json=scopeSpecificationId,proto3,customtype=MetadataAddress" json:"scope_specification_id" yaml:"specification_id"`
+	Signers              []string        `protobuf:"bytes,3,rep,name=signers,proto3" json:"signers,omitempty"`
 }
 
-// MsgP8eMemorializeContractRequest is the request type for the Msg/P8eMemorializeContract RPC method.
-type MsgP8EMemorializeContractRequest struct {
-	// The scope id of the object being add or modified on blockchain.
-	ScopeId string `protobuf:"bytes,1,opt,name=scope_id,json=scopeId,proto3" json:"scope_id,omitempty"`
-	// The uuid of the contract execution.
-	GroupId string `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
-	// The scope specification id.
-	ScopeSpecificationId string `protobuf:"bytes,3,opt,name=scope_specification_id,json=scopeSpecificationId,proto3" json:"scope_specification_id,omitempty"`
-	// The new recitals for the scope.  Used in leu of Contract for direct ownership changes.
-	Recitals *p8e.Recitals `protobuf:"bytes,4,opt,name=recitals,proto3" json:"recitals,omitempty"`
-	// The executed contract.
-	Contract *p8e.Contract `protobuf:"bytes,5,opt,name=contract,proto3" json:"contract,omitempty"`
-	// The contract signatures
-	Signatures *p8e.SignatureSet `protobuf:"bytes,6,opt,name=signatures,proto3" json:"signatures,omitempty"`
-	// The bech32 address of the notary (ie the broadcaster of this message).
-	Invoker string `protobuf:"bytes,7,opt,name=invoker,proto3" json:"invoker,omitempty"`
+func (m *MsgDeleteContractSpecFromScopeSpecRequest) Reset() {
+	*m = MsgDeleteContractSpecFromScopeSpecRequest{}
 }
-
-func (m *MsgP8EMemorializeContractRequest) Reset()      { *m = MsgP8EMemorializeContractRequest{} }
-func (*MsgP8EMemorializeContractRequest) ProtoMessage() {}
-func (*MsgP8EMemorializeContractRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{37}
+func (*MsgDeleteContractSpecFromScopeSpecRequest) ProtoMessage() {}
+func (*MsgDeleteContractSpecFromScopeSpecRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{40}
 }
-func (m *MsgP8EMemorializeContractRequest) XXX_Unmarshal(b []byte) error {
+func (m *MsgDeleteContractSpecFromScopeSpecRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgP8EMemorializeContractRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgDeleteContractSpecFromScopeSpecRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgP8EMemorializeContractRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgDeleteContractSpecFromScopeSpecRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1699,40 +1824,39 @@ func (m *MsgP8EMemorializeContractRequest) XXX_Marshal(b []byte, deterministic b
 		return b[:n], nil
 	}
 }
-func (m *MsgP8EMemorializeContractRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgP8EMemorializeContractRequest.Merge(m, src)
+func (m *MsgDeleteContractSpecFromScopeSpecRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteContractSpecFromScopeSpecRequest.Merge(m, src)
 }
-func (m *MsgP8EMemorializeContractRequest) XXX_Size() int {
+func (m *MsgDeleteContractSpecFromScopeSpecRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgP8EMemorializeContractRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgP8EMemorializeContractRequest.DiscardUnknown(m)
+func (m *MsgDeleteContractSpecFromScopeSpecRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteContractSpecFromScopeSpecRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgP8EMemorializeContractRequest proto.InternalMessageInfo
+var xxx_messageInfo_MsgDeleteContractSpecFromScopeSpecRequest proto.InternalMessageInfo
 
-// MsgP8eMemorializeContractResponse is the response type for the Msg/P8eMemorializeContract RPC method.
-type MsgP8EMemorializeContractResponse struct {
-	// scope_id_info contains information about the id/address of the scope that was added or updated.
-	ScopeIdInfo *ScopeIdInfo `protobuf:"bytes,1,opt,name=scope_id_info,json=scopeIdInfo,proto3" json:"scope_id_info,omitempty" yaml:"scope_id_info"`
-	// session_id_info contains information about the id/address of the session that was added or updated.
-	SessionIdInfo *SessionIdInfo `protobuf:"bytes,2,opt,name=session_id_info,json=sessionIdInfo,proto3" json:"session_id_info,omitempty" yaml:"session_id_info"`
-	// record_id_infos contains information about the ids/addresses of the records that were added or updated.
-	RecordIdInfos []*RecordIdInfo `protobuf:"bytes,3,rep,name=record_id_infos,json=recordIdInfos,proto3" json:"record_id_infos,omitempty" yaml:"record_id_infos"`
+// MsgDeleteContractSpecFromScopeSpecResponse is the response type for the Msg/DeleteContractSpecFromScopeSpec RPC
+// method.
+type MsgDeleteContractSpecFromScopeSpecResponse struct {
 }
 
-func (m *MsgP8EMemorializeContractResponse) Reset()         { *m = MsgP8EMemorializeContractResponse{} }
-func (m *MsgP8EMemorializeContractResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgP8EMemorializeContractResponse) ProtoMessage()    {}
-func (*MsgP8EMemorializeContractResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{38}
+func (m *MsgDeleteContractSpecFromScopeSpecResponse) Reset() {
+	*m = MsgDeleteContractSpecFromScopeSpecResponse{}
 }
-func (m *MsgP8EMemorializeContractResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgDeleteContractSpecFromScopeSpecResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgDeleteContractSpecFromScopeSpecResponse) ProtoMessage() {}
+func (*MsgDeleteContractSpecFromScopeSpecResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{41}
+}
+func (m *MsgDeleteContractSpecFromScopeSpecResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgP8EMemorializeContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgDeleteContractSpecFromScopeSpecResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgP8EMemorializeContractResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgDeleteContractSpecFromScopeSpecResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1742,57 +1866,36 @@ func (m *MsgP8EMemorializeContractResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *MsgP8EMemorializeContractResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgP8EMemorializeContractResponse.Merge(m, src)
+func (m *MsgDeleteContractSpecFromScopeSpecResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteContractSpecFromScopeSpecResponse.Merge(m, src)
 }
-func (m *MsgP8EMemorializeContractResponse) XXX_Size() int {
+func (m *MsgDeleteContractSpecFromScopeSpecResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgP8EMemorializeContractResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgP8EMemorializeContractResponse.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_MsgP8EMemorializeContractResponse proto.InternalMessageInfo
-
-func (m *MsgP8EMemorializeContractResponse) GetScopeIdInfo() *ScopeIdInfo {
-	if m != nil {
-		return m.ScopeIdInfo
-	}
-	return nil
-}
-
-func (m *MsgP8EMemorializeContractResponse) GetSessionIdInfo() *SessionIdInfo {
-	if m != nil {
-		return m.SessionIdInfo
-	}
-	return nil
+func (m *MsgDeleteContractSpecFromScopeSpecResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteContractSpecFromScopeSpecResponse.DiscardUnknown(m)
 }
 
-func (m *MsgP8EMemorializeContractResponse) GetRecordIdInfos() []*RecordIdInfo {
-	if m != nil {
-		return m.RecordIdInfos
-	}
-	return nil
-}
+var xxx_messageInfo_MsgDeleteContractSpecFromScopeSpecResponse proto.InternalMessageInfo
 
-// MsgBindOSLocatorRequest is the request type for the Msg/BindOSLocator RPC method.
-type MsgBindOSLocatorRequest struct {
-	// The object locator to bind the address to bind to the URI.
-	Locator ObjectStoreLocator `protobuf:"bytes,1,opt,name=locator,proto3" json:"locator"`
+// MsgDeleteContractSpecificationRequest is the request type for the Msg/DeleteContractSpecification RPC method.
+type MsgDeleteContractSpecificationRequest struct {
+	// MetadataAddress for the contract specification to delete.
+	SpecificationId MetadataAddress `protobuf:"bytes,1,opt,name=specification_id,json=specificationId,proto3,customtype=MetadataAddress" json:"specification_id" yaml:"specification_id"`
+	Signers         []string        `protobuf:"bytes,2,rep,name=signers,proto3" json:"signers,omitempty"`
 }
 
-func (m *MsgBindOSLocatorRequest) Reset()         { *m = MsgBindOSLocatorRequest{} }
-func (m *MsgBindOSLocatorRequest) String() string { return proto.CompactTextString(m) }
-func (*MsgBindOSLocatorRequest) ProtoMessage()    {}
-func (*MsgBindOSLocatorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{39}
+func (m *MsgDeleteContractSpecificationRequest) Reset()      { *m = MsgDeleteContractSpecificationRequest{} }
+func (*MsgDeleteContractSpecificationRequest) ProtoMessage() {}
+func (*MsgDeleteContractSpecificationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{42}
 }
-func (m *MsgBindOSLocatorRequest) XXX_Unmarshal(b []byte) error {
+func (m *MsgDeleteContractSpecificationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgBindOSLocatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgDeleteContractSpecificationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgBindOSLocatorRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgDeleteContractSpecificationRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1802,35 +1905,36 @@ func (m *MsgBindOSLocatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *MsgBindOSLocatorRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgBindOSLocatorRequest.Merge(m, src)
+func (m *MsgDeleteContractSpecificationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteContractSpecificationRequest.Merge(m, src)
 }
-func (m *MsgBindOSLocatorRequest) XXX_Size() int {
+func (m *MsgDeleteContractSpecificationRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgBindOSLocatorRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgBindOSLocatorRequest.DiscardUnknown(m)
+func (m *MsgDeleteContractSpecificationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteContractSpecificationRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgBindOSLocatorRequest proto.InternalMessageInfo
+var xxx_messageInfo_MsgDeleteContractSpecificationRequest proto.InternalMessageInfo
 
-// MsgBindOSLocatorResponse is the response type for the Msg/BindOSLocator RPC method.
-type MsgBindOSLocatorResponse struct {
-	Locator ObjectStoreLocator `protobuf:"bytes,1,opt,name=locator,proto3" json:"locator"`
+// MsgDeleteContractSpecificationResponse is the response type for the Msg/DeleteContractSpecification RPC method.
+type MsgDeleteContractSpecificationResponse struct {
 }
 
-func (m *MsgBindOSLocatorResponse) Reset()         { *m = MsgBindOSLocatorResponse{} }
-func (m *MsgBindOSLocatorResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgBindOSLocatorResponse) ProtoMessage()    {}
-func (*MsgBindOSLocatorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{40}
-}
-func (m *MsgBindOSLocatorResponse) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+func (m *MsgDeleteContractSpecificationResponse) Reset() {
+	*m = MsgDeleteContractSpecificationResponse{}
 }
-func (m *MsgBindOSLocatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgDeleteContractSpecificationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteContractSpecificationResponse) ProtoMessage()    {}
+func (*MsgDeleteContractSpecificationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{43}
+}
+func (m *MsgDeleteContractSpecificationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeleteContractSpecificationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgBindOSLocatorResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgDeleteContractSpecificationResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1840,43 +1944,44 @@ func (m *MsgBindOSLocatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *MsgBindOSLocatorResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgBindOSLocatorResponse.Merge(m, src)
+func (m *MsgDeleteContractSpecificationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteContractSpecificationResponse.Merge(m, src)
 }
-func (m *MsgBindOSLocatorResponse) XXX_Size() int {
+func (m *MsgDeleteContractSpecificationResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgBindOSLocatorResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgBindOSLocatorResponse.DiscardUnknown(m)
+func (m *MsgDeleteContractSpecificationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteContractSpecificationResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgBindOSLocatorResponse proto.InternalMessageInfo
-
-func (m *MsgBindOSLocatorResponse) GetLocator() ObjectStoreLocator {
-	if m != nil {
-		return m.Locator
-	}
-	return ObjectStoreLocator{}
-}
+var xxx_messageInfo_MsgDeleteContractSpecificationResponse proto.InternalMessageInfo
 
-// MsgDeleteOSLocatorRequest is the request type for the Msg/DeleteOSLocator RPC method.
-type MsgDeleteOSLocatorRequest struct {
-	// The record being removed
-	Locator ObjectStoreLocator `protobuf:"bytes,1,opt,name=locator,proto3" json:"locator"`
+// MsgWriteRecordSpecificationRequest is the request type for the Msg/WriteRecordSpecification RPC method.
+type MsgWriteRecordSpecificationRequest struct {
+	// specification is the RecordSpecification you want added or updated.
+	Specification RecordSpecification `protobuf:"bytes,1,opt,name=specification,proto3" json:"specification"`
+	// signers is the list of address of those signing this request.
+	Signers []string `protobuf:"bytes,2,rep,name=signers,proto3" json:"signers,omitempty"`
+	// contract_spec_uuid is an optional contract specification uuid string, e.g. "def6bc0a-c9dd-4874-948f-5206e6060a84"
+	// If provided, it will be combined with the record specification name to generate the MetadataAddress for the record
+	// specification which will override the specification_id in the provided specification. If not provided (or it is an
+	// empty string), nothing special happens.
+	// If there is a value in specification.specification_id that is different from the one created from this uuid and
+	// specification.name, an error is returned.
+	ContractSpecUuid string `protobuf:"bytes,3,opt,name=contract_spec_uuid,json=contractSpecUuid,proto3" json:"contract_spec_uuid,omitempty" yaml:"contract_spec_uuid"`
 }
 
-func (m *MsgDeleteOSLocatorRequest) Reset()         { *m = MsgDeleteOSLocatorRequest{} }
-func (m *MsgDeleteOSLocatorRequest) String() string { return proto.CompactTextString(m) }
-func (*MsgDeleteOSLocatorRequest) ProtoMessage()    {}
-func (*MsgDeleteOSLocatorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{41}
+func (m *MsgWriteRecordSpecificationRequest) Reset()      { *m = MsgWriteRecordSpecificationRequest{} }
+func (*MsgWriteRecordSpecificationRequest) ProtoMessage() {}
+func (*MsgWriteRecordSpecificationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{44}
 }
-func (m *MsgDeleteOSLocatorRequest) XXX_Unmarshal(b []byte) error {
+func (m *MsgWriteRecordSpecificationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgDeleteOSLocatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgWriteRecordSpecificationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgDeleteOSLocatorRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgWriteRecordSpecificationRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1886,35 +1991,37 @@ func (m *MsgDeleteOSLocatorRequest) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *MsgDeleteOSLocatorRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgDeleteOSLocatorRequest.Merge(m, src)
+func (m *MsgWriteRecordSpecificationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWriteRecordSpecificationRequest.Merge(m, src)
 }
-func (m *MsgDeleteOSLocatorRequest) XXX_Size() int {
+func (m *MsgWriteRecordSpecificationRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgDeleteOSLocatorRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgDeleteOSLocatorRequest.DiscardUnknown(m)
+func (m *MsgWriteRecordSpecificationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWriteRecordSpecificationRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgDeleteOSLocatorRequest proto.InternalMessageInfo
+var xxx_messageInfo_MsgWriteRecordSpecificationRequest proto.InternalMessageInfo
 
-// MsgDeleteOSLocatorResponse is the response type for the Msg/DeleteOSLocator RPC method.
-type MsgDeleteOSLocatorResponse struct {
-	Locator ObjectStoreLocator `protobuf:"bytes,1,opt,name=locator,proto3" json:"locator"`
+// MsgWriteRecordSpecificationResponse is the response type for the Msg/WriteRecordSpecification RPC method.
+type MsgWriteRecordSpecificationResponse struct {
+	// record_spec_id_info contains information about the id/address of the record specification that was added or
+	// updated.
+	RecordSpecIdInfo *RecordSpecIdInfo `protobuf:"bytes,1,opt,name=record_spec_id_info,json=recordSpecIdInfo,proto3" json:"record_spec_id_info,omitempty" yaml:"record_spec_id_info"`
 }
 
-func (m *MsgDeleteOSLocatorResponse) Reset()         { *m = MsgDeleteOSLocatorResponse{} }
-func (m *MsgDeleteOSLocatorResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgDeleteOSLocatorResponse) ProtoMessage()    {}
-func (*MsgDeleteOSLocatorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{42}
+func (m *MsgWriteRecordSpecificationResponse) Reset()         { *m = MsgWriteRecordSpecificationResponse{} }
+func (m *MsgWriteRecordSpecificationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWriteRecordSpecificationResponse) ProtoMessage()    {}
+func (*MsgWriteRecordSpecificationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{45}
 }
-func (m *MsgDeleteOSLocatorResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgWriteRecordSpecificationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgDeleteOSLocatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgWriteRecordSpecificationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgDeleteOSLocatorResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgWriteRecordSpecificationResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1924,43 +2031,43 @@ func (m *MsgDeleteOSLocatorResponse) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *MsgDeleteOSLocatorResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgDeleteOSLocatorResponse.Merge(m, src)
+func (m *MsgWriteRecordSpecificationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWriteRecordSpecificationResponse.Merge(m, src)
 }
-func (m *MsgDeleteOSLocatorResponse) XXX_Size() int {
+func (m *MsgWriteRecordSpecificationResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgDeleteOSLocatorResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgDeleteOSLocatorResponse.DiscardUnknown(m)
+func (m *MsgWriteRecordSpecificationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWriteRecordSpecificationResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgDeleteOSLocatorResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgWriteRecordSpecificationResponse proto.InternalMessageInfo
 
-func (m *MsgDeleteOSLocatorResponse) GetLocator() ObjectStoreLocator {
+func (m *MsgWriteRecordSpecificationResponse) GetRecordSpecIdInfo() *RecordSpecIdInfo {
 	if m != nil {
-		return m.Locator
+		return m.RecordSpecIdInfo
 	}
-	return ObjectStoreLocator{}
+	return nil
 }
 
-// MsgModifyOSLocatorRequest is the request type for the Msg/ModifyOSLocator RPC method.
-type MsgModifyOSLocatorRequest struct {
-	// The object locator to bind the address to bind to the URI.
-	Locator ObjectStoreLocator `protobuf:"bytes,1,opt,name=locator,proto3" json:"locator"`
+// MsgDeleteRecordSpecificationRequest is the request type for the Msg/DeleteRecordSpecification RPC method.
+type MsgDeleteRecordSpecificationRequest struct {
+	// MetadataAddress for the record specification to delete.
+	SpecificationId MetadataAddress `protobuf:"bytes,1,opt,name=specification_id,json=specificationId,proto3,customtype=MetadataAddress" json:"specification_id" yaml:"specification_id"`
+	Signers         []string        `protobuf:"bytes,2,rep,name=signers,proto3" json:"signers,omitempty"`
 }
 
-func (m *MsgModifyOSLocatorRequest) Reset()         { *m = MsgModifyOSLocatorRequest{} }
-func (m *MsgModifyOSLocatorRequest) String() string { return proto.CompactTextString(m) }
-func (*MsgModifyOSLocatorRequest) ProtoMessage()    {}
-func (*MsgModifyOSLocatorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{43}
+func (m *MsgDeleteRecordSpecificationRequest) Reset()      { *m = MsgDeleteRecordSpecificationRequest{} }
+func (*MsgDeleteRecordSpecificationRequest) ProtoMessage() {}
+func (*MsgDeleteRecordSpecificationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{46}
 }
-func (m *MsgModifyOSLocatorRequest) XXX_Unmarshal(b []byte) error {
+func (m *MsgDeleteRecordSpecificationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgModifyOSLocatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgDeleteRecordSpecificationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgModifyOSLocatorRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgDeleteRecordSpecificationRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1970,35 +2077,34 @@ func (m *MsgModifyOSLocatorRequest) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *MsgModifyOSLocatorRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgModifyOSLocatorRequest.Merge(m, src)
+func (m *MsgDeleteRecordSpecificationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteRecordSpecificationRequest.Merge(m, src)
 }
-func (m *MsgModifyOSLocatorRequest) XXX_Size() int {
+func (m *MsgDeleteRecordSpecificationRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgModifyOSLocatorRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgModifyOSLocatorRequest.DiscardUnknown(m)
+func (m *MsgDeleteRecordSpecificationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteRecordSpecificationRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgModifyOSLocatorRequest proto.InternalMessageInfo
+var xxx_messageInfo_MsgDeleteRecordSpecificationRequest proto.InternalMessageInfo
 
-// MsgModifyOSLocatorResponse is the response type for the Msg/ModifyOSLocator RPC method.
-type MsgModifyOSLocatorResponse struct {
-	Locator ObjectStoreLocator `protobuf:"bytes,1,opt,name=locator,proto3" json:"locator"`
+// MsgDeleteRecordSpecificationResponse is the response type for the Msg/DeleteRecordSpecification RPC method.
+type MsgDeleteRecordSpecificationResponse struct {
 }
 
-func (m *MsgModifyOSLocatorResponse) Reset()         { *m = MsgModifyOSLocatorResponse{} }
-func (m *MsgModifyOSLocatorResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgModifyOSLocatorResponse) ProtoMessage()    {}
-func (*MsgModifyOSLocatorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{44}
+func (m *MsgDeleteRecordSpecificationResponse) Reset()         { *m = MsgDeleteRecordSpecificationResponse{} }
+func (m *MsgDeleteRecordSpecificationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteRecordSpecificationResponse) ProtoMessage()    {}
+func (*MsgDeleteRecordSpecificationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{47}
 }
-func (m *MsgModifyOSLocatorResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgDeleteRecordSpecificationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgModifyOSLocatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgDeleteRecordSpecificationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgModifyOSLocatorResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgDeleteRecordSpecificationResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2008,1714 +2114,1834 @@ func (m *MsgModifyOSLocatorResponse) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *MsgModifyOSLocatorResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgModifyOSLocatorResponse.Merge(m, src)
+func (m *MsgDeleteRecordSpecificationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteRecordSpecificationResponse.Merge(m, src)
 }
-func (m *MsgModifyOSLocatorResponse) XXX_Size() int {
+func (m *MsgDeleteRecordSpecificationResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgModifyOSLocatorResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgModifyOSLocatorResponse.DiscardUnknown(m)
+func (m *MsgDeleteRecordSpecificationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteRecordSpecificationResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgModifyOSLocatorResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgDeleteRecordSpecificationResponse proto.InternalMessageInfo
 
-func (m *MsgModifyOSLocatorResponse) GetLocator() ObjectStoreLocator {
-	if m != nil {
-		return m.Locator
-	}
-	return ObjectStoreLocator{}
+// MsgWriteP8eContractSpecRequest is the request type for the Msg/WriteP8eContractSpec RPC method.
+type MsgWriteP8EContractSpecRequest struct {
+	// ContractSpec v39 p8e ContractSpect to be converted into a v40
+	Contractspec p8e.ContractSpec `protobuf:"bytes,1,opt,name=contractspec,proto3" json:"contractspec"`
+	Signers      []string         `protobuf:"bytes,2,rep,name=signers,proto3" json:"signers,omitempty"`
 }
 
-func init() {
-	proto.RegisterType((*MsgWriteScopeRequest)(nil), "provenance.metadata.v1.MsgWriteScopeRequest")
-	proto.RegisterType((*MsgWriteScopeResponse)(nil), "provenance.metadata.v1.MsgWriteScopeResponse")
-	proto.RegisterType((*MsgDeleteScopeRequest)(nil), "provenance.metadata.v1.MsgDeleteScopeRequest")
-	proto.RegisterType((*MsgDeleteScopeResponse)(nil), "provenance.metadata.v1.MsgDeleteScopeResponse")
-	proto.RegisterType((*MsgAddScopeDataAccessRequest)(nil), "provenance.metadata.v1.MsgAddScopeDataAccessRequest")
-	proto.RegisterType((*MsgAddScopeDataAccessResponse)(nil), "provenance.metadata.v1.MsgAddScopeDataAccessResponse")
-	proto.RegisterType((*MsgDeleteScopeDataAccessRequest)(nil), "provenance.metadata.v1.MsgDeleteScopeDataAccessRequest")
-	proto.RegisterType((*MsgDeleteScopeDataAccessResponse)(nil), "provenance.metadata.v1.MsgDeleteScopeDataAccessResponse")
-	proto.RegisterType((*MsgAddScopeOwnerRequest)(nil), "provenance.metadata.v1.MsgAddScopeOwnerRequest")
-	proto.RegisterType((*MsgAddScopeOwnerResponse)(nil), "provenance.metadata.v1.MsgAddScopeOwnerResponse")
-	proto.RegisterType((*MsgDeleteScopeOwnerRequest)(nil), "provenance.metadata.v1.MsgDeleteScopeOwnerRequest")
-	proto.RegisterType((*MsgDeleteScopeOwnerResponse)(nil), "provenance.metadata.v1.MsgDeleteScopeOwnerResponse")
-	proto.RegisterType((*MsgWriteSessionRequest)(nil), "provenance.metadata.v1.MsgWriteSessionRequest")
-	proto.RegisterType((*SessionIdComponents)(nil), "provenance.metadata.v1.SessionIdComponents")
-	proto.RegisterType((*MsgWriteSessionResponse)(nil), "provenance.metadata.v1.MsgWriteSessionResponse")
-	proto.RegisterType((*MsgWriteRecordRequest)(nil), "provenance.metadata.v1.MsgWriteRecordRequest")
-	proto.RegisterType((*MsgWriteRecordResponse)(nil), "provenance.metadata.v1.MsgWriteRecordResponse")
-	proto.RegisterType((*MsgDeleteRecordRequest)(nil), "provenance.metadata.v1.MsgDeleteRecordRequest")
-	proto.RegisterType((*MsgDeleteRecordResponse)(nil), "provenance.metadata.v1.MsgDeleteRecordResponse")
-	proto.RegisterType((*MsgWriteScopeSpecificationRequest)(nil), "provenance.metadata.v1.MsgWriteScopeSpecificationRequest")
-	proto.RegisterType((*MsgWriteScopeSpecificationResponse)(nil), "provenance.metadata.v1.MsgWriteScopeSpecificationResponse")
-	proto.RegisterType((*MsgDeleteScopeSpecificationRequest)(nil), "provenance.metadata.v1.MsgDeleteScopeSpecificationRequest")
-	proto.RegisterType((*MsgDeleteScopeSpecificationResponse)(nil), "provenance.metadata.v1.MsgDeleteScopeSpecificationResponse")
-	proto.RegisterType((*MsgWriteContractSpecificationRequest)(nil), "provenance.metadata.v1.MsgWriteContractSpecificationRequest")
-	proto.RegisterType((*MsgWriteContractSpecificationResponse)(nil), "provenance.metadata.v1.MsgWriteContractSpecificationResponse")
-	proto.RegisterType((*MsgAddContractSpecToScopeSpecRequest)(nil), "provenance.metadata.v1.MsgAddContractSpecToScopeSpecRequest")
-	proto.RegisterType((*MsgAddContractSpecToScopeSpecResponse)(nil), "provenance.metadata.v1.MsgAddContractSpecToScopeSpecResponse")
-	proto.RegisterType((*MsgDeleteContractSpecFromScopeSpecRequest)(nil), "provenance.metadata.v1.MsgDeleteContractSpecFromScopeSpecRequest")
-	proto.RegisterType((*MsgDeleteContractSpecFromScopeSpecResponse)(nil), "provenance.metadata.v1.MsgDeleteContractSpecFromScopeSpecResponse")
-	proto.RegisterType((*MsgDeleteContractSpecificationRequest)(nil), "provenance.metadata.v1.MsgDeleteContractSpecificationRequest")
-	proto.RegisterType((*MsgDeleteContractSpecificationResponse)(nil), "provenance.metadata.v1.MsgDeleteContractSpecificationResponse")
-	proto.RegisterType((*MsgWriteRecordSpecificationRequest)(nil), "provenance.metadata.v1.MsgWriteRecordSpecificationRequest")
-	proto.RegisterType((*MsgWriteRecordSpecificationResponse)(nil), "provenance.metadata.v1.MsgWriteRecordSpecificationResponse")
-	proto.RegisterType((*MsgDeleteRecordSpecificationRequest)(nil), "provenance.metadata.v1.MsgDeleteRecordSpecificationRequest")
-	proto.RegisterType((*MsgDeleteRecordSpecificationResponse)(nil), "provenance.metadata.v1.MsgDeleteRecordSpecificationResponse")
-	proto.RegisterType((*MsgWriteP8EContractSpecRequest)(nil), "provenance.metadata.v1.MsgWriteP8eContractSpecRequest")
-	proto.RegisterType((*MsgWriteP8EContractSpecResponse)(nil), "provenance.metadata.v1.MsgWriteP8eContractSpecResponse")
-	proto.RegisterType((*MsgP8EMemorializeContractRequest)(nil), "provenance.metadata.v1.MsgP8eMemorializeContractRequest")
-	proto.RegisterType((*MsgP8EMemorializeContractResponse)(nil), "provenance.metadata.v1.MsgP8eMemorializeContractResponse")
-	proto.RegisterType((*MsgBindOSLocatorRequest)(nil), "provenance.metadata.v1.MsgBindOSLocatorRequest")
-	proto.RegisterType((*MsgBindOSLocatorResponse)(nil), "provenance.metadata.v1.MsgBindOSLocatorResponse")
-	proto.RegisterType((*MsgDeleteOSLocatorRequest)(nil), "provenance.metadata.v1.MsgDeleteOSLocatorRequest")
-	proto.RegisterType((*MsgDeleteOSLocatorResponse)(nil), "provenance.metadata.v1.MsgDeleteOSLocatorResponse")
-	proto.RegisterType((*MsgModifyOSLocatorRequest)(nil), "provenance.metadata.v1.MsgModifyOSLocatorRequest")
-	proto.RegisterType((*MsgModifyOSLocatorResponse)(nil), "provenance.metadata.v1.MsgModifyOSLocatorResponse")
+func (m *MsgWriteP8EContractSpecRequest) Reset()      { *m = MsgWriteP8EContractSpecRequest{} }
+func (*MsgWriteP8EContractSpecRequest) ProtoMessage() {}
+func (*MsgWriteP8EContractSpecRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{48}
 }
-
-func init() { proto.RegisterFile("provenance/metadata/v1/tx.proto", fileDescriptor_3a3a0892f91e3036) }
-
-var fileDescriptor_3a3a0892f91e3036 = []byte{
-	// 2045 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0xdd, 0x6f, 0x1c, 0x57,
-	0x15, 0xdf, 0xbb, 0xdb, 0xc4, 0xf6, 0xb1, 0x8d, 0x9d, 0x1b, 0x7f, 0xec, 0x4e, 0x9a, 0x1d, 0x73,
-	0x9b, 0xb4, 0xae, 0xd3, 0xec, 0x12, 0x37, 0x34, 0x8e, 0x9b, 0x00, 0xd9, 0x16, 0x14, 0x43, 0xad,
-	0x44, 0x63, 0xa0, 0x02, 0x09, 0x45, 0x9b, 0x9d, 0x6b, 0x67, 0xa8, 0x3d, 0x77, 0x3b, 0x33, 0xce,
-	0x17, 0x0f, 0xa5, 0x12, 0x0f, 0x51, 0x85, 0x50, 0x01, 0xa9, 0xa2, 0x12, 0xaa, 0xf2, 0xd8, 0x07,
-	0x24, 0x3e, 0x1e, 0x11, 0x7f, 0x40, 0x85, 0x84, 0xd4, 0x17, 0x24, 0x54, 0xd0, 0xaa, 0x4a, 0x24,
-	0xc4, 0xf3, 0x3e, 0xf0, 0x8c, 0x66, 0xe6, 0xce, 0xce, 0xbd, 0xbb, 0x77, 0x3e, 0x76, 0xeb, 0x06,
-	0x23, 0xf1, 0x10, 0x29, 0x33, 0x7b, 0xbe, 0x7e, 0xe7, 0xfe, 0xee, 0x39, 0xf7, 0x9e, 0x31, 0xe8,
-	0x6d, 0x87, 0xdd, 0xa6, 0x76, 0xd3, 0x6e, 0xd1, 0xfa, 0x1e, 0xf5, 0x9a, 0x66, 0xd3, 0x6b, 0xd6,
-	0x6f, 0x9f, 0xab, 0x7b, 0x77, 0x6b, 0x6d, 0x87, 0x79, 0x0c, 0x2f, 0xc4, 0x02, 0xb5, 0x48, 0xa0,
-	0x76, 0xfb, 0x9c, 0x36, 0xb7, 0xc3, 0x76, 0x58, 0x20, 0x52, 0xf7, 0xff, 0x17, 0x4a, 0x6b, 0xa7,
-	0x13, 0xcc, 0xf5, 0x34, 0x43, 0xb1, 0xe5, 0x04, 0x31, 0x76, 0xf3, 0x87, 0xb4, 0xe5, 0xb9, 0x1e,
-	0x73, 0x28, 0x97, 0x3c, 0x95, 0x20, 0xd9, 0x5e, 0xa3, 0xfe, 0x3f, 0x2e, 0x45, 0x12, 0xa4, 0xdc,
-	0x16, 0x6b, 0x47, 0x32, 0x2b, 0x49, 0x32, 0x6d, 0xda, 0xb2, 0xb6, 0xad, 0x56, 0xd3, 0xb3, 0x98,
-	0x1d, 0xca, 0x92, 0x7f, 0x22, 0x98, 0xdb, 0x74, 0x77, 0x5e, 0x77, 0x2c, 0x8f, 0x6e, 0xf9, 0x36,
-	0x0c, 0xfa, 0xe6, 0x3e, 0x75, 0x3d, 0x7c, 0x11, 0x8e, 0x04, 0x36, 0xcb, 0x68, 0x09, 0x2d, 0x4f,
-	0xae, 0x9e, 0xac, 0xa9, 0xb3, 0x53, 0x0b, 0x94, 0x1a, 0x4f, 0x7d, 0xd4, 0xd1, 0x0b, 0x46, 0xa8,
-	0x81, 0xcb, 0x30, 0xe6, 0x5a, 0x3b, 0x36, 0x75, 0xdc, 0x72, 0x71, 0xa9, 0xb4, 0x3c, 0x61, 0x44,
-	0x8f, 0xf8, 0x3c, 0x40, 0x20, 0x72, 0x63, 0x7f, 0xdf, 0x32, 0xcb, 0xa5, 0x25, 0xb4, 0x3c, 0xd1,
-	0x98, 0xef, 0x76, 0xf4, 0x63, 0xf7, 0x9a, 0x7b, 0xbb, 0xeb, 0x24, 0xfe, 0x8d, 0x18, 0x13, 0xc1,
-	0xc3, 0x77, 0xf6, 0x2d, 0x13, 0x9f, 0x83, 0x09, 0x3f, 0xf4, 0x50, 0xe9, 0xa9, 0x40, 0x69, 0xae,
-	0xdb, 0xd1, 0x67, 0xb9, 0x52, 0xf4, 0x13, 0x31, 0xc6, 0xfd, 0xff, 0xfb, 0x2a, 0xeb, 0xb3, 0x0f,
-	0x1e, 0xea, 0x85, 0x5f, 0x3d, 0xd4, 0x0b, 0xff, 0x7a, 0xa8, 0x17, 0x7e, 0xfc, 0x8f, 0xa5, 0x02,
-	0xb9, 0x0f, 0xf3, 0x7d, 0x38, 0xdd, 0x36, 0xb3, 0x5d, 0x8a, 0x9b, 0x30, 0x1d, 0xfa, 0xb5, 0xcc,
-	0x1b, 0x96, 0xbd, 0xcd, 0x38, 0xe0, 0x67, 0x52, 0x01, 0x6f, 0x98, 0x1b, 0xf6, 0x36, 0x6b, 0x94,
-	0xbb, 0x1d, 0x7d, 0x4e, 0x8c, 0x9d, 0xdb, 0x20, 0xc6, 0xa4, 0x1b, 0x8b, 0x91, 0x77, 0x50, 0xe0,
-	0xfc, 0x55, 0xba, 0x4b, 0xfb, 0xb2, 0xfc, 0x75, 0x18, 0x8f, 0x14, 0x03, 0xbf, 0x53, 0x8d, 0x15,
-	0x3f, 0x93, 0x9f, 0x74, 0xf4, 0x99, 0x4d, 0xee, 0xf3, 0x8a, 0x69, 0x3a, 0xd4, 0x75, 0xbb, 0x1d,
-	0x7d, 0x46, 0xf6, 0x44, 0x8c, 0x31, 0xee, 0x24, 0x39, 0xe3, 0x8a, 0x44, 0x94, 0x61, 0xa1, 0x3f,
-	0x96, 0x30, 0x13, 0xe4, 0xcf, 0x08, 0x9e, 0xde, 0x74, 0x77, 0xae, 0x98, 0x66, 0xf0, 0xfe, 0x55,
-	0xdf, 0x79, 0xab, 0x45, 0x5d, 0xf7, 0x80, 0xa3, 0xbd, 0x00, 0x93, 0xbe, 0xe8, 0x8d, 0x66, 0x60,
-	0x3c, 0x8c, 0xb8, 0xb1, 0xd0, 0xed, 0xe8, 0x38, 0x54, 0x11, 0x7e, 0x24, 0x06, 0x98, 0xbd, 0x30,
-	0x44, 0x98, 0xa5, 0x2c, 0x98, 0x3a, 0x9c, 0x4c, 0xc0, 0xc2, 0xd1, 0xfe, 0x05, 0x81, 0x2e, 0x27,
-	0xe2, 0x7f, 0x1b, 0x30, 0x81, 0xa5, 0x64, 0x38, 0x1c, 0xf3, 0x27, 0x08, 0x16, 0x85, 0xac, 0x5c,
-	0xbb, 0x63, 0x53, 0xe7, 0x80, 0xb1, 0xbe, 0x06, 0x47, 0xd9, 0x9d, 0x1e, 0x13, 0x53, 0x0a, 0xc7,
-	0xf5, 0xa6, 0xe3, 0xdd, 0x6b, 0xcc, 0xfb, 0x3e, 0xba, 0x1d, 0x7d, 0x3a, 0x34, 0x18, 0xaa, 0x12,
-	0x83, 0xdb, 0x18, 0x2a, 0x01, 0x1a, 0x94, 0x07, 0xb1, 0x71, 0xe0, 0x7f, 0x44, 0xa0, 0xc9, 0xd9,
-	0xf9, 0x3c, 0xb0, 0x3f, 0x2f, 0x61, 0x9f, 0x68, 0x1c, 0x3b, 0x18, 0x60, 0x27, 0xe1, 0x84, 0x32,
-	0x76, 0x8e, 0xed, 0x4f, 0xc5, 0x60, 0x47, 0x87, 0xa5, 0x8d, 0xba, 0xae, 0xc5, 0xec, 0x08, 0xd7,
-	0x57, 0x61, 0xcc, 0x0d, 0xdf, 0xf0, 0xaa, 0xa6, 0x27, 0x56, 0xb5, 0x50, 0x8c, 0x17, 0xf2, 0x48,
-	0x2b, 0xa5, 0x94, 0xbf, 0x8d, 0x60, 0x9e, 0x4b, 0xf9, 0x55, 0xaf, 0xc5, 0xf6, 0xda, 0xcc, 0xa6,
-	0xb6, 0xe7, 0x06, 0x65, 0x7d, 0x72, 0xf5, 0x4c, 0x86, 0xa7, 0x0d, 0xf3, 0x95, 0x9e, 0x4a, 0x63,
-	0xa9, 0xdb, 0xd1, 0x9f, 0xe6, 0x69, 0x55, 0xd9, 0x24, 0xc6, 0x71, 0x77, 0x50, 0xed, 0x60, 0x1a,
-	0xc3, 0x5f, 0x11, 0x1c, 0x57, 0xc4, 0x84, 0x5f, 0x92, 0x7a, 0x15, 0x4a, 0xe9, 0x55, 0x57, 0x0b,
-	0x62, 0xb7, 0xea, 0xe9, 0x35, 0x4d, 0xd3, 0x29, 0x17, 0xd5, 0x7a, 0xfe, 0x6f, 0xb1, 0x9e, 0xcf,
-	0x2d, 0xbc, 0x0e, 0x53, 0x11, 0x76, 0xa1, 0x3b, 0x2e, 0x76, 0x3b, 0xfa, 0x71, 0x39, 0x33, 0x21,
-	0xa4, 0x49, 0xfe, 0xe8, 0xfb, 0x6c, 0x60, 0x98, 0x8d, 0xe8, 0x48, 0x6d, 0xcf, 0xda, 0xb6, 0xa8,
-	0x43, 0x7e, 0x12, 0xee, 0x75, 0x99, 0x16, 0xbc, 0xe7, 0x59, 0x30, 0x23, 0xe4, 0x59, 0xe8, 0x7a,
-	0xa7, 0x33, 0x57, 0x2d, 0xe8, 0x7b, 0x5a, 0xb7, 0xa3, 0x2f, 0x0c, 0xac, 0x57, 0xd8, 0xf9, 0xa6,
-	0x5d, 0x51, 0x94, 0xfc, 0xbc, 0x14, 0x37, 0x5e, 0x83, 0xb6, 0x98, 0x63, 0x46, 0xe4, 0xbc, 0x04,
-	0x47, 0x9d, 0xe0, 0x05, 0xf7, 0x5d, 0x4d, 0xf2, 0x1d, 0xaa, 0x71, 0x6a, 0x72, 0x9d, 0x43, 0xce,
-	0xcc, 0x6f, 0x01, 0x6e, 0x31, 0xdb, 0x73, 0x9a, 0x2d, 0xef, 0x46, 0x3f, 0x45, 0x4f, 0x76, 0x3b,
-	0x7a, 0x25, 0x34, 0x39, 0x28, 0x43, 0x8c, 0xd9, 0xe8, 0xe5, 0x16, 0xe7, 0x2c, 0xbe, 0x0c, 0x63,
-	0xed, 0xa6, 0xe3, 0x59, 0xd4, 0x2d, 0x1f, 0xc9, 0x53, 0x53, 0xf9, 0x1e, 0xe6, 0x3a, 0x0a, 0xca,
-	0xbf, 0x15, 0x17, 0x8c, 0x68, 0x49, 0x38, 0x31, 0x28, 0x7c, 0x21, 0xcc, 0x6f, 0x1f, 0x2f, 0x4e,
-	0xa5, 0xaf, 0x0d, 0xa7, 0x45, 0xa5, 0xdb, 0xd1, 0xe7, 0x43, 0x64, 0xb2, 0x15, 0x62, 0x4c, 0x39,
-	0x82, 0x20, 0xf9, 0x19, 0x12, 0x0e, 0x21, 0x32, 0x2b, 0xae, 0xc2, 0x44, 0x4f, 0x97, 0xd7, 0xe2,
-	0x33, 0xc9, 0xb5, 0x78, 0xb6, 0xcf, 0x1b, 0x31, 0xc6, 0x23, 0x47, 0x43, 0x1d, 0x8a, 0x2a, 0xc1,
-	0x5e, 0x91, 0xe3, 0x89, 0x7b, 0xe6, 0x17, 0xa5, 0x93, 0xe3, 0x96, 0x78, 0x8c, 0x8e, 0xc2, 0xfe,
-	0x2e, 0x4c, 0x4b, 0xc7, 0x6b, 0x9e, 0xb7, 0x95, 0xd4, 0x53, 0xa4, 0x64, 0x89, 0x2f, 0x9b, 0x6c,
-	0x26, 0x85, 0xe6, 0x52, 0xf1, 0x2b, 0x8d, 0x58, 0xfc, 0xde, 0x47, 0x40, 0xd2, 0xc0, 0x71, 0x5a,
-	0xb8, 0x80, 0xc3, 0xfa, 0x12, 0x98, 0x95, 0xa9, 0xf1, 0x5c, 0x26, 0x44, 0xce, 0x0e, 0x81, 0xf7,
-	0x83, 0xc6, 0x88, 0x31, 0xe3, 0xca, 0xf2, 0xe4, 0xb7, 0x61, 0x6c, 0x42, 0xdf, 0x53, 0x66, 0xfe,
-	0x07, 0x30, 0x2b, 0xa5, 0x2c, 0xe6, 0xcd, 0x6a, 0x32, 0x6f, 0x16, 0xe3, 0x2c, 0x89, 0x8a, 0x7e,
-	0x14, 0xe2, 0xab, 0x21, 0x59, 0x74, 0x1a, 0x9e, 0x49, 0x0d, 0x98, 0x33, 0xea, 0x53, 0x04, 0xa7,
-	0xa2, 0xa4, 0xbf, 0x22, 0x6c, 0xf6, 0x01, 0x68, 0xdf, 0x53, 0x93, 0xea, 0x6c, 0x52, 0xc6, 0x95,
-	0xc6, 0xfe, 0x2b, 0xbc, 0xfa, 0x10, 0xc1, 0xe9, 0x0c, 0x88, 0x9c, 0x5a, 0x6f, 0xc1, 0xbc, 0x5c,
-	0x05, 0x65, 0x76, 0xad, 0xe4, 0xc1, 0xca, 0x09, 0x26, 0xd4, 0x6a, 0xa5, 0x49, 0x62, 0xe0, 0xd6,
-	0x80, 0x16, 0xf9, 0x4d, 0x31, 0x58, 0x8d, 0x2b, 0xa6, 0x29, 0x9a, 0xfc, 0x36, 0xeb, 0x2d, 0x60,
-	0xb4, 0x1a, 0x36, 0x54, 0x24, 0xb3, 0x07, 0xc4, 0xb8, 0xc5, 0x96, 0x2a, 0x3f, 0x1b, 0x26, 0xbe,
-	0x05, 0x0b, 0xf1, 0x3e, 0x91, 0x9c, 0x15, 0x47, 0x76, 0x36, 0xe7, 0x0e, 0xd0, 0x52, 0xe6, 0x78,
-	0xe6, 0x61, 0xf4, 0xb9, 0x60, 0x61, 0xd3, 0xb2, 0xc5, 0x59, 0xfe, 0xfb, 0x22, 0x3c, 0xdf, 0xdb,
-	0x0d, 0xa2, 0xf0, 0x37, 0x1c, 0xb6, 0xf7, 0xff, 0xe4, 0x2a, 0x93, 0xfb, 0x02, 0xac, 0xe4, 0x49,
-	0x19, 0xcf, 0xf0, 0x1f, 0xc2, 0x4d, 0x36, 0x28, 0x7e, 0x98, 0x6b, 0xe4, 0x32, 0x3c, 0x9b, 0x15,
-	0x33, 0x87, 0xf7, 0x6f, 0xa1, 0x37, 0x85, 0x3d, 0x59, 0x89, 0xed, 0x75, 0x75, 0x91, 0x3c, 0x93,
-	0x7e, 0x62, 0xf9, 0x4c, 0x25, 0x52, 0x7d, 0xba, 0x2b, 0x8d, 0x74, 0xba, 0x53, 0xa4, 0xe8, 0x03,
-	0x14, 0xf4, 0x91, 0x64, 0xe0, 0xbc, 0x74, 0xde, 0x81, 0xe3, 0xfc, 0xe0, 0xa3, 0x28, 0x9c, 0xcb,
-	0xd9, 0xf8, 0x79, 0xd9, 0xac, 0x76, 0x3b, 0xba, 0x26, 0x9d, 0xa3, 0xe4, 0xa2, 0x39, 0xeb, 0xf4,
-	0x69, 0x90, 0xdf, 0x21, 0xa1, 0xd1, 0xa5, 0x2c, 0xcd, 0x21, 0xa2, 0xdd, 0xb3, 0x41, 0x91, 0x4f,
-	0x89, 0x98, 0x93, 0xee, 0x21, 0x82, 0x6a, 0x94, 0xfb, 0xeb, 0x6b, 0x12, 0x43, 0x23, 0x54, 0x06,
-	0x4c, 0x45, 0x8b, 0xe8, 0x47, 0x94, 0x95, 0xef, 0xf6, 0x1a, 0x95, 0x9a, 0x15, 0x27, 0x9b, 0x64,
-	0x63, 0x28, 0x28, 0x1f, 0x14, 0x83, 0xc1, 0x95, 0x3a, 0xc4, 0x43, 0xd2, 0x55, 0xf1, 0x7d, 0x98,
-	0x53, 0x90, 0x29, 0x1a, 0x0a, 0xe5, 0x27, 0xa7, 0xde, 0xed, 0xe8, 0x27, 0x12, 0xc9, 0xe9, 0x12,
-	0xe3, 0x58, 0x3f, 0x3b, 0x5d, 0xf2, 0xa0, 0x14, 0x8c, 0xc2, 0xae, 0xaf, 0xd1, 0x4d, 0xba, 0xc7,
-	0x1c, 0xab, 0xb9, 0x6b, 0xdd, 0xef, 0xa5, 0x29, 0x5a, 0xc5, 0x4a, 0xdf, 0xc8, 0x67, 0x22, 0x1e,
-	0xe3, 0x54, 0x60, 0x7c, 0xc7, 0x61, 0xfb, 0xed, 0xa8, 0x1b, 0x4c, 0x18, 0x63, 0xc1, 0xf3, 0x86,
-	0x89, 0xcf, 0x27, 0xb6, 0x8d, 0x60, 0xf7, 0x27, 0xb4, 0x80, 0xaf, 0x81, 0x7f, 0x2b, 0xb1, 0xbc,
-	0xe6, 0xae, 0x1b, 0xdc, 0x01, 0x53, 0xee, 0x53, 0x3e, 0x5b, 0x0c, 0x2e, 0x6b, 0xf4, 0xb4, 0x7c,
-	0x0b, 0x51, 0x92, 0xcb, 0x47, 0xb2, 0x2d, 0xf4, 0xc0, 0xf6, 0xb4, 0xf0, 0x55, 0x00, 0x9f, 0x52,
-	0x4d, 0x6f, 0xdf, 0xa1, 0x6e, 0xf9, 0x68, 0x36, 0x67, 0xb7, 0x22, 0xe9, 0x2d, 0xea, 0x19, 0x82,
-	0xae, 0xcf, 0x55, 0xcb, 0xbe, 0xcd, 0xde, 0xa0, 0x4e, 0x79, 0x2c, 0xcc, 0x0e, 0x7f, 0x54, 0x70,
-	0xf5, 0xef, 0xc5, 0xe0, 0xf2, 0x94, 0xb4, 0x14, 0x4f, 0x6c, 0x04, 0xaf, 0x9a, 0x78, 0x14, 0x3f,
-	0x9f, 0x89, 0x07, 0xbe, 0x05, 0x33, 0xf2, 0xed, 0x37, 0x6c, 0xfc, 0x79, 0x2f, 0xd1, 0x82, 0xa7,
-	0x3e, 0x33, 0xc4, 0x98, 0x16, 0x6f, 0xd1, 0x2e, 0x61, 0xc1, 0xad, 0xb5, 0x61, 0xd9, 0xe6, 0xb5,
-	0xad, 0xd7, 0x58, 0xab, 0xe9, 0xb1, 0xde, 0x44, 0xf3, 0x9b, 0x30, 0xb6, 0x1b, 0xbe, 0xc9, 0xda,
-	0xf2, 0xd7, 0x82, 0x2f, 0x51, 0x5b, 0x1e, 0x73, 0x28, 0xb7, 0x11, 0x0d, 0x10, 0xb8, 0x81, 0xf5,
-	0xf1, 0x07, 0x7c, 0x49, 0xc9, 0x76, 0x30, 0x62, 0xed, 0x73, 0xc8, 0x17, 0xf1, 0x00, 0x3d, 0x92,
-	0x37, 0xa1, 0xd2, 0xab, 0xd6, 0x4f, 0x08, 0xda, 0x2d, 0x61, 0x40, 0xfc, 0x24, 0xc0, 0x6d, 0x32,
-	0xd3, 0xda, 0xbe, 0xf7, 0x44, 0xc1, 0x0d, 0xb8, 0x3c, 0x78, 0x70, 0xab, 0xef, 0x2d, 0x42, 0x69,
-	0xd3, 0xdd, 0xc1, 0x16, 0x40, 0x3c, 0x54, 0xc0, 0x2f, 0x24, 0x19, 0x54, 0x7d, 0x7a, 0xd4, 0xce,
-	0xe6, 0x94, 0xe6, 0xe1, 0xef, 0xc2, 0xa4, 0x70, 0xe5, 0xc6, 0x69, 0xda, 0x83, 0x5f, 0xe0, 0xb4,
-	0x5a, 0x5e, 0x71, 0xee, 0xed, 0x6d, 0x04, 0x78, 0xf0, 0xab, 0x12, 0x3e, 0x9f, 0x62, 0x26, 0xf1,
-	0x83, 0x9a, 0xf6, 0xe5, 0x21, 0xb5, 0x78, 0x0c, 0xef, 0x20, 0x98, 0x57, 0x7e, 0xe8, 0xc1, 0x17,
-	0xf2, 0xa1, 0x19, 0x8c, 0x64, 0x6d, 0x78, 0x45, 0x1e, 0x8c, 0x03, 0xd3, 0xd2, 0x37, 0x17, 0x5c,
-	0xcf, 0x01, 0x4a, 0xfc, 0xfa, 0xa2, 0x7d, 0x29, 0xbf, 0x02, 0xf7, 0xf9, 0x23, 0x98, 0xed, 0xff,
-	0x1c, 0x82, 0x57, 0xf3, 0x21, 0x90, 0x3c, 0xbf, 0x38, 0x94, 0x0e, 0x77, 0xce, 0x60, 0x4a, 0x1c,
-	0xaa, 0xe3, 0x5a, 0x26, 0x5d, 0xa5, 0x8f, 0x32, 0x5a, 0x3d, 0xb7, 0x7c, 0x4c, 0x70, 0xe1, 0x2e,
-	0x80, 0x33, 0xb7, 0x87, 0x34, 0x50, 0xd5, 0x6a, 0x79, 0xc5, 0x63, 0x78, 0xe2, 0x31, 0x19, 0x67,
-	0x6f, 0x10, 0xd9, 0x5f, 0x3d, 0xb7, 0x3c, 0x77, 0xf8, 0x2e, 0x82, 0xc5, 0x84, 0x01, 0x24, 0xbe,
-	0x98, 0xab, 0x14, 0xa8, 0x2e, 0x1f, 0xda, 0xfa, 0x28, 0xaa, 0x3c, 0xa4, 0x5f, 0x22, 0x28, 0x27,
-	0x8d, 0xf1, 0xf0, 0x7a, 0x3e, 0xd2, 0x28, 0x83, 0x7a, 0x79, 0x24, 0x5d, 0x1e, 0xd5, 0xfb, 0x08,
-	0xb4, 0xe4, 0x89, 0x1a, 0xbe, 0x94, 0x05, 0x38, 0x6d, 0x44, 0xa0, 0x5d, 0x1e, 0x51, 0x9b, 0xc7,
-	0xf6, 0x6b, 0x04, 0x27, 0x52, 0x2e, 0xf5, 0xf8, 0x72, 0x26, 0xf0, 0xd4, 0xe8, 0xbe, 0x32, 0xaa,
-	0xba, 0x90, 0xba, 0xe4, 0x99, 0x55, 0x6a, 0xea, 0x32, 0x07, 0x83, 0xa9, 0xa9, 0xcb, 0x1e, 0x94,
-	0xe1, 0x0f, 0x11, 0xe8, 0x19, 0x23, 0x1f, 0x7c, 0x65, 0x28, 0xfc, 0xaa, 0x09, 0x9b, 0xd6, 0xf8,
-	0x2c, 0x26, 0x84, 0x7d, 0x91, 0x34, 0x96, 0xc0, 0xeb, 0xf9, 0x0a, 0xcd, 0xd0, 0xfb, 0x22, 0x73,
-	0x0e, 0xf2, 0x1e, 0x82, 0x4a, 0xe2, 0xcd, 0x1e, 0xbf, 0x9c, 0xb3, 0x1e, 0x29, 0xe3, 0xba, 0x34,
-	0x9a, 0x32, 0x0f, 0xec, 0xa7, 0x08, 0xe6, 0x54, 0xd7, 0x74, 0xfc, 0x52, 0x16, 0x5c, 0xf5, 0xe8,
-	0x41, 0xbb, 0x30, 0xb4, 0x1e, 0x1f, 0x6b, 0x94, 0x1e, 0x14, 0x11, 0xfe, 0x05, 0x82, 0x05, 0xf5,
-	0x4d, 0x0c, 0xa7, 0xb5, 0xff, 0xd4, 0x7b, 0xb4, 0x76, 0x71, 0x04, 0x4d, 0x31, 0x28, 0x07, 0xa6,
-	0xa5, 0xfb, 0x44, 0xea, 0xf1, 0x41, 0x75, 0xd5, 0x49, 0x3d, 0x3e, 0xa8, 0xaf, 0x2a, 0x77, 0x61,
-	0xa6, 0xef, 0xa0, 0x8f, 0xcf, 0x65, 0x2e, 0xf4, 0x80, 0xdf, 0xd5, 0x61, 0x54, 0x62, 0xcf, 0x7d,
-	0xa7, 0xf0, 0x54, 0xcf, 0xea, 0x4b, 0x42, 0xaa, 0xe7, 0x84, 0x43, 0x7e, 0xe3, 0x8d, 0x8f, 0x1e,
-	0x55, 0xd1, 0xc7, 0x8f, 0xaa, 0xe8, 0xd3, 0x47, 0x55, 0xf4, 0xee, 0xe3, 0x6a, 0xe1, 0xe3, 0xc7,
-	0xd5, 0xc2, 0xdf, 0x1e, 0x57, 0x0b, 0x50, 0xb1, 0x58, 0x82, 0xbd, 0xeb, 0xe8, 0xfb, 0xe7, 0x77,
-	0x2c, 0xef, 0xd6, 0xfe, 0xcd, 0x5a, 0x8b, 0xed, 0xd5, 0x63, 0xa1, 0xb3, 0x16, 0x13, 0x9e, 0xea,
-	0x77, 0xe3, 0x3f, 0x33, 0xf4, 0xee, 0xb5, 0xa9, 0x7b, 0xf3, 0x68, 0xf0, 0xc7, 0x85, 0x2f, 0xfe,
-	0x27, 0x00, 0x00, 0xff, 0xff, 0x30, 0x5d, 0x0c, 0x32, 0x74, 0x29, 0x00, 0x00,
+func (m *MsgWriteP8EContractSpecRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// WriteScope adds or updates a scope.
-	WriteScope(ctx context.Context, in *MsgWriteScopeRequest, opts ...grpc.CallOption) (*MsgWriteScopeResponse, error)
-	// DeleteScope deletes a scope and all associated Records, Sessions.
-	DeleteScope(ctx context.Context, in *MsgDeleteScopeRequest, opts ...grpc.CallOption) (*MsgDeleteScopeResponse, error)
-	// AddScopeDataAccess adds data access AccAddress to scope
-	AddScopeDataAccess(ctx context.Context, in *MsgAddScopeDataAccessRequest, opts ...grpc.CallOption) (*MsgAddScopeDataAccessResponse, error)
-	// DeleteScopeDataAccess removes data access AccAddress from scope
-	DeleteScopeDataAccess(ctx context.Context, in *MsgDeleteScopeDataAccessRequest, opts ...grpc.CallOption) (*MsgDeleteScopeDataAccessResponse, error)
-	// AddScopeOwner adds new owner AccAddress to scope
-	AddScopeOwner(ctx context.Context, in *MsgAddScopeOwnerRequest, opts ...grpc.CallOption) (*MsgAddScopeOwnerResponse, error)
-	// DeleteScopeOwner removes data access AccAddress from scope
-	DeleteScopeOwner(ctx context.Context, in *MsgDeleteScopeOwnerRequest, opts ...grpc.CallOption) (*MsgDeleteScopeOwnerResponse, error)
-	// WriteSession adds or updates a session context.
-	WriteSession(ctx context.Context, in *MsgWriteSessionRequest, opts ...grpc.CallOption) (*MsgWriteSessionResponse, error)
-	// WriteRecord adds or updates a record.
-	WriteRecord(ctx context.Context, in *MsgWriteRecordRequest, opts ...grpc.CallOption) (*MsgWriteRecordResponse, error)
-	// DeleteRecord deletes a record.
-	DeleteRecord(ctx context.Context, in *MsgDeleteRecordRequest, opts ...grpc.CallOption) (*MsgDeleteRecordResponse, error)
-	// WriteScopeSpecification adds or updates a scope specification.
-	WriteScopeSpecification(ctx context.Context, in *MsgWriteScopeSpecificationRequest, opts ...grpc.CallOption) (*MsgWriteScopeSpecificationResponse, error)
-	// DeleteScopeSpecification deletes a scope specification.
-	DeleteScopeSpecification(ctx context.Context, in *MsgDeleteScopeSpecificationRequest, opts ...grpc.CallOption) (*MsgDeleteScopeSpecificationResponse, error)
-	// WriteContractSpecification adds or updates a contract specification.
-	WriteContractSpecification(ctx context.Context, in *MsgWriteContractSpecificationRequest, opts ...grpc.CallOption) (*MsgWriteContractSpecificationResponse, error)
-	// DeleteContractSpecification deletes a contract specification.
-	DeleteContractSpecification(ctx context.Context, in *MsgDeleteContractSpecificationRequest, opts ...grpc.CallOption) (*MsgDeleteContractSpecificationResponse, error)
-	// AddContractSpecToScopeSpec adds contract specification to a scope specification.
-	AddContractSpecToScopeSpec(ctx context.Context, in *MsgAddContractSpecToScopeSpecRequest, opts ...grpc.CallOption) (*MsgAddContractSpecToScopeSpecResponse, error)
-	// DeleteContractSpecFromScopeSpec deletes a contract specification from a scope specification.
-	DeleteContractSpecFromScopeSpec(ctx context.Context, in *MsgDeleteContractSpecFromScopeSpecRequest, opts ...grpc.CallOption) (*MsgDeleteContractSpecFromScopeSpecResponse, error)
-	// WriteRecordSpecification adds or updates a record specification.
-	WriteRecordSpecification(ctx context.Context, in *MsgWriteRecordSpecificationRequest, opts ...grpc.CallOption) (*MsgWriteRecordSpecificationResponse, error)
-	// DeleteRecordSpecification deletes a record specification.
-	DeleteRecordSpecification(ctx context.Context, in *MsgDeleteRecordSpecificationRequest, opts ...grpc.CallOption) (*MsgDeleteRecordSpecificationResponse, error)
-	// WriteP8eContractSpec adds a P8e v39 contract spec as a v40 ContractSpecification
-	// It only exists to help facilitate the transition. Users should transition to WriteContractSpecification.
-	WriteP8EContractSpec(ctx context.Context, in *MsgWriteP8EContractSpecRequest, opts ...grpc.CallOption) (*MsgWriteP8EContractSpecResponse, error)
-	// P8EMemorializeContract records the results of a P8e contract execution as a session and set of records in a scope
-	// It only exists to help facilitate the transition. Users should transition to calling the individual Write methods.
-	P8EMemorializeContract(ctx context.Context, in *MsgP8EMemorializeContractRequest, opts ...grpc.CallOption) (*MsgP8EMemorializeContractResponse, error)
-	// BindOSLocator binds an owner address to a uri.
-	BindOSLocator(ctx context.Context, in *MsgBindOSLocatorRequest, opts ...grpc.CallOption) (*MsgBindOSLocatorResponse, error)
-	// DeleteOSLocator deletes an existing ObjectStoreLocator record.
-	DeleteOSLocator(ctx context.Context, in *MsgDeleteOSLocatorRequest, opts ...grpc.CallOption) (*MsgDeleteOSLocatorResponse, error)
-	// ModifyOSLocator updates an ObjectStoreLocator record by the current owner.
-	ModifyOSLocator(ctx context.Context, in *MsgModifyOSLocatorRequest, opts ...grpc.CallOption) (*MsgModifyOSLocatorResponse, error)
+func (m *MsgWriteP8EContractSpecRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWriteP8EContractSpecRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgWriteP8EContractSpecRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWriteP8EContractSpecRequest.Merge(m, src)
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgWriteP8EContractSpecRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *msgClient) WriteScope(ctx context.Context, in *MsgWriteScopeRequest, opts ...grpc.CallOption) (*MsgWriteScopeResponse, error) {
-	out := new(MsgWriteScopeResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/WriteScope", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgWriteP8EContractSpecRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWriteP8EContractSpecRequest.DiscardUnknown(m)
 }
 
-func (c *msgClient) DeleteScope(ctx context.Context, in *MsgDeleteScopeRequest, opts ...grpc.CallOption) (*MsgDeleteScopeResponse, error) {
-	out := new(MsgDeleteScopeResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/DeleteScope", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
+var xxx_messageInfo_MsgWriteP8EContractSpecRequest proto.InternalMessageInfo
 
-func (c *msgClient) AddScopeDataAccess(ctx context.Context, in *MsgAddScopeDataAccessRequest, opts ...grpc.CallOption) (*MsgAddScopeDataAccessResponse, error) {
-	out := new(MsgAddScopeDataAccessResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/AddScopeDataAccess", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgWriteP8eContractSpecResponse is the response type for the Msg/WriteP8eContractSpec RPC method.
+type MsgWriteP8EContractSpecResponse struct {
+	// contract_spec_id_info contains information about the id/address of the contract specification that was added or
+	// updated.
+	ContractSpecIdInfo *ContractSpecIdInfo `protobuf:"bytes,1,opt,name=contract_spec_id_info,json=contractSpecIdInfo,proto3" json:"contract_spec_id_info,omitempty" yaml:"contract_spec_id_info"`
+	// record_spec_id_infos contains information about the ids/addresses of the record specifications that were added or
+	// updated.
+	RecordSpecIdInfos []*RecordSpecIdInfo `protobuf:"bytes,2,rep,name=record_spec_id_infos,json=recordSpecIdInfos,proto3" json:"record_spec_id_infos,omitempty" yaml:"record_spec_id_infos"`
 }
 
-func (c *msgClient) DeleteScopeDataAccess(ctx context.Context, in *MsgDeleteScopeDataAccessRequest, opts ...grpc.CallOption) (*MsgDeleteScopeDataAccessResponse, error) {
-	out := new(MsgDeleteScopeDataAccessResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/DeleteScopeDataAccess", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgWriteP8EContractSpecResponse) Reset()         { *m = MsgWriteP8EContractSpecResponse{} }
+func (m *MsgWriteP8EContractSpecResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWriteP8EContractSpecResponse) ProtoMessage()    {}
+func (*MsgWriteP8EContractSpecResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{49}
 }
-
-func (c *msgClient) AddScopeOwner(ctx context.Context, in *MsgAddScopeOwnerRequest, opts ...grpc.CallOption) (*MsgAddScopeOwnerResponse, error) {
-	out := new(MsgAddScopeOwnerResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/AddScopeOwner", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgWriteP8EContractSpecResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *msgClient) DeleteScopeOwner(ctx context.Context, in *MsgDeleteScopeOwnerRequest, opts ...grpc.CallOption) (*MsgDeleteScopeOwnerResponse, error) {
-	out := new(MsgDeleteScopeOwnerResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/DeleteScopeOwner", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgWriteP8EContractSpecResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWriteP8EContractSpecResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *msgClient) WriteSession(ctx context.Context, in *MsgWriteSessionRequest, opts ...grpc.CallOption) (*MsgWriteSessionResponse, error) {
-	out := new(MsgWriteSessionResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/WriteSession", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgWriteP8EContractSpecResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWriteP8EContractSpecResponse.Merge(m, src)
 }
-
-func (c *msgClient) WriteRecord(ctx context.Context, in *MsgWriteRecordRequest, opts ...grpc.CallOption) (*MsgWriteRecordResponse, error) {
-	out := new(MsgWriteRecordResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/WriteRecord", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgWriteP8EContractSpecResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *msgClient) DeleteRecord(ctx context.Context, in *MsgDeleteRecordRequest, opts ...grpc.CallOption) (*MsgDeleteRecordResponse, error) {
-	out := new(MsgDeleteRecordResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/DeleteRecord", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgWriteP8EContractSpecResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWriteP8EContractSpecResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) WriteScopeSpecification(ctx context.Context, in *MsgWriteScopeSpecificationRequest, opts ...grpc.CallOption) (*MsgWriteScopeSpecificationResponse, error) {
-	out := new(MsgWriteScopeSpecificationResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/WriteScopeSpecification", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
+var xxx_messageInfo_MsgWriteP8EContractSpecResponse proto.InternalMessageInfo
 
-func (c *msgClient) DeleteScopeSpecification(ctx context.Context, in *MsgDeleteScopeSpecificationRequest, opts ...grpc.CallOption) (*MsgDeleteScopeSpecificationResponse, error) {
-	out := new(MsgDeleteScopeSpecificationResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/DeleteScopeSpecification", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgWriteP8EContractSpecResponse) GetContractSpecIdInfo() *ContractSpecIdInfo {
+	if m != nil {
+		return m.ContractSpecIdInfo
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) WriteContractSpecification(ctx context.Context, in *MsgWriteContractSpecificationRequest, opts ...grpc.CallOption) (*MsgWriteContractSpecificationResponse, error) {
-	out := new(MsgWriteContractSpecificationResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/WriteContractSpecification", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgWriteP8EContractSpecResponse) GetRecordSpecIdInfos() []*RecordSpecIdInfo {
+	if m != nil {
+		return m.RecordSpecIdInfos
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) DeleteContractSpecification(ctx context.Context, in *MsgDeleteContractSpecificationRequest, opts ...grpc.CallOption) (*MsgDeleteContractSpecificationResponse, error) {
-	out := new(MsgDeleteContractSpecificationResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/DeleteContractSpecification", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgP8eMemorializeContractRequest is the request type for the Msg/P8eMemorializeContract RPC method.
+type MsgP8EMemorializeContractRequest struct {
+	// The scope id of the object being add or modified on blockchain.
+	ScopeId string `protobuf:"bytes,1,opt,name=scope_id,json=scopeId,proto3" json:"scope_id,omitempty"`
+	// The uuid of the contract execution.
+	GroupId string `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// The scope specification id.
+	ScopeSpecificationId string `protobuf:"bytes,3,opt,name=scope_specification_id,json=scopeSpecificationId,proto3" json:"scope_specification_id,omitempty"`
+	// The new recitals for the scope.  Used in leu of Contract for direct ownership changes.
+	Recitals *p8e.Recitals `protobuf:"bytes,4,opt,name=recitals,proto3" json:"recitals,omitempty"`
+	// The executed contract.
+	Contract *p8e.Contract `protobuf:"bytes,5,opt,name=contract,proto3" json:"contract,omitempty"`
+	// The contract signatures
+	Signatures *p8e.SignatureSet `protobuf:"bytes,6,opt,name=signatures,proto3" json:"signatures,omitempty"`
+	// The bech32 address of the notary (ie the broadcaster of this message).
+	Invoker string `protobuf:"bytes,7,opt,name=invoker,proto3" json:"invoker,omitempty"`
 }
 
-func (c *msgClient) AddContractSpecToScopeSpec(ctx context.Context, in *MsgAddContractSpecToScopeSpecRequest, opts ...grpc.CallOption) (*MsgAddContractSpecToScopeSpecResponse, error) {
-	out := new(MsgAddContractSpecToScopeSpecResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/AddContractSpecToScopeSpec", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgP8EMemorializeContractRequest) Reset()      { *m = MsgP8EMemorializeContractRequest{} }
+func (*MsgP8EMemorializeContractRequest) ProtoMessage() {}
+func (*MsgP8EMemorializeContractRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{50}
 }
-
-func (c *msgClient) DeleteContractSpecFromScopeSpec(ctx context.Context, in *MsgDeleteContractSpecFromScopeSpecRequest, opts ...grpc.CallOption) (*MsgDeleteContractSpecFromScopeSpecResponse, error) {
-	out := new(MsgDeleteContractSpecFromScopeSpecResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/DeleteContractSpecFromScopeSpec", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgP8EMemorializeContractRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgP8EMemorializeContractRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgP8EMemorializeContractRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *msgClient) WriteRecordSpecification(ctx context.Context, in *MsgWriteRecordSpecificationRequest, opts ...grpc.CallOption) (*MsgWriteRecordSpecificationResponse, error) {
-	out := new(MsgWriteRecordSpecificationResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/WriteRecordSpecification", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgP8EMemorializeContractRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgP8EMemorializeContractRequest.Merge(m, src)
 }
-
-func (c *msgClient) DeleteRecordSpecification(ctx context.Context, in *MsgDeleteRecordSpecificationRequest, opts ...grpc.CallOption) (*MsgDeleteRecordSpecificationResponse, error) {
-	out := new(MsgDeleteRecordSpecificationResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/DeleteRecordSpecification", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgP8EMemorializeContractRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgP8EMemorializeContractRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgP8EMemorializeContractRequest.DiscardUnknown(m)
 }
 
-// Deprecated: Do not use.
-func (c *msgClient) WriteP8EContractSpec(ctx context.Context, in *MsgWriteP8EContractSpecRequest, opts ...grpc.CallOption) (*MsgWriteP8EContractSpecResponse, error) {
-	out := new(MsgWriteP8EContractSpecResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/WriteP8eContractSpec", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgP8EMemorializeContractRequest proto.InternalMessageInfo
+
+// MsgP8eMemorializeContractResponse is the response type for the Msg/P8eMemorializeContract RPC method.
+type MsgP8EMemorializeContractResponse struct {
+	// scope_id_info contains information about the id/address of the scope that was added or updated.
+	ScopeIdInfo *ScopeIdInfo `protobuf:"bytes,1,opt,name=scope_id_info,json=scopeIdInfo,proto3" json:"scope_id_info,omitempty" yaml:"scope_id_info"`
+	// session_id_info contains information about the id/address of the session that was added or updated.
+	SessionIdInfo *SessionIdInfo `protobuf:"bytes,2,opt,name=session_id_info,json=sessionIdInfo,proto3" json:"session_id_info,omitempty" yaml:"session_id_info"`
+	// record_id_infos contains information about the ids/addresses of the records that were added or updated.
+	RecordIdInfos []*RecordIdInfo `protobuf:"bytes,3,rep,name=record_id_infos,json=recordIdInfos,proto3" json:"record_id_infos,omitempty" yaml:"record_id_infos"`
 }
 
-// Deprecated: Do not use.
-func (c *msgClient) P8EMemorializeContract(ctx context.Context, in *MsgP8EMemorializeContractRequest, opts ...grpc.CallOption) (*MsgP8EMemorializeContractResponse, error) {
-	out := new(MsgP8EMemorializeContractResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/P8eMemorializeContract", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgP8EMemorializeContractResponse) Reset()         { *m = MsgP8EMemorializeContractResponse{} }
+func (m *MsgP8EMemorializeContractResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgP8EMemorializeContractResponse) ProtoMessage()    {}
+func (*MsgP8EMemorializeContractResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{51}
+}
+func (m *MsgP8EMemorializeContractResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgP8EMemorializeContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgP8EMemorializeContractResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgP8EMemorializeContractResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgP8EMemorializeContractResponse.Merge(m, src)
+}
+func (m *MsgP8EMemorializeContractResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgP8EMemorializeContractResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgP8EMemorializeContractResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) BindOSLocator(ctx context.Context, in *MsgBindOSLocatorRequest, opts ...grpc.CallOption) (*MsgBindOSLocatorResponse, error) {
-	out := new(MsgBindOSLocatorResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/BindOSLocator", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgP8EMemorializeContractResponse proto.InternalMessageInfo
+
+func (m *MsgP8EMemorializeContractResponse) GetScopeIdInfo() *ScopeIdInfo {
+	if m != nil {
+		return m.ScopeIdInfo
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) DeleteOSLocator(ctx context.Context, in *MsgDeleteOSLocatorRequest, opts ...grpc.CallOption) (*MsgDeleteOSLocatorResponse, error) {
-	out := new(MsgDeleteOSLocatorResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/DeleteOSLocator", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgP8EMemorializeContractResponse) GetSessionIdInfo() *SessionIdInfo {
+	if m != nil {
+		return m.SessionIdInfo
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) ModifyOSLocator(ctx context.Context, in *MsgModifyOSLocatorRequest, opts ...grpc.CallOption) (*MsgModifyOSLocatorResponse, error) {
-	out := new(MsgModifyOSLocatorResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/ModifyOSLocator", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgP8EMemorializeContractResponse) GetRecordIdInfos() []*RecordIdInfo {
+	if m != nil {
+		return m.RecordIdInfos
 	}
-	return out, nil
+	return nil
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// WriteScope adds or updates a scope.
-	WriteScope(context.Context, *MsgWriteScopeRequest) (*MsgWriteScopeResponse, error)
-	// DeleteScope deletes a scope and all associated Records, Sessions.
-	DeleteScope(context.Context, *MsgDeleteScopeRequest) (*MsgDeleteScopeResponse, error)
-	// AddScopeDataAccess adds data access AccAddress to scope
-	AddScopeDataAccess(context.Context, *MsgAddScopeDataAccessRequest) (*MsgAddScopeDataAccessResponse, error)
-	// DeleteScopeDataAccess removes data access AccAddress from scope
-	DeleteScopeDataAccess(context.Context, *MsgDeleteScopeDataAccessRequest) (*MsgDeleteScopeDataAccessResponse, error)
-	// AddScopeOwner adds new owner AccAddress to scope
-	AddScopeOwner(context.Context, *MsgAddScopeOwnerRequest) (*MsgAddScopeOwnerResponse, error)
-	// DeleteScopeOwner removes data access AccAddress from scope
-	DeleteScopeOwner(context.Context, *MsgDeleteScopeOwnerRequest) (*MsgDeleteScopeOwnerResponse, error)
-	// WriteSession adds or updates a session context.
-	WriteSession(context.Context, *MsgWriteSessionRequest) (*MsgWriteSessionResponse, error)
-	// WriteRecord adds or updates a record.
-	WriteRecord(context.Context, *MsgWriteRecordRequest) (*MsgWriteRecordResponse, error)
-	// DeleteRecord deletes a record.
-	DeleteRecord(context.Context, *MsgDeleteRecordRequest) (*MsgDeleteRecordResponse, error)
-	// WriteScopeSpecification adds or updates a scope specification.
-	WriteScopeSpecification(context.Context, *MsgWriteScopeSpecificationRequest) (*MsgWriteScopeSpecificationResponse, error)
-	// DeleteScopeSpecification deletes a scope specification.
-	DeleteScopeSpecification(context.Context, *MsgDeleteScopeSpecificationRequest) (*MsgDeleteScopeSpecificationResponse, error)
-	// WriteContractSpecification adds or updates a contract specification.
-	WriteContractSpecification(context.Context, *MsgWriteContractSpecificationRequest) (*MsgWriteContractSpecificationResponse, error)
-	// DeleteContractSpecification deletes a contract specification.
-	DeleteContractSpecification(context.Context, *MsgDeleteContractSpecificationRequest) (*MsgDeleteContractSpecificationResponse, error)
-	// AddContractSpecToScopeSpec adds contract specification to a scope specification.
-	AddContractSpecToScopeSpec(context.Context, *MsgAddContractSpecToScopeSpecRequest) (*MsgAddContractSpecToScopeSpecResponse, error)
-	// DeleteContractSpecFromScopeSpec deletes a contract specification from a scope specification.
-	DeleteContractSpecFromScopeSpec(context.Context, *MsgDeleteContractSpecFromScopeSpecRequest) (*MsgDeleteContractSpecFromScopeSpecResponse, error)
-	// WriteRecordSpecification adds or updates a record specification.
-	WriteRecordSpecification(context.Context, *MsgWriteRecordSpecificationRequest) (*MsgWriteRecordSpecificationResponse, error)
-	// DeleteRecordSpecification deletes a record specification.
-	DeleteRecordSpecification(context.Context, *MsgDeleteRecordSpecificationRequest) (*MsgDeleteRecordSpecificationResponse, error)
-	// WriteP8eContractSpec adds a P8e v39 contract spec as a v40 ContractSpecification
-	// It only exists to help facilitate the transition. Users should transition to WriteContractSpecification.
-	WriteP8EContractSpec(context.Context, *MsgWriteP8EContractSpecRequest) (*MsgWriteP8EContractSpecResponse, error)
-	// P8EMemorializeContract records the results of a P8e contract execution as a session and set of records in a scope
-	// It only exists to help facilitate the transition. Users should transition to calling the individual Write methods.
-	P8EMemorializeContract(context.Context, *MsgP8EMemorializeContractRequest) (*MsgP8EMemorializeContractResponse, error)
-	// BindOSLocator binds an owner address to a uri.
-	BindOSLocator(context.Context, *MsgBindOSLocatorRequest) (*MsgBindOSLocatorResponse, error)
-	// DeleteOSLocator deletes an existing ObjectStoreLocator record.
-	DeleteOSLocator(context.Context, *MsgDeleteOSLocatorRequest) (*MsgDeleteOSLocatorResponse, error)
-	// ModifyOSLocator updates an ObjectStoreLocator record by the current owner.
-	ModifyOSLocator(context.Context, *MsgModifyOSLocatorRequest) (*MsgModifyOSLocatorResponse, error)
+// MsgBindOSLocatorRequest is the request type for the Msg/BindOSLocator RPC method.
+type MsgBindOSLocatorRequest struct {
+	// The object locator to bind the address to bind to the URI.
+	Locator ObjectStoreLocator `protobuf:"bytes,1,opt,name=locator,proto3" json:"locator"`
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgBindOSLocatorRequest) Reset()         { *m = MsgBindOSLocatorRequest{} }
+func (m *MsgBindOSLocatorRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgBindOSLocatorRequest) ProtoMessage()    {}
+func (*MsgBindOSLocatorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{52}
 }
-
-func (*UnimplementedMsgServer) WriteScope(ctx context.Context, req *MsgWriteScopeRequest) (*MsgWriteScopeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WriteScope not implemented")
+func (m *MsgBindOSLocatorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) DeleteScope(ctx context.Context, req *MsgDeleteScopeRequest) (*MsgDeleteScopeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteScope not implemented")
+func (m *MsgBindOSLocatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBindOSLocatorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) AddScopeDataAccess(ctx context.Context, req *MsgAddScopeDataAccessRequest) (*MsgAddScopeDataAccessResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddScopeDataAccess not implemented")
+func (m *MsgBindOSLocatorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBindOSLocatorRequest.Merge(m, src)
 }
-func (*UnimplementedMsgServer) DeleteScopeDataAccess(ctx context.Context, req *MsgDeleteScopeDataAccessRequest) (*MsgDeleteScopeDataAccessResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteScopeDataAccess not implemented")
+func (m *MsgBindOSLocatorRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) AddScopeOwner(ctx context.Context, req *MsgAddScopeOwnerRequest) (*MsgAddScopeOwnerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddScopeOwner not implemented")
+func (m *MsgBindOSLocatorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBindOSLocatorRequest.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) DeleteScopeOwner(ctx context.Context, req *MsgDeleteScopeOwnerRequest) (*MsgDeleteScopeOwnerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteScopeOwner not implemented")
+
+var xxx_messageInfo_MsgBindOSLocatorRequest proto.InternalMessageInfo
+
+// MsgBindOSLocatorResponse is the response type for the Msg/BindOSLocator RPC method.
+type MsgBindOSLocatorResponse struct {
+	Locator ObjectStoreLocator `protobuf:"bytes,1,opt,name=locator,proto3" json:"locator"`
 }
-func (*UnimplementedMsgServer) WriteSession(ctx context.Context, req *MsgWriteSessionRequest) (*MsgWriteSessionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WriteSession not implemented")
+
+func (m *MsgBindOSLocatorResponse) Reset()         { *m = MsgBindOSLocatorResponse{} }
+func (m *MsgBindOSLocatorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBindOSLocatorResponse) ProtoMessage()    {}
+func (*MsgBindOSLocatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{53}
 }
-func (*UnimplementedMsgServer) WriteRecord(ctx context.Context, req *MsgWriteRecordRequest) (*MsgWriteRecordResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WriteRecord not implemented")
+func (m *MsgBindOSLocatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) DeleteRecord(ctx context.Context, req *MsgDeleteRecordRequest) (*MsgDeleteRecordResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteRecord not implemented")
+func (m *MsgBindOSLocatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBindOSLocatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) WriteScopeSpecification(ctx context.Context, req *MsgWriteScopeSpecificationRequest) (*MsgWriteScopeSpecificationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WriteScopeSpecification not implemented")
+func (m *MsgBindOSLocatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBindOSLocatorResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) DeleteScopeSpecification(ctx context.Context, req *MsgDeleteScopeSpecificationRequest) (*MsgDeleteScopeSpecificationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteScopeSpecification not implemented")
+func (m *MsgBindOSLocatorResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) WriteContractSpecification(ctx context.Context, req *MsgWriteContractSpecificationRequest) (*MsgWriteContractSpecificationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WriteContractSpecification not implemented")
+func (m *MsgBindOSLocatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBindOSLocatorResponse.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) DeleteContractSpecification(ctx context.Context, req *MsgDeleteContractSpecificationRequest) (*MsgDeleteContractSpecificationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteContractSpecification not implemented")
+
+var xxx_messageInfo_MsgBindOSLocatorResponse proto.InternalMessageInfo
+
+func (m *MsgBindOSLocatorResponse) GetLocator() ObjectStoreLocator {
+	if m != nil {
+		return m.Locator
+	}
+	return ObjectStoreLocator{}
 }
-func (*UnimplementedMsgServer) AddContractSpecToScopeSpec(ctx context.Context, req *MsgAddContractSpecToScopeSpecRequest) (*MsgAddContractSpecToScopeSpecResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddContractSpecToScopeSpec not implemented")
+
+// MsgDeleteOSLocatorRequest is the request type for the Msg/DeleteOSLocator RPC method.
+type MsgDeleteOSLocatorRequest struct {
+	// The record being removed
+	Locator ObjectStoreLocator `protobuf:"bytes,1,opt,name=locator,proto3" json:"locator"`
 }
-func (*UnimplementedMsgServer) DeleteContractSpecFromScopeSpec(ctx context.Context, req *MsgDeleteContractSpecFromScopeSpecRequest) (*MsgDeleteContractSpecFromScopeSpecResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteContractSpecFromScopeSpec not implemented")
-}
-func (*UnimplementedMsgServer) WriteRecordSpecification(ctx context.Context, req *MsgWriteRecordSpecificationRequest) (*MsgWriteRecordSpecificationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WriteRecordSpecification not implemented")
-}
-func (*UnimplementedMsgServer) DeleteRecordSpecification(ctx context.Context, req *MsgDeleteRecordSpecificationRequest) (*MsgDeleteRecordSpecificationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteRecordSpecification not implemented")
+
+func (m *MsgDeleteOSLocatorRequest) Reset()         { *m = MsgDeleteOSLocatorRequest{} }
+func (m *MsgDeleteOSLocatorRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteOSLocatorRequest) ProtoMessage()    {}
+func (*MsgDeleteOSLocatorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{54}
 }
-func (*UnimplementedMsgServer) WriteP8EContractSpec(ctx context.Context, req *MsgWriteP8EContractSpecRequest) (*MsgWriteP8EContractSpecResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WriteP8EContractSpec not implemented")
+func (m *MsgDeleteOSLocatorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) P8EMemorializeContract(ctx context.Context, req *MsgP8EMemorializeContractRequest) (*MsgP8EMemorializeContractResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method P8EMemorializeContract not implemented")
+func (m *MsgDeleteOSLocatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeleteOSLocatorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) BindOSLocator(ctx context.Context, req *MsgBindOSLocatorRequest) (*MsgBindOSLocatorResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BindOSLocator not implemented")
+func (m *MsgDeleteOSLocatorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteOSLocatorRequest.Merge(m, src)
 }
-func (*UnimplementedMsgServer) DeleteOSLocator(ctx context.Context, req *MsgDeleteOSLocatorRequest) (*MsgDeleteOSLocatorResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteOSLocator not implemented")
+func (m *MsgDeleteOSLocatorRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) ModifyOSLocator(ctx context.Context, req *MsgModifyOSLocatorRequest) (*MsgModifyOSLocatorResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ModifyOSLocator not implemented")
+func (m *MsgDeleteOSLocatorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteOSLocatorRequest.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
-}
+var xxx_messageInfo_MsgDeleteOSLocatorRequest proto.InternalMessageInfo
 
-func _Msg_WriteScope_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgWriteScopeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).WriteScope(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/WriteScope",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).WriteScope(ctx, req.(*MsgWriteScopeRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgDeleteOSLocatorResponse is the response type for the Msg/DeleteOSLocator RPC method.
+type MsgDeleteOSLocatorResponse struct {
+	Locator ObjectStoreLocator `protobuf:"bytes,1,opt,name=locator,proto3" json:"locator"`
 }
 
-func _Msg_DeleteScope_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeleteScopeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeleteScope(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/DeleteScope",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeleteScope(ctx, req.(*MsgDeleteScopeRequest))
+func (m *MsgDeleteOSLocatorResponse) Reset()         { *m = MsgDeleteOSLocatorResponse{} }
+func (m *MsgDeleteOSLocatorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteOSLocatorResponse) ProtoMessage()    {}
+func (*MsgDeleteOSLocatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{55}
+}
+func (m *MsgDeleteOSLocatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeleteOSLocatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeleteOSLocatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgDeleteOSLocatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteOSLocatorResponse.Merge(m, src)
+}
+func (m *MsgDeleteOSLocatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeleteOSLocatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteOSLocatorResponse.DiscardUnknown(m)
 }
 
-func _Msg_AddScopeDataAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddScopeDataAccessRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddScopeDataAccess(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/AddScopeDataAccess",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddScopeDataAccess(ctx, req.(*MsgAddScopeDataAccessRequest))
+var xxx_messageInfo_MsgDeleteOSLocatorResponse proto.InternalMessageInfo
+
+func (m *MsgDeleteOSLocatorResponse) GetLocator() ObjectStoreLocator {
+	if m != nil {
+		return m.Locator
 	}
-	return interceptor(ctx, in, info, handler)
+	return ObjectStoreLocator{}
 }
 
-func _Msg_DeleteScopeDataAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeleteScopeDataAccessRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeleteScopeDataAccess(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/DeleteScopeDataAccess",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeleteScopeDataAccess(ctx, req.(*MsgDeleteScopeDataAccessRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgModifyOSLocatorRequest is the request type for the Msg/ModifyOSLocator RPC method.
+type MsgModifyOSLocatorRequest struct {
+	// The object locator to bind the address to bind to the URI.
+	Locator ObjectStoreLocator `protobuf:"bytes,1,opt,name=locator,proto3" json:"locator"`
+	// update_fields are the names of the locator fields to change, any of:
+	// locator_uri, encryption_key, priority, state, key_valid_from, key_valid_until.
+	// All other fields keep their current values. If empty, only the locator_uri and encryption_key are changed.
+	UpdateFields []string `protobuf:"bytes,2,rep,name=update_fields,json=updateFields,proto3" json:"update_fields,omitempty" yaml:"update_fields"`
 }
 
-func _Msg_AddScopeOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddScopeOwnerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddScopeOwner(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/AddScopeOwner",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddScopeOwner(ctx, req.(*MsgAddScopeOwnerRequest))
+func (m *MsgModifyOSLocatorRequest) Reset()         { *m = MsgModifyOSLocatorRequest{} }
+func (m *MsgModifyOSLocatorRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgModifyOSLocatorRequest) ProtoMessage()    {}
+func (*MsgModifyOSLocatorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{56}
+}
+func (m *MsgModifyOSLocatorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgModifyOSLocatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgModifyOSLocatorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgModifyOSLocatorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgModifyOSLocatorRequest.Merge(m, src)
+}
+func (m *MsgModifyOSLocatorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgModifyOSLocatorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgModifyOSLocatorRequest.DiscardUnknown(m)
 }
 
-func _Msg_DeleteScopeOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeleteScopeOwnerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeleteScopeOwner(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/DeleteScopeOwner",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeleteScopeOwner(ctx, req.(*MsgDeleteScopeOwnerRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgModifyOSLocatorRequest proto.InternalMessageInfo
+
+// MsgModifyOSLocatorResponse is the response type for the Msg/ModifyOSLocator RPC method.
+type MsgModifyOSLocatorResponse struct {
+	Locator ObjectStoreLocator `protobuf:"bytes,1,opt,name=locator,proto3" json:"locator"`
 }
 
-func _Msg_WriteSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgWriteSessionRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).WriteSession(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/WriteSession",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).WriteSession(ctx, req.(*MsgWriteSessionRequest))
+func (m *MsgModifyOSLocatorResponse) Reset()         { *m = MsgModifyOSLocatorResponse{} }
+func (m *MsgModifyOSLocatorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgModifyOSLocatorResponse) ProtoMessage()    {}
+func (*MsgModifyOSLocatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{57}
+}
+func (m *MsgModifyOSLocatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgModifyOSLocatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgModifyOSLocatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgModifyOSLocatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgModifyOSLocatorResponse.Merge(m, src)
+}
+func (m *MsgModifyOSLocatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgModifyOSLocatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgModifyOSLocatorResponse.DiscardUnknown(m)
 }
 
-func _Msg_WriteRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgWriteRecordRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).WriteRecord(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/WriteRecord",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).WriteRecord(ctx, req.(*MsgWriteRecordRequest))
+var xxx_messageInfo_MsgModifyOSLocatorResponse proto.InternalMessageInfo
+
+func (m *MsgModifyOSLocatorResponse) GetLocator() ObjectStoreLocator {
+	if m != nil {
+		return m.Locator
 	}
-	return interceptor(ctx, in, info, handler)
+	return ObjectStoreLocator{}
 }
 
-func _Msg_DeleteRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeleteRecordRequest)
-	if err := dec(in); err != nil {
+func init() {
+	proto.RegisterType((*MsgWriteScopeRequest)(nil), "provenance.metadata.v1.MsgWriteScopeRequest")
+	proto.RegisterType((*MsgWriteScopeResponse)(nil), "provenance.metadata.v1.MsgWriteScopeResponse")
+	proto.RegisterType((*MsgWriteScopeBundleRequest)(nil), "provenance.metadata.v1.MsgWriteScopeBundleRequest")
+	proto.RegisterType((*ScopeBundle)(nil), "provenance.metadata.v1.ScopeBundle")
+	proto.RegisterType((*MsgWriteScopeBundleResponse)(nil), "provenance.metadata.v1.MsgWriteScopeBundleResponse")
+	proto.RegisterType((*MsgDeleteScopeRequest)(nil), "provenance.metadata.v1.MsgDeleteScopeRequest")
+	proto.RegisterType((*MsgDeleteScopeResponse)(nil), "provenance.metadata.v1.MsgDeleteScopeResponse")
+	proto.RegisterType((*MsgAddScopeDataAccessRequest)(nil), "provenance.metadata.v1.MsgAddScopeDataAccessRequest")
+	proto.RegisterType((*MsgAddScopeDataAccessResponse)(nil), "provenance.metadata.v1.MsgAddScopeDataAccessResponse")
+	proto.RegisterType((*MsgDeleteScopeDataAccessRequest)(nil), "provenance.metadata.v1.MsgDeleteScopeDataAccessRequest")
+	proto.RegisterType((*MsgDeleteScopeDataAccessResponse)(nil), "provenance.metadata.v1.MsgDeleteScopeDataAccessResponse")
+	proto.RegisterType((*MsgAddScopeOwnerRequest)(nil), "provenance.metadata.v1.MsgAddScopeOwnerRequest")
+	proto.RegisterType((*MsgAddScopeOwnerResponse)(nil), "provenance.metadata.v1.MsgAddScopeOwnerResponse")
+	proto.RegisterType((*MsgDeleteScopeOwnerRequest)(nil), "provenance.metadata.v1.MsgDeleteScopeOwnerRequest")
+	proto.RegisterType((*MsgDeleteScopeOwnerResponse)(nil), "provenance.metadata.v1.MsgDeleteScopeOwnerResponse")
+	proto.RegisterType((*MsgTokenizeScopeValueOwnerRequest)(nil), "provenance.metadata.v1.MsgTokenizeScopeValueOwnerRequest")
+	proto.RegisterType((*MsgTokenizeScopeValueOwnerResponse)(nil), "provenance.metadata.v1.MsgTokenizeScopeValueOwnerResponse")
+	proto.RegisterType((*MsgOfferScopeSaleRequest)(nil), "provenance.metadata.v1.MsgOfferScopeSaleRequest")
+	proto.RegisterType((*MsgOfferScopeSaleResponse)(nil), "provenance.metadata.v1.MsgOfferScopeSaleResponse")
+	proto.RegisterType((*MsgCancelScopeSaleRequest)(nil), "provenance.metadata.v1.MsgCancelScopeSaleRequest")
+	proto.RegisterType((*MsgCancelScopeSaleResponse)(nil), "provenance.metadata.v1.MsgCancelScopeSaleResponse")
+	proto.RegisterType((*MsgAcceptScopeSaleRequest)(nil), "provenance.metadata.v1.MsgAcceptScopeSaleRequest")
+	proto.RegisterType((*MsgAcceptScopeSaleResponse)(nil), "provenance.metadata.v1.MsgAcceptScopeSaleResponse")
+	proto.RegisterType((*MsgMigrateScopeSpecificationRequest)(nil), "provenance.metadata.v1.MsgMigrateScopeSpecificationRequest")
+	proto.RegisterType((*MsgMigrateScopeSpecificationResponse)(nil), "provenance.metadata.v1.MsgMigrateScopeSpecificationResponse")
+	proto.RegisterType((*MsgWriteSessionRequest)(nil), "provenance.metadata.v1.MsgWriteSessionRequest")
+	proto.RegisterType((*SessionIdComponents)(nil), "provenance.metadata.v1.SessionIdComponents")
+	proto.RegisterType((*MsgWriteSessionResponse)(nil), "provenance.metadata.v1.MsgWriteSessionResponse")
+	proto.RegisterType((*MsgWriteRecordRequest)(nil), "provenance.metadata.v1.MsgWriteRecordRequest")
+	proto.RegisterType((*MsgWriteRecordResponse)(nil), "provenance.metadata.v1.MsgWriteRecordResponse")
+	proto.RegisterType((*MsgDeleteRecordRequest)(nil), "provenance.metadata.v1.MsgDeleteRecordRequest")
+	proto.RegisterType((*MsgDeleteRecordResponse)(nil), "provenance.metadata.v1.MsgDeleteRecordResponse")
+	proto.RegisterType((*MsgWriteScopeSpecificationRequest)(nil), "provenance.metadata.v1.MsgWriteScopeSpecificationRequest")
+	proto.RegisterType((*MsgWriteScopeSpecificationResponse)(nil), "provenance.metadata.v1.MsgWriteScopeSpecificationResponse")
+	proto.RegisterType((*MsgDeleteScopeSpecificationRequest)(nil), "provenance.metadata.v1.MsgDeleteScopeSpecificationRequest")
+	proto.RegisterType((*MsgDeleteScopeSpecificationResponse)(nil), "provenance.metadata.v1.MsgDeleteScopeSpecificationResponse")
+	proto.RegisterType((*MsgWriteContractSpecificationRequest)(nil), "provenance.metadata.v1.MsgWriteContractSpecificationRequest")
+	proto.RegisterType((*MsgWriteContractSpecificationResponse)(nil), "provenance.metadata.v1.MsgWriteContractSpecificationResponse")
+	proto.RegisterType((*MsgAddContractSpecToScopeSpecRequest)(nil), "provenance.metadata.v1.MsgAddContractSpecToScopeSpecRequest")
+	proto.RegisterType((*MsgAddContractSpecToScopeSpecResponse)(nil), "provenance.metadata.v1.MsgAddContractSpecToScopeSpecResponse")
+	proto.RegisterType((*MsgDeleteContractSpecFromScopeSpecRequest)(nil), "provenance.metadata.v1.MsgDeleteContractSpecFromScopeSpecRequest")
+	proto.RegisterType((*MsgDeleteContractSpecFromScopeSpecResponse)(nil), "provenance.metadata.v1.MsgDeleteContractSpecFromScopeSpecResponse")
+	proto.RegisterType((*MsgDeleteContractSpecificationRequest)(nil), "provenance.metadata.v1.MsgDeleteContractSpecificationRequest")
+	proto.RegisterType((*MsgDeleteContractSpecificationResponse)(nil), "provenance.metadata.v1.MsgDeleteContractSpecificationResponse")
+	proto.RegisterType((*MsgWriteRecordSpecificationRequest)(nil), "provenance.metadata.v1.MsgWriteRecordSpecificationRequest")
+	proto.RegisterType((*MsgWriteRecordSpecificationResponse)(nil), "provenance.metadata.v1.MsgWriteRecordSpecificationResponse")
+	proto.RegisterType((*MsgDeleteRecordSpecificationRequest)(nil), "provenance.metadata.v1.MsgDeleteRecordSpecificationRequest")
+	proto.RegisterType((*MsgDeleteRecordSpecificationResponse)(nil), "provenance.metadata.v1.MsgDeleteRecordSpecificationResponse")
+	proto.RegisterType((*MsgWriteP8EContractSpecRequest)(nil), "provenance.metadata.v1.MsgWriteP8eContractSpecRequest")
+	proto.RegisterType((*MsgWriteP8EContractSpecResponse)(nil), "provenance.metadata.v1.MsgWriteP8eContractSpecResponse")
+	proto.RegisterType((*MsgP8EMemorializeContractRequest)(nil), "provenance.metadata.v1.MsgP8eMemorializeContractRequest")
+	proto.RegisterType((*MsgP8EMemorializeContractResponse)(nil), "provenance.metadata.v1.MsgP8eMemorializeContractResponse")
+	proto.RegisterType((*MsgBindOSLocatorRequest)(nil), "provenance.metadata.v1.MsgBindOSLocatorRequest")
+	proto.RegisterType((*MsgBindOSLocatorResponse)(nil), "provenance.metadata.v1.MsgBindOSLocatorResponse")
+	proto.RegisterType((*MsgDeleteOSLocatorRequest)(nil), "provenance.metadata.v1.MsgDeleteOSLocatorRequest")
+	proto.RegisterType((*MsgDeleteOSLocatorResponse)(nil), "provenance.metadata.v1.MsgDeleteOSLocatorResponse")
+	proto.RegisterType((*MsgModifyOSLocatorRequest)(nil), "provenance.metadata.v1.MsgModifyOSLocatorRequest")
+	proto.RegisterType((*MsgModifyOSLocatorResponse)(nil), "provenance.metadata.v1.MsgModifyOSLocatorResponse")
+}
+
+func init() { proto.RegisterFile("provenance/metadata/v1/tx.proto", fileDescriptor_3a3a0892f91e3036) }
+
+var fileDescriptor_3a3a0892f91e3036 = []byte{
+	// 2675 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5b, 0x5f, 0x6c, 0x1c, 0x47,
+	0x19, 0xf7, 0xde, 0xd9, 0xb1, 0xfd, 0xd9, 0x8e, 0x9d, 0x89, 0xff, 0x9c, 0x37, 0x89, 0xd7, 0xd9,
+	0xfc, 0x73, 0xf3, 0xe7, 0x2e, 0x76, 0x42, 0x93, 0x38, 0x49, 0xdb, 0x5c, 0x42, 0x15, 0x43, 0xad,
+	0x44, 0xeb, 0xd0, 0x08, 0x24, 0x64, 0xad, 0x6f, 0xc7, 0x97, 0x25, 0x77, 0xbb, 0xd7, 0xdd, 0x3d,
+	0x27, 0x0e, 0x48, 0xa5, 0x02, 0x89, 0x08, 0x01, 0x0a, 0x20, 0x21, 0x2a, 0xa1, 0x2a, 0x8f, 0x55,
+	0x85, 0x44, 0xe1, 0x11, 0xf1, 0xc6, 0x4b, 0x5e, 0x90, 0xfa, 0x52, 0x09, 0x15, 0x74, 0xad, 0x12,
+	0x09, 0xf5, 0x0d, 0xe9, 0x1e, 0xe0, 0x15, 0xed, 0xce, 0xec, 0xed, 0xec, 0xdd, 0xec, 0x9f, 0x73,
+	0xec, 0x10, 0x24, 0x1e, 0xa2, 0xdc, 0xee, 0x7e, 0x7f, 0x7f, 0xf3, 0xcd, 0x37, 0xf3, 0x7d, 0x33,
+	0x06, 0xa9, 0x66, 0x99, 0x1b, 0xd8, 0x50, 0x8d, 0x12, 0x2e, 0x54, 0xb1, 0xa3, 0x6a, 0xaa, 0xa3,
+	0x16, 0x36, 0xe6, 0x0b, 0xce, 0xfd, 0x7c, 0xcd, 0x32, 0x1d, 0x13, 0x4d, 0x06, 0x04, 0x79, 0x9f,
+	0x20, 0xbf, 0x31, 0x2f, 0xce, 0x94, 0x4c, 0xbb, 0x6a, 0xda, 0x85, 0x35, 0xd5, 0xc6, 0x85, 0x8d,
+	0xf9, 0x35, 0xec, 0xa8, 0xf3, 0x85, 0x92, 0xa9, 0x1b, 0x84, 0x4f, 0x1c, 0x2f, 0x9b, 0x65, 0xd3,
+	0xfb, 0x59, 0x70, 0x7f, 0xd1, 0xb7, 0x52, 0xd9, 0x34, 0xcb, 0x15, 0x5c, 0xf0, 0x9e, 0xd6, 0xea,
+	0xeb, 0x05, 0x47, 0xaf, 0x62, 0xdb, 0x51, 0xab, 0x35, 0x4a, 0x70, 0x24, 0xc2, 0x9e, 0x96, 0x6a,
+	0x42, 0x36, 0x17, 0x41, 0x66, 0xae, 0x7d, 0x07, 0x97, 0x1c, 0xdb, 0x31, 0x2d, 0x4c, 0x29, 0x0f,
+	0x47, 0x50, 0xd6, 0xce, 0x63, 0xf7, 0x1f, 0xa5, 0x92, 0x23, 0xa8, 0xec, 0x92, 0x59, 0xf3, 0x69,
+	0x8e, 0x47, 0xd1, 0xd4, 0x70, 0x49, 0x5f, 0xd7, 0x4b, 0xaa, 0xa3, 0x9b, 0xd4, 0x7b, 0xf9, 0x1f,
+	0x02, 0x8c, 0x2f, 0xdb, 0xe5, 0xdb, 0x96, 0xee, 0xe0, 0x15, 0x57, 0x86, 0x82, 0xdf, 0xa9, 0x63,
+	0xdb, 0x41, 0x17, 0xa0, 0xcf, 0x93, 0x99, 0x13, 0x66, 0x85, 0xb9, 0xa1, 0x85, 0x03, 0x79, 0x3e,
+	0xbc, 0x79, 0x8f, 0xa9, 0xd8, 0xfb, 0xa4, 0x21, 0xf5, 0x28, 0x84, 0x03, 0xe5, 0xa0, 0xdf, 0xd6,
+	0xcb, 0x06, 0xb6, 0xec, 0x5c, 0x66, 0x36, 0x3b, 0x37, 0xa8, 0xf8, 0x8f, 0xe8, 0x2c, 0x80, 0x47,
+	0xb2, 0x5a, 0xaf, 0xeb, 0x5a, 0x2e, 0x3b, 0x2b, 0xcc, 0x0d, 0x16, 0x27, 0x9a, 0x0d, 0x69, 0xcf,
+	0xa6, 0x5a, 0xad, 0x2c, 0xca, 0xc1, 0x37, 0x59, 0x19, 0xf4, 0x1e, 0xbe, 0x51, 0xd7, 0x35, 0x34,
+	0x0f, 0x83, 0xae, 0xe9, 0x84, 0xa9, 0xd7, 0x63, 0x1a, 0x6f, 0x36, 0xa4, 0x31, 0xca, 0xe4, 0x7f,
+	0x92, 0x95, 0x01, 0xf7, 0xb7, 0xcb, 0xb2, 0x38, 0xf6, 0xf0, 0xb1, 0xd4, 0xf3, 0xeb, 0xc7, 0x52,
+	0xcf, 0x97, 0x8f, 0xa5, 0x9e, 0xef, 0xff, 0x7d, 0xb6, 0x47, 0x7e, 0x00, 0x13, 0x6d, 0x7e, 0xda,
+	0x35, 0xd3, 0xb0, 0x31, 0x52, 0x61, 0x84, 0xe8, 0xd5, 0xb5, 0x55, 0xdd, 0x58, 0x37, 0xa9, 0xc3,
+	0x87, 0x62, 0x1d, 0x5e, 0xd2, 0x96, 0x8c, 0x75, 0xb3, 0x98, 0x6b, 0x36, 0xa4, 0x71, 0xd6, 0x76,
+	0x2a, 0x43, 0x56, 0x86, 0xec, 0x80, 0x4c, 0xfe, 0xa9, 0x00, 0x62, 0x48, 0x79, 0xb1, 0x6e, 0x68,
+	0x95, 0x16, 0xd4, 0x57, 0xa1, 0x7f, 0xcd, 0x7b, 0x61, 0xe7, 0x84, 0xd9, 0x6c, 0xa2, 0x6e, 0xc2,
+	0x4c, 0x21, 0xf7, 0x39, 0xa3, 0x41, 0xe7, 0x60, 0xf1, 0xa9, 0x00, 0x43, 0x8c, 0xa8, 0xe7, 0x19,
+	0xeb, 0x2b, 0x30, 0x60, 0x63, 0xdb, 0xd6, 0x4d, 0x83, 0xe8, 0x1d, 0x5a, 0x90, 0x22, 0xb9, 0x09,
+	0x1d, 0xe5, 0x6f, 0xb1, 0xa1, 0xd7, 0xa0, 0xdf, 0xc2, 0x25, 0xd3, 0xd2, 0xec, 0x5c, 0xd6, 0x93,
+	0x30, 0x13, 0x25, 0x41, 0xf1, 0xc8, 0x7c, 0xcf, 0x29, 0xd3, 0xe2, 0xc0, 0x43, 0xea, 0x9b, 0xfc,
+	0x03, 0x01, 0xf6, 0x71, 0x71, 0xa6, 0x43, 0xad, 0xc1, 0xee, 0xd0, 0x30, 0xa5, 0xc3, 0x9b, 0x8e,
+	0xf5, 0x74, 0xb3, 0x21, 0x4d, 0x70, 0xc6, 0xda, 0x96, 0x95, 0x61, 0x66, 0xb0, 0x6d, 0xf9, 0xc7,
+	0x82, 0x17, 0x6a, 0xd7, 0x70, 0x05, 0xb7, 0xcd, 0xa9, 0xaf, 0xc2, 0x80, 0xcf, 0xea, 0x41, 0x3d,
+	0x5c, 0x3c, 0xee, 0xba, 0xf2, 0x59, 0x43, 0x1a, 0x5d, 0xa6, 0x5a, 0xaf, 0x68, 0x9a, 0x85, 0x6d,
+	0xbb, 0xd9, 0x90, 0x46, 0xc3, 0xba, 0x64, 0xa5, 0x9f, 0x6a, 0xe9, 0x6a, 0xa8, 0x73, 0x30, 0xd9,
+	0x6e, 0x0b, 0x01, 0x43, 0xfe, 0x73, 0x06, 0xf6, 0x2f, 0xdb, 0xe5, 0x2b, 0x9a, 0xe6, 0xbd, 0xbf,
+	0xe6, 0x2a, 0x2f, 0x95, 0xb0, 0x6d, 0x6f, 0xb3, 0xb5, 0xe7, 0x60, 0xc8, 0x25, 0x5d, 0x55, 0x3d,
+	0xe1, 0xc4, 0xe2, 0xe2, 0x64, 0xb3, 0x21, 0x21, 0xc2, 0xc2, 0x7c, 0x94, 0x15, 0xd0, 0x5a, 0x66,
+	0xb0, 0x6e, 0x66, 0xc3, 0x69, 0x04, 0x41, 0xaf, 0x65, 0x56, 0x30, 0xc9, 0x05, 0x8a, 0xf7, 0x1b,
+	0x95, 0x00, 0xf0, 0xfd, 0x9a, 0x6e, 0x79, 0xc9, 0x2d, 0xd7, 0xe7, 0x05, 0xb2, 0x98, 0x27, 0x59,
+	0x3c, 0xef, 0x67, 0xf1, 0xfc, 0x2d, 0x3f, 0x8b, 0x17, 0x8f, 0x3d, 0x69, 0x48, 0x42, 0xb3, 0x21,
+	0xed, 0x23, 0x56, 0x04, 0xbc, 0x27, 0xcd, 0xaa, 0xee, 0xe0, 0x6a, 0xcd, 0xd9, 0x94, 0x1f, 0x7d,
+	0x2e, 0x09, 0x0a, 0x23, 0x96, 0x83, 0xaf, 0x04, 0x07, 0x22, 0x40, 0xa4, 0x30, 0xff, 0x45, 0x00,
+	0x29, 0x3c, 0x02, 0xff, 0x43, 0x48, 0x73, 0x1c, 0x96, 0x61, 0x36, 0xda, 0x1d, 0xea, 0xf3, 0x67,
+	0x02, 0x4c, 0x31, 0xa8, 0xdc, 0xb8, 0x67, 0x60, 0x6b, 0x9b, 0x7d, 0x7d, 0x0b, 0x76, 0x99, 0xf7,
+	0x5a, 0x53, 0x20, 0x26, 0x67, 0xdd, 0x54, 0x2d, 0x67, 0xb3, 0x38, 0xe1, 0xea, 0x68, 0x36, 0xa4,
+	0x11, 0x22, 0x90, 0xb0, 0xca, 0x0a, 0x95, 0xd1, 0x15, 0x00, 0x22, 0xe4, 0x3a, 0x7d, 0xa3, 0x8e,
+	0xff, 0x91, 0x24, 0x7a, 0x06, 0x9d, 0x9d, 0xf0, 0xfd, 0x95, 0x90, 0xef, 0x83, 0xc5, 0x3d, 0xdb,
+	0xe3, 0xd8, 0x01, 0xd8, 0xc7, 0xb5, 0x9d, 0xfa, 0xf6, 0x4f, 0x01, 0x0e, 0x2e, 0xdb, 0xe5, 0x5b,
+	0xe6, 0x5d, 0x6c, 0xe8, 0x0f, 0x08, 0xc5, 0xdb, 0x6a, 0xa5, 0xbe, 0x23, 0x2e, 0xde, 0x86, 0x5d,
+	0x76, 0xbd, 0x56, 0xab, 0x6c, 0xe6, 0x32, 0xde, 0x7a, 0xff, 0x3a, 0x15, 0x72, 0xb4, 0xac, 0x3b,
+	0x77, 0xea, 0x6b, 0xf9, 0x92, 0x59, 0x2d, 0xd0, 0x7d, 0x1d, 0xf9, 0xef, 0x94, 0xad, 0xdd, 0x2d,
+	0x38, 0x9b, 0x35, 0x6c, 0xe7, 0x97, 0x0c, 0x27, 0x00, 0x84, 0x48, 0x91, 0x15, 0x2a, 0xae, 0x2b,
+	0x40, 0xbe, 0x07, 0x72, 0x9c, 0xc3, 0x74, 0x51, 0x19, 0x87, 0x3e, 0x0d, 0x1b, 0x66, 0xd5, 0x73,
+	0x77, 0x50, 0x21, 0x0f, 0xe8, 0x0d, 0xd8, 0x5d, 0x55, 0xad, 0xbb, 0xd8, 0x5a, 0x55, 0x89, 0xbb,
+	0xd4, 0x11, 0x66, 0x15, 0x09, 0x7f, 0x97, 0x95, 0x11, 0xf2, 0x82, 0xc2, 0x23, 0x7f, 0x99, 0xf1,
+	0x02, 0xed, 0xc6, 0xfa, 0x3a, 0xb6, 0x3c, 0xdd, 0x2b, 0x6a, 0x65, 0xbb, 0x57, 0x92, 0xd3, 0xd0,
+	0xb7, 0x56, 0xdf, 0xc4, 0x16, 0x35, 0x4e, 0x6c, 0x36, 0xa4, 0x49, 0x42, 0xec, 0xbd, 0x66, 0x52,
+	0xa1, 0x42, 0x08, 0x91, 0x0a, 0x7d, 0x35, 0x4b, 0x2f, 0x61, 0xba, 0x54, 0x4f, 0xe7, 0x09, 0xfc,
+	0x79, 0x77, 0x77, 0x9d, 0xa7, 0xbb, 0xeb, 0xfc, 0x55, 0x53, 0x37, 0x8a, 0xa7, 0x5d, 0x83, 0x3e,
+	0xfa, 0x5c, 0x9a, 0x4b, 0x31, 0x64, 0x2e, 0x83, 0xad, 0x10, 0xc9, 0xe8, 0x5a, 0x28, 0x93, 0xf7,
+	0x26, 0x66, 0xf2, 0x01, 0x57, 0x51, 0x7b, 0xaa, 0x66, 0x07, 0xba, 0x2f, 0x69, 0xa0, 0xf7, 0xc1,
+	0x34, 0x07, 0x69, 0x1a, 0xf7, 0x3f, 0x13, 0xbc, 0xaf, 0x57, 0x55, 0xa3, 0x84, 0x2b, 0x3b, 0x35,
+	0x10, 0xdd, 0x2c, 0xe9, 0xfb, 0x41, 0xe4, 0xd9, 0x43, 0xcd, 0xfd, 0x51, 0xc6, 0x33, 0xd7, 0xcd,
+	0xc8, 0x35, 0x67, 0xa7, 0xcc, 0x1d, 0x0f, 0xc5, 0xcd, 0x0b, 0x8c, 0x0d, 0x06, 0xa7, 0xde, 0x74,
+	0x38, 0x75, 0x00, 0x41, 0x71, 0xfa, 0xb7, 0x00, 0x87, 0x96, 0xed, 0xf2, 0xb2, 0x5e, 0xb6, 0x54,
+	0x9a, 0xef, 0x56, 0xd8, 0xfa, 0x68, 0x9b, 0x11, 0xfb, 0x36, 0x8c, 0x85, 0xca, 0x2f, 0x57, 0x5c,
+	0xc6, 0x13, 0xb7, 0x10, 0x2d, 0x6e, 0x2a, 0xa8, 0x70, 0x58, 0x46, 0x59, 0x19, 0x0d, 0xbd, 0x0a,
+	0xc7, 0x4f, 0x62, 0x5a, 0x3b, 0x0a, 0x87, 0xe3, 0x1d, 0xa7, 0x08, 0xfd, 0x29, 0x03, 0x93, 0xad,
+	0xdd, 0x34, 0xd9, 0xac, 0xfb, 0xa0, 0xbc, 0x0e, 0xfd, 0x74, 0xfb, 0x4e, 0x4b, 0x86, 0x94, 0x9b,
+	0x7e, 0x9f, 0x2b, 0xa6, 0x44, 0x7c, 0x4f, 0x80, 0x09, 0x4a, 0xe5, 0xee, 0xb0, 0x4b, 0x66, 0xb5,
+	0x66, 0x1a, 0xd8, 0x70, 0x6c, 0xaf, 0x5c, 0x1c, 0x5a, 0x38, 0x91, 0xa0, 0x69, 0x49, 0xbb, 0xda,
+	0x62, 0x29, 0xce, 0x36, 0x1b, 0xd2, 0x7e, 0x0a, 0x22, 0x4f, 0xa6, 0xac, 0xec, 0xb5, 0x3b, 0xd9,
+	0xb6, 0xa7, 0xe0, 0xfc, 0x54, 0x80, 0xbd, 0x1c, 0x9b, 0xd0, 0xab, 0xa1, 0x1a, 0x58, 0x88, 0xa9,
+	0x81, 0xaf, 0xf7, 0xb0, 0x55, 0x70, 0x8b, 0xcf, 0x5d, 0x30, 0x72, 0x19, 0x3e, 0x9f, 0xfb, 0x2d,
+	0xe0, 0x73, 0x23, 0x09, 0x2d, 0xc2, 0xb0, 0xef, 0x3b, 0x53, 0x75, 0x4f, 0x35, 0x1b, 0xd2, 0xde,
+	0x30, 0x32, 0xc4, 0xa5, 0x21, 0xfa, 0xe8, 0xea, 0x2c, 0x22, 0x18, 0xf3, 0x63, 0x19, 0x1b, 0x8e,
+	0xbe, 0xae, 0x63, 0x4b, 0xfe, 0x21, 0xd9, 0xdc, 0x85, 0xc3, 0x82, 0xae, 0x85, 0x3a, 0x8c, 0x32,
+	0x38, 0x33, 0xd5, 0xf4, 0x91, 0xc4, 0x51, 0xf3, 0x6a, 0x2c, 0x66, 0x01, 0x6a, 0x93, 0x23, 0x2b,
+	0x23, 0x36, 0x4b, 0x2a, 0xff, 0x3c, 0x1b, 0x14, 0xf4, 0xa4, 0x2e, 0xf4, 0x83, 0xf3, 0x12, 0xec,
+	0x22, 0xa5, 0x21, 0xd5, 0x9d, 0xae, 0x9c, 0xa4, 0x3c, 0x2f, 0x79, 0x64, 0x7e, 0x1d, 0x50, 0xc9,
+	0x34, 0x1c, 0x4b, 0x2d, 0x39, 0xab, 0xed, 0x21, 0x7a, 0xa0, 0xd9, 0x90, 0xa6, 0x89, 0xc8, 0x4e,
+	0x1a, 0x59, 0x19, 0xf3, 0x5f, 0xae, 0xd0, 0x98, 0x45, 0x97, 0xa1, 0xbf, 0xa6, 0x5a, 0x8e, 0x8e,
+	0xc9, 0x12, 0x99, 0xb8, 0x89, 0xa6, 0x73, 0x98, 0xf2, 0x70, 0x42, 0xfe, 0xdd, 0x20, 0x61, 0xf8,
+	0x43, 0x42, 0x03, 0x03, 0xc3, 0x6e, 0x82, 0x6f, 0x5b, 0x5c, 0x1c, 0x8e, 0x1f, 0x9b, 0xce, 0xd2,
+	0x3b, 0x2c, 0x45, 0x56, 0x86, 0x2d, 0x86, 0xd0, 0x5d, 0xab, 0x83, 0x72, 0x37, 0x1c, 0x15, 0xd7,
+	0x61, 0xb0, 0xc5, 0x4b, 0x13, 0xf9, 0x89, 0xe8, 0xcc, 0x3b, 0xd6, 0xa6, 0x4d, 0x56, 0x06, 0x7c,
+	0x45, 0x5d, 0xad, 0xd5, 0xd3, 0x30, 0xd5, 0x61, 0x4f, 0x50, 0x24, 0x1d, 0x0c, 0x35, 0x2b, 0xb8,
+	0xcb, 0xcf, 0xdb, 0x30, 0x12, 0xca, 0xf5, 0x14, 0xb7, 0xe3, 0xb1, 0x1d, 0x8b, 0x90, 0x24, 0x3a,
+	0x6c, 0x61, 0x31, 0x31, 0x61, 0x1e, 0x4a, 0x7e, 0xd9, 0x2d, 0x26, 0xbf, 0xf7, 0x05, 0x90, 0xe3,
+	0x9c, 0xa3, 0x61, 0x61, 0x03, 0x22, 0xf9, 0xc5, 0x13, 0x1b, 0x0e, 0x8d, 0x63, 0x89, 0x2e, 0xd2,
+	0xe8, 0x60, 0xe2, 0xbe, 0x53, 0x98, 0xbb, 0x56, 0x86, 0xe9, 0xe5, 0xdf, 0x11, 0xdb, 0x98, 0x42,
+	0x87, 0x8b, 0x3c, 0x6f, 0xc5, 0x16, 0x76, 0x64, 0xc5, 0x4e, 0x8c, 0xa2, 0x23, 0x70, 0x28, 0xd6,
+	0x60, 0x1a, 0x51, 0x5f, 0x08, 0x70, 0xd8, 0x07, 0xfd, 0x2a, 0x33, 0xd9, 0x3b, 0x5c, 0xfb, 0x26,
+	0x3f, 0xa8, 0x4e, 0x45, 0x21, 0xce, 0x15, 0xf6, 0x5f, 0x89, 0xab, 0x0f, 0x05, 0x38, 0x92, 0xe0,
+	0x22, 0x0d, 0xad, 0x77, 0x61, 0x22, 0x9c, 0x05, 0xc3, 0xd1, 0x75, 0x3c, 0x8d, 0xaf, 0x34, 0xc0,
+	0x98, 0x5c, 0xcd, 0x15, 0x29, 0x2b, 0xa8, 0xd4, 0xc1, 0x25, 0xff, 0x36, 0xe3, 0x8d, 0xc6, 0x15,
+	0x4d, 0x63, 0x45, 0xde, 0x32, 0x5b, 0x03, 0xe8, 0x8f, 0x86, 0x01, 0xd3, 0x21, 0xb1, 0xdb, 0x14,
+	0x71, 0x53, 0x25, 0x1e, 0x3e, 0x4b, 0x1a, 0xba, 0x03, 0x93, 0xc1, 0x3c, 0xd9, 0xa6, 0x0d, 0xe9,
+	0xb8, 0xdd, 0x11, 0x96, 0x5d, 0xee, 0x4a, 0x8f, 0xc1, 0x91, 0x04, 0xb4, 0x68, 0x94, 0xff, 0x3e,
+	0x03, 0xaf, 0xb4, 0x66, 0x03, 0x4b, 0xfc, 0xa6, 0x65, 0x56, 0xff, 0x0f, 0x2e, 0x17, 0xdc, 0x93,
+	0x70, 0x3c, 0x0d, 0x64, 0x14, 0xe1, 0x3f, 0x90, 0x49, 0xd6, 0x49, 0xfe, 0x32, 0xe7, 0xc8, 0x39,
+	0x38, 0x9a, 0x64, 0x33, 0x75, 0xef, 0x5f, 0xcc, 0xda, 0x44, 0xd6, 0x64, 0xae, 0x6f, 0xb7, 0xf9,
+	0x49, 0xf2, 0x44, 0xfc, 0x8e, 0xe5, 0xb9, 0x52, 0x24, 0x7f, 0x77, 0x97, 0xdd, 0xd2, 0xee, 0x8e,
+	0x03, 0xd1, 0x07, 0xa4, 0xe4, 0x8d, 0x76, 0x9c, 0xa6, 0xce, 0x7b, 0xb0, 0x97, 0x6e, 0x7c, 0x38,
+	0x89, 0x73, 0x2e, 0xd9, 0x7f, 0x9a, 0x36, 0x67, 0x9a, 0x0d, 0x49, 0x0c, 0xed, 0xa3, 0xc2, 0x49,
+	0x73, 0xcc, 0x6a, 0xe3, 0x90, 0x3f, 0x16, 0x98, 0x85, 0x2e, 0x66, 0x68, 0x5e, 0xa2, 0xb0, 0x23,
+	0xc5, 0x74, 0x8c, 0xc5, 0x34, 0xe8, 0x1e, 0x0b, 0x30, 0xe3, 0x63, 0x7f, 0xf3, 0x7c, 0x28, 0x42,
+	0x7d, 0xaf, 0x14, 0x18, 0xf6, 0x07, 0xd1, 0xb5, 0x28, 0x09, 0x6f, 0xf7, 0x4c, 0x98, 0x15, 0x43,
+	0x83, 0x2d, 0x24, 0xa3, 0x2b, 0x57, 0x3e, 0xc8, 0x80, 0x14, 0x69, 0xe2, 0x4b, 0xb2, 0xaa, 0xa2,
+	0x07, 0x30, 0xce, 0x09, 0x26, 0xff, 0x14, 0x20, 0x7d, 0x70, 0x4a, 0xc1, 0xd1, 0x0f, 0x4f, 0x9e,
+	0xac, 0xec, 0x69, 0x8f, 0x4e, 0x5b, 0x7e, 0x98, 0xf5, 0xce, 0x3e, 0x6e, 0x9e, 0xc7, 0xcb, 0xb8,
+	0x6a, 0x5a, 0xba, 0x5a, 0xd1, 0x1f, 0xb4, 0x60, 0xf2, 0x47, 0x71, 0xba, 0xad, 0x5f, 0x34, 0x18,
+	0xf4, 0x80, 0xa6, 0x61, 0xa0, 0x6c, 0x99, 0xf5, 0x9a, 0xbf, 0x1a, 0x0c, 0x2a, 0xfd, 0xde, 0xf3,
+	0x92, 0x86, 0xce, 0x46, 0x2e, 0x1b, 0xde, 0xec, 0x8f, 0x58, 0x02, 0xde, 0x00, 0xb7, 0x2a, 0xd1,
+	0x1d, 0xb5, 0x62, 0xe7, 0x7a, 0xe3, 0xeb, 0x29, 0x37, 0x5a, 0x14, 0x4a, 0xab, 0xb4, 0xb8, 0x5c,
+	0x09, 0x3e, 0xc8, 0xb9, 0xbe, 0x64, 0x09, 0x2d, 0x67, 0x5b, 0x5c, 0xe8, 0x3a, 0x80, 0x1b, 0x52,
+	0xaa, 0x53, 0xb7, 0xb0, 0x9d, 0xdb, 0x95, 0x1c, 0xb3, 0x2b, 0x3e, 0xf5, 0x0a, 0x76, 0x14, 0x86,
+	0xd7, 0x8d, 0x55, 0xdd, 0xd8, 0x30, 0xef, 0x62, 0x2b, 0xd7, 0x4f, 0xd0, 0xa1, 0x8f, 0x9c, 0x58,
+	0xfd, 0x5b, 0x06, 0x0e, 0xc6, 0x0c, 0xc5, 0x0b, 0x3b, 0xda, 0xe7, 0x75, 0x3c, 0x32, 0x3b, 0xd3,
+	0xf1, 0x40, 0x77, 0x60, 0x34, 0x5c, 0xfd, 0xfa, 0xe7, 0xe5, 0xe9, 0x8a, 0x68, 0x46, 0x53, 0x9b,
+	0x18, 0x59, 0x19, 0x61, 0xab, 0x68, 0x5b, 0x36, 0xbd, 0xaa, 0xb5, 0xa8, 0x1b, 0xda, 0x8d, 0x95,
+	0xb7, 0xcc, 0x92, 0xea, 0x98, 0xad, 0xf3, 0x9d, 0xaf, 0x41, 0x7f, 0x85, 0xbc, 0x49, 0x9a, 0xf2,
+	0x37, 0xbc, 0x1b, 0x2e, 0x2b, 0x8e, 0x69, 0x61, 0x2a, 0xc3, 0x6f, 0x20, 0x50, 0x01, 0xcc, 0xc1,
+	0xfd, 0x3a, 0xe4, 0x3a, 0x15, 0xd2, 0x41, 0xdc, 0x46, 0x8d, 0xf2, 0x3b, 0x30, 0xdd, 0xca, 0xd6,
+	0x2f, 0xc8, 0xb5, 0x3b, 0xcc, 0x89, 0xe0, 0xce, 0x3a, 0xf7, 0x31, 0x39, 0xa8, 0x58, 0x36, 0x35,
+	0x7d, 0x7d, 0x73, 0x27, 0xbd, 0x43, 0x97, 0x61, 0xa4, 0x5e, 0xd3, 0x54, 0x07, 0xaf, 0xae, 0xeb,
+	0xb8, 0xa2, 0xf9, 0xe7, 0x90, 0xcc, 0x94, 0x09, 0x7d, 0x96, 0x95, 0x61, 0xf2, 0xfc, 0xa6, 0xf7,
+	0xd8, 0x01, 0x4e, 0x87, 0xc5, 0xdb, 0x0f, 0xce, 0xc2, 0x47, 0x07, 0x20, 0xbb, 0x6c, 0x97, 0x91,
+	0x0e, 0x10, 0x34, 0x25, 0xd0, 0xc9, 0x28, 0x81, 0xbc, 0x2b, 0x51, 0xe2, 0xa9, 0x94, 0xd4, 0xd4,
+	0xfc, 0xef, 0xc2, 0x58, 0xfb, 0x4d, 0x14, 0xb4, 0x90, 0x4a, 0x44, 0xe8, 0x7a, 0x90, 0x78, 0xa6,
+	0x2b, 0x1e, 0xaa, 0xbc, 0x02, 0x43, 0x4c, 0xbf, 0x00, 0xc5, 0x99, 0xde, 0x79, 0x51, 0x45, 0xcc,
+	0xa7, 0x25, 0xa7, 0xda, 0xde, 0x13, 0x00, 0x75, 0xde, 0x81, 0x40, 0x67, 0x63, 0xc4, 0x44, 0xde,
+	0x3b, 0x11, 0xbf, 0xd2, 0x25, 0x17, 0xb5, 0xc1, 0xbd, 0x76, 0xc3, 0xbd, 0x96, 0x80, 0xce, 0xa5,
+	0xf3, 0xa6, 0xd3, 0x92, 0xf3, 0xdd, 0x33, 0x52, 0x63, 0x2c, 0x18, 0x09, 0xdd, 0x10, 0x40, 0x85,
+	0x14, 0x4e, 0xb1, 0x07, 0xe9, 0xe2, 0xe9, 0xf4, 0x0c, 0x41, 0xbc, 0xb5, 0x1f, 0xde, 0xc7, 0xc6,
+	0x5b, 0xc4, 0x2d, 0x05, 0xf1, 0x4c, 0x57, 0x3c, 0x54, 0xf9, 0x23, 0x01, 0xa6, 0x22, 0x4e, 0xca,
+	0xd1, 0x85, 0x18, 0x81, 0xf1, 0xd7, 0x09, 0xc4, 0xc5, 0xad, 0xb0, 0x52, 0x93, 0xea, 0xb0, 0x3b,
+	0x7c, 0xa4, 0x8b, 0xe2, 0x30, 0xe5, 0x9e, 0xb3, 0x8b, 0xf3, 0x5d, 0x70, 0x50, 0xb5, 0xf7, 0x61,
+	0xb4, 0xed, 0x6c, 0x16, 0xc5, 0x49, 0xe1, 0x9f, 0x2b, 0x8b, 0x0b, 0xdd, 0xb0, 0x04, 0x9a, 0xdb,
+	0x4e, 0x3b, 0x63, 0x35, 0xf3, 0x8f, 0x88, 0xc5, 0x85, 0x6e, 0x58, 0xa8, 0xe6, 0x5f, 0xb9, 0x4b,
+	0x4f, 0xd4, 0x81, 0x22, 0xba, 0x18, 0x23, 0x31, 0xe9, 0xfc, 0x55, 0xbc, 0xb4, 0x35, 0x66, 0x6a,
+	0x98, 0x09, 0xc3, 0xec, 0x41, 0x15, 0xca, 0x27, 0xe6, 0xd2, 0xd0, 0x41, 0xa7, 0x58, 0x48, 0x4d,
+	0x1f, 0xe4, 0x5d, 0xa6, 0xbe, 0x46, 0x89, 0x4b, 0x46, 0xe8, 0x90, 0x42, 0xcc, 0xa7, 0x25, 0x0f,
+	0xdc, 0x63, 0x4b, 0x4f, 0x94, 0x9c, 0xb7, 0xc3, 0xfa, 0x0a, 0xa9, 0xe9, 0x99, 0x69, 0x1e, 0xd1,
+	0xd4, 0x8f, 0x9d, 0xe6, 0xf1, 0xa7, 0x1c, 0xe2, 0xe2, 0x56, 0x58, 0xa9, 0x49, 0xbf, 0x14, 0x20,
+	0x17, 0xd5, 0x1a, 0x47, 0x8b, 0xe9, 0x72, 0x19, 0xd7, 0xa8, 0x8b, 0x5b, 0xe2, 0xa5, 0x56, 0xbd,
+	0x2f, 0x80, 0x18, 0xdd, 0xa5, 0x46, 0x97, 0x92, 0x1c, 0x8e, 0x6b, 0xbb, 0x89, 0x97, 0xb7, 0xc8,
+	0x4d, 0x6d, 0xfb, 0x8d, 0x00, 0xfb, 0x62, 0x1a, 0x65, 0xe8, 0x72, 0xa2, 0xe3, 0xb1, 0xd6, 0xbd,
+	0xb6, 0x55, 0x76, 0x06, 0xba, 0xe8, 0x3e, 0x70, 0x2c, 0x74, 0x89, 0xcd, 0x76, 0xf1, 0xf2, 0x16,
+	0xb9, 0xa9, 0x6d, 0x1f, 0x0a, 0x20, 0x25, 0xb4, 0x51, 0xd1, 0x95, 0xae, 0xfc, 0xe7, 0x75, 0xad,
+	0xc5, 0xe2, 0xf3, 0x88, 0x60, 0xe6, 0x45, 0x54, 0xab, 0x0f, 0x2d, 0xa6, 0x4b, 0x34, 0x5d, 0xcf,
+	0x8b, 0xc4, 0xde, 0xa2, 0xbb, 0x52, 0x44, 0x76, 0xcb, 0xd0, 0xc5, 0x94, 0xf9, 0xa8, 0xeb, 0x95,
+	0x22, 0xb1, 0x41, 0x87, 0x7e, 0x22, 0xc0, 0x38, 0xaf, 0xf5, 0x85, 0x5e, 0x4d, 0x72, 0x97, 0xdf,
+	0xce, 0x13, 0xcf, 0x75, 0xcd, 0x47, 0x5b, 0x85, 0xd9, 0x87, 0x19, 0x01, 0xfd, 0x42, 0x80, 0x49,
+	0x7e, 0x77, 0x03, 0xc5, 0xed, 0x4a, 0x63, 0x7b, 0x53, 0xe2, 0x85, 0x2d, 0x70, 0xb2, 0x46, 0x59,
+	0x30, 0x12, 0xaa, 0xd1, 0x63, 0x77, 0xb5, 0xbc, 0xf6, 0x81, 0x78, 0x3a, 0x3d, 0x43, 0xb0, 0xa9,
+	0x69, 0x2b, 0x9e, 0x63, 0x37, 0x35, 0xfc, 0xda, 0x5e, 0x5c, 0xe8, 0x86, 0x25, 0xd0, 0xdc, 0x56,
+	0x99, 0xc6, 0x6a, 0xe6, 0xd7, 0xdd, 0xe2, 0x42, 0x37, 0x2c, 0x44, 0x73, 0xf1, 0xee, 0x93, 0xa7,
+	0x33, 0xc2, 0x27, 0x4f, 0x67, 0x84, 0x2f, 0x9e, 0xce, 0x08, 0x8f, 0x9e, 0xcd, 0xf4, 0x7c, 0xf2,
+	0x6c, 0xa6, 0xe7, 0xaf, 0xcf, 0x66, 0x7a, 0x60, 0x5a, 0x37, 0x23, 0xe4, 0xdd, 0x14, 0xbe, 0x75,
+	0x96, 0xb9, 0x4d, 0x17, 0x10, 0x9d, 0xd2, 0x4d, 0xe6, 0xa9, 0x70, 0x3f, 0xf8, 0x93, 0x20, 0xef,
+	0x7e, 0xdd, 0xda, 0x2e, 0xef, 0x4a, 0xe5, 0x99, 0xff, 0x0c, 0x00, 0x6d, 0xb6, 0x0d, 0x17, 0x61,
+	0x35, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// WriteScope adds or updates a scope.
+	WriteScope(ctx context.Context, in *MsgWriteScopeRequest, opts ...grpc.CallOption) (*MsgWriteScopeResponse, error)
+	// WriteScopeBundle adds or updates several scopes, along with their sessions and records, in a single message.
+	WriteScopeBundle(ctx context.Context, in *MsgWriteScopeBundleRequest, opts ...grpc.CallOption) (*MsgWriteScopeBundleResponse, error)
+	// DeleteScope deletes a scope and all associated Records, Sessions.
+	DeleteScope(ctx context.Context, in *MsgDeleteScopeRequest, opts ...grpc.CallOption) (*MsgDeleteScopeResponse, error)
+	// AddScopeDataAccess adds data access AccAddress to scope
+	AddScopeDataAccess(ctx context.Context, in *MsgAddScopeDataAccessRequest, opts ...grpc.CallOption) (*MsgAddScopeDataAccessResponse, error)
+	// DeleteScopeDataAccess removes data access AccAddress from scope
+	DeleteScopeDataAccess(ctx context.Context, in *MsgDeleteScopeDataAccessRequest, opts ...grpc.CallOption) (*MsgDeleteScopeDataAccessResponse, error)
+	// AddScopeOwner adds new owner AccAddress to scope
+	AddScopeOwner(ctx context.Context, in *MsgAddScopeOwnerRequest, opts ...grpc.CallOption) (*MsgAddScopeOwnerResponse, error)
+	// DeleteScopeOwner removes data access AccAddress from scope
+	DeleteScopeOwner(ctx context.Context, in *MsgDeleteScopeOwnerRequest, opts ...grpc.CallOption) (*MsgDeleteScopeOwnerResponse, error)
+	// TokenizeScopeValueOwner mints a marker-backed token representing the value ownership of a scope.
+	TokenizeScopeValueOwner(ctx context.Context, in *MsgTokenizeScopeValueOwnerRequest, opts ...grpc.CallOption) (*MsgTokenizeScopeValueOwnerResponse, error)
+	// OfferScopeSale offers the value ownership of a scope for a payment.
+	OfferScopeSale(ctx context.Context, in *MsgOfferScopeSaleRequest, opts ...grpc.CallOption) (*MsgOfferScopeSaleResponse, error)
+	// CancelScopeSale cancels an offer to sell the value ownership of a scope.
+	CancelScopeSale(ctx context.Context, in *MsgCancelScopeSaleRequest, opts ...grpc.CallOption) (*MsgCancelScopeSaleResponse, error)
+	// AcceptScopeSale pays for a scope and becomes its value owner in a single step.
+	AcceptScopeSale(ctx context.Context, in *MsgAcceptScopeSaleRequest, opts ...grpc.CallOption) (*MsgAcceptScopeSaleResponse, error)
+	// MigrateScopeSpecification moves a scope, and its sessions and records, to a different scope specification.
+	MigrateScopeSpecification(ctx context.Context, in *MsgMigrateScopeSpecificationRequest, opts ...grpc.CallOption) (*MsgMigrateScopeSpecificationResponse, error)
+	// WriteSession adds or updates a session context.
+	WriteSession(ctx context.Context, in *MsgWriteSessionRequest, opts ...grpc.CallOption) (*MsgWriteSessionResponse, error)
+	// WriteRecord adds or updates a record.
+	WriteRecord(ctx context.Context, in *MsgWriteRecordRequest, opts ...grpc.CallOption) (*MsgWriteRecordResponse, error)
+	// DeleteRecord deletes a record.
+	DeleteRecord(ctx context.Context, in *MsgDeleteRecordRequest, opts ...grpc.CallOption) (*MsgDeleteRecordResponse, error)
+	// WriteScopeSpecification adds or updates a scope specification.
+	WriteScopeSpecification(ctx context.Context, in *MsgWriteScopeSpecificationRequest, opts ...grpc.CallOption) (*MsgWriteScopeSpecificationResponse, error)
+	// DeleteScopeSpecification deletes a scope specification.
+	DeleteScopeSpecification(ctx context.Context, in *MsgDeleteScopeSpecificationRequest, opts ...grpc.CallOption) (*MsgDeleteScopeSpecificationResponse, error)
+	// WriteContractSpecification adds or updates a contract specification.
+	WriteContractSpecification(ctx context.Context, in *MsgWriteContractSpecificationRequest, opts ...grpc.CallOption) (*MsgWriteContractSpecificationResponse, error)
+	// DeleteContractSpecification deletes a contract specification.
+	DeleteContractSpecification(ctx context.Context, in *MsgDeleteContractSpecificationRequest, opts ...grpc.CallOption) (*MsgDeleteContractSpecificationResponse, error)
+	// AddContractSpecToScopeSpec adds contract specification to a scope specification.
+	AddContractSpecToScopeSpec(ctx context.Context, in *MsgAddContractSpecToScopeSpecRequest, opts ...grpc.CallOption) (*MsgAddContractSpecToScopeSpecResponse, error)
+	// DeleteContractSpecFromScopeSpec deletes a contract specification from a scope specification.
+	DeleteContractSpecFromScopeSpec(ctx context.Context, in *MsgDeleteContractSpecFromScopeSpecRequest, opts ...grpc.CallOption) (*MsgDeleteContractSpecFromScopeSpecResponse, error)
+	// WriteRecordSpecification adds or updates a record specification.
+	WriteRecordSpecification(ctx context.Context, in *MsgWriteRecordSpecificationRequest, opts ...grpc.CallOption) (*MsgWriteRecordSpecificationResponse, error)
+	// DeleteRecordSpecification deletes a record specification.
+	DeleteRecordSpecification(ctx context.Context, in *MsgDeleteRecordSpecificationRequest, opts ...grpc.CallOption) (*MsgDeleteRecordSpecificationResponse, error)
+	// WriteP8eContractSpec adds a P8e v39 contract spec as a v40 ContractSpecification
+	// It only exists to help facilitate the transition. Users should transition to WriteContractSpecification.
+	WriteP8EContractSpec(ctx context.Context, in *MsgWriteP8EContractSpecRequest, opts ...grpc.CallOption) (*MsgWriteP8EContractSpecResponse, error)
+	// P8EMemorializeContract records the results of a P8e contract execution as a session and set of records in a scope
+	// It only exists to help facilitate the transition. Users should transition to calling the individual Write methods.
+	P8EMemorializeContract(ctx context.Context, in *MsgP8EMemorializeContractRequest, opts ...grpc.CallOption) (*MsgP8EMemorializeContractResponse, error)
+	// BindOSLocator binds an owner address to a uri.
+	BindOSLocator(ctx context.Context, in *MsgBindOSLocatorRequest, opts ...grpc.CallOption) (*MsgBindOSLocatorResponse, error)
+	// DeleteOSLocator deletes an existing ObjectStoreLocator record.
+	DeleteOSLocator(ctx context.Context, in *MsgDeleteOSLocatorRequest, opts ...grpc.CallOption) (*MsgDeleteOSLocatorResponse, error)
+	// ModifyOSLocator updates an ObjectStoreLocator record by the current owner.
+	ModifyOSLocator(ctx context.Context, in *MsgModifyOSLocatorRequest, opts ...grpc.CallOption) (*MsgModifyOSLocatorResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) WriteScope(ctx context.Context, in *MsgWriteScopeRequest, opts ...grpc.CallOption) (*MsgWriteScopeResponse, error) {
+	out := new(MsgWriteScopeResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/WriteScope", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeleteRecord(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/DeleteRecord",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeleteRecord(ctx, req.(*MsgDeleteRecordRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_WriteScopeSpecification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgWriteScopeSpecificationRequest)
-	if err := dec(in); err != nil {
+func (c *msgClient) WriteScopeBundle(ctx context.Context, in *MsgWriteScopeBundleRequest, opts ...grpc.CallOption) (*MsgWriteScopeBundleResponse, error) {
+	out := new(MsgWriteScopeBundleResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/WriteScopeBundle", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).WriteScopeSpecification(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/WriteScopeSpecification",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).WriteScopeSpecification(ctx, req.(*MsgWriteScopeSpecificationRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_DeleteScopeSpecification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeleteScopeSpecificationRequest)
-	if err := dec(in); err != nil {
+func (c *msgClient) DeleteScope(ctx context.Context, in *MsgDeleteScopeRequest, opts ...grpc.CallOption) (*MsgDeleteScopeResponse, error) {
+	out := new(MsgDeleteScopeResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/DeleteScope", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeleteScopeSpecification(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/DeleteScopeSpecification",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeleteScopeSpecification(ctx, req.(*MsgDeleteScopeSpecificationRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_WriteContractSpecification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgWriteContractSpecificationRequest)
-	if err := dec(in); err != nil {
+func (c *msgClient) AddScopeDataAccess(ctx context.Context, in *MsgAddScopeDataAccessRequest, opts ...grpc.CallOption) (*MsgAddScopeDataAccessResponse, error) {
+	out := new(MsgAddScopeDataAccessResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/AddScopeDataAccess", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).WriteContractSpecification(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/WriteContractSpecification",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).WriteContractSpecification(ctx, req.(*MsgWriteContractSpecificationRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_DeleteContractSpecification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeleteContractSpecificationRequest)
-	if err := dec(in); err != nil {
+func (c *msgClient) DeleteScopeDataAccess(ctx context.Context, in *MsgDeleteScopeDataAccessRequest, opts ...grpc.CallOption) (*MsgDeleteScopeDataAccessResponse, error) {
+	out := new(MsgDeleteScopeDataAccessResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/DeleteScopeDataAccess", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeleteContractSpecification(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/DeleteContractSpecification",
+	return out, nil
+}
+
+func (c *msgClient) AddScopeOwner(ctx context.Context, in *MsgAddScopeOwnerRequest, opts ...grpc.CallOption) (*MsgAddScopeOwnerResponse, error) {
+	out := new(MsgAddScopeOwnerResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/AddScopeOwner", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeleteContractSpecification(ctx, req.(*MsgDeleteContractSpecificationRequest))
+	return out, nil
+}
+
+func (c *msgClient) DeleteScopeOwner(ctx context.Context, in *MsgDeleteScopeOwnerRequest, opts ...grpc.CallOption) (*MsgDeleteScopeOwnerResponse, error) {
+	out := new(MsgDeleteScopeOwnerResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/DeleteScopeOwner", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_AddContractSpecToScopeSpec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddContractSpecToScopeSpecRequest)
-	if err := dec(in); err != nil {
+func (c *msgClient) TokenizeScopeValueOwner(ctx context.Context, in *MsgTokenizeScopeValueOwnerRequest, opts ...grpc.CallOption) (*MsgTokenizeScopeValueOwnerResponse, error) {
+	out := new(MsgTokenizeScopeValueOwnerResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/TokenizeScopeValueOwner", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddContractSpecToScopeSpec(ctx, in)
+	return out, nil
+}
+
+func (c *msgClient) OfferScopeSale(ctx context.Context, in *MsgOfferScopeSaleRequest, opts ...grpc.CallOption) (*MsgOfferScopeSaleResponse, error) {
+	out := new(MsgOfferScopeSaleResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/OfferScopeSale", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/AddContractSpecToScopeSpec",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddContractSpecToScopeSpec(ctx, req.(*MsgAddContractSpecToScopeSpecRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_DeleteContractSpecFromScopeSpec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeleteContractSpecFromScopeSpecRequest)
-	if err := dec(in); err != nil {
+func (c *msgClient) CancelScopeSale(ctx context.Context, in *MsgCancelScopeSaleRequest, opts ...grpc.CallOption) (*MsgCancelScopeSaleResponse, error) {
+	out := new(MsgCancelScopeSaleResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/CancelScopeSale", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeleteContractSpecFromScopeSpec(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/DeleteContractSpecFromScopeSpec",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeleteContractSpecFromScopeSpec(ctx, req.(*MsgDeleteContractSpecFromScopeSpecRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_WriteRecordSpecification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgWriteRecordSpecificationRequest)
-	if err := dec(in); err != nil {
+func (c *msgClient) AcceptScopeSale(ctx context.Context, in *MsgAcceptScopeSaleRequest, opts ...grpc.CallOption) (*MsgAcceptScopeSaleResponse, error) {
+	out := new(MsgAcceptScopeSaleResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/AcceptScopeSale", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).WriteRecordSpecification(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/WriteRecordSpecification",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).WriteRecordSpecification(ctx, req.(*MsgWriteRecordSpecificationRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_DeleteRecordSpecification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeleteRecordSpecificationRequest)
-	if err := dec(in); err != nil {
+func (c *msgClient) MigrateScopeSpecification(ctx context.Context, in *MsgMigrateScopeSpecificationRequest, opts ...grpc.CallOption) (*MsgMigrateScopeSpecificationResponse, error) {
+	out := new(MsgMigrateScopeSpecificationResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/MigrateScopeSpecification", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeleteRecordSpecification(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/DeleteRecordSpecification",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeleteRecordSpecification(ctx, req.(*MsgDeleteRecordSpecificationRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_WriteP8EContractSpec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgWriteP8EContractSpecRequest)
-	if err := dec(in); err != nil {
+func (c *msgClient) WriteSession(ctx context.Context, in *MsgWriteSessionRequest, opts ...grpc.CallOption) (*MsgWriteSessionResponse, error) {
+	out := new(MsgWriteSessionResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/WriteSession", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).WriteP8EContractSpec(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/WriteP8EContractSpec",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).WriteP8EContractSpec(ctx, req.(*MsgWriteP8EContractSpecRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_P8EMemorializeContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgP8EMemorializeContractRequest)
-	if err := dec(in); err != nil {
+func (c *msgClient) WriteRecord(ctx context.Context, in *MsgWriteRecordRequest, opts ...grpc.CallOption) (*MsgWriteRecordResponse, error) {
+	out := new(MsgWriteRecordResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/WriteRecord", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).P8EMemorializeContract(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/P8EMemorializeContract",
+	return out, nil
+}
+
+func (c *msgClient) DeleteRecord(ctx context.Context, in *MsgDeleteRecordRequest, opts ...grpc.CallOption) (*MsgDeleteRecordResponse, error) {
+	out := new(MsgDeleteRecordResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/DeleteRecord", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).P8EMemorializeContract(ctx, req.(*MsgP8EMemorializeContractRequest))
+	return out, nil
+}
+
+func (c *msgClient) WriteScopeSpecification(ctx context.Context, in *MsgWriteScopeSpecificationRequest, opts ...grpc.CallOption) (*MsgWriteScopeSpecificationResponse, error) {
+	out := new(MsgWriteScopeSpecificationResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/WriteScopeSpecification", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_BindOSLocator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgBindOSLocatorRequest)
-	if err := dec(in); err != nil {
+func (c *msgClient) DeleteScopeSpecification(ctx context.Context, in *MsgDeleteScopeSpecificationRequest, opts ...grpc.CallOption) (*MsgDeleteScopeSpecificationResponse, error) {
+	out := new(MsgDeleteScopeSpecificationResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/DeleteScopeSpecification", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).BindOSLocator(ctx, in)
+	return out, nil
+}
+
+func (c *msgClient) WriteContractSpecification(ctx context.Context, in *MsgWriteContractSpecificationRequest, opts ...grpc.CallOption) (*MsgWriteContractSpecificationResponse, error) {
+	out := new(MsgWriteContractSpecificationResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/WriteContractSpecification", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/BindOSLocator",
+	return out, nil
+}
+
+func (c *msgClient) DeleteContractSpecification(ctx context.Context, in *MsgDeleteContractSpecificationRequest, opts ...grpc.CallOption) (*MsgDeleteContractSpecificationResponse, error) {
+	out := new(MsgDeleteContractSpecificationResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/DeleteContractSpecification", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).BindOSLocator(ctx, req.(*MsgBindOSLocatorRequest))
+	return out, nil
+}
+
+func (c *msgClient) AddContractSpecToScopeSpec(ctx context.Context, in *MsgAddContractSpecToScopeSpecRequest, opts ...grpc.CallOption) (*MsgAddContractSpecToScopeSpecResponse, error) {
+	out := new(MsgAddContractSpecToScopeSpecResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/AddContractSpecToScopeSpec", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_DeleteOSLocator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeleteOSLocatorRequest)
-	if err := dec(in); err != nil {
+func (c *msgClient) DeleteContractSpecFromScopeSpec(ctx context.Context, in *MsgDeleteContractSpecFromScopeSpecRequest, opts ...grpc.CallOption) (*MsgDeleteContractSpecFromScopeSpecResponse, error) {
+	out := new(MsgDeleteContractSpecFromScopeSpecResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/DeleteContractSpecFromScopeSpec", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeleteOSLocator(ctx, in)
+	return out, nil
+}
+
+func (c *msgClient) WriteRecordSpecification(ctx context.Context, in *MsgWriteRecordSpecificationRequest, opts ...grpc.CallOption) (*MsgWriteRecordSpecificationResponse, error) {
+	out := new(MsgWriteRecordSpecificationResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/WriteRecordSpecification", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/DeleteOSLocator",
+	return out, nil
+}
+
+func (c *msgClient) DeleteRecordSpecification(ctx context.Context, in *MsgDeleteRecordSpecificationRequest, opts ...grpc.CallOption) (*MsgDeleteRecordSpecificationResponse, error) {
+	out := new(MsgDeleteRecordSpecificationResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/DeleteRecordSpecification", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeleteOSLocator(ctx, req.(*MsgDeleteOSLocatorRequest))
+	return out, nil
+}
+
+// Deprecated: Do not use.
+func (c *msgClient) WriteP8EContractSpec(ctx context.Context, in *MsgWriteP8EContractSpecRequest, opts ...grpc.CallOption) (*MsgWriteP8EContractSpecResponse, error) {
+	out := new(MsgWriteP8EContractSpecResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/WriteP8eContractSpec", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_ModifyOSLocator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgModifyOSLocatorRequest)
-	if err := dec(in); err != nil {
+// Deprecated: Do not use.
+func (c *msgClient) P8EMemorializeContract(ctx context.Context, in *MsgP8EMemorializeContractRequest, opts ...grpc.CallOption) (*MsgP8EMemorializeContractResponse, error) {
+	out := new(MsgP8EMemorializeContractResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/P8eMemorializeContract", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).ModifyOSLocator(ctx, in)
+	return out, nil
+}
+
+func (c *msgClient) BindOSLocator(ctx context.Context, in *MsgBindOSLocatorRequest, opts ...grpc.CallOption) (*MsgBindOSLocatorResponse, error) {
+	out := new(MsgBindOSLocatorResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/BindOSLocator", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/ModifyOSLocator",
+	return out, nil
+}
+
+func (c *msgClient) DeleteOSLocator(ctx context.Context, in *MsgDeleteOSLocatorRequest, opts ...grpc.CallOption) (*MsgDeleteOSLocatorResponse, error) {
+	out := new(MsgDeleteOSLocatorResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/DeleteOSLocator", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ModifyOSLocator(ctx, req.(*MsgModifyOSLocatorRequest))
+	return out, nil
+}
+
+func (c *msgClient) ModifyOSLocator(ctx context.Context, in *MsgModifyOSLocatorRequest, opts ...grpc.CallOption) (*MsgModifyOSLocatorResponse, error) {
+	out := new(MsgModifyOSLocatorResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/ModifyOSLocator", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "provenance.metadata.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "WriteScope",
-			Handler:    _Msg_WriteScope_Handler,
-		},
-		{
-			MethodName: "DeleteScope",
-			Handler:    _Msg_DeleteScope_Handler,
-		},
-		{
-			MethodName: "AddScopeDataAccess",
-			Handler:    _Msg_AddScopeDataAccess_Handler,
-		},
-		{
-			MethodName: "DeleteScopeDataAccess",
-			Handler:    _Msg_DeleteScopeDataAccess_Handler,
-		},
-		{
-			MethodName: "AddScopeOwner",
-			Handler:    _Msg_AddScopeOwner_Handler,
-		},
-		{
-			MethodName: "DeleteScopeOwner",
-			Handler:    _Msg_DeleteScopeOwner_Handler,
-		},
-		{
-			MethodName: "WriteSession",
-			Handler:    _Msg_WriteSession_Handler,
-		},
-		{
-			MethodName: "WriteRecord",
-			Handler:    _Msg_WriteRecord_Handler,
-		},
-		{
-			MethodName: "DeleteRecord",
-			Handler:    _Msg_DeleteRecord_Handler,
-		},
-		{
-			MethodName: "WriteScopeSpecification",
-			Handler:    _Msg_WriteScopeSpecification_Handler,
-		},
-		{
-			MethodName: "DeleteScopeSpecification",
-			Handler:    _Msg_DeleteScopeSpecification_Handler,
-		},
-		{
-			MethodName: "WriteContractSpecification",
-			Handler:    _Msg_WriteContractSpecification_Handler,
-		},
-		{
-			MethodName: "DeleteContractSpecification",
-			Handler:    _Msg_DeleteContractSpecification_Handler,
-		},
-		{
-			MethodName: "AddContractSpecToScopeSpec",
-			Handler:    _Msg_AddContractSpecToScopeSpec_Handler,
-		},
-		{
-			MethodName: "DeleteContractSpecFromScopeSpec",
-			Handler:    _Msg_DeleteContractSpecFromScopeSpec_Handler,
-		},
-		{
-			MethodName: "WriteRecordSpecification",
-			Handler:    _Msg_WriteRecordSpecification_Handler,
-		},
-		{
-			MethodName: "DeleteRecordSpecification",
-			Handler:    _Msg_DeleteRecordSpecification_Handler,
-		},
-		{
-			MethodName: "WriteP8eContractSpec",
-			Handler:    _Msg_WriteP8EContractSpec_Handler,
-		},
-		{
-			MethodName: "P8eMemorializeContract",
-			Handler:    _Msg_P8EMemorializeContract_Handler,
-		},
-		{
-			MethodName: "BindOSLocator",
-			Handler:    _Msg_BindOSLocator_Handler,
-		},
-		{
-			MethodName: "DeleteOSLocator",
-			Handler:    _Msg_DeleteOSLocator_Handler,
-		},
-		{
-			MethodName: "ModifyOSLocator",
-			Handler:    _Msg_ModifyOSLocator_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "provenance/metadata/v1/tx.proto",
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// WriteScope adds or updates a scope.
+	WriteScope(context.Context, *MsgWriteScopeRequest) (*MsgWriteScopeResponse, error)
+	// WriteScopeBundle adds or updates several scopes, along with their sessions and records, in a single message.
+	WriteScopeBundle(context.Context, *MsgWriteScopeBundleRequest) (*MsgWriteScopeBundleResponse, error)
+	// DeleteScope deletes a scope and all associated Records, Sessions.
+	DeleteScope(context.Context, *MsgDeleteScopeRequest) (*MsgDeleteScopeResponse, error)
+	// AddScopeDataAccess adds data access AccAddress to scope
+	AddScopeDataAccess(context.Context, *MsgAddScopeDataAccessRequest) (*MsgAddScopeDataAccessResponse, error)
+	// DeleteScopeDataAccess removes data access AccAddress from scope
+	DeleteScopeDataAccess(context.Context, *MsgDeleteScopeDataAccessRequest) (*MsgDeleteScopeDataAccessResponse, error)
+	// AddScopeOwner adds new owner AccAddress to scope
+	AddScopeOwner(context.Context, *MsgAddScopeOwnerRequest) (*MsgAddScopeOwnerResponse, error)
+	// DeleteScopeOwner removes data access AccAddress from scope
+	DeleteScopeOwner(context.Context, *MsgDeleteScopeOwnerRequest) (*MsgDeleteScopeOwnerResponse, error)
+	// TokenizeScopeValueOwner mints a marker-backed token representing the value ownership of a scope.
+	TokenizeScopeValueOwner(context.Context, *MsgTokenizeScopeValueOwnerRequest) (*MsgTokenizeScopeValueOwnerResponse, error)
+	// OfferScopeSale offers the value ownership of a scope for a payment.
+	OfferScopeSale(context.Context, *MsgOfferScopeSaleRequest) (*MsgOfferScopeSaleResponse, error)
+	// CancelScopeSale cancels an offer to sell the value ownership of a scope.
+	CancelScopeSale(context.Context, *MsgCancelScopeSaleRequest) (*MsgCancelScopeSaleResponse, error)
+	// AcceptScopeSale pays for a scope and becomes its value owner in a single step.
+	AcceptScopeSale(context.Context, *MsgAcceptScopeSaleRequest) (*MsgAcceptScopeSaleResponse, error)
+	// MigrateScopeSpecification moves a scope, and its sessions and records, to a different scope specification.
+	MigrateScopeSpecification(context.Context, *MsgMigrateScopeSpecificationRequest) (*MsgMigrateScopeSpecificationResponse, error)
+	// WriteSession adds or updates a session context.
+	WriteSession(context.Context, *MsgWriteSessionRequest) (*MsgWriteSessionResponse, error)
+	// WriteRecord adds or updates a record.
+	WriteRecord(context.Context, *MsgWriteRecordRequest) (*MsgWriteRecordResponse, error)
+	// DeleteRecord deletes a record.
+	DeleteRecord(context.Context, *MsgDeleteRecordRequest) (*MsgDeleteRecordResponse, error)
+	// WriteScopeSpecification adds or updates a scope specification.
+	WriteScopeSpecification(context.Context, *MsgWriteScopeSpecificationRequest) (*MsgWriteScopeSpecificationResponse, error)
+	// DeleteScopeSpecification deletes a scope specification.
+	DeleteScopeSpecification(context.Context, *MsgDeleteScopeSpecificationRequest) (*MsgDeleteScopeSpecificationResponse, error)
+	// WriteContractSpecification adds or updates a contract specification.
+	WriteContractSpecification(context.Context, *MsgWriteContractSpecificationRequest) (*MsgWriteContractSpecificationResponse, error)
+	// DeleteContractSpecification deletes a contract specification.
+	DeleteContractSpecification(context.Context, *MsgDeleteContractSpecificationRequest) (*MsgDeleteContractSpecificationResponse, error)
+	// AddContractSpecToScopeSpec adds contract specification to a scope specification.
+	AddContractSpecToScopeSpec(context.Context, *MsgAddContractSpecToScopeSpecRequest) (*MsgAddContractSpecToScopeSpecResponse, error)
+	// DeleteContractSpecFromScopeSpec deletes a contract specification from a scope specification.
+	DeleteContractSpecFromScopeSpec(context.Context, *MsgDeleteContractSpecFromScopeSpecRequest) (*MsgDeleteContractSpecFromScopeSpecResponse, error)
+	// WriteRecordSpecification adds or updates a record specification.
+	WriteRecordSpecification(context.Context, *MsgWriteRecordSpecificationRequest) (*MsgWriteRecordSpecificationResponse, error)
+	// DeleteRecordSpecification deletes a record specification.
+	DeleteRecordSpecification(context.Context, *MsgDeleteRecordSpecificationRequest) (*MsgDeleteRecordSpecificationResponse, error)
+	// WriteP8eContractSpec adds a P8e v39 contract spec as a v40 ContractSpecification
+	// It only exists to help facilitate the transition. Users should transition to WriteContractSpecification.
+	WriteP8EContractSpec(context.Context, *MsgWriteP8EContractSpecRequest) (*MsgWriteP8EContractSpecResponse, error)
+	// P8EMemorializeContract records the results of a P8e contract execution as a session and set of records in a scope
+	// It only exists to help facilitate the transition. Users should transition to calling the individual Write methods.
+	P8EMemorializeContract(context.Context, *MsgP8EMemorializeContractRequest) (*MsgP8EMemorializeContractResponse, error)
+	// BindOSLocator binds an owner address to a uri.
+	BindOSLocator(context.Context, *MsgBindOSLocatorRequest) (*MsgBindOSLocatorResponse, error)
+	// DeleteOSLocator deletes an existing ObjectStoreLocator record.
+	DeleteOSLocator(context.Context, *MsgDeleteOSLocatorRequest) (*MsgDeleteOSLocatorResponse, error)
+	// ModifyOSLocator updates an ObjectStoreLocator record by the current owner.
+	ModifyOSLocator(context.Context, *MsgModifyOSLocatorRequest) (*MsgModifyOSLocatorResponse, error)
 }
 
-func (m *MsgWriteScopeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
 }
 
-func (m *MsgWriteScopeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServer) WriteScope(ctx context.Context, req *MsgWriteScopeRequest) (*MsgWriteScopeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WriteScope not implemented")
 }
-
-func (m *MsgWriteScopeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SpecUuid) > 0 {
-		i -= len(m.SpecUuid)
-		copy(dAtA[i:], m.SpecUuid)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.SpecUuid)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.ScopeUuid) > 0 {
-		i -= len(m.ScopeUuid)
-		copy(dAtA[i:], m.ScopeUuid)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ScopeUuid)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Signers) > 0 {
-		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Signers[iNdEx])
-			copy(dAtA[i:], m.Signers[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	{
-		size, err := m.Scope.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+func (*UnimplementedMsgServer) WriteScopeBundle(ctx context.Context, req *MsgWriteScopeBundleRequest) (*MsgWriteScopeBundleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WriteScopeBundle not implemented")
 }
-
-func (m *MsgWriteScopeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) DeleteScope(ctx context.Context, req *MsgDeleteScopeRequest) (*MsgDeleteScopeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteScope not implemented")
 }
-
-func (m *MsgWriteScopeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServer) AddScopeDataAccess(ctx context.Context, req *MsgAddScopeDataAccessRequest) (*MsgAddScopeDataAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddScopeDataAccess not implemented")
 }
-
-func (m *MsgWriteScopeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ScopeIdInfo != nil {
-		{
-			size, err := m.ScopeIdInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedMsgServer) DeleteScopeDataAccess(ctx context.Context, req *MsgDeleteScopeDataAccessRequest) (*MsgDeleteScopeDataAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteScopeDataAccess not implemented")
 }
-
-func (m *MsgDeleteScopeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) AddScopeOwner(ctx context.Context, req *MsgAddScopeOwnerRequest) (*MsgAddScopeOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddScopeOwner not implemented")
 }
-
-func (m *MsgDeleteScopeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServer) DeleteScopeOwner(ctx context.Context, req *MsgDeleteScopeOwnerRequest) (*MsgDeleteScopeOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteScopeOwner not implemented")
 }
-
-func (m *MsgDeleteScopeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signers) > 0 {
-		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Signers[iNdEx])
-			copy(dAtA[i:], m.Signers[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	{
-		size := m.ScopeId.Size()
-		i -= size
-		if _, err := m.ScopeId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+func (*UnimplementedMsgServer) TokenizeScopeValueOwner(ctx context.Context, req *MsgTokenizeScopeValueOwnerRequest) (*MsgTokenizeScopeValueOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenizeScopeValueOwner not implemented")
 }
-
-func (m *MsgDeleteScopeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) OfferScopeSale(ctx context.Context, req *MsgOfferScopeSaleRequest) (*MsgOfferScopeSaleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OfferScopeSale not implemented")
 }
-
-func (m *MsgDeleteScopeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServer) CancelScopeSale(ctx context.Context, req *MsgCancelScopeSaleRequest) (*MsgCancelScopeSaleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScopeSale not implemented")
 }
-
-func (m *MsgDeleteScopeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (*UnimplementedMsgServer) AcceptScopeSale(ctx context.Context, req *MsgAcceptScopeSaleRequest) (*MsgAcceptScopeSaleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptScopeSale not implemented")
 }
-
-func (m *MsgAddScopeDataAccessRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) MigrateScopeSpecification(ctx context.Context, req *MsgMigrateScopeSpecificationRequest) (*MsgMigrateScopeSpecificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateScopeSpecification not implemented")
+}
+func (*UnimplementedMsgServer) WriteSession(ctx context.Context, req *MsgWriteSessionRequest) (*MsgWriteSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WriteSession not implemented")
+}
+func (*UnimplementedMsgServer) WriteRecord(ctx context.Context, req *MsgWriteRecordRequest) (*MsgWriteRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WriteRecord not implemented")
+}
+func (*UnimplementedMsgServer) DeleteRecord(ctx context.Context, req *MsgDeleteRecordRequest) (*MsgDeleteRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRecord not implemented")
+}
+func (*UnimplementedMsgServer) WriteScopeSpecification(ctx context.Context, req *MsgWriteScopeSpecificationRequest) (*MsgWriteScopeSpecificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WriteScopeSpecification not implemented")
+}
+func (*UnimplementedMsgServer) DeleteScopeSpecification(ctx context.Context, req *MsgDeleteScopeSpecificationRequest) (*MsgDeleteScopeSpecificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteScopeSpecification not implemented")
+}
+func (*UnimplementedMsgServer) WriteContractSpecification(ctx context.Context, req *MsgWriteContractSpecificationRequest) (*MsgWriteContractSpecificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WriteContractSpecification not implemented")
+}
+func (*UnimplementedMsgServer) DeleteContractSpecification(ctx context.Context, req *MsgDeleteContractSpecificationRequest) (*MsgDeleteContractSpecificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteContractSpecification not implemented")
+}
+func (*UnimplementedMsgServer) AddContractSpecToScopeSpec(ctx context.Context, req *MsgAddContractSpecToScopeSpecRequest) (*MsgAddContractSpecToScopeSpecResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddContractSpecToScopeSpec not implemented")
+}
+func (*UnimplementedMsgServer) DeleteContractSpecFromScopeSpec(ctx context.Context, req *MsgDeleteContractSpecFromScopeSpecRequest) (*MsgDeleteContractSpecFromScopeSpecResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteContractSpecFromScopeSpec not implemented")
+}
+func (*UnimplementedMsgServer) WriteRecordSpecification(ctx context.Context, req *MsgWriteRecordSpecificationRequest) (*MsgWriteRecordSpecificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WriteRecordSpecification not implemented")
+}
+func (*UnimplementedMsgServer) DeleteRecordSpecification(ctx context.Context, req *MsgDeleteRecordSpecificationRequest) (*MsgDeleteRecordSpecificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRecordSpecification not implemented")
+}
+func (*UnimplementedMsgServer) WriteP8EContractSpec(ctx context.Context, req *MsgWriteP8EContractSpecRequest) (*MsgWriteP8EContractSpecResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WriteP8EContractSpec not implemented")
+}
+func (*UnimplementedMsgServer) P8EMemorializeContract(ctx context.Context, req *MsgP8EMemorializeContractRequest) (*MsgP8EMemorializeContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method P8EMemorializeContract not implemented")
+}
+func (*UnimplementedMsgServer) BindOSLocator(ctx context.Context, req *MsgBindOSLocatorRequest) (*MsgBindOSLocatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BindOSLocator not implemented")
+}
+func (*UnimplementedMsgServer) DeleteOSLocator(ctx context.Context, req *MsgDeleteOSLocatorRequest) (*MsgDeleteOSLocatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteOSLocator not implemented")
+}
+func (*UnimplementedMsgServer) ModifyOSLocator(ctx context.Context, req *MsgModifyOSLocatorRequest) (*MsgModifyOSLocatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModifyOSLocator not implemented")
 }
 
-func (m *MsgAddScopeDataAccessRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
 }
 
-func (m *MsgAddScopeDataAccessRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signers) > 0 {
-		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Signers[iNdEx])
-			copy(dAtA[i:], m.Signers[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
+func _Msg_WriteScope_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWriteScopeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.DataAccess) > 0 {
-		for iNdEx := len(m.DataAccess) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.DataAccess[iNdEx])
-			copy(dAtA[i:], m.DataAccess[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.DataAccess[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
+	if interceptor == nil {
+		return srv.(MsgServer).WriteScope(ctx, in)
 	}
-	{
-		size := m.ScopeId.Size()
-		i -= size
-		if _, err := m.ScopeId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/WriteScope",
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WriteScope(ctx, req.(*MsgWriteScopeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgAddScopeDataAccessResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_WriteScopeBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWriteScopeBundleRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgAddScopeDataAccessResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgAddScopeDataAccessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(MsgServer).WriteScopeBundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/WriteScopeBundle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WriteScopeBundle(ctx, req.(*MsgWriteScopeBundleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeleteScopeDataAccessRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_DeleteScope_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteScopeRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteScope(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/DeleteScope",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteScope(ctx, req.(*MsgDeleteScopeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeleteScopeDataAccessRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_AddScopeDataAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddScopeDataAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddScopeDataAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/AddScopeDataAccess",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddScopeDataAccess(ctx, req.(*MsgAddScopeDataAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeleteScopeDataAccessRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signers) > 0 {
-		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Signers[iNdEx])
-			copy(dAtA[i:], m.Signers[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
+func _Msg_DeleteScopeDataAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteScopeDataAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.DataAccess) > 0 {
-		for iNdEx := len(m.DataAccess) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.DataAccess[iNdEx])
-			copy(dAtA[i:], m.DataAccess[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.DataAccess[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteScopeDataAccess(ctx, in)
 	}
-	{
-		size := m.ScopeId.Size()
-		i -= size
-		if _, err := m.ScopeId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/DeleteScopeDataAccess",
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteScopeDataAccess(ctx, req.(*MsgDeleteScopeDataAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeleteScopeDataAccessResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_AddScopeOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddScopeOwnerRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).AddScopeOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/AddScopeOwner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddScopeOwner(ctx, req.(*MsgAddScopeOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeleteScopeDataAccessResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgDeleteScopeDataAccessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_DeleteScopeOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteScopeOwnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteScopeOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/DeleteScopeOwner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteScopeOwner(ctx, req.(*MsgDeleteScopeOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgAddScopeOwnerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_TokenizeScopeValueOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTokenizeScopeValueOwnerRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).TokenizeScopeValueOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/TokenizeScopeValueOwner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TokenizeScopeValueOwner(ctx, req.(*MsgTokenizeScopeValueOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgAddScopeOwnerRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_OfferScopeSale_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgOfferScopeSaleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).OfferScopeSale(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/OfferScopeSale",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).OfferScopeSale(ctx, req.(*MsgOfferScopeSaleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgAddScopeOwnerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signers) > 0 {
-		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Signers[iNdEx])
-			copy(dAtA[i:], m.Signers[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
+func _Msg_CancelScopeSale_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelScopeSaleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Owners) > 0 {
-		for iNdEx := len(m.Owners) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Owners[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelScopeSale(ctx, in)
 	}
-	{
-		size := m.ScopeId.Size()
-		i -= size
-		if _, err := m.ScopeId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/CancelScopeSale",
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelScopeSale(ctx, req.(*MsgCancelScopeSaleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgAddScopeOwnerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_AcceptScopeSale_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptScopeSaleRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptScopeSale(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/AcceptScopeSale",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptScopeSale(ctx, req.(*MsgAcceptScopeSaleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgAddScopeOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_MigrateScopeSpecification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMigrateScopeSpecificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MigrateScopeSpecification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/MigrateScopeSpecification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MigrateScopeSpecification(ctx, req.(*MsgMigrateScopeSpecificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgAddScopeOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_WriteSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWriteSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WriteSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/WriteSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WriteSession(ctx, req.(*MsgWriteSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeleteScopeOwnerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_WriteRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWriteRecordRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).WriteRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/WriteRecord",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WriteRecord(ctx, req.(*MsgWriteRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeleteScopeOwnerRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_DeleteRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/DeleteRecord",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteRecord(ctx, req.(*MsgDeleteRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeleteScopeOwnerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signers) > 0 {
-		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Signers[iNdEx])
-			copy(dAtA[i:], m.Signers[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
+func _Msg_WriteScopeSpecification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWriteScopeSpecificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Owners) > 0 {
-		for iNdEx := len(m.Owners) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Owners[iNdEx])
-			copy(dAtA[i:], m.Owners[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Owners[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
+	if interceptor == nil {
+		return srv.(MsgServer).WriteScopeSpecification(ctx, in)
 	}
-	{
-		size := m.ScopeId.Size()
-		i -= size
-		if _, err := m.ScopeId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/WriteScopeSpecification",
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WriteScopeSpecification(ctx, req.(*MsgWriteScopeSpecificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeleteScopeOwnerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_DeleteScopeSpecification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteScopeSpecificationRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteScopeSpecification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/DeleteScopeSpecification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteScopeSpecification(ctx, req.(*MsgDeleteScopeSpecificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeleteScopeOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgDeleteScopeOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgWriteSessionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_WriteContractSpecification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWriteContractSpecificationRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgWriteSessionRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(MsgServer).WriteContractSpecification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/WriteContractSpecification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WriteContractSpecification(ctx, req.(*MsgWriteContractSpecificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgWriteSessionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SpecUuid) > 0 {
-		i -= len(m.SpecUuid)
-		copy(dAtA[i:], m.SpecUuid)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.SpecUuid)))
-		i--
-		dAtA[i] = 0x22
+func _Msg_DeleteContractSpecification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteContractSpecificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.SessionIdComponents != nil {
-		{
-			size, err := m.SessionIdComponents.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteContractSpecification(ctx, in)
 	}
-	if len(m.Signers) > 0 {
-		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Signers[iNdEx])
-			copy(dAtA[i:], m.Signers[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/DeleteContractSpecification",
 	}
-	{
-		size, err := m.Session.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteContractSpecification(ctx, req.(*MsgDeleteContractSpecificationRequest))
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *SessionIdComponents) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_AddContractSpecToScopeSpec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddContractSpecToScopeSpecRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).AddContractSpecToScopeSpec(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/AddContractSpecToScopeSpec",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddContractSpecToScopeSpec(ctx, req.(*MsgAddContractSpecToScopeSpecRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *SessionIdComponents) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_DeleteContractSpecFromScopeSpec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteContractSpecFromScopeSpecRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteContractSpecFromScopeSpec(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/DeleteContractSpecFromScopeSpec",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteContractSpecFromScopeSpec(ctx, req.(*MsgDeleteContractSpecFromScopeSpecRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *SessionIdComponents) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SessionUuid) > 0 {
-		i -= len(m.SessionUuid)
-		copy(dAtA[i:], m.SessionUuid)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.SessionUuid)))
-		i--
-		dAtA[i] = 0x1a
+func _Msg_WriteRecordSpecification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWriteRecordSpecificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.ScopeIdentifier != nil {
-		{
-			size := m.ScopeIdentifier.Size()
-			i -= size
-			if _, err := m.ScopeIdentifier.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-		}
+	if interceptor == nil {
+		return srv.(MsgServer).WriteRecordSpecification(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/WriteRecordSpecification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WriteRecordSpecification(ctx, req.(*MsgWriteRecordSpecificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *SessionIdComponents_ScopeUuid) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_DeleteRecordSpecification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteRecordSpecificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteRecordSpecification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/DeleteRecordSpecification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteRecordSpecification(ctx, req.(*MsgDeleteRecordSpecificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *SessionIdComponents_ScopeUuid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i -= len(m.ScopeUuid)
-	copy(dAtA[i:], m.ScopeUuid)
-	i = encodeVarintTx(dAtA, i, uint64(len(m.ScopeUuid)))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-func (m *SessionIdComponents_ScopeAddr) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_WriteP8EContractSpec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWriteP8EContractSpecRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WriteP8EContractSpec(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/WriteP8EContractSpec",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WriteP8EContractSpec(ctx, req.(*MsgWriteP8EContractSpecRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *SessionIdComponents_ScopeAddr) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i -= len(m.ScopeAddr)
-	copy(dAtA[i:], m.ScopeAddr)
-	i = encodeVarintTx(dAtA, i, uint64(len(m.ScopeAddr)))
-	i--
-	dAtA[i] = 0x12
-	return len(dAtA) - i, nil
-}
-func (m *MsgWriteSessionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_P8EMemorializeContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgP8EMemorializeContractRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).P8EMemorializeContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/P8EMemorializeContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).P8EMemorializeContract(ctx, req.(*MsgP8EMemorializeContractRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgWriteSessionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_BindOSLocator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBindOSLocatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BindOSLocator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/BindOSLocator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BindOSLocator(ctx, req.(*MsgBindOSLocatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgWriteSessionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SessionIdInfo != nil {
-		{
-			size, err := m.SessionIdInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func _Msg_DeleteOSLocator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteOSLocatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteOSLocator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/DeleteOSLocator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteOSLocator(ctx, req.(*MsgDeleteOSLocatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgWriteRecordRequest) Marshal() (dAtA []byte, err error) {
+func _Msg_ModifyOSLocator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgModifyOSLocatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ModifyOSLocator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/ModifyOSLocator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ModifyOSLocator(ctx, req.(*MsgModifyOSLocatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "provenance.metadata.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "WriteScope",
+			Handler:    _Msg_WriteScope_Handler,
+		},
+		{
+			MethodName: "WriteScopeBundle",
+			Handler:    _Msg_WriteScopeBundle_Handler,
+		},
+		{
+			MethodName: "DeleteScope",
+			Handler:    _Msg_DeleteScope_Handler,
+		},
+		{
+			MethodName: "AddScopeDataAccess",
+			Handler:    _Msg_AddScopeDataAccess_Handler,
+		},
+		{
+			MethodName: "DeleteScopeDataAccess",
+			Handler:    _Msg_DeleteScopeDataAccess_Handler,
+		},
+		{
+			MethodName: "AddScopeOwner",
+			Handler:    _Msg_AddScopeOwner_Handler,
+		},
+		{
+			MethodName: "DeleteScopeOwner",
+			Handler:    _Msg_DeleteScopeOwner_Handler,
+		},
+		{
+			MethodName: "TokenizeScopeValueOwner",
+			Handler:    _Msg_TokenizeScopeValueOwner_Handler,
+		},
+		{
+			MethodName: "OfferScopeSale",
+			Handler:    _Msg_OfferScopeSale_Handler,
+		},
+		{
+			MethodName: "CancelScopeSale",
+			Handler:    _Msg_CancelScopeSale_Handler,
+		},
+		{
+			MethodName: "AcceptScopeSale",
+			Handler:    _Msg_AcceptScopeSale_Handler,
+		},
+		{
+			MethodName: "MigrateScopeSpecification",
+			Handler:    _Msg_MigrateScopeSpecification_Handler,
+		},
+		{
+			MethodName: "WriteSession",
+			Handler:    _Msg_WriteSession_Handler,
+		},
+		{
+			MethodName: "WriteRecord",
+			Handler:    _Msg_WriteRecord_Handler,
+		},
+		{
+			MethodName: "DeleteRecord",
+			Handler:    _Msg_DeleteRecord_Handler,
+		},
+		{
+			MethodName: "WriteScopeSpecification",
+			Handler:    _Msg_WriteScopeSpecification_Handler,
+		},
+		{
+			MethodName: "DeleteScopeSpecification",
+			Handler:    _Msg_DeleteScopeSpecification_Handler,
+		},
+		{
+			MethodName: "WriteContractSpecification",
+			Handler:    _Msg_WriteContractSpecification_Handler,
+		},
+		{
+			MethodName: "DeleteContractSpecification",
+			Handler:    _Msg_DeleteContractSpecification_Handler,
+		},
+		{
+			MethodName: "AddContractSpecToScopeSpec",
+			Handler:    _Msg_AddContractSpecToScopeSpec_Handler,
+		},
+		{
+			MethodName: "DeleteContractSpecFromScopeSpec",
+			Handler:    _Msg_DeleteContractSpecFromScopeSpec_Handler,
+		},
+		{
+			MethodName: "WriteRecordSpecification",
+			Handler:    _Msg_WriteRecordSpecification_Handler,
+		},
+		{
+			MethodName: "DeleteRecordSpecification",
+			Handler:    _Msg_DeleteRecordSpecification_Handler,
+		},
+		{
+			MethodName: "WriteP8eContractSpec",
+			Handler:    _Msg_WriteP8EContractSpec_Handler,
+		},
+		{
+			MethodName: "P8eMemorializeContract",
+			Handler:    _Msg_P8EMemorializeContract_Handler,
+		},
+		{
+			MethodName: "BindOSLocator",
+			Handler:    _Msg_BindOSLocator_Handler,
+		},
+		{
+			MethodName: "DeleteOSLocator",
+			Handler:    _Msg_DeleteOSLocator_Handler,
+		},
+		{
+			MethodName: "ModifyOSLocator",
+			Handler:    _Msg_ModifyOSLocator_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "provenance/metadata/v1/tx.proto",
+}
+
+func (m *MsgWriteScopeRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3725,46 +3951,27 @@ func (m *MsgWriteRecordRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgWriteRecordRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgWriteScopeRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgWriteRecordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgWriteScopeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Parties) > 0 {
-		for iNdEx := len(m.Parties) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Parties[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.ContractSpecUuid) > 0 {
-		i -= len(m.ContractSpecUuid)
-		copy(dAtA[i:], m.ContractSpecUuid)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractSpecUuid)))
+	if len(m.SpecUuid) > 0 {
+		i -= len(m.SpecUuid)
+		copy(dAtA[i:], m.SpecUuid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SpecUuid)))
 		i--
 		dAtA[i] = 0x22
 	}
-	if m.SessionIdComponents != nil {
-		{
-			size, err := m.SessionIdComponents.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
+	if len(m.ScopeUuid) > 0 {
+		i -= len(m.ScopeUuid)
+		copy(dAtA[i:], m.ScopeUuid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ScopeUuid)))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -3778,7 +3985,7 @@ func (m *MsgWriteRecordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	{
-		size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Scope.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -3790,7 +3997,7 @@ func (m *MsgWriteRecordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgWriteRecordResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgWriteScopeResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3800,19 +4007,19 @@ func (m *MsgWriteRecordResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgWriteRecordResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgWriteScopeResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgWriteRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgWriteScopeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.RecordIdInfo != nil {
+	if m.ScopeIdInfo != nil {
 		{
-			size, err := m.RecordIdInfo.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ScopeIdInfo.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -3825,7 +4032,7 @@ func (m *MsgWriteRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteRecordRequest) Marshal() (dAtA []byte, err error) {
+func (m *MsgWriteScopeBundleRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3835,12 +4042,12 @@ func (m *MsgDeleteRecordRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteRecordRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgWriteScopeBundleRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeleteRecordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgWriteScopeBundleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3854,20 +4061,24 @@ func (m *MsgDeleteRecordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 			dAtA[i] = 0x12
 		}
 	}
-	{
-		size := m.RecordId.Size()
-		i -= size
-		if _, err := m.RecordId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if len(m.Bundles) > 0 {
+		for iNdEx := len(m.Bundles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bundles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteRecordResponse) Marshal() (dAtA []byte, err error) {
+func (m *ScopeBundle) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3877,57 +4088,46 @@ func (m *MsgDeleteRecordResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteRecordResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ScopeBundle) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeleteRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ScopeBundle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgWriteScopeSpecificationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgWriteScopeSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgWriteScopeSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SpecUuid) > 0 {
-		i -= len(m.SpecUuid)
-		copy(dAtA[i:], m.SpecUuid)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.SpecUuid)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Signers) > 0 {
-		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Signers[iNdEx])
-			copy(dAtA[i:], m.Signers[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
+	if len(m.Sessions) > 0 {
+		for iNdEx := len(m.Sessions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sessions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
 			i--
 			dAtA[i] = 0x12
 		}
 	}
 	{
-		size, err := m.Specification.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Scope.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -3939,7 +4139,7 @@ func (m *MsgWriteScopeSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgWriteScopeSpecificationResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgWriteScopeBundleResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3949,32 +4149,34 @@ func (m *MsgWriteScopeSpecificationResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *MsgWriteScopeSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgWriteScopeBundleResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgWriteScopeSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgWriteScopeBundleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ScopeSpecIdInfo != nil {
-		{
-			size, err := m.ScopeSpecIdInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.ScopeIdInfos) > 0 {
+		for iNdEx := len(m.ScopeIdInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScopeIdInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0xa
 		}
-		i--
-		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteScopeSpecificationRequest) Marshal() (dAtA []byte, err error) {
+func (m *MsgDeleteScopeRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3984,12 +4186,12 @@ func (m *MsgDeleteScopeSpecificationRequest) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteScopeSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDeleteScopeRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeleteScopeSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDeleteScopeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4004,9 +4206,9 @@ func (m *MsgDeleteScopeSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (
 		}
 	}
 	{
-		size := m.SpecificationId.Size()
+		size := m.ScopeId.Size()
 		i -= size
-		if _, err := m.SpecificationId.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.ScopeId.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintTx(dAtA, i, uint64(size))
@@ -4016,7 +4218,7 @@ func (m *MsgDeleteScopeSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteScopeSpecificationResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgDeleteScopeResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4026,12 +4228,12 @@ func (m *MsgDeleteScopeSpecificationResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteScopeSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDeleteScopeResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeleteScopeSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDeleteScopeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4039,7 +4241,7 @@ func (m *MsgDeleteScopeSpecificationResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgWriteContractSpecificationRequest) Marshal() (dAtA []byte, err error) {
+func (m *MsgAddScopeDataAccessRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4049,22 +4251,32 @@ func (m *MsgWriteContractSpecificationRequest) Marshal() (dAtA []byte, err error
 	return dAtA[:n], nil
 }
 
-func (m *MsgWriteContractSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgAddScopeDataAccessRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgWriteContractSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgAddScopeDataAccessRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.SpecUuid) > 0 {
-		i -= len(m.SpecUuid)
-		copy(dAtA[i:], m.SpecUuid)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.SpecUuid)))
+	if m.Expiration != nil {
+		n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration):])
+		if err4 != nil {
+			return 0, err4
+		}
+		i -= n4
+		i = encodeVarintTx(dAtA, i, uint64(n4))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x2a
+	}
+	if len(m.Role) > 0 {
+		i -= len(m.Role)
+		copy(dAtA[i:], m.Role)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Role)))
+		i--
+		dAtA[i] = 0x22
 	}
 	if len(m.Signers) > 0 {
 		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
@@ -4072,15 +4284,24 @@ func (m *MsgWriteContractSpecificationRequest) MarshalToSizedBuffer(dAtA []byte)
 			copy(dAtA[i:], m.Signers[iNdEx])
 			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
 			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.DataAccess) > 0 {
+		for iNdEx := len(m.DataAccess) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DataAccess[iNdEx])
+			copy(dAtA[i:], m.DataAccess[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.DataAccess[iNdEx])))
+			i--
 			dAtA[i] = 0x12
 		}
 	}
 	{
-		size, err := m.Specification.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.ScopeId.Size()
+		i -= size
+		if _, err := m.ScopeId.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i -= size
 		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
 	i--
@@ -4088,7 +4309,7 @@ func (m *MsgWriteContractSpecificationRequest) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgWriteContractSpecificationResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgAddScopeDataAccessResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4098,32 +4319,20 @@ func (m *MsgWriteContractSpecificationResponse) Marshal() (dAtA []byte, err erro
 	return dAtA[:n], nil
 }
 
-func (m *MsgWriteContractSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgAddScopeDataAccessResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgWriteContractSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgAddScopeDataAccessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ContractSpecIdInfo != nil {
-		{
-			size, err := m.ContractSpecIdInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddContractSpecToScopeSpecRequest) Marshal() (dAtA []byte, err error) {
+func (m *MsgDeleteScopeDataAccessRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4133,12 +4342,12 @@ func (m *MsgAddContractSpecToScopeSpecRequest) Marshal() (dAtA []byte, err error
 	return dAtA[:n], nil
 }
 
-func (m *MsgAddContractSpecToScopeSpecRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDeleteScopeDataAccessRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgAddContractSpecToScopeSpecRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDeleteScopeDataAccessRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4152,20 +4361,19 @@ func (m *MsgAddContractSpecToScopeSpecRequest) MarshalToSizedBuffer(dAtA []byte)
 			dAtA[i] = 0x1a
 		}
 	}
-	{
-		size := m.ScopeSpecificationId.Size()
-		i -= size
-		if _, err := m.ScopeSpecificationId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if len(m.DataAccess) > 0 {
+		for iNdEx := len(m.DataAccess) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DataAccess[iNdEx])
+			copy(dAtA[i:], m.DataAccess[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.DataAccess[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x12
 	{
-		size := m.ContractSpecificationId.Size()
+		size := m.ScopeId.Size()
 		i -= size
-		if _, err := m.ContractSpecificationId.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.ScopeId.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintTx(dAtA, i, uint64(size))
@@ -4175,7 +4383,7 @@ func (m *MsgAddContractSpecToScopeSpecRequest) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddContractSpecToScopeSpecResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgDeleteScopeDataAccessResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4185,12 +4393,12 @@ func (m *MsgAddContractSpecToScopeSpecResponse) Marshal() (dAtA []byte, err erro
 	return dAtA[:n], nil
 }
 
-func (m *MsgAddContractSpecToScopeSpecResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDeleteScopeDataAccessResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgAddContractSpecToScopeSpecResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDeleteScopeDataAccessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4198,7 +4406,7 @@ func (m *MsgAddContractSpecToScopeSpecResponse) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteContractSpecFromScopeSpecRequest) Marshal() (dAtA []byte, err error) {
+func (m *MsgAddScopeOwnerRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4208,12 +4416,12 @@ func (m *MsgDeleteContractSpecFromScopeSpecRequest) Marshal() (dAtA []byte, err
 	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteContractSpecFromScopeSpecRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgAddScopeOwnerRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeleteContractSpecFromScopeSpecRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgAddScopeOwnerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4227,20 +4435,24 @@ func (m *MsgDeleteContractSpecFromScopeSpecRequest) MarshalToSizedBuffer(dAtA []
 			dAtA[i] = 0x1a
 		}
 	}
-	{
-		size := m.ScopeSpecificationId.Size()
-		i -= size
-		if _, err := m.ScopeSpecificationId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if len(m.Owners) > 0 {
+		for iNdEx := len(m.Owners) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Owners[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x12
 	{
-		size := m.ContractSpecificationId.Size()
+		size := m.ScopeId.Size()
 		i -= size
-		if _, err := m.ContractSpecificationId.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.ScopeId.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintTx(dAtA, i, uint64(size))
@@ -4250,7 +4462,7 @@ func (m *MsgDeleteContractSpecFromScopeSpecRequest) MarshalToSizedBuffer(dAtA []
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteContractSpecFromScopeSpecResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgAddScopeOwnerResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4260,12 +4472,12 @@ func (m *MsgDeleteContractSpecFromScopeSpecResponse) Marshal() (dAtA []byte, err
 	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteContractSpecFromScopeSpecResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgAddScopeOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeleteContractSpecFromScopeSpecResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgAddScopeOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4273,7 +4485,7 @@ func (m *MsgDeleteContractSpecFromScopeSpecResponse) MarshalToSizedBuffer(dAtA [
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteContractSpecificationRequest) Marshal() (dAtA []byte, err error) {
+func (m *MsgDeleteScopeOwnerRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4283,12 +4495,12 @@ func (m *MsgDeleteContractSpecificationRequest) Marshal() (dAtA []byte, err erro
 	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteContractSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDeleteScopeOwnerRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeleteContractSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDeleteScopeOwnerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4299,13 +4511,22 @@ func (m *MsgDeleteContractSpecificationRequest) MarshalToSizedBuffer(dAtA []byte
 			copy(dAtA[i:], m.Signers[iNdEx])
 			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
 			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Owners) > 0 {
+		for iNdEx := len(m.Owners) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Owners[iNdEx])
+			copy(dAtA[i:], m.Owners[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Owners[iNdEx])))
+			i--
 			dAtA[i] = 0x12
 		}
 	}
 	{
-		size := m.SpecificationId.Size()
+		size := m.ScopeId.Size()
 		i -= size
-		if _, err := m.SpecificationId.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.ScopeId.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintTx(dAtA, i, uint64(size))
@@ -4315,7 +4536,7 @@ func (m *MsgDeleteContractSpecificationRequest) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteContractSpecificationResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgDeleteScopeOwnerResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4325,12 +4546,12 @@ func (m *MsgDeleteContractSpecificationResponse) Marshal() (dAtA []byte, err err
 	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteContractSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDeleteScopeOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeleteContractSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDeleteScopeOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4338,7 +4559,7 @@ func (m *MsgDeleteContractSpecificationResponse) MarshalToSizedBuffer(dAtA []byt
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgWriteRecordSpecificationRequest) Marshal() (dAtA []byte, err error) {
+func (m *MsgTokenizeScopeValueOwnerRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4348,38 +4569,41 @@ func (m *MsgWriteRecordSpecificationRequest) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *MsgWriteRecordSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgTokenizeScopeValueOwnerRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgWriteRecordSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgTokenizeScopeValueOwnerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ContractSpecUuid) > 0 {
-		i -= len(m.ContractSpecUuid)
-		copy(dAtA[i:], m.ContractSpecUuid)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractSpecUuid)))
-		i--
-		dAtA[i] = 0x1a
-	}
 	if len(m.Signers) > 0 {
 		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Signers[iNdEx])
 			copy(dAtA[i:], m.Signers[iNdEx])
 			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x1a
 		}
 	}
 	{
-		size, err := m.Specification.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.Supply.Size()
+		i -= size
+		if _, err := m.Supply.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.ScopeId.Size()
 		i -= size
+		if _, err := m.ScopeId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
 		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
 	i--
@@ -4387,7 +4611,7 @@ func (m *MsgWriteRecordSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgWriteRecordSpecificationResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgTokenizeScopeValueOwnerResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4397,32 +4621,34 @@ func (m *MsgWriteRecordSpecificationResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *MsgWriteRecordSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgTokenizeScopeValueOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgWriteRecordSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgTokenizeScopeValueOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.RecordSpecIdInfo != nil {
-		{
-			size, err := m.RecordSpecIdInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
+	if len(m.MarkerAddress) > 0 {
+		i -= len(m.MarkerAddress)
+		copy(dAtA[i:], m.MarkerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MarkerAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteRecordSpecificationRequest) Marshal() (dAtA []byte, err error) {
+func (m *MsgOfferScopeSaleRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4432,12 +4658,12 @@ func (m *MsgDeleteRecordSpecificationRequest) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteRecordSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgOfferScopeSaleRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeleteRecordSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgOfferScopeSaleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4448,15 +4674,44 @@ func (m *MsgDeleteRecordSpecificationRequest) MarshalToSizedBuffer(dAtA []byte)
 			copy(dAtA[i:], m.Signers[iNdEx])
 			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x2a
 		}
 	}
-	{
-		size := m.SpecificationId.Size()
-		i -= size
-		if _, err := m.SpecificationId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiration):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintTx(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Price) > 0 {
+		for iNdEx := len(m.Price) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Price[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.ScopeId.Size()
+		i -= size
+		if _, err := m.ScopeId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
 		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
 	i--
@@ -4464,7 +4719,7 @@ func (m *MsgDeleteRecordSpecificationRequest) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteRecordSpecificationResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgOfferScopeSaleResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4474,12 +4729,12 @@ func (m *MsgDeleteRecordSpecificationResponse) Marshal() (dAtA []byte, err error
 	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteRecordSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgOfferScopeSaleResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeleteRecordSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgOfferScopeSaleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4487,7 +4742,7 @@ func (m *MsgDeleteRecordSpecificationResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgWriteP8EContractSpecRequest) Marshal() (dAtA []byte, err error) {
+func (m *MsgCancelScopeSaleRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4497,12 +4752,12 @@ func (m *MsgWriteP8EContractSpecRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgWriteP8EContractSpecRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCancelScopeSaleRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgWriteP8EContractSpecRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCancelScopeSaleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4517,11 +4772,11 @@ func (m *MsgWriteP8EContractSpecRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 		}
 	}
 	{
-		size, err := m.Contractspec.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.ScopeId.Size()
+		i -= size
+		if _, err := m.ScopeId.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i -= size
 		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
 	i--
@@ -4529,7 +4784,7 @@ func (m *MsgWriteP8EContractSpecRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgWriteP8EContractSpecResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgCancelScopeSaleResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4539,46 +4794,20 @@ func (m *MsgWriteP8EContractSpecResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgWriteP8EContractSpecResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCancelScopeSaleResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgWriteP8EContractSpecResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCancelScopeSaleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.RecordSpecIdInfos) > 0 {
-		for iNdEx := len(m.RecordSpecIdInfos) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RecordSpecIdInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if m.ContractSpecIdInfo != nil {
-		{
-			size, err := m.ContractSpecIdInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgP8EMemorializeContractRequest) Marshal() (dAtA []byte, err error) {
+func (m *MsgAcceptScopeSaleRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4588,107 +4817,29 @@ func (m *MsgP8EMemorializeContractRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgP8EMemorializeContractRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgAcceptScopeSaleRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgP8EMemorializeContractRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgAcceptScopeSaleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Invoker) > 0 {
-		i -= len(m.Invoker)
-		copy(dAtA[i:], m.Invoker)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Invoker)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.Signatures != nil {
-		{
-			size, err := m.Signatures.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.Contract != nil {
-		{
-			size, err := m.Contract.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.Recitals != nil {
-		{
-			size, err := m.Recitals.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x22
 		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.ScopeSpecificationId) > 0 {
-		i -= len(m.ScopeSpecificationId)
-		copy(dAtA[i:], m.ScopeSpecificationId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ScopeSpecificationId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.GroupId) > 0 {
-		i -= len(m.GroupId)
-		copy(dAtA[i:], m.GroupId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.GroupId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ScopeId) > 0 {
-		i -= len(m.ScopeId)
-		copy(dAtA[i:], m.ScopeId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ScopeId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgP8EMemorializeContractResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgP8EMemorializeContractResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgP8EMemorializeContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RecordIdInfos) > 0 {
-		for iNdEx := len(m.RecordIdInfos) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Price) > 0 {
+		for iNdEx := len(m.Price) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.RecordIdInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Price[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -4699,34 +4850,27 @@ func (m *MsgP8EMemorializeContractResponse) MarshalToSizedBuffer(dAtA []byte) (i
 			dAtA[i] = 0x1a
 		}
 	}
-	if m.SessionIdInfo != nil {
-		{
-			size, err := m.SessionIdInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Buyer)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.ScopeIdInfo != nil {
-		{
-			size, err := m.ScopeIdInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+	{
+		size := m.ScopeId.Size()
+		i -= size
+		if _, err := m.ScopeId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0xa
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgBindOSLocatorRequest) Marshal() (dAtA []byte, err error) {
+func (m *MsgAcceptScopeSaleResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4736,30 +4880,20 @@ func (m *MsgBindOSLocatorRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgBindOSLocatorRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgAcceptScopeSaleResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgBindOSLocatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgAcceptScopeSaleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Locator.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgBindOSLocatorResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgMigrateScopeSpecificationRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4769,22 +4903,41 @@ func (m *MsgBindOSLocatorResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgBindOSLocatorResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgMigrateScopeSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgBindOSLocatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgMigrateScopeSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
-		size, err := m.Locator.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.SpecificationId.Size()
+		i -= size
+		if _, err := m.SpecificationId.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.ScopeId.Size()
 		i -= size
+		if _, err := m.ScopeId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
 		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
 	i--
@@ -4792,7 +4945,7 @@ func (m *MsgBindOSLocatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteOSLocatorRequest) Marshal() (dAtA []byte, err error) {
+func (m *MsgMigrateScopeSpecificationResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4802,30 +4955,20 @@ func (m *MsgDeleteOSLocatorRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteOSLocatorRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgMigrateScopeSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeleteOSLocatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgMigrateScopeSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Locator.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteOSLocatorResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgWriteSessionRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4835,30 +4978,58 @@ func (m *MsgDeleteOSLocatorResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteOSLocatorResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgWriteSessionRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeleteOSLocatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgWriteSessionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Locator.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	if len(m.SpecUuid) > 0 {
+		i -= len(m.SpecUuid)
+		copy(dAtA[i:], m.SpecUuid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SpecUuid)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.SessionIdComponents != nil {
+		{
+			size, err := m.SessionIdComponents.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Session.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgModifyOSLocatorRequest) Marshal() (dAtA []byte, err error) {
+func (m *SessionIdComponents) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4868,30 +5039,64 @@ func (m *MsgModifyOSLocatorRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgModifyOSLocatorRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *SessionIdComponents) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgModifyOSLocatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SessionIdComponents) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Locator.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.SessionUuid) > 0 {
+		i -= len(m.SessionUuid)
+		copy(dAtA[i:], m.SessionUuid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SessionUuid)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ScopeIdentifier != nil {
+		{
+			size := m.ScopeIdentifier.Size()
+			i -= size
+			if _, err := m.ScopeIdentifier.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SessionIdComponents_ScopeUuid) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SessionIdComponents_ScopeUuid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.ScopeUuid)
+	copy(dAtA[i:], m.ScopeUuid)
+	i = encodeVarintTx(dAtA, i, uint64(len(m.ScopeUuid)))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
+func (m *SessionIdComponents_ScopeAddr) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
 
-func (m *MsgModifyOSLocatorResponse) Marshal() (dAtA []byte, err error) {
+func (m *SessionIdComponents_ScopeAddr) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.ScopeAddr)
+	copy(dAtA[i:], m.ScopeAddr)
+	i = encodeVarintTx(dAtA, i, uint64(len(m.ScopeAddr)))
+	i--
+	dAtA[i] = 0x12
+	return len(dAtA) - i, nil
+}
+func (m *MsgWriteSessionResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4901,781 +5106,3680 @@ func (m *MsgModifyOSLocatorResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgModifyOSLocatorResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgWriteSessionResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgModifyOSLocatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgWriteSessionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Locator.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.SessionIdInfo != nil {
+		{
+			size, err := m.SessionIdInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0xa
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgWriteRecordRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgWriteScopeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgWriteRecordRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWriteRecordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Scope.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+	if len(m.Parties) > 0 {
+		for iNdEx := len(m.Parties) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Parties[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
 		}
 	}
-	l = len(m.ScopeUuid)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ContractSpecUuid) > 0 {
+		i -= len(m.ContractSpecUuid)
+		copy(dAtA[i:], m.ContractSpecUuid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractSpecUuid)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.SpecUuid)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.SessionIdComponents != nil {
+		{
+			size, err := m.SessionIdComponents.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
-}
-
-func (m *MsgWriteScopeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	var l int
-	_ = l
-	if m.ScopeIdInfo != nil {
-		l = m.ScopeIdInfo.Size()
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteScopeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.ScopeId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+func (m *MsgWriteRecordResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteScopeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgWriteRecordResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgAddScopeDataAccessRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgWriteRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.ScopeId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.DataAccess) > 0 {
-		for _, s := range m.DataAccess {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+	if m.RecordIdInfo != nil {
+		{
+			size, err := m.RecordIdInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddScopeDataAccessResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgDeleteRecordRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteScopeDataAccessRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgDeleteRecordRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteRecordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.ScopeId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.DataAccess) > 0 {
-		for _, s := range m.DataAccess {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+	{
+		size := m.RecordId.Size()
+		i -= size
+		if _, err := m.RecordId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteScopeDataAccessResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgDeleteRecordResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteRecordResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddScopeOwnerRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgWriteScopeSpecificationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWriteScopeSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWriteScopeSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.ScopeId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.Owners) > 0 {
-		for _, e := range m.Owners {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if len(m.SpecUuid) > 0 {
+		i -= len(m.SpecUuid)
+		copy(dAtA[i:], m.SpecUuid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SpecUuid)))
+		i--
+		dAtA[i] = 0x1a
 	}
 	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	return n
+	{
+		size, err := m.Specification.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddScopeOwnerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgWriteScopeSpecificationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteScopeOwnerRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgWriteScopeSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWriteScopeSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.ScopeId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.Owners) > 0 {
-		for _, s := range m.Owners {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+	if m.ScopeSpecIdInfo != nil {
+		{
+			size, err := m.ScopeSpecIdInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteScopeOwnerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgDeleteScopeSpecificationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgWriteSessionRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgDeleteScopeSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteScopeSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Session.Size()
-	n += 1 + l + sovTx(uint64(l))
 	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if m.SessionIdComponents != nil {
-		l = m.SessionIdComponents.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.SpecUuid)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size := m.SpecificationId.Size()
+		i -= size
+		if _, err := m.SpecificationId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *SessionIdComponents) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ScopeIdentifier != nil {
-		n += m.ScopeIdentifier.Size()
-	}
-	l = len(m.SessionUuid)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgDeleteScopeSpecificationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *SessionIdComponents_ScopeUuid) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ScopeUuid)
-	n += 1 + l + sovTx(uint64(l))
-	return n
+func (m *MsgDeleteScopeSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *SessionIdComponents_ScopeAddr) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgDeleteScopeSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ScopeAddr)
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *MsgWriteSessionResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.SessionIdInfo != nil {
-		l = m.SessionIdInfo.Size()
-		n += 1 + l + sovTx(uint64(l))
+
+func (m *MsgWriteContractSpecificationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgWriteRecordRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgWriteContractSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWriteContractSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Record.Size()
-	n += 1 + l + sovTx(uint64(l))
+	if len(m.SpecUuid) > 0 {
+		i -= len(m.SpecUuid)
+		copy(dAtA[i:], m.SpecUuid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SpecUuid)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if m.SessionIdComponents != nil {
-		l = m.SessionIdComponents.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ContractSpecUuid)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Parties) > 0 {
-		for _, e := range m.Parties {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Specification.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgWriteRecordResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.RecordIdInfo != nil {
-		l = m.RecordIdInfo.Size()
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgWriteContractSpecificationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteRecordRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgWriteContractSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWriteContractSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.RecordId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+	if m.ContractSpecIdInfo != nil {
+		{
+			size, err := m.ContractSpecIdInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteRecordResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgAddContractSpecToScopeSpecRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgWriteScopeSpecificationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgAddContractSpecToScopeSpecRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddContractSpecToScopeSpecRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Specification.Size()
-	n += 1 + l + sovTx(uint64(l))
 	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	l = len(m.SpecUuid)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size := m.ScopeSpecificationId.Size()
+		i -= size
+		if _, err := m.ScopeSpecificationId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.ContractSpecificationId.Size()
+		i -= size
+		if _, err := m.ContractSpecificationId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgWriteScopeSpecificationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ScopeSpecIdInfo != nil {
-		l = m.ScopeSpecIdInfo.Size()
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgAddContractSpecToScopeSpecResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteScopeSpecificationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgAddContractSpecToScopeSpecResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddContractSpecToScopeSpecResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.SpecificationId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteScopeSpecificationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgDeleteContractSpecFromScopeSpecRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgWriteContractSpecificationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgDeleteContractSpecFromScopeSpecRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteContractSpecFromScopeSpecRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Specification.Size()
-	n += 1 + l + sovTx(uint64(l))
 	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	l = len(m.SpecUuid)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size := m.ScopeSpecificationId.Size()
+		i -= size
+		if _, err := m.ScopeSpecificationId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.ContractSpecificationId.Size()
+		i -= size
+		if _, err := m.ContractSpecificationId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgWriteContractSpecificationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ContractSpecIdInfo != nil {
-		l = m.ContractSpecIdInfo.Size()
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgDeleteContractSpecFromScopeSpecResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgAddContractSpecToScopeSpecRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.ContractSpecificationId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.ScopeSpecificationId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	return n
+func (m *MsgDeleteContractSpecFromScopeSpecResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgAddContractSpecToScopeSpecResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgDeleteContractSpecFromScopeSpecResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteContractSpecFromScopeSpecRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.ContractSpecificationId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.ScopeSpecificationId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+func (m *MsgDeleteContractSpecificationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteContractSpecFromScopeSpecResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgDeleteContractSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeleteContractSpecificationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgDeleteContractSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.SpecificationId.Size()
-	n += 1 + l + sovTx(uint64(l))
 	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	return n
+	{
+		size := m.SpecificationId.Size()
+		i -= size
+		if _, err := m.SpecificationId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteContractSpecificationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgDeleteContractSpecificationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgWriteRecordSpecificationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Specification.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	l = len(m.ContractSpecUuid)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgDeleteContractSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgWriteRecordSpecificationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgDeleteContractSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.RecordSpecIdInfo != nil {
-		l = m.RecordSpecIdInfo.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteRecordSpecificationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.SpecificationId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+func (m *MsgWriteRecordSpecificationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteRecordSpecificationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgWriteRecordSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgWriteP8EContractSpecRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgWriteRecordSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Contractspec.Size()
-	n += 1 + l + sovTx(uint64(l))
+	if len(m.ContractSpecUuid) > 0 {
+		i -= len(m.ContractSpecUuid)
+		copy(dAtA[i:], m.ContractSpecUuid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractSpecUuid)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	return n
+	{
+		size, err := m.Specification.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgWriteP8EContractSpecResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgWriteRecordSpecificationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWriteRecordSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWriteRecordSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ContractSpecIdInfo != nil {
-		l = m.ContractSpecIdInfo.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.RecordSpecIdInfos) > 0 {
-		for _, e := range m.RecordSpecIdInfos {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	if m.RecordSpecIdInfo != nil {
+		{
+			size, err := m.RecordSpecIdInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgP8EMemorializeContractRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ScopeId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.GroupId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ScopeSpecificationId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Recitals != nil {
-		l = m.Recitals.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Contract != nil {
-		l = m.Contract.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Signatures != nil {
-		l = m.Signatures.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Invoker)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgDeleteRecordSpecificationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgP8EMemorializeContractResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgDeleteRecordSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteRecordSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ScopeIdInfo != nil {
-		l = m.ScopeIdInfo.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.SessionIdInfo != nil {
-		l = m.SessionIdInfo.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if len(m.RecordIdInfos) > 0 {
-		for _, e := range m.RecordIdInfos {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	{
+		size := m.SpecificationId.Size()
+		i -= size
+		if _, err := m.SpecificationId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgBindOSLocatorRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgDeleteRecordSpecificationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Locator.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgBindOSLocatorResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Locator.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+func (m *MsgDeleteRecordSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeleteOSLocatorRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgDeleteRecordSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Locator.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteOSLocatorResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgWriteP8EContractSpecRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWriteP8EContractSpecRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWriteP8EContractSpecRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Locator.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Contractspec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgModifyOSLocatorRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgWriteP8EContractSpecResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWriteP8EContractSpecResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWriteP8EContractSpecResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Locator.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
+	if len(m.RecordSpecIdInfos) > 0 {
+		for iNdEx := len(m.RecordSpecIdInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecordSpecIdInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.ContractSpecIdInfo != nil {
+		{
+			size, err := m.ContractSpecIdInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgP8EMemorializeContractRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgP8EMemorializeContractRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgP8EMemorializeContractRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Invoker) > 0 {
+		i -= len(m.Invoker)
+		copy(dAtA[i:], m.Invoker)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Invoker)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Signatures != nil {
+		{
+			size, err := m.Signatures.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Contract != nil {
+		{
+			size, err := m.Contract.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Recitals != nil {
+		{
+			size, err := m.Recitals.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ScopeSpecificationId) > 0 {
+		i -= len(m.ScopeSpecificationId)
+		copy(dAtA[i:], m.ScopeSpecificationId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ScopeSpecificationId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.GroupId) > 0 {
+		i -= len(m.GroupId)
+		copy(dAtA[i:], m.GroupId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.GroupId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ScopeId) > 0 {
+		i -= len(m.ScopeId)
+		copy(dAtA[i:], m.ScopeId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ScopeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgP8EMemorializeContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgP8EMemorializeContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgP8EMemorializeContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RecordIdInfos) > 0 {
+		for iNdEx := len(m.RecordIdInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecordIdInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.SessionIdInfo != nil {
+		{
+			size, err := m.SessionIdInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ScopeIdInfo != nil {
+		{
+			size, err := m.ScopeIdInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBindOSLocatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBindOSLocatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBindOSLocatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Locator.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBindOSLocatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBindOSLocatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBindOSLocatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Locator.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteOSLocatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteOSLocatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteOSLocatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Locator.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteOSLocatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteOSLocatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteOSLocatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Locator.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgModifyOSLocatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgModifyOSLocatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgModifyOSLocatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UpdateFields) > 0 {
+		for iNdEx := len(m.UpdateFields) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.UpdateFields[iNdEx])
+			copy(dAtA[i:], m.UpdateFields[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.UpdateFields[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Locator.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgModifyOSLocatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgModifyOSLocatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgModifyOSLocatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Locator.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgWriteScopeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Scope.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.ScopeUuid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SpecUuid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWriteScopeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ScopeIdInfo != nil {
+		l = m.ScopeIdInfo.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWriteScopeBundleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Bundles) > 0 {
+		for _, e := range m.Bundles {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ScopeBundle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Scope.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Sessions) > 0 {
+		for _, e := range m.Sessions {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgWriteScopeBundleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ScopeIdInfos) > 0 {
+		for _, e := range m.ScopeIdInfos {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgDeleteScopeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ScopeId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgDeleteScopeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddScopeDataAccessRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ScopeId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.DataAccess) > 0 {
+		for _, s := range m.DataAccess {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Expiration != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddScopeDataAccessResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeleteScopeDataAccessRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ScopeId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.DataAccess) > 0 {
+		for _, s := range m.DataAccess {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgDeleteScopeDataAccessResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddScopeOwnerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ScopeId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Owners) > 0 {
+		for _, e := range m.Owners {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAddScopeOwnerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeleteScopeOwnerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ScopeId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Owners) > 0 {
+		for _, s := range m.Owners {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgDeleteScopeOwnerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTokenizeScopeValueOwnerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ScopeId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Supply.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgTokenizeScopeValueOwnerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MarkerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgOfferScopeSaleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ScopeId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Price) > 0 {
+		for _, e := range m.Price {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiration)
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgOfferScopeSaleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelScopeSaleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ScopeId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCancelScopeSaleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAcceptScopeSaleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ScopeId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Price) > 0 {
+		for _, e := range m.Price {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAcceptScopeSaleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMigrateScopeSpecificationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ScopeId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.SpecificationId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgMigrateScopeSpecificationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWriteSessionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Session.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.SessionIdComponents != nil {
+		l = m.SessionIdComponents.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SpecUuid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *SessionIdComponents) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ScopeIdentifier != nil {
+		n += m.ScopeIdentifier.Size()
+	}
+	l = len(m.SessionUuid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *SessionIdComponents_ScopeUuid) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeUuid)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+func (m *SessionIdComponents_ScopeAddr) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeAddr)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+func (m *MsgWriteSessionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SessionIdInfo != nil {
+		l = m.SessionIdInfo.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWriteRecordRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Record.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.SessionIdComponents != nil {
+		l = m.SessionIdComponents.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContractSpecUuid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Parties) > 0 {
+		for _, e := range m.Parties {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgWriteRecordResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RecordIdInfo != nil {
+		l = m.RecordIdInfo.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeleteRecordRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RecordId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgDeleteRecordResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWriteScopeSpecificationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Specification.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.SpecUuid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWriteScopeSpecificationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ScopeSpecIdInfo != nil {
+		l = m.ScopeSpecIdInfo.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeleteScopeSpecificationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SpecificationId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgDeleteScopeSpecificationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWriteContractSpecificationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Specification.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.SpecUuid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWriteContractSpecificationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ContractSpecIdInfo != nil {
+		l = m.ContractSpecIdInfo.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddContractSpecToScopeSpecRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ContractSpecificationId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.ScopeSpecificationId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAddContractSpecToScopeSpecResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeleteContractSpecFromScopeSpecRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ContractSpecificationId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.ScopeSpecificationId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgDeleteContractSpecFromScopeSpecResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeleteContractSpecificationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SpecificationId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgDeleteContractSpecificationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWriteRecordSpecificationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Specification.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.ContractSpecUuid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWriteRecordSpecificationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RecordSpecIdInfo != nil {
+		l = m.RecordSpecIdInfo.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeleteRecordSpecificationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SpecificationId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgDeleteRecordSpecificationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWriteP8EContractSpecRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Contractspec.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgWriteP8EContractSpecResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ContractSpecIdInfo != nil {
+		l = m.ContractSpecIdInfo.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.RecordSpecIdInfos) > 0 {
+		for _, e := range m.RecordSpecIdInfos {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgP8EMemorializeContractRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.GroupId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ScopeSpecificationId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Recitals != nil {
+		l = m.Recitals.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Contract != nil {
+		l = m.Contract.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Signatures != nil {
+		l = m.Signatures.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Invoker)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgP8EMemorializeContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ScopeIdInfo != nil {
+		l = m.ScopeIdInfo.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SessionIdInfo != nil {
+		l = m.SessionIdInfo.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.RecordIdInfos) > 0 {
+		for _, e := range m.RecordIdInfos {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgBindOSLocatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Locator.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgBindOSLocatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Locator.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgDeleteOSLocatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Locator.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgDeleteOSLocatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Locator.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgModifyOSLocatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Locator.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.UpdateFields) > 0 {
+		for _, s := range m.UpdateFields {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgModifyOSLocatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Locator.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgWriteScopeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWriteScopeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWriteScopeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Scope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeUuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeUuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecUuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpecUuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWriteScopeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWriteScopeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWriteScopeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeIdInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScopeIdInfo == nil {
+				m.ScopeIdInfo = &ScopeIdInfo{}
+			}
+			if err := m.ScopeIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWriteScopeBundleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWriteScopeBundleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWriteScopeBundleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bundles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bundles = append(m.Bundles, ScopeBundle{})
+			if err := m.Bundles[len(m.Bundles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScopeBundle) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScopeBundle: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScopeBundle: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Scope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sessions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sessions = append(m.Sessions, Session{})
+			if err := m.Sessions[len(m.Sessions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, Record{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWriteScopeBundleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWriteScopeBundleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWriteScopeBundleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeIdInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeIdInfos = append(m.ScopeIdInfos, &ScopeIdInfo{})
+			if err := m.ScopeIdInfos[len(m.ScopeIdInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteScopeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteScopeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteScopeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ScopeId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteScopeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteScopeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteScopeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddScopeDataAccessRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddScopeDataAccessRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddScopeDataAccessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ScopeId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataAccess", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataAccess = append(m.DataAccess, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Role = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddScopeDataAccessResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddScopeDataAccessResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddScopeDataAccessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteScopeDataAccessRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteScopeDataAccessRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteScopeDataAccessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ScopeId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataAccess", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataAccess = append(m.DataAccess, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteScopeDataAccessResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteScopeDataAccessResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteScopeDataAccessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddScopeOwnerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddScopeOwnerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddScopeOwnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ScopeId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owners", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owners = append(m.Owners, Party{})
+			if err := m.Owners[len(m.Owners)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgModifyOSLocatorResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Locator.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return nil
 }
+func (m *MsgAddScopeOwnerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddScopeOwnerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddScopeOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgWriteScopeRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteScopeOwnerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5698,17 +8802,17 @@ func (m *MsgWriteScopeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWriteScopeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteScopeOwnerRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWriteScopeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteScopeOwnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5718,28 +8822,28 @@ func (m *MsgWriteScopeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Scope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ScopeId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owners", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5767,11 +8871,11 @@ func (m *MsgWriteScopeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			m.Owners = append(m.Owners, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeUuid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5799,40 +8903,58 @@ func (m *MsgWriteScopeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScopeUuid = string(dAtA[iNdEx:postIndex])
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpecUuid", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteScopeOwnerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpecUuid = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteScopeOwnerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteScopeOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5854,7 +8976,7 @@ func (m *MsgWriteScopeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWriteScopeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgTokenizeScopeValueOwnerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5877,17 +8999,17 @@ func (m *MsgWriteScopeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWriteScopeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTokenizeScopeValueOwnerRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWriteScopeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTokenizeScopeValueOwnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeIdInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5897,28 +9019,91 @@ func (m *MsgWriteScopeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ScopeIdInfo == nil {
-				m.ScopeIdInfo = &ScopeIdInfo{}
+			if err := m.ScopeId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.ScopeIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Supply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5940,7 +9125,7 @@ func (m *MsgWriteScopeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteScopeRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgTokenizeScopeValueOwnerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5963,17 +9148,17 @@ func (m *MsgDeleteScopeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteScopeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTokenizeScopeValueOwnerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteScopeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTokenizeScopeValueOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5983,28 +9168,27 @@ func (m *MsgDeleteScopeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ScopeId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkerAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6032,7 +9216,7 @@ func (m *MsgDeleteScopeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			m.MarkerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6055,57 +9239,7 @@ func (m *MsgDeleteScopeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteScopeResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteScopeResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteScopeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgAddScopeDataAccessRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgOfferScopeSaleRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6128,10 +9262,10 @@ func (m *MsgAddScopeDataAccessRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddScopeDataAccessRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgOfferScopeSaleRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddScopeDataAccessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgOfferScopeSaleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6169,7 +9303,7 @@ func (m *MsgAddScopeDataAccessRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataAccess", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6197,9 +9331,76 @@ func (m *MsgAddScopeDataAccessRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DataAccess = append(m.DataAccess, string(dAtA[iNdEx:postIndex]))
+			m.Buyer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Price = append(m.Price, types.Coin{})
+			if err := m.Price[len(m.Price)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
 			}
@@ -6252,7 +9453,7 @@ func (m *MsgAddScopeDataAccessRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddScopeDataAccessResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgOfferScopeSaleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6275,10 +9476,10 @@ func (m *MsgAddScopeDataAccessResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddScopeDataAccessResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgOfferScopeSaleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddScopeDataAccessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgOfferScopeSaleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6302,7 +9503,7 @@ func (m *MsgAddScopeDataAccessResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteScopeDataAccessRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelScopeSaleRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6325,10 +9526,10 @@ func (m *MsgDeleteScopeDataAccessRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteScopeDataAccessRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelScopeSaleRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteScopeDataAccessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelScopeSaleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6365,38 +9566,6 @@ func (m *MsgDeleteScopeDataAccessRequest) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataAccess", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DataAccess = append(m.DataAccess, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
 			}
@@ -6449,7 +9618,7 @@ func (m *MsgDeleteScopeDataAccessRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteScopeDataAccessResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelScopeSaleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6472,10 +9641,10 @@ func (m *MsgDeleteScopeDataAccessResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteScopeDataAccessResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelScopeSaleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteScopeDataAccessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelScopeSaleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6499,7 +9668,7 @@ func (m *MsgDeleteScopeDataAccessResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddScopeOwnerRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgAcceptScopeSaleRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6522,10 +9691,10 @@ func (m *MsgAddScopeOwnerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddScopeOwnerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAcceptScopeSaleRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddScopeOwnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAcceptScopeSaleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6563,7 +9732,39 @@ func (m *MsgAddScopeOwnerRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owners", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buyer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6590,12 +9791,12 @@ func (m *MsgAddScopeOwnerRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owners = append(m.Owners, Party{})
-			if err := m.Owners[len(m.Owners)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Price = append(m.Price, types.Coin{})
+			if err := m.Price[len(m.Price)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
 			}
@@ -6648,7 +9849,7 @@ func (m *MsgAddScopeOwnerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddScopeOwnerResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAcceptScopeSaleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6671,10 +9872,10 @@ func (m *MsgAddScopeOwnerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddScopeOwnerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAcceptScopeSaleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddScopeOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAcceptScopeSaleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6698,7 +9899,7 @@ func (m *MsgAddScopeOwnerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteScopeOwnerRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgMigrateScopeSpecificationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6721,10 +9922,10 @@ func (m *MsgDeleteScopeOwnerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteScopeOwnerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMigrateScopeSpecificationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteScopeOwnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMigrateScopeSpecificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6762,9 +9963,9 @@ func (m *MsgDeleteScopeOwnerRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owners", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecificationId", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6774,23 +9975,24 @@ func (m *MsgDeleteScopeOwnerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owners = append(m.Owners, string(dAtA[iNdEx:postIndex]))
+			if err := m.SpecificationId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
@@ -6845,7 +10047,7 @@ func (m *MsgDeleteScopeOwnerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteScopeOwnerResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgMigrateScopeSpecificationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6868,10 +10070,10 @@ func (m *MsgDeleteScopeOwnerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteScopeOwnerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMigrateScopeSpecificationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteScopeOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMigrateScopeSpecificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -10439,6 +13641,38 @@ func (m *MsgModifyOSLocatorRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateFields", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdateFields = append(m.UpdateFields, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
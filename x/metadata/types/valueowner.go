@@ -0,0 +1,36 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ValueOwnerTokenDenomPrefix is the prefix of the denom of every value owner token.
+// The marker module's denom rules can change, so the keeper checks that a denom is unused before creating a token with it.
+const ValueOwnerTokenDenomPrefix = "scopevalue/"
+
+// ValueOwnerTokenDenom returns the denom of the value owner token for a scope.
+func ValueOwnerTokenDenom(scopeID MetadataAddress) string {
+	return ValueOwnerTokenDenomPrefix + scopeID.String()
+}
+
+// IsValueOwnerTokenDenom returns true if the denom is the denom of a value owner token.
+func IsValueOwnerTokenDenom(denom string) bool {
+	_, err := ScopeIDFromValueOwnerTokenDenom(denom)
+	return err == nil
+}
+
+// ScopeIDFromValueOwnerTokenDenom returns the scope id that a value owner token denom represents.
+func ScopeIDFromValueOwnerTokenDenom(denom string) (MetadataAddress, error) {
+	if !strings.HasPrefix(denom, ValueOwnerTokenDenomPrefix) {
+		return nil, fmt.Errorf("denom %q is not a value owner token denom", denom)
+	}
+	scopeID, err := MetadataAddressFromBech32(strings.TrimPrefix(denom, ValueOwnerTokenDenomPrefix))
+	if err != nil {
+		return nil, fmt.Errorf("denom %q is not a value owner token denom: %w", denom, err)
+	}
+	if !scopeID.IsScopeAddress() {
+		return nil, fmt.Errorf("denom %q is not a value owner token denom: %s is not a scope id", denom, scopeID)
+	}
+	return scopeID, nil
+}
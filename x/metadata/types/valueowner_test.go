@@ -0,0 +1,38 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValueOwnerTokenDenom(t *testing.T) {
+	scopeID := ScopeMetadataAddress(uuid.New())
+	denom := ValueOwnerTokenDenom(scopeID)
+	assert.Equal(t, "scopevalue/"+scopeID.String(), denom, "ValueOwnerTokenDenom")
+	assert.True(t, IsValueOwnerTokenDenom(denom), "IsValueOwnerTokenDenom(%q)", denom)
+
+	actual, err := ScopeIDFromValueOwnerTokenDenom(denom)
+	require.NoError(t, err, "ScopeIDFromValueOwnerTokenDenom(%q)", denom)
+	assert.Equal(t, scopeID, actual, "ScopeIDFromValueOwnerTokenDenom(%q)", denom)
+
+	specID := ScopeSpecMetadataAddress(uuid.New())
+	cases := map[string]struct {
+		denom    string
+		errValue string
+	}{
+		"no prefix":      {"nhash", `denom "nhash" is not a value owner token denom`},
+		"bad address":    {"scopevalue/nhash", `denom "scopevalue/nhash" is not a value owner token denom: decoding bech32 failed: invalid bech32 string length 5`},
+		"not a scope":    {"scopevalue/" + specID.String(), `denom "scopevalue/` + specID.String() + `" is not a value owner token denom: ` + specID.String() + ` is not a scope id`},
+		"missing prefix": {scopeID.String(), `denom "` + scopeID.String() + `" is not a value owner token denom`},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := ScopeIDFromValueOwnerTokenDenom(tc.denom)
+			assert.EqualError(t, err, tc.errValue, "ScopeIDFromValueOwnerTokenDenom(%q)", tc.denom)
+			assert.False(t, IsValueOwnerTokenDenom(tc.denom), "IsValueOwnerTokenDenom(%q)", tc.denom)
+		})
+	}
+}
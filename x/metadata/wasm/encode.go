@@ -15,6 +15,28 @@ import (
 type MetadataMsgParams struct {
 	// Params for encoding a MsgWriteScopeRequest
 	WriteScope *WriteScope `json:"write_scope,omitempty"`
+	// Params for encoding a MsgDeleteScopeRequest
+	DeleteScope *DeleteScope `json:"delete_scope,omitempty"`
+	// Params for encoding a MsgAddScopeOwnerRequest
+	AddScopeOwner *AddScopeOwner `json:"add_scope_owner,omitempty"`
+	// Params for encoding a MsgDeleteScopeOwnerRequest
+	DeleteScopeOwner *DeleteScopeOwner `json:"delete_scope_owner,omitempty"`
+	// Params for encoding a MsgAddScopeDataAccessRequest
+	AddScopeDataAccess *AddScopeDataAccess `json:"add_scope_data_access,omitempty"`
+	// Params for encoding a MsgDeleteScopeDataAccessRequest
+	DeleteScopeDataAccess *DeleteScopeDataAccess `json:"delete_scope_data_access,omitempty"`
+	// Params for encoding a MsgWriteSessionRequest
+	WriteSession *WriteSession `json:"write_session,omitempty"`
+	// Params for encoding a MsgWriteRecordRequest
+	WriteRecord *WriteRecord `json:"write_record,omitempty"`
+	// Params for encoding a MsgDeleteRecordRequest
+	DeleteRecord *DeleteRecord `json:"delete_record,omitempty"`
+	// Params for encoding a MsgWriteScopeSpecificationRequest
+	WriteScopeSpecification *WriteScopeSpecification `json:"write_scope_specification,omitempty"`
+	// Params for encoding a MsgWriteContractSpecificationRequest
+	WriteContractSpecification *WriteContractSpecification `json:"write_contract_specification,omitempty"`
+	// Params for encoding a MsgWriteRecordSpecificationRequest
+	WriteRecordSpecification *WriteRecordSpecification `json:"write_record_specification,omitempty"`
 }
 
 // WriteScope are params for encoding a MsgWriteScopeRequest.
@@ -25,6 +47,104 @@ type WriteScope struct {
 	Signers []string `json:"signers"`
 }
 
+// DeleteScope are params for encoding a MsgDeleteScopeRequest.
+type DeleteScope struct {
+	// The bech32 address of the scope we want to delete.
+	ScopeID string `json:"scope_id"`
+	// The signers' addresses.
+	Signers []string `json:"signers"`
+}
+
+// AddScopeOwner are params for encoding a MsgAddScopeOwnerRequest.
+type AddScopeOwner struct {
+	// The bech32 address of the scope we want to add owners to.
+	ScopeID string `json:"scope_id"`
+	// The owners to add.
+	Owners []*Party `json:"owners"`
+	// The signers' addresses.
+	Signers []string `json:"signers"`
+}
+
+// DeleteScopeOwner are params for encoding a MsgDeleteScopeOwnerRequest.
+type DeleteScopeOwner struct {
+	// The bech32 address of the scope we want to remove owners from.
+	ScopeID string `json:"scope_id"`
+	// The addresses of the owners to remove.
+	Owners []string `json:"owners"`
+	// The signers' addresses.
+	Signers []string `json:"signers"`
+}
+
+// AddScopeDataAccess are params for encoding a MsgAddScopeDataAccessRequest.
+type AddScopeDataAccess struct {
+	// The bech32 address of the scope we want to add data access addresses to.
+	ScopeID string `json:"scope_id"`
+	// The data access addresses to add.
+	DataAccess []string `json:"data_access"`
+	// The signers' addresses.
+	Signers []string `json:"signers"`
+}
+
+// DeleteScopeDataAccess are params for encoding a MsgDeleteScopeDataAccessRequest.
+type DeleteScopeDataAccess struct {
+	// The bech32 address of the scope we want to remove data access addresses from.
+	ScopeID string `json:"scope_id"`
+	// The data access addresses to remove.
+	DataAccess []string `json:"data_access"`
+	// The signers' addresses.
+	Signers []string `json:"signers"`
+}
+
+// WriteSession are params for encoding a MsgWriteSessionRequest.
+type WriteSession struct {
+	// The session we want to create/update.
+	Session Session `json:"session"`
+	// The signers' addresses.
+	Signers []string `json:"signers"`
+}
+
+// WriteRecord are params for encoding a MsgWriteRecordRequest.
+type WriteRecord struct {
+	// The record we want to create/update.
+	Record Record `json:"record"`
+	// The parties involved in creating the record, if different from the session parties.
+	Parties []*Party `json:"parties,omitempty"`
+	// The signers' addresses.
+	Signers []string `json:"signers"`
+}
+
+// DeleteRecord are params for encoding a MsgDeleteRecordRequest.
+type DeleteRecord struct {
+	// The bech32 address of the record we want to delete.
+	RecordID string `json:"record_id"`
+	// The signers' addresses.
+	Signers []string `json:"signers"`
+}
+
+// WriteScopeSpecification are params for encoding a MsgWriteScopeSpecificationRequest.
+type WriteScopeSpecification struct {
+	// The scope specification we want to create/update.
+	Specification ScopeSpecification `json:"specification"`
+	// The signers' addresses.
+	Signers []string `json:"signers"`
+}
+
+// WriteContractSpecification are params for encoding a MsgWriteContractSpecificationRequest.
+type WriteContractSpecification struct {
+	// The contract specification we want to create/update.
+	Specification ContractSpecification `json:"specification"`
+	// The signers' addresses.
+	Signers []string `json:"signers"`
+}
+
+// WriteRecordSpecification are params for encoding a MsgWriteRecordSpecificationRequest.
+type WriteRecordSpecification struct {
+	// The record specification we want to create/update.
+	Specification RecordSpecification `json:"specification"`
+	// The signers' addresses.
+	Signers []string `json:"signers"`
+}
+
 // Encoder returns a smart contract message encoder for the metadata module.
 func Encoder(contract sdk.AccAddress, msg json.RawMessage, version string) ([]sdk.Msg, error) {
 	wrapper := struct {
@@ -40,19 +160,37 @@ func Encoder(contract sdk.AccAddress, msg json.RawMessage, version string) ([]sd
 	switch {
 	case params.WriteScope != nil:
 		return params.WriteScope.Encode()
+	case params.DeleteScope != nil:
+		return params.DeleteScope.Encode()
+	case params.AddScopeOwner != nil:
+		return params.AddScopeOwner.Encode()
+	case params.DeleteScopeOwner != nil:
+		return params.DeleteScopeOwner.Encode()
+	case params.AddScopeDataAccess != nil:
+		return params.AddScopeDataAccess.Encode()
+	case params.DeleteScopeDataAccess != nil:
+		return params.DeleteScopeDataAccess.Encode()
+	case params.WriteSession != nil:
+		return params.WriteSession.Encode()
+	case params.WriteRecord != nil:
+		return params.WriteRecord.Encode()
+	case params.DeleteRecord != nil:
+		return params.DeleteRecord.Encode()
+	case params.WriteScopeSpecification != nil:
+		return params.WriteScopeSpecification.Encode()
+	case params.WriteContractSpecification != nil:
+		return params.WriteContractSpecification.Encode()
+	case params.WriteRecordSpecification != nil:
+		return params.WriteRecordSpecification.Encode()
 	default:
 		return nil, fmt.Errorf("wasm: invalid metadata encode request: %s", string(msg))
 	}
 }
 
-// Encode creates a MsgAddScopeDataAccessRequest.
+// Encode creates a MsgWriteScopeRequest.
 func (params *WriteScope) Encode() ([]sdk.Msg, error) {
-	// verify the signer addresses are valid
-	for _, addr := range params.Signers {
-		_, err := sdk.AccAddressFromBech32(addr)
-		if err != nil {
-			return nil, fmt.Errorf("wasm: signer address must be a Bech32 string: %w", err)
-		}
+	if err := validateSigners(params.Signers); err != nil {
+		return nil, err
 	}
 	scope, err := params.Scope.convertToBaseType()
 	if err != nil {
@@ -63,3 +201,202 @@ func (params *WriteScope) Encode() ([]sdk.Msg, error) {
 
 	return []sdk.Msg{msg}, nil
 }
+
+// Encode creates a MsgDeleteScopeRequest.
+func (params *DeleteScope) Encode() ([]sdk.Msg, error) {
+	if err := validateSigners(params.Signers); err != nil {
+		return nil, err
+	}
+	scopeID, err := types.MetadataAddressFromBech32(params.ScopeID)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid 'scope id': %w", err)
+	}
+
+	msg := types.NewMsgDeleteScopeRequest(scopeID, params.Signers)
+
+	return []sdk.Msg{msg}, nil
+}
+
+// Encode creates a MsgAddScopeOwnerRequest.
+func (params *AddScopeOwner) Encode() ([]sdk.Msg, error) {
+	if err := validateSigners(params.Signers); err != nil {
+		return nil, err
+	}
+	scopeID, err := types.MetadataAddressFromBech32(params.ScopeID)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid 'scope id': %w", err)
+	}
+	owners, err := convertPartiesToBaseType(params.Owners)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := types.NewMsgAddScopeOwnerRequest(scopeID, owners, params.Signers)
+
+	return []sdk.Msg{msg}, nil
+}
+
+// Encode creates a MsgDeleteScopeOwnerRequest.
+func (params *DeleteScopeOwner) Encode() ([]sdk.Msg, error) {
+	if err := validateSigners(params.Signers); err != nil {
+		return nil, err
+	}
+	scopeID, err := types.MetadataAddressFromBech32(params.ScopeID)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid 'scope id': %w", err)
+	}
+	for _, addr := range params.Owners {
+		if _, err = sdk.AccAddressFromBech32(addr); err != nil {
+			return nil, fmt.Errorf("wasm: invalid 'owners' address: %w", err)
+		}
+	}
+
+	msg := types.NewMsgDeleteScopeOwnerRequest(scopeID, params.Owners, params.Signers)
+
+	return []sdk.Msg{msg}, nil
+}
+
+// Encode creates a MsgAddScopeDataAccessRequest.
+func (params *AddScopeDataAccess) Encode() ([]sdk.Msg, error) {
+	if err := validateSigners(params.Signers); err != nil {
+		return nil, err
+	}
+	scopeID, err := types.MetadataAddressFromBech32(params.ScopeID)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid 'scope id': %w", err)
+	}
+	for _, addr := range params.DataAccess {
+		if _, err = sdk.AccAddressFromBech32(addr); err != nil {
+			return nil, fmt.Errorf("wasm: invalid 'data_access' address: %w", err)
+		}
+	}
+
+	msg := types.NewMsgAddScopeDataAccessRequest(scopeID, params.DataAccess, params.Signers)
+
+	return []sdk.Msg{msg}, nil
+}
+
+// Encode creates a MsgDeleteScopeDataAccessRequest.
+func (params *DeleteScopeDataAccess) Encode() ([]sdk.Msg, error) {
+	if err := validateSigners(params.Signers); err != nil {
+		return nil, err
+	}
+	scopeID, err := types.MetadataAddressFromBech32(params.ScopeID)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid 'scope id': %w", err)
+	}
+	for _, addr := range params.DataAccess {
+		if _, err = sdk.AccAddressFromBech32(addr); err != nil {
+			return nil, fmt.Errorf("wasm: invalid 'data_access' address: %w", err)
+		}
+	}
+
+	msg := types.NewMsgDeleteScopeDataAccessRequest(scopeID, params.DataAccess, params.Signers)
+
+	return []sdk.Msg{msg}, nil
+}
+
+// Encode creates a MsgWriteSessionRequest.
+func (params *WriteSession) Encode() ([]sdk.Msg, error) {
+	if err := validateSigners(params.Signers); err != nil {
+		return nil, err
+	}
+	session, err := params.Session.convertToBaseType()
+	if err != nil {
+		return nil, err
+	}
+
+	msg := types.NewMsgWriteSessionRequest(*session, params.Signers)
+
+	return []sdk.Msg{msg}, nil
+}
+
+// Encode creates a MsgWriteRecordRequest.
+func (params *WriteRecord) Encode() ([]sdk.Msg, error) {
+	if err := validateSigners(params.Signers); err != nil {
+		return nil, err
+	}
+	record, err := params.Record.convertToBaseType()
+	if err != nil {
+		return nil, err
+	}
+	parties, err := convertPartiesToBaseType(params.Parties)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := types.NewMsgWriteRecordRequest(*record, nil, "", params.Signers, parties)
+
+	return []sdk.Msg{msg}, nil
+}
+
+// Encode creates a MsgDeleteRecordRequest.
+func (params *DeleteRecord) Encode() ([]sdk.Msg, error) {
+	if err := validateSigners(params.Signers); err != nil {
+		return nil, err
+	}
+	recordID, err := types.MetadataAddressFromBech32(params.RecordID)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid 'record id': %w", err)
+	}
+
+	msg := types.NewMsgDeleteRecordRequest(recordID, params.Signers)
+
+	return []sdk.Msg{msg}, nil
+}
+
+// Encode creates a MsgWriteScopeSpecificationRequest.
+func (params *WriteScopeSpecification) Encode() ([]sdk.Msg, error) {
+	if err := validateSigners(params.Signers); err != nil {
+		return nil, err
+	}
+	spec, err := params.Specification.convertToBaseType()
+	if err != nil {
+		return nil, err
+	}
+
+	msg := types.NewMsgWriteScopeSpecificationRequest(*spec, params.Signers)
+
+	return []sdk.Msg{msg}, nil
+}
+
+// Encode creates a MsgWriteContractSpecificationRequest.
+func (params *WriteContractSpecification) Encode() ([]sdk.Msg, error) {
+	if err := validateSigners(params.Signers); err != nil {
+		return nil, err
+	}
+	spec, err := params.Specification.convertToBaseType()
+	if err != nil {
+		return nil, err
+	}
+
+	msg := types.NewMsgWriteContractSpecificationRequest(*spec, params.Signers)
+
+	return []sdk.Msg{msg}, nil
+}
+
+// Encode creates a MsgWriteRecordSpecificationRequest.
+func (params *WriteRecordSpecification) Encode() ([]sdk.Msg, error) {
+	if err := validateSigners(params.Signers); err != nil {
+		return nil, err
+	}
+	spec, err := params.Specification.convertToBaseType()
+	if err != nil {
+		return nil, err
+	}
+
+	msg := types.NewMsgWriteRecordSpecificationRequest(*spec, params.Signers)
+
+	return []sdk.Msg{msg}, nil
+}
+
+// validateSigners verifies the signer addresses are valid.
+func validateSigners(signers []string) error {
+	for _, addr := range signers {
+		_, err := sdk.AccAddressFromBech32(addr)
+		if err != nil {
+			return fmt.Errorf("wasm: signer address must be a Bech32 string: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+package wasm_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/x/metadata/types"
+	"github.com/provenance-io/provenance/x/metadata/wasm"
+)
+
+func init() {
+	app.SetConfig(false, false)
+}
+
+func TestEncoderFixtures(t *testing.T) {
+	tests := []struct {
+		fixture string
+		expType sdk.Msg
+	}{
+		{fixture: "write_scope.json", expType: &types.MsgWriteScopeRequest{}},
+		{fixture: "delete_scope.json", expType: &types.MsgDeleteScopeRequest{}},
+		{fixture: "add_scope_owner.json", expType: &types.MsgAddScopeOwnerRequest{}},
+		{fixture: "delete_scope_owner.json", expType: &types.MsgDeleteScopeOwnerRequest{}},
+		{fixture: "add_scope_data_access.json", expType: &types.MsgAddScopeDataAccessRequest{}},
+		{fixture: "delete_scope_data_access.json", expType: &types.MsgDeleteScopeDataAccessRequest{}},
+		{fixture: "write_session.json", expType: &types.MsgWriteSessionRequest{}},
+		{fixture: "write_record.json", expType: &types.MsgWriteRecordRequest{}},
+		{fixture: "delete_record.json", expType: &types.MsgDeleteRecordRequest{}},
+		{fixture: "write_scope_specification.json", expType: &types.MsgWriteScopeSpecificationRequest{}},
+		{fixture: "write_contract_specification.json", expType: &types.MsgWriteContractSpecificationRequest{}},
+		{fixture: "write_record_specification.json", expType: &types.MsgWriteRecordSpecificationRequest{}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.fixture, func(t *testing.T) {
+			bz, err := os.ReadFile(filepath.Join("testdata", tc.fixture))
+			require.NoError(t, err, "ReadFile")
+			msgs, err := wasm.Encoder(nil, bz, "")
+			require.NoError(t, err, "Encoder")
+			require.Len(t, msgs, 1, "encoded msgs")
+			assert.Equal(t, sdk.MsgTypeURL(tc.expType), sdk.MsgTypeURL(msgs[0]), "encoded msg type")
+			assert.NoError(t, msgs[0].ValidateBasic(), "ValidateBasic")
+		})
+	}
+}
+
+func TestEncoderErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		msg    string
+		expErr string
+	}{
+		{
+			name:   "no params",
+			msg:    `{"metadata":null}`,
+			expErr: "wasm: nil metadata encode params",
+		},
+		{
+			name:   "unknown message",
+			msg:    `{"metadata":{"unknown":{}}}`,
+			expErr: `wasm: invalid metadata encode request: {"metadata":{"unknown":{}}}`,
+		},
+		{
+			name:   "invalid signer",
+			msg:    `{"metadata":{"delete_scope":{"scope_id":"scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel","signers":["bad"]}}}`,
+			expErr: "wasm: signer address must be a Bech32 string: decoding bech32 failed: invalid bech32 string length 3",
+		},
+		{
+			name:   "invalid record id",
+			msg:    `{"metadata":{"delete_record":{"record_id":"bad","signers":[]}}}`,
+			expErr: "wasm: invalid 'record id': decoding bech32 failed: invalid bech32 string length 3",
+		},
+		{
+			name:   "record without process",
+			msg:    `{"metadata":{"write_record":{"record":{"session_id":"session1qxge0zaztu65tx5x5llv5xc9zts9sqlch3sxwn44j50jzgt8rshvqyfrjcr","specification_id":"recspec1qh00d0q2e8w5say53afqdesxp2zw42dq2jdvmdazuwzcaddhh8gmuqhez44","name":"recordname"},"signers":[]}}}`,
+			expErr: "wasm: process must be defined for a record",
+		},
+		{
+			name:   "contract spec without source",
+			msg:    `{"metadata":{"write_contract_specification":{"specification":{"specification_id":"contractspec1q000d0q2e8w5say53afqdesxp2zqzkr4fn","owner_addresses":[],"parties_involved":[],"class_name":"c"},"signers":[]}}}`,
+			expErr: "wasm: resource id or hash must be defined for a source",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := wasm.Encoder(nil, json.RawMessage(tc.msg), "")
+			assert.EqualError(t, err, tc.expErr, "Encoder")
+		})
+	}
+}
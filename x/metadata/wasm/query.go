@@ -22,6 +22,14 @@ type MetadataQueryParams struct {
 	GetSessions *GetSessionsParams `json:"get_sessions,omitempty"`
 	// Get records by scope ID and name (optional).
 	GetRecords *GetRecordsParams `json:"get_records,omitempty"`
+	// Get a scope specification by ID.
+	GetScopeSpecification *GetScopeSpecificationParams `json:"get_scope_specification,omitempty"`
+	// Get a contract specification by ID.
+	GetContractSpecification *GetContractSpecificationParams `json:"get_contract_specification,omitempty"`
+	// Get record specifications by contract specification ID.
+	GetRecordSpecifications *GetRecordSpecificationsParams `json:"get_record_specifications,omitempty"`
+	// Get object store locators by owner or scope ID.
+	GetOSLocators *GetOSLocatorsParams `json:"get_os_locators,omitempty"`
 }
 
 // GetScopeParams are the inputs for a scope query.
@@ -44,6 +52,33 @@ type GetRecordsParams struct {
 	Name string `json:"name,omitempty"`
 }
 
+// GetScopeSpecificationParams are the inputs for a scope specification query.
+type GetScopeSpecificationParams struct {
+	// The bech32 address of the scope specification we want to get.
+	SpecificationID string `json:"specification_id"`
+}
+
+// GetContractSpecificationParams are the inputs for a contract specification query.
+type GetContractSpecificationParams struct {
+	// The bech32 address of the contract specification we want to get.
+	SpecificationID string `json:"specification_id"`
+}
+
+// GetRecordSpecificationsParams are the inputs for a record specifications query.
+type GetRecordSpecificationsParams struct {
+	// The bech32 address of the contract specification we want to get record specifications for.
+	ContractSpecificationID string `json:"contract_specification_id"`
+}
+
+// GetOSLocatorsParams are the inputs for an object store locators query.
+// Only one field should be set.
+type GetOSLocatorsParams struct {
+	// The bech32 address of the owner we want to get the object store locator for.
+	Owner string `json:"owner,omitempty"`
+	// The bech32 address of the scope we want to get the owners' object store locators for.
+	ScopeID string `json:"scope_id,omitempty"`
+}
+
 // Querier returns a smart contract querier for the metadata module.
 func Querier(keeper keeper.Keeper) provwasm.Querier {
 	return func(ctx sdk.Context, query json.RawMessage, version string) ([]byte, error) {
@@ -64,6 +99,14 @@ func Querier(keeper keeper.Keeper) provwasm.Querier {
 			return params.GetSessions.Run(ctx, keeper)
 		case params.GetRecords != nil:
 			return params.GetRecords.Run(ctx, keeper)
+		case params.GetScopeSpecification != nil:
+			return params.GetScopeSpecification.Run(ctx, keeper)
+		case params.GetContractSpecification != nil:
+			return params.GetContractSpecification.Run(ctx, keeper)
+		case params.GetRecordSpecifications != nil:
+			return params.GetRecordSpecifications.Run(ctx, keeper)
+		case params.GetOSLocators != nil:
+			return params.GetOSLocators.Run(ctx, keeper)
 		default:
 			return nil, fmt.Errorf("wasm: invalid metadata query: %s", string(query))
 		}
@@ -80,6 +123,7 @@ func (params *GetScopeParams) Run(ctx sdk.Context, keeper keeper.Keeper) ([]byte
 	if !found {
 		return nil, fmt.Errorf("wasm: scope not found: %s", params.ScopeID)
 	}
+	scope.RemoveExpiredDataAccess(ctx.BlockTime())
 	return createScopeResponse(scope)
 }
 
@@ -112,3 +156,74 @@ func (params *GetRecordsParams) Run(ctx sdk.Context, keeper keeper.Keeper) ([]by
 	}
 	return createRecordsResponse(records)
 }
+
+// Run gets a scope specification by ID.
+func (params *GetScopeSpecificationParams) Run(ctx sdk.Context, keeper keeper.Keeper) ([]byte, error) {
+	specID, err := types.MetadataAddressFromBech32(params.SpecificationID)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid scope specification ID: %w", err)
+	}
+	spec, found := keeper.GetScopeSpecification(ctx, specID)
+	if !found {
+		return nil, fmt.Errorf("wasm: scope specification not found: %s", params.SpecificationID)
+	}
+	return createScopeSpecificationResponse(spec)
+}
+
+// Run gets a contract specification by ID.
+func (params *GetContractSpecificationParams) Run(ctx sdk.Context, keeper keeper.Keeper) ([]byte, error) {
+	specID, err := types.MetadataAddressFromBech32(params.SpecificationID)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid contract specification ID: %w", err)
+	}
+	spec, found := keeper.GetContractSpecification(ctx, specID)
+	if !found {
+		return nil, fmt.Errorf("wasm: contract specification not found: %s", params.SpecificationID)
+	}
+	return createContractSpecificationResponse(spec)
+}
+
+// Run gets record specifications by contract specification ID.
+func (params *GetRecordSpecificationsParams) Run(ctx sdk.Context, keeper keeper.Keeper) ([]byte, error) {
+	contractSpecID, err := types.MetadataAddressFromBech32(params.ContractSpecificationID)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid contract specification ID: %w", err)
+	}
+	specs, err := keeper.GetRecordSpecificationsForContractSpecificationID(ctx, contractSpecID)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: unable to get record specifications: %w", err)
+	}
+	return createRecordSpecificationsResponse(specs)
+}
+
+// Run gets object store locators by owner or scope ID.
+func (params *GetOSLocatorsParams) Run(ctx sdk.Context, keeper keeper.Keeper) ([]byte, error) {
+	switch {
+	case len(params.Owner) > 0 && len(params.ScopeID) > 0:
+		return nil, fmt.Errorf("wasm: only one of owner or scope ID can be provided")
+	case len(params.Owner) > 0:
+		owner, err := sdk.AccAddressFromBech32(params.Owner)
+		if err != nil {
+			return nil, fmt.Errorf("wasm: invalid owner address: %w", err)
+		}
+		locators, err := keeper.GetOSLocatorsForOwner(ctx, owner)
+		if err != nil {
+			return nil, fmt.Errorf("wasm: unable to get object store locators: %w", err)
+		}
+		if len(locators) == 0 {
+			return nil, fmt.Errorf("wasm: object store locator not found: %s", params.Owner)
+		}
+		return createObjectStoreLocatorsResponse(locators)
+	case len(params.ScopeID) > 0:
+		if _, err := types.MetadataAddressFromBech32(params.ScopeID); err != nil {
+			return nil, fmt.Errorf("wasm: invalid scope ID: %w", err)
+		}
+		locators, err := keeper.GetOSLocatorByScope(ctx, params.ScopeID)
+		if err != nil {
+			return nil, fmt.Errorf("wasm: unable to get object store locators: %w", err)
+		}
+		return createObjectStoreLocatorsResponse(locators)
+	default:
+		return nil, fmt.Errorf("wasm: owner or scope ID must be provided")
+	}
+}
@@ -0,0 +1,109 @@
+package wasm_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/x/metadata/types"
+	"github.com/provenance-io/provenance/x/metadata/wasm"
+)
+
+func TestQuerierSpecificationsAndLocators(t *testing.T) {
+	testApp := app.Setup(t)
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+	owner := sdk.AccAddress("addr1_______________")
+	scopeID, err := types.MetadataAddressFromBech32("scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel")
+	require.NoError(t, err, "scope id")
+	scopeSpecID, err := types.MetadataAddressFromBech32("scopespec1qnwg86nsatx5pl56muw0v9ytlz3qu3jx6m")
+	require.NoError(t, err, "scope spec id")
+	contractSpecID, err := types.MetadataAddressFromBech32("contractspec1q000d0q2e8w5say53afqdesxp2zqzkr4fn")
+	require.NoError(t, err, "contract spec id")
+	recordSpecID := contractSpecID.MustGetAsRecordSpecAddress("recordname")
+	unknownSpecID := types.ScopeSpecMetadataAddress(uuid.MustParse("7b8dfc1b-0c41-4b27-b9a2-41c6c8e4a8d1"))
+
+	ownerParties := []types.PartyType{types.PartyType_PARTY_TYPE_OWNER}
+	testApp.MetadataKeeper.SetScopeSpecification(ctx, *types.NewScopeSpecification(scopeSpecID,
+		types.NewDescription("scope spec", "", "", ""), []string{owner.String()}, ownerParties, []types.MetadataAddress{contractSpecID}))
+	testApp.MetadataKeeper.SetContractSpecification(ctx, *types.NewContractSpecification(contractSpecID,
+		nil, []string{owner.String()}, ownerParties, types.NewContractSpecificationSourceHash("hash"), "io.provenance.Contract"))
+	testApp.MetadataKeeper.SetRecordSpecification(ctx, *types.NewRecordSpecification(recordSpecID, "recordname",
+		[]*types.InputSpecification{types.NewInputSpecification("input", "string", types.NewInputSpecificationSourceHash("inhash"))},
+		"string", types.DefinitionType_DEFINITION_TYPE_RECORD, ownerParties))
+	testApp.MetadataKeeper.SetScope(ctx, *types.NewScope(scopeID, scopeSpecID,
+		[]types.Party{{Address: owner.String(), Role: types.PartyType_PARTY_TYPE_OWNER}}, nil, owner.String()))
+	testApp.AccountKeeper.SetAccount(ctx, testApp.AccountKeeper.NewAccountWithAddress(ctx, owner))
+	require.NoError(t, testApp.MetadataKeeper.SetOSLocator(ctx, types.NewOSLocatorRecord(owner, nil, "https://provenance.io")), "SetOSLocator")
+
+	querier := wasm.Querier(testApp.MetadataKeeper)
+	query := func(q string) ([]byte, error) {
+		return querier(ctx, json.RawMessage(`{"metadata":`+q+`}`), "")
+	}
+
+	tests := []struct {
+		name   string
+		query  string
+		exp    string
+		expErr string
+	}{
+		{
+			name:  "scope specification",
+			query: `{"get_scope_specification":{"specification_id":"scopespec1qnwg86nsatx5pl56muw0v9ytlz3qu3jx6m"}}`,
+			exp: `{"specification_id":"scopespec1qnwg86nsatx5pl56muw0v9ytlz3qu3jx6m","description":{"name":"scope spec"},` +
+				`"owner_addresses":["` + owner.String() + `"],"parties_involved":["owner"],` +
+				`"contract_spec_ids":["contractspec1q000d0q2e8w5say53afqdesxp2zqzkr4fn"]}`,
+		},
+		{
+			name:  "contract specification",
+			query: `{"get_contract_specification":{"specification_id":"contractspec1q000d0q2e8w5say53afqdesxp2zqzkr4fn"}}`,
+			exp: `{"specification_id":"contractspec1q000d0q2e8w5say53afqdesxp2zqzkr4fn","owner_addresses":["` + owner.String() + `"],` +
+				`"parties_involved":["owner"],"source":{"hash":{"hash":"hash"}},"class_name":"io.provenance.Contract"}`,
+		},
+		{
+			name:  "record specifications",
+			query: `{"get_record_specifications":{"contract_specification_id":"contractspec1q000d0q2e8w5say53afqdesxp2zqzkr4fn"}}`,
+			exp: `{"record_specifications":[{"specification_id":"` + recordSpecID.String() + `","name":"recordname",` +
+				`"inputs":[{"name":"input","type_name":"string","source":{"hash":{"hash":"inhash"}}}],` +
+				`"type_name":"string","result_type":"record","responsible_parties":["owner"]}]}`,
+		},
+		{
+			name:  "os locator by owner",
+			query: `{"get_os_locators":{"owner":"` + owner.String() + `"}}`,
+			exp:   `{"locators":[{"owner":"` + owner.String() + `","locator_uri":"https://provenance.io","name":"default"}]}`,
+		},
+		{
+			name:  "os locators by scope",
+			query: `{"get_os_locators":{"scope_id":"scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel"}}`,
+			exp:   `{"locators":[{"owner":"` + owner.String() + `","locator_uri":"https://provenance.io","name":"default"}]}`,
+		},
+		{
+			name:   "scope specification not found",
+			query:  `{"get_scope_specification":{"specification_id":"` + unknownSpecID.String() + `"}}`,
+			expErr: "wasm: scope specification not found: " + unknownSpecID.String(),
+		},
+		{
+			name:   "os locators without owner or scope",
+			query:  `{"get_os_locators":{}}`,
+			expErr: "wasm: owner or scope ID must be provided",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			bz, err := query(tc.query)
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "query")
+				return
+			}
+			require.NoError(t, err, "query")
+			assert.JSONEq(t, tc.exp, string(bz), "query result")
+		})
+	}
+}
@@ -164,6 +164,100 @@ const (
 	ResultStatusUnspecified ResultStatus = "unspecified"
 )
 
+// Description is general information about a specification.
+type Description struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	WebsiteURL  string `json:"website_url,omitempty"`
+	IconURL     string `json:"icon_url,omitempty"`
+}
+
+// ScopeSpecification defines the contract specifications and parties allowed in a scope.
+type ScopeSpecification struct {
+	SpecificationID string       `json:"specification_id"`
+	Description     *Description `json:"description,omitempty"`
+	OwnerAddresses  []string     `json:"owner_addresses"`
+	PartiesInvolved []PartyType  `json:"parties_involved"`
+	ContractSpecIDs []string     `json:"contract_spec_ids,omitempty"`
+}
+
+// ContractSpecification defines the parties and the source of a contract.
+type ContractSpecification struct {
+	SpecificationID string                       `json:"specification_id"`
+	Description     *Description                 `json:"description,omitempty"`
+	OwnerAddresses  []string                     `json:"owner_addresses"`
+	PartiesInvolved []PartyType                  `json:"parties_involved"`
+	Source          *ContractSpecificationSource `json:"source"`
+	ClassName       string                       `json:"class_name"`
+}
+
+// ContractSpecificationSource is the source of a contract. Either resource or hash should be set, but not both.
+type ContractSpecificationSource struct {
+	Resource *ContractSpecificationSourceResource `json:"resource,omitempty"`
+	Hash     *ContractSpecificationSourceHash     `json:"hash,omitempty"`
+}
+
+// ContractSpecificationSourceResource is the address of a record on chain that represents a contract.
+type ContractSpecificationSourceResource struct {
+	ResourceID string `json:"resource_id"`
+}
+
+// ContractSpecificationSourceHash is the hash of an off-chain contract binary.
+type ContractSpecificationSourceHash struct {
+	Hash string `json:"hash"`
+}
+
+// RecordSpecifications is a group of record specifications.
+type RecordSpecifications struct {
+	RecordSpecifications []*RecordSpecification `json:"record_specifications"`
+}
+
+// RecordSpecification defines the inputs and result of a record.
+type RecordSpecification struct {
+	SpecificationID    string                `json:"specification_id"`
+	Name               string                `json:"name"`
+	Inputs             []*InputSpecification `json:"inputs,omitempty"`
+	TypeName           string                `json:"type_name"`
+	ResultType         DefinitionType        `json:"result_type"`
+	ResponsibleParties []PartyType           `json:"responsible_parties"`
+}
+
+// InputSpecification defines an input of a record specification.
+type InputSpecification struct {
+	Name     string             `json:"name"`
+	TypeName string             `json:"type_name"`
+	Source   *RecordInputSource `json:"source"`
+}
+
+// DefinitionType defines the result types of a record specification.
+type DefinitionType string
+
+const (
+	// DefinitionTypeProposed is a concrete definition type.
+	DefinitionTypeProposed DefinitionType = "proposed"
+	// DefinitionTypeRecord is a concrete definition type.
+	DefinitionTypeRecord DefinitionType = "record"
+	// DefinitionTypeRecordList is a concrete definition type.
+	DefinitionTypeRecordList DefinitionType = "record_list"
+	// DefinitionTypeUnspecified is a concrete definition type.
+	DefinitionTypeUnspecified DefinitionType = "unspecified"
+)
+
+// ObjectStoreLocators is a group of object store locators.
+type ObjectStoreLocators struct {
+	Locators []*ObjectStoreLocator `json:"locators"`
+}
+
+// ObjectStoreLocator is the object store uri of an owner.
+type ObjectStoreLocator struct {
+	Owner         string `json:"owner"`
+	LocatorURI    string `json:"locator_uri"`
+	EncryptionKey string `json:"encryption_key,omitempty"`
+	Name          string `json:"name,omitempty"`
+	Priority      uint32 `json:"priority,omitempty"`
+	Retired       bool   `json:"retired,omitempty"`
+}
+
 // A slightly modified, non-panicing version of MetadataAddress.String(). Panics across FFI
 // boundaries can crash the chain, so just fail the query.
 func bech32Address(ma types.MetadataAddress) (string, error) {
@@ -216,11 +310,291 @@ func (scope *Scope) convertToBaseType() (*types.Scope, error) {
 	return baseType, nil
 }
 
+// Convert a provwasm session into the baseType session.
+func (session *Session) convertToBaseType() (*types.Session, error) {
+	sessionID, err := types.MetadataAddressFromBech32(session.SessionID)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid 'session id': %w", err)
+	}
+	specificationID, err := types.MetadataAddressFromBech32(session.SpecificationID)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid 'specification id': %w", err)
+	}
+	parties, err := convertPartiesToBaseType(session.Parties)
+	if err != nil {
+		return nil, err
+	}
+	return &types.Session{
+		SessionId:       sessionID,
+		SpecificationId: specificationID,
+		Parties:         parties,
+		Name:            session.Name,
+		Context:         session.Context,
+	}, nil
+}
+
+// Convert a provwasm record into the baseType record.
+func (record *Record) convertToBaseType() (*types.Record, error) {
+	sessionID, err := types.MetadataAddressFromBech32(record.SessionID)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid 'session id': %w", err)
+	}
+	specificationID, err := types.MetadataAddressFromBech32(record.SpecificationID)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid 'specification id': %w", err)
+	}
+	if record.Process == nil {
+		return nil, fmt.Errorf("wasm: process must be defined for a record")
+	}
+	process, err := record.Process.convertToBaseType()
+	if err != nil {
+		return nil, err
+	}
+	baseType := &types.Record{
+		Name:            record.Name,
+		SessionId:       sessionID,
+		Process:         *process,
+		Inputs:          make([]types.RecordInput, len(record.Inputs)),
+		Outputs:         make([]types.RecordOutput, len(record.Outputs)),
+		SpecificationId: specificationID,
+	}
+	for i, in := range record.Inputs {
+		input, err := in.convertToBaseType()
+		if err != nil {
+			return nil, err
+		}
+		baseType.Inputs[i] = *input
+	}
+	for i, out := range record.Outputs {
+		baseType.Outputs[i] = types.RecordOutput{
+			Hash:   out.Hash,
+			Status: out.Status.convertToBaseType(),
+		}
+	}
+	return baseType, nil
+}
+
+// Convert a provwasm process into the baseType process.
+func (process *Process) convertToBaseType() (*types.Process, error) {
+	baseType := &types.Process{
+		Name:   process.Name,
+		Method: process.Method,
+	}
+	switch {
+	case process.ProcessID != nil && process.ProcessID.Address != nil:
+		baseType.ProcessId = &types.Process_Address{Address: process.ProcessID.Address.Address}
+	case process.ProcessID != nil && process.ProcessID.Hash != nil:
+		baseType.ProcessId = &types.Process_Hash{Hash: process.ProcessID.Hash.Hash}
+	default:
+		return nil, fmt.Errorf("wasm: address or hash must be defined for a process id")
+	}
+	return baseType, nil
+}
+
+// Convert a provwasm record input into the baseType record input.
+func (input *RecordInput) convertToBaseType() (*types.RecordInput, error) {
+	baseType := &types.RecordInput{
+		Name:     input.Name,
+		TypeName: input.TypeName,
+		Status:   input.Status.convertToBaseType(),
+	}
+	switch {
+	case input.Source != nil && input.Source.Record != nil:
+		recordID, err := types.MetadataAddressFromBech32(input.Source.Record.RecordID)
+		if err != nil {
+			return nil, fmt.Errorf("wasm: invalid 'record id': %w", err)
+		}
+		baseType.Source = &types.RecordInput_RecordId{RecordId: recordID}
+	case input.Source != nil && input.Source.Hash != nil:
+		baseType.Source = &types.RecordInput_Hash{Hash: input.Source.Hash.Hash}
+	default:
+		return nil, fmt.Errorf("wasm: hash or record id must be defined for a source")
+	}
+	return baseType, nil
+}
+
+// Convert a provwasm scope specification into the baseType scope specification.
+func (spec *ScopeSpecification) convertToBaseType() (*types.ScopeSpecification, error) {
+	specificationID, err := types.MetadataAddressFromBech32(spec.SpecificationID)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid 'specification id': %w", err)
+	}
+	if err = validateOwnerAddresses(spec.OwnerAddresses); err != nil {
+		return nil, err
+	}
+	baseType := &types.ScopeSpecification{
+		SpecificationId: specificationID,
+		Description:     spec.Description.convertToBaseType(),
+		OwnerAddresses:  spec.OwnerAddresses,
+		PartiesInvolved: convertPartyTypesToBaseType(spec.PartiesInvolved),
+		ContractSpecIds: make([]types.MetadataAddress, len(spec.ContractSpecIDs)),
+	}
+	for i, id := range spec.ContractSpecIDs {
+		baseType.ContractSpecIds[i], err = types.MetadataAddressFromBech32(id)
+		if err != nil {
+			return nil, fmt.Errorf("wasm: invalid 'contract spec id': %w", err)
+		}
+	}
+	return baseType, nil
+}
+
+// Convert a provwasm contract specification into the baseType contract specification.
+func (spec *ContractSpecification) convertToBaseType() (*types.ContractSpecification, error) {
+	specificationID, err := types.MetadataAddressFromBech32(spec.SpecificationID)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid 'specification id': %w", err)
+	}
+	if err = validateOwnerAddresses(spec.OwnerAddresses); err != nil {
+		return nil, err
+	}
+	baseType := &types.ContractSpecification{
+		SpecificationId: specificationID,
+		Description:     spec.Description.convertToBaseType(),
+		OwnerAddresses:  spec.OwnerAddresses,
+		PartiesInvolved: convertPartyTypesToBaseType(spec.PartiesInvolved),
+		ClassName:       spec.ClassName,
+	}
+	switch {
+	case spec.Source != nil && spec.Source.Resource != nil:
+		resourceID, err := types.MetadataAddressFromBech32(spec.Source.Resource.ResourceID)
+		if err != nil {
+			return nil, fmt.Errorf("wasm: invalid 'resource id': %w", err)
+		}
+		baseType.Source = &types.ContractSpecification_ResourceId{ResourceId: resourceID}
+	case spec.Source != nil && spec.Source.Hash != nil:
+		baseType.Source = &types.ContractSpecification_Hash{Hash: spec.Source.Hash.Hash}
+	default:
+		return nil, fmt.Errorf("wasm: resource id or hash must be defined for a source")
+	}
+	return baseType, nil
+}
+
+// Convert a provwasm record specification into the baseType record specification.
+func (spec *RecordSpecification) convertToBaseType() (*types.RecordSpecification, error) {
+	specificationID, err := types.MetadataAddressFromBech32(spec.SpecificationID)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid 'specification id': %w", err)
+	}
+	baseType := &types.RecordSpecification{
+		SpecificationId:    specificationID,
+		Name:               spec.Name,
+		Inputs:             make([]*types.InputSpecification, len(spec.Inputs)),
+		TypeName:           spec.TypeName,
+		ResultType:         spec.ResultType.convertToBaseType(),
+		ResponsibleParties: convertPartyTypesToBaseType(spec.ResponsibleParties),
+	}
+	for i, in := range spec.Inputs {
+		input := &types.InputSpecification{
+			Name:     in.Name,
+			TypeName: in.TypeName,
+		}
+		switch {
+		case in.Source != nil && in.Source.Record != nil:
+			recordID, err := types.MetadataAddressFromBech32(in.Source.Record.RecordID)
+			if err != nil {
+				return nil, fmt.Errorf("wasm: invalid 'record id': %w", err)
+			}
+			input.Source = &types.InputSpecification_RecordId{RecordId: recordID}
+		case in.Source != nil && in.Source.Hash != nil:
+			input.Source = &types.InputSpecification_Hash{Hash: in.Source.Hash.Hash}
+		default:
+			return nil, fmt.Errorf("wasm: hash or record id must be defined for a source")
+		}
+		baseType.Inputs[i] = input
+	}
+	return baseType, nil
+}
+
+// Convert a provwasm description into the baseType description.
+func (description *Description) convertToBaseType() *types.Description {
+	if description == nil {
+		return nil
+	}
+	return &types.Description{
+		Name:        description.Name,
+		Description: description.Description,
+		WebsiteUrl:  description.WebsiteURL,
+		IconUrl:     description.IconURL,
+	}
+}
+
+// Convert a provwasm definition type into the baseType definition type.
+func (definitionType DefinitionType) convertToBaseType() types.DefinitionType {
+	switch definitionType {
+	case DefinitionTypeProposed:
+		return types.DefinitionType_DEFINITION_TYPE_PROPOSED
+	case DefinitionTypeRecord:
+		return types.DefinitionType_DEFINITION_TYPE_RECORD
+	case DefinitionTypeRecordList:
+		return types.DefinitionType_DEFINITION_TYPE_RECORD_LIST
+	default:
+		return types.DefinitionType_DEFINITION_TYPE_UNSPECIFIED
+	}
+}
+
+// Convert a provwasm record input status into the baseType record input status.
+func (status InputStatus) convertToBaseType() types.RecordInputStatus {
+	switch status {
+	case InputStatusProposed:
+		return types.RecordInputStatus_Proposed
+	case InputStatusRecord:
+		return types.RecordInputStatus_Record
+	default:
+		return types.RecordInputStatus_Unknown
+	}
+}
+
+// Convert a provwasm result status into the baseType result status.
+func (status ResultStatus) convertToBaseType() types.ResultStatus {
+	switch status {
+	case ResultStatusPass:
+		return types.ResultStatus_RESULT_STATUS_PASS
+	case ResultStatusFail:
+		return types.ResultStatus_RESULT_STATUS_FAIL
+	case ResultStatusSkip:
+		return types.ResultStatus_RESULT_STATUS_SKIP
+	default:
+		return types.ResultStatus_RESULT_STATUS_UNSPECIFIED
+	}
+}
+
+// Convert a slice of provwasm parties into baseType parties.
+func convertPartiesToBaseType(parties []*Party) ([]types.Party, error) {
+	baseTypes := make([]types.Party, len(parties))
+	for i, p := range parties {
+		party, err := p.convertToBaseType()
+		if err != nil {
+			return nil, err
+		}
+		baseTypes[i] = *party
+	}
+	return baseTypes, nil
+}
+
+// Convert a slice of provwasm party types into baseType party types.
+func convertPartyTypesToBaseType(partyTypes []PartyType) []types.PartyType {
+	baseTypes := make([]types.PartyType, len(partyTypes))
+	for i := range partyTypes {
+		baseTypes[i] = partyTypes[i].convertToBaseType()
+	}
+	return baseTypes
+}
+
+// verify the specification owner addresses are valid
+func validateOwnerAddresses(owners []string) error {
+	for _, addr := range owners {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return fmt.Errorf("wasm: invalid 'owner_addresses' address: %w", err)
+		}
+	}
+	return nil
+}
+
 // Convert a provwasm party into the baseType party.
 func (party *Party) convertToBaseType() (*types.Party, error) {
 	_, err := sdk.AccAddressFromBech32(party.Address)
 	if err != nil {
-		return nil, fmt.Errorf("wasm: invalid 'data_access' address: %w", err)
+		return nil, fmt.Errorf("wasm: invalid 'party' address: %w", err)
 	}
 	return &types.Party{
 		Address: party.Address,
@@ -434,6 +808,179 @@ func createSource(baseType types.RecordInput) (*RecordInputSource, error) {
 	return nil, fmt.Errorf("wasm: hash or record id must be defined for a source")
 }
 
+// Convert a scope specification into provwasm JSON format.
+func createScopeSpecificationResponse(baseType types.ScopeSpecification) ([]byte, error) {
+	specificationID, err := bech32Address(baseType.SpecificationId)
+	if err != nil {
+		return nil, err
+	}
+	spec := &ScopeSpecification{
+		SpecificationID: specificationID,
+		Description:     createDescription(baseType.Description),
+		OwnerAddresses:  baseType.OwnerAddresses,
+		PartiesInvolved: createRoles(baseType.PartiesInvolved),
+		ContractSpecIDs: make([]string, len(baseType.ContractSpecIds)),
+	}
+	for i, id := range baseType.ContractSpecIds {
+		spec.ContractSpecIDs[i], err = bech32Address(id)
+		if err != nil {
+			return nil, err
+		}
+	}
+	bz, err := json.Marshal(spec)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: marshal scope specification failed: %w", err)
+	}
+	return bz, nil
+}
+
+// Convert a contract specification into provwasm JSON format.
+func createContractSpecificationResponse(baseType types.ContractSpecification) ([]byte, error) {
+	specificationID, err := bech32Address(baseType.SpecificationId)
+	if err != nil {
+		return nil, err
+	}
+	spec := &ContractSpecification{
+		SpecificationID: specificationID,
+		Description:     createDescription(baseType.Description),
+		OwnerAddresses:  baseType.OwnerAddresses,
+		PartiesInvolved: createRoles(baseType.PartiesInvolved),
+		Source:          &ContractSpecificationSource{},
+		ClassName:       baseType.ClassName,
+	}
+	switch s := baseType.Source.(type) {
+	case *types.ContractSpecification_ResourceId:
+		resourceID, err := bech32Address(s.ResourceId)
+		if err != nil {
+			return nil, err
+		}
+		spec.Source.Resource = &ContractSpecificationSourceResource{ResourceID: resourceID}
+	case *types.ContractSpecification_Hash:
+		spec.Source.Hash = &ContractSpecificationSourceHash{Hash: s.Hash}
+	default:
+		return nil, fmt.Errorf("wasm: resource id or hash must be defined for a source")
+	}
+	bz, err := json.Marshal(spec)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: marshal contract specification failed: %w", err)
+	}
+	return bz, nil
+}
+
+// Convert a slice of record specifications into provwasm JSON format.
+func createRecordSpecificationsResponse(baseTypeSlice []*types.RecordSpecification) ([]byte, error) {
+	specs := &RecordSpecifications{
+		RecordSpecifications: make([]*RecordSpecification, len(baseTypeSlice)),
+	}
+	for i, baseType := range baseTypeSlice {
+		spec, err := createRecordSpecification(baseType)
+		if err != nil {
+			return nil, err
+		}
+		specs.RecordSpecifications[i] = spec
+	}
+	bz, err := json.Marshal(specs)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: marshal record specifications failed: %w", err)
+	}
+	return bz, nil
+}
+
+// Convert a record specification into its provwasm type.
+func createRecordSpecification(baseType *types.RecordSpecification) (*RecordSpecification, error) {
+	specificationID, err := bech32Address(baseType.SpecificationId)
+	if err != nil {
+		return nil, err
+	}
+	spec := &RecordSpecification{
+		SpecificationID:    specificationID,
+		Name:               baseType.Name,
+		Inputs:             make([]*InputSpecification, len(baseType.Inputs)),
+		TypeName:           baseType.TypeName,
+		ResultType:         createDefinitionType(baseType.ResultType),
+		ResponsibleParties: createRoles(baseType.ResponsibleParties),
+	}
+	for i, in := range baseType.Inputs {
+		input := &InputSpecification{
+			Name:     in.Name,
+			TypeName: in.TypeName,
+			Source:   &RecordInputSource{},
+		}
+		switch s := in.Source.(type) {
+		case *types.InputSpecification_RecordId:
+			recordID, err := bech32Address(s.RecordId)
+			if err != nil {
+				return nil, err
+			}
+			input.Source.Record = &RecordInputSourceRecord{RecordID: recordID}
+		case *types.InputSpecification_Hash:
+			input.Source.Hash = &RecordInputSourceHash{Hash: s.Hash}
+		default:
+			return nil, fmt.Errorf("wasm: hash or record id must be defined for a source")
+		}
+		spec.Inputs[i] = input
+	}
+	return spec, nil
+}
+
+// Convert a slice of object store locators into provwasm JSON format.
+func createObjectStoreLocatorsResponse(baseTypeSlice []types.ObjectStoreLocator) ([]byte, error) {
+	locators := &ObjectStoreLocators{
+		Locators: make([]*ObjectStoreLocator, len(baseTypeSlice)),
+	}
+	for i, baseType := range baseTypeSlice {
+		locators.Locators[i] = &ObjectStoreLocator{
+			Owner:         baseType.Owner,
+			LocatorURI:    baseType.LocatorUri,
+			EncryptionKey: baseType.EncryptionKey,
+			Name:          baseType.Name,
+			Priority:      baseType.Priority,
+			Retired:       baseType.State == types.ObjectStoreLocatorState_OBJECT_STORE_LOCATOR_STATE_RETIRED,
+		}
+	}
+	bz, err := json.Marshal(locators)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: marshal object store locators failed: %w", err)
+	}
+	return bz, nil
+}
+
+// Convert a description to its provwasm type.
+func createDescription(baseType *types.Description) *Description {
+	if baseType == nil {
+		return nil
+	}
+	return &Description{
+		Name:        baseType.Name,
+		Description: baseType.Description,
+		WebsiteURL:  baseType.WebsiteUrl,
+		IconURL:     baseType.IconUrl,
+	}
+}
+
+// Convert a slice of party types to their provwasm type.
+func createRoles(baseTypes []types.PartyType) []PartyType {
+	roles := make([]PartyType, len(baseTypes))
+	for i, baseType := range baseTypes {
+		roles[i] = createRole(baseType)
+	}
+	return roles
+}
+
+// Convert a definition type to its provwasm type.
+func createDefinitionType(baseType types.DefinitionType) DefinitionType {
+	switch baseType {
+	case types.DefinitionType_DEFINITION_TYPE_PROPOSED:
+		return DefinitionTypeProposed
+	case types.DefinitionType_DEFINITION_TYPE_RECORD:
+		return DefinitionTypeRecord
+	case types.DefinitionType_DEFINITION_TYPE_RECORD_LIST:
+		return DefinitionTypeRecordList
+	default:
+		return DefinitionTypeUnspecified
+	}
+}
+
 // Convert a party to its provwasm type.
 func createParty(baseType types.Party) *Party {
 	return &Party{
@@ -194,15 +194,18 @@ func (s *IntegrationTestSuite) TestGetNameParamsCmd() {
 		{
 			"json output",
 			[]string{fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
-			"{\"max_segment_length\":32,\"min_segment_length\":1,\"max_name_levels\":2,\"allow_unrestricted_names\":true}",
+			"{\"max_segment_length\":32,\"min_segment_length\":1,\"max_name_levels\":2,\"allow_unrestricted_names\":true,\"lease_duration\":\"0s\",\"renewal_fee\":[],\"grace_period\":\"0s\"}",
 		},
 		{
 			"text output",
 			[]string{fmt.Sprintf("--%s=text", tmcli.OutputFlag)},
 			`allow_unrestricted_names: true
+grace_period: 0s
+lease_duration: 0s
 max_name_levels: 2
 max_segment_length: 32
-min_segment_length: 1`,
+min_segment_length: 1
+renewal_fee: []`,
 		},
 	}
 
@@ -17,6 +17,9 @@ import (
 	"github.com/provenance-io/provenance/x/name/types"
 )
 
+// The flag for the number of levels below a name to include when listing children.
+const flagDepth = "depth"
+
 // GetQueryCmd is the top-level command for name CLI queries.
 func GetQueryCmd() *cobra.Command {
 	queryCmd := &cobra.Command{
@@ -31,6 +34,8 @@ func GetQueryCmd() *cobra.Command {
 		QueryParamsCmd(),
 		ResolveNameCommand(),
 		ReverseLookupCommand(),
+		ChildrenCommand(),
+		PrimaryNameCommand(),
 	)
 
 	return queryCmd
@@ -101,9 +106,10 @@ func ResolveNameCommand() *cobra.Command {
 func ReverseLookupCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "lookup [address]",
-		Short: "Reverse lookup of all names bound to a given address",
+		Short: "Reverse lookup of all names bound to a given account or metadata address",
 		Example: fmt.Sprintf(`$ %[1]s query name lookup pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk
 $ %[1]s query name lookup pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk --page=2 --limit=100
+$ %[1]s query name lookup scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel
 `, version.AppName),
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -118,19 +124,21 @@ $ %[1]s query name lookup pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk --page=2 --l
 				return err
 			}
 
-			address, err := sdk.AccAddressFromBech32(args[0])
-			if err != nil {
-				return fmt.Errorf("account address must be a Bech32 string: %w", err)
+			if _, err = types.ParseTargetAddress(args[0]); err != nil {
+				return fmt.Errorf("address must be a Bech32 account or metadata address: %w", err)
 			}
 
 			var response *types.QueryReverseLookupResponse
 			if response, err = queryClient.ReverseLookup(
 				context.Background(),
-				&types.QueryReverseLookupRequest{Address: address.String(), Pagination: pageReq},
+				&types.QueryReverseLookupRequest{Address: args[0], Pagination: pageReq},
 			); err != nil {
-				fmt.Printf("failed to query reverse lookup against \"%s\": %v\n", address, err)
+				fmt.Printf("failed to query reverse lookup against \"%s\": %v\n", args[0], err)
 				return nil
 			}
+			for i := range response.Name {
+				response.Name[i] = types.NameToUnicode(response.Name[i])
+			}
 			return clientCtx.PrintProto(response)
 		},
 	}
@@ -141,6 +149,95 @@ $ %[1]s query name lookup pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk --page=2 --l
 	return cmd
 }
 
+// ChildrenCommand returns the command handler for listing the names bound under a name.
+func ChildrenCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "children [name]",
+		Short: "List the names bound under a given name",
+		Example: fmt.Sprintf(`$ %[1]s query name children example.root
+$ %[1]s query name children example.root --depth=3 --page=2 --limit=100
+`, version.AppName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+
+			depth, err := cmd.Flags().GetUint32(flagDepth)
+			if err != nil {
+				return err
+			}
+
+			name := strings.ToLower(strings.TrimSpace(args[0]))
+			var response *types.QueryChildrenResponse
+			if response, err = queryClient.Children(
+				context.Background(),
+				&types.QueryChildrenRequest{Name: name, Depth: depth, Pagination: pageReq},
+			); err != nil {
+				fmt.Printf("failed to query children of \"%s\": %v\n", name, err)
+				return nil
+			}
+			for i := range response.Records {
+				response.Records[i].Name = types.NameToUnicode(response.Records[i].Name)
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	cmd.Flags().Uint32(flagDepth, 1, "the number of levels below the name to include")
+	flags.AddPaginationFlagsToCmd(cmd, "get")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// PrimaryNameCommand returns the command handler for getting the primary name of an address.
+func PrimaryNameCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "primary [address]",
+		Short: "Get the primary name of a given address",
+		Long: `Get the primary name of a given address.
+If the address has not designated a primary name, the name bound to the address with the fewest segments is returned,
+using the shortest name and then alphabetical order to break ties.`,
+		Example: fmt.Sprintf(`$ %s query name primary pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			address, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return fmt.Errorf("account address must be a Bech32 string: %w", err)
+			}
+
+			var response *types.QueryPrimaryNameResponse
+			if response, err = queryClient.PrimaryName(
+				context.Background(),
+				&types.QueryPrimaryNameRequest{Address: address.String()},
+			); err != nil {
+				fmt.Printf("failed to query primary name of \"%s\": %v\n", address, err)
+				return nil
+			}
+			response.Record.Name = types.NameToUnicode(response.Record.Name)
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // sdk ReadPageRequest expects binary but we encoded to base64 in our marshaller
 func withPageKeyDecoded(flagSet *flag.FlagSet) *flag.FlagSet {
 	encoded, err := flagSet.GetString(flags.FlagPageKey)
@@ -9,7 +9,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
-	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
 
 	"github.com/spf13/cobra"
@@ -31,6 +30,9 @@ func NewTxCmd() *cobra.Command {
 	txCmd.AddCommand(
 		GetBindNameCmd(),
 		GetDeleteNameCmd(),
+		GetModifyNameCmd(),
+		GetRenewNameCmd(),
+		GetSetPrimaryNameCmd(),
 	)
 	return txCmd
 }
@@ -38,25 +40,28 @@ func NewTxCmd() *cobra.Command {
 // GetBindNameCmd is the CLI command for binding a name to an address.
 func GetBindNameCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "bind [name] [address] [root]",
-		Short:   "Bind a name to an address under the given root name in the provenance blockchain",
-		Example: fmt.Sprintf(`$ %s tx name bind sample pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk root.example`, version.AppName),
-		Args:    cobra.ExactArgs(3),
+		Use:   "bind [name] [address] [root]",
+		Short: "Bind a name to an address under the given root name in the provenance blockchain",
+		Long: `Bind a name to an address under the given root name.
+The address can be an account address, or the metadata address of a scope, scope specification or contract specification
+owned by the signer.`,
+		Example: fmt.Sprintf(`$ %[1]s tx name bind sample pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk root.example
+$ %[1]s tx name bind loanpool7 scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel sc.pb`, version.AppName),
+		Args: cobra.ExactArgs(3),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
-			address, err := sdk.AccAddressFromBech32(args[1])
-			if err != nil {
+			if _, err = types.ParseTargetAddress(args[1]); err != nil {
 				return err
 			}
 			msg := types.NewMsgBindNameRequest(
-				types.NewNameRecord(
-					strings.ToLower(args[0]),
-					address,
-					viper.GetBool(flagRestricted),
-				),
+				types.NameRecord{
+					Name:       strings.ToLower(args[0]),
+					Address:    args[1],
+					Restricted: viper.GetBool(flagRestricted),
+				},
 				types.NewNameRecord(
 					strings.ToLower(args[2]),
 					clientCtx.FromAddress,
@@ -97,3 +102,95 @@ func GetDeleteNameCmd() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetModifyNameCmd is the CLI command for changing the address and restricted flag of a bound name.
+func GetModifyNameCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "modify-name [name] [new_address]",
+		Short: "Change the owner address and restricted flag of a bound name in the provenance blockchain",
+		Long: `Change the owner address and restricted flag of a bound name.
+The transaction must be signed by the current owner of the name.`,
+		Example: fmt.Sprintf(`$ %s tx name modify-name sample.root.example pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk --restrict=false`, version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			if _, err = types.ParseTargetAddress(args[1]); err != nil {
+				return err
+			}
+			restricted, err := cmd.Flags().GetBool(flagRestricted)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgModifyNameRequest(
+				clientCtx.GetFromAddress().String(),
+				types.NameRecord{
+					Name:       strings.TrimSpace(strings.ToLower(args[0])),
+					Address:    args[1],
+					Restricted: restricted,
+				},
+			)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().BoolP(flagRestricted, "r", true, "Restrict creation of child names to owner only")
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetRenewNameCmd is the CLI command for extending the lease on a bound name.
+func GetRenewNameCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "renew-name [name]",
+		Short: "Extend the lease on a bound name in the provenance blockchain",
+		Long: `Extend the lease on a bound name by the lease duration in the name module params.
+The transaction must be signed by the current owner of the name, who is charged the renewal fee.`,
+		Example: fmt.Sprintf(`$ %s tx name renew-name sample.root.example`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgRenewNameRequest(
+				strings.TrimSpace(strings.ToLower(args[0])),
+				clientCtx.GetFromAddress(),
+			)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetSetPrimaryNameCmd is the CLI command for designating the primary name of the signer.
+func GetSetPrimaryNameCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-primary [name]",
+		Short: "Designate a name bound to the signer as its primary name in the provenance blockchain",
+		Long: `Designate a name bound to the signer as its primary name.
+Omit the name to clear the primary name.`,
+		Example: fmt.Sprintf(`$ %[1]s tx name set-primary sample.root.example
+$ %[1]s tx name set-primary`, version.AppName),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			name := ""
+			if len(args) > 0 {
+				name = strings.TrimSpace(strings.ToLower(args[0]))
+			}
+			msg := types.NewMsgSetPrimaryNameRequest(name, clientCtx.GetFromAddress())
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -92,7 +92,7 @@ func TestCreateName(t *testing.T) {
 
 	app.NameKeeper.InitGenesis(ctx, nameData)
 
-	app.NameKeeper = keeper.NewKeeper(app.AppCodec(), app.GetKey(nametypes.ModuleName), app.GetSubspace(nametypes.ModuleName))
+	app.NameKeeper = keeper.NewKeeper(app.AppCodec(), app.GetKey(nametypes.ModuleName), app.GetSubspace(nametypes.ModuleName), app.BankKeeper, app.MetadataKeeper, app.NameKeeper.GetAuthority())
 	handler := name.NewHandler(app.NameKeeper)
 
 	for _, tc := range tests {
@@ -152,7 +152,7 @@ func TestDeleteName(t *testing.T) {
 
 	app.NameKeeper.InitGenesis(ctx, nameData)
 
-	app.NameKeeper = keeper.NewKeeper(app.AppCodec(), app.GetKey(nametypes.ModuleName), app.GetSubspace(nametypes.ModuleName))
+	app.NameKeeper = keeper.NewKeeper(app.AppCodec(), app.GetKey(nametypes.ModuleName), app.GetSubspace(nametypes.ModuleName), app.BankKeeper, app.MetadataKeeper, app.NameKeeper.GetAuthority())
 	handler := name.NewHandler(app.NameKeeper)
 
 	for _, tc := range tests {
@@ -170,3 +170,79 @@ func TestDeleteName(t *testing.T) {
 		})
 	}
 }
+
+// modify name record
+func TestModifyName(t *testing.T) {
+	priv1 := secp256k1.GenPrivKey()
+	addr1 := sdk.AccAddress(priv1.PubKey().Address())
+	priv2 := secp256k1.GenPrivKey()
+	addr2 := sdk.AccAddress(priv2.PubKey().Address())
+
+	acc1 := &authtypes.BaseAccount{
+		Address: addr1.String(),
+	}
+	accs := authtypes.GenesisAccounts{acc1}
+	app := simapp.SetupWithGenesisAccounts(t, "", accs)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	authority := app.NameKeeper.GetAuthority()
+
+	var nameData nametypes.GenesisState
+	nameData.Bindings = append(nameData.Bindings, nametypes.NewNameRecord("name", addr1, false))
+	nameData.Bindings = append(nameData.Bindings, nametypes.NewNameRecord("example.name", addr1, false))
+	nameData.Bindings = append(nameData.Bindings, nametypes.NewNameRecord("root", addr2, true))
+	nameData.Params.AllowUnrestrictedNames = false
+	nameData.Params.MaxNameLevels = 16
+	nameData.Params.MinSegmentLength = 2
+	nameData.Params.MaxSegmentLength = 16
+
+	app.NameKeeper.InitGenesis(ctx, nameData)
+	handler := name.NewHandler(app.NameKeeper)
+
+	tests := []struct {
+		name          string
+		expectedError error
+		msg           *nametypes.MsgModifyNameRequest
+		expectedEvent proto.Message
+	}{
+		{
+			name:          "modify name record by owner",
+			msg:           nametypes.NewMsgModifyNameRequest(addr1.String(), nametypes.NewNameRecord("example.name", addr2, true)),
+			expectedEvent: nametypes.NewEventNameUpdate(addr2.String(), "example.name", true),
+		},
+		{
+			name:          "modify name record by previous owner",
+			msg:           nametypes.NewMsgModifyNameRequest(addr1.String(), nametypes.NewNameRecord("example.name", addr1, false)),
+			expectedError: sdkerrors.ErrUnauthorized.Wrap("msg sender cannot modify name"),
+		},
+		{
+			name:          "modify non-root name record by authority",
+			msg:           nametypes.NewMsgModifyNameRequest(authority, nametypes.NewNameRecord("example.name", addr1, false)),
+			expectedError: sdkerrors.ErrUnauthorized.Wrap("msg sender cannot modify name"),
+		},
+		{
+			name:          "modify root name record by authority",
+			msg:           nametypes.NewMsgModifyNameRequest(authority, nametypes.NewNameRecord("root", addr1, false)),
+			expectedEvent: nametypes.NewEventNameUpdate(addr1.String(), "root", false),
+		},
+		{
+			name:          "modify name record that does not exist",
+			msg:           nametypes.NewMsgModifyNameRequest(addr1.String(), nametypes.NewNameRecord("unknown.name", addr1, false)),
+			expectedError: sdkerrors.ErrInvalidRequest.Wrap("name does not exist"),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			response, err := handler(ctx, tc.msg)
+			if tc.expectedError != nil {
+				require.EqualError(t, err, tc.expectedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			if tc.expectedEvent != nil {
+				result := containsMessage(response, tc.expectedEvent)
+				require.True(t, result, fmt.Sprintf("Expected typed event was not found: %v", tc.expectedEvent))
+			}
+		})
+	}
+}
@@ -10,11 +10,35 @@ import (
 func (keeper Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 	keeper.SetParams(ctx, data.Params)
 	for _, record := range data.Bindings {
-		addr, err := sdk.AccAddressFromBech32(record.Address)
+		if types.IsMetadataAddress(record.Address) {
+			target, err := types.ParseMetadataTarget(record.Address)
+			if err != nil {
+				panic(err)
+			}
+			if err = keeper.SetMetadataNameRecord(ctx, record.Name, target, record.Restricted); err != nil {
+				panic(err)
+			}
+		} else {
+			addr, err := sdk.AccAddressFromBech32(record.Address)
+			if err != nil {
+				panic(err)
+			}
+			if err = keeper.SetNameRecord(ctx, record.Name, addr, record.Restricted); err != nil {
+				panic(err)
+			}
+		}
+		if record.Expiration != nil {
+			if err := keeper.SetNameExpiration(ctx, record.Name, record.Expiration); err != nil {
+				panic(err)
+			}
+		}
+	}
+	for _, primary := range data.PrimaryNames {
+		addr, err := sdk.AccAddressFromBech32(primary.Address)
 		if err != nil {
 			panic(err)
 		}
-		if err := keeper.SetNameRecord(ctx, record.Name, addr, record.Restricted); err != nil {
+		if err := keeper.SetPrimaryName(ctx, addr, primary.Name); err != nil {
 			panic(err)
 		}
 	}
@@ -34,5 +58,10 @@ func (keeper Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	if err := keeper.IterateRecords(ctx, types.NameKeyPrefix, appendToRecords); err != nil {
 		panic(err)
 	}
-	return types.NewGenesisState(params, records)
+	genesis := types.NewGenesisState(params, records)
+	keeper.IteratePrimaryNames(ctx, func(primary types.PrimaryName) bool {
+		genesis.PrimaryNames = append(genesis.PrimaryNames, primary)
+		return false
+	})
+	return genesis
 }
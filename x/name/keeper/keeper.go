@@ -14,6 +14,7 @@ import (
 
 	uuid "github.com/google/uuid"
 
+	metadatatypes "github.com/provenance-io/provenance/x/metadata/types"
 	"github.com/provenance-io/provenance/x/name/types"
 )
 
@@ -30,6 +31,15 @@ type Keeper struct {
 
 	// The codec codec for binary encoding/decoding.
 	cdc codec.BinaryCodec
+
+	// The bank keeper used to collect name renewal fees.
+	bankKeeper types.BankKeeper
+
+	// The metadata keeper used to look up the owners of metadata objects that names are bound to.
+	metadataKeeper types.MetadataKeeper
+
+	// The address allowed to modify root names (usually the gov module account).
+	authority string
 }
 
 // NewKeeper returns a name keeper. It handles:
@@ -41,6 +51,9 @@ func NewKeeper(
 	cdc codec.BinaryCodec,
 	key storetypes.StoreKey,
 	paramSpace paramtypes.Subspace,
+	bankKeeper types.BankKeeper,
+	metadataKeeper types.MetadataKeeper,
+	authority string,
 ) Keeper {
 	// set KeyTable if it has not already been set
 	if !paramSpace.HasKeyTable() {
@@ -48,12 +61,20 @@ func NewKeeper(
 	}
 
 	return Keeper{
-		storeKey:   key,
-		paramSpace: paramSpace,
-		cdc:        cdc,
+		storeKey:       key,
+		paramSpace:     paramSpace,
+		cdc:            cdc,
+		bankKeeper:     bankKeeper,
+		metadataKeeper: metadataKeeper,
+		authority:      authority,
 	}
 }
 
+// GetAuthority returns the address allowed to modify root names.
+func (keeper Keeper) GetAuthority() string {
+	return keeper.authority
+}
+
 // Logger returns a module-specific logger.
 func (keeper Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+types.ModuleName)
@@ -77,7 +98,24 @@ func (keeper Keeper) SetNameRecord(ctx sdk.Context, name string, addr sdk.AccAdd
 	if err = types.ValidateAddress(addr); err != nil {
 		return types.ErrInvalidAddress.Wrap(err.Error())
 	}
-	key, err := types.GetNameKeyPrefix(name)
+	return keeper.setNewRecord(ctx, types.NewNameRecord(name, addr, restrict))
+}
+
+// SetMetadataNameRecord binds a name to a scope, scope specification or contract specification.
+func (keeper Keeper) SetMetadataNameRecord(ctx sdk.Context, name string, target metadatatypes.MetadataAddress, restrict bool) error {
+	var err error
+	if name, err = keeper.Normalize(ctx, name); err != nil {
+		return err
+	}
+	if _, err = types.ParseMetadataTarget(target.String()); err != nil {
+		return err
+	}
+	return keeper.setNewRecord(ctx, types.NameRecord{Name: name, Address: target.String(), Restricted: restrict})
+}
+
+// setNewRecord stores a record for a normalized name that is not yet bound.
+func (keeper Keeper) setNewRecord(ctx sdk.Context, record types.NameRecord) error {
+	key, err := types.GetNameKeyPrefix(record.Name)
 	if err != nil {
 		return err
 	}
@@ -85,24 +123,14 @@ func (keeper Keeper) SetNameRecord(ctx sdk.Context, name string, addr sdk.AccAdd
 	if store.Has(key) {
 		return types.ErrNameAlreadyBound
 	}
-	record := types.NewNameRecord(name, addr, restrict)
 	if err = record.ValidateBasic(); err != nil {
 		return err
 	}
-	bz, err := keeper.cdc.Marshal(&record)
-	if err != nil {
-		return err
-	}
-	store.Set(key, bz)
-	// Now index by address
-	addrPrefix, err := types.GetAddressKeyPrefix(addr)
-	if err != nil {
+	if err = keeper.setRecord(store, key, &record); err != nil {
 		return err
 	}
-	addrPrefix = append(addrPrefix, key...) // [0x04] :: [addr-bytes] :: [name-key-bytes]
-	store.Set(addrPrefix, bz)
 
-	nameBoundEvent := types.NewEventNameBound(record.Address, name, record.Restricted)
+	nameBoundEvent := types.NewEventNameBound(record.Address, record.Name, record.Restricted)
 
 	if err := ctx.EventManager().EmitTypedEvent(nameBoundEvent); err != nil {
 		return err
@@ -151,12 +179,13 @@ func (keeper Keeper) NameExists(ctx sdk.Context, name string) bool {
 }
 
 // GetRecordsByAddress looks up all names bound to an address.
+// The address can also be the bytes of a metadata address (see types.ParseTargetAddress).
 func (keeper Keeper) GetRecordsByAddress(ctx sdk.Context, address sdk.AccAddress) (types.NameRecords, error) {
 	// Return value data structure.
 	records := types.NameRecords{}
-	// Handler that adds records if account address matches.
+	// Handler that adds records if the target address matches.
 	appendToRecords := func(record types.NameRecord) error {
-		if record.Address == address.String() {
+		if target, err := types.ParseTargetAddress(record.Address); err == nil && target.Equals(address) {
 			records = append(records, record)
 		}
 		return nil
@@ -180,7 +209,7 @@ func (keeper Keeper) DeleteRecord(ctx sdk.Context, name string) error {
 	if err != nil {
 		return err
 	}
-	address, err := sdk.AccAddressFromBech32(record.Address)
+	address, err := types.ParseTargetAddress(record.Address)
 	if err != nil {
 		return err
 	}
@@ -200,6 +229,27 @@ func (keeper Keeper) DeleteRecord(ctx sdk.Context, name string) error {
 	if store.Has(addrPrefix) {
 		store.Delete(addrPrefix)
 	}
+	// Delete the parent index records
+	parentKeys, err := types.GetParentIndexKeys(record.Name)
+	if err != nil {
+		return err
+	}
+	for _, parentKey := range parentKeys {
+		store.Delete(parentKey)
+	}
+	// Delete the expiration index record
+	if record.Expiration != nil {
+		expKey, err := types.GetExpirationKey(record.Name, *record.Expiration)
+		if err != nil {
+			return err
+		}
+		store.Delete(expKey)
+	}
+
+	// A deleted name can no longer be the primary name of its owner.
+	if err = keeper.clearPrimaryName(ctx, address, record.Name); err != nil {
+		return err
+	}
 
 	nameUnboundEvent := types.NewEventNameUnbound(record.Address, name, record.Restricted)
 
@@ -210,6 +260,80 @@ func (keeper Keeper) DeleteRecord(ctx sdk.Context, name string) error {
 	return nil
 }
 
+// ModifyNameRecord changes the address and restricted flag of an existing name.
+// The name record and its address index are updated together.
+func (keeper Keeper) ModifyNameRecord(ctx sdk.Context, name string, addr sdk.AccAddress, restrict bool) error {
+	var err error
+	if name, err = keeper.Normalize(ctx, name); err != nil {
+		return err
+	}
+	if err = types.ValidateAddress(addr); err != nil {
+		return types.ErrInvalidAddress.Wrap(err.Error())
+	}
+	return keeper.modifyRecord(ctx, types.NewNameRecord(name, addr, restrict))
+}
+
+// ModifyMetadataNameRecord changes an existing name to be bound to a scope, scope specification or contract specification.
+func (keeper Keeper) ModifyMetadataNameRecord(ctx sdk.Context, name string, target metadatatypes.MetadataAddress, restrict bool) error {
+	var err error
+	if name, err = keeper.Normalize(ctx, name); err != nil {
+		return err
+	}
+	if _, err = types.ParseMetadataTarget(target.String()); err != nil {
+		return err
+	}
+	return keeper.modifyRecord(ctx, types.NameRecord{Name: name, Address: target.String(), Restricted: restrict})
+}
+
+// modifyRecord replaces the record of a normalized name that is already bound, keeping its expiration.
+func (keeper Keeper) modifyRecord(ctx sdk.Context, record types.NameRecord) error {
+	name := record.Name
+	existing, err := keeper.GetRecordByName(ctx, name)
+	if err != nil {
+		return err
+	}
+	existingAddr, err := types.ParseTargetAddress(existing.Address)
+	if err != nil {
+		return err
+	}
+	key, err := types.GetNameKeyPrefix(name)
+	if err != nil {
+		return err
+	}
+	record.Expiration = existing.Expiration
+	if err = record.ValidateBasic(); err != nil {
+		return err
+	}
+	store := ctx.KVStore(keeper.storeKey)
+	// Remove the old address index record
+	oldAddrPrefix, err := types.GetAddressKeyPrefix(existingAddr)
+	if err != nil {
+		return err
+	}
+	oldAddrPrefix = append(oldAddrPrefix, key...) // [0x05] :: [addr-bytes] :: [name-key-bytes]
+	if store.Has(oldAddrPrefix) {
+		store.Delete(oldAddrPrefix)
+	}
+	// Update the main name record and index it by its new address.
+	if err = keeper.setRecord(store, key, &record); err != nil {
+		return err
+	}
+	// A transferred name can no longer be the primary name of its previous owner.
+	if existing.Address != record.Address {
+		if err = keeper.clearPrimaryName(ctx, existingAddr, name); err != nil {
+			return err
+		}
+	}
+
+	nameUpdateEvent := types.NewEventNameUpdate(record.Address, name, record.Restricted)
+
+	if err := ctx.EventManager().EmitTypedEvent(nameUpdateEvent); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // IterateRecords iterates over all the stored name records and passes them to a callback function.
 func (keeper Keeper) IterateRecords(ctx sdk.Context, prefix []byte, handle Handler) error {
 	// Init a name record iterator
@@ -224,6 +348,10 @@ func (keeper Keeper) IterateRecords(ctx sdk.Context, prefix []byte, handle Handl
 			if err := types.ModuleCdc.Unmarshal(iterator.Value(), &record); err != nil {
 				return err
 			}
+			// Amino decodes a missing timestamp as the unix epoch, but legacy records never had leases.
+			if record.Expiration != nil && record.Expiration.Unix() == 0 {
+				record.Expiration = nil
+			}
 		} else {
 			if err := keeper.cdc.Unmarshal(iterator.Value(), &record); err != nil {
 				return err
@@ -236,11 +364,170 @@ func (keeper Keeper) IterateRecords(ctx sdk.Context, prefix []byte, handle Handl
 	return nil
 }
 
+// BuildParentIndexes indexes every stored name record under each of its parent names.
+func (keeper Keeper) BuildParentIndexes(ctx sdk.Context) error {
+	records := types.NameRecords{}
+	appendToRecords := func(record types.NameRecord) error {
+		records = append(records, record)
+		return nil
+	}
+	if err := keeper.IterateRecords(ctx, types.NameKeyPrefix, appendToRecords); err != nil {
+		return err
+	}
+	store := ctx.KVStore(keeper.storeKey)
+	for i := range records {
+		key, err := types.GetNameKeyPrefix(records[i].Name)
+		if err != nil {
+			return err
+		}
+		if err = setParentIndexes(store, records[i].Name, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ConvertUnicodeNames re-binds name records stored with raw unicode segments under their punycode form,
+// keeping their address, restriction, expiration and primary name designation.
+// Names that are not valid under the IDNA rules, or whose punycode form is already bound, can no longer be
+// reached through any message, so they are released and an EventNameConversionFailed is emitted for each.
+func (keeper Keeper) ConvertUnicodeNames(ctx sdk.Context) error {
+	records := types.NameRecords{}
+	appendUnicodeRecords := func(record types.NameRecord) error {
+		if !types.IsASCII(record.Name) {
+			records = append(records, record)
+		}
+		return nil
+	}
+	if err := keeper.IterateRecords(ctx, types.NameKeyPrefix, appendUnicodeRecords); err != nil {
+		return err
+	}
+	// The records are only being re-keyed, so the unbind and bind events are not emitted.
+	quietCtx := ctx.WithEventManager(sdk.NewEventManager())
+	store := ctx.KVStore(keeper.storeKey)
+	for i := range records {
+		record := records[i]
+		name, err := keeper.Normalize(ctx, record.Name)
+		if err == nil && keeper.NameExists(ctx, name) {
+			err = types.ErrNameAlreadyBound.Wrap(name)
+		}
+		if err != nil {
+			if err = keeper.releaseUnconvertibleName(ctx, record, err); err != nil {
+				return err
+			}
+			continue
+		}
+		addr, err := types.ParseTargetAddress(record.Address)
+		if err != nil {
+			return err
+		}
+		primary, err := keeper.GetDesignatedPrimaryName(ctx, addr)
+		if err != nil {
+			return err
+		}
+		if err = keeper.DeleteRecord(quietCtx, record.Name); err != nil {
+			return err
+		}
+		key, err := types.GetNameKeyPrefix(name)
+		if err != nil {
+			return err
+		}
+		record.Name = name
+		if err = keeper.setRecord(store, key, &record); err != nil {
+			return err
+		}
+		if primary == records[i].Name {
+			if err = keeper.SetPrimaryName(quietCtx, addr, name); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// releaseUnconvertibleName deletes a unicode name record that could not be converted to its punycode form.
+func (keeper Keeper) releaseUnconvertibleName(ctx sdk.Context, record types.NameRecord, reason error) error {
+	keeper.Logger(ctx).Error("releasing unconvertible unicode name", "name", record.Name, "address", record.Address, "error", reason)
+	if err := keeper.DeleteRecord(ctx, record.Name); err != nil {
+		return err
+	}
+	return ctx.EventManager().EmitTypedEvent(types.NewEventNameConversionFailed(record.Address, record.Name, reason.Error()))
+}
+
+// setRecord writes a name record to the store under the provided name key along with all of its index entries.
+func (keeper Keeper) setRecord(store sdk.KVStore, key []byte, record *types.NameRecord) error {
+	addr, err := types.ParseTargetAddress(record.Address)
+	if err != nil {
+		return err
+	}
+	bz, err := keeper.cdc.Marshal(record)
+	if err != nil {
+		return err
+	}
+	store.Set(key, bz)
+	// Now index by address
+	addrPrefix, err := types.GetAddressKeyPrefix(addr)
+	if err != nil {
+		return err
+	}
+	addrPrefix = append(addrPrefix, key...) // [0x05] :: [addr-bytes] :: [name-key-bytes]
+	store.Set(addrPrefix, bz)
+	// And index by each parent name
+	if err = setParentIndexes(store, record.Name, key); err != nil {
+		return err
+	}
+	// And by expiration for leased names
+	if record.Expiration != nil {
+		expKey, err := types.GetExpirationKey(record.Name, *record.Expiration)
+		if err != nil {
+			return err
+		}
+		store.Set(expKey, []byte(record.Name))
+	}
+	return nil
+}
+
+// getChildRecords returns up to limit name records bound directly under a parent name.
+func (keeper Keeper) getChildRecords(ctx sdk.Context, parent string, limit int) ([]types.NameRecord, error) {
+	key, err := types.GetParentDepthKeyPrefix(parent, 1)
+	if err != nil {
+		return nil, err
+	}
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, key)
+	defer iterator.Close()
+	var records []types.NameRecord
+	for ; iterator.Valid() && len(records) < limit; iterator.Next() {
+		record, err := getNameRecord(ctx, keeper, iterator.Value())
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, *record)
+	}
+	return records, nil
+}
+
+// setParentIndexes stores the provided name record key under each of the parent names of the name.
+func setParentIndexes(store sdk.KVStore, name string, nameKey []byte) error {
+	parentKeys, err := types.GetParentIndexKeys(name)
+	if err != nil {
+		return err
+	}
+	for _, parentKey := range parentKeys {
+		store.Set(parentKey, nameKey)
+	}
+	return nil
+}
+
 // Normalize returns a name is storage format.
+// Unicode segments are mapped through IDNA/UTS-46 and stored in punycode form; length limits apply to the encoded form.
 func (keeper Keeper) Normalize(ctx sdk.Context, name string) (string, error) {
 	comps := make([]string, 0)
 	for _, comp := range strings.Split(name, ".") {
-		comp = strings.ToLower(strings.TrimSpace(comp))
+		comp, err := types.ToASCIISegment(strings.ToLower(strings.TrimSpace(comp)))
+		if err != nil {
+			return "", err
+		}
 		lenComp := uint32(len(comp))
 		isUUID := isValidUUID(comp)
 		if lenComp < keeper.GetMinSegmentLength(ctx) {
@@ -266,6 +553,10 @@ func isValid(s string) bool {
 	if isValidUUID(s) {
 		return true
 	}
+	// Punycode segments are validated against their unicode form when they are encoded.
+	if types.IsACE(s) {
+		return true
+	}
 	// Only allow a single dash if not a UUID
 	if strings.Count(s, "-") > 1 {
 		return false
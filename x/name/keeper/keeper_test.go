@@ -12,6 +12,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/stretchr/testify/suite"
 
 	nametypes "github.com/provenance-io/provenance/x/name/types"
@@ -86,13 +87,19 @@ func (s *KeeperTestSuite) TestSetup() {
   minsegmentlength: 2
   maxnamelevels: 16
   allowunrestrictednames: false
+  leaseduration: 0s
+  renewalfee: []
+  graceperiod: 0s
 bindings:
 - name: name
   address: %[1]s
   restricted: false
+  expiration: null
 - name: example.name
   address: %[1]s
   restricted: false
+  expiration: null
+primarynames: []
 `, s.user1Addr.String()), string(out))
 }
 
@@ -111,7 +118,12 @@ func (s *KeeperTestSuite) TestNameNormalization() {
 		{"trim comp spaces", args{name: "test . normalize. pio "}, "test.normalize.pio", false},
 		{"allow single dash per comp", args{name: "test-field.my-service.pio"}, "test-field.my-service.pio", false},
 		{"allow digits", args{name: "test.normalize.v1.pio"}, "test.normalize.v1.pio", false},
-		{"allow unicode chars", args{name: "tœst.nørmålize.v1.pio"}, "tœst.nørmålize.v1.pio", false},
+		{"encode unicode chars", args{name: "tœst.mañana.v1.pio"}, "xn--tst-fya.xn--maana-pta.v1.pio", false},
+		{"encode caseless scripts", args{name: "例子.пример.pio"}, "xn--fsqu00a.xn--e1afmkfd.pio", false},
+		{"map unicode upper case", args{name: "ÜBER.pio"}, "xn--ber-goa.pio", false},
+		{"map full width chars", args{name: "ｔｅｓｔ.pio"}, "test.pio", false},
+		{"allow punycode comp", args{name: "xn--e1afmkfd.pio"}, "xn--e1afmkfd.pio", false},
+		{"allow latin mixed with han", args{name: "ex-例.pio"}, "xn--ex--xc0e.pio", false},
 		{"allow uuid as comp", args{name: "6443a1e8-ec9b-4ff1-b200-d639424bcba4.service.pb"},
 			"6443a1e8-ec9b-4ff1-b200-d639424bcba4.service.pb", false},
 		// Invalid names / components
@@ -135,6 +147,12 @@ func (s *KeeperTestSuite) TestNameNormalization() {
 		{"fail on unsupported chars", args{name: "fail+normalize.pio"}, "", true},
 		{"fail on unsupported chars", args{name: "fail`normalize.pio"}, "", true},
 		{"fail on unsupported chars", args{name: "fail%normalize.pio"}, "", true},
+		{"fail when encoded comp too long", args{name: "nørmålize.pio"}, "", true},
+		{"fail on multiple dashes in unicode comp", args{name: "пример-тест-ы.pio"}, "", true},
+		{"fail on mixed scripts", args{name: "paypаl.pio"}, "", true},
+		{"fail on latin look-alike script", args{name: "рау.pio"}, "", true},
+		{"fail on invalid punycode", args{name: "xn--abc.pio"}, "", true},
+		{"fail on unicode full stop", args{name: "ab。cd.pio"}, "", true},
 		{"fail on invalid uuid", args{name: "6443a1e8-ec9b-4ff1-b200-d639424bcba4-deadbeef.service.pb"}, "", true},
 	}
 	for _, tt := range tests {
@@ -261,6 +279,25 @@ func (s *KeeperTestSuite) TestDeleteRecord() {
 
 }
 
+func (s *KeeperTestSuite) TestModifyNameRecord() {
+	s.Require().NoError(s.app.NameKeeper.ModifyNameRecord(s.ctx, "example.name", s.user2Addr, true), "modifying existing name")
+
+	record, err := s.app.NameKeeper.GetRecordByName(s.ctx, "example.name")
+	s.Require().NoError(err, "getting modified record")
+	s.Assert().Equal(nametypes.NewNameRecord("example.name", s.user2Addr, true), *record, "modified record")
+
+	user1Records, err := s.app.NameKeeper.GetRecordsByAddress(s.ctx, s.user1Addr)
+	s.Require().NoError(err, "getting records for previous owner")
+	s.Assert().Equal(nametypes.NameRecords{nametypes.NewNameRecord("name", s.user1Addr, false)}, user1Records, "records for previous owner")
+
+	user2Records, err := s.app.NameKeeper.GetRecordsByAddress(s.ctx, s.user2Addr)
+	s.Require().NoError(err, "getting records for new owner")
+	s.Assert().Equal(nametypes.NameRecords{nametypes.NewNameRecord("example.name", s.user2Addr, true)}, user2Records, "records for new owner")
+
+	err = s.app.NameKeeper.ModifyNameRecord(s.ctx, "unknown.name", s.user2Addr, true)
+	s.Assert().ErrorIs(err, nametypes.ErrNameNotBound, "modifying unknown name")
+}
+
 func (s *KeeperTestSuite) TestIterateRecord() {
 	s.Run("iterate name's", func() {
 		records := nametypes.NameRecords{}
@@ -277,6 +314,147 @@ func (s *KeeperTestSuite) TestIterateRecord() {
 
 }
 
+func (s *KeeperTestSuite) TestChildren() {
+	nk := s.app.NameKeeper
+	s.Require().NoError(nk.SetNameRecord(s.ctx, "one.example.name", s.user1Addr, false), "binding one.example.name")
+	s.Require().NoError(nk.SetNameRecord(s.ctx, "two.example.name", s.user2Addr, false), "binding two.example.name")
+	s.Require().NoError(nk.SetNameRecord(s.ctx, "deep.one.example.name", s.user2Addr, false), "binding deep.one.example.name")
+	s.Require().NoError(nk.SetNameRecord(s.ctx, "other.name", s.user1Addr, false), "binding other.name")
+
+	children := func(name string, depth uint32) []nametypes.NameRecord {
+		resp, err := nk.Children(sdk.WrapSDKContext(s.ctx), &nametypes.QueryChildrenRequest{Name: name, Depth: depth})
+		s.Require().NoError(err, "Children(%q, %d)", name, depth)
+		return resp.Records
+	}
+
+	s.Run("direct children", func() {
+		s.Assert().ElementsMatch([]nametypes.NameRecord{
+			nametypes.NewNameRecord("example.name", s.user1Addr, false),
+			nametypes.NewNameRecord("other.name", s.user1Addr, false),
+		}, children("name", 0))
+		s.Assert().ElementsMatch([]nametypes.NameRecord{
+			nametypes.NewNameRecord("one.example.name", s.user1Addr, false),
+			nametypes.NewNameRecord("two.example.name", s.user2Addr, false),
+		}, children("example.name", 1))
+	})
+
+	s.Run("children with depth", func() {
+		s.Assert().ElementsMatch([]nametypes.NameRecord{
+			nametypes.NewNameRecord("one.example.name", s.user1Addr, false),
+			nametypes.NewNameRecord("two.example.name", s.user2Addr, false),
+			nametypes.NewNameRecord("deep.one.example.name", s.user2Addr, false),
+		}, children("example.name", 2))
+		s.Assert().Len(children("name", 10), 5)
+	})
+
+	s.Run("paginated children with depth", func() {
+		ctx := sdk.WrapSDKContext(s.ctx)
+		var names []string
+		var nextKey []byte
+		for i := 0; i < 3; i++ {
+			resp, err := nk.Children(ctx, &nametypes.QueryChildrenRequest{Name: "name", Depth: 2, Pagination: &query.PageRequest{Key: nextKey, Limit: 2}})
+			s.Require().NoError(err, "Children page %d", i)
+			for _, record := range resp.Records {
+				names = append(names, record.Name)
+			}
+			nextKey = resp.Pagination.NextKey
+			if len(nextKey) == 0 {
+				break
+			}
+		}
+		s.Assert().ElementsMatch([]string{"example.name", "other.name", "one.example.name", "two.example.name"}, names, "children over all pages")
+	})
+
+	s.Run("index stores only the name key", func() {
+		store := s.ctx.KVStore(s.app.GetKey(nametypes.ModuleName))
+		nameKey, err := nametypes.GetNameKeyPrefix("deep.one.example.name")
+		s.Require().NoError(err, "GetNameKeyPrefix")
+		parentKeys, err := nametypes.GetParentIndexKeys("deep.one.example.name")
+		s.Require().NoError(err, "GetParentIndexKeys")
+		for _, parentKey := range parentKeys {
+			s.Assert().Equal(nameKey, store.Get(parentKey), "parent index value")
+		}
+	})
+
+	s.Run("no children", func() {
+		s.Assert().Empty(children("deep.one.example.name", 0))
+	})
+
+	s.Run("unknown name", func() {
+		_, err := nk.Children(sdk.WrapSDKContext(s.ctx), &nametypes.QueryChildrenRequest{Name: "unknown.name"})
+		s.Assert().ErrorIs(err, nametypes.ErrNameNotBound)
+	})
+
+	s.Run("modified and deleted children", func() {
+		s.Require().NoError(nk.ModifyNameRecord(s.ctx, "two.example.name", s.user1Addr, true), "modifying two.example.name")
+		s.Require().NoError(nk.DeleteRecord(s.ctx, "deep.one.example.name"), "deleting deep.one.example.name")
+		s.Assert().ElementsMatch([]nametypes.NameRecord{
+			nametypes.NewNameRecord("one.example.name", s.user1Addr, false),
+			nametypes.NewNameRecord("two.example.name", s.user1Addr, true),
+		}, children("example.name", 5))
+	})
+
+	s.Run("rebuild parent indexes", func() {
+		store := s.ctx.KVStore(s.app.GetKey(nametypes.ModuleName))
+		iter := sdk.KVStorePrefixIterator(store, nametypes.ParentKeyPrefix)
+		var keys [][]byte
+		for ; iter.Valid(); iter.Next() {
+			keys = append(keys, iter.Key())
+		}
+		s.Require().NoError(iter.Close(), "closing iterator")
+		for _, key := range keys {
+			store.Delete(key)
+		}
+		s.Require().Empty(children("name", 10), "children after removing index")
+
+		s.Require().NoError(nk.BuildParentIndexes(s.ctx), "BuildParentIndexes")
+		s.Assert().Len(children("name", 10), 4, "children after rebuilding index")
+	})
+}
+
+func (s *KeeperTestSuite) TestConvertUnicodeNames() {
+	nk := s.app.NameKeeper
+	store := s.ctx.KVStore(s.app.GetKey(nametypes.ModuleName))
+	// Names bound before unicode segments were encoded were stored as they were provided.
+	for _, name := range []string{"пример.name", "рау.name", "данные.name"} {
+		key, err := nametypes.GetNameKeyPrefix(name)
+		s.Require().NoError(err, "GetNameKeyPrefix(%q)", name)
+		record := nametypes.NewNameRecord(name, s.user1Addr, false)
+		store.Set(key, s.app.AppCodec().MustMarshal(&record))
+	}
+	primaryKey, err := nametypes.GetPrimaryNameKey(s.user1Addr)
+	s.Require().NoError(err, "GetPrimaryNameKey")
+	store.Set(primaryKey, []byte("пример.name"))
+
+	// The punycode form of a unicode name can already be bound by someone else.
+	collision, err := nk.Normalize(s.ctx, "данные.name")
+	s.Require().NoError(err, "Normalize")
+	s.Require().NoError(nk.SetNameRecord(s.ctx, collision, s.user2Addr, false), "SetNameRecord(%q)", collision)
+
+	ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+	s.Require().NoError(nk.ConvertUnicodeNames(ctx), "ConvertUnicodeNames")
+
+	s.Assert().False(nk.NameExists(ctx, "пример.name"), "unicode name exists")
+	s.Assert().True(nk.ResolvesTo(ctx, "xn--e1afmkfd.name", s.user1Addr), "punycode name resolves to owner")
+	s.Assert().False(nk.NameExists(ctx, "рау.name"), "confusable name exists")
+	s.Assert().False(nk.NameExists(ctx, "данные.name"), "colliding unicode name exists")
+	s.Assert().True(nk.ResolvesTo(ctx, collision, s.user2Addr), "existing punycode name resolves to its owner")
+	primary, err := nk.GetDesignatedPrimaryName(ctx, s.user1Addr)
+	s.Require().NoError(err, "GetDesignatedPrimaryName")
+	s.Assert().Equal("xn--e1afmkfd.name", primary, "designated primary name")
+
+	var failed []string
+	for _, event := range ctx.EventManager().Events().ToABCIEvents() {
+		typedEvent, _ := sdk.ParseTypedEvent(event)
+		if e, ok := typedEvent.(*nametypes.EventNameConversionFailed); ok {
+			s.Assert().Equal(s.user1, e.Address, "conversion failed event address")
+			s.Assert().NotEmpty(e.Reason, "conversion failed event reason")
+			failed = append(failed, e.Name)
+		}
+	}
+	s.Assert().ElementsMatch([]string{"рау.name", "данные.name"}, failed, "conversion failed events")
+}
+
 func (s *KeeperTestSuite) TestSecp256r1KeyAlgo() {
 	s.Run("should successfully add name for account with secp256r1 key", func() {
 		err := s.app.NameKeeper.SetNameRecord(s.ctx, "secp256r1.name", s.user2Addr, true)
@@ -0,0 +1,157 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/provenance-io/provenance/x/name/types"
+)
+
+// SetNameExpiration sets the time the lease on a bound name expires. A nil expiration removes the lease.
+func (keeper Keeper) SetNameExpiration(ctx sdk.Context, name string, expiration *time.Time) error {
+	var err error
+	if name, err = keeper.Normalize(ctx, name); err != nil {
+		return err
+	}
+	record, err := keeper.GetRecordByName(ctx, name)
+	if err != nil {
+		return err
+	}
+	key, err := types.GetNameKeyPrefix(name)
+	if err != nil {
+		return err
+	}
+	store := ctx.KVStore(keeper.storeKey)
+	// Remove the old expiration index record
+	if record.Expiration != nil {
+		expKey, err := types.GetExpirationKey(name, *record.Expiration)
+		if err != nil {
+			return err
+		}
+		store.Delete(expKey)
+	}
+	record.Expiration = expiration
+	return keeper.setRecord(store, key, record)
+}
+
+// MaxNamesReleasedPerBlock is the maximum number of name records deleted by the EndBlocker in one block.
+// Any other expired names are released in the following blocks.
+const MaxNamesReleasedPerBlock = 100
+
+// RenewName extends the lease on a name by the lease duration, charging the renewal fee to the owner.
+// The lease is extended from its current expiration, or from the block time if it has already expired.
+// If leases have been disabled, the lease is removed without charging the fee and the name no longer expires.
+func (keeper Keeper) RenewName(ctx sdk.Context, name string, owner sdk.AccAddress) (*types.NameRecord, error) {
+	record, err := keeper.GetRecordByName(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if record.Expiration == nil {
+		return nil, types.ErrNameNotLeased
+	}
+	var expiration *time.Time
+	if leaseDuration := keeper.GetLeaseDuration(ctx); leaseDuration > 0 {
+		fee := keeper.GetRenewalFee(ctx)
+		if !fee.IsZero() {
+			if err = keeper.bankKeeper.SendCoinsFromAccountToModule(ctx, owner, authtypes.FeeCollectorName, fee); err != nil {
+				return nil, err
+			}
+		}
+		start := *record.Expiration
+		if ctx.BlockTime().After(start) {
+			start = ctx.BlockTime()
+		}
+		newExpiration := start.Add(leaseDuration).UTC()
+		expiration = &newExpiration
+	}
+	if err = keeper.SetNameExpiration(ctx, record.Name, expiration); err != nil {
+		return nil, err
+	}
+	record.Expiration = expiration
+
+	nameRenewedEvent := types.NewEventNameRenewed(record.Address, record.Name, record.Expiration)
+
+	if err := ctx.EventManager().EmitTypedEvent(nameRenewedEvent); err != nil {
+		return nil, err
+	}
+
+	return record, nil
+}
+
+// GetExpiredNames returns the names whose leases expired before the provided time.
+// At most limit names are returned, the ones with the earliest expirations first. A limit of zero returns them all.
+func (keeper Keeper) GetExpiredNames(ctx sdk.Context, before time.Time, limit int) []string {
+	store := ctx.KVStore(keeper.storeKey)
+	// The index is only precise to the second, so include the entire second and filter on the records.
+	iterator := store.Iterator(types.ExpirationKeyPrefix, types.GetExpirationKeyPrefix(before.Add(time.Second)))
+	defer iterator.Close()
+	var names []string
+	for ; iterator.Valid() && (limit <= 0 || len(names) < limit); iterator.Next() {
+		names = append(names, string(iterator.Value()))
+	}
+	return names
+}
+
+// ReleaseExpiredNames deletes all names whose leases expired more than the grace period ago,
+// along with all of the names bound under them.
+// At most MaxNamesReleasedPerBlock records are deleted; the rest are left for the next block.
+func (keeper Keeper) ReleaseExpiredNames(ctx sdk.Context) {
+	cutoff := ctx.BlockTime().Add(-keeper.GetGracePeriod(ctx))
+	released := 0
+	for _, name := range keeper.GetExpiredNames(ctx, cutoff, MaxNamesReleasedPerBlock) {
+		if released >= MaxNamesReleasedPerBlock {
+			return
+		}
+		record, err := keeper.GetRecordByName(ctx, name)
+		if err != nil {
+			keeper.Logger(ctx).Error("unable to get expired name record", "name", name, "err", err)
+			continue
+		}
+		if record.Expiration == nil || record.Expiration.After(cutoff) {
+			continue
+		}
+		count, err := keeper.releaseName(ctx, name, MaxNamesReleasedPerBlock-released)
+		released += count
+		if err != nil {
+			keeper.Logger(ctx).Error("unable to release expired name", "name", name, "err", err)
+			continue
+		}
+		if keeper.NameExists(ctx, name) {
+			// Not all of the names under it could be released in this block.
+			return
+		}
+
+		nameExpiredEvent := types.NewEventNameExpired(record.Address, record.Name, record.Expiration)
+
+		if err = ctx.EventManager().EmitTypedEvent(nameExpiredEvent); err != nil {
+			keeper.Logger(ctx).Error("unable to emit name expired event", "name", name, "err", err)
+		}
+	}
+}
+
+// releaseName deletes a name along with all of the names bound under it, deleting at most limit records.
+// Child names are deleted before their parent, so the name itself is only deleted once nothing is left under it.
+// It returns the number of records deleted.
+func (keeper Keeper) releaseName(ctx sdk.Context, name string, limit int) (int, error) {
+	children, err := keeper.getChildRecords(ctx, name, limit)
+	if err != nil {
+		return 0, err
+	}
+	released := 0
+	for _, child := range children {
+		count, err := keeper.releaseName(ctx, child.Name, limit-released)
+		released += count
+		if err != nil {
+			return released, err
+		}
+		if released >= limit {
+			return released, nil
+		}
+	}
+	if err = keeper.DeleteRecord(ctx, name); err != nil {
+		return released, err
+	}
+	return released + 1, nil
+}
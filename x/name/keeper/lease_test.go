@@ -0,0 +1,197 @@
+package keeper_test
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+
+	"github.com/provenance-io/provenance/x/name/keeper"
+	nametypes "github.com/provenance-io/provenance/x/name/types"
+)
+
+func (s *KeeperTestSuite) setLeaseParams(leaseDuration time.Duration, fee sdk.Coins, gracePeriod time.Duration) {
+	params := s.app.NameKeeper.GetParams(s.ctx)
+	params.LeaseDuration = leaseDuration
+	params.RenewalFee = fee
+	params.GracePeriod = gracePeriod
+	s.app.NameKeeper.SetParams(s.ctx, params)
+}
+
+func (s *KeeperTestSuite) TestNameLeases() {
+	nk := s.app.NameKeeper
+	msgServer := keeper.NewMsgServerImpl(nk)
+	fee := sdk.NewCoins(sdk.NewInt64Coin("leasecoin", 10))
+	blockTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.ctx = s.ctx.WithBlockTime(blockTime)
+	s.setLeaseParams(time.Hour, fee, 10*time.Minute)
+	s.Require().NoError(testutil.FundAccount(s.app.BankKeeper, s.ctx, s.user1Addr, sdk.NewCoins(sdk.NewInt64Coin("leasecoin", 15))), "funding user1")
+
+	s.Run("bound names get a lease", func() {
+		_, err := msgServer.BindName(sdk.WrapSDKContext(s.ctx), nametypes.NewMsgBindNameRequest(
+			nametypes.NewNameRecord("leased", s.user1Addr, false),
+			nametypes.NewNameRecord("example.name", s.user1Addr, false),
+		))
+		s.Require().NoError(err, "BindName")
+		record, err := nk.GetRecordByName(s.ctx, "leased.example.name")
+		s.Require().NoError(err, "GetRecordByName")
+		s.Require().NotNil(record.Expiration, "expiration")
+		s.Assert().Equal(blockTime.Add(time.Hour), *record.Expiration, "expiration")
+	})
+
+	s.Run("names from genesis and gov do not expire", func() {
+		record, err := nk.GetRecordByName(s.ctx, "example.name")
+		s.Require().NoError(err, "GetRecordByName")
+		s.Assert().Nil(record.Expiration, "expiration")
+		_, err = nk.RenewName(s.ctx, "example.name", s.user1Addr)
+		s.Assert().ErrorIs(err, nametypes.ErrNameNotLeased, "RenewName")
+	})
+
+	s.Run("renewal by non-owner is rejected", func() {
+		_, err := msgServer.RenewName(sdk.WrapSDKContext(s.ctx), nametypes.NewMsgRenewNameRequest("leased.example.name", s.user2Addr))
+		s.Assert().EqualError(err, "msg sender cannot renew name: unauthorized")
+	})
+
+	s.Run("renewal extends the lease and charges the fee", func() {
+		_, err := msgServer.RenewName(sdk.WrapSDKContext(s.ctx), nametypes.NewMsgRenewNameRequest("leased.example.name", s.user1Addr))
+		s.Require().NoError(err, "RenewName")
+		record, err := nk.GetRecordByName(s.ctx, "leased.example.name")
+		s.Require().NoError(err, "GetRecordByName")
+		s.Require().NotNil(record.Expiration, "expiration")
+		s.Assert().Equal(blockTime.Add(2*time.Hour), *record.Expiration, "expiration")
+		s.Assert().Equal("5leasecoin", s.app.BankKeeper.GetAllBalances(s.ctx, s.user1Addr).String(), "owner balance")
+		feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+		s.Assert().Equal("10", s.app.BankKeeper.GetBalance(s.ctx, feeCollector, "leasecoin").Amount.String(), "fee collector balance")
+	})
+
+	s.Run("renewal without funds fails", func() {
+		_, err := msgServer.RenewName(sdk.WrapSDKContext(s.ctx), nametypes.NewMsgRenewNameRequest("leased.example.name", s.user1Addr))
+		s.Assert().ErrorContains(err, "insufficient funds")
+	})
+
+	s.Run("names are kept during the grace period", func() {
+		ctx := s.ctx.WithBlockTime(blockTime.Add(2*time.Hour + 5*time.Minute))
+		nk.ReleaseExpiredNames(ctx)
+		s.Assert().True(nk.NameExists(ctx, "leased.example.name"), "name exists")
+	})
+
+	s.Run("names are released after the grace period", func() {
+		ctx := s.ctx.WithBlockTime(blockTime.Add(2*time.Hour + 11*time.Minute)).WithEventManager(sdk.NewEventManager())
+		nk.ReleaseExpiredNames(ctx)
+		s.Assert().False(nk.NameExists(ctx, "leased.example.name"), "name exists")
+		s.Assert().True(nk.NameExists(ctx, "example.name"), "parent name exists")
+		s.Assert().Empty(nk.GetExpiredNames(ctx, ctx.BlockTime(), 0), "expired names")
+
+		expiration := blockTime.Add(2 * time.Hour)
+		expected := nametypes.NewEventNameExpired(s.user1, "leased.example.name", &expiration)
+		found := false
+		for _, event := range ctx.EventManager().Events().ToABCIEvents() {
+			typedEvent, _ := sdk.ParseTypedEvent(event)
+			if e, ok := typedEvent.(*nametypes.EventNameExpired); ok {
+				s.Assert().Equal(expected, e, "expired event")
+				found = true
+			}
+		}
+		s.Assert().True(found, "expired event emitted")
+	})
+}
+
+func (s *KeeperTestSuite) TestRenewNameLeasesDisabled() {
+	nk := s.app.NameKeeper
+	expiration := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.ctx = s.ctx.WithBlockTime(expiration)
+	s.setLeaseParams(0, sdk.NewCoins(sdk.NewInt64Coin("leasecoin", 10)), 0)
+	s.Require().NoError(nk.SetNameRecord(s.ctx, "leased.example.name", s.user1Addr, false), "SetNameRecord")
+	s.Require().NoError(nk.SetNameExpiration(s.ctx, "leased.example.name", &expiration), "SetNameExpiration")
+
+	record, err := nk.RenewName(s.ctx, "leased.example.name", s.user1Addr)
+	s.Require().NoError(err, "RenewName without funds")
+	s.Assert().Nil(record.Expiration, "expiration")
+	s.Assert().True(s.app.BankKeeper.GetAllBalances(s.ctx, s.user1Addr).IsZero(), "owner balance")
+}
+
+func (s *KeeperTestSuite) TestReleaseExpiredNameCascade() {
+	nk := s.app.NameKeeper
+	expiration := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.setLeaseParams(time.Hour, nil, 0)
+	for _, name := range []string{"leased.example.name", "child.leased.example.name", "grandchild.child.leased.example.name", "other.leased.example.name"} {
+		s.Require().NoError(nk.SetNameRecord(s.ctx, name, s.user2Addr, false), "SetNameRecord(%q)", name)
+	}
+	s.Require().NoError(nk.SetNameExpiration(s.ctx, "leased.example.name", &expiration), "SetNameExpiration")
+
+	ctx := s.ctx.WithBlockTime(expiration.Add(time.Minute)).WithEventManager(sdk.NewEventManager())
+	nk.ReleaseExpiredNames(ctx)
+	for _, name := range []string{"leased.example.name", "child.leased.example.name", "grandchild.child.leased.example.name", "other.leased.example.name"} {
+		s.Assert().False(nk.NameExists(ctx, name), "%q exists", name)
+	}
+	s.Assert().True(nk.NameExists(ctx, "example.name"), "parent name exists")
+	s.Assert().Empty(nk.GetExpiredNames(ctx, ctx.BlockTime(), 0), "expired names")
+
+	var unbound []string
+	for _, event := range ctx.EventManager().Events().ToABCIEvents() {
+		typedEvent, _ := sdk.ParseTypedEvent(event)
+		if e, ok := typedEvent.(*nametypes.EventNameUnbound); ok {
+			unbound = append(unbound, e.Name)
+		}
+	}
+	s.Assert().Len(unbound, 4, "unbound events")
+	s.Assert().Equal("leased.example.name", unbound[len(unbound)-1], "last unbound name")
+}
+
+func (s *KeeperTestSuite) TestReleaseExpiredNamesLimit() {
+	nk := s.app.NameKeeper
+	expiration := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.setLeaseParams(time.Hour, nil, 0)
+	s.Require().NoError(nk.SetNameRecord(s.ctx, "leased.example.name", s.user2Addr, false), "SetNameRecord")
+	s.Require().NoError(nk.SetNameExpiration(s.ctx, "leased.example.name", &expiration), "SetNameExpiration")
+	children := keeper.MaxNamesReleasedPerBlock + 5
+	for i := 0; i < children; i++ {
+		name := fmt.Sprintf("child%d.leased.example.name", i)
+		s.Require().NoError(nk.SetNameRecord(s.ctx, name, s.user2Addr, false), "SetNameRecord(%q)", name)
+	}
+	countChildren := func(ctx sdk.Context) int {
+		count := 0
+		for i := 0; i < children; i++ {
+			if nk.NameExists(ctx, fmt.Sprintf("child%d.leased.example.name", i)) {
+				count++
+			}
+		}
+		return count
+	}
+
+	ctx := s.ctx.WithBlockTime(expiration.Add(time.Minute))
+	nk.ReleaseExpiredNames(ctx)
+	s.Assert().Equal(5, countChildren(ctx), "children left after the first block")
+	s.Assert().True(nk.NameExists(ctx, "leased.example.name"), "expired name exists after the first block")
+	s.Assert().Equal([]string{"leased.example.name"}, nk.GetExpiredNames(ctx, ctx.BlockTime(), 0), "expired names after the first block")
+
+	nk.ReleaseExpiredNames(ctx)
+	s.Assert().Equal(0, countChildren(ctx), "children left after the second block")
+	s.Assert().False(nk.NameExists(ctx, "leased.example.name"), "expired name exists after the second block")
+}
+
+func (s *KeeperTestSuite) TestSetNameExpiration() {
+	nk := s.app.NameKeeper
+	first := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	second := first.Add(time.Hour)
+
+	s.Require().NoError(nk.SetNameExpiration(s.ctx, "example.name", &first), "setting first expiration")
+	s.Assert().Equal([]string{"example.name"}, nk.GetExpiredNames(s.ctx, first, 0), "expired names at first expiration")
+
+	s.Require().NoError(nk.SetNameExpiration(s.ctx, "example.name", &second), "setting second expiration")
+	s.Assert().Empty(nk.GetExpiredNames(s.ctx, first, 0), "expired names at first expiration after update")
+	s.Assert().Equal([]string{"example.name"}, nk.GetExpiredNames(s.ctx, second, 0), "expired names at second expiration")
+
+	s.Require().NoError(nk.ModifyNameRecord(s.ctx, "example.name", s.user2Addr, true), "modifying name")
+	record, err := nk.GetRecordByName(s.ctx, "example.name")
+	s.Require().NoError(err, "GetRecordByName")
+	s.Require().NotNil(record.Expiration, "expiration after modify")
+	s.Assert().Equal(second, *record.Expiration, "expiration after modify")
+
+	s.Require().NoError(nk.SetNameExpiration(s.ctx, "example.name", nil), "clearing expiration")
+	s.Assert().Empty(nk.GetExpiredNames(s.ctx, second, 0), "expired names after clearing")
+
+	s.Assert().ErrorIs(nk.SetNameExpiration(s.ctx, "unknown.name", &first), nametypes.ErrNameNotBound, "unknown name")
+}
@@ -23,3 +23,28 @@ func (m *Migrator) Migrate1to2(ctx sdk.Context) error {
 	ctx.Logger().Info("Finished Migrating Name Module from Version 1 to 2")
 	return err
 }
+
+// Migrate2to3 migrates from version 2 to 3.
+func (m *Migrator) Migrate2to3(ctx sdk.Context) error {
+	ctx.Logger().Info("Migrating Name Module from Version 2 to 3 (1/1)")
+	err := m.keeper.BuildParentIndexes(ctx)
+	ctx.Logger().Info("Finished Migrating Name Module from Version 2 to 3")
+	return err
+}
+
+// Migrate3to4 migrates from version 3 to 4.
+func (m *Migrator) Migrate3to4(ctx sdk.Context) error {
+	ctx.Logger().Info("Migrating Name Module from Version 3 to 4 (1/1)")
+	// Existing name records have no expiration so they are unaffected. Only the new lease params need to be stored.
+	m.keeper.SetParams(ctx, m.keeper.GetParams(ctx))
+	ctx.Logger().Info("Finished Migrating Name Module from Version 3 to 4")
+	return nil
+}
+
+// Migrate4to5 migrates from version 4 to 5.
+func (m *Migrator) Migrate4to5(ctx sdk.Context) error {
+	ctx.Logger().Info("Migrating Name Module from Version 4 to 5 (1/1)")
+	err := m.keeper.ConvertUnicodeNames(ctx)
+	ctx.Logger().Info("Finished Migrating Name Module from Version 4 to 5")
+	return err
+}
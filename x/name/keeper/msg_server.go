@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/armon/go-metrics"
 	"github.com/cosmos/cosmos-sdk/telemetry"
@@ -45,7 +46,7 @@ func (s msgServer) BindName(goCtx context.Context, msg *types.MsgBindNameRequest
 			ctx.Logger().Error("unable to parse parent address", "err", addrErr)
 			return nil, sdkerrors.ErrInvalidRequest.Wrap(addrErr.Error())
 		}
-		if !s.Keeper.ResolvesTo(ctx, msg.Parent.Name, parentAddress) {
+		if !s.Keeper.IsNameOwner(ctx, msg.Parent.Name, parentAddress) {
 			errm := "parent name is restricted and does not resolve to the provided parent address"
 			ctx.Logger().Error(errm)
 			return nil, sdkerrors.ErrInvalidRequest.Wrap(errm)
@@ -63,14 +64,45 @@ func (s msgServer) BindName(goCtx context.Context, msg *types.MsgBindNameRequest
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(types.ErrNameAlreadyBound.Error())
 	}
 	// Bind name to address
-	address, err := sdk.AccAddressFromBech32(msg.Record.Address)
-	if err != nil {
-		ctx.Logger().Error("invalid address", "err", err)
-		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	if types.IsMetadataAddress(msg.Record.Address) {
+		target, err := types.ParseMetadataTarget(msg.Record.Address)
+		if err != nil {
+			ctx.Logger().Error("invalid address", "err", err)
+			return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+		}
+		// Only an owner of a metadata object can bind a name to it.
+		signer, err := sdk.AccAddressFromBech32(msg.Parent.Address)
+		if err != nil {
+			ctx.Logger().Error("invalid parent address", "err", err)
+			return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+		}
+		if !s.Keeper.ControlsTarget(ctx, msg.Record.Address, signer) {
+			errm := "msg sender is not an owner of the metadata address"
+			ctx.Logger().Error(errm, "address", msg.Record.Address)
+			return nil, sdkerrors.ErrUnauthorized.Wrap(errm)
+		}
+		if err := s.Keeper.SetMetadataNameRecord(ctx, name, target, msg.Record.Restricted); err != nil {
+			ctx.Logger().Error("unable to bind name", "err", err)
+			return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+		}
+	} else {
+		address, err := sdk.AccAddressFromBech32(msg.Record.Address)
+		if err != nil {
+			ctx.Logger().Error("invalid address", "err", err)
+			return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+		}
+		if err := s.Keeper.SetNameRecord(ctx, name, address, msg.Record.Restricted); err != nil {
+			ctx.Logger().Error("unable to bind name", "err", err)
+			return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+		}
 	}
-	if err := s.Keeper.SetNameRecord(ctx, name, address, msg.Record.Restricted); err != nil {
-		ctx.Logger().Error("unable to bind name", "err", err)
-		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	// Names bound while leases are enabled expire unless renewed.
+	if leaseDuration := s.Keeper.GetLeaseDuration(ctx); leaseDuration > 0 {
+		expiration := ctx.BlockTime().Add(leaseDuration).UTC()
+		if err := s.Keeper.SetNameExpiration(ctx, name, &expiration); err != nil {
+			ctx.Logger().Error("unable to set name expiration", "err", err)
+			return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+		}
 	}
 
 	// key: modulename+name+bind
@@ -119,7 +151,7 @@ func (s msgServer) DeleteName(goCtx context.Context, msg *types.MsgDeleteNameReq
 		return nil, sdkerrors.ErrInvalidRequest.Wrap("name does not exist")
 	}
 	// Ensure permission
-	if !s.Keeper.ResolvesTo(ctx, name, address) {
+	if !s.Keeper.IsNameOwner(ctx, name, address) {
 		ctx.Logger().Error("msg sender cannot delete name", "name", name)
 		return nil, sdkerrors.ErrUnauthorized.Wrap("msg sender cannot delete name")
 	}
@@ -148,3 +180,179 @@ func (s msgServer) DeleteName(goCtx context.Context, msg *types.MsgDeleteNameReq
 
 	return &types.MsgDeleteNameResponse{}, nil
 }
+
+// ModifyName changes the address and restricted flag of an existing name
+func (s msgServer) ModifyName(goCtx context.Context, msg *types.MsgModifyNameRequest) (*types.MsgModifyNameResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	// Validate
+	if err := msg.ValidateBasic(); err != nil {
+		ctx.Logger().Error("unable to validate message", "err", err)
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	// Normalize
+	name, err := s.Keeper.Normalize(ctx, msg.Record.Name)
+	if err != nil {
+		ctx.Logger().Error("invalid name", "err", err)
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	// Parse addresses
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		ctx.Logger().Error("invalid authority", "err", err)
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	// Ensure the name exists
+	if !s.Keeper.NameExists(ctx, name) {
+		ctx.Logger().Error("invalid name", "name", name)
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("name does not exist")
+	}
+	// Ensure permission: the current owner can always modify the name, and the authority can modify root names.
+	isRootAuthority := msg.Authority == s.Keeper.GetAuthority() && !strings.Contains(name, ".")
+	if !isRootAuthority && !s.Keeper.IsNameOwner(ctx, name, authority) {
+		ctx.Logger().Error("msg sender cannot modify name", "name", name)
+		return nil, sdkerrors.ErrUnauthorized.Wrap("msg sender cannot modify name")
+	}
+	// Modify
+	if types.IsMetadataAddress(msg.Record.Address) {
+		target, err := types.ParseMetadataTarget(msg.Record.Address)
+		if err != nil {
+			ctx.Logger().Error("invalid address", "err", err)
+			return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+		}
+		// Only an owner of a metadata object can bind a name to it.
+		if !isRootAuthority && !s.Keeper.ControlsTarget(ctx, msg.Record.Address, authority) {
+			errm := "msg sender is not an owner of the metadata address"
+			ctx.Logger().Error(errm, "address", msg.Record.Address)
+			return nil, sdkerrors.ErrUnauthorized.Wrap(errm)
+		}
+		if err := s.Keeper.ModifyMetadataNameRecord(ctx, name, target, msg.Record.Restricted); err != nil {
+			ctx.Logger().Error("error modifying name", "err", err)
+			return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+		}
+	} else {
+		address, err := sdk.AccAddressFromBech32(msg.Record.Address)
+		if err != nil {
+			ctx.Logger().Error("invalid address", "err", err)
+			return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+		}
+		if err := s.Keeper.ModifyNameRecord(ctx, name, address, msg.Record.Restricted); err != nil {
+			ctx.Logger().Error("error modifying name", "err", err)
+			return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+		}
+	}
+
+	// key: modulename+name+modify
+	defer func() {
+		telemetry.IncrCounterWithLabels(
+			[]string{types.ModuleName, "name", "modify"},
+			1,
+			[]metrics.Label{telemetry.NewLabel("name", name), telemetry.NewLabel("address", msg.Record.Address)},
+		)
+	}()
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeNameUpdate,
+			sdk.NewAttribute(types.KeyAttributeAddress, msg.Record.Address),
+			sdk.NewAttribute(types.KeyAttributeName, msg.Record.Name),
+		),
+	)
+
+	return &types.MsgModifyNameResponse{}, nil
+}
+
+// RenewName extends the lease on a name owned by the signer
+func (s msgServer) RenewName(goCtx context.Context, msg *types.MsgRenewNameRequest) (*types.MsgRenewNameResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	// Validate
+	if err := msg.ValidateBasic(); err != nil {
+		ctx.Logger().Error("unable to validate message", "err", err)
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	// Normalize
+	name, err := s.Keeper.Normalize(ctx, msg.Name)
+	if err != nil {
+		ctx.Logger().Error("invalid name", "err", err)
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	// Parse address
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		ctx.Logger().Error("invalid owner", "err", err)
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	// Ensure the name exists
+	if !s.Keeper.NameExists(ctx, name) {
+		ctx.Logger().Error("invalid name", "name", name)
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("name does not exist")
+	}
+	// Ensure permission
+	if !s.Keeper.IsNameOwner(ctx, name, owner) {
+		ctx.Logger().Error("msg sender cannot renew name", "name", name)
+		return nil, sdkerrors.ErrUnauthorized.Wrap("msg sender cannot renew name")
+	}
+	// Renew
+	if _, err := s.Keeper.RenewName(ctx, name, owner); err != nil {
+		ctx.Logger().Error("error renewing name", "err", err)
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	// key: modulename+name+renew
+	defer func() {
+		telemetry.IncrCounterWithLabels(
+			[]string{types.ModuleName, "name", "renew"},
+			1,
+			[]metrics.Label{telemetry.NewLabel("name", name), telemetry.NewLabel("address", msg.Owner)},
+		)
+	}()
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeNameRenewed,
+			sdk.NewAttribute(types.KeyAttributeAddress, msg.Owner),
+			sdk.NewAttribute(types.KeyAttributeName, msg.Name),
+		),
+	)
+
+	return &types.MsgRenewNameResponse{}, nil
+}
+
+// SetPrimaryName designates one of the names bound to the signer as its primary name
+func (s msgServer) SetPrimaryName(goCtx context.Context, msg *types.MsgSetPrimaryNameRequest) (*types.MsgSetPrimaryNameResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	// Validate
+	if err := msg.ValidateBasic(); err != nil {
+		ctx.Logger().Error("unable to validate message", "err", err)
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	// Parse address
+	address, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		ctx.Logger().Error("invalid address", "err", err)
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	// Set (the keeper ensures the name is bound to the address)
+	if err := s.Keeper.SetPrimaryName(ctx, address, msg.Name); err != nil {
+		ctx.Logger().Error("unable to set primary name", "err", err)
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	// key: modulename+name+primary
+	defer func() {
+		telemetry.IncrCounterWithLabels(
+			[]string{types.ModuleName, "name", "primary"},
+			1,
+			[]metrics.Label{telemetry.NewLabel("name", msg.Name), telemetry.NewLabel("address", msg.Address)},
+		)
+	}()
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypePrimaryNameUpdate,
+			sdk.NewAttribute(types.KeyAttributeAddress, msg.Address),
+			sdk.NewAttribute(types.KeyAttributeName, msg.Name),
+		),
+	)
+
+	return &types.MsgSetPrimaryNameResponse{}, nil
+}
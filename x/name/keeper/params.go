@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/provenance-io/provenance/x/name/types"
@@ -13,6 +15,9 @@ func (keeper Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 		MinSegmentLength:       keeper.GetMinSegmentLength(ctx),
 		MaxNameLevels:          keeper.GetMaxNameLevels(ctx),
 		AllowUnrestrictedNames: keeper.GetAllowUnrestrictedNames(ctx),
+		LeaseDuration:          keeper.GetLeaseDuration(ctx),
+		RenewalFee:             keeper.GetRenewalFee(ctx),
+		GracePeriod:            keeper.GetGracePeriod(ctx),
 	}
 }
 
@@ -56,3 +61,30 @@ func (keeper Keeper) GetAllowUnrestrictedNames(ctx sdk.Context) (enabled bool) {
 	}
 	return
 }
+
+// GetLeaseDuration returns the current length of name leases, zero if leases are disabled (or default if unset)
+func (keeper Keeper) GetLeaseDuration(ctx sdk.Context) (duration time.Duration) {
+	duration = types.DefaultLeaseDuration
+	if keeper.paramSpace.Has(ctx, types.ParamStoreKeyLeaseDuration) {
+		keeper.paramSpace.Get(ctx, types.ParamStoreKeyLeaseDuration, &duration)
+	}
+	return
+}
+
+// GetRenewalFee returns the current fee charged for a name lease renewal (or default if unset)
+func (keeper Keeper) GetRenewalFee(ctx sdk.Context) (fee sdk.Coins) {
+	fee = types.DefaultRenewalFee
+	if keeper.paramSpace.Has(ctx, types.ParamStoreKeyRenewalFee) {
+		keeper.paramSpace.Get(ctx, types.ParamStoreKeyRenewalFee, &fee)
+	}
+	return
+}
+
+// GetGracePeriod returns the current time allowed after a lease expires before the name is released (or default if unset)
+func (keeper Keeper) GetGracePeriod(ctx sdk.Context) (period time.Duration) {
+	period = types.DefaultGracePeriod
+	if keeper.paramSpace.Has(ctx, types.ParamStoreKeyGracePeriod) {
+		keeper.paramSpace.Get(ctx, types.ParamStoreKeyGracePeriod, &period)
+	}
+	return
+}
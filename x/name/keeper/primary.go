@@ -0,0 +1,113 @@
+package keeper
+
+import (
+	"sort"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/name/types"
+)
+
+// SetPrimaryName designates a name bound to an address as its primary name. An empty name clears the primary name.
+func (keeper Keeper) SetPrimaryName(ctx sdk.Context, addr sdk.AccAddress, name string) error {
+	key, err := types.GetPrimaryNameKey(addr)
+	if err != nil {
+		return err
+	}
+	store := ctx.KVStore(keeper.storeKey)
+	if len(strings.TrimSpace(name)) == 0 {
+		if !store.Has(key) {
+			return nil
+		}
+		store.Delete(key)
+	} else {
+		if name, err = keeper.Normalize(ctx, name); err != nil {
+			return err
+		}
+		if !keeper.ResolvesTo(ctx, name, addr) {
+			return types.ErrNameNotOwned.Wrapf("%s", name)
+		}
+		store.Set(key, []byte(name))
+	}
+
+	primaryNameUpdateEvent := types.NewEventPrimaryNameUpdate(addr.String(), name)
+
+	if err := ctx.EventManager().EmitTypedEvent(primaryNameUpdateEvent); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetDesignatedPrimaryName returns the name an address has designated as its primary name, or empty if none.
+func (keeper Keeper) GetDesignatedPrimaryName(ctx sdk.Context, addr sdk.AccAddress) (string, error) {
+	key, err := types.GetPrimaryNameKey(addr)
+	if err != nil {
+		return "", err
+	}
+	return string(ctx.KVStore(keeper.storeKey).Get(key)), nil
+}
+
+// GetPrimaryName returns the primary name record of an address and whether it was designated by the address.
+// Addresses without a designated primary name fall back to the bound name with the fewest segments,
+// then the shortest name, then the first name alphabetically.
+func (keeper Keeper) GetPrimaryName(ctx sdk.Context, addr sdk.AccAddress) (*types.NameRecord, bool, error) {
+	name, err := keeper.GetDesignatedPrimaryName(ctx, addr)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(name) > 0 {
+		record, err := keeper.GetRecordByName(ctx, name)
+		if err != nil {
+			return nil, false, err
+		}
+		return record, true, nil
+	}
+	records, err := keeper.GetRecordsByAddress(ctx, addr)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(records) == 0 {
+		return nil, false, types.ErrNameNotBound
+	}
+	sort.SliceStable(records, func(i, j int) bool {
+		return lessPrimaryName(records[i].Name, records[j].Name)
+	})
+	return &records[0], false, nil
+}
+
+// lessPrimaryName returns true if name a should be preferred over name b as a fallback primary name.
+func lessPrimaryName(a, b string) bool {
+	if sa, sb := strings.Count(a, "."), strings.Count(b, "."); sa != sb {
+		return sa < sb
+	}
+	if len(a) != len(b) {
+		return len(a) < len(b)
+	}
+	return a < b
+}
+
+// clearPrimaryName removes the primary name of an address if it is the provided name.
+func (keeper Keeper) clearPrimaryName(ctx sdk.Context, addr sdk.AccAddress, name string) error {
+	current, err := keeper.GetDesignatedPrimaryName(ctx, addr)
+	if err != nil || current != name {
+		return err
+	}
+	return keeper.SetPrimaryName(ctx, addr, "")
+}
+
+// IteratePrimaryNames iterates over all the designated primary names and passes them to a callback function.
+// Iteration stops when the callback returns true.
+func (keeper Keeper) IteratePrimaryNames(ctx sdk.Context, handle func(primary types.PrimaryName) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.PrimaryNameKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		// [0x08] :: [addr-length] :: [addr-bytes]
+		addr := sdk.AccAddress(iterator.Key()[len(types.PrimaryNameKeyPrefix)+1:])
+		if handle(types.PrimaryName{Address: addr.String(), Name: string(iterator.Value())}) {
+			break
+		}
+	}
+}
@@ -0,0 +1,94 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/name/keeper"
+	nametypes "github.com/provenance-io/provenance/x/name/types"
+)
+
+func (s *KeeperTestSuite) TestPrimaryName() {
+	nk := s.app.NameKeeper
+	msgServer := keeper.NewMsgServerImpl(nk)
+	s.Require().NoError(nk.SetNameRecord(s.ctx, "zz.name", s.user1Addr, false), "binding zz.name")
+	s.Require().NoError(nk.SetNameRecord(s.ctx, "aa.name", s.user1Addr, false), "binding aa.name")
+	s.Require().NoError(nk.SetNameRecord(s.ctx, "other.name", s.user2Addr, false), "binding other.name")
+
+	primary := func(addr sdk.AccAddress) (string, bool) {
+		resp, err := nk.PrimaryName(sdk.WrapSDKContext(s.ctx), &nametypes.QueryPrimaryNameRequest{Address: addr.String()})
+		s.Require().NoError(err, "PrimaryName(%s)", addr)
+		return resp.Record.Name, resp.Designated
+	}
+
+	s.Run("fallback ordering", func() {
+		name, designated := primary(s.user1Addr)
+		s.Assert().Equal("name", name, "fewest segments first")
+		s.Assert().False(designated, "designated")
+
+		s.Require().NoError(nk.DeleteRecord(s.ctx, "name"), "deleting name")
+		name, _ = primary(s.user1Addr)
+		s.Assert().Equal("aa.name", name, "shortest then alphabetical")
+	})
+
+	s.Run("name must be bound to the address", func() {
+		_, err := msgServer.SetPrimaryName(sdk.WrapSDKContext(s.ctx), nametypes.NewMsgSetPrimaryNameRequest("other.name", s.user1Addr))
+		s.Assert().ErrorContains(err, nametypes.ErrNameNotOwned.Error())
+	})
+
+	s.Run("designated primary name", func() {
+		_, err := msgServer.SetPrimaryName(sdk.WrapSDKContext(s.ctx), nametypes.NewMsgSetPrimaryNameRequest("ZZ.Name", s.user1Addr))
+		s.Require().NoError(err, "SetPrimaryName")
+		name, designated := primary(s.user1Addr)
+		s.Assert().Equal("zz.name", name, "primary name")
+		s.Assert().True(designated, "designated")
+
+		genesis := nk.ExportGenesis(s.ctx)
+		s.Assert().Equal([]nametypes.PrimaryName{{Address: s.user1, Name: "zz.name"}}, genesis.PrimaryNames, "exported primary names")
+		s.Assert().NoError(genesis.Validate(), "exported genesis validate")
+	})
+
+	s.Run("cleared when transferred", func() {
+		s.Require().NoError(nk.ModifyNameRecord(s.ctx, "zz.name", s.user2Addr, false), "transferring zz.name")
+		name, designated := primary(s.user1Addr)
+		s.Assert().Equal("aa.name", name, "primary name after transfer")
+		s.Assert().False(designated, "designated after transfer")
+	})
+
+	s.Run("cleared when deleted", func() {
+		s.Require().NoError(nk.SetPrimaryName(s.ctx, s.user1Addr, "aa.name"), "SetPrimaryName")
+		s.Require().NoError(nk.SetNameRecord(s.ctx, "ab.name", s.user1Addr, false), "binding ab.name")
+		s.Require().NoError(nk.DeleteRecord(s.ctx, "aa.name"), "deleting aa.name")
+		name, designated := primary(s.user1Addr)
+		s.Assert().Equal("ab.name", name, "primary name after delete")
+		s.Assert().False(designated, "designated after delete")
+	})
+
+	s.Run("cleared explicitly", func() {
+		s.Require().NoError(nk.SetPrimaryName(s.ctx, s.user2Addr, "zz.name"), "SetPrimaryName")
+		_, err := msgServer.SetPrimaryName(sdk.WrapSDKContext(s.ctx), nametypes.NewMsgSetPrimaryNameRequest("", s.user2Addr))
+		s.Require().NoError(err, "clearing primary name")
+		name, designated := primary(s.user2Addr)
+		s.Assert().Equal("zz.name", name, "fallback primary name")
+		s.Assert().False(designated, "designated")
+	})
+
+	s.Run("no names", func() {
+		_, err := nk.PrimaryName(sdk.WrapSDKContext(s.ctx), &nametypes.QueryPrimaryNameRequest{Address: sdk.AccAddress("no_names_address____").String()})
+		s.Assert().ErrorIs(err, nametypes.ErrNameNotBound)
+	})
+}
+
+func (s *KeeperTestSuite) TestPrimaryNameGenesisValidate() {
+	genesis := nametypes.GenesisState{
+		Params:       nametypes.DefaultParams(),
+		Bindings:     nametypes.NameRecords{nametypes.NewNameRecord("example.name", s.user1Addr, false)},
+		PrimaryNames: []nametypes.PrimaryName{{Address: s.user1, Name: "example.name"}},
+	}
+	s.Assert().NoError(genesis.Validate(), "valid primary name")
+
+	genesis.PrimaryNames = []nametypes.PrimaryName{{Address: s.user2, Name: "example.name"}}
+	s.Assert().EqualError(genesis.Validate(), `primary name "example.name" is not bound to address `+s.user2, "primary name bound to another address")
+
+	genesis.PrimaryNames = []nametypes.PrimaryName{{Address: s.user1, Name: "example.name"}, {Address: s.user1, Name: "example.name"}}
+	s.Assert().EqualError(genesis.Validate(), "duplicate primary name for address "+s.user1, "duplicate primary names")
+}
@@ -66,7 +66,7 @@ func queryLookupNames(ctx sdk.Context, path []string, _ abci.RequestQuery, keepe
 	if addrs == "" {
 		return nil, sdkerrors.ErrInvalidRequest.Wrap("address cannot be empty")
 	}
-	addr, err := sdk.AccAddressFromBech32(addrs)
+	addr, err := types.ParseTargetAddress(addrs)
 	if err != nil {
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
@@ -1,7 +1,9 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
+	"math"
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -43,7 +45,7 @@ func (keeper Keeper) ReverseLookup(c context.Context, request *types.QueryRevers
 	ctx := sdk.UnwrapSDKContext(c)
 	names := make([]string, 0)
 	store := ctx.KVStore(keeper.storeKey)
-	accAddr, err := sdk.AccAddressFromBech32(request.Address)
+	accAddr, err := types.ParseTargetAddress(request.Address)
 	if err != nil {
 		return nil, types.ErrInvalidAddress
 	}
@@ -73,3 +75,79 @@ func (keeper Keeper) ReverseLookup(c context.Context, request *types.QueryRevers
 
 	return &types.QueryReverseLookupResponse{Name: names, Pagination: pageRes}, nil
 }
+
+// Children gets the names bound under a name.
+func (keeper Keeper) Children(c context.Context, request *types.QueryChildrenRequest) (*types.QueryChildrenResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	name, err := keeper.Normalize(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	if !keeper.NameExists(ctx, name) {
+		return nil, types.ErrNameNotBound
+	}
+	depth := int(request.Depth)
+	if depth == 0 {
+		depth = 1
+	}
+	if depth >= math.MaxUint8 {
+		depth = math.MaxUint8 - 1
+	}
+	key, err := types.GetParentKeyPrefix(name)
+	if err != nil {
+		return nil, err
+	}
+	records := make([]types.NameRecord, 0)
+	store := ctx.KVStore(keeper.storeKey)
+	// The parent index is ordered by depth, so only the entries within the requested depth are iterated.
+	childStore := depthLimitedStore{KVStore: prefix.NewStore(store, key), end: []byte{byte(depth + 1)}}
+	pageRes, err := query.Paginate(childStore, request.Pagination, func(key []byte, value []byte) error {
+		record, err := getNameRecord(ctx, keeper, value)
+		if err != nil {
+			return err
+		}
+		records = append(records, *record)
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryChildrenResponse{Records: records, Pagination: pageRes}, nil
+}
+
+// depthLimitedStore limits iteration over a parent index store to the names within a number of levels of the parent.
+type depthLimitedStore struct {
+	sdk.KVStore
+	end []byte
+}
+
+func (s depthLimitedStore) Iterator(start, end []byte) sdk.Iterator {
+	return s.KVStore.Iterator(start, s.limit(end))
+}
+
+func (s depthLimitedStore) ReverseIterator(start, end []byte) sdk.Iterator {
+	return s.KVStore.ReverseIterator(start, s.limit(end))
+}
+
+func (s depthLimitedStore) limit(end []byte) []byte {
+	if end == nil || bytes.Compare(end, s.end) > 0 {
+		return s.end
+	}
+	return end
+}
+
+// PrimaryName gets the primary name of an address.
+func (keeper Keeper) PrimaryName(c context.Context, request *types.QueryPrimaryNameRequest) (*types.QueryPrimaryNameResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	accAddr, err := sdk.AccAddressFromBech32(request.Address)
+	if err != nil {
+		return nil, types.ErrInvalidAddress
+	}
+	record, designated, err := keeper.GetPrimaryName(ctx, accAddr)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryPrimaryNameResponse{Record: *record, Designated: designated}, nil
+}
@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	metadatatypes "github.com/provenance-io/provenance/x/metadata/types"
+	"github.com/provenance-io/provenance/x/name/types"
+)
+
+// GetMetadataOwners returns the owner addresses of a scope, scope specification or contract specification.
+func (keeper Keeper) GetMetadataOwners(ctx sdk.Context, target metadatatypes.MetadataAddress) ([]string, error) {
+	switch {
+	case target.IsScopeAddress():
+		scope, found := keeper.metadataKeeper.GetScope(ctx, target)
+		if !found {
+			return nil, types.ErrInvalidAddress.Wrapf("scope %s not found", target)
+		}
+		owners := make([]string, 0, len(scope.Owners))
+		for _, owner := range scope.Owners {
+			owners = append(owners, owner.Address)
+		}
+		return owners, nil
+	case target.IsScopeSpecificationAddress():
+		spec, found := keeper.metadataKeeper.GetScopeSpecification(ctx, target)
+		if !found {
+			return nil, types.ErrInvalidAddress.Wrapf("scope specification %s not found", target)
+		}
+		return spec.OwnerAddresses, nil
+	case target.IsContractSpecificationAddress():
+		spec, found := keeper.metadataKeeper.GetContractSpecification(ctx, target)
+		if !found {
+			return nil, types.ErrInvalidAddress.Wrapf("contract specification %s not found", target)
+		}
+		return spec.OwnerAddresses, nil
+	default:
+		return nil, types.ErrInvalidAddress.Wrapf("names cannot be bound to %s", target)
+	}
+}
+
+// ControlsTarget returns true if the address is the account address a name is bound to,
+// or an owner of the metadata object a name is bound to.
+func (keeper Keeper) ControlsTarget(ctx sdk.Context, target string, addr sdk.AccAddress) bool {
+	if target == addr.String() {
+		return true
+	}
+	metadataAddr, err := types.ParseMetadataTarget(target)
+	if err != nil {
+		return false
+	}
+	owners, err := keeper.GetMetadataOwners(ctx, metadataAddr)
+	if err != nil {
+		return false
+	}
+	for _, owner := range owners {
+		if owner == addr.String() {
+			return true
+		}
+	}
+	return false
+}
+
+// IsNameOwner returns true if the address controls the target of a name.
+// Names bound to a metadata object are controlled by the owners of that object.
+func (keeper Keeper) IsNameOwner(ctx sdk.Context, name string, addr sdk.AccAddress) bool { //nolint:interfacer
+	record, err := keeper.GetRecordByName(ctx, name)
+	if err != nil {
+		return false
+	}
+	return keeper.ControlsTarget(ctx, record.Address, addr)
+}
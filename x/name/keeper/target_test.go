@@ -0,0 +1,89 @@
+package keeper_test
+
+import (
+	"github.com/google/uuid"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	metadatatypes "github.com/provenance-io/provenance/x/metadata/types"
+	"github.com/provenance-io/provenance/x/name/keeper"
+	nametypes "github.com/provenance-io/provenance/x/name/types"
+)
+
+func (s *KeeperTestSuite) TestMetadataTargets() {
+	nk := s.app.NameKeeper
+	msgServer := keeper.NewMsgServerImpl(nk)
+	scopeID := metadatatypes.ScopeMetadataAddress(uuid.New())
+	s.app.MetadataKeeper.SetScope(s.ctx, metadatatypes.Scope{
+		ScopeId:           scopeID,
+		Owners:            []metadatatypes.Party{{Address: s.user1, Role: metadatatypes.PartyType_PARTY_TYPE_OWNER}},
+		ValueOwnerAddress: s.user1,
+	})
+	specID := metadatatypes.ScopeSpecMetadataAddress(uuid.New())
+	s.app.MetadataKeeper.SetScopeSpecification(s.ctx, metadatatypes.ScopeSpecification{
+		SpecificationId: specID,
+		Description:     metadatatypes.NewDescription("spec", "", "", ""),
+		OwnerAddresses:  []string{s.user2},
+		PartiesInvolved: []metadatatypes.PartyType{metadatatypes.PartyType_PARTY_TYPE_OWNER},
+	})
+	bind := func(name, target, signer string) error {
+		_, err := msgServer.BindName(sdk.WrapSDKContext(s.ctx), nametypes.NewMsgBindNameRequest(
+			nametypes.NameRecord{Name: name, Address: target},
+			nametypes.NameRecord{Name: "name", Address: signer},
+		))
+		return err
+	}
+
+	s.Run("bind to scope", func() {
+		s.Require().NoError(bind("loanpool", scopeID.String(), s.user1), "binding loanpool.name")
+		resolved, err := nk.Resolve(sdk.WrapSDKContext(s.ctx), &nametypes.QueryResolveRequest{Name: "loanpool.name"})
+		s.Require().NoError(err, "Resolve")
+		s.Assert().Equal(scopeID.String(), resolved.Address, "resolved address")
+		lookup, err := nk.ReverseLookup(sdk.WrapSDKContext(s.ctx), &nametypes.QueryReverseLookupRequest{Address: scopeID.String()})
+		s.Require().NoError(err, "ReverseLookup")
+		s.Assert().Equal([]string{"loanpool.name"}, lookup.Name, "reverse lookup names")
+	})
+
+	s.Run("bind to scope specification", func() {
+		s.Require().NoError(bind("spec", specID.String(), s.user2), "binding spec.name")
+		s.Assert().True(nk.IsNameOwner(s.ctx, "spec.name", s.user2Addr), "spec owner controls spec.name")
+		s.Assert().False(nk.IsNameOwner(s.ctx, "spec.name", s.user1Addr), "other address controls spec.name")
+	})
+
+	s.Run("signer must own the target", func() {
+		err := bind("stolen", scopeID.String(), s.user2)
+		s.Assert().ErrorContains(err, "msg sender is not an owner of the metadata address")
+	})
+
+	s.Run("unknown scope", func() {
+		err := bind("unknown", metadatatypes.ScopeMetadataAddress(uuid.New()).String(), s.user1)
+		s.Assert().ErrorContains(err, "msg sender is not an owner of the metadata address")
+	})
+
+	s.Run("unsupported metadata address", func() {
+		err := bind("session", metadatatypes.SessionMetadataAddress(uuid.New(), uuid.New()).String(), s.user1)
+		s.Assert().ErrorContains(err, "names cannot be bound to")
+	})
+
+	s.Run("delete requires a target owner", func() {
+		_, err := msgServer.DeleteName(sdk.WrapSDKContext(s.ctx), nametypes.NewMsgDeleteNameRequest(nametypes.NewNameRecord("loanpool.name", s.user2Addr, false)))
+		s.Assert().ErrorContains(err, "msg sender cannot delete name")
+		_, err = msgServer.DeleteName(sdk.WrapSDKContext(s.ctx), nametypes.NewMsgDeleteNameRequest(nametypes.NewNameRecord("loanpool.name", s.user1Addr, false)))
+		s.Require().NoError(err, "deleting loanpool.name as scope owner")
+		s.Assert().False(nk.NameExists(s.ctx, "loanpool.name"), "loanpool.name exists")
+	})
+
+	s.Run("transfer to scope", func() {
+		s.Require().NoError(nk.SetNameRecord(s.ctx, "transfer.name", s.user1Addr, false), "binding transfer.name")
+		_, err := msgServer.ModifyName(sdk.WrapSDKContext(s.ctx), nametypes.NewMsgModifyNameRequest(s.user1, nametypes.NameRecord{Name: "transfer.name", Address: scopeID.String()}))
+		s.Require().NoError(err, "transferring transfer.name to scope")
+		records, err := nk.GetRecordsByAddress(s.ctx, sdk.AccAddress(scopeID))
+		s.Require().NoError(err, "GetRecordsByAddress(scope)")
+		s.Assert().Equal(nametypes.NameRecords{{Name: "transfer.name", Address: scopeID.String()}}, records, "records bound to scope")
+		records, err = nk.GetRecordsByAddress(s.ctx, s.user1Addr)
+		s.Require().NoError(err, "GetRecordsByAddress(user1)")
+		for _, record := range records {
+			s.Assert().NotEqual("transfer.name", record.Name, "transferred name still indexed under user1")
+		}
+	})
+}
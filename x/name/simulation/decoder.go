@@ -22,13 +22,15 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvB.Value, &nameB)
 
 			return fmt.Sprintf("%v\n%v", nameA, nameB)
-		case bytes.Equal(kvA.Key[:1], types.AddressKeyPrefix):
+		case bytes.Equal(kvA.Key[:1], types.AddressKeyPrefix), bytes.Equal(kvA.Key[:1], types.ParentKeyPrefix):
 			var nameA, nameB types.NameRecord
 
 			cdc.MustUnmarshal(kvA.Value, &nameA)
 			cdc.MustUnmarshal(kvB.Value, &nameB)
 
 			return fmt.Sprintf("%v\n%v", nameA, nameB)
+		case bytes.Equal(kvA.Key[:1], types.ExpirationKeyPrefix), bytes.Equal(kvA.Key[:1], types.PrimaryNameKeyPrefix):
+			return fmt.Sprintf("%s\n%s", kvA.Value, kvB.Value)
 		default:
 			panic(fmt.Sprintf("unexpected %s key %X (%s)", types.ModuleName, kvA.Key, kvA.Key))
 		}
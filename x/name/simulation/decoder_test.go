@@ -24,6 +24,9 @@ func TestDecodeStore(t *testing.T) {
 		Pairs: []kv.Pair{
 			{Key: types.NameKeyPrefix, Value: cdc.MustMarshal(&testNameRecord)},
 			{Key: types.AddressKeyPrefix, Value: cdc.MustMarshal(&testNameRecord)},
+			{Key: types.ParentKeyPrefix, Value: cdc.MustMarshal(&testNameRecord)},
+			{Key: types.ExpirationKeyPrefix, Value: []byte(testNameRecord.Name)},
+			{Key: types.PrimaryNameKeyPrefix, Value: []byte(testNameRecord.Name)},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
 	}
@@ -34,6 +37,9 @@ func TestDecodeStore(t *testing.T) {
 	}{
 		{"Name Record", fmt.Sprintf("%v\n%v", testNameRecord, testNameRecord)},
 		{"Address Cache", fmt.Sprintf("%v\n%v", testNameRecord, testNameRecord)},
+		{"Parent Index", fmt.Sprintf("%v\n%v", testNameRecord, testNameRecord)},
+		{"Expiration Index", "test\ntest"},
+		{"Primary Name", "test\ntest"},
 		{"other", ""},
 	}
 
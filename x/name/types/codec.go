@@ -14,6 +14,9 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(MsgBindNameRequest{}, "provenance/MsgBindNameRequest", nil)
 	cdc.RegisterConcrete(MsgDeleteNameRequest{}, "provenance/MsgDeleteNameRequest", nil)
+	cdc.RegisterConcrete(MsgModifyNameRequest{}, "provenance/MsgModifyNameRequest", nil)
+	cdc.RegisterConcrete(MsgRenewNameRequest{}, "provenance/MsgRenewNameRequest", nil)
+	cdc.RegisterConcrete(MsgSetPrimaryNameRequest{}, "provenance/MsgSetPrimaryNameRequest", nil)
 	cdc.RegisterConcrete(CreateRootNameProposal{}, "provenance/CreateRootNameProposal", nil)
 }
 
@@ -23,6 +26,9 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgBindNameRequest{},
 		&MsgDeleteNameRequest{},
+		&MsgModifyNameRequest{},
+		&MsgRenewNameRequest{},
+		&MsgSetPrimaryNameRequest{},
 	)
 
 	registry.RegisterImplementations(
@@ -22,4 +22,10 @@ var (
 	ErrInvalidAddress = cerrs.Register(ModuleName, 8, "invalid account address")
 	// ErrNameContainsSegments indicates a multi-segment name in a single segment context.
 	ErrNameContainsSegments = cerrs.Register(ModuleName, 9, "invalid name: \".\" is reserved")
+	// ErrNameNotLeased indicates a renewal was requested for a name without a lease.
+	ErrNameNotLeased = cerrs.Register(ModuleName, 10, "name does not have a lease")
+	// ErrNameNotOwned indicates a name is not bound to the address it is being used for.
+	ErrNameNotOwned = cerrs.Register(ModuleName, 11, "name is not bound to address")
+	// ErrNameConfusable indicates a name segment could be visually mistaken for a different name.
+	ErrNameConfusable = cerrs.Register(ModuleName, 12, "name contains confusable characters")
 )
@@ -1,10 +1,18 @@
 package types
 
+import "time"
+
 const (
 	// EventTypeNameBound is the type of event generated when a name is bound to an address.
 	EventTypeNameBound string = "name_bound"
 	// EventTypeNameUnbound is the type of event generated when a name is unbound from an address (deleted).
 	EventTypeNameUnbound string = "name_unbound"
+	// EventTypeNameUpdate is the type of event generated when a name's address or restricted flag is changed.
+	EventTypeNameUpdate string = "name_update"
+	// EventTypeNameRenewed is the type of event generated when the lease on a name is renewed.
+	EventTypeNameRenewed string = "name_renewed"
+	// EventTypePrimaryNameUpdate is the type of event generated when an address sets its primary name.
+	EventTypePrimaryNameUpdate string = "primary_name_update"
 
 	// KeyAttributeName is the key for a name.
 	KeyAttributeName string = "name"
@@ -27,3 +35,42 @@ func NewEventNameUnbound(address string, name string, restricted bool) *EventNam
 		Restricted: restricted,
 	}
 }
+
+func NewEventNameUpdate(address string, name string, restricted bool) *EventNameUpdate {
+	return &EventNameUpdate{
+		Address:    address,
+		Name:       name,
+		Restricted: restricted,
+	}
+}
+
+func NewEventNameRenewed(address string, name string, expiration *time.Time) *EventNameRenewed {
+	return &EventNameRenewed{
+		Address:    address,
+		Name:       name,
+		Expiration: expiration,
+	}
+}
+
+func NewEventNameExpired(address string, name string, expiration *time.Time) *EventNameExpired {
+	return &EventNameExpired{
+		Address:    address,
+		Name:       name,
+		Expiration: expiration,
+	}
+}
+
+func NewEventPrimaryNameUpdate(address string, name string) *EventPrimaryNameUpdate {
+	return &EventPrimaryNameUpdate{
+		Address: address,
+		Name:    name,
+	}
+}
+
+func NewEventNameConversionFailed(address string, name string, reason string) *EventNameConversionFailed {
+	return &EventNameConversionFailed{
+		Address: address,
+		Name:    name,
+		Reason:  reason,
+	}
+}
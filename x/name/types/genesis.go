@@ -30,6 +30,17 @@ func (nrs NameRecords) Contains(name string) bool {
 	return false
 }
 
+// ResolvesTo returns true if the given name exists in a slice of NameRecord genesis objects and is bound to the address.
+func (nrs NameRecords) ResolvesTo(name string, address string) bool {
+	for _, nr := range nrs {
+		if nr.Name == strings.ToLower(strings.TrimSpace(name)) {
+			return nr.Address == address
+		}
+	}
+
+	return false
+}
+
 // GetGenesisStateFromAppState returns x/name GenesisState given raw application genesis state.
 func GetGenesisStateFromAppState(cdc codec.Codec, appState map[string]json.RawMessage) *GenesisState {
 	var genesisState GenesisState
@@ -50,13 +61,27 @@ func (state GenesisState) Validate() error {
 			return fmt.Errorf("address cannot be empty")
 		}
 	}
+	seen := make(map[string]bool, len(state.PrimaryNames))
+	for _, primary := range state.PrimaryNames {
+		if strings.TrimSpace(primary.Address) == "" {
+			return fmt.Errorf("primary name address cannot be empty")
+		}
+		if seen[primary.Address] {
+			return fmt.Errorf("duplicate primary name for address %s", primary.Address)
+		}
+		seen[primary.Address] = true
+		if !NameRecords(state.Bindings).ResolvesTo(primary.Name, primary.Address) {
+			return fmt.Errorf("primary name %q is not bound to address %s", primary.Name, primary.Address)
+		}
+	}
 	return nil
 }
 
 // DefaultGenesisState returns the initial set of name -> address bindings.
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Params:   DefaultParams(),
-		Bindings: NameRecords{},
+		Params:       DefaultParams(),
+		Bindings:     NameRecords{},
+		PrimaryNames: []PrimaryName{},
 	}
 }
@@ -29,6 +29,8 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// bindings defines all the name records present at genesis
 	Bindings []NameRecord `protobuf:"bytes,2,rep,name=bindings,proto3" json:"bindings"`
+	// primary_names defines the primary names designated by addresses at genesis
+	PrimaryNames []PrimaryName `protobuf:"bytes,3,rep,name=primary_names,json=primaryNames,proto3" json:"primary_names"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -71,23 +73,25 @@ func init() {
 func init() { proto.RegisterFile("provenance/name/v1/genesis.proto", fileDescriptor_dba8546991615694) }
 
 var fileDescriptor_dba8546991615694 = []byte{
-	// 254 bytes of a gzipped FileDescriptorProto
+	// 284 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x28, 0x28, 0xca, 0x2f,
 	0x4b, 0xcd, 0x4b, 0xcc, 0x4b, 0x4e, 0xd5, 0xcf, 0x4b, 0xcc, 0x4d, 0xd5, 0x2f, 0x33, 0xd4, 0x4f,
 	0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x42, 0xa8,
 	0xd0, 0x03, 0xa9, 0xd0, 0x2b, 0x33, 0x94, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x4b, 0xeb, 0x83,
-	0x58, 0x10, 0x95, 0x52, 0xb2, 0x58, 0xcc, 0x02, 0xeb, 0x00, 0x4b, 0x2b, 0x4d, 0x61, 0xe4, 0xe2,
+	0x58, 0x10, 0x95, 0x52, 0xb2, 0x58, 0xcc, 0x02, 0xeb, 0x00, 0x4b, 0x2b, 0x3d, 0x60, 0xe4, 0xe2,
 	0x71, 0x87, 0x18, 0x1d, 0x5c, 0x92, 0x58, 0x92, 0x2a, 0x64, 0xc1, 0xc5, 0x56, 0x90, 0x58, 0x94,
 	0x98, 0x5b, 0x2c, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x6d, 0x24, 0xa5, 0x87, 0x69, 0x95, 0x5e, 0x00,
 	0x58, 0x85, 0x13, 0xcb, 0x89, 0x7b, 0xf2, 0x0c, 0x41, 0x50, 0xf5, 0x42, 0x0e, 0x5c, 0x1c, 0x49,
 	0x99, 0x79, 0x29, 0x99, 0x79, 0xe9, 0xc5, 0x12, 0x4c, 0x0a, 0xcc, 0x1a, 0xdc, 0x46, 0x72, 0xd8,
-	0xf4, 0xfa, 0x25, 0xe6, 0xa6, 0x06, 0xa5, 0x26, 0xe7, 0x17, 0xa5, 0x40, 0xf5, 0xc3, 0x75, 0x59,
-	0x71, 0x74, 0x2c, 0x90, 0x67, 0x78, 0xb1, 0x40, 0x9e, 0xc1, 0x29, 0xf9, 0xc4, 0x23, 0x39, 0xc6,
-	0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39,
-	0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xb8, 0x44, 0x33, 0xf3, 0xb1, 0x98, 0x1a, 0xc0, 0x18, 0x65, 0x90,
-	0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x8f, 0x50, 0xa0, 0x9b, 0x99, 0x8f,
-	0xc4, 0xd3, 0xaf, 0x80, 0x84, 0x41, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0x38, 0x08, 0x8c,
-	0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0xcb, 0x53, 0xef, 0x56, 0x6f, 0x01, 0x00, 0x00,
+	0xf4, 0xfa, 0x25, 0xe6, 0xa6, 0x06, 0xa5, 0x26, 0xe7, 0x17, 0xa5, 0x40, 0xf5, 0xc3, 0x75, 0x09,
+	0x79, 0x71, 0xf1, 0x16, 0x14, 0x65, 0xe6, 0x26, 0x16, 0x55, 0xc6, 0x83, 0x54, 0x17, 0x4b, 0x30,
+	0x83, 0x8d, 0x91, 0xc7, 0xea, 0x04, 0x88, 0x42, 0x90, 0x69, 0x50, 0x73, 0x78, 0x0a, 0x10, 0x42,
+	0xc5, 0x56, 0x1c, 0x1d, 0x0b, 0xe4, 0x19, 0x5e, 0x2c, 0x90, 0x67, 0x70, 0x4a, 0x3e, 0xf1, 0x48,
+	0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0,
+	0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x06, 0x2e, 0xd1, 0xcc, 0x7c, 0x2c, 0x46, 0x07, 0x30, 0x46,
+	0x19, 0xa4, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x23, 0x14, 0xe8, 0x66,
+	0xe6, 0x23, 0xf1, 0xf4, 0x2b, 0x20, 0xe1, 0x59, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0x0e,
+	0x4e, 0x63, 0xc0, 0x00, 0x8e, 0x74, 0x10, 0x6d, 0xbb, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -110,6 +114,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PrimaryNames) > 0 {
+		for iNdEx := len(m.PrimaryNames) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PrimaryNames[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Bindings) > 0 {
 		for iNdEx := len(m.Bindings) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -162,6 +180,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.PrimaryNames) > 0 {
+		for _, e := range m.PrimaryNames {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -267,6 +291,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimaryNames", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrimaryNames = append(m.PrimaryNames, PrimaryName{})
+			if err := m.PrimaryNames[len(m.PrimaryNames)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
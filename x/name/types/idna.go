@@ -0,0 +1,173 @@
+package types
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/net/idna"
+)
+
+// ACEPrefix is the prefix of a name segment stored in its punycode (ASCII compatible encoding) form.
+const ACEPrefix = "xn--"
+
+// idnaProfile maps unicode name segments using the non-transitional UTS-46 mapping and validates them
+// according to IDNA 2008 (including the hyphen, joiner and bidi rules).
+var idnaProfile = idna.New(
+	idna.MapForLookup(),
+	idna.Transitional(false),
+	idna.BidiRule(),
+)
+
+// allowedScriptSets are the combinations of scripts that may be mixed within a single name segment.
+// These are the combinations allowed by the "Highly Restrictive" level of UTS-39.
+var allowedScriptSets = []map[string]bool{
+	{"Latin": true, "Han": true, "Hiragana": true, "Katakana": true},
+	{"Latin": true, "Han": true, "Bopomofo": true},
+	{"Latin": true, "Han": true, "Hangul": true},
+}
+
+// latinConfusables are non-latin letters that are visually indistinguishable from a latin letter or digit.
+var latinConfusables = map[rune]bool{
+	// Cyrillic
+	'а': true, 'в': true, 'е': true, 'к': true, 'м': true, 'н': true, 'о': true, 'р': true, 'с': true,
+	'т': true, 'у': true, 'х': true, 'ѕ': true, 'і': true, 'ј': true, 'ԁ': true, 'ԛ': true, 'ԝ': true,
+	'һ': true, 'ӏ': true, 'ь': true, 'ѵ': true, 'ү': true,
+	// Greek
+	'α': true, 'β': true, 'γ': true, 'ε': true, 'ι': true, 'κ': true, 'ν': true, 'ο': true, 'ρ': true,
+	'τ': true, 'υ': true, 'χ': true,
+	// Armenian
+	'օ': true, 'ո': true, 'ս': true, 'հ': true, 'ց': true, 'զ': true,
+}
+
+// IsACE returns true if the name segment is in its punycode (ASCII compatible encoding) form.
+func IsACE(segment string) bool {
+	return strings.HasPrefix(segment, ACEPrefix)
+}
+
+// IsASCII returns true if the string only contains ASCII characters.
+func IsASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
+
+// ToASCIISegment converts a unicode name segment into its stored punycode form after mapping it through IDNA/UTS-46.
+// Segments that are already plain ASCII (and not punycode) are returned unchanged.
+func ToASCIISegment(segment string) (string, error) {
+	if IsASCII(segment) && !IsACE(segment) {
+		return segment, nil
+	}
+	encoded, err := idnaProfile.ToASCII(segment)
+	if err != nil {
+		return "", ErrNameInvalid.Wrapf("%q: %v", segment, err)
+	}
+	if strings.Contains(encoded, ".") {
+		return "", ErrNameContainsSegments.Wrapf("%q", segment)
+	}
+	decoded, err := idnaProfile.ToUnicode(encoded)
+	if err != nil {
+		return "", ErrNameInvalid.Wrapf("%q: %v", segment, err)
+	}
+	if !IsValidUnicodeSegment(decoded) {
+		return "", ErrNameInvalid.Wrapf("%q", segment)
+	}
+	if isConfusable(decoded) {
+		return "", ErrNameConfusable.Wrapf("%q", segment)
+	}
+	return encoded, nil
+}
+
+// IsValidUnicodeSegment returns true if the unicode form of a name segment only contains lowercase or caseless
+// letters, combining marks, digits, and at most a single dash.
+func IsValidUnicodeSegment(segment string) bool {
+	if strings.Count(segment, "-") > 1 {
+		return false
+	}
+	for _, c := range segment {
+		switch {
+		case c == '-', unicode.IsDigit(c), unicode.IsMark(c):
+			continue
+		case unicode.IsLetter(c) && !unicode.IsUpper(c) && !unicode.IsTitle(c):
+			continue
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// NameToUnicode converts the punycode segments of a name into their unicode form for display.
+// Segments that cannot be decoded are left as they are.
+func NameToUnicode(name string) string {
+	if !strings.Contains(name, ACEPrefix) {
+		return name
+	}
+	comps := strings.Split(name, ".")
+	for i, comp := range comps {
+		if !IsACE(comp) {
+			continue
+		}
+		if decoded, err := idnaProfile.ToUnicode(comp); err == nil {
+			comps[i] = decoded
+		}
+	}
+	return strings.Join(comps, ".")
+}
+
+// isConfusable returns true if a name segment could be mistaken for a different name. This is the case when it
+// mixes scripts in a way not allowed by UTS-39, or when it is written in a single non-latin script using
+// only letters that look like latin letters.
+func isConfusable(segment string) bool {
+	scripts := map[string]bool{}
+	allLatinLookalikes := true
+	for _, c := range segment {
+		if !unicode.IsLetter(c) {
+			continue
+		}
+		if script := scriptOf(c); script != "" {
+			scripts[script] = true
+		}
+		if !latinConfusables[c] {
+			allLatinLookalikes = false
+		}
+	}
+	if len(scripts) == 0 {
+		return false
+	}
+	if len(scripts) > 1 {
+		for _, allowed := range allowedScriptSets {
+			if isSubset(scripts, allowed) {
+				return false
+			}
+		}
+		return true
+	}
+	return !scripts["Latin"] && allLatinLookalikes
+}
+
+// scriptOf returns the name of the unicode script of a rune, ignoring the Common and Inherited pseudo-scripts.
+func scriptOf(c rune) string {
+	for name, table := range unicode.Scripts {
+		if name == "Common" || name == "Inherited" {
+			continue
+		}
+		if unicode.Is(table, c) {
+			return name
+		}
+	}
+	return ""
+}
+
+// isSubset returns true if every entry in set is also in of.
+func isSubset(set, of map[string]bool) bool {
+	for k := range set {
+		if !of[k] {
+			return false
+		}
+	}
+	return true
+}
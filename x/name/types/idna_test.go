@@ -0,0 +1,45 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToASCIISegment(t *testing.T) {
+	cases := map[string]struct {
+		segment  string
+		expected string
+		errValue string
+	}{
+		"plain ascii unchanged":        {"example", "example", ""},
+		"latin with diacritics":        {"mañana", "xn--maana-pta", ""},
+		"cyrillic":                     {"пример", "xn--e1afmkfd", ""},
+		"already punycode":             {"xn--e1afmkfd", "xn--e1afmkfd", ""},
+		"japanese scripts mixed":       {"日本語テキスト", "xn--nckya0bk5909dcvb2w6i", ""},
+		"latin and cyrillic mixed":     {"paypаl", "", `"paypаl": name contains confusable characters`},
+		"cyrillic latin look-alikes":   {"рау", "", `"рау": name contains confusable characters`},
+		"symbols":                      {"ab☃", "", `"ab☃": value provided for name is invalid`},
+		"maps to multiple segments":    {"ab。cd", "", `"ab。cd": invalid name: "." is reserved`},
+		"invalid punycode":             {"xn--abc", "", `"xn--abc": idna: invalid label "\u0082\u0081\u0080": value provided for name is invalid`},
+		"unicode with too many dashes": {"при-мер-ы", "", `"при-мер-ы": value provided for name is invalid`},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			actual, err := ToASCIISegment(tc.segment)
+			if len(tc.errValue) > 0 {
+				require.EqualError(t, err, tc.errValue, "ToASCIISegment(%q) error", tc.segment)
+				return
+			}
+			require.NoError(t, err, "ToASCIISegment(%q) error", tc.segment)
+			assert.Equal(t, tc.expected, actual, "ToASCIISegment(%q)", tc.segment)
+		})
+	}
+}
+
+func TestNameToUnicode(t *testing.T) {
+	assert.Equal(t, "example.name", NameToUnicode("example.name"), "ascii name")
+	assert.Equal(t, "пример.mañana.pb", NameToUnicode("xn--e1afmkfd.xn--maana-pta.pb"), "punycode segments")
+	assert.Equal(t, "xn--abc.pb", NameToUnicode("xn--abc.pb"), "invalid punycode segment")
+}
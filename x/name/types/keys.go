@@ -2,8 +2,11 @@ package types
 
 import (
 	"crypto/sha256"
+	"encoding/binary"
 	"fmt"
+	"math"
 	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
@@ -34,6 +37,12 @@ var (
 	NameKeyPrefix = []byte{0x03}
 	// AddressKeyPrefix is a prefix added to keys for indexing name records by address.
 	AddressKeyPrefix = []byte{0x05}
+	// ParentKeyPrefix is a prefix added to keys for indexing name records by each of their parent names.
+	ParentKeyPrefix = []byte{0x06}
+	// ExpirationKeyPrefix is a prefix added to keys for indexing leased name records by their expiration time.
+	ExpirationKeyPrefix = []byte{0x07}
+	// PrimaryNameKeyPrefix is a prefix added to keys for storing the primary name of an address.
+	PrimaryNameKeyPrefix = []byte{0x08}
 )
 
 // GetNameKeyPrefix converts a name into key format.
@@ -72,6 +81,64 @@ func getNamePrefixByType(name string, key []byte) ([]byte, error) {
 	return key, nil
 }
 
+// GetParentKeyPrefix returns a store key prefix for indexing all the names under a parent name.
+func GetParentKeyPrefix(parent string) (key []byte, err error) {
+	key = ParentKeyPrefix
+	return getNamePrefixByType(parent, key)
+}
+
+// GetParentDepthKeyPrefix returns a store key prefix for indexing the names a given number of levels under a parent name.
+// The key has the format [0x06] :: [parent-name-hash] :: [depth]
+func GetParentDepthKeyPrefix(parent string, depth int) (key []byte, err error) {
+	if depth < 1 || depth > math.MaxUint8 {
+		return nil, fmt.Errorf("invalid parent index depth %d: %w", depth, ErrNameHasTooManySegments)
+	}
+	if key, err = GetParentKeyPrefix(parent); err != nil {
+		return nil, err
+	}
+	return append(key, byte(depth)), nil
+}
+
+// GetParentIndexKeys returns the parent index keys for a name, one for each of its parent names.
+// E.g. for "a.b.c", keys are returned for "b.c" (depth 1) and "c" (depth 2).
+// Each key has the format [0x06] :: [parent-name-hash] :: [depth] :: [name-hash]
+func GetParentIndexKeys(name string) ([][]byte, error) {
+	nameKey, err := GetNameKeyPrefix(name)
+	if err != nil {
+		return nil, err
+	}
+	nameHash := nameKey[len(NameKeyPrefix):]
+	comps := strings.Split(name, ".")
+	keys := make([][]byte, 0, len(comps)-1)
+	for i := 1; i < len(comps); i++ {
+		parentKey, err := GetParentDepthKeyPrefix(strings.Join(comps[i:], "."), i)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, append(parentKey, nameHash...))
+	}
+	return keys, nil
+}
+
+// GetExpirationKeyPrefix returns a store key prefix for all names that expire during the second of a given time.
+// The key has the format [0x07] :: [big-endian-unix-seconds]
+func GetExpirationKeyPrefix(expiration time.Time) []byte {
+	key := make([]byte, len(ExpirationKeyPrefix)+8)
+	copy(key, ExpirationKeyPrefix)
+	binary.BigEndian.PutUint64(key[len(ExpirationKeyPrefix):], uint64(expiration.Unix()))
+	return key
+}
+
+// GetExpirationKey returns the expiration index key for a name.
+// The key has the format [0x07] :: [big-endian-unix-seconds] :: [name-hash]
+func GetExpirationKey(name string, expiration time.Time) ([]byte, error) {
+	nameKey, err := GetNameKeyPrefix(name)
+	if err != nil {
+		return nil, err
+	}
+	return append(GetExpirationKeyPrefix(expiration), nameKey[len(NameKeyPrefix):]...), nil
+}
+
 // GetAddressKeyPrefix returns a store key for a name record address
 func GetAddressKeyPrefix(addr sdk.AccAddress) (key []byte, err error) {
 	err = sdk.VerifyAddressFormat(addr.Bytes())
@@ -82,6 +149,17 @@ func GetAddressKeyPrefix(addr sdk.AccAddress) (key []byte, err error) {
 	return
 }
 
+// GetPrimaryNameKey returns a store key for the primary name of an address.
+// The key has the format [0x08] :: [length-prefixed-addr-bytes]
+func GetPrimaryNameKey(addr sdk.AccAddress) (key []byte, err error) {
+	err = sdk.VerifyAddressFormat(addr.Bytes())
+	if err == nil {
+		key = PrimaryNameKeyPrefix
+		key = append(key, address.MustLengthPrefix(addr.Bytes())...)
+	}
+	return
+}
+
 func ValidateAddress(address sdk.AccAddress) error {
 	if err := sdk.VerifyAddressFormat(address); err != nil {
 		return err
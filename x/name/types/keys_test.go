@@ -109,6 +109,29 @@ func (s *NameKeyTestSuite) TestAddressKeyPrefix() {
 	s.Assert().Equal(AddressKeyPrefix, key[0:1])
 }
 
+func (s *NameKeyTestSuite) TestParentIndexKeys() {
+	nameKey, err := GetNameKeyPrefix("a.b.c")
+	s.Require().NoError(err, "GetNameKeyPrefix")
+	parentKey, err := GetParentKeyPrefix("b.c")
+	s.Require().NoError(err, "GetParentKeyPrefix")
+	grandparentKey, err := GetParentKeyPrefix("c")
+	s.Require().NoError(err, "GetParentKeyPrefix")
+
+	keys, err := GetParentIndexKeys("a.b.c")
+	s.Require().NoError(err, "GetParentIndexKeys")
+	s.Require().Len(keys, 2, "parent index keys")
+	s.Assert().Equal(append(append(parentKey, 1), nameKey[1:]...), keys[0], "parent index key")
+	s.Assert().Equal(append(append(grandparentKey, 2), nameKey[1:]...), keys[1], "grandparent index key")
+
+	depthKey, err := GetParentDepthKeyPrefix("c", 2)
+	s.Require().NoError(err, "GetParentDepthKeyPrefix")
+	s.Assert().Equal(depthKey, keys[1][:len(depthKey)], "depth prefix of grandparent index key")
+	_, err = GetParentDepthKeyPrefix("c", 0)
+	s.Assert().ErrorIs(err, ErrNameHasTooManySegments, "depth zero")
+	_, err = GetParentDepthKeyPrefix("c", 256)
+	s.Assert().ErrorIs(err, ErrNameHasTooManySegments, "depth over 255")
+}
+
 func mustHexDecode(h string) []byte {
 	var err error
 	var result []byte
@@ -9,12 +9,21 @@ import (
 
 // name message types
 const (
-	TypeMsgBindNameRequest   = "bind_name"
-	TypeMsgDeleteNameRequest = "delete_name"
+	TypeMsgBindNameRequest       = "bind_name"
+	TypeMsgDeleteNameRequest     = "delete_name"
+	TypeMsgModifyNameRequest     = "modify_name"
+	TypeMsgRenewNameRequest      = "renew_name"
+	TypeMsgSetPrimaryNameRequest = "set_primary_name"
 )
 
 // Compile time interface checks.
-var _, _ sdk.Msg = &MsgBindNameRequest{}, &MsgDeleteNameRequest{}
+var (
+	_ sdk.Msg = &MsgBindNameRequest{}
+	_ sdk.Msg = &MsgDeleteNameRequest{}
+	_ sdk.Msg = &MsgModifyNameRequest{}
+	_ sdk.Msg = &MsgRenewNameRequest{}
+	_ sdk.Msg = &MsgSetPrimaryNameRequest{}
+)
 
 // NewMsgBindNameRequest creates a new bind name request
 func NewMsgBindNameRequest(record, parent NameRecord) *MsgBindNameRequest {
@@ -101,3 +110,133 @@ func (msg MsgDeleteNameRequest) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{addr}
 }
+
+// NewMsgModifyNameRequest creates a new Modify Name Request
+func NewMsgModifyNameRequest(authority string, record NameRecord) *MsgModifyNameRequest {
+	return &MsgModifyNameRequest{
+		Authority: authority,
+		Record:    record,
+	}
+}
+
+// Route implements Msg
+func (msg MsgModifyNameRequest) Route() string { return ModuleName }
+
+// Type implements Msg
+func (msg MsgModifyNameRequest) Type() string { return TypeMsgModifyNameRequest }
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgModifyNameRequest) ValidateBasic() error {
+	if strings.TrimSpace(msg.Authority) == "" {
+		return fmt.Errorf("authority cannot be empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return fmt.Errorf("invalid authority: %w", err)
+	}
+	if strings.TrimSpace(msg.Record.Name) == "" {
+		return fmt.Errorf("name cannot be empty")
+	}
+	if strings.TrimSpace(msg.Record.Address) == "" {
+		return fmt.Errorf("address cannot be empty")
+	}
+	if _, err := ParseTargetAddress(msg.Record.Address); err != nil {
+		return fmt.Errorf("invalid address: %w", err)
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgModifyNameRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners indicates that the message must have been signed by the authority.
+func (msg MsgModifyNameRequest) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgRenewNameRequest creates a new Renew Name Request
+func NewMsgRenewNameRequest(name string, owner sdk.AccAddress) *MsgRenewNameRequest { //nolint:interfacer
+	return &MsgRenewNameRequest{
+		Name:  name,
+		Owner: owner.String(),
+	}
+}
+
+// Route implements Msg
+func (msg MsgRenewNameRequest) Route() string { return ModuleName }
+
+// Type implements Msg
+func (msg MsgRenewNameRequest) Type() string { return TypeMsgRenewNameRequest }
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgRenewNameRequest) ValidateBasic() error {
+	if strings.TrimSpace(msg.Name) == "" {
+		return fmt.Errorf("name cannot be empty")
+	}
+	if strings.TrimSpace(msg.Owner) == "" {
+		return fmt.Errorf("owner cannot be empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return fmt.Errorf("invalid owner: %w", err)
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgRenewNameRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners indicates that the message must have been signed by the owner.
+func (msg MsgRenewNameRequest) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgSetPrimaryNameRequest creates a new Set Primary Name Request
+func NewMsgSetPrimaryNameRequest(name string, address sdk.AccAddress) *MsgSetPrimaryNameRequest { //nolint:interfacer
+	return &MsgSetPrimaryNameRequest{
+		Name:    name,
+		Address: address.String(),
+	}
+}
+
+// Route implements Msg
+func (msg MsgSetPrimaryNameRequest) Route() string { return ModuleName }
+
+// Type implements Msg
+func (msg MsgSetPrimaryNameRequest) Type() string { return TypeMsgSetPrimaryNameRequest }
+
+// ValidateBasic runs stateless validation checks on the message.
+// The name may be empty to clear the primary name.
+func (msg MsgSetPrimaryNameRequest) ValidateBasic() error {
+	if strings.TrimSpace(msg.Address) == "" {
+		return fmt.Errorf("address cannot be empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return fmt.Errorf("invalid address: %w", err)
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgSetPrimaryNameRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners indicates that the message must have been signed by the address.
+func (msg MsgSetPrimaryNameRequest) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
@@ -5,17 +5,24 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -33,6 +40,12 @@ type Params struct {
 	MaxNameLevels uint32 `protobuf:"varint,3,opt,name=max_name_levels,json=maxNameLevels,proto3" json:"max_name_levels,omitempty"`
 	// determines if unrestricted name keys are allowed or not
 	AllowUnrestrictedNames bool `protobuf:"varint,4,opt,name=allow_unrestricted_names,json=allowUnrestrictedNames,proto3" json:"allow_unrestricted_names,omitempty"`
+	// length of the lease given to newly bound names and added by each renewal, zero disables leases
+	LeaseDuration time.Duration `protobuf:"bytes,5,opt,name=lease_duration,json=leaseDuration,proto3,stdduration" json:"lease_duration"`
+	// fee charged to the owner for each lease renewal
+	RenewalFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=renewal_fee,json=renewalFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"renewal_fee"`
+	// amount of time after a lease expires before the name is released
+	GracePeriod time.Duration `protobuf:"bytes,7,opt,name=grace_period,json=gracePeriod,proto3,stdduration" json:"grace_period"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -96,6 +109,27 @@ func (m *Params) GetAllowUnrestrictedNames() bool {
 	return false
 }
 
+func (m *Params) GetLeaseDuration() time.Duration {
+	if m != nil {
+		return m.LeaseDuration
+	}
+	return 0
+}
+
+func (m *Params) GetRenewalFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RenewalFee
+	}
+	return nil
+}
+
+func (m *Params) GetGracePeriod() time.Duration {
+	if m != nil {
+		return m.GracePeriod
+	}
+	return 0
+}
+
 // NameRecord is a structure used to bind ownership of a name hierarchy to a collection of addresses
 type NameRecord struct {
 	// The bound name
@@ -104,6 +138,8 @@ type NameRecord struct {
 	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 	// Whether owner signature is required to add sub-names.
 	Restricted bool `protobuf:"varint,3,opt,name=restricted,proto3" json:"restricted,omitempty"`
+	// When the lease on the name expires. Names without an expiration do not expire.
+	Expiration *time.Time `protobuf:"bytes,4,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
 }
 
 func (m *NameRecord) Reset()      { *m = NameRecord{} }
@@ -159,6 +195,54 @@ func (m *NameRecord) GetRestricted() bool {
 	return false
 }
 
+func (m *NameRecord) GetExpiration() *time.Time {
+	if m != nil {
+		return m.Expiration
+	}
+	return nil
+}
+
+// PrimaryName is a name designated by an address as its primary (display) name.
+type PrimaryName struct {
+	// The address the primary name is designated for.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// The primary name. It must be bound to the address.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *PrimaryName) Reset()         { *m = PrimaryName{} }
+func (m *PrimaryName) String() string { return proto.CompactTextString(m) }
+func (*PrimaryName) ProtoMessage()    {}
+func (*PrimaryName) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a314256905bb00ec, []int{2}
+}
+func (m *PrimaryName) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PrimaryName) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PrimaryName.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PrimaryName) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrimaryName.Merge(m, src)
+}
+func (m *PrimaryName) XXX_Size() int {
+	return m.Size()
+}
+func (m *PrimaryName) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrimaryName.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrimaryName proto.InternalMessageInfo
+
 // CreateRootNameProposal details a proposal to create a new root name
 // that is controlled by a given owner and optionally restricted to the owner
 // for the sole creation of sub names.
@@ -173,7 +257,7 @@ type CreateRootNameProposal struct {
 func (m *CreateRootNameProposal) Reset()      { *m = CreateRootNameProposal{} }
 func (*CreateRootNameProposal) ProtoMessage() {}
 func (*CreateRootNameProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a314256905bb00ec, []int{2}
+	return fileDescriptor_a314256905bb00ec, []int{3}
 }
 func (m *CreateRootNameProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -213,7 +297,7 @@ func (m *EventNameBound) Reset()         { *m = EventNameBound{} }
 func (m *EventNameBound) String() string { return proto.CompactTextString(m) }
 func (*EventNameBound) ProtoMessage()    {}
 func (*EventNameBound) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a314256905bb00ec, []int{3}
+	return fileDescriptor_a314256905bb00ec, []int{4}
 }
 func (m *EventNameBound) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -274,7 +358,7 @@ func (m *EventNameUnbound) Reset()         { *m = EventNameUnbound{} }
 func (m *EventNameUnbound) String() string { return proto.CompactTextString(m) }
 func (*EventNameUnbound) ProtoMessage()    {}
 func (*EventNameUnbound) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a314256905bb00ec, []int{4}
+	return fileDescriptor_a314256905bb00ec, []int{5}
 }
 func (m *EventNameUnbound) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -324,47 +408,371 @@ func (m *EventNameUnbound) GetRestricted() bool {
 	return false
 }
 
+// Event emitted when name is updated.
+type EventNameUpdate struct {
+	Address    string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Name       string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Restricted bool   `protobuf:"varint,3,opt,name=restricted,proto3" json:"restricted,omitempty"`
+}
+
+func (m *EventNameUpdate) Reset()         { *m = EventNameUpdate{} }
+func (m *EventNameUpdate) String() string { return proto.CompactTextString(m) }
+func (*EventNameUpdate) ProtoMessage()    {}
+func (*EventNameUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a314256905bb00ec, []int{6}
+}
+func (m *EventNameUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventNameUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventNameUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventNameUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventNameUpdate.Merge(m, src)
+}
+func (m *EventNameUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventNameUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventNameUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventNameUpdate proto.InternalMessageInfo
+
+func (m *EventNameUpdate) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *EventNameUpdate) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *EventNameUpdate) GetRestricted() bool {
+	if m != nil {
+		return m.Restricted
+	}
+	return false
+}
+
+// Event emitted when a name lease is renewed.
+type EventNameRenewed struct {
+	Address    string     `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Name       string     `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Expiration *time.Time `protobuf:"bytes,3,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
+}
+
+func (m *EventNameRenewed) Reset()         { *m = EventNameRenewed{} }
+func (m *EventNameRenewed) String() string { return proto.CompactTextString(m) }
+func (*EventNameRenewed) ProtoMessage()    {}
+func (*EventNameRenewed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a314256905bb00ec, []int{7}
+}
+func (m *EventNameRenewed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventNameRenewed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventNameRenewed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventNameRenewed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventNameRenewed.Merge(m, src)
+}
+func (m *EventNameRenewed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventNameRenewed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventNameRenewed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventNameRenewed proto.InternalMessageInfo
+
+func (m *EventNameRenewed) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *EventNameRenewed) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *EventNameRenewed) GetExpiration() *time.Time {
+	if m != nil {
+		return m.Expiration
+	}
+	return nil
+}
+
+// Event emitted when an expired name is released.
+type EventNameExpired struct {
+	Address    string     `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Name       string     `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Expiration *time.Time `protobuf:"bytes,3,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
+}
+
+func (m *EventNameExpired) Reset()         { *m = EventNameExpired{} }
+func (m *EventNameExpired) String() string { return proto.CompactTextString(m) }
+func (*EventNameExpired) ProtoMessage()    {}
+func (*EventNameExpired) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a314256905bb00ec, []int{8}
+}
+func (m *EventNameExpired) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventNameExpired) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventNameExpired.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventNameExpired) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventNameExpired.Merge(m, src)
+}
+func (m *EventNameExpired) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventNameExpired) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventNameExpired.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventNameExpired proto.InternalMessageInfo
+
+func (m *EventNameExpired) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *EventNameExpired) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *EventNameExpired) GetExpiration() *time.Time {
+	if m != nil {
+		return m.Expiration
+	}
+	return nil
+}
+
+// Event emitted when a name stored with raw unicode segments cannot be converted to its punycode form during
+// migration. The name is released and an EventNameUnbound is emitted along with it.
+type EventNameConversionFailed struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Name    string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The reason the name could not be converted.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *EventNameConversionFailed) Reset()         { *m = EventNameConversionFailed{} }
+func (m *EventNameConversionFailed) String() string { return proto.CompactTextString(m) }
+func (*EventNameConversionFailed) ProtoMessage()    {}
+func (*EventNameConversionFailed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a314256905bb00ec, []int{9}
+}
+func (m *EventNameConversionFailed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventNameConversionFailed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventNameConversionFailed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventNameConversionFailed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventNameConversionFailed.Merge(m, src)
+}
+func (m *EventNameConversionFailed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventNameConversionFailed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventNameConversionFailed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventNameConversionFailed proto.InternalMessageInfo
+
+func (m *EventNameConversionFailed) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *EventNameConversionFailed) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *EventNameConversionFailed) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// Event emitted when the primary name of an address is set or cleared.
+type EventPrimaryNameUpdate struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// The new primary name, empty when cleared.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *EventPrimaryNameUpdate) Reset()         { *m = EventPrimaryNameUpdate{} }
+func (m *EventPrimaryNameUpdate) String() string { return proto.CompactTextString(m) }
+func (*EventPrimaryNameUpdate) ProtoMessage()    {}
+func (*EventPrimaryNameUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a314256905bb00ec, []int{10}
+}
+func (m *EventPrimaryNameUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPrimaryNameUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPrimaryNameUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventPrimaryNameUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPrimaryNameUpdate.Merge(m, src)
+}
+func (m *EventPrimaryNameUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPrimaryNameUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPrimaryNameUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPrimaryNameUpdate proto.InternalMessageInfo
+
+func (m *EventPrimaryNameUpdate) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *EventPrimaryNameUpdate) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "provenance.name.v1.Params")
 	proto.RegisterType((*NameRecord)(nil), "provenance.name.v1.NameRecord")
+	proto.RegisterType((*PrimaryName)(nil), "provenance.name.v1.PrimaryName")
 	proto.RegisterType((*CreateRootNameProposal)(nil), "provenance.name.v1.CreateRootNameProposal")
 	proto.RegisterType((*EventNameBound)(nil), "provenance.name.v1.EventNameBound")
 	proto.RegisterType((*EventNameUnbound)(nil), "provenance.name.v1.EventNameUnbound")
+	proto.RegisterType((*EventNameUpdate)(nil), "provenance.name.v1.EventNameUpdate")
+	proto.RegisterType((*EventNameRenewed)(nil), "provenance.name.v1.EventNameRenewed")
+	proto.RegisterType((*EventNameExpired)(nil), "provenance.name.v1.EventNameExpired")
+	proto.RegisterType((*EventNameConversionFailed)(nil), "provenance.name.v1.EventNameConversionFailed")
+	proto.RegisterType((*EventPrimaryNameUpdate)(nil), "provenance.name.v1.EventPrimaryNameUpdate")
 }
 
 func init() { proto.RegisterFile("provenance/name/v1/name.proto", fileDescriptor_a314256905bb00ec) }
 
 var fileDescriptor_a314256905bb00ec = []byte{
-	// 452 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0xb1, 0x6e, 0x13, 0x4d,
-	0x10, 0xc7, 0x6f, 0x13, 0x3b, 0x5f, 0x3c, 0x1f, 0x81, 0x68, 0x65, 0xa2, 0x13, 0x12, 0x17, 0xcb,
-	0x05, 0x4a, 0x01, 0x3e, 0x22, 0x1a, 0x44, 0x19, 0x44, 0x17, 0x21, 0xeb, 0x50, 0x1a, 0x0a, 0x9c,
-	0xf5, 0xdd, 0xe8, 0xb2, 0xd2, 0xdd, 0xee, 0x69, 0x77, 0x7d, 0x31, 0x6f, 0x40, 0x49, 0x49, 0x99,
-	0x92, 0x27, 0x41, 0x94, 0x29, 0x29, 0x91, 0xdd, 0xf0, 0x18, 0x68, 0xe7, 0xe2, 0xf8, 0x62, 0x0a,
-	0x1a, 0xaa, 0xdb, 0x99, 0xff, 0x7f, 0x66, 0x7e, 0x73, 0xda, 0x85, 0xc7, 0x95, 0xd1, 0x35, 0x2a,
-	0xa1, 0x52, 0x8c, 0x95, 0x28, 0x31, 0xae, 0x8f, 0xe9, 0x3b, 0xaa, 0x8c, 0x76, 0x9a, 0xf3, 0xb5,
-	0x3c, 0xa2, 0x74, 0x7d, 0xfc, 0xa8, 0x9f, 0xeb, 0x5c, 0x93, 0x1c, 0xfb, 0x53, 0xe3, 0x1c, 0x7e,
-	0x63, 0xb0, 0x33, 0x16, 0x46, 0x94, 0x96, 0x3f, 0x05, 0x5e, 0x8a, 0xf9, 0xc4, 0x62, 0x5e, 0xa2,
-	0x72, 0x93, 0x02, 0x55, 0xee, 0x2e, 0x42, 0x36, 0x60, 0x47, 0x7b, 0xc9, 0x7e, 0x29, 0xe6, 0xef,
-	0x1a, 0xe1, 0x94, 0xf2, 0xe4, 0x96, 0x6a, 0xd3, 0xbd, 0x75, 0xe3, 0x96, 0xea, 0xae, 0xfb, 0x09,
-	0x3c, 0xf0, 0xbd, 0x3d, 0xcb, 0xa4, 0xc0, 0x1a, 0x0b, 0x1b, 0x6e, 0x93, 0x75, 0xaf, 0x14, 0xf3,
-	0xb7, 0xa2, 0xc4, 0x53, 0x4a, 0xf2, 0x97, 0x10, 0x8a, 0xa2, 0xd0, 0x97, 0x93, 0x99, 0x32, 0x68,
-	0x9d, 0x91, 0xa9, 0xc3, 0x8c, 0xca, 0x6c, 0xd8, 0x19, 0xb0, 0xa3, 0xdd, 0xe4, 0x80, 0xf4, 0xb3,
-	0x96, 0xec, 0xcb, 0xed, 0xf0, 0x1c, 0xc0, 0x1f, 0x12, 0x4c, 0xb5, 0xc9, 0x38, 0x87, 0x8e, 0x2f,
-	0x22, 0xfa, 0x5e, 0x42, 0x67, 0x1e, 0xc2, 0x7f, 0x22, 0xcb, 0x0c, 0x5a, 0x4b, 0x98, 0xbd, 0x64,
-	0x15, 0xf2, 0x08, 0x60, 0xdd, 0x8e, 0xc0, 0x76, 0x93, 0x56, 0xe6, 0x55, 0xe7, 0xcb, 0xd5, 0x61,
-	0x30, 0xfc, 0xca, 0xe0, 0xe0, 0xb5, 0x41, 0xe1, 0x30, 0xd1, 0xda, 0xf9, 0x61, 0x63, 0xa3, 0x2b,
-	0x6d, 0x45, 0xc1, 0xfb, 0xd0, 0x75, 0xd2, 0x15, 0xab, 0x79, 0x4d, 0xc0, 0x07, 0xf0, 0x7f, 0x86,
-	0x36, 0x35, 0xb2, 0x72, 0x52, 0xab, 0x9b, 0xa1, 0xed, 0xd4, 0x2d, 0xe6, 0x76, 0x0b, 0xb3, 0x0f,
-	0x5d, 0x7d, 0xa9, 0xd0, 0xd0, 0xbe, 0xbd, 0xa4, 0x09, 0x36, 0x10, 0xbb, 0x7f, 0x20, 0xde, 0xfb,
-	0x74, 0x75, 0x18, 0x78, 0xcc, 0x5f, 0x1e, 0xf5, 0x03, 0xdc, 0x7f, 0x53, 0xa3, 0x22, 0xc8, 0x13,
-	0x3d, 0x53, 0x59, 0x7b, 0x79, 0x76, 0x77, 0xf9, 0x15, 0xc3, 0x56, 0x8b, 0xe1, 0x2f, 0x3f, 0x64,
-	0x78, 0x0e, 0xfb, 0xb7, 0xfd, 0xcf, 0xd4, 0xf4, 0xdf, 0x4f, 0x38, 0x49, 0xbf, 0x2f, 0x22, 0x76,
-	0xbd, 0x88, 0xd8, 0xcf, 0x45, 0xc4, 0x3e, 0x2f, 0xa3, 0xe0, 0x7a, 0x19, 0x05, 0x3f, 0x96, 0x51,
-	0x00, 0x0f, 0x25, 0xdd, 0xdd, 0x8d, 0xeb, 0x3d, 0x66, 0xef, 0x9f, 0xe7, 0xd2, 0x5d, 0xcc, 0xa6,
-	0xa3, 0x54, 0x97, 0xf1, 0xda, 0xf0, 0x4c, 0xea, 0x56, 0x14, 0xcf, 0x9b, 0xe7, 0xe2, 0x3e, 0x56,
-	0x68, 0xa7, 0x3b, 0xf4, 0x06, 0x5e, 0xfc, 0x0e, 0x00, 0x00, 0xff, 0xff, 0xb9, 0x38, 0xb5, 0xff,
-	0x4e, 0x03, 0x00, 0x00,
+	// 737 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x55, 0x3d, 0x6f, 0xdb, 0x46,
+	0x18, 0x16, 0xad, 0x0f, 0x4b, 0x27, 0x7f, 0x81, 0x70, 0x05, 0xda, 0x40, 0x29, 0x41, 0x43, 0xa1,
+	0xa1, 0x26, 0xed, 0x76, 0x29, 0x3c, 0x15, 0x72, 0xad, 0xa1, 0x30, 0x0c, 0x81, 0xad, 0x97, 0x0e,
+	0x65, 0x4f, 0xe4, 0x6b, 0xfa, 0x50, 0xf2, 0x8e, 0xb8, 0x3b, 0xc9, 0xf2, 0xda, 0xa9, 0xa3, 0x47,
+	0x8f, 0x46, 0xa7, 0x22, 0xbf, 0xc4, 0xa3, 0xc7, 0x4c, 0x71, 0x60, 0x2f, 0xf9, 0x19, 0x01, 0x8f,
+	0xa4, 0x45, 0xc9, 0x43, 0xa2, 0x20, 0x41, 0x26, 0xf2, 0xfd, 0x7e, 0xde, 0xe7, 0x39, 0xf2, 0xd0,
+	0xb7, 0x31, 0x67, 0x13, 0xa0, 0x98, 0x7a, 0x60, 0x53, 0x1c, 0x81, 0x3d, 0x39, 0x50, 0x4f, 0x2b,
+	0xe6, 0x4c, 0x32, 0x5d, 0x9f, 0x85, 0x2d, 0xe5, 0x9e, 0x1c, 0xec, 0x6e, 0x07, 0x2c, 0x60, 0x2a,
+	0x6c, 0x27, 0x6f, 0x69, 0xe6, 0xae, 0xe9, 0x31, 0x11, 0x31, 0x61, 0x8f, 0xb0, 0x48, 0x9a, 0x8c,
+	0x40, 0xe2, 0x03, 0xdb, 0x63, 0x84, 0xe6, 0xf1, 0x80, 0xb1, 0x20, 0x04, 0x5b, 0x59, 0xa3, 0xf1,
+	0xb9, 0xed, 0x8f, 0x39, 0x96, 0x84, 0xe5, 0xf1, 0xf6, 0x62, 0x5c, 0x92, 0x08, 0x84, 0xc4, 0x51,
+	0x9c, 0x26, 0x74, 0xef, 0xca, 0xa8, 0x36, 0xc4, 0x1c, 0x47, 0x42, 0xff, 0x1e, 0xe9, 0x11, 0x9e,
+	0xba, 0x02, 0x82, 0x08, 0xa8, 0x74, 0x43, 0xa0, 0x81, 0xbc, 0x30, 0xb4, 0x8e, 0xd6, 0x5b, 0x77,
+	0xb6, 0x22, 0x3c, 0xfd, 0x2d, 0x0d, 0x9c, 0x28, 0xbf, 0xca, 0x26, 0x74, 0x31, 0x7b, 0x25, 0xcb,
+	0x26, 0x74, 0x3e, 0xfb, 0x3b, 0xb4, 0x99, 0xf4, 0x4e, 0x96, 0x75, 0x43, 0x98, 0x40, 0x28, 0x8c,
+	0xb2, 0x4a, 0x5d, 0x8f, 0xf0, 0xf4, 0x14, 0x47, 0x70, 0xa2, 0x9c, 0xfa, 0x4f, 0xc8, 0xc0, 0x61,
+	0xc8, 0x2e, 0xdd, 0x31, 0xe5, 0x20, 0x24, 0x27, 0x9e, 0x04, 0x5f, 0x95, 0x09, 0xa3, 0xd2, 0xd1,
+	0x7a, 0x75, 0xa7, 0xa5, 0xe2, 0x67, 0x85, 0x70, 0x52, 0x2e, 0xf4, 0x5f, 0xd1, 0x46, 0x08, 0x58,
+	0x80, 0x9b, 0x33, 0x60, 0x54, 0x3b, 0x5a, 0xaf, 0xf9, 0xc3, 0x8e, 0x95, 0x52, 0x60, 0xe5, 0x14,
+	0x58, 0xbf, 0x64, 0x09, 0xfd, 0xfa, 0xdd, 0x9b, 0x76, 0xe9, 0xe6, 0xa1, 0xad, 0x39, 0xeb, 0xaa,
+	0x34, 0x0f, 0xe8, 0x21, 0x6a, 0x72, 0xa0, 0x70, 0x89, 0x43, 0xf7, 0x1c, 0xc0, 0xa8, 0x75, 0xca,
+	0xaa, 0x51, 0xaa, 0x85, 0x95, 0x68, 0x61, 0x65, 0x5a, 0x58, 0x47, 0x8c, 0xd0, 0xfe, 0x7e, 0xd2,
+	0xe8, 0xd5, 0x43, 0xbb, 0x17, 0x10, 0x79, 0x31, 0x1e, 0x59, 0x1e, 0x8b, 0xec, 0x4c, 0xb8, 0xf4,
+	0xb1, 0x27, 0xfc, 0xbf, 0x6d, 0x79, 0x15, 0x83, 0x50, 0x05, 0xc2, 0x41, 0x59, 0xff, 0x01, 0x80,
+	0x3e, 0x40, 0x6b, 0x01, 0xc7, 0x1e, 0xb8, 0x31, 0x70, 0xc2, 0x7c, 0x63, 0xf5, 0xe3, 0x71, 0x37,
+	0x55, 0xe1, 0x50, 0xd5, 0x75, 0xff, 0xd3, 0x10, 0x4a, 0xb8, 0x70, 0xc0, 0x63, 0xdc, 0xd7, 0x75,
+	0x54, 0x49, 0x78, 0x53, 0x02, 0x36, 0x1c, 0xf5, 0xae, 0x1b, 0x68, 0x15, 0xfb, 0x3e, 0x07, 0x21,
+	0x94, 0x52, 0x0d, 0x27, 0x37, 0x75, 0x13, 0xa1, 0x19, 0xa3, 0x4a, 0x9b, 0xba, 0x53, 0xf0, 0xe8,
+	0x3f, 0x23, 0x04, 0xd3, 0x98, 0x64, 0xd4, 0x56, 0x14, 0xc4, 0xdd, 0x17, 0x10, 0x7f, 0xcf, 0x4f,
+	0x57, 0xbf, 0x72, 0x9d, 0xe0, 0x2b, 0xd4, 0x1c, 0x56, 0x6e, 0x6e, 0xdb, 0xa5, 0xee, 0x31, 0x6a,
+	0x0e, 0x39, 0x89, 0x30, 0xbf, 0x3a, 0x5d, 0x00, 0xa4, 0xcd, 0x03, 0xca, 0xe1, 0xaf, 0xcc, 0xe0,
+	0x1f, 0xd6, 0xff, 0xbd, 0x6d, 0x97, 0xde, 0x25, 0x6d, 0xfe, 0xd7, 0x50, 0xeb, 0x88, 0x03, 0x96,
+	0xe0, 0x30, 0x26, 0x93, 0x56, 0x43, 0xce, 0x62, 0x26, 0x70, 0xa8, 0x6f, 0xa3, 0xaa, 0x24, 0x32,
+	0xcc, 0x17, 0x4f, 0x0d, 0xbd, 0x83, 0x9a, 0x3e, 0x08, 0x8f, 0x93, 0x58, 0x2d, 0x90, 0x76, 0x2d,
+	0xba, 0x9e, 0x07, 0x96, 0x0b, 0x7c, 0x6d, 0xa3, 0x2a, 0xbb, 0xa4, 0xc0, 0xd5, 0xc2, 0x0d, 0x27,
+	0x35, 0x16, 0xb8, 0xaa, 0x2e, 0x72, 0x75, 0xb8, 0x96, 0xc0, 0xbc, 0xc9, 0xa1, 0xfe, 0x89, 0x36,
+	0x8e, 0x27, 0x40, 0x15, 0xc8, 0x3e, 0x1b, 0x53, 0x7f, 0xb9, 0xa5, 0x3f, 0xa4, 0x4c, 0xf7, 0x2f,
+	0xb4, 0xf5, 0xdc, 0xff, 0x8c, 0x8e, 0xbe, 0xc0, 0x04, 0x17, 0x6d, 0xce, 0x26, 0xc4, 0x3e, 0x96,
+	0xf0, 0x99, 0x07, 0xfc, 0xa3, 0x15, 0x76, 0x70, 0x92, 0x2f, 0x03, 0x96, 0xdd, 0x61, 0xfe, 0x7c,
+	0x96, 0x97, 0x3f, 0x9f, 0xf3, 0x20, 0x8e, 0x13, 0xff, 0x57, 0x00, 0x81, 0xd1, 0xce, 0x33, 0x86,
+	0x23, 0x46, 0x27, 0xc0, 0x05, 0x61, 0x74, 0x80, 0x49, 0xb8, 0x34, 0x98, 0x16, 0xaa, 0x71, 0xc0,
+	0x22, 0x03, 0xd2, 0x70, 0x32, 0xab, 0x3b, 0x40, 0x2d, 0x35, 0xa2, 0xf0, 0x19, 0x7e, 0x8a, 0xa8,
+	0x7d, 0xef, 0xee, 0xd1, 0xd4, 0xee, 0x1f, 0x4d, 0xed, 0xed, 0xa3, 0xa9, 0x5d, 0x3f, 0x99, 0xa5,
+	0xfb, 0x27, 0xb3, 0xf4, 0xfa, 0xc9, 0x2c, 0xa1, 0x6f, 0x08, 0xb3, 0x5e, 0xde, 0x70, 0x43, 0xed,
+	0x8f, 0xfd, 0xc2, 0xff, 0x71, 0x96, 0xb0, 0x47, 0x58, 0xc1, 0xb2, 0xa7, 0xe9, 0x8d, 0xa9, 0xfe,
+	0x96, 0xa3, 0x9a, 0x62, 0xed, 0xc7, 0xf7, 0x03, 0x00, 0xb8, 0xf0, 0x46, 0xca, 0x51, 0x07, 0x00,
+	0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -387,6 +795,36 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.GracePeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.GracePeriod):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintName(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x3a
+	if len(m.RenewalFee) > 0 {
+		for iNdEx := len(m.RenewalFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RenewalFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintName(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.LeaseDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.LeaseDuration):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintName(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x2a
 	if m.AllowUnrestrictedNames {
 		i--
 		if m.AllowUnrestrictedNames {
@@ -435,6 +873,16 @@ func (m *NameRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Expiration != nil {
+		n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintName(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.Restricted {
 		i--
 		if m.Restricted {
@@ -462,7 +910,7 @@ func (m *NameRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *CreateRootNameProposal) Marshal() (dAtA []byte, err error) {
+func (m *PrimaryName) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -472,21 +920,58 @@ func (m *CreateRootNameProposal) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CreateRootNameProposal) MarshalTo(dAtA []byte) (int, error) {
+func (m *PrimaryName) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CreateRootNameProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PrimaryName) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Restricted {
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Name)))
 		i--
-		if m.Restricted {
-			dAtA[i] = 1
-		} else {
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CreateRootNameProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateRootNameProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateRootNameProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Restricted {
+		i--
+		if m.Restricted {
+			dAtA[i] = 1
+		} else {
 			dAtA[i] = 0
 		}
 		i--
@@ -617,6 +1102,228 @@ func (m *EventNameUnbound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventNameUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventNameUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventNameUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Restricted {
+		i--
+		if m.Restricted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventNameRenewed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventNameRenewed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventNameRenewed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expiration != nil {
+		n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration):])
+		if err4 != nil {
+			return 0, err4
+		}
+		i -= n4
+		i = encodeVarintName(dAtA, i, uint64(n4))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventNameExpired) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventNameExpired) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventNameExpired) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expiration != nil {
+		n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration):])
+		if err5 != nil {
+			return 0, err5
+		}
+		i -= n5
+		i = encodeVarintName(dAtA, i, uint64(n5))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventNameConversionFailed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventNameConversionFailed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventNameConversionFailed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventPrimaryNameUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPrimaryNameUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPrimaryNameUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintName(dAtA []byte, offset int, v uint64) int {
 	offset -= sovName(v)
 	base := offset
@@ -646,6 +1353,16 @@ func (m *Params) Size() (n int) {
 	if m.AllowUnrestrictedNames {
 		n += 2
 	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.LeaseDuration)
+	n += 1 + l + sovName(uint64(l))
+	if len(m.RenewalFee) > 0 {
+		for _, e := range m.RenewalFee {
+			l = e.Size()
+			n += 1 + l + sovName(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.GracePeriod)
+	n += 1 + l + sovName(uint64(l))
 	return n
 }
 
@@ -666,6 +1383,27 @@ func (m *NameRecord) Size() (n int) {
 	if m.Restricted {
 		n += 2
 	}
+	if m.Expiration != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration)
+		n += 1 + l + sovName(uint64(l))
+	}
+	return n
+}
+
+func (m *PrimaryName) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
 	return n
 }
 
@@ -734,16 +1472,959 @@ func (m *EventNameUnbound) Size() (n int) {
 	if m.Restricted {
 		n += 2
 	}
-	return n
-}
+	return n
+}
+
+func (m *EventNameUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	if m.Restricted {
+		n += 2
+	}
+	return n
+}
+
+func (m *EventNameRenewed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	if m.Expiration != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration)
+		n += 1 + l + sovName(uint64(l))
+	}
+	return n
+}
+
+func (m *EventNameExpired) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	if m.Expiration != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration)
+		n += 1 + l + sovName(uint64(l))
+	}
+	return n
+}
+
+func (m *EventNameConversionFailed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	return n
+}
+
+func (m *EventPrimaryNameUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	return n
+}
+
+func sovName(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozName(x uint64) (n int) {
+	return sovName(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowName
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSegmentLength", wireType)
+			}
+			m.MaxSegmentLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSegmentLength |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSegmentLength", wireType)
+			}
+			m.MinSegmentLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinSegmentLength |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxNameLevels", wireType)
+			}
+			m.MaxNameLevels = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxNameLevels |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowUnrestrictedNames", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowUnrestrictedNames = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaseDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.LeaseDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RenewalFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RenewalFee = append(m.RenewalFee, types.Coin{})
+			if err := m.RenewalFee[len(m.RenewalFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GracePeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.GracePeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipName(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthName
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NameRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowName
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NameRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NameRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restricted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Restricted = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipName(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthName
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PrimaryName) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowName
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PrimaryName: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PrimaryName: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipName(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthName
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateRootNameProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowName
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateRootNameProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateRootNameProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restricted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Restricted = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipName(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthName
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventNameBound) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowName
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventNameBound: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventNameBound: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restricted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Restricted = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipName(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthName
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovName(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozName(x uint64) (n int) {
-	return sovName(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *EventNameUnbound) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -766,17 +2447,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventNameUnbound: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventNameUnbound: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxSegmentLength", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			m.MaxSegmentLength = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowName
@@ -786,16 +2467,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxSegmentLength |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinSegmentLength", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			m.MinSegmentLength = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowName
@@ -805,33 +2499,27 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MinSegmentLength |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxNameLevels", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
 			}
-			m.MaxNameLevels = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowName
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaxNameLevels |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
 			}
-		case 4:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowUnrestrictedNames", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Restricted", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -848,7 +2536,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.AllowUnrestrictedNames = bool(v != 0)
+			m.Restricted = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipName(dAtA[iNdEx:])
@@ -870,7 +2558,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NameRecord) Unmarshal(dAtA []byte) error {
+func (m *EventNameUpdate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -893,15 +2581,15 @@ func (m *NameRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NameRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventNameUpdate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NameRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventNameUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -929,11 +2617,11 @@ func (m *NameRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -961,7 +2649,7 @@ func (m *NameRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
@@ -1004,7 +2692,7 @@ func (m *NameRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateRootNameProposal) Unmarshal(dAtA []byte) error {
+func (m *EventNameRenewed) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1027,15 +2715,15 @@ func (m *CreateRootNameProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateRootNameProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventNameRenewed: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateRootNameProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventNameRenewed: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1063,11 +2751,11 @@ func (m *CreateRootNameProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1095,11 +2783,97 @@ func (m *CreateRootNameProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipName(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthName
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventNameExpired) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowName
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventNameExpired: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventNameExpired: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1127,11 +2901,11 @@ func (m *CreateRootNameProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1159,13 +2933,13 @@ func (m *CreateRootNameProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Restricted", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowName
@@ -1175,12 +2949,28 @@ func (m *CreateRootNameProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Restricted = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipName(dAtA[iNdEx:])
@@ -1202,7 +2992,7 @@ func (m *CreateRootNameProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventNameBound) Unmarshal(dAtA []byte) error {
+func (m *EventNameConversionFailed) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1225,10 +3015,10 @@ func (m *EventNameBound) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventNameBound: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventNameConversionFailed: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventNameBound: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventNameConversionFailed: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1296,10 +3086,10 @@ func (m *EventNameBound) Unmarshal(dAtA []byte) error {
 			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Restricted", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowName
@@ -1309,12 +3099,24 @@ func (m *EventNameBound) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Restricted = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipName(dAtA[iNdEx:])
@@ -1336,7 +3138,7 @@ func (m *EventNameBound) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventNameUnbound) Unmarshal(dAtA []byte) error {
+func (m *EventPrimaryNameUpdate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1359,10 +3161,10 @@ func (m *EventNameUnbound) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventNameUnbound: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventPrimaryNameUpdate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventNameUnbound: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventPrimaryNameUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1429,26 +3231,6 @@ func (m *EventNameUnbound) Unmarshal(dAtA []byte) error {
 			}
 			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Restricted", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowName
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Restricted = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipName(dAtA[iNdEx:])
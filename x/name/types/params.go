@@ -2,7 +2,9 @@ package types
 
 import (
 	"fmt"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
@@ -12,8 +14,13 @@ const (
 	DefaultMaxSegmentLength       = uint32(32)
 	DefaultMaxSegments            = uint32(16)
 	DefaultAllowUnrestrictedNames = true
+	DefaultLeaseDuration          = time.Duration(0)
+	DefaultGracePeriod            = 30 * 24 * time.Hour
 )
 
+// DefaultRenewalFee is the default fee charged to renew a name lease.
+var DefaultRenewalFee = sdk.Coins{}
+
 // Parameter store keys
 var (
 	// maximum length of name segment to allow
@@ -24,6 +31,12 @@ var (
 	ParamStoreKeyMaxNameLevels = []byte("MaxNameLevels")
 	// determines if unrestricted name keys are allowed or not
 	ParamStoreKeyAllowUnrestrictedNames = []byte("AllowUnrestrictedNames")
+	// length of the lease given to newly bound names, zero disables leases
+	ParamStoreKeyLeaseDuration = []byte("LeaseDuration")
+	// fee charged for each lease renewal
+	ParamStoreKeyRenewalFee = []byte("RenewalFee")
+	// amount of time after a lease expires before the name is released
+	ParamStoreKeyGracePeriod = []byte("GracePeriod")
 )
 
 // ParamKeyTable for slashing module
@@ -37,12 +50,18 @@ func NewParams(
 	minSegmentLength uint32,
 	maxNameLevels uint32,
 	allowUnrestrictedNames bool,
+	leaseDuration time.Duration,
+	renewalFee sdk.Coins,
+	gracePeriod time.Duration,
 ) Params {
 	return Params{
 		MaxSegmentLength:       maxSegmentLength,
 		MinSegmentLength:       minSegmentLength,
 		MaxNameLevels:          maxNameLevels,
 		AllowUnrestrictedNames: allowUnrestrictedNames,
+		LeaseDuration:          leaseDuration,
+		RenewalFee:             renewalFee,
+		GracePeriod:            gracePeriod,
 	}
 }
 
@@ -53,6 +72,9 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(ParamStoreKeyMinSegmentLength, &p.MinSegmentLength, validateIntParam),
 		paramtypes.NewParamSetPair(ParamStoreKeyMaxNameLevels, &p.MaxNameLevels, validateIntParam),
 		paramtypes.NewParamSetPair(ParamStoreKeyAllowUnrestrictedNames, &p.AllowUnrestrictedNames, validateAllowUnrestrictedNames),
+		paramtypes.NewParamSetPair(ParamStoreKeyLeaseDuration, &p.LeaseDuration, validateDurationParam),
+		paramtypes.NewParamSetPair(ParamStoreKeyRenewalFee, &p.RenewalFee, validateRenewalFee),
+		paramtypes.NewParamSetPair(ParamStoreKeyGracePeriod, &p.GracePeriod, validateDurationParam),
 	}
 }
 
@@ -63,6 +85,9 @@ func DefaultParams() Params {
 		DefaultMinSegmentLength,
 		DefaultMaxSegments,
 		DefaultAllowUnrestrictedNames,
+		DefaultLeaseDuration,
+		DefaultRenewalFee,
+		DefaultGracePeriod,
 	)
 }
 
@@ -98,6 +123,15 @@ func (p *Params) Equal(that interface{}) bool {
 	if p.MinSegmentLength != that1.MinSegmentLength {
 		return false
 	}
+	if p.LeaseDuration != that1.LeaseDuration {
+		return false
+	}
+	if p.RenewalFee.String() != that1.RenewalFee.String() {
+		return false
+	}
+	if p.GracePeriod != that1.GracePeriod {
+		return false
+	}
 
 	return true
 }
@@ -118,3 +152,22 @@ func validateAllowUnrestrictedNames(i interface{}) error {
 	}
 	return nil
 }
+
+func validateDurationParam(i interface{}) error {
+	d, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if d < 0 {
+		return fmt.Errorf("duration cannot be negative: %s", d)
+	}
+	return nil
+}
+
+func validateRenewalFee(i interface{}) error {
+	fee, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return fee.Validate()
+}
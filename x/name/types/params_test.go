@@ -2,7 +2,9 @@ package types
 
 import (
 	"testing"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -16,12 +18,21 @@ func TestDefaultParams(t *testing.T) {
 	require.Equal(t, DefaultMaxSegmentLength, p.MaxSegmentLength)
 	require.Equal(t, DefaultMaxSegments, p.MaxNameLevels)
 	require.Equal(t, DefaultAllowUnrestrictedNames, p.AllowUnrestrictedNames)
+	require.Equal(t, DefaultLeaseDuration, p.LeaseDuration)
+	require.Equal(t, DefaultRenewalFee, p.RenewalFee)
+	require.Equal(t, DefaultGracePeriod, p.GracePeriod)
 
-	require.True(t, p.Equal(NewParams(DefaultMaxSegmentLength, DefaultMinSegmentLength, DefaultMaxSegments, DefaultAllowUnrestrictedNames)))
-	require.False(t, p.Equal(NewParams(1, DefaultMinSegmentLength, DefaultMaxSegments, DefaultAllowUnrestrictedNames)))
-	require.False(t, p.Equal(NewParams(DefaultMaxSegmentLength, 1, DefaultMaxSegments, DefaultAllowUnrestrictedNames)))
-	require.False(t, p.Equal(NewParams(DefaultMaxSegmentLength, DefaultMinSegmentLength, 1, DefaultAllowUnrestrictedNames)))
-	require.False(t, p.Equal(NewParams(DefaultMaxSegmentLength, DefaultMinSegmentLength, DefaultMaxSegments, false)))
+	newParams := func(maxSegmentLength, minSegmentLength, maxNameLevels uint32, allowUnrestrictedNames bool) Params {
+		return NewParams(maxSegmentLength, minSegmentLength, maxNameLevels, allowUnrestrictedNames, DefaultLeaseDuration, DefaultRenewalFee, DefaultGracePeriod)
+	}
+	require.True(t, p.Equal(newParams(DefaultMaxSegmentLength, DefaultMinSegmentLength, DefaultMaxSegments, DefaultAllowUnrestrictedNames)))
+	require.False(t, p.Equal(newParams(1, DefaultMinSegmentLength, DefaultMaxSegments, DefaultAllowUnrestrictedNames)))
+	require.False(t, p.Equal(newParams(DefaultMaxSegmentLength, 1, DefaultMaxSegments, DefaultAllowUnrestrictedNames)))
+	require.False(t, p.Equal(newParams(DefaultMaxSegmentLength, DefaultMinSegmentLength, 1, DefaultAllowUnrestrictedNames)))
+	require.False(t, p.Equal(newParams(DefaultMaxSegmentLength, DefaultMinSegmentLength, DefaultMaxSegments, false)))
+	require.False(t, p.Equal(NewParams(DefaultMaxSegmentLength, DefaultMinSegmentLength, DefaultMaxSegments, DefaultAllowUnrestrictedNames, time.Hour, DefaultRenewalFee, DefaultGracePeriod)))
+	require.False(t, p.Equal(NewParams(DefaultMaxSegmentLength, DefaultMinSegmentLength, DefaultMaxSegments, DefaultAllowUnrestrictedNames, DefaultLeaseDuration, sdk.NewCoins(sdk.NewInt64Coin("nhash", 1)), DefaultGracePeriod)))
+	require.False(t, p.Equal(NewParams(DefaultMaxSegmentLength, DefaultMinSegmentLength, DefaultMaxSegments, DefaultAllowUnrestrictedNames, DefaultLeaseDuration, DefaultRenewalFee, time.Hour)))
 
 	var p2 *Params
 	require.True(t, p2.Equal(nil))
@@ -30,13 +41,13 @@ func TestDefaultParams(t *testing.T) {
 
 func TestParamString(t *testing.T) {
 	p := DefaultParams()
-	require.Equal(t, `max_segment_length:32 min_segment_length:2 max_name_levels:16 allow_unrestricted_names:true `, p.String())
+	require.Equal(t, `max_segment_length:32 min_segment_length:2 max_name_levels:16 allow_unrestricted_names:true grace_period:<seconds:2592000 > `, p.String())
 }
 
 func TestParamSetPairs(t *testing.T) {
 	p := DefaultParams()
 	pairs := p.ParamSetPairs()
-	require.Equal(t, 4, len(pairs))
+	require.Equal(t, 7, len(pairs))
 
 	for i := range pairs {
 		switch string(pairs[i].Key) {
@@ -55,6 +66,16 @@ func TestParamSetPairs(t *testing.T) {
 			require.Error(t, pairs[i].ValidatorFn("foo"))
 			require.Error(t, pairs[i].ValidatorFn(-1000))
 			require.NoError(t, pairs[i].ValidatorFn(uint32(1000)))
+		case string(ParamStoreKeyLeaseDuration), string(ParamStoreKeyGracePeriod):
+			require.Error(t, pairs[i].ValidatorFn("foo"))
+			require.Error(t, pairs[i].ValidatorFn(-time.Hour))
+			require.NoError(t, pairs[i].ValidatorFn(time.Duration(0)))
+			require.NoError(t, pairs[i].ValidatorFn(time.Hour))
+		case string(ParamStoreKeyRenewalFee):
+			require.Error(t, pairs[i].ValidatorFn("foo"))
+			require.Error(t, pairs[i].ValidatorFn(sdk.Coins{sdk.Coin{Denom: "nhash", Amount: sdk.NewInt(-1)}}))
+			require.NoError(t, pairs[i].ValidatorFn(sdk.Coins{}))
+			require.NoError(t, pairs[i].ValidatorFn(sdk.NewCoins(sdk.NewInt64Coin("nhash", 10))))
 		default:
 			require.Fail(t, "unexpected param set pair")
 		}
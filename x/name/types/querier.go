@@ -1,5 +1,7 @@
 package types
 
+import "time"
+
 // querier keys
 const (
 	// The query base for getting the module params
@@ -15,6 +17,8 @@ type QueryNameResult struct {
 	Name       string `json:"name"`
 	Address    string `json:"address"`
 	Restricted bool   `json:"restricted"`
+	// Expiration is when the lease on the name expires, nil if it does not expire.
+	Expiration *time.Time `json:"expiration,omitempty"`
 }
 
 // String implements fmt.Stringer
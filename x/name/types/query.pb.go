@@ -289,6 +289,171 @@ func (m *QueryReverseLookupResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryReverseLookupResponse proto.InternalMessageInfo
 
+// QueryChildrenRequest is the request type for the Query/Children method.
+type QueryChildrenRequest struct {
+	// name to find the child names of
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// depth is the number of levels below the name to include.
+	// Zero or one only returns the names directly under the name. Two would also include the children of those, etc.
+	Depth uint32 `protobuf:"varint,2,opt,name=depth,proto3" json:"depth,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryChildrenRequest) Reset()         { *m = QueryChildrenRequest{} }
+func (m *QueryChildrenRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryChildrenRequest) ProtoMessage()    {}
+func (*QueryChildrenRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e9b0d5536fc961a, []int{6}
+}
+func (m *QueryChildrenRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChildrenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChildrenRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryChildrenRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChildrenRequest.Merge(m, src)
+}
+func (m *QueryChildrenRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChildrenRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChildrenRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryChildrenRequest proto.InternalMessageInfo
+
+// QueryChildrenResponse is the response type for the Query/Children method.
+type QueryChildrenResponse struct {
+	// an array of name records bound under the given name
+	Records []NameRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryChildrenResponse) Reset()         { *m = QueryChildrenResponse{} }
+func (m *QueryChildrenResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryChildrenResponse) ProtoMessage()    {}
+func (*QueryChildrenResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e9b0d5536fc961a, []int{7}
+}
+func (m *QueryChildrenResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChildrenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChildrenResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryChildrenResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChildrenResponse.Merge(m, src)
+}
+func (m *QueryChildrenResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChildrenResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChildrenResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryChildrenResponse proto.InternalMessageInfo
+
+// QueryPrimaryNameRequest is the request type for the Query/PrimaryName method.
+type QueryPrimaryNameRequest struct {
+	// address to find the primary name of
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryPrimaryNameRequest) Reset()         { *m = QueryPrimaryNameRequest{} }
+func (m *QueryPrimaryNameRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPrimaryNameRequest) ProtoMessage()    {}
+func (*QueryPrimaryNameRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e9b0d5536fc961a, []int{8}
+}
+func (m *QueryPrimaryNameRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPrimaryNameRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPrimaryNameRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPrimaryNameRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPrimaryNameRequest.Merge(m, src)
+}
+func (m *QueryPrimaryNameRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPrimaryNameRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPrimaryNameRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPrimaryNameRequest proto.InternalMessageInfo
+
+// QueryPrimaryNameResponse is the response type for the Query/PrimaryName method.
+type QueryPrimaryNameResponse struct {
+	// the primary name record of the address
+	Record NameRecord `protobuf:"bytes,1,opt,name=record,proto3" json:"record"`
+	// designated is true if the address designated the name as its primary name, false if it is the fallback name.
+	Designated bool `protobuf:"varint,2,opt,name=designated,proto3" json:"designated,omitempty"`
+}
+
+func (m *QueryPrimaryNameResponse) Reset()         { *m = QueryPrimaryNameResponse{} }
+func (m *QueryPrimaryNameResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPrimaryNameResponse) ProtoMessage()    {}
+func (*QueryPrimaryNameResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e9b0d5536fc961a, []int{9}
+}
+func (m *QueryPrimaryNameResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPrimaryNameResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPrimaryNameResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPrimaryNameResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPrimaryNameResponse.Merge(m, src)
+}
+func (m *QueryPrimaryNameResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPrimaryNameResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPrimaryNameResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPrimaryNameResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "provenance.name.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "provenance.name.v1.QueryParamsResponse")
@@ -296,46 +461,61 @@ func init() {
 	proto.RegisterType((*QueryResolveResponse)(nil), "provenance.name.v1.QueryResolveResponse")
 	proto.RegisterType((*QueryReverseLookupRequest)(nil), "provenance.name.v1.QueryReverseLookupRequest")
 	proto.RegisterType((*QueryReverseLookupResponse)(nil), "provenance.name.v1.QueryReverseLookupResponse")
+	proto.RegisterType((*QueryChildrenRequest)(nil), "provenance.name.v1.QueryChildrenRequest")
+	proto.RegisterType((*QueryChildrenResponse)(nil), "provenance.name.v1.QueryChildrenResponse")
+	proto.RegisterType((*QueryPrimaryNameRequest)(nil), "provenance.name.v1.QueryPrimaryNameRequest")
+	proto.RegisterType((*QueryPrimaryNameResponse)(nil), "provenance.name.v1.QueryPrimaryNameResponse")
 }
 
 func init() { proto.RegisterFile("provenance/name/v1/query.proto", fileDescriptor_4e9b0d5536fc961a) }
 
 var fileDescriptor_4e9b0d5536fc961a = []byte{
-	// 533 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x3f, 0x6f, 0xd3, 0x40,
-	0x18, 0xc6, 0x7d, 0x6d, 0x49, 0xcb, 0x55, 0x2c, 0x47, 0x90, 0x82, 0x55, 0x9c, 0xca, 0xaa, 0xd2,
-	0xa8, 0xa2, 0x77, 0x24, 0x5d, 0x10, 0x63, 0x07, 0x58, 0x90, 0x08, 0x1e, 0xd9, 0x2e, 0xce, 0xc9,
-	0x58, 0x24, 0x7e, 0x5d, 0x9f, 0x63, 0x51, 0x55, 0x59, 0x60, 0xa0, 0x23, 0x12, 0x2b, 0x43, 0x3f,
-	0x02, 0x1f, 0xa3, 0x63, 0x25, 0x16, 0x26, 0x84, 0x12, 0x06, 0x3e, 0x06, 0xf2, 0xdd, 0x45, 0x71,
-	0x94, 0x2b, 0x61, 0xbb, 0xbc, 0xff, 0x9e, 0xdf, 0xeb, 0xe7, 0x55, 0xb0, 0x97, 0x66, 0x50, 0x88,
-	0x84, 0x27, 0xa1, 0x60, 0x09, 0x1f, 0x09, 0x56, 0x74, 0xd8, 0xd9, 0x58, 0x64, 0xe7, 0x34, 0xcd,
-	0x20, 0x07, 0x42, 0x16, 0x79, 0x5a, 0xe6, 0x69, 0xd1, 0x71, 0x8f, 0x42, 0x90, 0x23, 0x90, 0xac,
-	0xcf, 0xa5, 0xd0, 0xc5, 0xac, 0xe8, 0xf4, 0x45, 0xce, 0x3b, 0x2c, 0xe5, 0x51, 0x9c, 0xf0, 0x3c,
-	0x86, 0x44, 0xf7, 0xbb, 0xf5, 0x08, 0x22, 0x50, 0x4f, 0x56, 0xbe, 0x4c, 0x74, 0x2f, 0x02, 0x88,
-	0x86, 0x82, 0xf1, 0x34, 0x66, 0x3c, 0x49, 0x20, 0x57, 0x2d, 0xd2, 0x64, 0x1f, 0x59, 0x98, 0x94,
-	0xb6, 0x4a, 0xfb, 0x75, 0x4c, 0x5e, 0x97, 0xa2, 0x3d, 0x9e, 0xf1, 0x91, 0x0c, 0xc4, 0xd9, 0x58,
-	0xc8, 0xdc, 0x7f, 0x85, 0xef, 0x2f, 0x45, 0x65, 0x0a, 0x89, 0x14, 0xe4, 0x29, 0xae, 0xa5, 0x2a,
-	0xd2, 0x40, 0xfb, 0xa8, 0xbd, 0xdb, 0x75, 0xe9, 0xea, 0x42, 0x54, 0xf7, 0x9c, 0x6e, 0x5d, 0xff,
-	0x6c, 0x3a, 0x81, 0xa9, 0xf7, 0x4f, 0xcc, 0xc0, 0x40, 0x48, 0x18, 0x16, 0xc2, 0xe8, 0x10, 0x82,
-	0xb7, 0xca, 0x36, 0x35, 0xee, 0x6e, 0xa0, 0xde, 0xcf, 0x76, 0x2e, 0xaf, 0x9a, 0xce, 0x9f, 0xab,
-	0xa6, 0xe3, 0xf7, 0x70, 0x7d, 0xb9, 0xc9, 0x60, 0x34, 0xf0, 0x36, 0x1f, 0x0c, 0x32, 0x21, 0xa5,
-	0x69, 0x9c, 0xff, 0x24, 0x1e, 0xc6, 0x99, 0x90, 0x79, 0x16, 0x87, 0xb9, 0x18, 0x34, 0x36, 0xf6,
-	0x51, 0x7b, 0x27, 0xa8, 0x44, 0xfc, 0x4f, 0x08, 0x3f, 0x34, 0x23, 0x0b, 0x91, 0x49, 0xf1, 0x12,
-	0xe0, 0xdd, 0x38, 0x9d, 0xd3, 0xdc, 0x3e, 0xf7, 0x39, 0xc6, 0x0b, 0x33, 0xd4, 0xdc, 0xdd, 0x6e,
-	0x8b, 0x6a, 0xe7, 0x68, 0xe9, 0x1c, 0xd5, 0x36, 0x1b, 0xe7, 0x68, 0x8f, 0x47, 0xf3, 0x1d, 0x83,
-	0x4a, 0x67, 0x65, 0xb7, 0x8f, 0x08, 0xbb, 0x36, 0x12, 0xb3, 0xe2, 0xe2, 0xc3, 0x6c, 0xce, 0x3f,
-	0x0c, 0x79, 0x61, 0x81, 0x38, 0x5c, 0x0b, 0xa1, 0x07, 0xda, 0x29, 0xba, 0xdf, 0x36, 0xf1, 0x1d,
-	0x45, 0x41, 0x26, 0xb8, 0xa6, 0x8d, 0x23, 0x2d, 0x9b, 0xa9, 0xab, 0x37, 0xe2, 0x1e, 0xae, 0xad,
-	0xd3, 0xd2, 0xbe, 0xff, 0xe1, 0xfb, 0xef, 0x2f, 0x1b, 0x7b, 0xc4, 0x65, 0x96, 0x53, 0xd4, 0xf7,
-	0x41, 0x2e, 0x11, 0xde, 0x36, 0x36, 0x93, 0xdb, 0x07, 0x2f, 0x5f, 0x8f, 0xdb, 0x5e, 0x5f, 0x68,
-	0x10, 0x8e, 0x14, 0xc2, 0x01, 0xf1, 0x6d, 0x08, 0x99, 0x2e, 0x66, 0x17, 0x65, 0x60, 0x42, 0xbe,
-	0x22, 0x7c, 0x6f, 0xc9, 0x14, 0x72, 0xfc, 0x0f, 0x9d, 0xd5, 0x33, 0x72, 0xe9, 0xff, 0x96, 0x1b,
-	0xb8, 0xc7, 0x0a, 0xae, 0x45, 0x0e, 0x6c, 0x70, 0x43, 0x55, 0xcb, 0x2e, 0xcc, 0x25, 0x4e, 0x4e,
-	0xc3, 0xeb, 0xa9, 0x87, 0x6e, 0xa6, 0x1e, 0xfa, 0x35, 0xf5, 0xd0, 0xe7, 0x99, 0xe7, 0xdc, 0xcc,
-	0x3c, 0xe7, 0xc7, 0xcc, 0x73, 0xf0, 0x83, 0x18, 0x2c, 0xca, 0x3d, 0xf4, 0xe6, 0x49, 0x14, 0xe7,
-	0x6f, 0xc7, 0x7d, 0x1a, 0xc2, 0xa8, 0x22, 0x71, 0x1c, 0x43, 0x55, 0xf0, 0xbd, 0x96, 0xcc, 0xcf,
-	0x53, 0x21, 0xfb, 0x35, 0xf5, 0xe7, 0x70, 0xf2, 0x37, 0x00, 0x00, 0xff, 0xff, 0xb6, 0x9d, 0xe6,
-	0x24, 0xd1, 0x04, 0x00, 0x00,
+	// 717 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x3d, 0x6f, 0x13, 0x4d,
+	0x10, 0xf6, 0xe6, 0xc3, 0xc9, 0x3b, 0x56, 0x9a, 0x7d, 0x1d, 0xbd, 0x7e, 0x4f, 0xe1, 0x12, 0x1d,
+	0xf9, 0x22, 0x1f, 0x77, 0xd8, 0x69, 0x10, 0x02, 0x8a, 0x20, 0x41, 0x83, 0xc0, 0x5c, 0x49, 0xb7,
+	0xf6, 0xad, 0x2e, 0x27, 0xec, 0xdb, 0xcb, 0xee, 0xd9, 0x22, 0x8a, 0xd2, 0x84, 0x82, 0x14, 0x14,
+	0x08, 0x0a, 0x1a, 0x8a, 0xd4, 0xfc, 0x92, 0x94, 0x91, 0x68, 0xa0, 0x41, 0x28, 0xa1, 0xe0, 0x67,
+	0xa0, 0xdb, 0xdd, 0x93, 0xcf, 0xf1, 0x39, 0x76, 0x01, 0xdd, 0xde, 0xec, 0x3c, 0x33, 0xcf, 0x3c,
+	0x33, 0xb3, 0x07, 0x66, 0xc4, 0x59, 0x97, 0x86, 0x24, 0x6c, 0x52, 0x27, 0x24, 0x6d, 0xea, 0x74,
+	0xab, 0xce, 0x7e, 0x87, 0xf2, 0x03, 0x3b, 0xe2, 0x2c, 0x66, 0x18, 0xf7, 0xee, 0xed, 0xe4, 0xde,
+	0xee, 0x56, 0x8d, 0x8d, 0x26, 0x13, 0x6d, 0x26, 0x9c, 0x06, 0x11, 0x54, 0x39, 0x3b, 0xdd, 0x6a,
+	0x83, 0xc6, 0xa4, 0xea, 0x44, 0xc4, 0x0f, 0x42, 0x12, 0x07, 0x2c, 0x54, 0x78, 0xa3, 0xec, 0x33,
+	0x9f, 0xc9, 0xa3, 0x93, 0x9c, 0xb4, 0x75, 0xc1, 0x67, 0xcc, 0x6f, 0x51, 0x87, 0x44, 0x81, 0x43,
+	0xc2, 0x90, 0xc5, 0x12, 0x22, 0xf4, 0xed, 0x8d, 0x1c, 0x4e, 0x32, 0xb7, 0xbc, 0xb6, 0xca, 0x80,
+	0x9f, 0x27, 0x49, 0xeb, 0x84, 0x93, 0xb6, 0x70, 0xe9, 0x7e, 0x87, 0x8a, 0xd8, 0x7a, 0x06, 0xff,
+	0xf6, 0x59, 0x45, 0xc4, 0x42, 0x41, 0xf1, 0x1d, 0x28, 0x46, 0xd2, 0x52, 0x41, 0x4b, 0x68, 0xbd,
+	0x54, 0x33, 0xec, 0xc1, 0x82, 0x6c, 0x85, 0xd9, 0x9d, 0x3a, 0xfb, 0xbe, 0x58, 0x70, 0xb5, 0xbf,
+	0xb5, 0xa3, 0x03, 0xba, 0x54, 0xb0, 0x56, 0x97, 0xea, 0x3c, 0x18, 0xc3, 0x54, 0x02, 0x93, 0xe1,
+	0xfe, 0x71, 0xe5, 0xf9, 0xee, 0xec, 0xc9, 0xe9, 0x62, 0xe1, 0xd7, 0xe9, 0x62, 0xc1, 0xaa, 0x43,
+	0xb9, 0x1f, 0xa4, 0x69, 0x54, 0x60, 0x86, 0x78, 0x1e, 0xa7, 0x42, 0x68, 0x60, 0xfa, 0x89, 0x4d,
+	0x00, 0x4e, 0x45, 0xcc, 0x83, 0x66, 0x4c, 0xbd, 0xca, 0xc4, 0x12, 0x5a, 0x9f, 0x75, 0x33, 0x16,
+	0xeb, 0x0d, 0x82, 0xff, 0x75, 0xc8, 0x2e, 0xe5, 0x82, 0x3e, 0x61, 0xec, 0x65, 0x27, 0x4a, 0xd9,
+	0x0c, 0x8f, 0xfb, 0x08, 0xa0, 0xd7, 0x0c, 0x19, 0xb7, 0x54, 0x5b, 0xb5, 0x55, 0xe7, 0xec, 0xa4,
+	0x73, 0xb6, 0x6a, 0xb3, 0xee, 0x9c, 0x5d, 0x27, 0x7e, 0x5a, 0xa3, 0x9b, 0x41, 0x66, 0x6a, 0x7b,
+	0x8d, 0xc0, 0xc8, 0x63, 0xa2, 0x4b, 0xec, 0x09, 0x33, 0x99, 0x0a, 0x83, 0x1f, 0xe7, 0x90, 0x58,
+	0x1b, 0x49, 0x42, 0x05, 0x1c, 0xc2, 0xe2, 0x3d, 0xd2, 0x12, 0x3f, 0xdc, 0x0b, 0x5a, 0x1e, 0xa7,
+	0xe1, 0x35, 0x8d, 0xc1, 0x65, 0x98, 0xf6, 0x68, 0x14, 0xef, 0xc9, 0xd4, 0x73, 0xae, 0xfa, 0xb8,
+	0x22, 0xcd, 0xe4, 0x1f, 0x90, 0xe6, 0x33, 0x82, 0xf9, 0x2b, 0xa4, 0xb4, 0x2a, 0x0f, 0x60, 0x86,
+	0xd3, 0x26, 0xe3, 0x9e, 0x90, 0xc2, 0x94, 0x6a, 0x66, 0xde, 0x00, 0x3e, 0x25, 0x6d, 0xea, 0x4a,
+	0x37, 0x3d, 0x84, 0x29, 0xe8, 0x6f, 0x28, 0x78, 0x1f, 0xfe, 0x53, 0x9b, 0xc2, 0x83, 0x36, 0xe1,
+	0x07, 0x2a, 0xf7, 0x88, 0x71, 0xca, 0xc0, 0x8f, 0x11, 0x54, 0x06, 0xf1, 0xba, 0xdc, 0x7b, 0x50,
+	0x54, 0xcc, 0xf5, 0xba, 0x8d, 0x57, 0xad, 0xc6, 0x24, 0xbb, 0xe0, 0x51, 0x11, 0xf8, 0x21, 0xc9,
+	0xec, 0x42, 0xcf, 0xd2, 0x23, 0x51, 0xfb, 0x36, 0x0d, 0xd3, 0x92, 0x04, 0x3e, 0x82, 0xa2, 0x5a,
+	0x5f, 0xbc, 0x9a, 0x97, 0x6b, 0xf0, 0xa5, 0x30, 0xd6, 0x46, 0xfa, 0xa9, 0x62, 0x2c, 0xeb, 0xf8,
+	0xcb, 0xcf, 0x0f, 0x13, 0x0b, 0xd8, 0x70, 0x72, 0x1e, 0x24, 0xf5, 0x4a, 0xe0, 0x13, 0x04, 0x33,
+	0x7a, 0xd9, 0xf1, 0xf0, 0xc0, 0xfd, 0x6f, 0x88, 0xb1, 0x3e, 0xda, 0x51, 0x53, 0xd8, 0x90, 0x14,
+	0x96, 0xb1, 0x95, 0x47, 0x81, 0x2b, 0x67, 0xe7, 0x30, 0x31, 0x1c, 0xe1, 0x4f, 0x08, 0xe6, 0xfa,
+	0x56, 0x13, 0x6f, 0x5f, 0x93, 0x67, 0xf0, 0x31, 0x31, 0xec, 0x71, 0xdd, 0x35, 0xb9, 0x2d, 0x49,
+	0x6e, 0x15, 0x2f, 0xe7, 0x91, 0x6b, 0x49, 0x5f, 0xe7, 0x50, 0x0f, 0xd0, 0x11, 0x7e, 0x8b, 0x60,
+	0x36, 0x5d, 0x0f, 0x3c, 0x5c, 0x81, 0x2b, 0x6b, 0x6d, 0xdc, 0x1a, 0xc3, 0x53, 0xf3, 0xd9, 0x94,
+	0x7c, 0x56, 0xf0, 0xcd, 0x3c, 0x3e, 0x4d, 0xed, 0x9d, 0xaa, 0xf5, 0x11, 0x41, 0x29, 0x33, 0xc1,
+	0x78, 0x73, 0xf8, 0x54, 0x0c, 0xec, 0x89, 0xb1, 0x35, 0x9e, 0xb3, 0xe6, 0xb5, 0x2d, 0x79, 0xad,
+	0xe1, 0x95, 0xdc, 0x39, 0x52, 0x80, 0x9e, 0x50, 0xbb, 0xcd, 0xb3, 0x0b, 0x13, 0x9d, 0x5f, 0x98,
+	0xe8, 0xc7, 0x85, 0x89, 0xde, 0x5d, 0x9a, 0x85, 0xf3, 0x4b, 0xb3, 0xf0, 0xf5, 0xd2, 0x2c, 0xc0,
+	0x7c, 0xc0, 0x72, 0x12, 0xd7, 0xd1, 0x8b, 0xdb, 0x7e, 0x10, 0xef, 0x75, 0x1a, 0x76, 0x93, 0xb5,
+	0x33, 0x39, 0xb6, 0x03, 0x96, 0xcd, 0xf8, 0x4a, 0xe5, 0x8c, 0x0f, 0x22, 0x2a, 0x1a, 0x45, 0xf9,
+	0x2f, 0xdd, 0xf9, 0x3d, 0x00, 0x89, 0x53, 0x41, 0xfb, 0x00, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -356,6 +536,12 @@ type QueryClient interface {
 	Resolve(ctx context.Context, in *QueryResolveRequest, opts ...grpc.CallOption) (*QueryResolveResponse, error)
 	// ReverseLookup queries for all names bound against a given address
 	ReverseLookup(ctx context.Context, in *QueryReverseLookupRequest, opts ...grpc.CallOption) (*QueryReverseLookupResponse, error)
+	// Children queries for the names bound under a given name
+	Children(ctx context.Context, in *QueryChildrenRequest, opts ...grpc.CallOption) (*QueryChildrenResponse, error)
+	// PrimaryName queries for the primary name of an address.
+	// If the address has not designated a primary name, the name bound to the address with the fewest segments is
+	// returned, using the shortest name and then alphabetical order to break ties.
+	PrimaryName(ctx context.Context, in *QueryPrimaryNameRequest, opts ...grpc.CallOption) (*QueryPrimaryNameResponse, error)
 }
 
 type queryClient struct {
@@ -393,6 +579,24 @@ func (c *queryClient) ReverseLookup(ctx context.Context, in *QueryReverseLookupR
 	return out, nil
 }
 
+func (c *queryClient) Children(ctx context.Context, in *QueryChildrenRequest, opts ...grpc.CallOption) (*QueryChildrenResponse, error) {
+	out := new(QueryChildrenResponse)
+	err := c.cc.Invoke(ctx, "/provenance.name.v1.Query/Children", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PrimaryName(ctx context.Context, in *QueryPrimaryNameRequest, opts ...grpc.CallOption) (*QueryPrimaryNameResponse, error) {
+	out := new(QueryPrimaryNameResponse)
+	err := c.cc.Invoke(ctx, "/provenance.name.v1.Query/PrimaryName", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries params of the name module.
@@ -401,6 +605,12 @@ type QueryServer interface {
 	Resolve(context.Context, *QueryResolveRequest) (*QueryResolveResponse, error)
 	// ReverseLookup queries for all names bound against a given address
 	ReverseLookup(context.Context, *QueryReverseLookupRequest) (*QueryReverseLookupResponse, error)
+	// Children queries for the names bound under a given name
+	Children(context.Context, *QueryChildrenRequest) (*QueryChildrenResponse, error)
+	// PrimaryName queries for the primary name of an address.
+	// If the address has not designated a primary name, the name bound to the address with the fewest segments is
+	// returned, using the shortest name and then alphabetical order to break ties.
+	PrimaryName(context.Context, *QueryPrimaryNameRequest) (*QueryPrimaryNameResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -416,6 +626,12 @@ func (*UnimplementedQueryServer) Resolve(ctx context.Context, req *QueryResolveR
 func (*UnimplementedQueryServer) ReverseLookup(ctx context.Context, req *QueryReverseLookupRequest) (*QueryReverseLookupResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReverseLookup not implemented")
 }
+func (*UnimplementedQueryServer) Children(ctx context.Context, req *QueryChildrenRequest) (*QueryChildrenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Children not implemented")
+}
+func (*UnimplementedQueryServer) PrimaryName(ctx context.Context, req *QueryPrimaryNameRequest) (*QueryPrimaryNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PrimaryName not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -475,6 +691,42 @@ func _Query_ReverseLookup_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Children_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryChildrenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Children(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.name.v1.Query/Children",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Children(ctx, req.(*QueryChildrenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PrimaryName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPrimaryNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PrimaryName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.name.v1.Query/PrimaryName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PrimaryName(ctx, req.(*QueryPrimaryNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "provenance.name.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -491,6 +743,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ReverseLookup",
 			Handler:    _Query_ReverseLookup_Handler,
 		},
+		{
+			MethodName: "Children",
+			Handler:    _Query_Children_Handler,
+		},
+		{
+			MethodName: "PrimaryName",
+			Handler:    _Query_PrimaryName_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "provenance/name/v1/query.proto",
@@ -708,73 +968,242 @@ func (m *QueryReverseLookupResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryChildrenRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryChildrenRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryResolveRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryChildrenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if m.Depth != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Depth))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryResolveResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryChildrenResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChildrenResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChildrenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Restricted {
-		n += 2
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryReverseLookupRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPrimaryNameRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPrimaryNameRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPrimaryNameRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPrimaryNameResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPrimaryNameResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPrimaryNameResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Designated {
+		i--
+		if m.Designated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryResolveRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryResolveResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Restricted {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryReverseLookupRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
@@ -804,6 +1233,72 @@ func (m *QueryReverseLookupResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryChildrenRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Depth != 0 {
+		n += 1 + sovQuery(uint64(m.Depth))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryChildrenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPrimaryNameRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPrimaryNameResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Record.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Designated {
+		n += 2
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -829,16 +1324,283 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryResolveRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryResolveRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryResolveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryResolveResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryResolveResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryResolveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restricted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Restricted = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -860,7 +1622,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryReverseLookupRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -883,15 +1645,47 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryReverseLookupRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryReverseLookupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -918,7 +1712,10 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -943,7 +1740,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryResolveRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryReverseLookupResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -966,10 +1763,10 @@ func (m *QueryResolveRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryResolveRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryReverseLookupResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryResolveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryReverseLookupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1002,7 +1799,43 @@ func (m *QueryResolveRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Name = append(m.Name, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1025,7 +1858,7 @@ func (m *QueryResolveRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryResolveResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryChildrenRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1048,15 +1881,15 @@ func (m *QueryResolveResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryResolveResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryChildrenRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryResolveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryChildrenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1084,13 +1917,13 @@ func (m *QueryResolveResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Restricted", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Depth", wireType)
 			}
-			var v int
+			m.Depth = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1100,12 +1933,47 @@ func (m *QueryResolveResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Depth |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Restricted = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1127,7 +1995,7 @@ func (m *QueryResolveResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryReverseLookupRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryChildrenResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1150,17 +2018,17 @@ func (m *QueryReverseLookupRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryReverseLookupRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryChildrenResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryReverseLookupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryChildrenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1170,23 +2038,25 @@ func (m *QueryReverseLookupRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Records = append(m.Records, NameRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -1218,7 +2088,7 @@ func (m *QueryReverseLookupRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+				m.Pagination = &query.PageResponse{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -1245,7 +2115,7 @@ func (m *QueryReverseLookupRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryReverseLookupResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPrimaryNameRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1268,15 +2138,15 @@ func (m *QueryReverseLookupResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryReverseLookupResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPrimaryNameRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryReverseLookupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPrimaryNameRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1304,11 +2174,61 @@ func (m *QueryReverseLookupResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = append(m.Name, string(dAtA[iNdEx:postIndex]))
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPrimaryNameResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPrimaryNameResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPrimaryNameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1335,13 +2255,30 @@ func (m *QueryReverseLookupResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Designated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Designated = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -0,0 +1,43 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	metadatatypes "github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// ParseMetadataTarget parses a bech32 metadata address that a name can be bound to.
+// Only scope, scope specification and contract specification addresses are supported.
+func ParseMetadataTarget(target string) (metadatatypes.MetadataAddress, error) {
+	addr, err := metadatatypes.MetadataAddressFromBech32(target)
+	if err != nil {
+		return nil, ErrInvalidAddress.Wrap(err.Error())
+	}
+	if !addr.IsScopeAddress() && !addr.IsScopeSpecificationAddress() && !addr.IsContractSpecificationAddress() {
+		return nil, ErrInvalidAddress.Wrapf("names cannot be bound to %s", target)
+	}
+	return addr, nil
+}
+
+// IsMetadataAddress returns true if the bech32 address is a metadata address rather than an account address.
+func IsMetadataAddress(target string) bool {
+	_, err := metadatatypes.MetadataAddressFromBech32(target)
+	return err == nil
+}
+
+// ParseTargetAddress parses the bech32 address a name is bound to, which is either an account address or a supported
+// metadata address. The address bytes are returned as an AccAddress so they can be used to index names by address.
+func ParseTargetAddress(target string) (sdk.AccAddress, error) {
+	addr, accErr := sdk.AccAddressFromBech32(target)
+	if accErr == nil {
+		return addr, nil
+	}
+	if !IsMetadataAddress(target) {
+		return nil, accErr
+	}
+	metadataAddr, err := ParseMetadataTarget(target)
+	if err != nil {
+		return nil, err
+	}
+	return sdk.AccAddress(metadataAddr), nil
+}
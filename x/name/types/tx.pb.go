@@ -185,38 +185,292 @@ func (m *MsgDeleteNameResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDeleteNameResponse proto.InternalMessageInfo
 
+// MsgModifyNameRequest defines an sdk.Msg type that is used to change the address and restricted flag of an existing
+// address/name binding. It must be signed by the current owner of the name, or by the governance module authority if
+// the name is a root name.
+type MsgModifyNameRequest struct {
+	// The address signing the message.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// The updated name record. The name must already be bound.
+	Record NameRecord `protobuf:"bytes,2,opt,name=record,proto3" json:"record"`
+}
+
+func (m *MsgModifyNameRequest) Reset()         { *m = MsgModifyNameRequest{} }
+func (m *MsgModifyNameRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgModifyNameRequest) ProtoMessage()    {}
+func (*MsgModifyNameRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eacf6cd967218635, []int{4}
+}
+func (m *MsgModifyNameRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgModifyNameRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgModifyNameRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgModifyNameRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgModifyNameRequest.Merge(m, src)
+}
+func (m *MsgModifyNameRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgModifyNameRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgModifyNameRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgModifyNameRequest proto.InternalMessageInfo
+
+// MsgModifyNameResponse defines the Msg/ModifyName response type.
+type MsgModifyNameResponse struct {
+}
+
+func (m *MsgModifyNameResponse) Reset()         { *m = MsgModifyNameResponse{} }
+func (m *MsgModifyNameResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgModifyNameResponse) ProtoMessage()    {}
+func (*MsgModifyNameResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eacf6cd967218635, []int{5}
+}
+func (m *MsgModifyNameResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgModifyNameResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgModifyNameResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgModifyNameResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgModifyNameResponse.Merge(m, src)
+}
+func (m *MsgModifyNameResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgModifyNameResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgModifyNameResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgModifyNameResponse proto.InternalMessageInfo
+
+// MsgRenewNameRequest defines an sdk.Msg type that is used to extend the lease on a name. The renewal fee from the
+// module params is charged to the owner, who must be the current owner of the name.
+type MsgRenewNameRequest struct {
+	// The name to renew.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The current owner of the name and signer of the message.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (m *MsgRenewNameRequest) Reset()         { *m = MsgRenewNameRequest{} }
+func (m *MsgRenewNameRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgRenewNameRequest) ProtoMessage()    {}
+func (*MsgRenewNameRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eacf6cd967218635, []int{6}
+}
+func (m *MsgRenewNameRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRenewNameRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRenewNameRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRenewNameRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRenewNameRequest.Merge(m, src)
+}
+func (m *MsgRenewNameRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRenewNameRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRenewNameRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRenewNameRequest proto.InternalMessageInfo
+
+// MsgRenewNameResponse defines the Msg/RenewName response type.
+type MsgRenewNameResponse struct {
+}
+
+func (m *MsgRenewNameResponse) Reset()         { *m = MsgRenewNameResponse{} }
+func (m *MsgRenewNameResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRenewNameResponse) ProtoMessage()    {}
+func (*MsgRenewNameResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eacf6cd967218635, []int{7}
+}
+func (m *MsgRenewNameResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRenewNameResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRenewNameResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRenewNameResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRenewNameResponse.Merge(m, src)
+}
+func (m *MsgRenewNameResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRenewNameResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRenewNameResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRenewNameResponse proto.InternalMessageInfo
+
+// MsgSetPrimaryNameRequest defines an sdk.Msg type that is used to designate one of the names bound to an address as
+// that address's primary name. An empty name clears the primary name.
+type MsgSetPrimaryNameRequest struct {
+	// The name to use as the primary name. It must be bound to the address.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The address setting its primary name and signer of the message.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *MsgSetPrimaryNameRequest) Reset()         { *m = MsgSetPrimaryNameRequest{} }
+func (m *MsgSetPrimaryNameRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgSetPrimaryNameRequest) ProtoMessage()    {}
+func (*MsgSetPrimaryNameRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eacf6cd967218635, []int{8}
+}
+func (m *MsgSetPrimaryNameRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetPrimaryNameRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetPrimaryNameRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetPrimaryNameRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetPrimaryNameRequest.Merge(m, src)
+}
+func (m *MsgSetPrimaryNameRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetPrimaryNameRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetPrimaryNameRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetPrimaryNameRequest proto.InternalMessageInfo
+
+// MsgSetPrimaryNameResponse defines the Msg/SetPrimaryName response type.
+type MsgSetPrimaryNameResponse struct {
+}
+
+func (m *MsgSetPrimaryNameResponse) Reset()         { *m = MsgSetPrimaryNameResponse{} }
+func (m *MsgSetPrimaryNameResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetPrimaryNameResponse) ProtoMessage()    {}
+func (*MsgSetPrimaryNameResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eacf6cd967218635, []int{9}
+}
+func (m *MsgSetPrimaryNameResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetPrimaryNameResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetPrimaryNameResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetPrimaryNameResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetPrimaryNameResponse.Merge(m, src)
+}
+func (m *MsgSetPrimaryNameResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetPrimaryNameResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetPrimaryNameResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetPrimaryNameResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgBindNameRequest)(nil), "provenance.name.v1.MsgBindNameRequest")
 	proto.RegisterType((*MsgBindNameResponse)(nil), "provenance.name.v1.MsgBindNameResponse")
 	proto.RegisterType((*MsgDeleteNameRequest)(nil), "provenance.name.v1.MsgDeleteNameRequest")
 	proto.RegisterType((*MsgDeleteNameResponse)(nil), "provenance.name.v1.MsgDeleteNameResponse")
+	proto.RegisterType((*MsgModifyNameRequest)(nil), "provenance.name.v1.MsgModifyNameRequest")
+	proto.RegisterType((*MsgModifyNameResponse)(nil), "provenance.name.v1.MsgModifyNameResponse")
+	proto.RegisterType((*MsgRenewNameRequest)(nil), "provenance.name.v1.MsgRenewNameRequest")
+	proto.RegisterType((*MsgRenewNameResponse)(nil), "provenance.name.v1.MsgRenewNameResponse")
+	proto.RegisterType((*MsgSetPrimaryNameRequest)(nil), "provenance.name.v1.MsgSetPrimaryNameRequest")
+	proto.RegisterType((*MsgSetPrimaryNameResponse)(nil), "provenance.name.v1.MsgSetPrimaryNameResponse")
 }
 
 func init() { proto.RegisterFile("provenance/name/v1/tx.proto", fileDescriptor_eacf6cd967218635) }
 
 var fileDescriptor_eacf6cd967218635 = []byte{
-	// 324 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2e, 0x28, 0xca, 0x2f,
-	0x4b, 0xcd, 0x4b, 0xcc, 0x4b, 0x4e, 0xd5, 0xcf, 0x4b, 0xcc, 0x4d, 0xd5, 0x2f, 0x33, 0xd4, 0x2f,
-	0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x42, 0x48, 0xea, 0x81, 0x24, 0xf5, 0xca,
-	0x0c, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0xd2, 0xfa, 0x20, 0x16, 0x44, 0xa5, 0x94, 0x2c,
-	0x16, 0x63, 0xc0, 0x3a, 0xc0, 0xd2, 0x4a, 0xb3, 0x18, 0xb9, 0x84, 0x7c, 0x8b, 0xd3, 0x9d, 0x32,
-	0xf3, 0x52, 0xfc, 0x12, 0x73, 0x53, 0x83, 0x52, 0x0b, 0x4b, 0x53, 0x8b, 0x4b, 0x84, 0x6c, 0xb8,
-	0xd8, 0x0a, 0x12, 0x8b, 0x52, 0xf3, 0x4a, 0x24, 0x18, 0x15, 0x18, 0x35, 0xb8, 0x8d, 0xe4, 0xf4,
-	0x30, 0x2d, 0xd4, 0x83, 0x68, 0x48, 0xce, 0x2f, 0x4a, 0x71, 0x62, 0x39, 0x71, 0x4f, 0x9e, 0x21,
-	0x08, 0xaa, 0x07, 0xa4, 0xbb, 0x08, 0x2c, 0x2e, 0xc1, 0x44, 0x8a, 0x6e, 0x88, 0x1e, 0x2b, 0x8e,
-	0x8e, 0x05, 0xf2, 0x0c, 0x2f, 0x16, 0xc8, 0x33, 0x28, 0x89, 0x72, 0x09, 0xa3, 0xb8, 0xad, 0xb8,
-	0x20, 0x3f, 0xaf, 0x38, 0x55, 0x29, 0x8e, 0x4b, 0xc4, 0xb7, 0x38, 0xdd, 0x25, 0x35, 0x27, 0xb5,
-	0x24, 0x15, 0xcd, 0xd1, 0x50, 0x6b, 0x19, 0x29, 0xb2, 0x56, 0x9c, 0x4b, 0x14, 0xcd, 0x7c, 0x88,
-	0xc5, 0x46, 0x47, 0x19, 0xb9, 0x98, 0x7d, 0x8b, 0xd3, 0x85, 0xa2, 0xb9, 0x38, 0x60, 0x8e, 0x12,
-	0x52, 0xc3, 0x66, 0x09, 0x66, 0x88, 0x4a, 0xa9, 0x13, 0x54, 0x07, 0xb1, 0x44, 0x28, 0x91, 0x8b,
-	0x0b, 0x61, 0xb5, 0x90, 0x06, 0x0e, 0x6d, 0x18, 0xbe, 0x97, 0xd2, 0x24, 0x42, 0x25, 0xc4, 0x0a,
-	0xa7, 0xe4, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2,
-	0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0xe0, 0x12, 0xcd, 0xcc, 0xc7,
-	0x62, 0x4c, 0x00, 0x63, 0x94, 0x41, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae,
-	0x3e, 0x42, 0x81, 0x6e, 0x66, 0x3e, 0x12, 0x4f, 0xbf, 0x02, 0x92, 0xc2, 0x4a, 0x2a, 0x0b, 0x52,
-	0x8b, 0x93, 0xd8, 0xc0, 0x09, 0xcc, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x80, 0x68, 0x9e, 0xb8,
-	0xc8, 0x02, 0x00, 0x00,
+	// 488 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0x6d, 0x5a, 0x4a, 0xf3, 0x90, 0x18, 0x8e, 0x04, 0x82, 0x0b, 0x0e, 0xca, 0x00, 0xa9,
+	0x44, 0x6d, 0x5a, 0x36, 0xc4, 0x14, 0xb1, 0x30, 0x18, 0x55, 0x66, 0x03, 0xa9, 0x92, 0x1b, 0x3f,
+	0x5c, 0x4b, 0xf8, 0xce, 0xdc, 0x5d, 0xd2, 0x46, 0xe2, 0x03, 0x30, 0x32, 0x33, 0xf5, 0xe3, 0x74,
+	0xec, 0xc8, 0x84, 0x50, 0xb2, 0xf0, 0x0d, 0x58, 0x51, 0xee, 0xae, 0xd8, 0x49, 0x6c, 0x25, 0x08,
+	0x36, 0x9f, 0xdf, 0xfb, 0xff, 0x7f, 0x7f, 0xdf, 0x7b, 0x32, 0xec, 0xe4, 0x9c, 0x8d, 0x90, 0x46,
+	0x74, 0x80, 0x3e, 0x8d, 0x32, 0xf4, 0x47, 0xfb, 0xbe, 0x3c, 0xf3, 0x72, 0xce, 0x24, 0x23, 0xa4,
+	0x28, 0x7a, 0xb3, 0xa2, 0x37, 0xda, 0x77, 0x9a, 0x09, 0x4b, 0x98, 0x2a, 0xfb, 0xb3, 0x27, 0xdd,
+	0xe9, 0x3c, 0xa8, 0xb0, 0x51, 0x0a, 0x55, 0xee, 0x7e, 0xb5, 0x81, 0x04, 0x22, 0xe9, 0xa7, 0x34,
+	0x7e, 0x1d, 0x65, 0x18, 0xe2, 0xc7, 0x21, 0x0a, 0x49, 0x5e, 0xc0, 0x56, 0x1e, 0x71, 0xa4, 0xb2,
+	0x6d, 0x3f, 0xb4, 0x7b, 0x37, 0x0f, 0x5c, 0x6f, 0x19, 0xe8, 0x69, 0xc1, 0x80, 0xf1, 0xb8, 0xbf,
+	0x79, 0xf1, 0xbd, 0x63, 0x85, 0x46, 0x33, 0x53, 0x73, 0xf5, 0xbe, 0x7d, 0xed, 0x6f, 0xd4, 0x5a,
+	0xf3, 0x7c, 0xfb, 0xf3, 0x79, 0xc7, 0xfa, 0x79, 0xde, 0xb1, 0xba, 0x2d, 0xb8, 0x3d, 0x97, 0x4d,
+	0xe4, 0x8c, 0x0a, 0xec, 0x1e, 0x41, 0x33, 0x10, 0xc9, 0x4b, 0xfc, 0x80, 0x12, 0x17, 0x42, 0x1b,
+	0xac, 0xfd, 0x4f, 0xd8, 0xbb, 0xd0, 0x5a, 0xf0, 0x37, 0xe0, 0x4f, 0x0a, 0x1c, 0xb0, 0x38, 0x7d,
+	0x3f, 0x2e, 0x83, 0xef, 0x43, 0x23, 0x1a, 0xca, 0x13, 0xc6, 0x53, 0x39, 0x56, 0xec, 0x46, 0x58,
+	0xbc, 0xf8, 0x6f, 0xb7, 0xa1, 0x63, 0x95, 0xe9, 0x26, 0xd6, 0x2b, 0x75, 0x4d, 0x21, 0x52, 0x3c,
+	0x2d, 0xa7, 0x22, 0xb0, 0x39, 0x73, 0x37, 0x81, 0xd4, 0x33, 0x69, 0xc2, 0x75, 0x76, 0x4a, 0x91,
+	0xab, 0x28, 0x8d, 0x50, 0x1f, 0x4a, 0x8c, 0x3b, 0xd0, 0x9c, 0xb7, 0x32, 0x88, 0x10, 0xda, 0x81,
+	0x48, 0xde, 0xa0, 0x3c, 0xe4, 0x69, 0x16, 0xf1, 0xf1, 0x2a, 0x4e, 0x1b, 0x6e, 0x44, 0x71, 0xcc,
+	0x51, 0x08, 0x43, 0xba, 0x3a, 0x96, 0x58, 0x3b, 0x70, 0xaf, 0xc2, 0x53, 0x03, 0x0f, 0x7e, 0x6d,
+	0xc0, 0x46, 0x20, 0x12, 0xf2, 0x0e, 0xb6, 0xaf, 0xe6, 0x4f, 0x1e, 0x55, 0x5d, 0xdc, 0xf2, 0xf2,
+	0x3a, 0x8f, 0x57, 0xf6, 0x69, 0x08, 0x89, 0x00, 0x8a, 0x29, 0x93, 0x5e, 0x8d, 0x6c, 0x69, 0xd1,
+	0x9c, 0xdd, 0x35, 0x3a, 0x0b, 0x44, 0x31, 0xb1, 0x5a, 0xc4, 0xd2, 0x4a, 0x39, 0xbb, 0x6b, 0x74,
+	0x1a, 0xc4, 0x11, 0x34, 0xfe, 0x0c, 0x8c, 0xd4, 0x7d, 0xfb, 0xe2, 0x76, 0x38, 0xbd, 0xd5, 0x8d,
+	0xc6, 0x3f, 0x83, 0x5b, 0xf3, 0x43, 0x22, 0x4f, 0x6a, 0xb4, 0x95, 0xfb, 0xe1, 0xec, 0xad, 0xd9,
+	0xad, 0x71, 0xfd, 0xc1, 0xc5, 0xc4, 0xb5, 0x2f, 0x27, 0xae, 0xfd, 0x63, 0xe2, 0xda, 0x5f, 0xa6,
+	0xae, 0x75, 0x39, 0x75, 0xad, 0x6f, 0x53, 0xd7, 0x82, 0x56, 0xca, 0x2a, 0xac, 0x0e, 0xed, 0xb7,
+	0x4f, 0x93, 0x54, 0x9e, 0x0c, 0x8f, 0xbd, 0x01, 0xcb, 0xfc, 0xa2, 0x61, 0x2f, 0x65, 0xa5, 0x93,
+	0x7f, 0xa6, 0x7f, 0x7f, 0x72, 0x9c, 0xa3, 0x38, 0xde, 0x52, 0x7f, 0xbf, 0x67, 0xbf, 0x07, 0x00,
+	0x97, 0xdd, 0x2b, 0x4f, 0x65, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -235,6 +489,12 @@ type MsgClient interface {
 	BindName(ctx context.Context, in *MsgBindNameRequest, opts ...grpc.CallOption) (*MsgBindNameResponse, error)
 	// DeleteName defines a method to verify a particular invariance.
 	DeleteName(ctx context.Context, in *MsgDeleteNameRequest, opts ...grpc.CallOption) (*MsgDeleteNameResponse, error)
+	// ModifyName changes the address and restricted flag of an existing name.
+	ModifyName(ctx context.Context, in *MsgModifyNameRequest, opts ...grpc.CallOption) (*MsgModifyNameResponse, error)
+	// RenewName extends the lease on a name owned by the signer.
+	RenewName(ctx context.Context, in *MsgRenewNameRequest, opts ...grpc.CallOption) (*MsgRenewNameResponse, error)
+	// SetPrimaryName designates one of the names bound to the signer as its primary name.
+	SetPrimaryName(ctx context.Context, in *MsgSetPrimaryNameRequest, opts ...grpc.CallOption) (*MsgSetPrimaryNameResponse, error)
 }
 
 type msgClient struct {
@@ -263,12 +523,45 @@ func (c *msgClient) DeleteName(ctx context.Context, in *MsgDeleteNameRequest, op
 	return out, nil
 }
 
+func (c *msgClient) ModifyName(ctx context.Context, in *MsgModifyNameRequest, opts ...grpc.CallOption) (*MsgModifyNameResponse, error) {
+	out := new(MsgModifyNameResponse)
+	err := c.cc.Invoke(ctx, "/provenance.name.v1.Msg/ModifyName", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RenewName(ctx context.Context, in *MsgRenewNameRequest, opts ...grpc.CallOption) (*MsgRenewNameResponse, error) {
+	out := new(MsgRenewNameResponse)
+	err := c.cc.Invoke(ctx, "/provenance.name.v1.Msg/RenewName", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetPrimaryName(ctx context.Context, in *MsgSetPrimaryNameRequest, opts ...grpc.CallOption) (*MsgSetPrimaryNameResponse, error) {
+	out := new(MsgSetPrimaryNameResponse)
+	err := c.cc.Invoke(ctx, "/provenance.name.v1.Msg/SetPrimaryName", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// BindName binds a name to an address under a root name.
 	BindName(context.Context, *MsgBindNameRequest) (*MsgBindNameResponse, error)
 	// DeleteName defines a method to verify a particular invariance.
 	DeleteName(context.Context, *MsgDeleteNameRequest) (*MsgDeleteNameResponse, error)
+	// ModifyName changes the address and restricted flag of an existing name.
+	ModifyName(context.Context, *MsgModifyNameRequest) (*MsgModifyNameResponse, error)
+	// RenewName extends the lease on a name owned by the signer.
+	RenewName(context.Context, *MsgRenewNameRequest) (*MsgRenewNameResponse, error)
+	// SetPrimaryName designates one of the names bound to the signer as its primary name.
+	SetPrimaryName(context.Context, *MsgSetPrimaryNameRequest) (*MsgSetPrimaryNameResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -281,6 +574,15 @@ func (*UnimplementedMsgServer) BindName(ctx context.Context, req *MsgBindNameReq
 func (*UnimplementedMsgServer) DeleteName(ctx context.Context, req *MsgDeleteNameRequest) (*MsgDeleteNameResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteName not implemented")
 }
+func (*UnimplementedMsgServer) ModifyName(ctx context.Context, req *MsgModifyNameRequest) (*MsgModifyNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModifyName not implemented")
+}
+func (*UnimplementedMsgServer) RenewName(ctx context.Context, req *MsgRenewNameRequest) (*MsgRenewNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenewName not implemented")
+}
+func (*UnimplementedMsgServer) SetPrimaryName(ctx context.Context, req *MsgSetPrimaryNameRequest) (*MsgSetPrimaryNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPrimaryName not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -322,6 +624,60 @@ func _Msg_DeleteName_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ModifyName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgModifyNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ModifyName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.name.v1.Msg/ModifyName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ModifyName(ctx, req.(*MsgModifyNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RenewName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRenewNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RenewName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.name.v1.Msg/RenewName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RenewName(ctx, req.(*MsgRenewNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetPrimaryName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetPrimaryNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetPrimaryName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.name.v1.Msg/SetPrimaryName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetPrimaryName(ctx, req.(*MsgSetPrimaryNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "provenance.name.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -334,6 +690,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteName",
 			Handler:    _Msg_DeleteName_Handler,
 		},
+		{
+			MethodName: "ModifyName",
+			Handler:    _Msg_ModifyName_Handler,
+		},
+		{
+			MethodName: "RenewName",
+			Handler:    _Msg_RenewName_Handler,
+		},
+		{
+			MethodName: "SetPrimaryName",
+			Handler:    _Msg_SetPrimaryName_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "provenance/name/v1/tx.proto",
@@ -461,51 +829,310 @@ func (m *MsgDeleteNameResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgModifyNameRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgBindNameRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Parent.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.Record.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+
+func (m *MsgModifyNameRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgBindNameResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgModifyNameRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	{
+		size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteNameRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgModifyNameResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Record.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteNameResponse) Size() (n int) {
+func (m *MsgModifyNameResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgModifyNameResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRenewNameRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRenewNameRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRenewNameRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRenewNameResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRenewNameResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRenewNameResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetPrimaryNameRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPrimaryNameRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPrimaryNameRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetPrimaryNameResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPrimaryNameResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPrimaryNameResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgBindNameRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Parent.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Record.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgBindNameResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeleteNameRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Record.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgDeleteNameResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgModifyNameRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Record.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgModifyNameResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRenewNameRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRenewNameResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetPrimaryNameRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetPrimaryNameResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -819,6 +1446,499 @@ func (m *MsgDeleteNameResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgModifyNameRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgModifyNameRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgModifyNameRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgModifyNameResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgModifyNameResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgModifyNameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRenewNameRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRenewNameRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRenewNameRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRenewNameResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRenewNameResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRenewNameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetPrimaryNameRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetPrimaryNameRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetPrimaryNameRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetPrimaryNameResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetPrimaryNameResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetPrimaryNameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
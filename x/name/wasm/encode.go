@@ -22,6 +22,8 @@ type NameMsgParams struct {
 	Bind *BindNameParams `json:"bind_name,omitempty"`
 	// Encode a MsgUnBindName
 	Delete *DeleteNameParams `json:"delete_name,omitempty"`
+	// Encode a MsgModifyName
+	Modify *ModifyNameParams `json:"modify_name,omitempty"`
 }
 
 // BindNameParams are params for encoding a MsgBindName.
@@ -40,6 +42,16 @@ type DeleteNameParams struct {
 	Name string `json:"name"`
 }
 
+// ModifyNameParams are params for encoding a MsgModifyNameRequest.
+type ModifyNameParams struct {
+	// The name to modify. It must currently be owned by the contract.
+	Name string `json:"name"`
+	// The new address of the name
+	Address string `json:"address"`
+	// Whether to restrict binding child names to the owner
+	Restrict bool `json:"restrict"`
+}
+
 // Encoder returns a smart contract message encoder for the name module.
 func Encoder(contract sdk.AccAddress, msg json.RawMessage, version string) ([]sdk.Msg, error) {
 	wrapper := struct {
@@ -57,6 +69,8 @@ func Encoder(contract sdk.AccAddress, msg json.RawMessage, version string) ([]sd
 		return params.Bind.Encode(contract)
 	case params.Delete != nil:
 		return params.Delete.Encode(contract)
+	case params.Modify != nil:
+		return params.Modify.Encode(contract)
 	default:
 		return nil, fmt.Errorf("wasm: invalid name encode request: %s", string(msg))
 	}
@@ -88,3 +102,15 @@ func (params *DeleteNameParams) Encode(contract sdk.AccAddress) ([]sdk.Msg, erro
 	msg := types.NewMsgDeleteNameRequest(record)
 	return []sdk.Msg{msg}, nil
 }
+
+// Encode creates a MsgModifyNameRequest.
+// The contract must be the current owner of the name.
+func (params *ModifyNameParams) Encode(contract sdk.AccAddress) ([]sdk.Msg, error) {
+	address, err := sdk.AccAddressFromBech32(params.Address)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid modify address: %w", err)
+	}
+	record := types.NewNameRecord(params.Name, address, params.Restrict)
+	msg := types.NewMsgModifyNameRequest(contract.String(), record)
+	return []sdk.Msg{msg}, nil
+}
@@ -19,6 +19,8 @@ type NameQueryParams struct {
 	Resolve *ResolveQueryParams `json:"resolve,omitempty"`
 	// Lookup all names an address is bound to.
 	Lookup *LookupQueryParams `json:"lookup,omitempty"`
+	// Get the primary name of an address.
+	Primary *PrimaryNameQueryParams `json:"primary_name,omitempty"`
 }
 
 // ResolveQueryParams are the inputs for a resolve name query.
@@ -33,6 +35,12 @@ type LookupQueryParams struct {
 	Address string `json:"address"`
 }
 
+// PrimaryNameQueryParams are the inputs for a primary name query.
+type PrimaryNameQueryParams struct {
+	// Find the primary name of this address.
+	Address string `json:"address"`
+}
+
 // Querier returns a smart contract querier for the name module.
 func Querier(keeper keeper.Keeper) provwasm.Querier {
 	return func(ctx sdk.Context, query json.RawMessage, version string) ([]byte, error) {
@@ -51,6 +59,8 @@ func Querier(keeper keeper.Keeper) provwasm.Querier {
 			return params.Resolve.Run(ctx, keeper)
 		case params.Lookup != nil:
 			return params.Lookup.Run(ctx, keeper)
+		case params.Primary != nil:
+			return params.Primary.Run(ctx, keeper)
 		default:
 			return nil, fmt.Errorf("wasm: invalid name query: %s", string(query))
 		}
@@ -68,7 +78,7 @@ func (params *ResolveQueryParams) Run(ctx sdk.Context, keeper keeper.Keeper) ([]
 
 // Run looks up all names bound to a given address.
 func (params *LookupQueryParams) Run(ctx sdk.Context, keeper keeper.Keeper) ([]byte, error) {
-	acc, err := sdk.AccAddressFromBech32(params.Address)
+	acc, err := types.ParseTargetAddress(params.Address)
 	if err != nil {
 		return nil, fmt.Errorf("wasm: invalid address: %w", err)
 	}
@@ -79,6 +89,31 @@ func (params *LookupQueryParams) Run(ctx sdk.Context, keeper keeper.Keeper) ([]b
 	return createResponse(records)
 }
 
+// Run gets the primary name of a given address.
+func (params *PrimaryNameQueryParams) Run(ctx sdk.Context, keeper keeper.Keeper) ([]byte, error) {
+	acc, err := sdk.AccAddressFromBech32(params.Address)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid address: %w", err)
+	}
+	record, designated, err := keeper.GetPrimaryName(ctx, acc)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: primary name query failed: %w", err)
+	}
+	rep := &QueryResPrimaryName{
+		Record: QueryResName{
+			Name:       record.Name,
+			Address:    record.Address,
+			Restricted: record.Restricted,
+		},
+		Designated: designated,
+	}
+	bz, err := json.Marshal(rep)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: marshal response failed: %w", err)
+	}
+	return bz, nil
+}
+
 // A helper function for converting name module record types into local query response types.
 func createResponse(records types.NameRecords) ([]byte, error) {
 	rep := &QueryResNames{}
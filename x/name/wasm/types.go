@@ -12,3 +12,10 @@ type QueryResName struct {
 type QueryResNames struct {
 	Records []QueryResName `json:"records,omitempty"`
 }
+
+// QueryResPrimaryName contains the primary name of an address.
+type QueryResPrimaryName struct {
+	Record QueryResName `json:"record"`
+	// Whether the address designated the name as its primary name.
+	Designated bool `json:"designated"`
+}